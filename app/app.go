@@ -179,6 +179,7 @@ var (
 		bridgemoduletypes.ModuleName:       nil,
 		spmoduletypes.ModuleName:           {authtypes.Staking},
 		virtualgroupmoduletypes.ModuleName: nil,
+		challengemoduletypes.ModuleName:    {authtypes.Burner},
 	}
 )
 
@@ -452,7 +453,6 @@ func New(
 		app.AuthzKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	spModule := spmodule.NewAppModule(appCodec, app.SpKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.PaymentKeeper = *paymentmodulekeeper.NewKeeper(
 		appCodec,
@@ -512,6 +512,9 @@ func New(
 	)
 	challengeModule := challengemodule.NewAppModule(appCodec, app.ChallengeKeeper, app.AccountKeeper, app.BankKeeper)
 
+	app.SpKeeper.SetChallengeKeeper(&app.ChallengeKeeper)
+	spModule := spmodule.NewAppModule(appCodec, app.SpKeeper, app.AccountKeeper, app.BankKeeper)
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -7,10 +7,15 @@ import (
 	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	bridgemoduletypes "github.com/bnb-chain/greenfield/x/bridge/types"
+	challengemoduletypes "github.com/bnb-chain/greenfield/x/challenge/types"
 	paymentmodule "github.com/bnb-chain/greenfield/x/payment"
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	spmoduletypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagemoduletypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgroupmodule "github.com/bnb-chain/greenfield/x/virtualgroup"
+	virtualgroupmoduletypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
 func (app *App) RegisterUpgradeHandlers(chainID string, serverCfg *serverconfig.Config) error {
@@ -24,6 +29,7 @@ func (app *App) RegisterUpgradeHandlers(chainID string, serverCfg *serverconfig.
 	app.registerNagquUpgradeHandler()
 	app.registerPampasUpgradeHandler()
 	app.registerManchurianUpgradeHandler()
+	app.registerHulunbeierUpgradeHandler()
 	// app.register...()
 	// ...
 	return nil
@@ -128,3 +134,102 @@ func (app *App) registerManchurianUpgradeHandler() {
 			return nil
 		})
 }
+
+func (app *App) registerHulunbeierUpgradeHandler() {
+	// Register the upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(gnfdtypes.Hulunbeier,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.Logger().Info("upgrade to ", plan.Name)
+
+			// enable the new messages
+			msgGasParamsList := []*gashubtypes.MsgGasParams{
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgCreatePrepaidPlan{}), 1.2e3),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgSubmitReadReceipt{}), 1.2e3),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&storagemoduletypes.MsgClaimReadFee{}), 1.2e3),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&spmoduletypes.MsgUnjailStorageProvider{}), 1.2e3),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&spmoduletypes.MsgWithdrawDeposit{}), 1.2e3),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&virtualgroupmoduletypes.MsgForcedSwapOut{}), 2.4e4),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&virtualgroupmoduletypes.MsgCreateGlobalVirtualGroups{}), 1e6),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&virtualgroupmoduletypes.MsgRebalanceGlobalVirtualGroups{}), 2.4e4),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&challengemoduletypes.MsgAppealSlash{}), 1.2e3),
+				gashubtypes.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&challengemoduletypes.MsgAttestAppeal{}), 1e2),
+			}
+			for _, msgGasParams := range msgGasParamsList {
+				app.GashubKeeper.SetMsgGasParams(ctx, *msgGasParams)
+			}
+
+			// set the default values of the new params, they are zero after the upgrade
+			paymentParams := app.PaymentKeeper.GetParams(ctx)
+			paymentParams.StatementPeriod = paymenttypes.DefaultStatementPeriod
+			paymentParams.StatementRetentionTime = paymenttypes.DefaultStatementRetentionTime
+			if err := app.PaymentKeeper.SetParams(ctx, paymentParams); err != nil {
+				return nil, err
+			}
+
+			spParams := app.SpKeeper.GetParams(ctx)
+			spParams.UnjailCooldownDuration = spmoduletypes.DefaultUnjailCooldownDuration
+			spParams.PriceChangeNoticeDays = spmoduletypes.DefaultPriceChangeNoticeDays
+			spParams.DepositUnbondingPeriod = spmoduletypes.DefaultDepositUnbondingPeriod
+			if err := app.SpKeeper.SetParams(ctx, spParams); err != nil {
+				return nil, err
+			}
+
+			challengeParams := app.ChallengeKeeper.GetParams(ctx)
+			challengeParams.SelectionMode = challengemoduletypes.DefaultSelectionMode
+			challengeParams.SpSlashRiskWeight = challengemoduletypes.DefaultSpSlashRiskWeight
+			challengeParams.ChallengerBond = challengemoduletypes.DefaultChallengerBond
+			challengeParams.ChallengerBondBurnRatio = challengemoduletypes.DefaultChallengerBondBurnRatio
+			challengeParams.ChallengerRateLimit = challengemoduletypes.DefaultChallengerRateLimit
+			challengeParams.ChallengerRateLimitWindow = challengemoduletypes.DefaultChallengerRateLimitWindow
+			challengeParams.ChallengeHistoryKeptBlocks = challengemoduletypes.DefaultChallengeHistoryKeptBlocks
+			challengeParams.SlashEscrowPeriod = challengemoduletypes.DefaultSlashEscrowPeriod
+			challengeParams.ChallengeSegmentCount = challengemoduletypes.DefaultChallengeSegmentCount
+			challengeParams.SlashCurve = challengemoduletypes.DefaultSlashCurve
+			challengeParams.SlashSteps = challengemoduletypes.DefaultSlashSteps
+			challengeParams.RedundancySlashAmountSizeRates = challengemoduletypes.DefaultRedundancySlashAmountSizeRates
+			challengeParams.ChallengeRedundancyCount = challengemoduletypes.DefaultChallengeRedundancyCount
+			if err := app.ChallengeKeeper.SetParams(ctx, challengeParams); err != nil {
+				return nil, err
+			}
+
+			virtualgroupParams := app.VirtualgroupKeeper.GetParams(ctx)
+			virtualgroupParams.ForcedSwapOutTimeout = virtualgroupmoduletypes.DefaultForcedSwapOutTimeout
+			if err := app.VirtualgroupKeeper.SetParams(ctx, virtualgroupParams); err != nil {
+				return nil, err
+			}
+
+			// the out flows active at the upgrade are recorded in the billing statements since now
+			app.PaymentKeeper.SetBillingStatementStartTime(ctx, ctx.BlockTime().Unix())
+
+			// index the existing auto settle and auto resume records by address
+			app.PaymentKeeper.BackfillAutoRecordIndexes(ctx)
+
+			// track the charge size of the existing replica objects, which are charged by the replica store price tiers
+			if err := app.StorageKeeper.BackfillReplicaChargeSize(ctx); err != nil {
+				return nil, err
+			}
+
+			// index the existing sealed objects for the weighted challenge selection
+			app.StorageKeeper.BackfillSealedObjectSamples(ctx)
+
+			// record the bills of the existing buckets for the per-bucket billing statements
+			if err := app.StorageKeeper.BackfillBucketFlows(ctx); err != nil {
+				return nil, err
+			}
+
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		})
+
+	// Register the upgrade initializer
+	app.UpgradeKeeper.SetUpgradeInitializer(gnfdtypes.Hulunbeier,
+		func() error {
+			app.Logger().Info("Init Hulunbeier upgrade")
+			mm, ok := app.mm.Modules[virtualgroupmoduletypes.ModuleName].(*virtualgroupmodule.AppModule)
+			if !ok {
+				panic("*virtualgroupmodule.AppModule not found")
+			}
+			mm.SetConsensusVersion(2)
+
+			return nil
+		})
+}
@@ -72,6 +72,8 @@ type (
 	MsgUpdateGroupMember = storagetypes.MsgUpdateGroupMember
 	MsgLeaveGroup        = storagetypes.MsgLeaveGroup
 
-	MsgSubmit = challengetypes.MsgSubmit
-	MsgAttest = challengetypes.MsgAttest
+	MsgSubmit       = challengetypes.MsgSubmit
+	MsgAttest       = challengetypes.MsgAttest
+	MsgAppealSlash  = challengetypes.MsgAppealSlash
+	MsgAttestAppeal = challengetypes.MsgAttestAppeal
 )
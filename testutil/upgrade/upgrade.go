@@ -0,0 +1,16 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithUpgraded returns a copy of the context on which the given upgrades are applied, the other upgrades are not.
+func WithUpgraded(ctx sdk.Context, names ...string) sdk.Context {
+	upgraded := make(map[string]bool, len(names))
+	for _, name := range names {
+		upgraded[name] = true
+	}
+	return sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), ctx.IsCheckTx(), func(_ sdk.Context, name string) bool {
+		return upgraded[name]
+	}, ctx.Logger()).WithEventManager(ctx.EventManager()).WithGasMeter(ctx.GasMeter())
+}
@@ -0,0 +1,124 @@
+// Package checksum computes and verifies the checksums of objects, which are the ExpectChecksums of MsgCreateObject
+// and the Checksums of ObjectInfo.
+//
+// The payload of an object is split into segments of the max segment size. The primary storage provider stores the
+// segments, and each segment is erasure coded into data and parity pieces, which are stored by secondary storage
+// providers by the redundancy index. The checksums consist of the integrity hash of all segments, followed by the
+// integrity hash of all pieces of each redundancy index, where an integrity hash is the sha256 hash of the
+// concatenated sha256 hashes of segments/pieces.
+package checksum
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// RedundancyIndexPrimary is the redundancy index of the primary storage provider, which stores the segments.
+const RedundancyIndexPrimary = int32(-1)
+
+var (
+	ErrInvalidRedundancyIndex = errors.New("invalid redundancy index")
+	ErrInvalidSegmentIndex    = errors.New("invalid segment index")
+	ErrPieceHashMismatch      = errors.New("piece hash mismatch")
+	ErrIntegrityHashMismatch  = errors.New("integrity hash mismatch")
+)
+
+// GenerateChecksum returns the sha256 hash of a segment/piece.
+func GenerateChecksum(data []byte) []byte {
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// GenerateIntegrityHash returns the integrity hash of the hashes of segments/pieces.
+func GenerateIntegrityHash(hashes [][]byte) []byte {
+	return storagetypes.GenerateHash(hashes)
+}
+
+// ChecksumIndex returns the index in checksums for the redundancy index.
+func ChecksumIndex(redundancyIndex int32) int {
+	return int(redundancyIndex + 1)
+}
+
+// ComputePieceHashes reads the payload and returns the hashes of all segments/pieces of every redundancy index.
+// The first list is the hashes of segments, and the others are the hashes of pieces by redundancy index.
+func ComputePieceHashes(reader io.Reader, segmentSize uint64, dataChunks, parityChunks uint32) ([][][]byte, uint64, error) {
+	if segmentSize == 0 {
+		return nil, 0, errors.New("segment size cannot be zero")
+	}
+
+	pieceHashes := make([][][]byte, 1+dataChunks+parityChunks)
+	for i := range pieceHashes {
+		pieceHashes[i] = make([][]byte, 0)
+	}
+
+	size := uint64(0)
+	segment := make([]byte, segmentSize)
+	for {
+		n, err := io.ReadFull(reader, segment)
+		if n > 0 {
+			size += uint64(n)
+			pieceHashes[0] = append(pieceHashes[0], GenerateChecksum(segment[:n]))
+
+			pieces, encodeErr := EncodeSegment(segment[:n], dataChunks, parityChunks)
+			if encodeErr != nil {
+				return nil, 0, encodeErr
+			}
+			for i, piece := range pieces {
+				pieceHashes[i+1] = append(pieceHashes[i+1], GenerateChecksum(piece))
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return pieceHashes, size, nil
+}
+
+// ComputeChecksums reads the payload and returns the checksums of the object, along with the payload size.
+func ComputeChecksums(reader io.Reader, segmentSize uint64, dataChunks, parityChunks uint32) ([][]byte, uint64, error) {
+	pieceHashes, size, err := ComputePieceHashes(reader, segmentSize, dataChunks, parityChunks)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	checksums := make([][]byte, len(pieceHashes))
+	for i, hashes := range pieceHashes {
+		checksums[i] = GenerateIntegrityHash(hashes)
+	}
+	return checksums, size, nil
+}
+
+// ComputeChecksumsWithParams reads the payload and returns the checksums of the object with the segment size
+// and erasure coding chunks of the versioned params, along with the payload size.
+func ComputeChecksumsWithParams(reader io.Reader, params storagetypes.VersionedParams) ([][]byte, uint64, error) {
+	return ComputeChecksums(reader, params.MaxSegmentSize, params.RedundantDataChunkNum, params.RedundantParityChunkNum)
+}
+
+// VerifyPiece verifies a challenged segment/piece against the checksums of the object. The storage provider returns
+// the challenged segment/piece along with the hashes of all segments/pieces of its redundancy index, the hash of the
+// segment/piece must be in the hashes at the segment index, and the integrity hash of the hashes must be in the checksums.
+func VerifyPiece(checksums [][]byte, redundancyIndex int32, segmentIndex uint32, piece []byte, pieceHashes [][]byte) error {
+	checksumIndex := ChecksumIndex(redundancyIndex)
+	if checksumIndex < 0 || checksumIndex >= len(checksums) {
+		return fmt.Errorf("%w: %d", ErrInvalidRedundancyIndex, redundancyIndex)
+	}
+	if int(segmentIndex) >= len(pieceHashes) {
+		return fmt.Errorf("%w: %d", ErrInvalidSegmentIndex, segmentIndex)
+	}
+
+	if !bytes.Equal(GenerateChecksum(piece), pieceHashes[segmentIndex]) {
+		return fmt.Errorf("%w: segment %d", ErrPieceHashMismatch, segmentIndex)
+	}
+	if !bytes.Equal(GenerateIntegrityHash(pieceHashes), checksums[checksumIndex]) {
+		return fmt.Errorf("%w: redundancy index %d", ErrIntegrityHashMismatch, redundancyIndex)
+	}
+	return nil
+}
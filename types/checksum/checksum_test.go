@@ -0,0 +1,197 @@
+package checksum
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func randomPayload(size int) []byte {
+	payload := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(payload)
+	return payload
+}
+
+func TestEncodeSegment(t *testing.T) {
+	segment := randomPayload(1001)
+	pieces, err := EncodeSegment(segment, 4, 2)
+	require.NoError(t, err)
+	require.Len(t, pieces, 6)
+
+	// the data pieces are the split segment padded with zeros
+	padded := append(append([]byte{}, segment...), make([]byte, 3)...)
+	for i := 0; i < 4; i++ {
+		require.Len(t, pieces[i], 251)
+		require.Equal(t, padded[i*251:(i+1)*251], pieces[i])
+	}
+
+	// the segment can be recovered from any data chunks of the pieces
+	m, err := buildMatrix(4, 6)
+	require.NoError(t, err)
+	for _, picked := range [][]int{{0, 1, 2, 3}, {2, 3, 4, 5}, {0, 2, 4, 5}, {1, 3, 4, 5}} {
+		sub := make(matrix, 4)
+		for i, index := range picked {
+			sub[i] = m[index]
+		}
+		inv, err := sub.invert()
+		require.NoError(t, err)
+
+		recovered := make([]byte, 0, len(padded))
+		for d := 0; d < 4; d++ {
+			data := make([]byte, 251)
+			for i, index := range picked {
+				for j, b := range pieces[index] {
+					data[j] ^= galMultiply(inv[d][i], b)
+				}
+			}
+			recovered = append(recovered, data...)
+		}
+		require.Equal(t, padded, recovered)
+	}
+
+	_, err = EncodeSegment(segment, 0, 2)
+	require.Error(t, err)
+}
+
+// The vectors are generated by github.com/klauspost/reedsolomon v1.10.0, which storage providers encode pieces with.
+// The segment is filled with byte(i*7 + 3) at index i.
+func TestEncodeSegment_KnownAnswers(t *testing.T) {
+	testSegment := func(size int) []byte {
+		segment := make([]byte, size)
+		for i := range segment {
+			segment[i] = byte(i*7 + 3)
+		}
+		return segment
+	}
+
+	testCases := []struct {
+		name         string
+		size         int
+		dataChunks   uint32
+		parityChunks uint32
+		pieces       []string
+	}{
+		{
+			name:         "4+2 with padding",
+			size:         37,
+			dataChunks:   4,
+			parityChunks: 2,
+			pieces: []string{
+				"030a11181f262d343b42", "4950575e656c737a8188", "8f969da4abb2b9c0c7ce",
+				"d5dce3eaf1f8ff000000", "3bd2531dcd134576da03", "11e8856b77594b3b73d1",
+			},
+		},
+		{
+			name:         "6+3 with padding",
+			size:         50,
+			dataChunks:   6,
+			parityChunks: 3,
+			pieces: []string{
+				"030a11181f262d343b", "424950575e656c737a", "81888f969da4abb2b9",
+				"c0c7ced5dce3eaf1f8", "ff060d141b22293037", "3e454c535a00000000",
+				"60b493ba99727f4859", "a1fbd2f1d854567c6e", "8e2179b4ae50e6a982",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pieces, err := EncodeSegment(testSegment(tc.size), tc.dataChunks, tc.parityChunks)
+			require.NoError(t, err)
+			require.Len(t, pieces, len(tc.pieces))
+			for i, piece := range pieces {
+				require.Equal(t, tc.pieces[i], hex.EncodeToString(piece), "piece %d", i)
+			}
+		})
+	}
+
+	// a large segment is compared by the sha256 hashes of the pieces
+	pieceHashes := []string{
+		"5201c953ecc42cba55915e8443de76ddf9f13dea0556feba961b2cd61e3a63cd",
+		"fa8e4334d9737a6de6074cba40a3371dfcf38d0371b69b2b678f5812bbccf29a",
+		"ae9e3d9da68070692d542af6dde11187c31ee315bc917a32f7847d7cbe16e318",
+		"764540310bb372f9b519df4870f5eff02edaf1c31b22688cbc53f1e2e1085b78",
+		"f89cd9cf42b545fbf15d36fb27f9ae754c6bbe3dff97b19d09e4607e1966d563",
+		"97d75ad58d83f7a882993e7146a5e0b6459f399c45bd7db295ca3a36aafc873b",
+	}
+	pieces, err := EncodeSegment(testSegment(1<<16+5), 4, 2)
+	require.NoError(t, err)
+	require.Len(t, pieces, len(pieceHashes))
+	for i, piece := range pieces {
+		require.Len(t, piece, 16386)
+		hash := sha256.Sum256(piece)
+		require.Equal(t, pieceHashes[i], hex.EncodeToString(hash[:]), "piece %d", i)
+	}
+}
+
+func TestCodingMatrix(t *testing.T) {
+	m, err := codingMatrix(4, 6)
+	require.NoError(t, err)
+	built, err := buildMatrix(4, 6)
+	require.NoError(t, err)
+	require.Equal(t, built, m)
+
+	// the matrix is built once for the shape
+	cached, err := codingMatrix(4, 6)
+	require.NoError(t, err)
+	require.Same(t, &m[0][0], &cached[0][0])
+}
+
+func TestComputeChecksums(t *testing.T) {
+	segmentSize := uint64(1024)
+	payload := randomPayload(2600)
+
+	checksums, size, err := ComputeChecksums(bytes.NewReader(payload), segmentSize, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(payload)), size)
+	require.Len(t, checksums, 7)
+
+	segmentHashes := [][]byte{
+		GenerateChecksum(payload[:1024]),
+		GenerateChecksum(payload[1024:2048]),
+		GenerateChecksum(payload[2048:]),
+	}
+	require.Equal(t, GenerateIntegrityHash(segmentHashes), checksums[0])
+
+	params := storagetypes.DefaultParams().VersionedParams
+	params.MaxSegmentSize = segmentSize
+	withParams, _, err := ComputeChecksumsWithParams(bytes.NewReader(payload), params)
+	require.NoError(t, err)
+	require.Equal(t, checksums, withParams)
+}
+
+func TestVerifyPiece(t *testing.T) {
+	segmentSize := uint64(1024)
+	payload := randomPayload(2600)
+
+	checksums, _, err := ComputeChecksums(bytes.NewReader(payload), segmentSize, 4, 2)
+	require.NoError(t, err)
+	pieceHashes, _, err := ComputePieceHashes(bytes.NewReader(payload), segmentSize, 4, 2)
+	require.NoError(t, err)
+
+	// segment challenged on the primary sp
+	segment := payload[1024:2048]
+	require.NoError(t, VerifyPiece(checksums, RedundancyIndexPrimary, 1, segment, pieceHashes[ChecksumIndex(RedundancyIndexPrimary)]))
+
+	// piece challenged on a secondary sp
+	pieces, err := EncodeSegment(payload[2048:], 4, 2)
+	require.NoError(t, err)
+	require.NoError(t, VerifyPiece(checksums, 4, 2, pieces[4], pieceHashes[ChecksumIndex(4)]))
+
+	// tampered piece
+	tampered := append([]byte{}, pieces[4]...)
+	tampered[0] ^= 1
+	require.ErrorIs(t, VerifyPiece(checksums, 4, 2, tampered, pieceHashes[ChecksumIndex(4)]), ErrPieceHashMismatch)
+
+	// hashes of another redundancy index
+	require.ErrorIs(t, VerifyPiece(checksums, 4, 2, pieces[3], pieceHashes[ChecksumIndex(3)]), ErrIntegrityHashMismatch)
+
+	// invalid indexes
+	require.ErrorIs(t, VerifyPiece(checksums, 6, 2, pieces[4], pieceHashes[ChecksumIndex(4)]), ErrInvalidRedundancyIndex)
+	require.ErrorIs(t, VerifyPiece(checksums, 4, 3, pieces[4], pieceHashes[ChecksumIndex(4)]), ErrInvalidSegmentIndex)
+}
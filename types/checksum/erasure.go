@@ -0,0 +1,211 @@
+package checksum
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// The erasure coding below is a systematic Reed-Solomon code over GF(2^8), which is compatible with the default
+// encoder of github.com/klauspost/reedsolomon used by storage providers: the field is generated by the polynomial
+// x^8 + x^4 + x^3 + x^2 + 1, and the coding matrix is a vandermonde matrix whose top square is turned into identity.
+
+// fieldPolynomial is the generating polynomial of the field.
+const fieldPolynomial = 0x11d
+
+var (
+	expTable [510]byte
+	logTable [256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = byte(x)
+		expTable[i+255] = byte(x)
+		logTable[x] = byte(i)
+		x <<= 1
+		if x >= 256 {
+			x ^= fieldPolynomial
+		}
+	}
+}
+
+func galMultiply(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+int(logTable[b])]
+}
+
+func galDivide(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+255-int(logTable[b])]
+}
+
+func galExp(a byte, n int) byte {
+	if n == 0 {
+		return 1
+	}
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])*n)%255]
+}
+
+type matrix [][]byte
+
+func newMatrix(rows, cols int) matrix {
+	m := make(matrix, rows)
+	for r := range m {
+		m[r] = make([]byte, cols)
+	}
+	return m
+}
+
+// vandermonde returns a matrix whose element at (r, c) is r^c.
+func vandermonde(rows, cols int) matrix {
+	m := newMatrix(rows, cols)
+	for r := range m {
+		for c := range m[r] {
+			m[r][c] = galExp(byte(r), c)
+		}
+	}
+	return m
+}
+
+func (m matrix) multiply(right matrix) matrix {
+	result := newMatrix(len(m), len(right[0]))
+	for r := range result {
+		for c := range result[r] {
+			var value byte
+			for i := range right {
+				value ^= galMultiply(m[r][i], right[i][c])
+			}
+			result[r][c] = value
+		}
+	}
+	return result
+}
+
+// invert returns the inverse of a square matrix by gaussian elimination.
+func (m matrix) invert() (matrix, error) {
+	size := len(m)
+	work := newMatrix(size, size*2)
+	for r := range m {
+		copy(work[r], m[r])
+		work[r][size+r] = 1
+	}
+
+	for r := 0; r < size; r++ {
+		if work[r][r] == 0 {
+			for below := r + 1; below < size; below++ {
+				if work[below][r] != 0 {
+					work[r], work[below] = work[below], work[r]
+					break
+				}
+			}
+		}
+		if work[r][r] == 0 {
+			return nil, errors.New("matrix is singular")
+		}
+		if work[r][r] != 1 {
+			scale := galDivide(1, work[r][r])
+			for c := range work[r] {
+				work[r][c] = galMultiply(work[r][c], scale)
+			}
+		}
+		for other := 0; other < size; other++ {
+			if other == r || work[other][r] == 0 {
+				continue
+			}
+			scale := work[other][r]
+			for c := range work[other] {
+				work[other][c] ^= galMultiply(scale, work[r][c])
+			}
+		}
+	}
+
+	result := newMatrix(size, size)
+	for r := range result {
+		copy(result[r], work[r][size:])
+	}
+	return result, nil
+}
+
+// buildMatrix returns the coding matrix, the top dataShards rows of which are identity.
+func buildMatrix(dataShards, totalShards int) (matrix, error) {
+	vm := vandermonde(totalShards, dataShards)
+	top := make(matrix, dataShards)
+	for r := range top {
+		top[r] = vm[r]
+	}
+	topInv, err := top.invert()
+	if err != nil {
+		return nil, err
+	}
+	return vm.multiply(topInv), nil
+}
+
+type matrixShape struct {
+	dataShards, totalShards int
+}
+
+// codingMatrices caches the coding matrices by shape, since they are built with costly inversions.
+var codingMatrices sync.Map
+
+// codingMatrix returns the cached coding matrix of the shape, which is built on the first use.
+func codingMatrix(dataShards, totalShards int) (matrix, error) {
+	shape := matrixShape{dataShards: dataShards, totalShards: totalShards}
+	if m, ok := codingMatrices.Load(shape); ok {
+		return m.(matrix), nil
+	}
+	m, err := buildMatrix(dataShards, totalShards)
+	if err != nil {
+		return nil, err
+	}
+	cached, _ := codingMatrices.LoadOrStore(shape, m)
+	return cached.(matrix), nil
+}
+
+// EncodeSegment erasure codes a segment into dataChunks data pieces followed by parityChunks parity pieces.
+// The segment is split into data pieces of equal size, and the last ones are padded with zeros if needed.
+func EncodeSegment(segment []byte, dataChunks, parityChunks uint32) ([][]byte, error) {
+	dataShards, totalShards := int(dataChunks), int(dataChunks+parityChunks)
+	if dataShards <= 0 || parityChunks == 0 || totalShards > 256 {
+		return nil, fmt.Errorf("invalid erasure coding chunks, data: %d, parity: %d", dataChunks, parityChunks)
+	}
+
+	pieces := make([][]byte, totalShards)
+	if len(segment) == 0 {
+		return pieces, nil
+	}
+
+	pieceSize := (len(segment) + dataShards - 1) / dataShards
+	for i := range pieces {
+		pieces[i] = make([]byte, pieceSize)
+	}
+	for i := 0; i < dataShards && i*pieceSize < len(segment); i++ {
+		copy(pieces[i], segment[i*pieceSize:])
+	}
+
+	m, err := codingMatrix(dataShards, totalShards)
+	if err != nil {
+		return nil, err
+	}
+	for p := dataShards; p < totalShards; p++ {
+		parity := pieces[p]
+		for d := 0; d < dataShards; d++ {
+			coefficient := m[p][d]
+			if coefficient == 0 {
+				continue
+			}
+			for i, b := range pieces[d] {
+				parity[i] ^= galMultiply(coefficient, b)
+			}
+		}
+	}
+	return pieces, nil
+}
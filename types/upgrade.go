@@ -0,0 +1,4 @@
+package types
+
+// Hulunbeier is the upgrade name for Hulunbeier upgrade, the state machine changes of the upgrade are gated on it.
+const Hulunbeier = "Hulunbeier"
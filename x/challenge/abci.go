@@ -3,9 +3,11 @@ package challenge
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	k "github.com/bnb-chain/greenfield/x/challenge/keeper"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
@@ -17,6 +19,9 @@ func BeginBlocker(ctx sdk.Context, keeper k.Keeper) {
 	// delete expired challenges at this height
 	keeper.RemoveChallengeUntil(ctx, blockHeight)
 
+	// distribute the escrowed slashes released at this height
+	keeper.ReleaseSlashEscrowUntil(ctx, blockHeight)
+
 	params := keeper.GetParams(ctx)
 	// delete too old slashes at this height
 	coolingOffPeriod := params.SlashCoolingOffPeriod
@@ -29,6 +34,17 @@ func BeginBlocker(ctx sdk.Context, keeper k.Keeper) {
 	if blockHeight > 0 && blockHeight%params.SpSlashCountingWindow == 0 {
 		keeper.ClearSpSlashAmount(ctx)
 	}
+
+	// prune too old challenge history
+	keptBlocks := params.ChallengeHistoryKeptBlocks
+	if keptBlocks > 0 && blockHeight > keptBlocks {
+		keeper.PruneChallengeHistoryUntil(ctx, blockHeight-keptBlocks)
+	}
+
+	// reset challenger submit counts when a new rate limit window starts
+	if blockHeight > 0 && params.ChallengerRateLimitWindow > 0 && blockHeight%params.ChallengerRateLimitWindow == 0 {
+		keeper.ClearChallengerSubmitCount(ctx)
+	}
 }
 
 func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
@@ -40,23 +56,69 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 		return
 	}
 
+	expiredHeight := params.ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
+	events := make([]proto.Message, 0)     // for events
+	objectMap := make(map[string]struct{}) // for de-duplication
+
+	// challenge the storage providers which took over the moved objects first, the challenges of the objects being
+	// moved are deferred since the Hulunbeier upgrade
+	upgraded := ctx.IsUpgraded(gnfdtypes.Hulunbeier)
+	if upgraded {
+		for _, event := range keeper.IssueFollowUpChallenges(ctx, expiredHeight, needed-count) {
+			objectMap[fmt.Sprintf("%d-%s", event.SpId, event.ObjectId.String())] = struct{}{}
+			events = append(events, event)
+			count++
+		}
+	}
+	defer func() {
+		err := ctx.EventManager().EmitTypedEvents(events...)
+		if err != nil {
+			ctx.Logger().Error("failed to emit challenge events", "err", err.Error())
+		}
+	}()
+	if count >= needed {
+		return
+	}
+
 	objectCount := keeper.StorageKeeper.GetObjectInfoCount(ctx)
 	if objectCount.IsZero() {
 		return
 	}
 
-	expiredHeight := params.ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
+	// in weighted mode, objects are sampled from the sealed object index, fall back to uniform mode if it is empty
+	var sampleCounts []uint64
+	var sampleSizes []sdkmath.Uint
+	weighted := false
+	if params.SelectionMode == types.CHALLENGE_SELECTION_MODE_WEIGHTED {
+		sampleCounts = keeper.StorageKeeper.GetSealedObjectSampleCounts(ctx)
+		for _, count := range sampleCounts {
+			if count > 0 {
+				weighted = true
+				break
+			}
+		}
+		if weighted {
+			sampleSizes = keeper.StorageKeeper.GetSealedObjectSampleSizes(ctx)
+		}
+	}
+
+	segmentCount := k.ChallengeSegmentCount(params)
+	redundancyCount := k.ChallengeRedundancyCount(params)
 
-	events := make([]proto.Message, 0)                      // for events
-	objectMap := make(map[string]struct{})                  // for de-duplication
 	iteration, maxIteration := uint64(0), 10*(needed-count) // to prevent endless loop
 	for count < needed && iteration < maxIteration {
 		iteration++
 		seed := k.SeedFromRandaoMix(ctx.BlockHeader().RandaoMix, iteration)
 
 		// random object info
-		objectId := k.RandomObjectId(seed, objectCount)
-		objectInfo, found := keeper.StorageKeeper.GetObjectInfoById(ctx, objectId)
+		var objectInfo *storagetypes.ObjectInfo
+		var found bool
+		if weighted {
+			objectInfo, found = keeper.RandomSealedObject(ctx, seed, sampleCounts, sampleSizes)
+		} else {
+			objectId := k.RandomObjectId(seed, objectCount)
+			objectInfo, found = keeper.StorageKeeper.GetObjectInfoById(ctx, objectId)
+		}
 		if !found || objectInfo.ObjectStatus != storagetypes.OBJECT_STATUS_SEALED {
 			continue
 		}
@@ -66,41 +128,15 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 			continue
 		}
 
-		// random redundancy index (sp address)
-		var spOperatorId uint32
-
 		bucket, found := keeper.StorageKeeper.GetBucketInfo(ctx, objectInfo.BucketName)
 		if !found {
 			continue
 		}
+		migrating := bucket.BucketStatus == storagetypes.BUCKET_STATUS_MIGRATING
 		gvg, found := keeper.StorageKeeper.GetObjectGVG(ctx, bucket.Id, objectInfo.LocalVirtualGroupId)
 		if !found {
 			continue
 		}
-		redundancyIndex := k.RandomRedundancyIndex(seed, uint64(len(gvg.SecondarySpIds)+1))
-		if redundancyIndex == types.RedundancyIndexPrimary { // primary sp
-			spOperatorId = gvg.PrimarySpId
-		} else {
-			spOperatorId = gvg.SecondarySpIds[redundancyIndex]
-		}
-
-		sp, found := keeper.SpKeeper.GetStorageProvider(ctx, spOperatorId)
-		if !found {
-			continue
-		}
-		if sp.Status != sptypes.STATUS_IN_SERVICE && sp.Status != sptypes.STATUS_GRACEFUL_EXITING {
-			continue
-		}
-
-		mapKey := fmt.Sprintf("%d-%s", spOperatorId, objectInfo.Id.String())
-		if _, ok := objectMap[mapKey]; ok { // already generated for this pair
-			continue
-		}
-
-		// check recent slash
-		if keeper.ExistsSlash(ctx, sp.Id, objectInfo.Id) {
-			continue
-		}
 
 		// random segment/piece index
 		segmentSize, err := keeper.StorageKeeper.MaxSegmentSize(ctx, objectInfo.CreateAt)
@@ -110,30 +146,84 @@ func EndBlocker(ctx sdk.Context, keeper k.Keeper) {
 			continue
 		}
 		segments := k.CalculateSegments(objectInfo.PayloadSize, segmentSize)
-		segmentIndex := k.RandomSegmentIndex(seed, segments)
-
-		objectMap[mapKey] = struct{}{}
-
-		challengeId := keeper.GetChallengeId(ctx) + 1
-		keeper.SaveChallenge(ctx, types.Challenge{
-			Id:            challengeId,
-			ExpiredHeight: expiredHeight,
-		})
-		events = append(events, &types.EventStartChallenge{
-			ChallengeId:       challengeId,
-			ObjectId:          objectInfo.Id,
-			SegmentIndex:      segmentIndex,
-			SpId:              sp.Id,
-			SpOperatorAddress: sp.OperatorAddress,
-			RedundancyIndex:   redundancyIndex,
-			ChallengerAddress: "",
-			ExpiredHeight:     expiredHeight,
+		segmentIndexes := k.RandomSegmentIndexes(seed, segments, segmentCount)
+		segmentIndex := segmentIndexes[0]
+
+		// random redundancy indexes (sp addresses)
+		sps := uint64(len(gvg.SecondarySpIds) + 1)
+		redundancyIndexes := k.RandomRedundancyIndexes(seed, sps, redundancyCount, func(seed []byte) int32 {
+			if weighted {
+				return keeper.RandomRiskWeightedRedundancyIndex(ctx, seed, gvg, params)
+			}
+			return k.RandomRedundancyIndex(seed, sps)
 		})
-
-		count++
-	}
-	err := ctx.EventManager().EmitTypedEvents(events...)
-	if err != nil {
-		ctx.Logger().Error("failed to emit challenge events", "err", err.Error())
+		for _, redundancyIndex := range redundancyIndexes {
+			if count >= needed {
+				break
+			}
+
+			var spOperatorId uint32
+			if redundancyIndex == types.RedundancyIndexPrimary { // primary sp
+				spOperatorId = gvg.PrimarySpId
+			} else {
+				spOperatorId = gvg.SecondarySpIds[redundancyIndex]
+			}
+			// defer the challenge of the object which is being moved, the sp which takes over the object will be
+			// challenged once the bucket migration or the swap out completes
+			if upgraded && (migrating || keeper.StorageKeeper.IsSPSwappingOut(ctx, gvg, spOperatorId)) {
+				keeper.SaveFollowUpChallenge(ctx, types.FollowUpChallenge{
+					ObjectId:        objectInfo.Id,
+					RedundancyIndex: redundancyIndex,
+					SegmentIndexes:  segmentIndexes,
+				})
+				continue
+			}
+
+			sp, found := keeper.SpKeeper.GetStorageProvider(ctx, spOperatorId)
+			if !found {
+				continue
+			}
+			if sp.Status != sptypes.STATUS_IN_SERVICE && sp.Status != sptypes.STATUS_GRACEFUL_EXITING {
+				continue
+			}
+
+			mapKey := fmt.Sprintf("%d-%s", spOperatorId, objectInfo.Id.String())
+			if _, ok := objectMap[mapKey]; ok { // already generated for this pair
+				continue
+			}
+
+			// check recent slash
+			if keeper.ExistsSlash(ctx, sp.Id, objectInfo.Id) {
+				continue
+			}
+
+			objectMap[mapKey] = struct{}{}
+
+			challengeId := keeper.GetChallengeId(ctx) + 1
+			keeper.SaveChallenge(ctx, types.Challenge{
+				Id:                challengeId,
+				ExpiredHeight:     expiredHeight,
+				ObjectId:          objectInfo.Id,
+				SegmentIndex:      segmentIndex,
+				SpId:              sp.Id,
+				SpOperatorAddress: sp.OperatorAddress,
+				RedundancyIndex:   redundancyIndex,
+				SegmentIndexes:    segmentIndexes,
+			})
+			events = append(events, &types.EventStartChallenge{
+				ChallengeId:       challengeId,
+				ObjectId:          objectInfo.Id,
+				SegmentIndex:      segmentIndex,
+				SpId:              sp.Id,
+				SpOperatorAddress: sp.OperatorAddress,
+				RedundancyIndex:   redundancyIndex,
+				ChallengerAddress: "",
+				ExpiredHeight:     expiredHeight,
+				SegmentIndexes:    segmentIndexes,
+				ChallengedSpId:    sp.Id,
+			})
+
+			count++
+		}
 	}
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge"
 	"github.com/bnb-chain/greenfield/x/challenge/keeper"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
@@ -56,7 +58,7 @@ func (s *TestSuite) SetupTest() {
 		CMS: testCtx.CMS,
 	}
 
-	s.ctx = testCtx.Ctx
+	s.ctx = upgrade.WithUpgraded(testCtx.Ctx, gnfdtypes.Hulunbeier)
 
 	ctrl := gomock.NewController(s.T())
 
@@ -109,6 +111,9 @@ func (s *TestSuite) TestBeginBlocker_RemoveExpiredChallenge() {
 		ExpiredHeight: 300,
 	})
 
+	// the expired challenge is passed by the storage provider
+	s.spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Any(), true).Times(1)
+
 	s.ctx = s.ctx.WithBlockHeight(101)
 	challenge.BeginBlocker(s.ctx, *s.challengeKeeper)
 	s.Require().False(s.challengeKeeper.ExistsChallenge(s.ctx, 100))
@@ -212,6 +217,116 @@ func (s *TestSuite) TestEndBlocker_SuccessRandomChallenge() {
 	}}
 	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+
+	sp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
+		Return(sp, true).AnyTimes()
+
+	preChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
+	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	s.Require().True(preChallengeId == afterChallengeId-1)
+}
+
+func (s *TestSuite) TestEndBlocker_MultipleRedundancyChallenges() {
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengeCountPerBlock = 2
+	params.ChallengeRedundancyCount = 2
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	s.storageKeeper.EXPECT().GetObjectInfoCount(gomock.Any()).Return(sdk.NewUint(1))
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(1),
+		BucketName:   "bucketname",
+		ObjectName:   "objectname",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(existObject.Id)).
+		Return(existObject, true).AnyTimes()
+
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+		Id:         math.NewUint(10),
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 100, SecondarySpIds: []uint32{
+		1, 2, 3, 4, 5, 6,
+	}}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, id uint32) (*sptypes.StorageProvider, bool) {
+			return &sptypes.StorageProvider{Id: id, Status: sptypes.STATUS_IN_SERVICE}, true
+		}).AnyTimes()
+
+	// the only object is challenged on two distinct storage providers
+	preChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
+	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	s.Require().Equal(preChallengeId+2, afterChallengeId)
+
+	first, found := s.challengeKeeper.GetPendingChallenge(s.ctx, afterChallengeId-1)
+	s.Require().True(found)
+	second, found := s.challengeKeeper.GetPendingChallenge(s.ctx, afterChallengeId)
+	s.Require().True(found)
+	s.Require().Equal(existObject.Id, first.ObjectId)
+	s.Require().Equal(existObject.Id, second.ObjectId)
+	s.Require().NotEqual(first.SpId, second.SpId)
+	s.Require().NotEqual(first.RedundancyIndex, second.RedundancyIndex)
+}
+
+func (s *TestSuite) TestEndBlocker_SuccessWeightedChallenge() {
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.SelectionMode = types.CHALLENGE_SELECTION_MODE_WEIGHTED
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(64),
+		BucketName:   "bucketname",
+		ObjectName:   "objectname",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	sizeClass := storagetypes.SealedObjectSizeClass(existObject.PayloadSize)
+	counts := make([]uint64, storagetypes.SealedObjectSizeClasses)
+	counts[sizeClass] = 1
+	sizes := make([]math.Uint, storagetypes.SealedObjectSizeClasses)
+	for i := range sizes {
+		sizes[i] = math.ZeroUint()
+	}
+	sizes[sizeClass] = math.NewUint(existObject.PayloadSize)
+
+	s.storageKeeper.EXPECT().GetObjectInfoCount(gomock.Any()).Return(sdk.NewUint(100))
+	s.storageKeeper.EXPECT().GetSealedObjectSampleCounts(gomock.Any()).Return(counts)
+	s.storageKeeper.EXPECT().GetSealedObjectSampleSizes(gomock.Any()).Return(sizes)
+	s.storageKeeper.EXPECT().GetSealedObjectSample(gomock.Any(), gomock.Eq(sizeClass), gomock.Eq(uint64(0))).
+		Return(existObject.Id, true).AnyTimes()
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(existObject.Id)).
+		Return(existObject, true).AnyTimes()
+
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+		Id:         math.NewUint(10),
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 100, SecondarySpIds: []uint32{
+		1,
+	}}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
 
 	sp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE}
 	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
@@ -222,3 +337,155 @@ func (s *TestSuite) TestEndBlocker_SuccessRandomChallenge() {
 	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
 	s.Require().True(preChallengeId == afterChallengeId-1)
 }
+
+func (s *TestSuite) TestEndBlocker_SkipMigratingBucket() {
+	s.storageKeeper.EXPECT().GetObjectInfoCount(gomock.Any()).Return(sdk.NewUint(100))
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(64),
+		BucketName:   "bucketname",
+		ObjectName:   "objectname",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(existObject.Id)).
+		Return(existObject, true).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+
+	migratingBucket := &storagetypes.BucketInfo{
+		BucketName:   existObject.BucketName,
+		Id:           math.NewUint(10),
+		BucketStatus: storagetypes.BUCKET_STATUS_MIGRATING,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(migratingBucket.BucketName)).
+		Return(migratingBucket, true).AnyTimes()
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 1}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+
+	preChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
+	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	s.Require().Equal(preChallengeId, afterChallengeId)
+
+	// the challenge is deferred until the migration completes
+	followUp, found := s.challengeKeeper.GetFollowUpChallenge(s.ctx, existObject.Id, types.RedundancyIndexPrimary)
+	s.Require().True(found)
+	s.Require().Equal([]uint32{0}, followUp.SegmentIndexes)
+}
+
+func (s *TestSuite) TestEndBlocker_SkipSwappingOutSp() {
+	s.storageKeeper.EXPECT().GetObjectInfoCount(gomock.Any()).Return(sdk.NewUint(100))
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(64),
+		BucketName:   "bucketname",
+		ObjectName:   "objectname",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(existObject.Id)).
+		Return(existObject, true).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+		Id:         math.NewUint(10),
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+
+	// both the primary sp and the secondary sp are swapping out
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 100, SecondarySpIds: []uint32{
+		1,
+	}}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Eq(gvg), gomock.Any()).
+		Return(true).AnyTimes()
+
+	preChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
+	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	s.Require().Equal(preChallengeId, afterChallengeId)
+
+	// the challenges are deferred until the swap out completes
+	_, primaryFound := s.challengeKeeper.GetFollowUpChallenge(s.ctx, existObject.Id, types.RedundancyIndexPrimary)
+	_, secondaryFound := s.challengeKeeper.GetFollowUpChallenge(s.ctx, existObject.Id, 0)
+	s.Require().True(primaryFound || secondaryFound)
+}
+
+func (s *TestSuite) TestEndBlocker_FollowUpChallenge() {
+	s.storageKeeper.EXPECT().GetObjectInfoCount(gomock.Any()).Return(sdk.NewUint(0)).AnyTimes()
+
+	migratingBucket := &storagetypes.BucketInfo{
+		BucketName:   "migratingbucket",
+		Id:           math.NewUint(10),
+		BucketStatus: storagetypes.BUCKET_STATUS_MIGRATING,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(migratingBucket.BucketName)).
+		Return(migratingBucket, true).AnyTimes()
+	migratedBucket := &storagetypes.BucketInfo{
+		BucketName: "migratedbucket",
+		Id:         math.NewUint(11),
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(migratedBucket.BucketName)).
+		Return(migratedBucket, true).AnyTimes()
+
+	migratingObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(64),
+		BucketName:   migratingBucket.BucketName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(migratingObject.Id)).
+		Return(migratingObject, true).AnyTimes()
+	migratedObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(65),
+		BucketName:   migratedBucket.BucketName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(migratedObject.Id)).
+		Return(migratedObject, true).AnyTimes()
+
+	// the bucket is migrated to the new primary sp
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 2}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Eq(migratedBucket.Id), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+	sp := &sptypes.StorageProvider{Id: 2, OperatorAddress: "0x0000000000000000000000000000000000000002", Status: sptypes.STATUS_IN_SERVICE}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Eq(sp.Id)).
+		Return(sp, true).AnyTimes()
+
+	for _, objectId := range []math.Uint{migratingObject.Id, migratedObject.Id} {
+		s.challengeKeeper.SaveFollowUpChallenge(s.ctx, types.FollowUpChallenge{
+			ObjectId:        objectId,
+			RedundancyIndex: types.RedundancyIndexPrimary,
+			SegmentIndexes:  []uint32{0},
+		})
+	}
+
+	// the follow-up challenges are not issued before the upgrade
+	preChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	challenge.EndBlocker(upgrade.WithUpgraded(s.ctx), *s.challengeKeeper)
+	s.Require().Equal(preChallengeId, s.challengeKeeper.GetChallengeId(s.ctx))
+
+	challenge.EndBlocker(s.ctx, *s.challengeKeeper)
+	afterChallengeId := s.challengeKeeper.GetChallengeId(s.ctx)
+	s.Require().Equal(preChallengeId+1, afterChallengeId)
+
+	// the new primary sp is challenged for the migrated object
+	challenged, found := s.challengeKeeper.GetPendingChallenge(s.ctx, afterChallengeId)
+	s.Require().True(found)
+	s.Require().Equal(sp.Id, challenged.SpId)
+	s.Require().Equal(migratedObject.Id, challenged.ObjectId)
+	_, found = s.challengeKeeper.GetFollowUpChallenge(s.ctx, migratedObject.Id, types.RedundancyIndexPrimary)
+	s.Require().False(found)
+
+	// the object which is still being migrated is kept
+	_, found = s.challengeKeeper.GetFollowUpChallenge(s.ctx, migratingObject.Id, types.RedundancyIndexPrimary)
+	s.Require().True(found)
+}
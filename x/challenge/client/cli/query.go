@@ -26,6 +26,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdLatestAttestedChallenges())
 	cmd.AddCommand(CmdAttestedChallenge())
 	cmd.AddCommand(CmdInturnChallenger())
+	cmd.AddCommand(CmdPendingChallenges())
+	cmd.AddCommand(CmdChallengeHistoryBySp())
+	cmd.AddCommand(CmdChallengeHistoryByObject())
+	cmd.AddCommand(CmdSlashEscrow())
+	cmd.AddCommand(CmdSpSlashAmount())
 
 	return cmd
 }
@@ -145,3 +150,189 @@ func CmdInturnChallenger() *cobra.Command {
 
 	return cmd
 }
+
+func CmdPendingChallenges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-challenges [sp-id]",
+		Short: "Query the pending (not expired) challenges of a storage provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSpId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("sp-id %s not a valid uint32, please input a valid sp-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingChallenges(cmd.Context(), &types.QueryPendingChallengesRequest{
+				SpId:       uint32(argSpId),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdChallengeHistoryBySp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-history-by-sp [sp-id]",
+		Short: "Query the challenge history of a storage provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSpId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("sp-id %s not a valid uint32, please input a valid sp-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChallengeHistoryBySp(cmd.Context(), &types.QueryChallengeHistoryBySpRequest{
+				SpId:       uint32(argSpId),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdChallengeHistoryByObject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-history-by-object [object-id]",
+		Short: "Query the challenge history of an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChallengeHistoryByObject(cmd.Context(), &types.QueryChallengeHistoryByObjectRequest{
+				ObjectId:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdSlashEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-escrow [challenge-id]",
+		Short: "Query the escrowed slash of a challenge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChallengeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("challenge-id %s not a valid uint, please input a valid challenge-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashEscrow(cmd.Context(), &types.QuerySlashEscrowRequest{
+				ChallengeId: argChallengeId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSpSlashAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sp-slash-amount [sp-id]",
+		Short: "Query the slashed amount of a storage provider in the current window and the remaining cap",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSpId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("sp-id %s not a valid uint32, please input a valid sp-id", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SpSlashAmount(cmd.Context(), &types.QuerySpSlashAmountRequest{
+				SpId: uint32(argSpId),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
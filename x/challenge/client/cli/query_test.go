@@ -53,6 +53,61 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryInturnAttestationSubmitterResponse{},
 		},
+		{
+			"query pending-challenges",
+			append(
+				[]string{
+					"pending-challenges",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryPendingChallengesResponse{},
+		},
+		{
+			"query challenge-history-by-sp",
+			append(
+				[]string{
+					"challenge-history-by-sp",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryChallengeHistoryResponse{},
+		},
+		{
+			"query challenge-history-by-object",
+			append(
+				[]string{
+					"challenge-history-by-object",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryChallengeHistoryResponse{},
+		},
+		{
+			"query slash-escrow",
+			append(
+				[]string{
+					"slash-escrow",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QuerySlashEscrowResponse{},
+		},
+		{
+			"query sp-slash-amount",
+			append(
+				[]string{
+					"sp-slash-amount",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QuerySpSlashAmountResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
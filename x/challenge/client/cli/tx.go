@@ -31,6 +31,8 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdSubmit())
 	cmd.AddCommand(CmdAttest())
+	cmd.AddCommand(CmdAppealSlash())
+	cmd.AddCommand(CmdAttestAppeal())
 
 	return cmd
 }
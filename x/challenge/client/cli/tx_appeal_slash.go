@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func CmdAppealSlash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "appeal-slash [challenge-id] [segment-proofs] [piece-hashes] [bls-signature]",
+		Short: "Broadcast message appeal-slash",
+		Long:  "Broadcast message appeal-slash, the segment proofs are the comma separated hex encoded failed segments/pieces in the order of the failed segment indexes of the slash, and the piece hashes are the comma separated hex encoded hashes of all the segments/pieces stored by the storage provider for the object",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChallengeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("challenge-id %s not a valid uint, please input a valid challenge-id", args[0])
+			}
+
+			argSegmentProofs := make([][]byte, 0)
+			for _, proof := range strings.Split(args[1], ",") {
+				segmentProof, err := hex.DecodeString(proof)
+				if err != nil {
+					return fmt.Errorf("segment proof %s not a hex encoded bytes, please input valid segment-proofs", proof)
+				}
+				argSegmentProofs = append(argSegmentProofs, segmentProof)
+			}
+
+			argPieceHashes := make([][]byte, 0)
+			for _, hash := range strings.Split(args[2], ",") {
+				pieceHash, err := hex.DecodeString(hash)
+				if err != nil {
+					return fmt.Errorf("piece hash %s not a hex encoded bytes, please input valid piece-hashes", hash)
+				}
+				argPieceHashes = append(argPieceHashes, pieceHash)
+			}
+
+			argBlsSignature, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("bls-signature %s not a hex encoded bytes, please input a valid bls-signature", args[3])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAppealSlash(
+				clientCtx.GetFromAddress(),
+				argChallengeId,
+				argSegmentProofs,
+				argPieceHashes,
+				argBlsSignature,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const flagFailedSegmentIndexes = "failed-segment-indexes"
+
 func CmdAttest() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "attest [challenge-id] [object-id] [sp-operator-address] [vote-result] [challenger-address] [vote-validator-set] [vote-agg-signature]",
@@ -69,6 +71,18 @@ func CmdAttest() *cobra.Command {
 				return fmt.Errorf("vote-agg-signature %s not a hex encoded bytes, please input a valid vote-agg-signature", args[6])
 			}
 
+			argFailedSegmentIndexes := make([]uint32, 0)
+			failedSegmentIndexes, _ := cmd.Flags().GetString(flagFailedSegmentIndexes)
+			if failedSegmentIndexes != "" {
+				for _, split := range strings.Split(failedSegmentIndexes, ",") {
+					index, err := strconv.ParseUint(split, 10, 32)
+					if err != nil {
+						return fmt.Errorf("failed-segment-indexes %s not a valid comma seperated uint array, please input a valid failed-segment-indexes", failedSegmentIndexes)
+					}
+					argFailedSegmentIndexes = append(argFailedSegmentIndexes, uint32(index))
+				}
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -84,6 +98,7 @@ func CmdAttest() *cobra.Command {
 				argVoteValidatorSet,
 				argVoteAggSignature,
 			)
+			msg.FailedSegmentIndexes = argFailedSegmentIndexes
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -91,6 +106,7 @@ func CmdAttest() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagFailedSegmentIndexes, "", "The comma separated segment/piece indexes failed the challenge")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func CmdAttestAppeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-appeal [challenge-id] [upheld] [vote-validator-set] [vote-agg-signature]",
+		Short: "Broadcast message attest-appeal",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChallengeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("challenge-id %s not a valid uint, please input a valid challenge-id", args[0])
+			}
+
+			argUpheld, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("upheld %s not a valid bool, please input a valid upheld", args[1])
+			}
+
+			argVoteValidatorSet := make([]uint64, 0)
+			splits := strings.Split(args[2], ",")
+			for _, split := range splits {
+				val, err := strconv.ParseUint(split, 10, 64)
+				if err != nil {
+					return fmt.Errorf("vote-validator-set %s not a valid comma seperated uint array, please input a valid vote-validator-set", args[2])
+				}
+				argVoteValidatorSet = append(argVoteValidatorSet, val)
+			}
+			if len(argVoteValidatorSet) == 0 {
+				return fmt.Errorf("vote-validator-set %s not a valid comma seperated uint array, please input a valid vote-validator-set", args[2])
+			}
+
+			argVoteAggSignature, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("vote-agg-signature %s not a hex encoded bytes, please input a valid vote-agg-signature", args[3])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestAppeal(
+				clientCtx.GetFromAddress(),
+				argChallengeId,
+				argUpheld,
+				argVoteValidatorSet,
+				argVoteAggSignature,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
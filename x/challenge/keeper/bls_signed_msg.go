@@ -61,3 +61,23 @@ func (k Keeper) verifySignature(ctx sdk.Context, signedMsg BlsSignedMsg, validat
 
 	return signedChallengers, nil
 }
+
+// verifyAppealSignature verifies whether the bls signature of the storage provider over the appeal is valid or not.
+func (k Keeper) verifyAppealSignature(ctx sdk.Context, msg *types.MsgAppealSlash, blsKey []byte) error {
+	pubKey, err := bls.PublicKeyFromBytes(blsKey)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidBlsPubKey, fmt.Sprintf("BLS public key converts failed: %v", err))
+	}
+
+	signature, err := bls.SignatureFromBytes(msg.BlsSignature)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidAppealSignature, fmt.Sprintf("BLS signature converts failed: %v", err))
+	}
+
+	signBytes := msg.GetBlsSignBytes(ctx.ChainID())
+	if !signature.Verify(pubKey, signBytes[:]) {
+		return errors.Wrap(types.ErrInvalidAppealSignature, "Signature verify failed")
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
 )
 
@@ -30,7 +31,8 @@ func (k Keeper) setChallengeId(ctx sdk.Context, challengeId uint64) {
 	store.Set(types.ChallengeIdKey, bz)
 }
 
-// SaveChallenge set a specific challenge in the store
+// SaveChallenge set a specific challenge in the store, the details of the challenge are kept until it is expired
+// since the Hulunbeier upgrade.
 func (k Keeper) SaveChallenge(ctx sdk.Context, challenge types.Challenge) {
 	k.setChallengeId(ctx, challenge.Id)
 
@@ -40,19 +42,89 @@ func (k Keeper) SaveChallenge(ctx sdk.Context, challenge types.Challenge) {
 	binary.BigEndian.PutUint64(heightBytes, challenge.ExpiredHeight)
 
 	store.Set(getChallengeKeyBytes(challenge.Id), heightBytes)
+
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	pendingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingChallengeKeyPrefix)
+	pendingStore.Set(getChallengeKeyBytes(challenge.Id), k.cdc.MustMarshal(&challenge))
+	spStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSpPendingChallengePrefix(challenge.SpId))
+	spStore.Set(getChallengeKeyBytes(challenge.Id), []byte{})
+}
+
+// GetPendingChallenge returns the details of a challenge which is not expired yet
+func (k Keeper) GetPendingChallenge(ctx sdk.Context, challengeId uint64) (types.Challenge, bool) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return types.Challenge{}, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingChallengeKeyPrefix)
+	bz := store.Get(getChallengeKeyBytes(challengeId))
+	if bz == nil {
+		return types.Challenge{}, false
+	}
+	var challenge types.Challenge
+	k.cdc.MustUnmarshal(bz, &challenge)
+	return challenge, true
+}
+
+// removePendingChallenge removes the details of an expired challenge, and records it as passed for the challenged
+// storage provider unless the storage provider is slashed for the object.
+func (k Keeper) removePendingChallenge(ctx sdk.Context, challengeId uint64) {
+	challenge, found := k.GetPendingChallenge(ctx, challengeId)
+	if !found {
+		return
+	}
+	if !k.ExistsSlash(ctx, challenge.SpId, challenge.ObjectId) {
+		k.SpKeeper.RecordChallengeResult(ctx, challenge.SpId, true)
+	}
+	k.deletePendingChallenge(ctx, challenge)
+}
+
+// deletePendingChallenge deletes the details of a challenge.
+func (k Keeper) deletePendingChallenge(ctx sdk.Context, challenge types.Challenge) {
+	pendingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingChallengeKeyPrefix)
+	pendingStore.Delete(getChallengeKeyBytes(challenge.Id))
+	spStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSpPendingChallengePrefix(challenge.SpId))
+	spStore.Delete(getChallengeKeyBytes(challenge.Id))
+}
+
+// ExpireChallenge removes a challenge before its expired height without recording any result for the storage
+// provider, e.g., the challenged object is being moved. The bond of the challenge is fully refunded.
+func (k Keeper) ExpireChallenge(ctx sdk.Context, challengeId uint64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
+	store.Delete(getChallengeKeyBytes(challengeId))
+
+	if challenge, found := k.GetPendingChallenge(ctx, challengeId); found {
+		k.deletePendingChallenge(ctx, challenge)
+	}
+	return k.SettleChallengeBond(ctx, challengeId, true)
 }
 
-// RemoveChallengeUntil removes challenges which are expired
+// RemoveChallengeUntil removes challenges which are expired, the bonds of the expired challenges which are not
+// attested as succeed are settled as failed.
 func (k Keeper) RemoveChallengeUntil(ctx sdk.Context, height uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeKeyPrefix)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 
+	expiredIds := make([]uint64, 0)
 	for ; iterator.Valid(); iterator.Next() {
 		expiredHeight := binary.BigEndian.Uint64(iterator.Value())
 		if expiredHeight <= height {
 			store.Delete(iterator.Key())
+			expiredIds = append(expiredIds, binary.BigEndian.Uint64(iterator.Key()))
+		}
+	}
+
+	for _, challengeId := range expiredIds {
+		k.removePendingChallenge(ctx, challengeId)
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SettleChallengeBond(cacheCtx, challengeId, false); err != nil {
+			ctx.Logger().Error("fail to settle challenge bond", "challenge id", challengeId, "err", err.Error())
+			continue
 		}
+		write()
 	}
 }
 
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// SaveChallengeRecord saves the attestation of a challenge into the challenge history,
+// and indexes it by storage provider, object info and height.
+func (k Keeper) SaveChallengeRecord(ctx sdk.Context, record types.ChallengeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	idBz := getChallengeKeyBytes(record.ChallengeId)
+
+	historyStore := prefix.NewStore(store, types.ChallengeHistoryKeyPrefix)
+	historyStore.Set(idBz, k.cdc.MustMarshal(&record))
+
+	prefix.NewStore(store, types.GetSpChallengeHistoryPrefix(record.SpId)).Set(idBz, []byte{})
+	prefix.NewStore(store, types.GetObjectChallengeHistoryPrefix(record.ObjectId)).Set(idBz, []byte{})
+	prefix.NewStore(store, types.GetChallengeHistoryHeightPrefix(record.Height)).Set(idBz, []byte{})
+}
+
+// GetChallengeRecord returns the challenge record by challenge id
+func (k Keeper) GetChallengeRecord(ctx sdk.Context, challengeId uint64) (types.ChallengeRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeHistoryKeyPrefix)
+	bz := store.Get(getChallengeKeyBytes(challengeId))
+	if bz == nil {
+		return types.ChallengeRecord{}, false
+	}
+	var record types.ChallengeRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// PruneChallengeHistoryUntil removes the challenge records which are attested at or before the height
+func (k Keeper) PruneChallengeHistoryUntil(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	heightStore := prefix.NewStore(store, types.ChallengeHistoryHeightKeyPrefix)
+	iterator := heightStore.Iterator(nil, k.encodeUint64(height+1))
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	historyStore := prefix.NewStore(store, types.ChallengeHistoryKeyPrefix)
+	for _, key := range keys {
+		heightStore.Delete(key)
+
+		idBz := key[8:]
+		bz := historyStore.Get(idBz)
+		if bz == nil {
+			continue
+		}
+		var record types.ChallengeRecord
+		k.cdc.MustUnmarshal(bz, &record)
+
+		historyStore.Delete(idBz)
+		prefix.NewStore(store, types.GetSpChallengeHistoryPrefix(record.SpId)).Delete(idBz)
+		prefix.NewStore(store, types.GetObjectChallengeHistoryPrefix(record.ObjectId)).Delete(idBz)
+	}
+}
+
+// parseChallengeHistoryKey returns the challenge id of a key in the challenge history indexes
+func parseChallengeHistoryKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// GetChallengerSubmitCount returns the number of challenges submitted by the challenger in the current rate limit window
+func (k Keeper) GetChallengerSubmitCount(ctx sdk.Context, challenger sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengerSubmitCountKeyPrefix)
+	bz := store.Get(challenger.Bytes())
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetChallengerSubmitCount(ctx sdk.Context, challenger sdk.AccAddress, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengerSubmitCountKeyPrefix)
+	store.Set(challenger.Bytes(), k.encodeUint64(count))
+}
+
+// ClearChallengerSubmitCount resets the submit counts of all challengers when a new rate limit window starts
+func (k Keeper) ClearChallengerSubmitCount(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengerSubmitCountKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+// GetChallengeBond returns the bond locked for a user submitted challenge
+func (k Keeper) GetChallengeBond(ctx sdk.Context, challengeId uint64) (types.ChallengeBond, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeBondKeyPrefix)
+	bz := store.Get(getChallengeKeyBytes(challengeId))
+	if bz == nil {
+		return types.ChallengeBond{}, false
+	}
+	var bond types.ChallengeBond
+	k.cdc.MustUnmarshal(bz, &bond)
+	return bond, true
+}
+
+// LockChallengeBond transfers the challenger bond to the challenge module account, it is a no-op if the bond is zero.
+func (k Keeper) LockChallengeBond(ctx sdk.Context, challengeId uint64, challenger sdk.AccAddress) error {
+	amount := k.GetParams(ctx).ChallengerBond
+	if amount.IsNil() || !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.SpKeeper.DepositDenomForSP(ctx), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, challenger, types.ModuleName, coins); err != nil {
+		return errors.Wrapf(types.ErrInsufficientBond, "fail to lock challenger bond: %s", err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeBondKeyPrefix)
+	store.Set(getChallengeKeyBytes(challengeId), k.cdc.MustMarshal(&types.ChallengeBond{
+		Challenger: challenger.String(),
+		Amount:     amount,
+	}))
+	return nil
+}
+
+// SettleChallengeBond returns the bond of a user submitted challenge to the challenger if the challenge succeeds,
+// otherwise part of the bond is burned by ChallengerBondBurnRatio. It is a no-op if there is no bond for the challenge.
+func (k Keeper) SettleChallengeBond(ctx sdk.Context, challengeId uint64, succeed bool) error {
+	bond, found := k.GetChallengeBond(ctx, challengeId)
+	if !found {
+		return nil
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChallengeBondKeyPrefix)
+	store.Delete(getChallengeKeyBytes(challengeId))
+
+	burnAmount := sdkmath.ZeroInt()
+	if !succeed {
+		burnRatio := k.GetParams(ctx).ChallengerBondBurnRatio
+		if !burnRatio.IsNil() {
+			burnAmount = burnRatio.MulInt(bond.Amount).TruncateInt()
+		}
+	}
+	refundAmount := bond.Amount.Sub(burnAmount)
+
+	denom := k.SpKeeper.DepositDenomForSP(ctx)
+	if burnAmount.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, burnAmount))); err != nil {
+			return err
+		}
+	}
+	if refundAmount.IsPositive() {
+		challenger := sdk.MustAccAddressFromHex(bond.Challenger)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger,
+			sdk.NewCoins(sdk.NewCoin(denom, refundAmount))); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSettleChallengeBond{
+		ChallengeId:       challengeId,
+		ChallengerAddress: bond.Challenger,
+		Succeed:           succeed,
+		RefundAmount:      refundAmount.String(),
+		BurnAmount:        burnAmount.String(),
+	})
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+func (s *TestSuite) TestSettleChallengeBond() {
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengerBond = sdk.NewInt(1000)
+	params.ChallengerBondBurnRatio = sdk.NewDecWithPrec(3, 1)
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	challenger := sample.RandAccAddress()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Eq(challenger), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(1000))))).Return(nil).Times(3)
+	for id := uint64(1); id <= 3; id++ {
+		s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{Id: id, ExpiredHeight: 100})
+		s.Require().NoError(s.challengeKeeper.LockChallengeBond(s.ctx, id, challenger))
+	}
+
+	// the bond is fully refunded for a succeed challenge
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(challenger),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(1000))))).Return(nil)
+	s.Require().NoError(s.challengeKeeper.SettleChallengeBond(s.ctx, 1, true))
+	_, found := s.challengeKeeper.GetChallengeBond(s.ctx, 1)
+	s.Require().False(found)
+
+	// settling again is a no-op
+	s.Require().NoError(s.challengeKeeper.SettleChallengeBond(s.ctx, 1, false))
+
+	// the bond is partly burned for a failed challenge
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(300))))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(challenger),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(700))))).Return(nil)
+	s.Require().NoError(s.challengeKeeper.SettleChallengeBond(s.ctx, 2, false))
+
+	// the bond of an expired challenge is settled as failed
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(300))))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(challenger),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(700))))).Return(nil)
+	// the expired challenges are passed by the storage provider
+	s.spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Any(), true).Times(3)
+	s.challengeKeeper.RemoveChallengeUntil(s.ctx, 100)
+	_, found = s.challengeKeeper.GetChallengeBond(s.ctx, 3)
+	s.Require().False(found)
+	s.Require().False(s.challengeKeeper.ExistsChallenge(s.ctx, 3))
+}
+
+func (s *TestSuite) TestExpireChallenge() {
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengerBond = sdk.NewInt(1000)
+	params.ChallengerBondBurnRatio = sdk.NewDecWithPrec(3, 1)
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	challenger := sample.RandAccAddress()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Eq(challenger), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(1000))))).Return(nil)
+	s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{Id: 1, ExpiredHeight: 100, SpId: 10, ObjectId: sdk.NewUint(1)})
+	s.Require().NoError(s.challengeKeeper.LockChallengeBond(s.ctx, 1, challenger))
+
+	// the bond is fully refunded, and no result is recorded for the storage provider
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(challenger),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(1000))))).Return(nil)
+	s.spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.Require().NoError(s.challengeKeeper.ExpireChallenge(s.ctx, 1))
+	s.Require().False(s.challengeKeeper.ExistsChallenge(s.ctx, 1))
+	_, found := s.challengeKeeper.GetPendingChallenge(s.ctx, 1)
+	s.Require().False(found)
+	_, found = s.challengeKeeper.GetChallengeBond(s.ctx, 1)
+	s.Require().False(found)
+
+	res, err := s.queryClient.PendingChallenges(s.ctx, &types.QueryPendingChallengesRequest{SpId: 10})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Challenges)
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// SaveFollowUpChallenge records a challenge of an object which is being moved, the storage provider which takes over
+// the object will be challenged once the move completes.
+func (k Keeper) SaveFollowUpChallenge(ctx sdk.Context, followUp types.FollowUpChallenge) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFollowUpChallengeKey(followUp.ObjectId, followUp.RedundancyIndex), k.cdc.MustMarshal(&followUp))
+}
+
+// GetFollowUpChallenge returns the follow-up challenge of an object info at the redundancy index
+func (k Keeper) GetFollowUpChallenge(ctx sdk.Context, objectId sdkmath.Uint, redundancyIndex int32) (types.FollowUpChallenge, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFollowUpChallengeKey(objectId, redundancyIndex))
+	if bz == nil {
+		return types.FollowUpChallenge{}, false
+	}
+	var followUp types.FollowUpChallenge
+	k.cdc.MustUnmarshal(bz, &followUp)
+	return followUp, true
+}
+
+// IssueFollowUpChallenges issues at most limit challenges for the recorded follow-up challenges whose objects are not
+// being moved anymore. The follow-up challenges of deleted objects, or of storage providers which cannot be challenged,
+// are dropped, while the ones whose objects are still being moved are kept.
+func (k Keeper) IssueFollowUpChallenges(ctx sdk.Context, expiredHeight, limit uint64) []*types.EventStartChallenge {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.FollowUpChallengeKeyPrefix)
+
+	// to prevent scanning too many follow-up challenges whose objects are still being moved
+	followUps := make([]types.FollowUpChallenge, 0)
+	for ; iterator.Valid() && uint64(len(followUps)) < 10*limit; iterator.Next() {
+		var followUp types.FollowUpChallenge
+		k.cdc.MustUnmarshal(iterator.Value(), &followUp)
+		followUps = append(followUps, followUp)
+	}
+	iterator.Close()
+
+	events := make([]*types.EventStartChallenge, 0)
+	for _, followUp := range followUps {
+		if uint64(len(events)) >= limit {
+			break
+		}
+		sp, moving, ok := k.followUpChallengedSp(ctx, followUp)
+		if moving {
+			continue
+		}
+		store.Delete(types.GetFollowUpChallengeKey(followUp.ObjectId, followUp.RedundancyIndex))
+		if !ok {
+			continue
+		}
+
+		challengeId := k.GetChallengeId(ctx) + 1
+		k.SaveChallenge(ctx, types.Challenge{
+			Id:                challengeId,
+			ExpiredHeight:     expiredHeight,
+			ObjectId:          followUp.ObjectId,
+			SegmentIndex:      followUp.SegmentIndexes[0],
+			SpId:              sp.Id,
+			SpOperatorAddress: sp.OperatorAddress,
+			RedundancyIndex:   followUp.RedundancyIndex,
+			SegmentIndexes:    followUp.SegmentIndexes,
+		})
+		events = append(events, &types.EventStartChallenge{
+			ChallengeId:       challengeId,
+			ObjectId:          followUp.ObjectId,
+			SegmentIndex:      followUp.SegmentIndexes[0],
+			SpId:              sp.Id,
+			SpOperatorAddress: sp.OperatorAddress,
+			RedundancyIndex:   followUp.RedundancyIndex,
+			ChallengerAddress: "",
+			ExpiredHeight:     expiredHeight,
+			SegmentIndexes:    followUp.SegmentIndexes,
+			ChallengedSpId:    sp.Id,
+		})
+	}
+	return events
+}
+
+// followUpChallengedSp returns the storage provider which currently stores the object at the redundancy index of the
+// follow-up challenge. It reports whether the object is still being moved, or whether the storage provider can be
+// challenged.
+func (k Keeper) followUpChallengedSp(ctx sdk.Context, followUp types.FollowUpChallenge) (sp *sptypes.StorageProvider, moving bool, ok bool) {
+	if len(followUp.SegmentIndexes) == 0 {
+		return nil, false, false
+	}
+	objectInfo, found := k.StorageKeeper.GetObjectInfoById(ctx, followUp.ObjectId)
+	if !found || objectInfo.ObjectStatus != storagetypes.OBJECT_STATUS_SEALED {
+		return nil, false, false
+	}
+	bucket, found := k.StorageKeeper.GetBucketInfo(ctx, objectInfo.BucketName)
+	if !found {
+		return nil, false, false
+	}
+	if bucket.BucketStatus == storagetypes.BUCKET_STATUS_MIGRATING {
+		return nil, true, false
+	}
+	gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucket.Id, objectInfo.LocalVirtualGroupId)
+	if !found {
+		return nil, false, false
+	}
+
+	var spId uint32
+	if followUp.RedundancyIndex == types.RedundancyIndexPrimary {
+		spId = gvg.PrimarySpId
+	} else if followUp.RedundancyIndex >= 0 && int(followUp.RedundancyIndex) < len(gvg.SecondarySpIds) {
+		spId = gvg.SecondarySpIds[followUp.RedundancyIndex]
+	} else {
+		return nil, false, false
+	}
+	if k.StorageKeeper.IsSPSwappingOut(ctx, gvg, spId) {
+		return nil, true, false
+	}
+
+	sp, found = k.SpKeeper.GetStorageProvider(ctx, spId)
+	if !found || (sp.Status != sptypes.STATUS_IN_SERVICE && sp.Status != sptypes.STATUS_GRACEFUL_EXITING) {
+		return nil, false, false
+	}
+	if k.ExistsSlash(ctx, sp.Id, objectInfo.Id) {
+		return nil, false, false
+	}
+	return sp, false, true
+}
@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -79,3 +83,102 @@ func (k Keeper) InturnAttestationSubmitter(goCtx context.Context, req *types.Que
 	}, nil
 
 }
+
+func (k Keeper) PendingChallenges(goCtx context.Context, req *types.QueryPendingChallengesRequest) (*types.QueryPendingChallengesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenges := make([]types.Challenge, 0)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSpPendingChallengePrefix(req.SpId))
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		challenge, found := k.GetPendingChallenge(ctx, binary.BigEndian.Uint64(key))
+		if found {
+			challenges = append(challenges, challenge)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingChallengesResponse{Challenges: challenges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChallengeHistoryBySp(goCtx context.Context, req *types.QueryChallengeHistoryBySpRequest) (*types.QueryChallengeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return k.queryChallengeHistory(ctx, types.GetSpChallengeHistoryPrefix(req.SpId), req.Pagination)
+}
+
+func (k Keeper) ChallengeHistoryByObject(goCtx context.Context, req *types.QueryChallengeHistoryByObjectRequest) (*types.QueryChallengeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	objectId, err := sdkmath.ParseUint(req.ObjectId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid object id")
+	}
+
+	return k.queryChallengeHistory(ctx, types.GetObjectChallengeHistoryPrefix(objectId), req.Pagination)
+}
+
+func (k Keeper) queryChallengeHistory(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) (*types.QueryChallengeHistoryResponse, error) {
+	records := make([]types.ChallengeRecord, 0)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		record, found := k.GetChallengeRecord(ctx, parseChallengeHistoryKey(key))
+		if found {
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChallengeHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SlashEscrow(goCtx context.Context, req *types.QuerySlashEscrowRequest) (*types.QuerySlashEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, found := k.GetSlashEscrow(ctx, req.ChallengeId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "slash escrow not found")
+	}
+
+	return &types.QuerySlashEscrowResponse{Escrow: escrow}, nil
+}
+
+func (k Keeper) SpSlashAmount(goCtx context.Context, req *types.QuerySpSlashAmountRequest) (*types.QuerySpSlashAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.SpKeeper.GetStorageProvider(ctx, req.SpId); !found {
+		return nil, status.Error(codes.NotFound, "storage provider not found")
+	}
+
+	return &types.QuerySpSlashAmountResponse{
+		SlashAmount:     k.GetSpSlashAmount(ctx, req.SpId),
+		MaxSlashAmount:  k.GetParams(ctx).SpSlashMaxAmount,
+		RemainingAmount: k.GetSpSlashExposure(ctx, req.SpId),
+		WindowEndHeight: k.GetSpSlashWindowEndHeight(ctx),
+	}, nil
+}
@@ -4,18 +4,23 @@ import (
 	"encoding/hex"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge/keeper"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 )
 
 func TestParamsQuery(t *testing.T) {
@@ -104,3 +109,142 @@ func TestInturnAttestationSubmitterQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, hex.EncodeToString(blsKey), response.BlsPubKey)
 }
+
+func TestChallengeHistoryQuery(t *testing.T) {
+	keeper, ctx := makeKeeper(t)
+	err := keeper.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, err)
+
+	records := []types.ChallengeRecord{
+		{ChallengeId: 1, SpId: 1, ObjectId: sdkmath.NewUint(10), Result: types.CHALLENGE_SUCCEED, SlashAmount: sdkmath.NewInt(100), Height: 10},
+		{ChallengeId: 2, SpId: 2, ObjectId: sdkmath.NewUint(10), Result: types.CHALLENGE_FAILED, SlashAmount: sdkmath.ZeroInt(), Height: 20},
+		{ChallengeId: 3, SpId: 1, ObjectId: sdkmath.NewUint(20), Result: types.CHALLENGE_SUCCEED, SlashAmount: sdkmath.NewInt(200), Height: 30},
+	}
+	for _, record := range records {
+		keeper.SaveChallengeRecord(ctx, record)
+	}
+
+	response, err := keeper.ChallengeHistoryBySp(ctx, &types.QueryChallengeHistoryBySpRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChallengeRecord{records[0], records[2]}, response.Records)
+
+	response, err = keeper.ChallengeHistoryBySp(ctx, &types.QueryChallengeHistoryBySpRequest{
+		SpId:       1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChallengeRecord{records[0]}, response.Records)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+
+	response, err = keeper.ChallengeHistoryByObject(ctx, &types.QueryChallengeHistoryByObjectRequest{ObjectId: "10"})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChallengeRecord{records[0], records[1]}, response.Records)
+
+	_, err = keeper.ChallengeHistoryByObject(ctx, &types.QueryChallengeHistoryByObjectRequest{ObjectId: "invalid"})
+	require.Error(t, err)
+
+	// prune the records attested at or before height 20
+	keeper.PruneChallengeHistoryUntil(ctx, 20)
+	response, err = keeper.ChallengeHistoryBySp(ctx, &types.QueryChallengeHistoryBySpRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChallengeRecord{records[2]}, response.Records)
+	response, err = keeper.ChallengeHistoryByObject(ctx, &types.QueryChallengeHistoryByObjectRequest{ObjectId: "10"})
+	require.NoError(t, err)
+	require.Empty(t, response.Records)
+	_, found := keeper.GetChallengeRecord(ctx, 2)
+	require.False(t, found)
+}
+
+func TestPendingChallengesQuery(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := upgrade.WithUpgraded(testCtx.Ctx, gnfdtypes.Hulunbeier)
+
+	ctrl := gomock.NewController(t)
+	spKeeper := types.NewMockSpKeeper(ctrl)
+	// the expired challenge is passed by the storage provider
+	spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Eq(uint32(1)), true).Times(1)
+
+	keeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		key,
+		&types.MockBankKeeper{},
+		&types.MockStorageKeeper{},
+		spKeeper,
+		&types.MockStakingKeeper{},
+		&types.MockPaymentKeeper{},
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+	)
+	err := keeper.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, err)
+
+	challenges := []types.Challenge{
+		{Id: 1, ExpiredHeight: 10, ObjectId: sdkmath.NewUint(10), SegmentIndex: 1, SpId: 1, RedundancyIndex: types.RedundancyIndexPrimary},
+		{Id: 2, ExpiredHeight: 20, ObjectId: sdkmath.NewUint(10), SegmentIndex: 2, SpId: 2, RedundancyIndex: 0},
+		{Id: 3, ExpiredHeight: 30, ObjectId: sdkmath.NewUint(20), SegmentIndex: 3, SpId: 1, RedundancyIndex: 1},
+	}
+	for _, challenge := range challenges {
+		keeper.SaveChallenge(ctx, challenge)
+	}
+
+	response, err := keeper.PendingChallenges(ctx, &types.QueryPendingChallengesRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.Challenge{challenges[0], challenges[2]}, response.Challenges)
+
+	response, err = keeper.PendingChallenges(ctx, &types.QueryPendingChallengesRequest{
+		SpId:       1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Challenge{challenges[0]}, response.Challenges)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+
+	// expired challenges are removed
+	keeper.RemoveChallengeUntil(ctx, 10)
+	response, err = keeper.PendingChallenges(ctx, &types.QueryPendingChallengesRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.Challenge{challenges[2]}, response.Challenges)
+	_, found := keeper.GetPendingChallenge(ctx, 1)
+	require.False(t, found)
+}
+
+func TestSpSlashAmountQuery(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeight(150)
+
+	ctrl := gomock.NewController(t)
+	spKeeper := types.NewMockSpKeeper(ctrl)
+	spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Eq(uint32(1))).Return(&sptypes.StorageProvider{Id: 1}, true).AnyTimes()
+	spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+
+	keeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		key,
+		&types.MockBankKeeper{},
+		&types.MockStorageKeeper{},
+		spKeeper,
+		&types.MockStakingKeeper{},
+		&types.MockPaymentKeeper{},
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+	)
+	params := types.DefaultParams()
+	params.SpSlashMaxAmount = sdkmath.NewInt(1000)
+	params.SpSlashCountingWindow = 100
+	require.NoError(t, keeper.SetParams(ctx, params))
+	keeper.SetSpSlashAmount(ctx, 1, sdkmath.NewInt(300))
+
+	response, err := keeper.SpSlashAmount(ctx, &types.QuerySpSlashAmountRequest{SpId: 1})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(300), response.SlashAmount)
+	require.Equal(t, sdkmath.NewInt(1000), response.MaxSlashAmount)
+	require.Equal(t, sdkmath.NewInt(700), response.RemainingAmount)
+	require.Equal(t, uint64(200), response.WindowEndHeight)
+
+	_, err = keeper.SpSlashAmount(ctx, &types.QuerySpSlashAmountRequest{SpId: 2})
+	require.Error(t, err)
+}
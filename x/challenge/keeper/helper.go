@@ -6,6 +6,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
 )
 
 // RandaoMixLength is the length of randao mix in Tendermint header
@@ -59,6 +61,40 @@ func RandomSegmentIndex(seed []byte, segments uint64) uint32 {
 	return uint32(index.Uint64())
 }
 
+// ChallengeSegmentCount returns the number of segments/pieces sampled in each challenge, which is at least one.
+func ChallengeSegmentCount(params types.Params) uint64 {
+	if params.ChallengeSegmentCount == 0 {
+		return 1
+	}
+	return params.ChallengeSegmentCount
+}
+
+// RandomSegmentIndexes generates at most count distinct random segment indexes for challenge.
+// The first index is the same as RandomSegmentIndex, and the others are generated with distinct seeds derived from the seed.
+func RandomSegmentIndexes(seed []byte, segments, count uint64) []uint32 {
+	return SampleSegmentIndexes(seed, segments, count, RandomSegmentIndex(seed, segments))
+}
+
+// SampleSegmentIndexes generates at most count distinct segment indexes for challenge, starting with the given first index.
+// The others are generated with distinct seeds derived from the seed.
+func SampleSegmentIndexes(seed []byte, segments, count uint64, first uint32) []uint32 {
+	if count > segments {
+		count = segments
+	}
+
+	indexes := []uint32{first}
+	picked := map[uint32]struct{}{first: {}}
+	for i := uint64(1); uint64(len(indexes)) < count && i < 10*count; i++ { // to prevent endless loop
+		index := RandomSegmentIndex(SeedFromRandaoMix(seed, i), segments)
+		if _, ok := picked[index]; ok {
+			continue
+		}
+		picked[index] = struct{}{}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
 // RandomRedundancyIndex generates a random redundancy index (storage provider) for challenge.
 // Be noted: RedundancyIndex starts from -1 (the primary sp).
 func RandomRedundancyIndex(seed []byte, sps uint64) int32 {
@@ -67,3 +103,31 @@ func RandomRedundancyIndex(seed []byte, sps uint64) int32 {
 	index := new(big.Int).Mod(number, big.NewInt(int64(sps)))
 	return int32(index.Uint64()) - 1
 }
+
+// ChallengeRedundancyCount returns the number of storage providers challenged for each sampled object, which is at least one.
+func ChallengeRedundancyCount(params types.Params) uint64 {
+	if params.ChallengeRedundancyCount == 0 {
+		return 1
+	}
+	return params.ChallengeRedundancyCount
+}
+
+// RandomRedundancyIndexes generates at most count distinct redundancy indexes with the pick function.
+// The first index is picked with the seed, and the others are picked with distinct seeds derived from the seed.
+func RandomRedundancyIndexes(seed []byte, sps, count uint64, pick func(seed []byte) int32) []int32 {
+	if count > sps {
+		count = sps
+	}
+
+	indexes := []int32{pick(seed)}
+	picked := map[int32]struct{}{indexes[0]: {}}
+	for i := uint64(1); uint64(len(indexes)) < count && i < 10*count; i++ { // to prevent endless loop
+		index := pick(SeedFromRandaoMix(seed, i))
+		if _, ok := picked[index]; ok {
+			continue
+		}
+		picked[index] = struct{}{}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/x/challenge/keeper"
+)
+
+func TestRandomSegmentIndexes(t *testing.T) {
+	randaoMix := sdk.Keccak256([]byte{1})
+	randaoMix = append(randaoMix, sdk.Keccak256([]byte{2})...)
+	seed := keeper.SeedFromRandaoMix(randaoMix, 1)
+
+	// the first index is the same as the single segment challenge
+	indexes := keeper.RandomSegmentIndexes(seed, 100, 1)
+	require.Equal(t, []uint32{keeper.RandomSegmentIndex(seed, 100)}, indexes)
+
+	// the indexes are distinct and within the segments
+	indexes = keeper.RandomSegmentIndexes(seed, 100, 8)
+	require.Len(t, indexes, 8)
+	require.Equal(t, keeper.RandomSegmentIndex(seed, 100), indexes[0])
+	picked := make(map[uint32]struct{})
+	for _, index := range indexes {
+		require.Less(t, index, uint32(100))
+		picked[index] = struct{}{}
+	}
+	require.Len(t, picked, 8)
+
+	// the count is capped by the number of segments
+	indexes = keeper.RandomSegmentIndexes(seed, 1, 8)
+	require.Equal(t, []uint32{0}, indexes)
+}
+
+func TestRandomRedundancyIndexes(t *testing.T) {
+	randaoMix := sdk.Keccak256([]byte{1})
+	randaoMix = append(randaoMix, sdk.Keccak256([]byte{2})...)
+	seed := keeper.SeedFromRandaoMix(randaoMix, 1)
+	pick := func(seed []byte) int32 {
+		return keeper.RandomRedundancyIndex(seed, 7)
+	}
+
+	// the first index is the same as the single redundancy index challenge
+	indexes := keeper.RandomRedundancyIndexes(seed, 7, 1, pick)
+	require.Equal(t, []int32{keeper.RandomRedundancyIndex(seed, 7)}, indexes)
+
+	// the indexes are distinct and within the storage providers
+	indexes = keeper.RandomRedundancyIndexes(seed, 7, 3, pick)
+	require.Len(t, indexes, 3)
+	picked := make(map[int32]struct{})
+	for _, index := range indexes {
+		require.GreaterOrEqual(t, index, int32(-1))
+		require.Less(t, index, int32(6))
+		picked[index] = struct{}{}
+	}
+	require.Len(t, picked, 3)
+
+	// the count is capped by the number of storage providers
+	indexes = keeper.RandomRedundancyIndexes(seed, 1, 3, func(seed []byte) int32 {
+		return keeper.RandomRedundancyIndex(seed, 1)
+	})
+	require.Equal(t, []int32{-1}, indexes)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/types/checksum"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// AppealSlash handles the appeal of a storage provider against an escrowed slash.
+// The proofs of all the failed segments/pieces are verified against the checksums of the object, and the storage
+// provider signs them with its bls key. The release of the escrow is postponed for validators to attest the appeal.
+func (k msgServer) AppealSlash(goCtx context.Context, msg *types.MsgAppealSlash) (*types.MsgAppealSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spOperator := sdk.MustAccAddressFromHex(msg.SpOperatorAddress)
+	sp, found := k.SpKeeper.GetStorageProviderByOperatorAddr(ctx, spOperator)
+	if !found {
+		return nil, errors.Wrapf(types.ErrUnknownSp, "cannot find sp with operator address: %s", msg.SpOperatorAddress)
+	}
+
+	escrow, found := k.GetSlashEscrow(ctx, msg.ChallengeId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrSlashEscrowNotFound, "slash of challenge %d is not escrowed, it could be released", msg.ChallengeId)
+	}
+	if escrow.SpId != sp.Id {
+		return nil, errors.Wrapf(types.ErrSlashEscrowNotFound, "challenge %d does not slash sp %s", msg.ChallengeId, msg.SpOperatorAddress)
+	}
+	if escrow.Appealed {
+		return nil, types.ErrSlashAlreadyAppealed
+	}
+
+	objectInfo, found := k.StorageKeeper.GetObjectInfoById(ctx, escrow.ObjectId)
+	if !found {
+		return nil, types.ErrUnknownBucketObject
+	}
+	// all the failed segments/pieces should be proved
+	failedSegments := escrow.GetFailedSegments()
+	if len(msg.SegmentProofs) != len(failedSegments) {
+		return nil, errors.Wrapf(types.ErrInvalidSegmentProof, "challenge %d failed %d segments, but %d proofs are provided",
+			msg.ChallengeId, len(failedSegments), len(msg.SegmentProofs))
+	}
+	for i, segmentIndex := range failedSegments {
+		err := checksum.VerifyPiece(objectInfo.Checksums, escrow.RedundancyIndex, segmentIndex, msg.SegmentProofs[i], msg.PieceHashes)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidSegmentProof, "fail to verify the proof of segment %d of challenge %d: %s",
+				segmentIndex, msg.ChallengeId, err)
+		}
+	}
+
+	if err := k.verifyAppealSignature(ctx, msg, sp.BlsKey); err != nil {
+		return nil, err
+	}
+
+	escrow = k.AppealSlashEscrow(ctx, escrow)
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventAppealSlash{
+		ChallengeId:       msg.ChallengeId,
+		SpId:              sp.Id,
+		SpOperatorAddress: sp.OperatorAddress,
+		ReleaseHeight:     escrow.ReleaseHeight,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppealSlashResponse{}, nil
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"math/big"
 
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -11,6 +10,7 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
 	paymentmoduletypes "github.com/bnb-chain/greenfield/x/payment/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
@@ -69,16 +69,18 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		return nil, types.ErrUnknownBucketObject
 	}
 
-	//for migrating buckets, or swapping out, the process could be done when offline service does the verification
 	bucketInfo, found := k.StorageKeeper.GetBucketInfo(ctx, objectInfo.BucketName)
 	if !found {
 		return nil, storagetypes.ErrNoSuchBucket.Wrapf("bucket not found when attest")
 	}
+	gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", objectInfo.LocalVirtualGroupId)
+	}
 
 	spInState := k.StorageKeeper.MustGetPrimarySPForBucket(ctx, bucketInfo)
 
 	if spInState.Id != sp.Id {
-		gvg, _ := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
 		found = false
 		for _, id := range gvg.SecondarySpIds {
 			if id == sp.Id {
@@ -91,28 +93,75 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 		}
 	}
 
+	// the object is being handed over, the challenge is expired without slash, and the storage provider which takes
+	// over the object will be challenged once the move completes, since the Hulunbeier upgrade
+	if msg.VoteResult == types.CHALLENGE_SUCCEED && ctx.IsUpgraded(gnfdtypes.Hulunbeier) &&
+		(bucketInfo.BucketStatus == storagetypes.BUCKET_STATUS_MIGRATING || k.StorageKeeper.IsSPSwappingOut(ctx, gvg, sp.Id)) {
+		if challenge, found := k.GetPendingChallenge(ctx, msg.ChallengeId); found {
+			segmentIndexes := challenge.SegmentIndexes
+			if len(segmentIndexes) == 0 {
+				segmentIndexes = []uint32{challenge.SegmentIndex}
+			}
+			k.SaveFollowUpChallenge(ctx, types.FollowUpChallenge{
+				ObjectId:        msg.ObjectId,
+				RedundancyIndex: challenge.RedundancyIndex,
+				SegmentIndexes:  segmentIndexes,
+			})
+		}
+		if err = k.ExpireChallenge(ctx, msg.ChallengeId); err != nil {
+			return nil, err
+		}
+		if err = ctx.EventManager().EmitTypedEvents(&types.EventExpireChallenge{
+			ChallengeId: msg.ChallengeId,
+			SpId:        sp.Id,
+			ObjectId:    msg.ObjectId,
+		}); err != nil {
+			return nil, err
+		}
+		return &types.MsgAttestResponse{}, nil
+	}
+
+	slashAmount := sdkmath.ZeroInt()
 	if msg.VoteResult == types.CHALLENGE_SUCCEED {
 		// check slash
 		if k.ExistsSlash(ctx, sp.Id, msg.ObjectId) {
 			return nil, types.ErrDuplicatedSlash
 		}
 
+		// check failed segments
+		failedSegments, err := k.failedSegmentIndexes(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
 		// check slash amount
-		objectSize := objectInfo.PayloadSize
-		toSlashAmount := k.calculateSlashAmount(ctx, objectSize)
+		params := k.GetParams(ctx)
+		toSlashAmount := CalculateSlashAmount(params, objectInfo, int64(len(failedSegments)))
 
 		slashedAmount := k.GetSpSlashAmount(ctx, sp.Id)
-		if !slashedAmount.IsZero() { // if it is the first time to slash, do not check the amount
-			maxSlashAmount := k.GetParams(ctx).SpSlashMaxAmount
-			if (slashedAmount.Add(toSlashAmount)).GT(maxSlashAmount) {
-				ctx.Logger().Info("slash amount exceed the max allow amount",
-					"toSlashAmount", toSlashAmount, "slashedAmount", slashedAmount)
-				toSlashAmount = sdk.ZeroInt()
+		exceeded := false
+		maxSlashAmount := params.SpSlashMaxAmount
+		if (slashedAmount.Add(toSlashAmount)).GT(maxSlashAmount) {
+			ctx.Logger().Info("slash amount exceed the max allow amount",
+				"toSlashAmount", toSlashAmount, "slashedAmount", slashedAmount)
+			if err = ctx.EventManager().EmitTypedEvents(&types.EventSlashCapped{
+				ChallengeId:       msg.ChallengeId,
+				SpId:              sp.Id,
+				ObjectId:          msg.ObjectId,
+				CappedAmount:      toSlashAmount.String(),
+				WindowSlashAmount: slashedAmount.String(),
+				MaxSlashAmount:    maxSlashAmount.String(),
+			}); err != nil {
+				return nil, err
 			}
+			toSlashAmount = sdk.ZeroInt()
+			exceeded = true
 		}
 
+		slashAmount = toSlashAmount
+
 		// do slash & reward
-		err = k.doSlashAndRewards(ctx, msg.ChallengeId, msg.VoteResult, toSlashAmount, sp.Id, submitter, challenger, validators)
+		err = k.doSlashAndRewards(ctx, msg.ChallengeId, msg.ObjectId, failedSegments, msg.VoteResult, toSlashAmount, sp.Id, submitter, challenger, validators)
 		if err != nil {
 			return nil, err
 		}
@@ -123,7 +172,22 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 			Height:   uint64(ctx.BlockHeight()),
 		}
 		k.SaveSlash(ctx, slash)
-		k.SetSpSlashAmount(ctx, sp.Id, slashedAmount.Add(toSlashAmount))
+		windowSlashAmount := slashedAmount.Add(toSlashAmount)
+		k.SetSpSlashAmount(ctx, sp.Id, windowSlashAmount)
+		k.SpKeeper.RecordChallengeResult(ctx, sp.Id, false)
+
+		// the cumulative slash amount of the sp in the window reaches the max slash amount, jail it since the
+		// Hulunbeier upgrade
+		if ctx.IsUpgraded(gnfdtypes.Hulunbeier) && (exceeded || windowSlashAmount.GTE(params.SpSlashMaxAmount)) {
+			// only the sp in service or in maintenance is jailed, otherwise the jail is not due to this slash
+			jailed := sp.IsInService() || sp.IsInMaintenance()
+			if err = k.SpKeeper.Jail(ctx, sp.Id, sptypes.JailReasonSlashExceeded); err != nil {
+				return nil, err
+			}
+			if jailed {
+				k.markSlashEscrowJailed(ctx, msg.ChallengeId)
+			}
+		}
 	} else {
 		// check whether it is a heartbeat attest
 		heartbeatInterval := k.GetParams(ctx).HeartbeatInterval
@@ -137,6 +201,22 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 			return nil, err
 		}
 	}
+	// the bond of an escrowed slash is settled along with the escrow, since the slash can still be appealed
+	if _, escrowed := k.GetSlashEscrow(ctx, msg.ChallengeId); !escrowed {
+		if err = k.SettleChallengeBond(ctx, msg.ChallengeId, msg.VoteResult == types.CHALLENGE_SUCCEED); err != nil {
+			return nil, err
+		}
+	}
+	k.SaveChallengeRecord(ctx, types.ChallengeRecord{
+		ChallengeId:       msg.ChallengeId,
+		SpId:              sp.Id,
+		ObjectId:          msg.ObjectId,
+		Result:            msg.VoteResult,
+		SlashAmount:       slashAmount,
+		ChallengerAddress: msg.ChallengerAddress,
+		SubmitterAddress:  submitter.String(),
+		Height:            uint64(ctx.BlockHeight()),
+	})
 	k.AppendAttestedChallenge(ctx, &types.AttestedChallenge{
 		Id:     msg.ChallengeId,
 		Result: msg.VoteResult,
@@ -145,22 +225,28 @@ func (k msgServer) Attest(goCtx context.Context, msg *types.MsgAttest) (*types.M
 	return &types.MsgAttestResponse{}, nil
 }
 
-// calculateSlashAmount calculates the slash amount based on object size. There are also bounds of the amount.
-func (k msgServer) calculateSlashAmount(ctx sdk.Context, objectSize uint64) sdkmath.Int {
-	params := k.GetParams(ctx)
-	sizeRate := params.SlashAmountSizeRate
-	objectSizeInGB := sdk.NewDecFromBigInt(new(big.Int).SetUint64(objectSize)).QuoRoundUp(sdk.NewDec(one_gb_bytes))
-	slashAmount := objectSizeInGB.Mul(sizeRate).Mul(sdk.NewDec(1e18)).TruncateInt()
+// failedSegmentIndexes returns the failed segment/piece indexes of a succeed challenge, which are checked against
+// the sampled segments/pieces of the challenge. It is the challenged segment/piece if no failed segment/piece is specified.
+func (k msgServer) failedSegmentIndexes(ctx sdk.Context, msg *types.MsgAttest) ([]uint32, error) {
+	challenge, found := k.GetPendingChallenge(ctx, msg.ChallengeId)
+	if len(msg.FailedSegmentIndexes) == 0 {
+		return []uint32{challenge.SegmentIndex}, nil
+	}
 
-	min := params.SlashAmountMin
-	if slashAmount.LT(min) {
-		return min
+	if !found {
+		return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot find the sampled segments of challenge %d", msg.ChallengeId)
 	}
-	max := params.SlashAmountMax
-	if slashAmount.GT(max) {
-		return max
+	sampled := make(map[uint32]struct{}, len(challenge.SegmentIndexes)+1)
+	sampled[challenge.SegmentIndex] = struct{}{}
+	for _, index := range challenge.SegmentIndexes {
+		sampled[index] = struct{}{}
 	}
-	return slashAmount
+	for _, index := range msg.FailedSegmentIndexes {
+		if _, ok := sampled[index]; !ok {
+			return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "segment %d is not sampled in challenge %d", index, msg.ChallengeId)
+		}
+	}
+	return msg.FailedSegmentIndexes, nil
 }
 
 // calculateSlashRewards calculates the rewards to challenger, submitter and validators when the total slash amount.
@@ -196,7 +282,8 @@ func (k msgServer) calculateSlashRewards(ctx sdk.Context, total sdkmath.Int, cha
 }
 
 // doSlashAndRewards will execute the slash, transfer the rewards and emit events.
-func (k msgServer) doSlashAndRewards(ctx sdk.Context, challengeId uint64, voteResult types.VoteResult, slashAmount sdkmath.Int,
+// If the slash escrow period is set, the slashed funds are held in escrow and the rewards are transferred when released.
+func (k msgServer) doSlashAndRewards(ctx sdk.Context, challengeId uint64, objectId sdkmath.Uint, failedSegmentIndexes []uint32, voteResult types.VoteResult, slashAmount sdkmath.Int,
 	spID uint32, submitter, challenger sdk.AccAddress, validators []string) error {
 
 	challengerReward, eachValidatorReward, submitterReward := sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()
@@ -235,7 +322,12 @@ func (k msgServer) doSlashAndRewards(ctx sdk.Context, challengeId uint64, voteRe
 			}})
 
 		if challengerReward.IsPositive() || eachValidatorReward.IsPositive() || submitterReward.IsPositive() {
-			err := k.SpKeeper.Slash(ctx, spID, rewards)
+			var err error
+			if escrowPeriod := k.GetParams(ctx).SlashEscrowPeriod; escrowPeriod > 0 {
+				err = k.EscrowSlash(ctx, challengeId, spID, objectId, failedSegmentIndexes, rewards, escrowPeriod)
+			} else {
+				err = k.SpKeeper.Slash(ctx, spID, rewards)
+			}
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+)
+
+// AttestAppeal handles the attestation of validators for an appealed slash.
+// If the appeal is upheld, the escrowed slash is returned to the storage provider, otherwise it is burned.
+func (k msgServer) AttestAppeal(goCtx context.Context, msg *types.MsgAttestAppeal) (*types.MsgAttestAppealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	submitter := sdk.MustAccAddressFromHex(msg.Submitter)
+
+	escrow, found := k.GetSlashEscrow(ctx, msg.ChallengeId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrSlashEscrowNotFound, "slash of challenge %d is not escrowed, it could be released", msg.ChallengeId)
+	}
+	if !escrow.Appealed {
+		return nil, types.ErrSlashNotAppealed
+	}
+
+	historicalInfo, ok := k.stakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	if !ok {
+		return nil, errors.Wrap(types.ErrInvalidVoteValidatorSet, "fail to get validators")
+	}
+	allValidators := historicalInfo.Valset
+	inTurn, err := k.isInturnAttestation(ctx, submitter, allValidators)
+	if err != nil {
+		return nil, err
+	}
+	if !inTurn {
+		return nil, types.ErrNotInturnChallenger
+	}
+
+	// check attest validators and signatures
+	if _, err = k.verifySignature(ctx, msg, allValidators); err != nil {
+		return nil, err
+	}
+
+	result := types.SLASH_ESCROW_BURNED
+	if msg.Upheld {
+		result = types.SLASH_ESCROW_RELEASED
+	}
+	if err = k.SettleSlashEscrow(ctx, escrow, result); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestAppealResponse{}, nil
+}
@@ -248,10 +248,22 @@ func (s *TestSuite) TestAttest_Normal() {
 		Return("BNB").AnyTimes()
 	s.spKeeper.EXPECT().Slash(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).AnyTimes()
+	s.spKeeper.EXPECT().RecordChallengeResult(gomock.Any(), gomock.Eq(sp.Id), false).
+		AnyTimes()
+	s.spKeeper.EXPECT().Jail(gomock.Any(), gomock.Eq(sp.Id), gomock.Any()).
+		Return(nil).AnyTimes()
 	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
 		Return(sp, true).AnyTimes()
 	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(sp).AnyTimes()
 
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{
+		PrimarySpId: sp.Id,
+	}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Eq(existBucket.Id), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+
 	// success attestation
 	attestMsg1 := &types.MsgAttest{
 		Submitter:         validSubmitter.String(),
@@ -326,3 +338,105 @@ func (s *TestSuite) TestAttest_Normal() {
 	_, err = s.msgServer.Attest(s.ctx, attestMsg3)
 	require.Error(s.T(), err)
 }
+
+func (s *TestSuite) TestAttest_ObjectUnderMove() {
+	// prepare challenges
+	challenge1Id := uint64(99)
+	s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{
+		Id: challenge1Id,
+	})
+	challenge2Id := uint64(101)
+	s.challengeKeeper.SaveChallenge(s.ctx, types.Challenge{
+		Id: challenge2Id,
+	})
+
+	validSubmitter := sample.RandAccAddress()
+
+	blsKey, _ := bls.RandKey()
+	historicalInfo := stakingtypes.HistoricalInfo{
+		Header: tmproto.Header{},
+		Valset: []stakingtypes.Validator{{
+			BlsKey:            blsKey.PublicKey().Marshal(),
+			ChallengerAddress: validSubmitter.String(),
+		}},
+	}
+	s.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), gomock.Any()).
+		Return(historicalInfo, true).AnyTimes()
+
+	migratingBucket := &storagetypes.BucketInfo{
+		Id:           math.NewUint(10),
+		BucketName:   "migratingbucket",
+		BucketStatus: storagetypes.BUCKET_STATUS_MIGRATING,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(migratingBucket.BucketName)).
+		Return(migratingBucket, true).AnyTimes()
+	existBucket := &storagetypes.BucketInfo{
+		Id:         math.NewUint(11),
+		BucketName: "existbucket",
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+
+	migratingObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		ObjectName:   "migratingobject",
+		BucketName:   migratingBucket.BucketName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(migratingObject.Id)).
+		Return(migratingObject, true).AnyTimes()
+	swappingObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(100),
+		ObjectName:   "swappingobject",
+		BucketName:   existBucket.BucketName,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(swappingObject.Id)).
+		Return(swappingObject, true).AnyTimes()
+
+	spOperatorAcc := sample.RandAccAddress()
+	sp := &sptypes.StorageProvider{Id: 1, OperatorAddress: spOperatorAcc.String()}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
+		Return(sp, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(sp).AnyTimes()
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{
+		PrimarySpId: sp.Id,
+	}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Eq(sp.Id)).
+		Return(true).AnyTimes()
+
+	// the sp is neither slashed nor jailed, so no slash related calls are expected
+	for _, tc := range []struct {
+		challengeId uint64
+		objectId    math.Uint
+	}{
+		{challenge1Id, migratingObject.Id}, // the bucket is migrating
+		{challenge2Id, swappingObject.Id},  // the sp is swapping out
+	} {
+		attestMsg := &types.MsgAttest{
+			Submitter:         validSubmitter.String(),
+			ChallengeId:       tc.challengeId,
+			ObjectId:          tc.objectId,
+			SpOperatorAddress: spOperatorAcc.String(),
+			VoteResult:        types.CHALLENGE_SUCCEED,
+			ChallengerAddress: "",
+			VoteValidatorSet:  []uint64{1},
+		}
+		toSign := attestMsg.GetBlsSignBytes(s.ctx.ChainID())
+		attestMsg.VoteAggSignature = blsKey.Sign(toSign[:]).Marshal()
+		_, err := s.msgServer.Attest(s.ctx, attestMsg)
+		s.Require().NoError(err)
+
+		s.Require().False(s.challengeKeeper.ExistsChallenge(s.ctx, tc.challengeId))
+		s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, sp.Id, tc.objectId))
+		s.Require().True(s.challengeKeeper.GetSpSlashAmount(s.ctx, sp.Id).IsZero())
+
+		// the sp which takes over the object will be challenged once the move completes
+		followUp, found := s.challengeKeeper.GetFollowUpChallenge(s.ctx, tc.objectId, 0)
+		s.Require().True(found)
+		s.Require().Equal([]uint32{0}, followUp.SegmentIndexes)
+	}
+}
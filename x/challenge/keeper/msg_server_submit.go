@@ -6,9 +6,11 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
 // Submit handles user's request for submitting a challenge.
@@ -18,11 +20,23 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 	spOperator := sdk.MustAccAddressFromHex(msg.SpOperatorAddress)
 	challenger := sdk.MustAccAddressFromHex(msg.Challenger)
 
+	// check challenger rate limit
+	params := k.GetParams(ctx)
+	submitCount := k.GetChallengerSubmitCount(ctx, challenger)
+	if params.ChallengerRateLimit > 0 && submitCount >= params.ChallengerRateLimit {
+		return nil, errors.Wrapf(types.ErrChallengerRateLimited, "the challenger has submitted %d challenges in the current window", submitCount)
+	}
+
 	// check sp status
 	bucketInfo, found := k.StorageKeeper.GetBucketInfo(ctx, msg.BucketName)
 	if !found {
 		return nil, types.ErrUnknownBucketObject
 	}
+	// the objects being moved cannot be challenged since the Hulunbeier upgrade
+	upgraded := ctx.IsUpgraded(gnfdtypes.Hulunbeier)
+	if upgraded && bucketInfo.BucketStatus == storagetypes.BUCKET_STATUS_MIGRATING {
+		return nil, errors.Wrap(types.ErrObjectUnderMove, "the bucket is migrating")
+	}
 	sp := k.StorageKeeper.MustGetPrimarySPForBucket(ctx, bucketInfo)
 	if sp.Status != sptypes.STATUS_IN_SERVICE && sp.Status != sptypes.STATUS_GRACEFUL_EXITING {
 		return nil, types.ErrInvalidSpStatus
@@ -43,17 +57,21 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 	// check whether the sp stores the object info, generate redundancy index
 	stored := false
 	redundancyIndex := types.RedundancyIndexPrimary
+	challengedSpId := sp.Id
 
 	if spOperator.Equals(sdk.MustAccAddressFromHex(sp.OperatorAddress)) {
 		stored = true
 	}
 
-	if !stored {
-		gvg, found := k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
+	var gvg *virtualgrouptypes.GlobalVirtualGroup
+	if !stored || upgraded {
+		gvg, found = k.StorageKeeper.GetObjectGVG(ctx, bucketInfo.Id, objectInfo.LocalVirtualGroupId)
 		if !found {
 			return nil, errors.Wrapf(types.ErrCannotFindGVG, "no GVG binding for LVG: %d", objectInfo.LocalVirtualGroupId)
 		}
+	}
 
+	if !stored {
 		// check secondary sp
 		for i, spId := range gvg.SecondarySpIds {
 			tmpSp, found := k.SpKeeper.GetStorageProvider(ctx, spId)
@@ -62,6 +80,7 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 			}
 			if spOperator.Equals(sdk.MustAccAddressFromHex(tmpSp.OperatorAddress)) {
 				redundancyIndex = int32(i)
+				challengedSpId = spId
 				stored = true
 				break
 			}
@@ -71,6 +90,11 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		return nil, types.ErrNotStoredOnSp
 	}
 
+	// check whether the sp is swapping out, its successor should be challenged after the swap out completes
+	if upgraded && k.StorageKeeper.IsSPSwappingOut(ctx, gvg, challengedSpId) {
+		return nil, errors.Wrapf(types.ErrObjectUnderMove, "the storage provider %d is swapping out", challengedSpId)
+	}
+
 	// check sp recent slash
 	if k.ExistsSlash(ctx, sp.Id, objectInfo.Id) {
 		return nil, types.ErrExistsRecentSlash
@@ -81,24 +105,40 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidSegmentIndex, "cannot get segment size: %s", err.Error())
 	}
-	segmentIndex := msg.SegmentIndex
+	var segmentIndexes []uint32
 	segments := CalculateSegments(objectInfo.PayloadSize, segmentSize)
 	if msg.RandomIndex {
-		segmentIndex = RandomSegmentIndex(ctx.BlockHeader().RandaoMix, segments)
+		segmentIndexes = RandomSegmentIndexes(ctx.BlockHeader().RandaoMix, segments, ChallengeSegmentCount(params))
 	} else {
-		if uint64(segmentIndex) > segments-1 {
+		if uint64(msg.SegmentIndex) > segments-1 {
 			return nil, types.ErrInvalidSegmentIndex
 		}
+		// the specified index is always challenged, the others are sampled randomly
+		segmentIndexes = SampleSegmentIndexes(ctx.BlockHeader().RandaoMix, segments, ChallengeSegmentCount(params), msg.SegmentIndex)
 	}
+	segmentIndex := segmentIndexes[0]
 
 	k.IncrChallengeCountCurrentBlock(ctx)
 	challengeId := k.GetChallengeId(ctx) + 1
-	expiredHeight := k.Keeper.GetParams(ctx).ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
+	expiredHeight := params.ChallengeKeepAlivePeriod + uint64(ctx.BlockHeight())
 	k.SaveChallenge(ctx, types.Challenge{
-		Id:            challengeId,
-		ExpiredHeight: expiredHeight,
+		Id:                challengeId,
+		ExpiredHeight:     expiredHeight,
+		ObjectId:          objectInfo.Id,
+		SegmentIndex:      segmentIndex,
+		SpId:              challengedSpId,
+		SpOperatorAddress: spOperator.String(),
+		RedundancyIndex:   redundancyIndex,
+		ChallengerAddress: challenger.String(),
+		SegmentIndexes:    segmentIndexes,
 	})
 
+	// lock challenger bond & count the submission
+	if err := k.LockChallengeBond(ctx, challengeId, challenger); err != nil {
+		return nil, err
+	}
+	k.SetChallengerSubmitCount(ctx, challenger, submitCount+1)
+
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStartChallenge{
 		ChallengeId:       challengeId,
 		ObjectId:          objectInfo.Id,
@@ -108,6 +148,8 @@ func (k msgServer) Submit(goCtx context.Context, msg *types.MsgSubmit) (*types.M
 		RedundancyIndex:   redundancyIndex,
 		ChallengerAddress: challenger.String(),
 		ExpiredHeight:     expiredHeight,
+		SegmentIndexes:    segmentIndexes,
+		ChallengedSpId:    challengedSpId,
 	}); err != nil {
 		return nil, err
 	}
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -65,12 +67,17 @@ func (s *TestSuite) TestSubmit() {
 		Return(nil, false).AnyTimes()
 
 	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Eq(types.ModuleName), gomock.Any()).
+		Return(nil).AnyTimes()
 
 	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 100, SecondarySpIds: []uint32{
 		1,
 	}}
 	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
 
 	secondarySpAddr := sample.RandAccAddress()
 	secondarySp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 1, OperatorAddress: secondarySpAddr.String()}
@@ -177,6 +184,21 @@ func (s *TestSuite) TestSubmit() {
 	s.Require().Equal(uint64(3), s.challengeKeeper.GetChallengeCountCurrentBlock(s.ctx))
 	s.Require().Equal(uint64(3), s.challengeKeeper.GetChallengeId(s.ctx))
 
+	// the event of the challenge on the secondary sp keeps the primary sp id
+	var event *types.EventStartChallenge
+	for _, abciEvent := range s.ctx.EventManager().ABCIEvents() {
+		typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if startEvent, ok := typedEvent.(*types.EventStartChallenge); ok {
+			event = startEvent
+		}
+	}
+	s.Require().NotNil(event)
+	s.Require().Equal(existSp.Id, event.SpId)
+	s.Require().Equal(secondarySp.Id, event.ChallengedSpId)
+
 	// create slash
 	s.challengeKeeper.SaveSlash(s.ctx, types.Slash{
 		SpId:     existSp.Id,
@@ -212,3 +234,199 @@ func (s *TestSuite) TestSubmit() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSubmit_RateLimit() {
+	existSpAddr := sample.RandAccAddress()
+	existSp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 100, OperatorAddress: existSpAddr.String()}
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		BucketName:   "existbucket",
+		ObjectName:   "existobject",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(existObject, true).AnyTimes()
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Any()).
+		Return(existBucket, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(existSp).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: existSp.Id}, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(10000), nil).AnyTimes()
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengerRateLimit = 2
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	challenger := sample.RandAccAddress()
+	bond := sdk.NewCoins(sdk.NewCoin("BNB", params.ChallengerBond))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Eq(challenger), gomock.Eq(types.ModuleName), gomock.Eq(bond)).
+		Return(nil).Times(2)
+
+	msg := types.MsgSubmit{
+		Challenger:        challenger.String(),
+		SpOperatorAddress: existSpAddr.String(),
+		BucketName:        existObject.BucketName,
+		ObjectName:        existObject.ObjectName,
+		RandomIndex:       true,
+	}
+	for i := 0; i < 2; i++ {
+		res, err := s.msgServer.Submit(s.ctx, &msg)
+		s.Require().NoError(err)
+		bondInfo, found := s.challengeKeeper.GetChallengeBond(s.ctx, res.ChallengeId)
+		s.Require().True(found)
+		s.Require().Equal(challenger.String(), bondInfo.Challenger)
+		s.Require().Equal(params.ChallengerBond, bondInfo.Amount)
+	}
+	s.Require().Equal(uint64(2), s.challengeKeeper.GetChallengerSubmitCount(s.ctx, challenger))
+
+	_, err := s.msgServer.Submit(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrChallengerRateLimited)
+
+	// the limit is reset in a new window
+	s.challengeKeeper.ClearChallengerSubmitCount(s.ctx)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Eq(challenger), gomock.Eq(types.ModuleName), gomock.Eq(bond)).
+		Return(errors.New("insufficient funds"))
+	_, err = s.msgServer.Submit(s.ctx, &msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientBond)
+}
+
+func (s *TestSuite) TestSubmit_MultiSegments() {
+	existSpAddr := sample.RandAccAddress()
+	existSp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 100, OperatorAddress: existSpAddr.String()}
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		BucketName:   "existbucket",
+		ObjectName:   "existobject",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(existObject, true).AnyTimes()
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Any()).
+		Return(existBucket, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(existSp).AnyTimes()
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: existSp.Id}, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(false).AnyTimes()
+	s.storageKeeper.EXPECT().MaxSegmentSize(gomock.Any(), gomock.Any()).Return(uint64(100), nil).AnyTimes()
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Eq(types.ModuleName), gomock.Any()).
+		Return(nil).AnyTimes()
+
+	params := s.challengeKeeper.GetParams(s.ctx)
+	params.ChallengeSegmentCount = 3
+	_ = s.challengeKeeper.SetParams(s.ctx, params)
+
+	// random index samples multiple distinct segments
+	msg := types.MsgSubmit{
+		Challenger:        sample.RandAccAddressHex(),
+		SpOperatorAddress: existSpAddr.String(),
+		BucketName:        existObject.BucketName,
+		ObjectName:        existObject.ObjectName,
+		RandomIndex:       true,
+	}
+	res, err := s.msgServer.Submit(s.ctx, &msg)
+	s.Require().NoError(err)
+	challenge, found := s.challengeKeeper.GetPendingChallenge(s.ctx, res.ChallengeId)
+	s.Require().True(found)
+	s.Require().Len(challenge.SegmentIndexes, 3)
+	s.Require().Equal(challenge.SegmentIndex, challenge.SegmentIndexes[0])
+	picked := make(map[uint32]struct{})
+	for _, index := range challenge.SegmentIndexes {
+		s.Require().Less(index, uint32(5))
+		picked[index] = struct{}{}
+	}
+	s.Require().Len(picked, 3)
+
+	// specific index is challenged first, along with other sampled segments
+	msg.RandomIndex = false
+	msg.SegmentIndex = 4
+	res, err = s.msgServer.Submit(s.ctx, &msg)
+	s.Require().NoError(err)
+	challenge, _ = s.challengeKeeper.GetPendingChallenge(s.ctx, res.ChallengeId)
+	s.Require().Len(challenge.SegmentIndexes, 3)
+	s.Require().Equal(uint32(4), challenge.SegmentIndex)
+	s.Require().Equal(uint32(4), challenge.SegmentIndexes[0])
+	picked = make(map[uint32]struct{})
+	for _, index := range challenge.SegmentIndexes {
+		s.Require().Less(index, uint32(5))
+		picked[index] = struct{}{}
+	}
+	s.Require().Len(picked, 3)
+}
+
+func (s *TestSuite) TestSubmit_ObjectUnderMove() {
+	existSpAddr := sample.RandAccAddress()
+	existSp := &sptypes.StorageProvider{Status: sptypes.STATUS_IN_SERVICE, Id: 100, OperatorAddress: existSpAddr.String()}
+
+	existObject := &storagetypes.ObjectInfo{
+		Id:           math.NewUint(10),
+		BucketName:   "existbucket",
+		ObjectName:   "existobject",
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED,
+		PayloadSize:  500}
+	s.storageKeeper.EXPECT().GetObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(existObject, true).AnyTimes()
+	existBucket := &storagetypes.BucketInfo{
+		BucketName: existObject.BucketName,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(existBucket.BucketName)).
+		Return(existBucket, true).AnyTimes()
+	migratingBucket := &storagetypes.BucketInfo{
+		BucketName:   "migratingbucket",
+		BucketStatus: storagetypes.BUCKET_STATUS_MIGRATING,
+	}
+	s.storageKeeper.EXPECT().GetBucketInfo(gomock.Any(), gomock.Eq(migratingBucket.BucketName)).
+		Return(migratingBucket, true).AnyTimes()
+	s.storageKeeper.EXPECT().MustGetPrimarySPForBucket(gomock.Any(), gomock.Any()).Return(existSp).AnyTimes()
+
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: existSp.Id}
+	s.storageKeeper.EXPECT().GetObjectGVG(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(gvg, true).AnyTimes()
+	s.storageKeeper.EXPECT().IsSPSwappingOut(gomock.Any(), gomock.Eq(gvg), gomock.Eq(existSp.Id)).
+		Return(true).AnyTimes()
+
+	tests := []struct {
+		name string
+		msg  types.MsgSubmit
+	}{
+		{
+			name: "migrating bucket",
+			msg: types.MsgSubmit{
+				Challenger:        sample.RandAccAddressHex(),
+				SpOperatorAddress: existSpAddr.String(),
+				BucketName:        migratingBucket.BucketName,
+				ObjectName:        existObject.ObjectName,
+				RandomIndex:       true,
+			},
+		},
+		{
+			name: "swapping out sp",
+			msg: types.MsgSubmit{
+				Challenger:        sample.RandAccAddressHex(),
+				SpOperatorAddress: existSpAddr.String(),
+				BucketName:        existBucket.BucketName,
+				ObjectName:        existObject.ObjectName,
+				RandomIndex:       true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			_, err := s.msgServer.Submit(s.ctx, &tt.msg)
+			require.ErrorIs(t, err, types.ErrObjectUnderMove)
+		})
+	}
+	s.Require().Equal(uint64(0), s.challengeKeeper.GetChallengeId(s.ctx))
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge"
 	"github.com/bnb-chain/greenfield/x/challenge/keeper"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
@@ -52,7 +54,7 @@ func (s *TestSuite) SetupTest() {
 		CMS: testCtx.CMS,
 	}
 
-	s.ctx = testCtx.Ctx
+	s.ctx = upgrade.WithUpgraded(testCtx.Ctx, gnfdtypes.Hulunbeier)
 
 	ctrl := gomock.NewController(s.T())
 
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// The salts to derive independent random numbers from the same seed in weighted selection mode.
+const (
+	saltSizeClass byte = iota + 1
+	saltSampleIndex
+	saltRedundancyIndex
+)
+
+// randomNumber derives a random number from the seed and the salt.
+func randomNumber(seed []byte, salt byte) *big.Int {
+	return new(big.Int).SetBytes(sdk.Keccak256(seed, []byte{salt}))
+}
+
+// RandomWeightedSizeClass picks a size class of the sealed object sampling index for challenge, with the probability
+// of the total payload size of its objects over the total payload size of all the sealed objects.
+func RandomWeightedSizeClass(seed []byte, sizes []sdkmath.Uint) (uint32, bool) {
+	weights := make([]*big.Int, len(sizes))
+	total := new(big.Int)
+	for class, size := range sizes {
+		weights[class] = size.BigInt()
+		total.Add(total, weights[class])
+	}
+	if total.Sign() == 0 {
+		return 0, false
+	}
+	index, ok := pickWeighted(randomNumber(seed, saltSizeClass), weights, total)
+	return uint32(index), ok
+}
+
+// RandomSampleIndex generates a random index of an object in a size class of the sampling index for challenge.
+func RandomSampleIndex(seed []byte, count uint64) uint64 {
+	number := randomNumber(seed, saltSampleIndex)
+	return new(big.Int).Mod(number, new(big.Int).SetUint64(count)).Uint64()
+}
+
+// RandomWeightedRedundancyIndex generates a random redundancy index (storage provider) for challenge with the
+// weights of the storage providers, the first weight is for the primary sp.
+// Be noted: RedundancyIndex starts from -1 (the primary sp).
+func RandomWeightedRedundancyIndex(seed []byte, weights []*big.Int) int32 {
+	total := new(big.Int)
+	for _, weight := range weights {
+		total.Add(total, weight)
+	}
+	if total.Sign() == 0 {
+		return types.RedundancyIndexPrimary
+	}
+	index, _ := pickWeighted(randomNumber(seed, saltRedundancyIndex), weights, total)
+	return int32(index) - 1
+}
+
+// pickWeighted picks an index with probability proportional to its weight.
+func pickWeighted(number *big.Int, weights []*big.Int, total *big.Int) (int, bool) {
+	point := new(big.Int).Mod(number, total)
+	for i, weight := range weights {
+		if point.Cmp(weight) < 0 {
+			return i, true
+		}
+		point.Sub(point, weight)
+	}
+	return 0, false
+}
+
+// RandomSealedObject samples a sealed object for challenge from the sampling index of the storage module, weighted by
+// payload size. The size class is picked by the total payload size of its objects, then an object is picked uniformly
+// in the size class, in which the payload sizes differ by less than a factor of 2.
+func (k Keeper) RandomSealedObject(ctx sdk.Context, seed []byte, counts []uint64, sizes []sdkmath.Uint) (*storagetypes.ObjectInfo, bool) {
+	sizeClass, found := RandomWeightedSizeClass(seed, sizes)
+	if !found || counts[sizeClass] == 0 {
+		return nil, false
+	}
+	objectId, found := k.StorageKeeper.GetSealedObjectSample(ctx, sizeClass, RandomSampleIndex(seed, counts[sizeClass]))
+	if !found {
+		return nil, false
+	}
+	return k.StorageKeeper.GetObjectInfoById(ctx, objectId)
+}
+
+// GetSpSlashRiskWeight returns the weight of a storage provider in weighted selection mode, which is
+// 1 + SpSlashRiskWeight * min(slashed amount / SpSlashMaxAmount, 1).
+func (k Keeper) GetSpSlashRiskWeight(ctx sdk.Context, spId uint32, params types.Params) sdk.Dec {
+	weight := sdk.OneDec()
+	if params.SpSlashRiskWeight.IsNil() || !params.SpSlashMaxAmount.IsPositive() {
+		return weight
+	}
+	ratio := sdk.NewDecFromInt(k.GetSpSlashAmount(ctx, spId)).QuoInt(params.SpSlashMaxAmount)
+	if ratio.GT(sdk.OneDec()) {
+		ratio = sdk.OneDec()
+	}
+	return weight.Add(params.SpSlashRiskWeight.Mul(ratio))
+}
+
+// RandomRiskWeightedRedundancyIndex generates a random redundancy index for challenge, storage providers which were
+// slashed more in the current counting window are more likely to be picked.
+func (k Keeper) RandomRiskWeightedRedundancyIndex(ctx sdk.Context, seed []byte, gvg *virtualgrouptypes.GlobalVirtualGroup,
+	params types.Params,
+) int32 {
+	weights := make([]*big.Int, 0, len(gvg.SecondarySpIds)+1)
+	weights = append(weights, k.GetSpSlashRiskWeight(ctx, gvg.PrimarySpId, params).BigInt())
+	for _, spId := range gvg.SecondarySpIds {
+		weights = append(weights, k.GetSpSlashRiskWeight(ctx, spId, params).BigInt())
+	}
+	return RandomWeightedRedundancyIndex(seed, weights)
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/x/challenge/keeper"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func TestRandomWeightedSizeClass(t *testing.T) {
+	randaoMix := sdk.Keccak256([]byte{1})
+	randaoMix = append(randaoMix, sdk.Keccak256([]byte{2})...)
+
+	sizes := make([]sdkmath.Uint, 65)
+	for i := range sizes {
+		sizes[i] = sdkmath.ZeroUint()
+	}
+
+	// no sealed object
+	_, found := keeper.RandomWeightedSizeClass(keeper.SeedFromRandaoMix(randaoMix, 1), sizes)
+	require.False(t, found)
+
+	// the size classes are picked by their total payload size
+	sizes[10] = sdkmath.NewUint(1000)
+	sizes[20] = sdkmath.NewUint(9000)
+	picked := make(map[uint32]int)
+	for i := uint64(0); i < 1000; i++ {
+		class, found := keeper.RandomWeightedSizeClass(keeper.SeedFromRandaoMix(randaoMix, i), sizes)
+		require.True(t, found)
+		picked[class]++
+	}
+	require.Equal(t, 1000, picked[10]+picked[20])
+	require.Greater(t, picked[10], 50)
+	require.Less(t, picked[10], 150)
+
+	// the index is within the size class
+	for i := uint64(0); i < 100; i++ {
+		require.Less(t, keeper.RandomSampleIndex(keeper.SeedFromRandaoMix(randaoMix, i), 3), uint64(3))
+	}
+}
+
+func TestRandomRiskWeightedRedundancyIndex(t *testing.T) {
+	k, ctx := makeKeeper(t)
+	randaoMix := sdk.Keccak256([]byte{1})
+	randaoMix = append(randaoMix, sdk.Keccak256([]byte{2})...)
+
+	// zero weights fall back to the primary sp
+	seed := keeper.SeedFromRandaoMix(randaoMix, 1)
+	require.Equal(t, types.RedundancyIndexPrimary, keeper.RandomWeightedRedundancyIndex(seed, []*big.Int{big.NewInt(0), big.NewInt(0)}))
+	require.Equal(t, int32(1), keeper.RandomWeightedRedundancyIndex(seed, []*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(1)}))
+
+	params := types.DefaultParams()
+	params.SpSlashRiskWeight = sdk.NewDec(9)
+	k.SetSpSlashAmount(ctx, 3, params.SpSlashMaxAmount.MulRaw(2))
+	require.Equal(t, sdk.OneDec(), k.GetSpSlashRiskWeight(ctx, 1, params))
+	require.Equal(t, sdk.NewDec(10), k.GetSpSlashRiskWeight(ctx, 3, params))
+
+	// the slashed sp is picked much more often
+	gvg := &virtualgrouptypes.GlobalVirtualGroup{PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}}
+	picked := make(map[int32]int)
+	for i := uint64(0); i < 1200; i++ {
+		picked[k.RandomRiskWeightedRedundancyIndex(ctx, keeper.SeedFromRandaoMix(randaoMix, i), gvg, params)]++
+	}
+	require.Greater(t, picked[1], 800)
+}
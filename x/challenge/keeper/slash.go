@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 // SaveSlash set a specific slash in the store
@@ -21,6 +23,12 @@ func (k Keeper) SaveSlash(ctx sdk.Context, slash types.Slash) {
 	store.Set(getSlashKeyBytes(slash.SpId, slash.ObjectId), heightBytes)
 }
 
+// RemoveSlash removes the slash of a pair of sp and object info
+func (k Keeper) RemoveSlash(ctx sdk.Context, spId uint32, objectId sdkmath.Uint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKeyPrefix)
+	store.Delete(getSlashKeyBytes(spId, objectId))
+}
+
 // RemoveSlashUntil removes slashes which are created earlier
 func (k Keeper) RemoveSlashUntil(ctx sdk.Context, height uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKeyPrefix)
@@ -78,6 +86,23 @@ func (k Keeper) GetSpSlashAmount(ctx sdk.Context, spId uint32) sdkmath.Int {
 	return amount
 }
 
+// GetSpSlashExposure returns the amount the sp can still be slashed in the current slash counting window
+func (k Keeper) GetSpSlashExposure(ctx sdk.Context, spId uint32) sdkmath.Int {
+	exposure := k.GetParams(ctx).SpSlashMaxAmount.Sub(k.GetSpSlashAmount(ctx, spId))
+	if exposure.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return exposure
+}
+
+// GetSpSlashWindowEndHeight returns the height at which the current slash counting window ends, the slashed amounts
+// of storage providers are cleared at that height.
+func (k Keeper) GetSpSlashWindowEndHeight(ctx sdk.Context) uint64 {
+	window := k.GetParams(ctx).SpSlashCountingWindow
+	height := uint64(ctx.BlockHeight())
+	return (height/window + 1) * window
+}
+
 func (k Keeper) ClearSpSlashAmount(ctx sdk.Context) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashAmountKeyPrefix)
 
@@ -88,3 +113,42 @@ func (k Keeper) ClearSpSlashAmount(ctx sdk.Context) {
 		store.Delete(iterator.Key())
 	}
 }
+
+// CalculateSlashAmount calculates the slash amount of an object info with the slash curve for the failed segments.
+// The amount of each segment is at least SlashAmountMin, and the total amount is at most SlashAmountMax.
+func CalculateSlashAmount(params types.Params, objectInfo *storagetypes.ObjectInfo, failedSegments int64) sdkmath.Int {
+	objectSizeInGB := sdk.NewDecFromBigInt(new(big.Int).SetUint64(objectInfo.PayloadSize)).QuoRoundUp(sdk.NewDec(one_gb_bytes))
+
+	var slashAmount sdkmath.Int
+	switch params.SlashCurve {
+	case types.SLASH_CURVE_STEPWISE:
+		slashAmount = params.SlashAmountMax
+		for _, step := range params.SlashSteps {
+			if objectSizeInGB.LTE(sdk.NewDecFromBigInt(new(big.Int).SetUint64(step.ObjectSizeGb))) {
+				slashAmount = step.Amount
+				break
+			}
+		}
+	case types.SLASH_CURVE_PER_REDUNDANCY_TYPE:
+		sizeRate, found := params.RedundancySlashAmountSizeRateMap()[objectInfo.RedundancyType]
+		if !found {
+			sizeRate = params.SlashAmountSizeRate
+		}
+		slashAmount = objectSizeInGB.Mul(sizeRate).Mul(sdk.NewDec(1e18)).TruncateInt()
+	default:
+		slashAmount = objectSizeInGB.Mul(params.SlashAmountSizeRate).Mul(sdk.NewDec(1e18)).TruncateInt()
+	}
+
+	min := params.SlashAmountMin
+	if slashAmount.LT(min) {
+		slashAmount = min
+	}
+	if failedSegments > 1 {
+		slashAmount = slashAmount.MulRaw(failedSegments)
+	}
+	max := params.SlashAmountMax
+	if slashAmount.GT(max) {
+		return max
+	}
+	return slashAmount
+}
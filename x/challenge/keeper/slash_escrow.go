@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSlashEscrow returns the escrowed slash of a challenge
+func (k Keeper) GetSlashEscrow(ctx sdk.Context, challengeId uint64) (types.SlashEscrow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashEscrowKeyPrefix)
+	bz := store.Get(getChallengeKeyBytes(challengeId))
+	if bz == nil {
+		return types.SlashEscrow{}, false
+	}
+	var escrow types.SlashEscrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// SaveSlashEscrow sets the slash escrow in the store, and indexes it by the release height unless it is appealed
+func (k Keeper) SaveSlashEscrow(ctx sdk.Context, escrow types.SlashEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.SlashEscrowKeyPrefix).Set(getChallengeKeyBytes(escrow.ChallengeId), k.cdc.MustMarshal(&escrow))
+	if !escrow.Appealed {
+		store.Set(types.GetSlashEscrowQueueKey(escrow.ReleaseHeight, escrow.ChallengeId), []byte{})
+	}
+}
+
+// removeSlashEscrow removes the slash escrow and its index from the store
+func (k Keeper) removeSlashEscrow(ctx sdk.Context, escrow types.SlashEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.SlashEscrowKeyPrefix).Delete(getChallengeKeyBytes(escrow.ChallengeId))
+	store.Delete(types.GetSlashEscrowQueueKey(escrow.ReleaseHeight, escrow.ChallengeId))
+}
+
+// EscrowSlash transfers the slashed funds from the deposit of the storage provider to the challenge module account.
+// The funds are held for the escrow period, and distributed as the rewards if the slash is not appealed.
+// The failed segment/piece indexes are kept, so that the appeal has to prove all of them.
+func (k Keeper) EscrowSlash(ctx sdk.Context, challengeId uint64, spId uint32, objectId sdkmath.Uint,
+	failedSegmentIndexes []uint32, rewards []sptypes.RewardInfo, escrowPeriod uint64) error {
+	amount := sdkmath.ZeroInt()
+	for _, reward := range rewards {
+		amount = amount.Add(reward.Amount.Amount)
+	}
+
+	denom := k.SpKeeper.DepositDenomForSP(ctx)
+	if err := k.SpKeeper.SlashToModule(ctx, spId, types.ModuleName, sdk.NewCoin(denom, amount)); err != nil {
+		return err
+	}
+
+	escrow := types.SlashEscrow{
+		ChallengeId:   challengeId,
+		SpId:          spId,
+		ObjectId:      objectId,
+		Amount:        amount,
+		Rewards:       rewards,
+		ReleaseHeight: uint64(ctx.BlockHeight()) + escrowPeriod,
+
+		FailedSegmentIndexes: failedSegmentIndexes,
+	}
+	if challenge, found := k.GetPendingChallenge(ctx, challengeId); found {
+		escrow.SegmentIndex = challenge.SegmentIndex
+		escrow.RedundancyIndex = challenge.RedundancyIndex
+	}
+	k.SaveSlashEscrow(ctx, escrow)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventEscrowSlash{
+		ChallengeId:   challengeId,
+		SpId:          spId,
+		Amount:        amount.String(),
+		ReleaseHeight: escrow.ReleaseHeight,
+	})
+}
+
+// AppealSlashEscrow marks the escrowed slash as appealed, and removes it from the release queue. The appealed escrow
+// is kept until validators attest whether the appeal is upheld.
+func (k Keeper) AppealSlashEscrow(ctx sdk.Context, escrow types.SlashEscrow) types.SlashEscrow {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSlashEscrowQueueKey(escrow.ReleaseHeight, escrow.ChallengeId))
+	escrow.Appealed = true
+	k.SaveSlashEscrow(ctx, escrow)
+	return escrow
+}
+
+// markSlashEscrowJailed records that the escrowed slash of the challenge jails the storage provider, so that the jail
+// is revoked along with the slash if the appeal is upheld.
+func (k Keeper) markSlashEscrowJailed(ctx sdk.Context, challengeId uint64) {
+	escrow, found := k.GetSlashEscrow(ctx, challengeId)
+	if !found {
+		return
+	}
+	escrow.JailedAt = ctx.BlockTime().Unix()
+	k.SaveSlashEscrow(ctx, escrow)
+}
+
+// postponeSlashEscrow postpones the release of the escrowed slash by the period from the current height.
+func (k Keeper) postponeSlashEscrow(ctx sdk.Context, escrow types.SlashEscrow, period uint64) types.SlashEscrow {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSlashEscrowQueueKey(escrow.ReleaseHeight, escrow.ChallengeId))
+	escrow.ReleaseHeight = uint64(ctx.BlockHeight()) + period
+	k.SaveSlashEscrow(ctx, escrow)
+	return escrow
+}
+
+// SettleSlashEscrow removes the escrowed slash from the store, and distributes, releases or burns the funds.
+// The challenger bond of the challenge is settled along with the escrow, the challenge fails if the appeal is upheld,
+// in which case the slash record of the object is removed, and the jail due to the slash is revoked as well.
+func (k Keeper) SettleSlashEscrow(ctx sdk.Context, escrow types.SlashEscrow, result types.SlashEscrowResult) error {
+	k.removeSlashEscrow(ctx, escrow)
+
+	denom := k.SpKeeper.DepositDenomForSP(ctx)
+	switch result {
+	case types.SLASH_ESCROW_DISTRIBUTED:
+		for _, reward := range escrow.Rewards {
+			if !reward.Amount.IsPositive() {
+				continue
+			}
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromHex(reward.Address),
+				sdk.NewCoins(reward.Amount))
+			if err != nil {
+				return err
+			}
+		}
+	case types.SLASH_ESCROW_RELEASED:
+		if escrow.Amount.IsPositive() {
+			err := k.SpKeeper.RestoreSlashedDeposit(ctx, escrow.SpId, types.ModuleName, sdk.NewCoin(denom, escrow.Amount))
+			if err != nil {
+				return err
+			}
+		}
+		// the slash is revoked, so it is not counted into the slash amount of the storage provider anymore
+		slashedAmount := k.GetSpSlashAmount(ctx, escrow.SpId).Sub(escrow.Amount)
+		if slashedAmount.IsNegative() {
+			slashedAmount = sdkmath.ZeroInt()
+		}
+		k.SetSpSlashAmount(ctx, escrow.SpId, slashedAmount)
+		k.RemoveSlash(ctx, escrow.SpId, escrow.ObjectId)
+		if escrow.JailedAt != 0 {
+			if err := k.SpKeeper.RevokeJail(ctx, escrow.SpId, escrow.JailedAt); err != nil {
+				return err
+			}
+		}
+	case types.SLASH_ESCROW_BURNED:
+		if escrow.Amount.IsPositive() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, escrow.Amount))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := k.SettleChallengeBond(ctx, escrow.ChallengeId, result != types.SLASH_ESCROW_RELEASED); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSettleSlashEscrow{
+		ChallengeId: escrow.ChallengeId,
+		SpId:        escrow.SpId,
+		Amount:      escrow.Amount.String(),
+		Result:      result,
+	})
+}
+
+// ReleaseSlashEscrowUntil distributes the escrowed slashes which are released at or before the height as rewards.
+// The appealed escrows are kept until the appeals are attested. The escrow failed to be distributed is kept and retried
+// after the escrow period.
+func (k Keeper) ReleaseSlashEscrowUntil(ctx sdk.Context, height uint64) {
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashEscrowQueueKeyPrefix)
+	iterator := queueStore.Iterator(nil, k.encodeUint64(height+1))
+	defer iterator.Close()
+
+	challengeIds := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		challengeIds = append(challengeIds, binary.BigEndian.Uint64(iterator.Key()[8:]))
+	}
+
+	for _, challengeId := range challengeIds {
+		escrow, found := k.GetSlashEscrow(ctx, challengeId)
+		if !found || escrow.Appealed {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SettleSlashEscrow(cacheCtx, escrow, types.SLASH_ESCROW_DISTRIBUTED); err != nil {
+			ctx.Logger().Error("fail to release slash escrow", "challenge id", challengeId, "err", err.Error())
+			// keep the funds in escrow, and retry later rather than in every block
+			period := k.GetParams(ctx).SlashEscrowPeriod
+			if period == 0 {
+				period = 1
+			}
+			k.postponeSlashEscrow(ctx, escrow, period)
+			continue
+		}
+		write()
+	}
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/types/checksum"
+	"github.com/bnb-chain/greenfield/x/challenge/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func (s *TestSuite) TestSlashEscrow() {
+	s.spKeeper.EXPECT().DepositDenomForSP(gomock.Any()).Return("BNB").AnyTimes()
+	s.spKeeper.EXPECT().SlashToModule(gomock.Any(), gomock.Eq(uint32(1)), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoin("BNB", sdk.NewInt(300)))).Return(nil).Times(3)
+
+	validator, submitter := sample.RandAccAddress(), sample.RandAccAddress()
+	rewards := []sptypes.RewardInfo{
+		{Address: validator.String(), Amount: sdk.NewCoin("BNB", sdk.NewInt(200))},
+		{Address: submitter.String(), Amount: sdk.NewCoin("BNB", sdk.NewInt(100))},
+	}
+	s.ctx = s.ctx.WithBlockHeight(100)
+	for id := uint64(1); id <= 3; id++ {
+		s.Require().NoError(s.challengeKeeper.EscrowSlash(s.ctx, id, 1, sdkmath.NewUint(id), []uint32{0, 2}, rewards, 10))
+	}
+	escrow, found := s.challengeKeeper.GetSlashEscrow(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal([]uint32{0, 2}, escrow.FailedSegmentIndexes)
+	s.Require().Equal(uint64(110), escrow.ReleaseHeight)
+	s.Require().Equal(sdk.NewInt(300), escrow.Amount)
+	s.Require().False(escrow.Appealed)
+
+	// the appealed escrow is kept until the appeal is attested
+	s.ctx = s.ctx.WithBlockHeight(105)
+	escrow, _ = s.challengeKeeper.GetSlashEscrow(s.ctx, 2)
+	escrow = s.challengeKeeper.AppealSlashEscrow(s.ctx, escrow)
+	s.Require().True(escrow.Appealed)
+
+	// the upheld appeal restores the deposit and the slash amount of the sp, and rolls back the slash record,
+	// the challenger bond and the jail due to the slash
+	escrow.JailedAt = 1000
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, escrow)
+	s.spKeeper.EXPECT().RevokeJail(gomock.Any(), gomock.Eq(uint32(1)), gomock.Eq(int64(1000))).Return(nil)
+	challenger := sample.RandAccAddress()
+	bond := types.DefaultChallengerBond
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Eq(challenger), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", bond)))).Return(nil)
+	s.Require().NoError(s.challengeKeeper.LockChallengeBond(s.ctx, 2, challenger))
+	s.challengeKeeper.SaveSlash(s.ctx, types.Slash{SpId: 1, ObjectId: escrow.ObjectId, Height: 100})
+	s.challengeKeeper.SetSpSlashAmount(s.ctx, 1, sdk.NewInt(900))
+	s.spKeeper.EXPECT().RestoreSlashedDeposit(gomock.Any(), gomock.Eq(uint32(1)), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoin("BNB", sdk.NewInt(300)))).Return(nil)
+	burnAmount := types.DefaultChallengerBondBurnRatio.MulInt(bond).TruncateInt()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", burnAmount)))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(challenger),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", bond.Sub(burnAmount))))).Return(nil)
+	s.Require().NoError(s.challengeKeeper.SettleSlashEscrow(s.ctx, escrow, types.SLASH_ESCROW_RELEASED))
+	_, found = s.challengeKeeper.GetSlashEscrow(s.ctx, 2)
+	s.Require().False(found)
+	s.Require().Equal(sdk.NewInt(600), s.challengeKeeper.GetSpSlashAmount(s.ctx, 1))
+	s.Require().False(s.challengeKeeper.ExistsSlash(s.ctx, 1, escrow.ObjectId))
+	_, found = s.challengeKeeper.GetChallengeBond(s.ctx, 2)
+	s.Require().False(found)
+
+	// the rejected appeal burns the escrow
+	escrow, _ = s.challengeKeeper.GetSlashEscrow(s.ctx, 3)
+	escrow = s.challengeKeeper.AppealSlashEscrow(s.ctx, escrow)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Eq(types.ModuleName),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(300))))).Return(nil)
+	s.Require().NoError(s.challengeKeeper.SettleSlashEscrow(s.ctx, escrow, types.SLASH_ESCROW_BURNED))
+
+	// the escrow is distributed as rewards when released
+	s.challengeKeeper.ReleaseSlashEscrowUntil(s.ctx, 109)
+	_, found = s.challengeKeeper.GetSlashEscrow(s.ctx, 1)
+	s.Require().True(found)
+
+	// the escrow failed to be distributed is kept and retried later
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(validator),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(200))))).Return(errors.New("blocked address"))
+	s.ctx = s.ctx.WithBlockHeight(110)
+	s.challengeKeeper.ReleaseSlashEscrowUntil(s.ctx, 110)
+	escrow, found = s.challengeKeeper.GetSlashEscrow(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(uint64(111), escrow.ReleaseHeight) // retried in the next block as the escrow period is 0 by default
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(validator),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(200))))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(submitter),
+		gomock.Eq(sdk.NewCoins(sdk.NewCoin("BNB", sdk.NewInt(100))))).Return(nil)
+	s.challengeKeeper.ReleaseSlashEscrowUntil(s.ctx, 110)
+	_, found = s.challengeKeeper.GetSlashEscrow(s.ctx, 1)
+	s.Require().True(found)
+	s.challengeKeeper.ReleaseSlashEscrowUntil(s.ctx, 111)
+	_, found = s.challengeKeeper.GetSlashEscrow(s.ctx, 1)
+	s.Require().False(found)
+
+	// the appealed escrow is not released until the appeal is attested
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, types.SlashEscrow{ChallengeId: 4, SpId: 1, Amount: sdk.NewInt(300),
+		Rewards: rewards, ReleaseHeight: 120})
+	escrow, _ = s.challengeKeeper.GetSlashEscrow(s.ctx, 4)
+	s.challengeKeeper.AppealSlashEscrow(s.ctx, escrow)
+	s.challengeKeeper.ReleaseSlashEscrowUntil(s.ctx, 200)
+	escrow, found = s.challengeKeeper.GetSlashEscrow(s.ctx, 4)
+	s.Require().True(found)
+	s.Require().True(escrow.Appealed)
+}
+
+func (s *TestSuite) TestAppealSlash_Invalid() {
+	spOperator := sample.RandAccAddress()
+	sp := &sptypes.StorageProvider{Id: 1, OperatorAddress: spOperator.String()}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Eq(spOperator)).
+		Return(sp, true).AnyTimes()
+
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, types.SlashEscrow{ChallengeId: 1, SpId: 2, Amount: sdk.NewInt(1)})
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, types.SlashEscrow{ChallengeId: 2, SpId: 1, Amount: sdk.NewInt(1), Appealed: true})
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, types.SlashEscrow{ChallengeId: 3, SpId: 1, Amount: sdk.NewInt(1)})
+
+	// the object of escrow 4 has two segments, and the first one is challenged on the primary sp
+	segments := [][]byte{[]byte("segment-0"), []byte("segment-1")}
+	segmentHashes := [][]byte{checksum.GenerateChecksum(segments[0]), checksum.GenerateChecksum(segments[1])}
+	object := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(4), Checksums: [][]byte{checksum.GenerateIntegrityHash(segmentHashes)}}
+	s.storageKeeper.EXPECT().GetObjectInfoById(gomock.Any(), gomock.Eq(object.Id)).Return(object, true).AnyTimes()
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, types.SlashEscrow{ChallengeId: 4, SpId: 1, ObjectId: object.Id,
+		Amount: sdk.NewInt(1), RedundancyIndex: types.RedundancyIndexPrimary})
+	// both segments of the object failed the challenge of escrow 5
+	s.challengeKeeper.SaveSlashEscrow(s.ctx, types.SlashEscrow{ChallengeId: 5, SpId: 1, ObjectId: object.Id,
+		Amount: sdk.NewInt(1), RedundancyIndex: types.RedundancyIndexPrimary, FailedSegmentIndexes: []uint32{0, 1}})
+
+	tests := []struct {
+		name string
+		msg  types.MsgAppealSlash
+		err  error
+	}{
+		{
+			name: "not escrowed",
+			msg:  types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 100},
+			err:  types.ErrSlashEscrowNotFound,
+		}, {
+			name: "not slashed sp",
+			msg:  types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 1},
+			err:  types.ErrSlashEscrowNotFound,
+		}, {
+			name: "already appealed",
+			msg:  types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 2},
+			err:  types.ErrSlashAlreadyAppealed,
+		}, {
+			name: "proof of another segment",
+			msg: types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 4,
+				SegmentProofs: [][]byte{segments[1]}, PieceHashes: segmentHashes},
+			err: types.ErrInvalidSegmentProof,
+		}, {
+			name: "piece hashes not in the checksums",
+			msg: types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 4,
+				SegmentProofs: [][]byte{segments[0]}, PieceHashes: segmentHashes[:1]},
+			err: types.ErrInvalidSegmentProof,
+		}, {
+			name: "proof of only one failed segment",
+			msg: types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 5,
+				SegmentProofs: [][]byte{segments[0]}, PieceHashes: segmentHashes},
+			err: types.ErrInvalidSegmentProof,
+		}, {
+			name: "proofs in the wrong order",
+			msg: types.MsgAppealSlash{SpOperatorAddress: spOperator.String(), ChallengeId: 5,
+				SegmentProofs: [][]byte{segments[1], segments[0]}, PieceHashes: segmentHashes},
+			err: types.ErrInvalidSegmentProof,
+		},
+	}
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			_, err := s.msgServer.AppealSlash(s.ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	// attestation is only for the appealed slash
+	_, err := s.msgServer.AttestAppeal(s.ctx, &types.MsgAttestAppeal{Submitter: sample.RandAccAddressHex(), ChallengeId: 3})
+	s.Require().ErrorIs(err, types.ErrSlashNotAppealed)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/bnb-chain/greenfield/x/challenge/keeper"
 	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 func createSlash(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Slash {
@@ -40,3 +41,54 @@ func TestRemoveSpSlashAmount(t *testing.T) {
 	require.True(t, keeper.GetSpSlashAmount(ctx, 1).Int64() == 0)
 	require.True(t, keeper.GetSpSlashAmount(ctx, 2).Int64() == 0)
 }
+
+func TestCalculateSlashAmount(t *testing.T) {
+	params := types.DefaultParams()
+	params.SlashAmountSizeRate = sdk.NewDecWithPrec(1, 2)
+	params.SlashAmountMin = sdk.NewInt(1e15)
+	params.SlashAmountMax = sdk.NewInt(1e18)
+	params.SlashSteps = []types.SlashStep{
+		{ObjectSizeGb: 1, Amount: sdk.NewInt(1e16)},
+		{ObjectSizeGb: 4, Amount: sdk.NewInt(1e17)},
+	}
+	params.RedundancySlashAmountSizeRates = []types.RedundancySlashRate{
+		{RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, SizeRate: sdk.NewDecWithPrec(2, 2)},
+	}
+
+	const gb = 1024 * 1024 * 1024
+	ecObject := &storagetypes.ObjectInfo{PayloadSize: 2 * gb, RedundancyType: storagetypes.REDUNDANCY_EC_TYPE}
+	replicaObject := &storagetypes.ObjectInfo{PayloadSize: 2 * gb, RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE}
+
+	// linear
+	require.Equal(t, sdk.NewInt(2e16), keeper.CalculateSlashAmount(params, ecObject, 1))
+	require.Equal(t, sdk.NewInt(2e16), keeper.CalculateSlashAmount(params, replicaObject, 1))
+	require.Equal(t, params.SlashAmountMax, keeper.CalculateSlashAmount(params, &storagetypes.ObjectInfo{PayloadSize: 1000 * gb}, 1))
+	require.Equal(t, params.SlashAmountMin, keeper.CalculateSlashAmount(params, &storagetypes.ObjectInfo{PayloadSize: 1}, 1))
+
+	// stepwise
+	params.SlashCurve = types.SLASH_CURVE_STEPWISE
+	require.Equal(t, sdk.NewInt(1e16), keeper.CalculateSlashAmount(params, &storagetypes.ObjectInfo{PayloadSize: gb}, 1))
+	require.Equal(t, sdk.NewInt(1e17), keeper.CalculateSlashAmount(params, ecObject, 1))
+	require.Equal(t, params.SlashAmountMax, keeper.CalculateSlashAmount(params, &storagetypes.ObjectInfo{PayloadSize: 5 * gb}, 1))
+
+	// per redundancy type
+	params.SlashCurve = types.SLASH_CURVE_PER_REDUNDANCY_TYPE
+	require.Equal(t, sdk.NewInt(2e16), keeper.CalculateSlashAmount(params, ecObject, 1))
+	require.Equal(t, sdk.NewInt(4e16), keeper.CalculateSlashAmount(params, replicaObject, 1))
+
+	// multiple failed segments, the total amount is bounded
+	require.Equal(t, sdk.NewInt(6e16), keeper.CalculateSlashAmount(params, ecObject, 3))
+	require.Equal(t, sdk.NewInt(4e15), keeper.CalculateSlashAmount(params, &storagetypes.ObjectInfo{PayloadSize: 1}, 4))
+	require.Equal(t, params.SlashAmountMax, keeper.CalculateSlashAmount(params, replicaObject, 30))
+}
+
+func TestGetSpSlashWindowEndHeight(t *testing.T) {
+	keeper, ctx := makeKeeper(t)
+	params := types.DefaultParams()
+	params.SpSlashCountingWindow = 100
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	require.Equal(t, uint64(100), keeper.GetSpSlashWindowEndHeight(ctx.WithBlockHeight(0)))
+	require.Equal(t, uint64(100), keeper.GetSpSlashWindowEndHeight(ctx.WithBlockHeight(99)))
+	require.Equal(t, uint64(200), keeper.GetSpSlashWindowEndHeight(ctx.WithBlockHeight(100)))
+}
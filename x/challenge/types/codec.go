@@ -10,6 +10,8 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSubmit{}, "challenge/Submit", nil)
 	cdc.RegisterConcrete(&MsgAttest{}, "challenge/Attest", nil)
+	cdc.RegisterConcrete(&MsgAppealSlash{}, "challenge/AppealSlash", nil)
+	cdc.RegisterConcrete(&MsgAttestAppeal{}, "challenge/AttestAppeal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,6 +21,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAttest{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAppealSlash{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAttestAppeal{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
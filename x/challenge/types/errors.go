@@ -24,4 +24,12 @@ var (
 	ErrNotInturnChallenger     = errors.Register(ModuleName, 16, "challenger is not in turn")
 	ErrInvalidParams           = errors.Register(ModuleName, 17, "invalid params")
 	ErrCannotFindGVG           = errors.Register(ModuleName, 18, "fail to find global virtual group for the object")
+	ErrChallengerRateLimited   = errors.Register(ModuleName, 19, "too many challenges submitted by the challenger")
+	ErrInsufficientBond        = errors.Register(ModuleName, 20, "insufficient balance for challenger bond")
+	ErrSlashEscrowNotFound     = errors.Register(ModuleName, 21, "slash escrow not found")
+	ErrSlashAlreadyAppealed    = errors.Register(ModuleName, 22, "slash has already been appealed")
+	ErrSlashNotAppealed        = errors.Register(ModuleName, 23, "slash has not been appealed")
+	ErrInvalidAppealSignature  = errors.Register(ModuleName, 24, "invalid appeal signature")
+	ErrObjectUnderMove         = errors.Register(ModuleName, 25, "the object is being migrated or swapped out")
+	ErrInvalidSegmentProof     = errors.Register(ModuleName, 26, "invalid segment proof")
 )
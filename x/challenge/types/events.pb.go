@@ -42,6 +42,11 @@ type EventStartChallenge struct {
 	ChallengerAddress string `protobuf:"bytes,7,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
 	// The challenge will be expired after this height
 	ExpiredHeight uint64 `protobuf:"varint,8,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty"`
+	// All the sampled segment/piece indexes of the object info, the first one is the same as segment_index.
+	SegmentIndexes []uint32 `protobuf:"varint,9,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+	// The id of the storage provider to be challenged, for the submitted challenges sp_id is the primary storage
+	// provider of the bucket, which can be different from the challenged one.
+	ChallengedSpId uint32 `protobuf:"varint,10,opt,name=challenged_sp_id,json=challengedSpId,proto3" json:"challenged_sp_id,omitempty"`
 }
 
 func (m *EventStartChallenge) Reset()         { *m = EventStartChallenge{} }
@@ -126,6 +131,20 @@ func (m *EventStartChallenge) GetExpiredHeight() uint64 {
 	return 0
 }
 
+func (m *EventStartChallenge) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+func (m *EventStartChallenge) GetChallengedSpId() uint32 {
+	if m != nil {
+		return m.ChallengedSpId
+	}
+	return 0
+}
+
 // EventAttestChallenge to indicate a challenge has been attested.
 type EventAttestChallenge struct {
 	// The id of challenge.
@@ -244,297 +263,2092 @@ func (m *EventAttestChallenge) GetValidatorRewardAmount() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventStartChallenge)(nil), "greenfield.challenge.EventStartChallenge")
-	proto.RegisterType((*EventAttestChallenge)(nil), "greenfield.challenge.EventAttestChallenge")
+// EventSlashCapped to indicate the slash of a succeed challenge is zeroed, since the storage provider has reached
+// the max slash amount in the current slash counting window.
+type EventSlashCapped struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The storage provider to slash.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The id of the object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The slash amount which is zeroed.
+	CappedAmount string `protobuf:"bytes,4,opt,name=capped_amount,json=cappedAmount,proto3" json:"capped_amount,omitempty"`
+	// The slashed amount of the storage provider in the current window.
+	WindowSlashAmount string `protobuf:"bytes,5,opt,name=window_slash_amount,json=windowSlashAmount,proto3" json:"window_slash_amount,omitempty"`
+	// The max slash amount of a storage provider in a window.
+	MaxSlashAmount string `protobuf:"bytes,6,opt,name=max_slash_amount,json=maxSlashAmount,proto3" json:"max_slash_amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/challenge/events.proto", fileDescriptor_e9eaa4bfadaa20f8) }
-
-var fileDescriptor_e9eaa4bfadaa20f8 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8e, 0xff, 0x5c, 0xfe, 0x66, 0x92, 0x94, 0xd6, 0x09, 0xd4, 0x14, 0xc9, 0x75, 0x8a, 0x90,
-	0xc2, 0x22, 0x89, 0x00, 0xa9, 0xea, 0x36, 0x45, 0x15, 0x8d, 0x58, 0x20, 0xb9, 0x82, 0x05, 0x1b,
-	0xcb, 0xf6, 0x1c, 0x6c, 0x23, 0x67, 0xc6, 0x9a, 0x99, 0x94, 0xf4, 0x2d, 0xe0, 0x5d, 0xfa, 0x10,
-	0x5d, 0x56, 0x5d, 0x21, 0x16, 0x15, 0x4a, 0xc4, 0x7b, 0x20, 0x8f, 0x27, 0x76, 0x82, 0x8a, 0x50,
-	0x76, 0x9e, 0xef, 0x32, 0xdf, 0xf1, 0x39, 0x73, 0x50, 0x37, 0x60, 0x00, 0xe4, 0x53, 0x04, 0x31,
-	0x1e, 0xfa, 0xa1, 0x1b, 0xc7, 0x40, 0x02, 0x18, 0xc2, 0x05, 0x10, 0xc1, 0x07, 0x09, 0xa3, 0x82,
-	0xea, 0x9d, 0x42, 0x32, 0xc8, 0x25, 0xfb, 0x8f, 0x7d, 0xca, 0x27, 0x94, 0x3b, 0x52, 0x33, 0xcc,
-	0x0e, 0x99, 0x61, 0xbf, 0x13, 0xd0, 0x80, 0x66, 0x78, 0xfa, 0xa5, 0x50, 0xeb, 0xde, 0x24, 0x71,
-	0x99, 0x80, 0xf2, 0x1d, 0x7e, 0x2b, 0xa3, 0xf6, 0x69, 0x9a, 0x7c, 0x2e, 0x5c, 0x26, 0x5e, 0x2f,
-	0x35, 0x7a, 0x17, 0x35, 0x73, 0x83, 0x13, 0x61, 0x43, 0xb3, 0xb4, 0x5e, 0xc5, 0x6e, 0xe4, 0xd8,
-	0x18, 0xeb, 0xc7, 0xa8, 0x4e, 0xbd, 0xcf, 0xe0, 0x8b, 0x94, 0xff, 0xcf, 0xd2, 0x7a, 0xf5, 0x93,
-	0x27, 0xd7, 0x77, 0x07, 0xa5, 0x1f, 0x77, 0x07, 0x95, 0xf7, 0x11, 0x11, 0xb7, 0x57, 0xfd, 0x86,
-	0xaa, 0x31, 0x3d, 0xda, 0x5b, 0x99, 0x7a, 0x8c, 0xf5, 0xa7, 0xa8, 0xc5, 0x21, 0x98, 0x00, 0x11,
-	0x4e, 0x44, 0x30, 0xcc, 0x8c, 0xb2, 0xa5, 0xf5, 0x5a, 0x76, 0x53, 0x81, 0xe3, 0x14, 0xd3, 0xdb,
-	0xa8, 0xca, 0x93, 0xf4, 0xea, 0x8a, 0x24, 0x2b, 0x3c, 0x19, 0x63, 0xfd, 0x0c, 0xb5, 0x79, 0xe2,
-	0xd0, 0x04, 0x98, 0x2b, 0x28, 0x73, 0x5c, 0x8c, 0x19, 0x70, 0x6e, 0x54, 0x65, 0xba, 0x71, 0x7b,
-	0xd5, 0xef, 0xa8, 0xc4, 0x51, 0xc6, 0x9c, 0x0b, 0x16, 0x91, 0xc0, 0xde, 0xe5, 0xc9, 0x3b, 0xe5,
-	0x51, 0x84, 0xfe, 0x1c, 0xed, 0x30, 0xc0, 0x53, 0x82, 0x5d, 0xe2, 0x5f, 0xaa, 0x32, 0x6a, 0x96,
-	0xd6, 0xab, 0xda, 0x0f, 0x0a, 0x3c, 0xab, 0xe4, 0x0d, 0xd2, 0xf3, 0xff, 0x2e, 0x32, 0xff, 0xff,
-	0x57, 0x66, 0xe1, 0x59, 0x66, 0x3e, 0x43, 0xdb, 0x30, 0x4b, 0x22, 0x06, 0xd8, 0x09, 0x21, 0x0a,
-	0x42, 0x61, 0x6c, 0xc9, 0xb6, 0xb6, 0x14, 0x7a, 0x26, 0xc1, 0xc3, 0x5f, 0x65, 0xd4, 0x91, 0x33,
-	0x19, 0x09, 0x01, 0x7c, 0xd3, 0xa1, 0xd4, 0x18, 0xf0, 0x69, 0x2c, 0xe4, 0x44, 0xb6, 0x5f, 0x5a,
-	0x83, 0xfb, 0x5e, 0xd2, 0xe0, 0x03, 0x15, 0x60, 0x4b, 0x9d, 0xad, 0xf4, 0x45, 0xbf, 0xcb, 0x2b,
-	0xfd, 0xee, 0xa2, 0x26, 0x8f, 0x5d, 0x1e, 0x3a, 0xee, 0x84, 0x4e, 0x89, 0x90, 0xb3, 0xa8, 0xdb,
-	0x0d, 0x89, 0x8d, 0x24, 0xf4, 0x97, 0xee, 0x54, 0x37, 0xef, 0xce, 0x31, 0x32, 0x56, 0x2e, 0x62,
-	0xf0, 0xc5, 0x65, 0x78, 0x99, 0x5b, 0x93, 0xb9, 0x8f, 0x0a, 0xde, 0x96, 0xb4, 0x2a, 0xe1, 0x14,
-	0xed, 0xf2, 0xa9, 0x37, 0x89, 0x84, 0xd8, 0x60, 0x3e, 0x3b, 0xb9, 0x65, 0x59, 0xc0, 0x11, 0xda,
-	0x2b, 0xae, 0x59, 0xcf, 0xdf, 0x92, 0xf9, 0x0f, 0x73, 0x7a, 0x2d, 0xfe, 0x08, 0xed, 0x5d, 0xb8,
-	0x71, 0x84, 0xe5, 0x93, 0x5c, 0xf7, 0xa1, 0xcc, 0x97, 0xd3, 0xab, 0xbe, 0x93, 0xb7, 0xd7, 0x73,
-	0x53, 0xbb, 0x99, 0x9b, 0xda, 0xcf, 0xb9, 0xa9, 0x7d, 0x5d, 0x98, 0xa5, 0x9b, 0x85, 0x59, 0xfa,
-	0xbe, 0x30, 0x4b, 0x1f, 0x5f, 0x04, 0x91, 0x08, 0xa7, 0xde, 0xc0, 0xa7, 0x93, 0xa1, 0x47, 0xbc,
-	0xbe, 0x1f, 0xba, 0x11, 0x19, 0xae, 0x2c, 0xf3, 0xec, 0xcf, 0x75, 0xf6, 0x6a, 0x72, 0x9f, 0x5f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x86, 0xd6, 0xb3, 0x28, 0x5d, 0x04, 0x00, 0x00,
+func (m *EventSlashCapped) Reset()         { *m = EventSlashCapped{} }
+func (m *EventSlashCapped) String() string { return proto.CompactTextString(m) }
+func (*EventSlashCapped) ProtoMessage()    {}
+func (*EventSlashCapped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{2}
 }
-
-func (m *EventStartChallenge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSlashCapped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashCapped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashCapped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventStartChallenge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSlashCapped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashCapped.Merge(m, src)
+}
+func (m *EventSlashCapped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashCapped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashCapped.DiscardUnknown(m)
 }
 
-func (m *EventStartChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiredHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiredHeight))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.ChallengerAddress) > 0 {
-		i -= len(m.ChallengerAddress)
-		copy(dAtA[i:], m.ChallengerAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerAddress)))
-		i--
-		dAtA[i] = 0x3a
+var xxx_messageInfo_EventSlashCapped proto.InternalMessageInfo
+
+func (m *EventSlashCapped) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	if m.RedundancyIndex != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RedundancyIndex))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *EventSlashCapped) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	if len(m.SpOperatorAddress) > 0 {
-		i -= len(m.SpOperatorAddress)
-		copy(dAtA[i:], m.SpOperatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpOperatorAddress)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *EventSlashCapped) GetCappedAmount() string {
+	if m != nil {
+		return m.CappedAmount
 	}
-	if m.SpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *EventSlashCapped) GetWindowSlashAmount() string {
+	if m != nil {
+		return m.WindowSlashAmount
 	}
-	if m.SegmentIndex != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SegmentIndex))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventSlashCapped) GetMaxSlashAmount() string {
+	if m != nil {
+		return m.MaxSlashAmount
 	}
-	{
-		size := m.ObjectId.Size()
-		i -= size
-		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// EventExpireChallenge to indicate a succeed challenge is expired without slash, since the challenged object is
+// being moved, i.e. its bucket is migrating or the storage provider is swapping out.
+type EventExpireChallenge struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The challenged storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The id of the object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+}
+
+func (m *EventExpireChallenge) Reset()         { *m = EventExpireChallenge{} }
+func (m *EventExpireChallenge) String() string { return proto.CompactTextString(m) }
+func (*EventExpireChallenge) ProtoMessage()    {}
+func (*EventExpireChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{3}
+}
+func (m *EventExpireChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.ChallengeId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventExpireChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireChallenge.Merge(m, src)
+}
+func (m *EventExpireChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpireChallenge proto.InternalMessageInfo
+
+func (m *EventExpireChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventAttestChallenge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventExpireChallenge) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventAttestChallenge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSettleChallengeBond to indicate the bond of a user submitted challenge has been settled.
+type EventSettleChallengeBond struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The address of challenger.
+	ChallengerAddress string `protobuf:"bytes,2,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// Whether the challenge succeeded.
+	Succeed bool `protobuf:"varint,3,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	// The bond amount refunded to the challenger.
+	RefundAmount string `protobuf:"bytes,4,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	// The bond amount burned.
+	BurnAmount string `protobuf:"bytes,5,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount,omitempty"`
 }
 
-func (m *EventAttestChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorRewardAmount) > 0 {
-		i -= len(m.ValidatorRewardAmount)
-		copy(dAtA[i:], m.ValidatorRewardAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorRewardAmount)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.SubmitterRewardAmount) > 0 {
-		i -= len(m.SubmitterRewardAmount)
-		copy(dAtA[i:], m.SubmitterRewardAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubmitterRewardAmount)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SubmitterAddress) > 0 {
-		i -= len(m.SubmitterAddress)
-		copy(dAtA[i:], m.SubmitterAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubmitterAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ChallengerRewardAmount) > 0 {
-		i -= len(m.ChallengerRewardAmount)
-		copy(dAtA[i:], m.ChallengerRewardAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerRewardAmount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ChallengerAddress) > 0 {
-		i -= len(m.ChallengerAddress)
-		copy(dAtA[i:], m.ChallengerAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerAddress)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventSettleChallengeBond) Reset()         { *m = EventSettleChallengeBond{} }
+func (m *EventSettleChallengeBond) String() string { return proto.CompactTextString(m) }
+func (*EventSettleChallengeBond) ProtoMessage()    {}
+func (*EventSettleChallengeBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{4}
+}
+func (m *EventSettleChallengeBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSettleChallengeBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSettleChallengeBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.SlashAmount) > 0 {
-		i -= len(m.SlashAmount)
-		copy(dAtA[i:], m.SlashAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashAmount)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventSettleChallengeBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSettleChallengeBond.Merge(m, src)
+}
+func (m *EventSettleChallengeBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSettleChallengeBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSettleChallengeBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSettleChallengeBond proto.InternalMessageInfo
+
+func (m *EventSettleChallengeBond) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	if m.SpId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *EventSettleChallengeBond) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
 	}
-	if m.Result != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventSettleChallengeBond) GetSucceed() bool {
+	if m != nil {
+		return m.Succeed
 	}
-	if m.ChallengeId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *EventSettleChallengeBond) GetRefundAmount() string {
+	if m != nil {
+		return m.RefundAmount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventSettleChallengeBond) GetBurnAmount() string {
+	if m != nil {
+		return m.BurnAmount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventStartChallenge) Size() (n int) {
-	if m == nil {
-		return 0
+
+// EventEscrowSlash to indicate the slash of a storage provider is held in escrow.
+type EventEscrowSlash struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The slashed storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The escrowed slash amount.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The height at which the escrow will be released.
+	ReleaseHeight uint64 `protobuf:"varint,4,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *EventEscrowSlash) Reset()         { *m = EventEscrowSlash{} }
+func (m *EventEscrowSlash) String() string { return proto.CompactTextString(m) }
+func (*EventEscrowSlash) ProtoMessage()    {}
+func (*EventEscrowSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{5}
+}
+func (m *EventEscrowSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEscrowSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEscrowSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEscrowSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEscrowSlash.Merge(m, src)
+}
+func (m *EventEscrowSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEscrowSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEscrowSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEscrowSlash proto.InternalMessageInfo
+
+func (m *EventEscrowSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventEscrowSlash) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventEscrowSlash) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventEscrowSlash) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// EventAppealSlash to indicate a storage provider has appealed an escrowed slash.
+type EventAppealSlash struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The slashed storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The operator address of the storage provider.
+	SpOperatorAddress string `protobuf:"bytes,3,opt,name=sp_operator_address,json=spOperatorAddress,proto3" json:"sp_operator_address,omitempty"`
+	// The height at which the escrow would have been released, the appealed escrow is kept until the appeal is attested.
+	ReleaseHeight uint64 `protobuf:"varint,4,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *EventAppealSlash) Reset()         { *m = EventAppealSlash{} }
+func (m *EventAppealSlash) String() string { return proto.CompactTextString(m) }
+func (*EventAppealSlash) ProtoMessage()    {}
+func (*EventAppealSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{6}
+}
+func (m *EventAppealSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAppealSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAppealSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAppealSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAppealSlash.Merge(m, src)
+}
+func (m *EventAppealSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAppealSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAppealSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAppealSlash proto.InternalMessageInfo
+
+func (m *EventAppealSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventAppealSlash) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventAppealSlash) GetSpOperatorAddress() string {
+	if m != nil {
+		return m.SpOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventAppealSlash) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// EventSettleSlashEscrow to indicate an escrowed slash has been settled.
+type EventSettleSlashEscrow struct {
+	// The id of challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The slashed storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The escrowed slash amount.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// How the escrowed slash is settled.
+	Result SlashEscrowResult `protobuf:"varint,4,opt,name=result,proto3,enum=greenfield.challenge.SlashEscrowResult" json:"result,omitempty"`
+}
+
+func (m *EventSettleSlashEscrow) Reset()         { *m = EventSettleSlashEscrow{} }
+func (m *EventSettleSlashEscrow) String() string { return proto.CompactTextString(m) }
+func (*EventSettleSlashEscrow) ProtoMessage()    {}
+func (*EventSettleSlashEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eaa4bfadaa20f8, []int{7}
+}
+func (m *EventSettleSlashEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSettleSlashEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSettleSlashEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSettleSlashEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSettleSlashEscrow.Merge(m, src)
+}
+func (m *EventSettleSlashEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSettleSlashEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSettleSlashEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSettleSlashEscrow proto.InternalMessageInfo
+
+func (m *EventSettleSlashEscrow) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *EventSettleSlashEscrow) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventSettleSlashEscrow) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventSettleSlashEscrow) GetResult() SlashEscrowResult {
+	if m != nil {
+		return m.Result
+	}
+	return SLASH_ESCROW_DISTRIBUTED
+}
+
+func init() {
+	proto.RegisterType((*EventStartChallenge)(nil), "greenfield.challenge.EventStartChallenge")
+	proto.RegisterType((*EventAttestChallenge)(nil), "greenfield.challenge.EventAttestChallenge")
+	proto.RegisterType((*EventSlashCapped)(nil), "greenfield.challenge.EventSlashCapped")
+	proto.RegisterType((*EventExpireChallenge)(nil), "greenfield.challenge.EventExpireChallenge")
+	proto.RegisterType((*EventSettleChallengeBond)(nil), "greenfield.challenge.EventSettleChallengeBond")
+	proto.RegisterType((*EventEscrowSlash)(nil), "greenfield.challenge.EventEscrowSlash")
+	proto.RegisterType((*EventAppealSlash)(nil), "greenfield.challenge.EventAppealSlash")
+	proto.RegisterType((*EventSettleSlashEscrow)(nil), "greenfield.challenge.EventSettleSlashEscrow")
+}
+
+func init() { proto.RegisterFile("greenfield/challenge/events.proto", fileDescriptor_e9eaa4bfadaa20f8) }
+
+var fileDescriptor_e9eaa4bfadaa20f8 = []byte{
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xf3, 0x6b, 0x93, 0xc9, 0x8f, 0x4d, 0x9d, 0xd0, 0x35, 0x8b, 0x94, 0xa6, 0x41, 0x68,
+	0xc3, 0xa1, 0x89, 0x00, 0x69, 0xb5, 0x37, 0x94, 0xae, 0x22, 0x36, 0xe2, 0x80, 0xe4, 0x08, 0x0e,
+	0x5c, 0x2c, 0xc7, 0xf3, 0xd6, 0x31, 0x72, 0xc6, 0xd6, 0xcc, 0x78, 0x9b, 0x3d, 0x73, 0x81, 0x1b,
+	0xff, 0x06, 0xf7, 0xbd, 0xf1, 0x0f, 0xf4, 0x58, 0xf5, 0x84, 0x38, 0x54, 0xa8, 0x05, 0xfe, 0x0e,
+	0xe4, 0x99, 0x89, 0x7f, 0x54, 0xa9, 0x68, 0xd8, 0xde, 0x32, 0xdf, 0x7b, 0xcf, 0xdf, 0xf3, 0xf7,
+	0xbd, 0x79, 0x31, 0x3a, 0x76, 0x29, 0x00, 0x79, 0xed, 0x81, 0x8f, 0x27, 0xce, 0xca, 0xf6, 0x7d,
+	0x20, 0x2e, 0x4c, 0xe0, 0x0d, 0x10, 0xce, 0xc6, 0x21, 0x0d, 0x78, 0xa0, 0xf7, 0xd2, 0x94, 0x71,
+	0x92, 0xf2, 0xf4, 0x43, 0x27, 0x60, 0xeb, 0x80, 0x59, 0x22, 0x67, 0x22, 0x0f, 0xb2, 0xe0, 0x69,
+	0xcf, 0x0d, 0xdc, 0x40, 0xe2, 0xf1, 0x2f, 0x85, 0x0e, 0x76, 0x32, 0xf1, 0xb7, 0x21, 0xa8, 0xba,
+	0xe1, 0x5f, 0x25, 0xd4, 0x9d, 0xc5, 0xcc, 0x0b, 0x6e, 0x53, 0xfe, 0x72, 0x9b, 0xa3, 0x1f, 0xa3,
+	0x66, 0x52, 0x60, 0x79, 0xd8, 0xd0, 0x06, 0xda, 0xa8, 0x6c, 0x36, 0x12, 0x6c, 0x8e, 0xf5, 0x17,
+	0xa8, 0x1e, 0x2c, 0x7f, 0x00, 0x87, 0xc7, 0xf1, 0xe2, 0x40, 0x1b, 0xd5, 0x4f, 0x3f, 0x3a, 0xbf,
+	0x3a, 0x2a, 0xfc, 0x71, 0x75, 0x54, 0xfe, 0xd6, 0x23, 0xfc, 0xf2, 0xdd, 0x49, 0x43, 0xf5, 0x18,
+	0x1f, 0xcd, 0x9a, 0xcc, 0x9e, 0x63, 0xfd, 0x63, 0xd4, 0x62, 0xe0, 0xae, 0x81, 0x70, 0xcb, 0x23,
+	0x18, 0x36, 0x46, 0x69, 0xa0, 0x8d, 0x5a, 0x66, 0x53, 0x81, 0xf3, 0x18, 0xd3, 0xbb, 0xa8, 0xc2,
+	0xc2, 0xf8, 0xd1, 0x65, 0x11, 0x2c, 0xb3, 0x70, 0x8e, 0xf5, 0x57, 0xa8, 0xcb, 0x42, 0x2b, 0x08,
+	0x81, 0xda, 0x3c, 0xa0, 0x96, 0x8d, 0x31, 0x05, 0xc6, 0x8c, 0x8a, 0x60, 0x37, 0x2e, 0xdf, 0x9d,
+	0xf4, 0x14, 0xe3, 0x54, 0x46, 0x16, 0x9c, 0x7a, 0xc4, 0x35, 0x0f, 0x58, 0xf8, 0x8d, 0xaa, 0x51,
+	0x01, 0xfd, 0x53, 0xd4, 0xa1, 0x80, 0x23, 0x82, 0x6d, 0xe2, 0xbc, 0x55, 0x6d, 0x54, 0x07, 0xda,
+	0xa8, 0x62, 0x3e, 0x4e, 0x71, 0xd9, 0xc9, 0x57, 0x48, 0x4f, 0xde, 0x3b, 0xe5, 0x7c, 0xf4, 0x5f,
+	0x9c, 0x69, 0xcd, 0x96, 0xf3, 0x13, 0xd4, 0x86, 0x4d, 0xe8, 0x51, 0xc0, 0xd6, 0x0a, 0x3c, 0x77,
+	0xc5, 0x8d, 0x9a, 0x90, 0xb5, 0xa5, 0xd0, 0x57, 0x02, 0xd4, 0x9f, 0xa1, 0xc7, 0x39, 0x79, 0x80,
+	0x19, 0xf5, 0x41, 0x69, 0xd4, 0x32, 0xdb, 0x59, 0x81, 0x80, 0xe9, 0x23, 0xd4, 0x49, 0x48, 0xb0,
+	0x25, 0xd5, 0x42, 0x42, 0xad, 0x76, 0x8a, 0x2f, 0xc2, 0x39, 0x1e, 0xfe, 0x5d, 0x42, 0x3d, 0x61,
+	0xf3, 0x94, 0x73, 0x60, 0xfb, 0xfa, 0x5c, 0xa5, 0xc0, 0x22, 0x9f, 0x0b, 0x93, 0xdb, 0x9f, 0x0f,
+	0xc6, 0xbb, 0x86, 0x73, 0xfc, 0x5d, 0xc0, 0xc1, 0x14, 0x79, 0xa6, 0xca, 0x4f, 0x2d, 0x2c, 0x65,
+	0x2c, 0x3c, 0x46, 0x4d, 0xe6, 0xdb, 0x6c, 0x65, 0xd9, 0xeb, 0x20, 0x22, 0x5c, 0xd8, 0x5b, 0x37,
+	0x1b, 0x02, 0x9b, 0x0a, 0xe8, 0x0e, 0xc1, 0x2b, 0xfb, 0x0b, 0xfe, 0x02, 0x19, 0x99, 0x07, 0x51,
+	0x38, 0xb3, 0x29, 0xde, 0xf2, 0x56, 0x05, 0xef, 0x61, 0x1a, 0x37, 0x45, 0x58, 0xb5, 0x30, 0x43,
+	0x07, 0x2c, 0x5a, 0xae, 0x3d, 0xce, 0xf7, 0xb0, 0xbc, 0x93, 0x94, 0x6c, 0x1b, 0x78, 0x8e, 0x9e,
+	0xa4, 0x8f, 0xc9, 0xf3, 0xd7, 0x04, 0xff, 0x07, 0x49, 0x38, 0x47, 0xff, 0x1c, 0x3d, 0x79, 0x63,
+	0xfb, 0x1e, 0x16, 0x53, 0x9e, 0xaf, 0x43, 0xb2, 0x2e, 0x09, 0x67, 0xeb, 0x86, 0x3f, 0x16, 0x51,
+	0x47, 0x5e, 0xe7, 0x58, 0xce, 0x97, 0x76, 0x18, 0x02, 0xbe, 0x8f, 0xc7, 0x89, 0x53, 0xc5, 0x8c,
+	0x53, 0xb9, 0x0b, 0x5e, 0xda, 0xf3, 0x82, 0x3b, 0x82, 0x3b, 0x6f, 0x72, 0x53, 0x82, 0xea, 0x1d,
+	0xc7, 0xa8, 0x7b, 0xe6, 0x11, 0x1c, 0x9c, 0x59, 0xb9, 0x79, 0x10, 0x36, 0x9b, 0x07, 0x32, 0xb4,
+	0xc8, 0x4c, 0xc5, 0x08, 0x75, 0xd6, 0xf6, 0x26, 0x9f, 0x2c, 0x4d, 0x6c, 0xaf, 0xed, 0x4d, 0x26,
+	0x73, 0xf8, 0x93, 0xa6, 0xa6, 0x7d, 0x26, 0xee, 0xd5, 0x5e, 0xd3, 0xfe, 0xb0, 0x4a, 0x0c, 0xff,
+	0xd1, 0x90, 0x21, 0x0d, 0x01, 0xce, 0xfd, 0xb4, 0x95, 0xd3, 0x80, 0xdc, 0xcb, 0x98, 0xdd, 0x57,
+	0xa1, 0xb8, 0xff, 0x55, 0x30, 0xd0, 0x23, 0x16, 0x39, 0x0e, 0x80, 0x7c, 0x81, 0x9a, 0xb9, 0x3d,
+	0xc6, 0x66, 0x51, 0x78, 0x1d, 0x91, 0xdb, 0x66, 0x49, 0x50, 0x89, 0x7f, 0x84, 0x1a, 0xcb, 0x88,
+	0x92, 0xbc, 0x49, 0x28, 0x86, 0x94, 0xe6, 0x3f, 0x6b, 0x6a, 0xf2, 0x66, 0xcc, 0xa1, 0xca, 0xb8,
+	0xff, 0xad, 0xf7, 0x21, 0xaa, 0x2a, 0x22, 0x21, 0xb6, 0xa9, 0x4e, 0xf1, 0x02, 0xa5, 0xe0, 0x83,
+	0xcd, 0x60, 0xbb, 0x40, 0xcb, 0x72, 0x81, 0x2a, 0x54, 0x2e, 0xd0, 0xe1, 0x6f, 0xdb, 0x5e, 0xa6,
+	0x61, 0x08, 0xb6, 0xff, 0x7e, 0xbd, 0xdc, 0xf1, 0x97, 0x53, 0xda, 0xff, 0x2f, 0xe7, 0x9e, 0xdd,
+	0xff, 0xaa, 0xa1, 0xc3, 0xcc, 0xc8, 0x88, 0xee, 0xa5, 0xa8, 0x0f, 0xae, 0xe7, 0x97, 0xc9, 0x6a,
+	0x2f, 0x8b, 0xd5, 0xfe, 0x6c, 0xf7, 0x6a, 0xcf, 0xb4, 0x90, 0xdf, 0xf0, 0xa7, 0x5f, 0x9f, 0x5f,
+	0xf7, 0xb5, 0x8b, 0xeb, 0xbe, 0xf6, 0xe7, 0x75, 0x5f, 0xfb, 0xe5, 0xa6, 0x5f, 0xb8, 0xb8, 0xe9,
+	0x17, 0x7e, 0xbf, 0xe9, 0x17, 0xbe, 0xff, 0xcc, 0xf5, 0xf8, 0x2a, 0x5a, 0x8e, 0x9d, 0x60, 0x3d,
+	0x59, 0x92, 0xe5, 0x89, 0xb3, 0xb2, 0x3d, 0x32, 0xc9, 0x7c, 0x8f, 0x6c, 0x6e, 0x7f, 0x91, 0x2c,
+	0xab, 0xe2, 0x93, 0xe4, 0x8b, 0x7f, 0x07, 0x00, 0x93, 0xc2, 0x05, 0x50, 0x20, 0x09, 0x00, 0x00,
+}
+
+func (m *EventStartChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStartChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStartChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChallengeId != 0 {
-		n += 1 + sovEvents(uint64(m.ChallengeId))
+	if m.ChallengedSpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengedSpId))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = m.ObjectId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SegmentIndex != 0 {
-		n += 1 + sovEvents(uint64(m.SegmentIndex))
+	if len(m.SegmentIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SegmentIndexes)*10)
+		var j1 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
+	if m.ExpiredHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiredHeight))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.SpOperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.RedundancyIndex != 0 {
-		n += 1 + sovEvents(uint64(m.RedundancyIndex))
+		i = encodeVarintEvents(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ChallengerAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SpOperatorAddress) > 0 {
+		i -= len(m.SpOperatorAddress)
+		copy(dAtA[i:], m.SpOperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpOperatorAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ExpiredHeight != 0 {
-		n += 1 + sovEvents(uint64(m.ExpiredHeight))
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SegmentIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttestChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttestChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttestChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorRewardAmount) > 0 {
+		i -= len(m.ValidatorRewardAmount)
+		copy(dAtA[i:], m.ValidatorRewardAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorRewardAmount)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SubmitterRewardAmount) > 0 {
+		i -= len(m.SubmitterRewardAmount)
+		copy(dAtA[i:], m.SubmitterRewardAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubmitterRewardAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SubmitterAddress) > 0 {
+		i -= len(m.SubmitterAddress)
+		copy(dAtA[i:], m.SubmitterAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubmitterAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChallengerRewardAmount) > 0 {
+		i -= len(m.ChallengerRewardAmount)
+		copy(dAtA[i:], m.ChallengerRewardAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerRewardAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashAmount) > 0 {
+		i -= len(m.SlashAmount)
+		copy(dAtA[i:], m.SlashAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashCapped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashCapped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashCapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxSlashAmount) > 0 {
+		i -= len(m.MaxSlashAmount)
+		copy(dAtA[i:], m.MaxSlashAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxSlashAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WindowSlashAmount) > 0 {
+		i -= len(m.WindowSlashAmount)
+		copy(dAtA[i:], m.WindowSlashAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WindowSlashAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CappedAmount) > 0 {
+		i -= len(m.CappedAmount)
+		copy(dAtA[i:], m.CappedAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CappedAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpireChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleChallengeBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleChallengeBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleChallengeBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BurnAmount) > 0 {
+		i -= len(m.BurnAmount)
+		copy(dAtA[i:], m.BurnAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BurnAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RefundAmount) > 0 {
+		i -= len(m.RefundAmount)
+		copy(dAtA[i:], m.RefundAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RefundAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Succeed {
+		i--
+		if m.Succeed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEscrowSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEscrowSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEscrowSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAppealSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAppealSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAppealSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SpOperatorAddress) > 0 {
+		i -= len(m.SpOperatorAddress)
+		copy(dAtA[i:], m.SpOperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpOperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleSlashEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleSlashEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleSlashEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventStartChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SegmentIndex != 0 {
+		n += 1 + sovEvents(uint64(m.SegmentIndex))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovEvents(uint64(m.RedundancyIndex))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiredHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiredHeight))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.ChallengedSpId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengedSpId))
+	}
+	return n
+}
+
+func (m *EventAttestChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SlashAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChallengerRewardAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubmitterRewardAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValidatorRewardAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSlashCapped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.CappedAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WindowSlashAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxSlashAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExpireChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSettleChallengeBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Succeed {
+		n += 2
+	}
+	l = len(m.RefundAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BurnAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEscrowSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *EventAppealSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *EventSettleSlashEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStartChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStartChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+			}
+			m.SegmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+			}
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+			}
+			m.ExpiredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengedSpId", wireType)
+			}
+			m.ChallengedSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengedSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttestChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttestChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= VoteResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerRewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerRewardAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterRewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmitterRewardAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewardAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashCapped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashCapped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashCapped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CappedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowSlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventAttestChallenge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChallengeId != 0 {
-		n += 1 + sovEvents(uint64(m.ChallengeId))
-	}
-	if m.Result != 0 {
-		n += 1 + sovEvents(uint64(m.Result))
-	}
-	if m.SpId != 0 {
-		n += 1 + sovEvents(uint64(m.SpId))
-	}
-	l = len(m.SlashAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ChallengerAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ChallengerRewardAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SubmitterAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SubmitterRewardAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ValidatorRewardAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
+func (m *EventExpireChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -557,10 +2371,10 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStartChallenge: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExpireChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStartChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExpireChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -583,6 +2397,25 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
@@ -616,11 +2449,112 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSettleChallengeBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSettleChallengeBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSettleChallengeBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeed", wireType)
 			}
-			m.SegmentIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -630,16 +2564,17 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SegmentIndex |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Succeed = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
 			}
-			m.SpId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -649,14 +2584,27 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpOperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -684,13 +2632,63 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpOperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.BurnAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEscrowSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEscrowSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEscrowSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
 			}
-			m.RedundancyIndex = 0
+			m.ChallengeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -700,14 +2698,33 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedundancyIndex |= int32(b&0x7F) << shift
+				m.ChallengeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -735,13 +2752,13 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			m.ExpiredHeight = 0
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -751,7 +2768,7 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiredHeight |= uint64(b&0x7F) << shift
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -777,7 +2794,7 @@ func (m *EventStartChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
+func (m *EventAppealSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -800,10 +2817,10 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttestChallenge: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAppealSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttestChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -826,25 +2843,6 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= VoteResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
@@ -863,9 +2861,9 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,13 +2891,13 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashAmount = string(dAtA[iNdEx:postIndex])
+			m.SpOperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			var stringLen uint64
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -909,29 +2907,66 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerRewardAmount", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSettleSlashEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSettleSlashEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSettleSlashEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -941,29 +2976,16 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChallengeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChallengerRewardAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			var stringLen uint64
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -973,27 +2995,14 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterRewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1021,13 +3030,13 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubmitterRewardAmount = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardAmount", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1037,24 +3046,11 @@ func (m *EventAttestChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Result |= SlashEscrowResult(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorRewardAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -16,6 +16,11 @@ type SpKeeper interface {
 	GetStorageProviderByOperatorAddr(ctx sdk.Context, opAddr sdk.AccAddress) (sp *sp.StorageProvider, found bool)
 	DepositDenomForSP(ctx sdk.Context) (res string)
 	Slash(ctx sdk.Context, spID uint32, rewardInfos []sp.RewardInfo) error
+	SlashToModule(ctx sdk.Context, spID uint32, moduleName string, amount sdk.Coin) error
+	RestoreSlashedDeposit(ctx sdk.Context, spID uint32, moduleName string, amount sdk.Coin) error
+	RecordChallengeResult(ctx sdk.Context, spId uint32, passed bool)
+	Jail(ctx sdk.Context, spId uint32, reason string) error
+	RevokeJail(ctx sdk.Context, spId uint32, jailedAt int64) error
 }
 
 type StakingKeeper interface {
@@ -30,7 +35,11 @@ type StorageKeeper interface {
 	GetBucketInfo(ctx sdk.Context, bucketName string) (*storage.BucketInfo, bool)
 	MaxSegmentSize(ctx sdk.Context, timestamp int64) (res uint64, err error)
 	GetObjectGVG(ctx sdk.Context, bucketID sdkmath.Uint, lvgID uint32) (*types.GlobalVirtualGroup, bool)
+	IsSPSwappingOut(ctx sdk.Context, gvg *types.GlobalVirtualGroup, spID uint32) bool
 	MustGetPrimarySPForBucket(ctx sdk.Context, bucketInfo *storage.BucketInfo) *sp.StorageProvider
+	GetSealedObjectSampleCounts(ctx sdk.Context) []uint64
+	GetSealedObjectSampleSizes(ctx sdk.Context) []sdkmath.Uint
+	GetSealedObjectSample(ctx sdk.Context, sizeClass uint32, index uint64) (sdkmath.Uint, bool)
 }
 
 type PaymentKeeper interface {
@@ -46,4 +55,7 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
@@ -84,6 +84,60 @@ func (mr *MockSpKeeperMockRecorder) GetStorageProviderByOperatorAddr(ctx, opAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageProviderByOperatorAddr", reflect.TypeOf((*MockSpKeeper)(nil).GetStorageProviderByOperatorAddr), ctx, opAddr)
 }
 
+// Jail mocks base method.
+func (m *MockSpKeeper) Jail(ctx types2.Context, spId uint32, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, spId, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSpKeeperMockRecorder) Jail(ctx, spId, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSpKeeper)(nil).Jail), ctx, spId, reason)
+}
+
+// RecordChallengeResult mocks base method.
+func (m *MockSpKeeper) RecordChallengeResult(ctx types2.Context, spId uint32, passed bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordChallengeResult", ctx, spId, passed)
+}
+
+// RecordChallengeResult indicates an expected call of RecordChallengeResult.
+func (mr *MockSpKeeperMockRecorder) RecordChallengeResult(ctx, spId, passed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChallengeResult", reflect.TypeOf((*MockSpKeeper)(nil).RecordChallengeResult), ctx, spId, passed)
+}
+
+// RestoreSlashedDeposit mocks base method.
+func (m *MockSpKeeper) RestoreSlashedDeposit(ctx types2.Context, spID uint32, moduleName string, amount types2.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSlashedDeposit", ctx, spID, moduleName, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreSlashedDeposit indicates an expected call of RestoreSlashedDeposit.
+func (mr *MockSpKeeperMockRecorder) RestoreSlashedDeposit(ctx, spID, moduleName, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSlashedDeposit", reflect.TypeOf((*MockSpKeeper)(nil).RestoreSlashedDeposit), ctx, spID, moduleName, amount)
+}
+
+// RevokeJail mocks base method.
+func (m *MockSpKeeper) RevokeJail(ctx types2.Context, spId uint32, jailedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeJail", ctx, spId, jailedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeJail indicates an expected call of RevokeJail.
+func (mr *MockSpKeeperMockRecorder) RevokeJail(ctx, spId, jailedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeJail", reflect.TypeOf((*MockSpKeeper)(nil).RevokeJail), ctx, spId, jailedAt)
+}
+
 // Slash mocks base method.
 func (m *MockSpKeeper) Slash(ctx types2.Context, spID uint32, rewardInfos []types.RewardInfo) error {
 	m.ctrl.T.Helper()
@@ -98,6 +152,20 @@ func (mr *MockSpKeeperMockRecorder) Slash(ctx, spID, rewardInfos interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSpKeeper)(nil).Slash), ctx, spID, rewardInfos)
 }
 
+// SlashToModule mocks base method.
+func (m *MockSpKeeper) SlashToModule(ctx types2.Context, spID uint32, moduleName string, amount types2.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashToModule", ctx, spID, moduleName, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlashToModule indicates an expected call of SlashToModule.
+func (mr *MockSpKeeperMockRecorder) SlashToModule(ctx, spID, moduleName, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashToModule", reflect.TypeOf((*MockSpKeeper)(nil).SlashToModule), ctx, spID, moduleName, amount)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -247,6 +315,63 @@ func (mr *MockStorageKeeperMockRecorder) GetObjectInfoCount(ctx interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectInfoCount", reflect.TypeOf((*MockStorageKeeper)(nil).GetObjectInfoCount), ctx)
 }
 
+// GetSealedObjectSample mocks base method.
+func (m *MockStorageKeeper) GetSealedObjectSample(ctx types2.Context, sizeClass uint32, index uint64) (math.Uint, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSealedObjectSample", ctx, sizeClass, index)
+	ret0, _ := ret[0].(math.Uint)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSealedObjectSample indicates an expected call of GetSealedObjectSample.
+func (mr *MockStorageKeeperMockRecorder) GetSealedObjectSample(ctx, sizeClass, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSealedObjectSample", reflect.TypeOf((*MockStorageKeeper)(nil).GetSealedObjectSample), ctx, sizeClass, index)
+}
+
+// GetSealedObjectSampleCounts mocks base method.
+func (m *MockStorageKeeper) GetSealedObjectSampleCounts(ctx types2.Context) []uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSealedObjectSampleCounts", ctx)
+	ret0, _ := ret[0].([]uint64)
+	return ret0
+}
+
+// GetSealedObjectSampleCounts indicates an expected call of GetSealedObjectSampleCounts.
+func (mr *MockStorageKeeperMockRecorder) GetSealedObjectSampleCounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSealedObjectSampleCounts", reflect.TypeOf((*MockStorageKeeper)(nil).GetSealedObjectSampleCounts), ctx)
+}
+
+// GetSealedObjectSampleSizes mocks base method.
+func (m *MockStorageKeeper) GetSealedObjectSampleSizes(ctx types2.Context) []math.Uint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSealedObjectSampleSizes", ctx)
+	ret0, _ := ret[0].([]math.Uint)
+	return ret0
+}
+
+// GetSealedObjectSampleSizes indicates an expected call of GetSealedObjectSampleSizes.
+func (mr *MockStorageKeeperMockRecorder) GetSealedObjectSampleSizes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSealedObjectSampleSizes", reflect.TypeOf((*MockStorageKeeper)(nil).GetSealedObjectSampleSizes), ctx)
+}
+
+// IsSPSwappingOut mocks base method.
+func (m *MockStorageKeeper) IsSPSwappingOut(ctx types2.Context, gvg *types1.GlobalVirtualGroup, spID uint32) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSPSwappingOut", ctx, gvg, spID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSPSwappingOut indicates an expected call of IsSPSwappingOut.
+func (mr *MockStorageKeeperMockRecorder) IsSPSwappingOut(ctx, gvg, spID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSPSwappingOut", reflect.TypeOf((*MockStorageKeeper)(nil).IsSPSwappingOut), ctx, gvg, spID)
+}
+
 // MaxSegmentSize mocks base method.
 func (m *MockStorageKeeper) MaxSegmentSize(ctx types2.Context, timestamp int64) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -388,6 +513,48 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx types2.Context, moduleName string, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx types2.Context, senderAddr types2.AccAddress, recipientModule string, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx types2.Context, senderModule string, recipientAddr types2.AccAddress, amt types2.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx types2.Context, addr types2.AccAddress) types2.Coins {
 	m.ctrl.T.Helper()
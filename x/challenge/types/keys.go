@@ -1,5 +1,13 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bnb-chain/greenfield/internal/sequence"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "challenge"
@@ -48,4 +56,81 @@ var (
 
 	// SlashAmountKeyPrefix is the prefix to count the amount of Slash for a sp.
 	SlashAmountKeyPrefix = []byte{0x18}
+
+	// ChallengeBondKeyPrefix is the prefix to retrieve the bond of a user submitted challenge.
+	ChallengeBondKeyPrefix = []byte{0x19}
+
+	// ChallengerSubmitCountKeyPrefix is the prefix to count the submitted challenges of a challenger in the current
+	// rate limit window.
+	ChallengerSubmitCountKeyPrefix = []byte{0x1A}
+
+	// ChallengeHistoryKeyPrefix is the prefix to retrieve ChallengeRecord by challenge id.
+	ChallengeHistoryKeyPrefix = []byte{0x1B}
+
+	// SpChallengeHistoryKeyPrefix is the prefix to index ChallengeRecord by storage provider.
+	SpChallengeHistoryKeyPrefix = []byte{0x1C}
+
+	// ObjectChallengeHistoryKeyPrefix is the prefix to index ChallengeRecord by object info.
+	ObjectChallengeHistoryKeyPrefix = []byte{0x1D}
+
+	// ChallengeHistoryHeightKeyPrefix is the prefix to index ChallengeRecord by height, which is used for prune purpose.
+	ChallengeHistoryHeightKeyPrefix = []byte{0x1E}
+
+	// PendingChallengeKeyPrefix is the prefix to retrieve the details of Challenge which is not expired yet.
+	PendingChallengeKeyPrefix = []byte{0x1F}
+
+	// SpPendingChallengeKeyPrefix is the prefix to index the pending Challenge by storage provider.
+	SpPendingChallengeKeyPrefix = []byte{0x20}
+
+	// SlashEscrowKeyPrefix is the prefix to retrieve SlashEscrow by challenge id.
+	SlashEscrowKeyPrefix = []byte{0x21}
+
+	// SlashEscrowQueueKeyPrefix is the prefix to index SlashEscrow by release height.
+	SlashEscrowQueueKeyPrefix = []byte{0x22}
+
+	// FollowUpChallengeKeyPrefix is the prefix to retrieve FollowUpChallenge by object info and redundancy index.
+	FollowUpChallengeKeyPrefix = []byte{0x23}
 )
+
+// GetSpPendingChallengePrefix returns the prefix of the pending challenges of a storage provider
+func GetSpPendingChallengePrefix(spId uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spId)
+	return append(SpPendingChallengeKeyPrefix, bz...)
+}
+
+// GetSpChallengeHistoryPrefix returns the prefix of the challenge history of a storage provider
+func GetSpChallengeHistoryPrefix(spId uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, spId)
+	return append(SpChallengeHistoryKeyPrefix, bz...)
+}
+
+// GetObjectChallengeHistoryPrefix returns the prefix of the challenge history of an object info
+func GetObjectChallengeHistoryPrefix(objectId sdkmath.Uint) []byte {
+	var seq sequence.Sequence[sdkmath.Uint]
+	return append(ObjectChallengeHistoryKeyPrefix, seq.EncodeSequence(objectId)...)
+}
+
+// GetChallengeHistoryHeightPrefix returns the prefix of the challenge history attested at the height
+func GetChallengeHistoryHeightPrefix(height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return append(ChallengeHistoryHeightKeyPrefix, bz...)
+}
+
+// GetSlashEscrowQueueKey returns the key of a slash escrow in the release queue
+func GetSlashEscrowQueueKey(releaseHeight, challengeId uint64) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, releaseHeight)
+	binary.BigEndian.PutUint64(bz[8:], challengeId)
+	return append(SlashEscrowQueueKeyPrefix, bz...)
+}
+
+// GetFollowUpChallengeKey returns the key of the follow-up challenge of an object info at the redundancy index
+func GetFollowUpChallengeKey(objectId sdkmath.Uint, redundancyIndex int32) []byte {
+	var seq sequence.Sequence[sdkmath.Uint]
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(redundancyIndex))
+	return append(append(FollowUpChallengeKeyPrefix, seq.EncodeSequence(objectId)...), bz...)
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAppealSlash = "appeal_slash"
+
+var _ sdk.Msg = &MsgAppealSlash{}
+
+func NewMsgAppealSlash(spOperator sdk.AccAddress, challengeId uint64, segmentProofs [][]byte, pieceHashes [][]byte,
+	blsSignature []byte) *MsgAppealSlash {
+	return &MsgAppealSlash{
+		SpOperatorAddress: spOperator.String(),
+		ChallengeId:       challengeId,
+		SegmentProofs:     segmentProofs,
+		PieceHashes:       pieceHashes,
+		BlsSignature:      blsSignature,
+	}
+}
+
+func (msg *MsgAppealSlash) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAppealSlash) Type() string {
+	return TypeMsgAppealSlash
+}
+
+func (msg *MsgAppealSlash) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpOperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgAppealSlash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAppealSlash) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.SpOperatorAddress)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sp operator address (%s)", err)
+	}
+
+	if len(msg.SegmentProofs) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "segment proofs cannot be empty")
+	}
+	for _, proof := range msg.SegmentProofs {
+		if len(proof) == 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "segment proof cannot be empty")
+		}
+	}
+
+	if len(msg.PieceHashes) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "piece hashes cannot be empty")
+	}
+	for _, hash := range msg.PieceHashes {
+		if len(hash) != sha256.Size {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "length of piece hash is invalid")
+		}
+	}
+
+	if len(msg.BlsSignature) != BlsSignatureLength {
+		return errors.Wrap(ErrInvalidAppealSignature, "length of bls signature is invalid")
+	}
+
+	return nil
+}
+
+// GetBlsSignBytes returns the bytes signed by the storage provider for the appeal.
+func (msg *MsgAppealSlash) GetBlsSignBytes(chainId string) [32]byte {
+	challengeIdBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(challengeIdBz, msg.ChallengeId)
+	// hash the proofs one by one, since their lengths vary
+	proofHashes := make([][]byte, 0, len(msg.SegmentProofs))
+	for _, proof := range msg.SegmentProofs {
+		proofHashes = append(proofHashes, sdk.Keccak256(proof))
+	}
+	proofHash := sdk.Keccak256(proofHashes...)
+	pieceHashesHash := sdk.Keccak256(msg.PieceHashes...)
+
+	bs := make([]byte, 0)
+	bs = append(bs, []byte(chainId)...)
+	bs = append(bs, []byte(TypeMsgAppealSlash)...)
+	bs = append(bs, challengeIdBz...)
+	bs = append(bs, proofHash...)
+	bs = append(bs, pieceHashesHash...)
+	hash := sdk.Keccak256Hash(bs)
+	return hash
+}
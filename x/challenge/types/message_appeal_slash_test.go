@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+)
+
+func TestMsgAppealSlash_ValidateBasic(t *testing.T) {
+	var sig [96]byte
+	tests := []struct {
+		name string
+		msg  MsgAppealSlash
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty segment proof",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty segment proof in proofs",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				SegmentProofs:     [][]byte{{1, 2, 3}, {}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty piece hashes",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				SegmentProofs:     [][]byte{{1, 2, 3}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid piece hash",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				SegmentProofs:     [][]byte{{1, 2, 3}},
+				PieceHashes:       [][]byte{{1, 2, 3}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid bls signature",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				SegmentProofs:     [][]byte{{1, 2, 3}},
+				PieceHashes:       [][]byte{sdk.Keccak256([]byte{1, 2, 3})},
+				BlsSignature:      []byte{1, 2, 3},
+			},
+			err: ErrInvalidAppealSignature,
+		}, {
+			name: "valid message",
+			msg: MsgAppealSlash{
+				SpOperatorAddress: sample.RandAccAddressHex(),
+				ChallengeId:       1,
+				SegmentProofs:     [][]byte{{1, 2, 3}},
+				PieceHashes:       [][]byte{sdk.Keccak256([]byte{1, 2, 3})},
+				BlsSignature:      sig[:],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAppealSlash_GetBlsSignBytes(t *testing.T) {
+	msg := MsgAppealSlash{ChallengeId: 1, SegmentProofs: [][]byte{{1, 2, 3}}}
+	other := MsgAppealSlash{ChallengeId: 1, SegmentProofs: [][]byte{{1, 2, 4}}}
+	require.NotEqual(t, msg.GetBlsSignBytes("greenfield_9000-1"), other.GetBlsSignBytes("greenfield_9000-1"))
+	require.NotEqual(t, msg.GetBlsSignBytes("greenfield_9000-1"), msg.GetBlsSignBytes("greenfield_1017-1"))
+	other = MsgAppealSlash{ChallengeId: 1, SegmentProofs: [][]byte{{1, 2, 3}}, PieceHashes: [][]byte{{1}}}
+	require.NotEqual(t, msg.GetBlsSignBytes("greenfield_9000-1"), other.GetBlsSignBytes("greenfield_9000-1"))
+	other = MsgAppealSlash{ChallengeId: 1, SegmentProofs: [][]byte{{1, 2}, {3}}}
+	require.NotEqual(t, msg.GetBlsSignBytes("greenfield_9000-1"), other.GetBlsSignBytes("greenfield_9000-1"))
+}
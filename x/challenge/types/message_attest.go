@@ -77,6 +77,19 @@ func (msg *MsgAttest) ValidateBasic() error {
 		return errors.Wrap(ErrInvalidVoteAggSignature, "length of aggregated signature is invalid")
 	}
 
+	if len(msg.FailedSegmentIndexes) > 0 {
+		if msg.VoteResult != CHALLENGE_SUCCEED {
+			return errors.Wrap(ErrInvalidSegmentIndex, "failed segment indexes should be empty when the challenge fails")
+		}
+		indexes := make(map[uint32]struct{}, len(msg.FailedSegmentIndexes))
+		for _, index := range msg.FailedSegmentIndexes {
+			if _, ok := indexes[index]; ok {
+				return errors.Wrapf(ErrInvalidSegmentIndex, "duplicated failed segment index %d", index)
+			}
+			indexes[index] = struct{}{}
+		}
+	}
+
 	return nil
 }
 
@@ -100,6 +113,11 @@ func (msg *MsgAttest) GetBlsSignBytes(chainId string) [32]byte {
 	bs = append(bs, resultBz...)
 	bs = append(bs, spOperatorBz...)
 	bs = append(bs, challengerBz...)
+	for _, index := range msg.FailedSegmentIndexes {
+		indexBz := make([]byte, 4)
+		binary.BigEndian.PutUint32(indexBz, index)
+		bs = append(bs, indexBz...)
+	}
 	hash := sdk.Keccak256Hash(bs)
 	return hash
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAttestAppeal = "attest_appeal"
+
+var _ sdk.Msg = &MsgAttestAppeal{}
+
+func NewMsgAttestAppeal(submitter sdk.AccAddress, challengeId uint64, upheld bool,
+	voteValidatorSet []uint64, voteAggSignature []byte) *MsgAttestAppeal {
+	return &MsgAttestAppeal{
+		Submitter:        submitter.String(),
+		ChallengeId:      challengeId,
+		Upheld:           upheld,
+		VoteValidatorSet: voteValidatorSet,
+		VoteAggSignature: voteAggSignature,
+	}
+}
+
+func (msg *MsgAttestAppeal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAttestAppeal) Type() string {
+	return TypeMsgAttestAppeal
+}
+
+func (msg *MsgAttestAppeal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAttestAppeal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAttestAppeal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Submitter)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address (%s)", err)
+	}
+
+	if len(msg.VoteValidatorSet) == 0 {
+		return errors.Wrap(ErrInvalidVoteValidatorSet, "vote validator set cannot be empty")
+	}
+
+	if len(msg.VoteAggSignature) != BlsSignatureLength {
+		return errors.Wrap(ErrInvalidVoteAggSignature, "length of aggregated signature is invalid")
+	}
+
+	return nil
+}
+
+func (msg *MsgAttestAppeal) GetBlsSignBytes(chainId string) [32]byte {
+	challengeIdBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(challengeIdBz, msg.ChallengeId)
+	upheldBz := []byte{0}
+	if msg.Upheld {
+		upheldBz = []byte{1}
+	}
+
+	bs := make([]byte, 0)
+	bs = append(bs, []byte(chainId)...)
+	bs = append(bs, []byte(TypeMsgAttestAppeal)...)
+	bs = append(bs, challengeIdBz...)
+	bs = append(bs, upheldBz...)
+	hash := sdk.Keccak256Hash(bs)
+	return hash
+}
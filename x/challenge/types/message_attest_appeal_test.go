@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+)
+
+func TestMsgAttestAppeal_ValidateBasic(t *testing.T) {
+	var sig [96]byte
+	tests := []struct {
+		name string
+		msg  MsgAttestAppeal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAttestAppeal{
+				Submitter: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid vote validator set",
+			msg: MsgAttestAppeal{
+				Submitter:        sample.RandAccAddressHex(),
+				ChallengeId:      1,
+				VoteValidatorSet: make([]uint64, 0),
+			},
+			err: ErrInvalidVoteValidatorSet,
+		}, {
+			name: "invalid vote aggregated signature",
+			msg: MsgAttestAppeal{
+				Submitter:        sample.RandAccAddressHex(),
+				ChallengeId:      1,
+				VoteValidatorSet: []uint64{1},
+				VoteAggSignature: []byte{1, 2, 3},
+			},
+			err: ErrInvalidVoteAggSignature,
+		}, {
+			name: "valid message",
+			msg: MsgAttestAppeal{
+				Submitter:        sample.RandAccAddressHex(),
+				ChallengeId:      1,
+				Upheld:           true,
+				VoteValidatorSet: []uint64{1},
+				VoteAggSignature: sig[:],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAttestAppeal_GetBlsSignBytes(t *testing.T) {
+	upheld := MsgAttestAppeal{ChallengeId: 1, Upheld: true}
+	rejected := MsgAttestAppeal{ChallengeId: 1, Upheld: false}
+	require.NotEqual(t, upheld.GetBlsSignBytes("greenfield_9000-1"), rejected.GetBlsSignBytes("greenfield_9000-1"))
+}
@@ -49,6 +49,28 @@ func TestMsgAttest_ValidateBasic(t *testing.T) {
 				VoteAggSignature:  []byte{1, 2, 3},
 			},
 			err: ErrInvalidVoteAggSignature,
+		}, {
+			name: "failed segment indexes for failed challenge",
+			msg: MsgAttest{
+				Submitter:            sample.RandAccAddressHex(),
+				SpOperatorAddress:    sample.RandAccAddressHex(),
+				VoteResult:           CHALLENGE_FAILED,
+				VoteValidatorSet:     []uint64{1},
+				VoteAggSignature:     sig[:],
+				FailedSegmentIndexes: []uint32{1},
+			},
+			err: ErrInvalidSegmentIndex,
+		}, {
+			name: "duplicated failed segment indexes",
+			msg: MsgAttest{
+				Submitter:            sample.RandAccAddressHex(),
+				SpOperatorAddress:    sample.RandAccAddressHex(),
+				VoteResult:           CHALLENGE_SUCCEED,
+				VoteValidatorSet:     []uint64{1},
+				VoteAggSignature:     sig[:],
+				FailedSegmentIndexes: []uint32{1, 1},
+			},
+			err: ErrInvalidSegmentIndex,
 		}, {
 			name: "valid message",
 			msg: MsgAttest{
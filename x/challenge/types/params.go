@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -83,6 +85,84 @@ var (
 	DefaultSpSlashCountingWindow = uint64(43200) // about one day
 )
 
+var (
+	KeySelectionMode     = []byte("SelectionMode")
+	DefaultSelectionMode = CHALLENGE_SELECTION_MODE_UNIFORM
+)
+
+var (
+	KeySpSlashRiskWeight     = []byte("SpSlashRiskWeight")
+	DefaultSpSlashRiskWeight = sdk.NewDec(1)
+)
+
+var (
+	KeyChallengerBond     = []byte("ChallengerBond")
+	DefaultChallengerBond = math.NewIntFromBigInt(big.NewInt(1e16)) // 0.01 bnb
+)
+
+var (
+	KeyChallengerBondBurnRatio     = []byte("ChallengerBondBurnRatio")
+	DefaultChallengerBondBurnRatio = sdk.NewDecWithPrec(5, 1)
+)
+
+var (
+	KeyChallengerRateLimit            = []byte("ChallengerRateLimit")
+	DefaultChallengerRateLimit uint64 = 10
+)
+
+var (
+	KeyChallengerRateLimitWindow            = []byte("ChallengerRateLimitWindow")
+	DefaultChallengerRateLimitWindow uint64 = 1200 // about one hour
+)
+
+var (
+	KeyChallengeHistoryKeptBlocks            = []byte("ChallengeHistoryKeptBlocks")
+	DefaultChallengeHistoryKeptBlocks uint64 = 1296000 // about thirty days
+)
+
+var (
+	KeySlashEscrowPeriod            = []byte("SlashEscrowPeriod")
+	DefaultSlashEscrowPeriod uint64 = 0 // slash immediately
+)
+
+var (
+	KeyChallengeSegmentCount            = []byte("ChallengeSegmentCount")
+	DefaultChallengeSegmentCount uint64 = 1
+)
+
+var (
+	KeyChallengeRedundancyCount            = []byte("ChallengeRedundancyCount")
+	DefaultChallengeRedundancyCount uint64 = 1
+)
+
+var (
+	KeySlashCurve     = []byte("SlashCurve")
+	DefaultSlashCurve = SLASH_CURVE_LINEAR
+)
+
+var (
+	KeySlashSteps     = []byte("SlashSteps")
+	DefaultSlashSteps = []SlashStep{
+		{ObjectSizeGb: 1, Amount: math.NewIntFromBigInt(big.NewInt(1e16))},
+		{ObjectSizeGb: 16, Amount: math.NewIntFromBigInt(big.NewInt(1e17))},
+		{ObjectSizeGb: 64, Amount: math.NewIntFromBigInt(big.NewInt(5e17))},
+	}
+)
+
+var (
+	KeyRedundancySlashAmountSizeRates     = []byte("RedundancySlashAmountSizeRates")
+	DefaultRedundancySlashAmountSizeRates = []RedundancySlashRate{
+		{RedundancyType: storagetypes.REDUNDANCY_EC_TYPE, SizeRate: sdk.NewDecWithPrec(85, 4)},
+		{RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, SizeRate: sdk.NewDecWithPrec(85, 4)},
+	}
+)
+
+// MaxChallengeSegmentCount is the max number of segments/pieces sampled in each challenge.
+const MaxChallengeSegmentCount = 32
+
+// MaxChallengeRedundancyCount is the max number of storage providers challenged for each sampled object.
+const MaxChallengeRedundancyCount = 7
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -104,22 +184,48 @@ func NewParams(
 	attestationKeptCount uint64,
 	spSlashMaxAmount math.Int,
 	spSlashCountingWindow uint64,
+	selectionMode ChallengeSelectionMode,
+	spSlashRiskWeight sdk.Dec,
+	challengerBond math.Int,
+	challengerBondBurnRatio sdk.Dec,
+	challengerRateLimit uint64,
+	challengerRateLimitWindow uint64,
+	challengeHistoryKeptBlocks uint64,
+	slashEscrowPeriod uint64,
+	challengeSegmentCount uint64,
+	slashCurve SlashCurve,
+	slashSteps []SlashStep,
+	redundancySlashAmountSizeRates []RedundancySlashRate,
+	challengeRedundancyCount uint64,
 ) Params {
 	return Params{
-		ChallengeCountPerBlock:    challengeCountPerBlock,
-		ChallengeKeepAlivePeriod:  challengeKeepAlivePeriod,
-		SlashCoolingOffPeriod:     slashCoolingOffPeriod,
-		SlashAmountSizeRate:       slashAmountSizeRate,
-		SlashAmountMin:            slashAmountMin,
-		SlashAmountMax:            slashAmountMax,
-		RewardValidatorRatio:      rewardValidatorRatio,
-		RewardSubmitterRatio:      rewardSubmitterRatio,
-		RewardSubmitterThreshold:  rewardSubmitterThreshold,
-		HeartbeatInterval:         heartbeatInterval,
-		AttestationInturnInterval: attestationInturnInterval,
-		AttestationKeptCount:      attestationKeptCount,
-		SpSlashMaxAmount:          spSlashMaxAmount,
-		SpSlashCountingWindow:     spSlashCountingWindow,
+		ChallengeCountPerBlock:         challengeCountPerBlock,
+		ChallengeKeepAlivePeriod:       challengeKeepAlivePeriod,
+		SlashCoolingOffPeriod:          slashCoolingOffPeriod,
+		SlashAmountSizeRate:            slashAmountSizeRate,
+		SlashAmountMin:                 slashAmountMin,
+		SlashAmountMax:                 slashAmountMax,
+		RewardValidatorRatio:           rewardValidatorRatio,
+		RewardSubmitterRatio:           rewardSubmitterRatio,
+		RewardSubmitterThreshold:       rewardSubmitterThreshold,
+		HeartbeatInterval:              heartbeatInterval,
+		AttestationInturnInterval:      attestationInturnInterval,
+		AttestationKeptCount:           attestationKeptCount,
+		SpSlashMaxAmount:               spSlashMaxAmount,
+		SpSlashCountingWindow:          spSlashCountingWindow,
+		SelectionMode:                  selectionMode,
+		SpSlashRiskWeight:              spSlashRiskWeight,
+		ChallengerBond:                 challengerBond,
+		ChallengerBondBurnRatio:        challengerBondBurnRatio,
+		ChallengerRateLimit:            challengerRateLimit,
+		ChallengerRateLimitWindow:      challengerRateLimitWindow,
+		ChallengeHistoryKeptBlocks:     challengeHistoryKeptBlocks,
+		SlashEscrowPeriod:              slashEscrowPeriod,
+		ChallengeSegmentCount:          challengeSegmentCount,
+		SlashCurve:                     slashCurve,
+		SlashSteps:                     slashSteps,
+		RedundancySlashAmountSizeRates: redundancySlashAmountSizeRates,
+		ChallengeRedundancyCount:       challengeRedundancyCount,
 	}
 }
 
@@ -140,6 +246,19 @@ func DefaultParams() Params {
 		DefaultAttestationKeptCount,
 		DefaultSpSlashMaxAmount,
 		DefaultSpSlashCountingWindow,
+		DefaultSelectionMode,
+		DefaultSpSlashRiskWeight,
+		DefaultChallengerBond,
+		DefaultChallengerBondBurnRatio,
+		DefaultChallengerRateLimit,
+		DefaultChallengerRateLimitWindow,
+		DefaultChallengeHistoryKeptBlocks,
+		DefaultSlashEscrowPeriod,
+		DefaultChallengeSegmentCount,
+		DefaultSlashCurve,
+		DefaultSlashSteps,
+		DefaultRedundancySlashAmountSizeRates,
+		DefaultChallengeRedundancyCount,
 	)
 }
 
@@ -160,6 +279,19 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAttestationKeptCount, &p.AttestationKeptCount, validateAttestationKeptCount),
 		paramtypes.NewParamSetPair(KeySpSlashMaxAmount, &p.SpSlashMaxAmount, validateSpSlashMaxAmount),
 		paramtypes.NewParamSetPair(KeySpSlashCountingWindow, &p.SpSlashCountingWindow, validateSpSlashCountingWindow),
+		paramtypes.NewParamSetPair(KeySelectionMode, &p.SelectionMode, validateSelectionMode),
+		paramtypes.NewParamSetPair(KeySpSlashRiskWeight, &p.SpSlashRiskWeight, validateSpSlashRiskWeight),
+		paramtypes.NewParamSetPair(KeyChallengerBond, &p.ChallengerBond, validateChallengerBond),
+		paramtypes.NewParamSetPair(KeyChallengerBondBurnRatio, &p.ChallengerBondBurnRatio, validateChallengerBondBurnRatio),
+		paramtypes.NewParamSetPair(KeyChallengerRateLimit, &p.ChallengerRateLimit, validateChallengerRateLimit),
+		paramtypes.NewParamSetPair(KeyChallengerRateLimitWindow, &p.ChallengerRateLimitWindow, validateChallengerRateLimitWindow),
+		paramtypes.NewParamSetPair(KeyChallengeHistoryKeptBlocks, &p.ChallengeHistoryKeptBlocks, validateChallengeHistoryKeptBlocks),
+		paramtypes.NewParamSetPair(KeySlashEscrowPeriod, &p.SlashEscrowPeriod, validateSlashEscrowPeriod),
+		paramtypes.NewParamSetPair(KeyChallengeSegmentCount, &p.ChallengeSegmentCount, validateChallengeSegmentCount),
+		paramtypes.NewParamSetPair(KeySlashCurve, &p.SlashCurve, validateSlashCurve),
+		paramtypes.NewParamSetPair(KeySlashSteps, &p.SlashSteps, validateSlashSteps),
+		paramtypes.NewParamSetPair(KeyRedundancySlashAmountSizeRates, &p.RedundancySlashAmountSizeRates, validateRedundancySlashAmountSizeRates),
+		paramtypes.NewParamSetPair(KeyChallengeRedundancyCount, &p.ChallengeRedundancyCount, validateChallengeRedundancyCount),
 	}
 }
 
@@ -229,6 +361,68 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSelectionMode(p.SelectionMode); err != nil {
+		return err
+	}
+
+	if err := validateSpSlashRiskWeight(p.SpSlashRiskWeight); err != nil {
+		return err
+	}
+
+	if err := validateChallengerBond(p.ChallengerBond); err != nil {
+		return err
+	}
+
+	if err := validateChallengerBondBurnRatio(p.ChallengerBondBurnRatio); err != nil {
+		return err
+	}
+
+	if err := validateChallengerRateLimit(p.ChallengerRateLimit); err != nil {
+		return err
+	}
+
+	if err := validateChallengerRateLimitWindow(p.ChallengerRateLimitWindow); err != nil {
+		return err
+	}
+
+	if err := validateChallengeHistoryKeptBlocks(p.ChallengeHistoryKeptBlocks); err != nil {
+		return err
+	}
+
+	if err := validateSlashEscrowPeriod(p.SlashEscrowPeriod); err != nil {
+		return err
+	}
+
+	if err := validateChallengeSegmentCount(p.ChallengeSegmentCount); err != nil {
+		return err
+	}
+
+	if err := validateSlashCurve(p.SlashCurve); err != nil {
+		return err
+	}
+
+	if err := validateSlashSteps(p.SlashSteps); err != nil {
+		return err
+	}
+
+	if err := validateRedundancySlashAmountSizeRates(p.RedundancySlashAmountSizeRates); err != nil {
+		return err
+	}
+
+	if err := validateChallengeRedundancyCount(p.ChallengeRedundancyCount); err != nil {
+		return err
+	}
+
+	if p.SlashCurve == SLASH_CURVE_STEPWISE && len(p.SlashSteps) == 0 {
+		return errors.New("slash steps cannot be empty for stepwise slash curve")
+	}
+
+	for _, step := range p.SlashSteps {
+		if step.Amount.LT(p.SlashAmountMin) || step.Amount.GT(p.SlashAmountMax) {
+			return fmt.Errorf("slash step amount %s is out of the range of slash amount min and max", step.Amount)
+		}
+	}
+
 	return nil
 }
 
@@ -238,6 +432,15 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// RedundancySlashAmountSizeRateMap returns the slash amount size rates keyed by redundancy type.
+func (p Params) RedundancySlashAmountSizeRateMap() map[storagetypes.RedundancyType]sdk.Dec {
+	rates := make(map[storagetypes.RedundancyType]sdk.Dec, len(p.RedundancySlashAmountSizeRates))
+	for _, rate := range p.RedundancySlashAmountSizeRates {
+		rates[rate.RedundancyType] = rate.SizeRate
+	}
+	return rates
+}
+
 // validateChallengeCountPerBlock validates the ChallengeCountPerBlock param
 func validateChallengeCountPerBlock(v interface{}) error {
 	_, ok := v.(uint64)
@@ -450,3 +653,219 @@ func validateSpSlashCountingWindow(v interface{}) error {
 
 	return nil
 }
+
+// validateSelectionMode validates the SelectionMode param
+func validateSelectionMode(v interface{}) error {
+	selectionMode, ok := v.(ChallengeSelectionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := ChallengeSelectionMode_name[int32(selectionMode)]; !ok {
+		return fmt.Errorf("unknown challenge selection mode: %d", selectionMode)
+	}
+
+	return nil
+}
+
+// validateSpSlashRiskWeight validates the SpSlashRiskWeight param
+func validateSpSlashRiskWeight(v interface{}) error {
+	spSlashRiskWeight, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if spSlashRiskWeight.IsNil() {
+		return errors.New("sp slash risk weight cannot be nil")
+	}
+
+	if spSlashRiskWeight.LT(sdk.ZeroDec()) {
+		return errors.New("sp slash risk weight cannot be lower than zero")
+	}
+
+	return nil
+}
+
+// validateChallengerBond validates the ChallengerBond param
+func validateChallengerBond(v interface{}) error {
+	challengerBond, ok := v.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if challengerBond.IsNil() {
+		return errors.New("challenger bond cannot be nil")
+	}
+
+	if challengerBond.LT(sdk.ZeroInt()) {
+		return errors.New("challenger bond cannot be lower than zero")
+	}
+
+	return nil
+}
+
+// validateChallengerBondBurnRatio validates the ChallengerBondBurnRatio param
+func validateChallengerBondBurnRatio(v interface{}) error {
+	burnRatio, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if burnRatio.IsNil() {
+		return errors.New("challenger bond burn ratio cannot be nil")
+	}
+
+	if burnRatio.LT(sdk.ZeroDec()) || burnRatio.GT(sdk.OneDec()) {
+		return errors.New("challenger bond burn ratio should be between zero and one")
+	}
+
+	return nil
+}
+
+// validateChallengerRateLimit validates the ChallengerRateLimit param
+func validateChallengerRateLimit(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateChallengerRateLimitWindow validates the ChallengerRateLimitWindow param
+func validateChallengerRateLimitWindow(v interface{}) error {
+	window, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if window == 0 {
+		return errors.New("challenger rate limit window cannot be zero")
+	}
+
+	return nil
+}
+
+// validateChallengeHistoryKeptBlocks validates the ChallengeHistoryKeptBlocks param
+func validateChallengeHistoryKeptBlocks(v interface{}) error {
+	keptBlocks, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if keptBlocks == 0 {
+		return errors.New("challenge history kept blocks cannot be zero")
+	}
+
+	return nil
+}
+
+// validateSlashEscrowPeriod validates the SlashEscrowPeriod param
+func validateSlashEscrowPeriod(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateChallengeSegmentCount validates the ChallengeSegmentCount param
+func validateChallengeSegmentCount(v interface{}) error {
+	count, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if count == 0 {
+		return errors.New("challenge segment count cannot be zero")
+	}
+
+	if count > MaxChallengeSegmentCount {
+		return fmt.Errorf("challenge segment count cannot be larger than %d", MaxChallengeSegmentCount)
+	}
+
+	return nil
+}
+
+// validateChallengeRedundancyCount validates the ChallengeRedundancyCount param
+func validateChallengeRedundancyCount(v interface{}) error {
+	count, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if count == 0 {
+		return errors.New("challenge redundancy count cannot be zero")
+	}
+
+	if count > MaxChallengeRedundancyCount {
+		return fmt.Errorf("challenge redundancy count cannot be larger than %d", MaxChallengeRedundancyCount)
+	}
+
+	return nil
+}
+
+// validateSlashCurve validates the SlashCurve param
+func validateSlashCurve(v interface{}) error {
+	slashCurve, ok := v.(SlashCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := SlashCurve_name[int32(slashCurve)]; !ok {
+		return fmt.Errorf("unknown slash curve: %d", slashCurve)
+	}
+
+	return nil
+}
+
+// validateSlashSteps validates the SlashSteps param
+func validateSlashSteps(v interface{}) error {
+	slashSteps, ok := v.([]SlashStep)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, step := range slashSteps {
+		if step.Amount.IsNil() {
+			return errors.New("slash step amount cannot be nil")
+		}
+		if step.Amount.IsNegative() {
+			return errors.New("slash step amount cannot be lower than zero")
+		}
+		if i > 0 && step.ObjectSizeGb <= slashSteps[i-1].ObjectSizeGb {
+			return errors.New("slash steps should be in ascending order of object size")
+		}
+	}
+
+	return nil
+}
+
+// validateRedundancySlashAmountSizeRates validates the RedundancySlashAmountSizeRates param
+func validateRedundancySlashAmountSizeRates(v interface{}) error {
+	rates, ok := v.([]RedundancySlashRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[storagetypes.RedundancyType]struct{}, len(rates))
+	for _, rate := range rates {
+		if _, ok := storagetypes.RedundancyType_name[int32(rate.RedundancyType)]; !ok {
+			return fmt.Errorf("unknown redundancy type: %d", rate.RedundancyType)
+		}
+		if _, ok := seen[rate.RedundancyType]; ok {
+			return fmt.Errorf("duplicated slash amount size rate for redundancy type %s", rate.RedundancyType)
+		}
+		seen[rate.RedundancyType] = struct{}{}
+
+		if rate.SizeRate.IsNil() {
+			return errors.New("redundancy slash amount size rate cannot be nil")
+		}
+		if rate.SizeRate.IsNegative() {
+			return errors.New("redundancy slash amount size rate cannot be lower than zero")
+		}
+	}
+
+	return nil
+}
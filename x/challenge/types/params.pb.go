@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/bnb-chain/greenfield/x/storage/types"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,6 +26,164 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ChallengeSelectionMode defines how the random challenges pick objects and storage providers.
+type ChallengeSelectionMode int32
+
+const (
+	// Objects are picked uniformly by id, and storage providers are picked uniformly from the global virtual group.
+	CHALLENGE_SELECTION_MODE_UNIFORM ChallengeSelectionMode = 0
+	// Sealed objects are picked weighted by payload size, and storage providers are picked weighted by
+	// their slash amount in the current counting window.
+	CHALLENGE_SELECTION_MODE_WEIGHTED ChallengeSelectionMode = 1
+)
+
+var ChallengeSelectionMode_name = map[int32]string{
+	0: "CHALLENGE_SELECTION_MODE_UNIFORM",
+	1: "CHALLENGE_SELECTION_MODE_WEIGHTED",
+}
+
+var ChallengeSelectionMode_value = map[string]int32{
+	"CHALLENGE_SELECTION_MODE_UNIFORM":  0,
+	"CHALLENGE_SELECTION_MODE_WEIGHTED": 1,
+}
+
+func (x ChallengeSelectionMode) String() string {
+	return proto.EnumName(ChallengeSelectionMode_name, int32(x))
+}
+
+func (ChallengeSelectionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2396367ee53edf57, []int{0}
+}
+
+// SlashCurve defines how the slash amount of a failed challenge is calculated from the object info.
+type SlashCurve int32
+
+const (
+	// The slash amount is linear in the object size with slash_amount_size_rate.
+	SLASH_CURVE_LINEAR SlashCurve = 0
+	// The slash amount is picked from slash_steps by the object size.
+	SLASH_CURVE_STEPWISE SlashCurve = 1
+	// The slash amount is linear in the object size, with the size rate of the object's redundancy type in
+	// redundancy_slash_amount_size_rates, or slash_amount_size_rate if the redundancy type is absent.
+	SLASH_CURVE_PER_REDUNDANCY_TYPE SlashCurve = 2
+)
+
+var SlashCurve_name = map[int32]string{
+	0: "SLASH_CURVE_LINEAR",
+	1: "SLASH_CURVE_STEPWISE",
+	2: "SLASH_CURVE_PER_REDUNDANCY_TYPE",
+}
+
+var SlashCurve_value = map[string]int32{
+	"SLASH_CURVE_LINEAR":              0,
+	"SLASH_CURVE_STEPWISE":            1,
+	"SLASH_CURVE_PER_REDUNDANCY_TYPE": 2,
+}
+
+func (x SlashCurve) String() string {
+	return proto.EnumName(SlashCurve_name, int32(x))
+}
+
+func (SlashCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2396367ee53edf57, []int{1}
+}
+
+// SlashStep defines a step of the stepwise slash curve.
+type SlashStep struct {
+	// The upper bound of the object size in GB of the step, inclusive.
+	ObjectSizeGb uint64 `protobuf:"varint,1,opt,name=object_size_gb,json=objectSizeGb,proto3" json:"object_size_gb,omitempty" yaml:"object_size_gb"`
+	// The slash amount of the objects in the step, which is within [slash_amount_min, slash_amount_max].
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SlashStep) Reset()         { *m = SlashStep{} }
+func (m *SlashStep) String() string { return proto.CompactTextString(m) }
+func (*SlashStep) ProtoMessage()    {}
+func (*SlashStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2396367ee53edf57, []int{0}
+}
+func (m *SlashStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashStep.Merge(m, src)
+}
+func (m *SlashStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashStep proto.InternalMessageInfo
+
+func (m *SlashStep) GetObjectSizeGb() uint64 {
+	if m != nil {
+		return m.ObjectSizeGb
+	}
+	return 0
+}
+
+// RedundancySlashRate defines the slash amount size rate of a redundancy type in the per redundancy type slash curve.
+type RedundancySlashRate struct {
+	// The redundancy type of objects.
+	RedundancyType types.RedundancyType `protobuf:"varint,1,opt,name=redundancy_type,json=redundancyType,proto3,enum=greenfield.storage.RedundancyType" json:"redundancy_type,omitempty" yaml:"redundancy_type"`
+	// The slash amount size rate of the objects with the redundancy type.
+	SizeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=size_rate,json=sizeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"size_rate" yaml:"size_rate"`
+}
+
+func (m *RedundancySlashRate) Reset()         { *m = RedundancySlashRate{} }
+func (m *RedundancySlashRate) String() string { return proto.CompactTextString(m) }
+func (*RedundancySlashRate) ProtoMessage()    {}
+func (*RedundancySlashRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2396367ee53edf57, []int{1}
+}
+func (m *RedundancySlashRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedundancySlashRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedundancySlashRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedundancySlashRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedundancySlashRate.Merge(m, src)
+}
+func (m *RedundancySlashRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedundancySlashRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedundancySlashRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedundancySlashRate proto.InternalMessageInfo
+
+func (m *RedundancySlashRate) GetRedundancyType() types.RedundancyType {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return types.REDUNDANCY_EC_TYPE
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// Challenges which will be emitted in each block, including user submitted or randomly triggered.
@@ -55,12 +214,44 @@ type Params struct {
 	SpSlashMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=sp_slash_max_amount,json=spSlashMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sp_slash_max_amount"`
 	// The number of blocks to count how much a sp had been slashed.
 	SpSlashCountingWindow uint64 `protobuf:"varint,14,opt,name=sp_slash_counting_window,json=spSlashCountingWindow,proto3" json:"sp_slash_counting_window,omitempty" yaml:"sp_slash_counting_window"`
+	// The mode to pick objects and storage providers for random challenges.
+	SelectionMode ChallengeSelectionMode `protobuf:"varint,15,opt,name=selection_mode,json=selectionMode,proto3,enum=greenfield.challenge.ChallengeSelectionMode" json:"selection_mode,omitempty" yaml:"selection_mode"`
+	// In weighted selection mode, a storage provider is picked with the weight of 1 + sp_slash_risk_weight * r,
+	// where r is the ratio of its slash amount in the current counting window to sp_slash_max_amount, capped at 1.
+	SpSlashRiskWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=sp_slash_risk_weight,json=spSlashRiskWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sp_slash_risk_weight" yaml:"sp_slash_risk_weight"`
+	// The bond locked from the challenger for each submitted challenge, it is refunded when the challenge succeeds,
+	// and partly burned when the challenge fails or expires without being attested.
+	ChallengerBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=challenger_bond,json=challengerBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"challenger_bond"`
+	// The ratio of the challenger bond to burn when the challenge fails.
+	ChallengerBondBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=challenger_bond_burn_ratio,json=challengerBondBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"challenger_bond_burn_ratio" yaml:"challenger_bond_burn_ratio"`
+	// The max number of challenges a challenger can submit in a rate limit window, 0 means no limit.
+	ChallengerRateLimit uint64 `protobuf:"varint,19,opt,name=challenger_rate_limit,json=challengerRateLimit,proto3" json:"challenger_rate_limit,omitempty" yaml:"challenger_rate_limit"`
+	// The number of blocks of the challenger rate limit window.
+	ChallengerRateLimitWindow uint64 `protobuf:"varint,20,opt,name=challenger_rate_limit_window,json=challengerRateLimitWindow,proto3" json:"challenger_rate_limit_window,omitempty" yaml:"challenger_rate_limit_window"`
+	// The number of blocks to keep the challenge history, older records are pruned.
+	ChallengeHistoryKeptBlocks uint64 `protobuf:"varint,21,opt,name=challenge_history_kept_blocks,json=challengeHistoryKeptBlocks,proto3" json:"challenge_history_kept_blocks,omitempty" yaml:"challenge_history_kept_blocks"`
+	// The number of blocks to hold the slashed funds in escrow, during which the storage provider can appeal the slash.
+	// The slash is executed immediately if it is 0.
+	SlashEscrowPeriod uint64 `protobuf:"varint,22,opt,name=slash_escrow_period,json=slashEscrowPeriod,proto3" json:"slash_escrow_period,omitempty" yaml:"slash_escrow_period"`
+	// The number of segments/pieces sampled in each challenge, the slash amount scales with the number of failed ones.
+	ChallengeSegmentCount uint64 `protobuf:"varint,23,opt,name=challenge_segment_count,json=challengeSegmentCount,proto3" json:"challenge_segment_count,omitempty" yaml:"challenge_segment_count"`
+	// The curve to calculate the slash amount of a failed challenge, the amount is always bounded by
+	// slash_amount_min and slash_amount_max.
+	SlashCurve SlashCurve `protobuf:"varint,24,opt,name=slash_curve,json=slashCurve,proto3,enum=greenfield.challenge.SlashCurve" json:"slash_curve,omitempty" yaml:"slash_curve"`
+	// The steps of the stepwise slash curve, ordered by object size. Objects larger than the last step are
+	// slashed with slash_amount_max.
+	SlashSteps []SlashStep `protobuf:"bytes,25,rep,name=slash_steps,json=slashSteps,proto3" json:"slash_steps" yaml:"slash_steps"`
+	// The slash amount size rates keyed by redundancy type in the per redundancy type slash curve, each redundancy
+	// type appears at most once.
+	RedundancySlashAmountSizeRates []RedundancySlashRate `protobuf:"bytes,26,rep,name=redundancy_slash_amount_size_rates,json=redundancySlashAmountSizeRates,proto3" json:"redundancy_slash_amount_size_rates" yaml:"redundancy_slash_amount_size_rates"`
+	// The number of storage providers (redundancy indexes) challenged for each randomly sampled object.
+	ChallengeRedundancyCount uint64 `protobuf:"varint,27,opt,name=challenge_redundancy_count,json=challengeRedundancyCount,proto3" json:"challenge_redundancy_count,omitempty" yaml:"challenge_redundancy_count"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2396367ee53edf57, []int{0}
+	return fileDescriptor_2396367ee53edf57, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,58 +329,251 @@ func (m *Params) GetSpSlashCountingWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetSelectionMode() ChallengeSelectionMode {
+	if m != nil {
+		return m.SelectionMode
+	}
+	return CHALLENGE_SELECTION_MODE_UNIFORM
+}
+
+func (m *Params) GetChallengerRateLimit() uint64 {
+	if m != nil {
+		return m.ChallengerRateLimit
+	}
+	return 0
+}
+
+func (m *Params) GetChallengerRateLimitWindow() uint64 {
+	if m != nil {
+		return m.ChallengerRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeHistoryKeptBlocks() uint64 {
+	if m != nil {
+		return m.ChallengeHistoryKeptBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSlashEscrowPeriod() uint64 {
+	if m != nil {
+		return m.SlashEscrowPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeSegmentCount() uint64 {
+	if m != nil {
+		return m.ChallengeSegmentCount
+	}
+	return 0
+}
+
+func (m *Params) GetSlashCurve() SlashCurve {
+	if m != nil {
+		return m.SlashCurve
+	}
+	return SLASH_CURVE_LINEAR
+}
+
+func (m *Params) GetSlashSteps() []SlashStep {
+	if m != nil {
+		return m.SlashSteps
+	}
+	return nil
+}
+
+func (m *Params) GetRedundancySlashAmountSizeRates() []RedundancySlashRate {
+	if m != nil {
+		return m.RedundancySlashAmountSizeRates
+	}
+	return nil
+}
+
+func (m *Params) GetChallengeRedundancyCount() uint64 {
+	if m != nil {
+		return m.ChallengeRedundancyCount
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("greenfield.challenge.ChallengeSelectionMode", ChallengeSelectionMode_name, ChallengeSelectionMode_value)
+	proto.RegisterEnum("greenfield.challenge.SlashCurve", SlashCurve_name, SlashCurve_value)
+	proto.RegisterType((*SlashStep)(nil), "greenfield.challenge.SlashStep")
+	proto.RegisterType((*RedundancySlashRate)(nil), "greenfield.challenge.RedundancySlashRate")
 	proto.RegisterType((*Params)(nil), "greenfield.challenge.Params")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/params.proto", fileDescriptor_2396367ee53edf57) }
 
 var fileDescriptor_2396367ee53edf57 = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0xdf, 0xe3, 0xf1, 0xca, 0xd0, 0x22, 0x6a, 0xd2, 0x68, 0x02, 0x22, 0x06, 0xb7, 0x42,
-	0x6c, 0x48, 0x54, 0x75, 0x87, 0xba, 0x21, 0x74, 0x13, 0x51, 0x44, 0x64, 0xaa, 0x22, 0x55, 0x95,
-	0xac, 0x89, 0x73, 0x13, 0x8f, 0x62, 0xcf, 0x58, 0xf6, 0x04, 0x02, 0xeb, 0xb6, 0xeb, 0x2e, 0xbb,
-	0xec, 0x47, 0xf4, 0x23, 0x58, 0xa2, 0xae, 0xaa, 0x2e, 0xac, 0x0a, 0xfe, 0x20, 0x5f, 0x50, 0x79,
-	0xc6, 0x71, 0x4c, 0x02, 0x95, 0x50, 0x59, 0x25, 0xb9, 0xe7, 0xdc, 0x7b, 0xce, 0xcd, 0xcc, 0xbd,
-	0x83, 0xd6, 0xbb, 0x21, 0x00, 0xeb, 0x50, 0xf0, 0xda, 0x35, 0xc7, 0x25, 0x9e, 0x07, 0xac, 0x0b,
-	0xb5, 0x80, 0x84, 0xc4, 0x8f, 0xaa, 0x41, 0xc8, 0x05, 0xd7, 0x8b, 0x63, 0x4a, 0x35, 0xa3, 0x2c,
-	0x97, 0x1d, 0x1e, 0xf9, 0x3c, 0xb2, 0x25, 0xa7, 0xa6, 0x7e, 0xa8, 0x84, 0xe5, 0x62, 0x97, 0x77,
-	0xb9, 0x8a, 0x27, 0xdf, 0x54, 0xd4, 0x1c, 0xce, 0xa3, 0xd9, 0xa6, 0xac, 0xab, 0xdb, 0xa8, 0x9c,
-	0x15, 0xb2, 0x1d, 0xde, 0x67, 0xc2, 0x0e, 0x20, 0xb4, 0x5b, 0x1e, 0x77, 0x7a, 0x58, 0x5b, 0xd3,
-	0x36, 0x67, 0xea, 0xcf, 0x86, 0xb1, 0xb1, 0x76, 0x4a, 0x7c, 0x6f, 0xdb, 0xbc, 0x95, 0x6a, 0x5a,
-	0xa5, 0x0c, 0xdb, 0x4d, 0xa0, 0x26, 0x84, 0xf5, 0x04, 0xd0, 0x01, 0xad, 0x8c, 0xb3, 0x7a, 0x00,
-	0x81, 0x4d, 0x3c, 0x7a, 0x0c, 0x49, 0x2a, 0xe5, 0x6d, 0xfc, 0x8f, 0x94, 0xd8, 0x18, 0xc6, 0x86,
-	0x39, 0x29, 0x31, 0x45, 0x36, 0x2d, 0x9c, 0xa1, 0x7b, 0x00, 0xc1, 0x4e, 0x82, 0x35, 0x25, 0xa4,
-	0xbf, 0x47, 0x38, 0xf2, 0x48, 0xe4, 0xda, 0x0e, 0xe7, 0x1e, 0x65, 0x5d, 0x9b, 0x77, 0x3a, 0x23,
-	0x8d, 0x7f, 0xa5, 0xc6, 0xd3, 0x61, 0x6c, 0x18, 0x4a, 0xe3, 0x36, 0xa6, 0x69, 0x3d, 0x91, 0xd0,
-	0xae, 0x42, 0x0e, 0x3a, 0x9d, 0xb4, 0xfa, 0x07, 0x0d, 0x95, 0x54, 0x12, 0xf1, 0x65, 0xe3, 0x11,
-	0x3d, 0x03, 0x3b, 0x24, 0x02, 0xf0, 0xcc, 0x9a, 0xb6, 0x39, 0x57, 0x3f, 0x38, 0x8f, 0x8d, 0xc2,
-	0xcf, 0xd8, 0xd8, 0xe8, 0x52, 0xe1, 0xf6, 0x5b, 0x55, 0x87, 0xfb, 0xe9, 0x41, 0xa4, 0x1f, 0x5b,
-	0x51, 0xbb, 0x57, 0x13, 0xa7, 0x01, 0x44, 0xd5, 0x57, 0xe0, 0x0c, 0x63, 0x63, 0x35, 0x6f, 0x65,
-	0xb2, 0xaa, 0x69, 0x2d, 0x49, 0x60, 0x47, 0xc6, 0x0f, 0xe9, 0x19, 0x58, 0x44, 0x80, 0xde, 0x41,
-	0x8b, 0xd7, 0xf8, 0x3e, 0x65, 0xf8, 0x3f, 0xa9, 0xff, 0xf2, 0x0e, 0xfa, 0x0d, 0x26, 0xbe, 0x7f,
-	0xdb, 0x42, 0xe9, 0x3d, 0x69, 0x30, 0x61, 0x2d, 0xe4, 0xc4, 0xf6, 0x29, 0x9b, 0xd6, 0x21, 0x03,
-	0x3c, 0x7b, 0xdf, 0x3a, 0x64, 0xa0, 0x7f, 0xd4, 0x50, 0x29, 0x84, 0x13, 0x12, 0xb6, 0xed, 0x63,
-	0xe2, 0xd1, 0x36, 0x11, 0x3c, 0x4c, 0xfa, 0xa7, 0x1c, 0xff, 0xff, 0x77, 0x7f, 0xeb, 0xcd, 0x55,
-	0x4d, 0xab, 0xa8, 0x80, 0xb7, 0xa3, 0xb8, 0x95, 0x84, 0xf5, 0x4f, 0x63, 0x1f, 0x51, 0xbf, 0xe5,
-	0x53, 0x21, 0x60, 0xe4, 0xe3, 0x81, 0xf4, 0xd1, 0xbc, 0xb3, 0x8f, 0xca, 0x35, 0x1f, 0xd9, 0xb5,
-	0x9d, 0x34, 0x72, 0x38, 0x92, 0x53, 0x46, 0xce, 0xd0, 0xf2, 0x94, 0x0f, 0xe1, 0x86, 0x10, 0xb9,
-	0xdc, 0x6b, 0xe3, 0xb9, 0x7b, 0x38, 0x02, 0x3c, 0xa1, 0xfb, 0x66, 0x54, 0x5d, 0x7f, 0x8d, 0x74,
-	0x17, 0x48, 0x28, 0x5a, 0x40, 0x84, 0x4d, 0x99, 0x80, 0xf0, 0x98, 0x78, 0x18, 0xc9, 0xd9, 0x59,
-	0x1d, 0xc6, 0x46, 0x59, 0x75, 0x34, 0xcd, 0x31, 0xad, 0xc7, 0x59, 0xb0, 0x91, 0xc6, 0xf4, 0x0e,
-	0x5a, 0x21, 0x42, 0x40, 0x24, 0x92, 0xbe, 0x58, 0xc2, 0xed, 0x87, 0x6c, 0x5c, 0x76, 0x7e, 0x72,
-	0xec, 0xff, 0x40, 0x36, 0xad, 0x72, 0x0e, 0x6d, 0x48, 0x30, 0xd3, 0x39, 0x42, 0xa5, 0x7c, 0x6a,
-	0x0f, 0x02, 0xa1, 0x76, 0x13, 0x7e, 0x28, 0x25, 0xd6, 0xc7, 0x77, 0xe2, 0x66, 0x9e, 0x69, 0x15,
-	0x73, 0xc0, 0x1e, 0x04, 0x42, 0xee, 0x2f, 0xbd, 0x87, 0x96, 0xa2, 0xc0, 0x56, 0x63, 0xe0, 0x93,
-	0x41, 0x3a, 0x0a, 0xf8, 0xd1, 0x3d, 0x9c, 0xc1, 0x62, 0x14, 0x1c, 0x26, 0x75, 0xf7, 0xc9, 0x40,
-	0xcd, 0x82, 0xdc, 0x5e, 0x23, 0x31, 0xe9, 0x2a, 0xd9, 0x4b, 0x27, 0x94, 0xb5, 0xf9, 0x09, 0x5e,
-	0x98, 0xda, 0x5e, 0xb7, 0x30, 0x93, 0xed, 0xa5, 0x0a, 0xef, 0xa6, 0xc0, 0x91, 0x8c, 0x6f, 0xcf,
-	0x7c, 0xf9, 0x6a, 0x14, 0xea, 0x7b, 0xe7, 0x97, 0x15, 0xed, 0xe2, 0xb2, 0xa2, 0xfd, 0xba, 0xac,
-	0x68, 0x9f, 0xaf, 0x2a, 0x85, 0x8b, 0xab, 0x4a, 0xe1, 0xc7, 0x55, 0xa5, 0xf0, 0xee, 0x79, 0xae,
-	0x8b, 0x16, 0x6b, 0x6d, 0x39, 0x2e, 0xa1, 0xac, 0x96, 0x7b, 0x8d, 0x06, 0xb9, 0xf7, 0x48, 0x36,
-	0xd5, 0x9a, 0x95, 0x0f, 0xc9, 0x8b, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x44, 0x79, 0xdf,
-	0xb4, 0x06, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x13, 0xd7, 0x8d, 0x37, 0x89, 0xa3, 0xac, 0x65, 0x85, 0x92, 0x13, 0x51, 0x66, 0x7e,
+	0xea, 0x04, 0x8d, 0x8c, 0xa4, 0xb7, 0xa0, 0x40, 0x61, 0xc9, 0x6c, 0x2c, 0x44, 0x96, 0x85, 0x95,
+	0x12, 0x23, 0x41, 0x80, 0x05, 0x45, 0xad, 0x24, 0x56, 0x12, 0x29, 0x70, 0x57, 0xfe, 0xc9, 0xa1,
+	0xa7, 0xb6, 0xe8, 0x31, 0xc7, 0x1e, 0x8b, 0xf6, 0xd2, 0x07, 0xe8, 0x43, 0xe4, 0x18, 0xf4, 0x54,
+	0xf4, 0xc0, 0x16, 0xc9, 0x1b, 0xe8, 0x01, 0x8a, 0x62, 0x77, 0x29, 0x91, 0xfa, 0xb1, 0x01, 0xa3,
+	0x3e, 0x59, 0x9e, 0xf9, 0xf8, 0x7d, 0x33, 0x9c, 0x9d, 0xd9, 0x21, 0x58, 0x6f, 0x79, 0x84, 0x38,
+	0x4d, 0x9b, 0x74, 0x1b, 0x9b, 0x56, 0xdb, 0xec, 0x76, 0x89, 0xd3, 0x22, 0x9b, 0x7d, 0xd3, 0x33,
+	0x7b, 0x34, 0xd7, 0xf7, 0x5c, 0xe6, 0xc2, 0x44, 0x08, 0xc9, 0x8d, 0x21, 0xe9, 0x94, 0xe5, 0xd2,
+	0x9e, 0x4b, 0xb1, 0xc0, 0x6c, 0xca, 0x7f, 0xe4, 0x03, 0xe9, 0x44, 0xcb, 0x6d, 0xb9, 0xd2, 0xce,
+	0x7f, 0x05, 0x56, 0x2d, 0xa2, 0x44, 0x99, 0xeb, 0x99, 0x2d, 0xb2, 0x69, 0xb9, 0xbd, 0x9e, 0xeb,
+	0x48, 0x80, 0xfe, 0x8b, 0x02, 0x96, 0xaa, 0x5d, 0x93, 0xb6, 0xab, 0x8c, 0xf4, 0xe1, 0x57, 0x60,
+	0xd9, 0xad, 0x7f, 0x43, 0x2c, 0x86, 0xa9, 0xfd, 0x86, 0xe0, 0x56, 0x5d, 0x55, 0xb2, 0xca, 0xc6,
+	0x42, 0x3e, 0x35, 0xf4, 0xb5, 0xd5, 0x63, 0xb3, 0xd7, 0x7d, 0xa2, 0x4f, 0xfa, 0x75, 0x74, 0x45,
+	0x1a, 0xaa, 0xf6, 0x1b, 0xf2, 0xb4, 0x0e, 0x6b, 0x60, 0xd1, 0xec, 0xb9, 0x03, 0x87, 0xa9, 0x17,
+	0xb2, 0xca, 0xc6, 0x52, 0xfe, 0xcb, 0x77, 0xbe, 0x16, 0xfb, 0xcb, 0xd7, 0xee, 0xb5, 0x6c, 0xd6,
+	0x1e, 0xd4, 0x73, 0x96, 0xdb, 0x0b, 0xc2, 0x0e, 0xfe, 0x3c, 0xa4, 0x8d, 0xce, 0x26, 0x3b, 0xee,
+	0x13, 0x9a, 0x2b, 0x3a, 0xec, 0x8f, 0xdf, 0x1f, 0x82, 0x20, 0xab, 0xa2, 0xc3, 0x50, 0xc0, 0xa5,
+	0xff, 0xad, 0x80, 0x15, 0x44, 0x1a, 0x03, 0xa7, 0x61, 0x3a, 0xd6, 0xb1, 0x08, 0x17, 0x99, 0x8c,
+	0xc0, 0x16, 0xb8, 0xe6, 0x8d, 0xcd, 0x98, 0xb3, 0x88, 0x78, 0x97, 0x1f, 0xeb, 0xb9, 0xc8, 0xeb,
+	0x0b, 0xf2, 0xce, 0x85, 0x0c, 0xb5, 0xe3, 0x3e, 0xc9, 0xa7, 0x87, 0xbe, 0x96, 0x94, 0x39, 0x4d,
+	0x91, 0xe8, 0x68, 0xd9, 0x9b, 0xc0, 0x42, 0x0c, 0x96, 0x44, 0xc2, 0x9e, 0xc9, 0x48, 0x90, 0x59,
+	0xfe, 0x0c, 0x99, 0x6d, 0x13, 0x6b, 0xe8, 0x6b, 0x71, 0x29, 0x36, 0x26, 0xd2, 0xd1, 0x25, 0xfe,
+	0x9b, 0x67, 0xa2, 0xff, 0x9b, 0x04, 0x8b, 0x15, 0x51, 0x7f, 0x88, 0x41, 0x6a, 0x5c, 0x70, 0x6c,
+	0xf1, 0xfc, 0x71, 0x9f, 0x78, 0xb8, 0xde, 0x75, 0xad, 0x4e, 0x50, 0x8e, 0x3b, 0x43, 0x5f, 0xcb,
+	0x4a, 0xb6, 0x13, 0xa1, 0x3a, 0x4a, 0x8e, 0x7d, 0x05, 0xee, 0xaa, 0x10, 0x2f, 0xcf, 0x1d, 0x90,
+	0x80, 0xb5, 0xf0, 0xa9, 0x0e, 0x21, 0x7d, 0x6c, 0x76, 0xed, 0x03, 0xc2, 0x1f, 0xb5, 0xdd, 0x86,
+	0x48, 0x6f, 0x21, 0x7f, 0x6f, 0xe8, 0x6b, 0xfa, 0xb4, 0xc4, 0x0c, 0x58, 0x47, 0xea, 0xd8, 0xfb,
+	0x8c, 0x90, 0xfe, 0x16, 0xf7, 0x55, 0x84, 0x0b, 0xbe, 0x06, 0x2a, 0xe5, 0x95, 0xc2, 0x96, 0xeb,
+	0x76, 0x6d, 0xa7, 0x85, 0xdd, 0x66, 0x73, 0xa4, 0x71, 0x51, 0x68, 0xdc, 0x1e, 0xfa, 0x9a, 0x16,
+	0xbc, 0x94, 0x13, 0x90, 0x3a, 0x5a, 0x15, 0xae, 0x82, 0xf4, 0xec, 0x35, 0x9b, 0x01, 0xfb, 0x77,
+	0x0a, 0x48, 0xca, 0x87, 0xe4, 0x19, 0xc1, 0x61, 0x7d, 0x16, 0x44, 0x7d, 0xf6, 0xce, 0x5c, 0x9f,
+	0x5b, 0xd1, 0x50, 0xa6, 0x59, 0x75, 0xb4, 0x22, 0x1c, 0x5b, 0xc2, 0x5e, 0x0d, 0xea, 0x06, 0x9b,
+	0x20, 0x3e, 0x81, 0xef, 0xd9, 0x8e, 0xfa, 0xc9, 0x39, 0x9c, 0xfc, 0xe5, 0x88, 0xd8, 0xae, 0xed,
+	0xcc, 0xea, 0x98, 0x47, 0xea, 0xe2, 0x79, 0xeb, 0x98, 0x47, 0xf0, 0x7b, 0x05, 0x24, 0x3d, 0x72,
+	0x68, 0x7a, 0x0d, 0x7c, 0x60, 0x76, 0xed, 0x86, 0xc9, 0x5c, 0x8f, 0xe7, 0x6f, 0xbb, 0xea, 0xa7,
+	0xff, 0xef, 0xb5, 0xce, 0x67, 0xd5, 0x51, 0x42, 0x3a, 0x5e, 0x8c, 0xec, 0x88, 0x9b, 0xe1, 0x0f,
+	0x61, 0x1c, 0x74, 0x50, 0xef, 0xd9, 0x8c, 0x91, 0x51, 0x1c, 0x97, 0x44, 0x1c, 0x95, 0x33, 0xc7,
+	0x91, 0x99, 0x88, 0x63, 0x7c, 0x6c, 0xa7, 0x03, 0xa9, 0x8e, 0xe4, 0x64, 0x20, 0x6f, 0x40, 0x7a,
+	0x26, 0x0e, 0xd6, 0xf6, 0x08, 0x6d, 0xbb, 0xdd, 0x86, 0xba, 0x74, 0x0e, 0x25, 0x50, 0xa7, 0x74,
+	0x6b, 0x23, 0x76, 0x58, 0x02, 0xb0, 0x4d, 0x4c, 0x8f, 0xd5, 0x89, 0xc9, 0xb0, 0xed, 0x30, 0xe2,
+	0x1d, 0x98, 0x5d, 0x15, 0x88, 0xde, 0xb9, 0x35, 0xf4, 0xb5, 0x94, 0xcc, 0x68, 0x16, 0xa3, 0xa3,
+	0xeb, 0x63, 0x63, 0x31, 0xb0, 0xc1, 0x26, 0x58, 0x33, 0x19, 0x23, 0x94, 0xf1, 0xbc, 0x1c, 0x8e,
+	0x1d, 0x78, 0x4e, 0x48, 0x7b, 0x79, 0xba, 0xed, 0x4f, 0x01, 0xeb, 0x28, 0x15, 0xf1, 0x16, 0x85,
+	0x73, 0xac, 0xb3, 0x0f, 0x92, 0xd1, 0x47, 0x3b, 0xa4, 0xcf, 0xe4, 0x6c, 0x52, 0xaf, 0x08, 0x89,
+	0xf5, 0xf0, 0x4c, 0xcc, 0xc7, 0xe9, 0x28, 0x11, 0x71, 0x3c, 0x23, 0x7d, 0x26, 0xe6, 0x17, 0xec,
+	0x80, 0x15, 0xda, 0xc7, 0xb2, 0x0d, 0x7a, 0xe6, 0x51, 0xd0, 0x0a, 0xea, 0xd5, 0x73, 0xa8, 0x41,
+	0x9c, 0xf6, 0xc5, 0xad, 0xb2, 0x6b, 0x1e, 0xc9, 0x5e, 0x10, 0xd3, 0x6b, 0x24, 0x26, 0xa2, 0xe2,
+	0x73, 0xe9, 0xd0, 0x76, 0x1a, 0xee, 0xa1, 0xba, 0x3c, 0x33, 0xbd, 0x4e, 0x40, 0xf2, 0xe9, 0x25,
+	0x89, 0x0b, 0x81, 0x63, 0x5f, 0xd8, 0xa1, 0x03, 0x96, 0x29, 0xe9, 0x12, 0x4b, 0x64, 0xde, 0x73,
+	0x1b, 0x44, 0xbd, 0x26, 0xee, 0xad, 0xcf, 0x73, 0xf3, 0xae, 0xfd, 0x5c, 0x61, 0xf4, 0xab, 0x3a,
+	0x7a, 0x68, 0xd7, 0x6d, 0x90, 0xe8, 0xad, 0x3c, 0xc9, 0xa6, 0xa3, 0xab, 0x34, 0x8a, 0x84, 0xdf,
+	0x82, 0xc4, 0x38, 0x46, 0xcf, 0xa6, 0x1d, 0x7c, 0x48, 0xec, 0x56, 0x9b, 0xa9, 0x71, 0xf1, 0xee,
+	0x76, 0xcf, 0xdc, 0x4b, 0x6b, 0x53, 0x79, 0x47, 0x38, 0x75, 0x74, 0x3d, 0xc8, 0x19, 0xd9, 0xb4,
+	0xb3, 0x2f, 0x6c, 0x90, 0x80, 0x6b, 0x91, 0x86, 0xab, 0xbb, 0x4e, 0x43, 0xbd, 0x7e, 0x1e, 0xd3,
+	0x2b, 0x24, 0xcd, 0xbb, 0x4e, 0x03, 0xbe, 0x55, 0x40, 0x7a, 0x4a, 0x07, 0xd7, 0xf9, 0xc1, 0x95,
+	0x93, 0x03, 0x0a, 0xc9, 0xea, 0x99, 0xb3, 0x5d, 0x9f, 0xba, 0x07, 0x67, 0x98, 0x75, 0x74, 0x63,
+	0x32, 0x92, 0xfc, 0xc0, 0x73, 0xe4, 0xfc, 0xa8, 0x81, 0xd5, 0xc9, 0x51, 0x43, 0x70, 0xd7, 0xee,
+	0xd9, 0x4c, 0x5d, 0x11, 0x87, 0x28, 0x3b, 0xf4, 0xb5, 0x9b, 0x33, 0xf4, 0x21, 0x4c, 0x47, 0x2b,
+	0xa1, 0x9d, 0xdf, 0x38, 0x25, 0x6e, 0x85, 0x6d, 0x70, 0x73, 0x2e, 0x7c, 0x74, 0x42, 0x13, 0x82,
+	0xfc, 0xb3, 0xa1, 0xaf, 0xdd, 0x3e, 0x85, 0x7c, 0x7c, 0x4a, 0x53, 0x73, 0x34, 0x82, 0x93, 0xda,
+	0x01, 0xb7, 0xc6, 0x4e, 0xdc, 0xb6, 0x29, 0x73, 0xbd, 0x63, 0xd9, 0xab, 0x62, 0xcb, 0xa0, 0xea,
+	0xaa, 0x90, 0xda, 0x18, 0xfa, 0xda, 0x9d, 0x29, 0xa9, 0x79, 0x70, 0x1d, 0x85, 0x05, 0xda, 0x91,
+	0x6e, 0xde, 0xe0, 0x62, 0x31, 0xa1, 0xb0, 0x0c, 0xe4, 0x25, 0x8b, 0x09, 0xb5, 0x3c, 0xf7, 0x70,
+	0xb4, 0x2d, 0x24, 0x85, 0x44, 0x66, 0xe8, 0x6b, 0xe9, 0xe8, 0x15, 0x3d, 0x01, 0xe2, 0xc7, 0x8e,
+	0x5b, 0x0d, 0x61, 0x0c, 0x96, 0x84, 0x57, 0x20, 0xac, 0x0b, 0xa6, 0xa4, 0xd5, 0x23, 0xce, 0x68,
+	0x16, 0xdd, 0x10, 0x9c, 0x7a, 0x78, 0x2f, 0x9c, 0x00, 0xd4, 0x51, 0x58, 0xbf, 0xaa, 0x74, 0xc8,
+	0x69, 0xf4, 0x12, 0x5c, 0x0e, 0x7a, 0x7e, 0xe0, 0x1d, 0x10, 0x55, 0x15, 0xfd, 0x9b, 0x9d, 0xdf,
+	0xbf, 0x72, 0x04, 0x70, 0x5c, 0x3e, 0x39, 0xf4, 0x35, 0x38, 0xb1, 0xf3, 0x70, 0xb3, 0x8e, 0x00,
+	0x1d, 0x63, 0xe0, 0xeb, 0x11, 0x35, 0x65, 0xa4, 0x4f, 0xd5, 0x54, 0xf6, 0xe2, 0xc6, 0xe5, 0xc7,
+	0xda, 0x29, 0xd4, 0x7c, 0x77, 0xcf, 0xa7, 0xf9, 0xb9, 0x9e, 0x66, 0x17, 0x0c, 0x23, 0x76, 0x0e,
+	0xa3, 0xf0, 0x37, 0x05, 0x44, 0x17, 0xde, 0xf9, 0xeb, 0x0e, 0x55, 0xd3, 0x42, 0xf5, 0xfe, 0x7c,
+	0xd5, 0x39, 0xcb, 0x78, 0xfe, 0x51, 0xa0, 0x7f, 0x7f, 0x66, 0xa7, 0x3e, 0x41, 0x42, 0x47, 0x19,
+	0x6f, 0x92, 0x67, 0x72, 0xb9, 0xa2, 0xd0, 0x8a, 0xb4, 0x33, 0x8e, 0x10, 0xca, 0x12, 0xae, 0x89,
+	0x12, 0xde, 0x9d, 0xd3, 0xa0, 0x33, 0xd8, 0xe8, 0x9e, 0x1a, 0xc6, 0x2e, 0x0a, 0xf9, 0x64, 0xe1,
+	0xa7, 0x9f, 0xb5, 0xd8, 0x83, 0x0e, 0x48, 0xce, 0x9f, 0xb2, 0xf0, 0x0e, 0xc8, 0x16, 0x76, 0xb6,
+	0x4a, 0x25, 0xa3, 0xfc, 0xd4, 0xc0, 0x55, 0xa3, 0x64, 0x14, 0x6a, 0xc5, 0xbd, 0x32, 0xde, 0xdd,
+	0xdb, 0x36, 0xf0, 0xf3, 0x72, 0xf1, 0xeb, 0x3d, 0xb4, 0x1b, 0x8f, 0xc1, 0xbb, 0x60, 0xfd, 0x44,
+	0xd4, 0xbe, 0x51, 0x7c, 0xba, 0x53, 0x33, 0xb6, 0xe3, 0x4a, 0x7a, 0xe1, 0xc7, 0x5f, 0x33, 0xb1,
+	0x07, 0x36, 0x00, 0xe1, 0x91, 0x80, 0x49, 0x00, 0xab, 0xa5, 0xad, 0xea, 0x0e, 0x2e, 0x3c, 0x47,
+	0x2f, 0x0c, 0x5c, 0x2a, 0x96, 0x8d, 0x2d, 0x14, 0x8f, 0x41, 0x15, 0x24, 0xa2, 0xf6, 0x6a, 0xcd,
+	0xa8, 0xec, 0x17, 0xab, 0x46, 0x5c, 0x81, 0xb7, 0x81, 0x16, 0xf5, 0x54, 0x0c, 0x84, 0x91, 0xb1,
+	0xfd, 0xbc, 0xbc, 0xbd, 0x55, 0x2e, 0xbc, 0xc4, 0xb5, 0x97, 0x15, 0x23, 0x7e, 0x41, 0x4a, 0xe5,
+	0x9f, 0xbd, 0xfb, 0x90, 0x51, 0xde, 0x7f, 0xc8, 0x28, 0xff, 0x7c, 0xc8, 0x28, 0x6f, 0x3f, 0x66,
+	0x62, 0xef, 0x3f, 0x66, 0x62, 0x7f, 0x7e, 0xcc, 0xc4, 0x5e, 0x3d, 0x8a, 0xcc, 0xbf, 0xba, 0x53,
+	0x7f, 0x68, 0xb5, 0x4d, 0xdb, 0xd9, 0x8c, 0x7c, 0x2f, 0x1e, 0x45, 0xbe, 0x4d, 0xc5, 0x38, 0xac,
+	0x2f, 0x8a, 0x6f, 0xc6, 0x2f, 0xfe, 0x1b, 0x00, 0xdc, 0xc1, 0x74, 0x34, 0xc0, 0x0e, 0x00, 0x00,
+}
+
+func (m *SlashStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ObjectSizeGb != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ObjectSizeGb))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedundancySlashRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedundancySlashRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedundancySlashRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SizeRate.Size()
+		i -= size
+		if _, err := m.SizeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RedundancyType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -212,6 +596,128 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChallengeRedundancyCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeRedundancyCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.RedundancySlashAmountSizeRates) > 0 {
+		for iNdEx := len(m.RedundancySlashAmountSizeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedundancySlashAmountSizeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.SlashSteps) > 0 {
+		for iNdEx := len(m.SlashSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.SlashCurve != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashCurve))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ChallengeSegmentCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeSegmentCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.SlashEscrowPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashEscrowPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ChallengeHistoryKeptBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeHistoryKeptBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ChallengerRateLimitWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengerRateLimitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ChallengerRateLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengerRateLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.ChallengerBondBurnRatio.Size()
+		i -= size
+		if _, err := m.ChallengerBondBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ChallengerBond.Size()
+		i -= size
+		if _, err := m.ChallengerBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.SpSlashRiskWeight.Size()
+		i -= size
+		if _, err := m.SpSlashRiskWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.SelectionMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SelectionMode))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.SpSlashCountingWindow != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SpSlashCountingWindow))
 		i--
@@ -331,24 +837,52 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *Params) Size() (n int) {
+func (m *SlashStep) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChallengeCountPerBlock != 0 {
-		n += 1 + sovParams(uint64(m.ChallengeCountPerBlock))
+	if m.ObjectSizeGb != 0 {
+		n += 1 + sovParams(uint64(m.ObjectSizeGb))
 	}
-	if m.ChallengeKeepAlivePeriod != 0 {
-		n += 1 + sovParams(uint64(m.ChallengeKeepAlivePeriod))
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RedundancySlashRate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.SlashCoolingOffPeriod != 0 {
-		n += 1 + sovParams(uint64(m.SlashCoolingOffPeriod))
+	var l int
+	_ = l
+	if m.RedundancyType != 0 {
+		n += 1 + sovParams(uint64(m.RedundancyType))
 	}
-	l = m.SlashAmountSizeRate.Size()
+	l = m.SizeRate.Size()
 	n += 1 + l + sovParams(uint64(l))
-	l = m.SlashAmountMin.Size()
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeCountPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeCountPerBlock))
+	}
+	if m.ChallengeKeepAlivePeriod != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeKeepAlivePeriod))
+	}
+	if m.SlashCoolingOffPeriod != 0 {
+		n += 1 + sovParams(uint64(m.SlashCoolingOffPeriod))
+	}
+	l = m.SlashAmountSizeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashAmountMin.Size()
 	n += 1 + l + sovParams(uint64(l))
 	l = m.SlashAmountMax.Size()
 	n += 1 + l + sovParams(uint64(l))
@@ -372,6 +906,48 @@ func (m *Params) Size() (n int) {
 	if m.SpSlashCountingWindow != 0 {
 		n += 1 + sovParams(uint64(m.SpSlashCountingWindow))
 	}
+	if m.SelectionMode != 0 {
+		n += 1 + sovParams(uint64(m.SelectionMode))
+	}
+	l = m.SpSlashRiskWeight.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ChallengerBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ChallengerBondBurnRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ChallengerRateLimit != 0 {
+		n += 2 + sovParams(uint64(m.ChallengerRateLimit))
+	}
+	if m.ChallengerRateLimitWindow != 0 {
+		n += 2 + sovParams(uint64(m.ChallengerRateLimitWindow))
+	}
+	if m.ChallengeHistoryKeptBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ChallengeHistoryKeptBlocks))
+	}
+	if m.SlashEscrowPeriod != 0 {
+		n += 2 + sovParams(uint64(m.SlashEscrowPeriod))
+	}
+	if m.ChallengeSegmentCount != 0 {
+		n += 2 + sovParams(uint64(m.ChallengeSegmentCount))
+	}
+	if m.SlashCurve != 0 {
+		n += 2 + sovParams(uint64(m.SlashCurve))
+	}
+	if len(m.SlashSteps) > 0 {
+		for _, e := range m.SlashSteps {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RedundancySlashAmountSizeRates) > 0 {
+		for _, e := range m.RedundancySlashAmountSizeRates {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ChallengeRedundancyCount != 0 {
+		n += 2 + sovParams(uint64(m.ChallengeRedundancyCount))
+	}
 	return n
 }
 
@@ -381,6 +957,212 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *SlashStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectSizeGb", wireType)
+			}
+			m.ObjectSizeGb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectSizeGb |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedundancySlashRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedundancySlashRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedundancySlashRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= types.RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SizeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -443,16 +1225,137 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChallengeKeepAlivePeriod |= uint64(b&0x7F) << shift
+				m.ChallengeKeepAlivePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCoolingOffPeriod", wireType)
+			}
+			m.SlashCoolingOffPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCoolingOffPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmountSizeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmountSizeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmountMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmountMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmountMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashCoolingOffPeriod", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
 			}
-			m.SlashCoolingOffPeriod = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmountMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardValidatorRatio", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -462,14 +1365,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashCoolingOffPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardValidatorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmountSizeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSubmitterRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -497,13 +1415,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashAmountSizeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardSubmitterRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmountMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSubmitterThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -531,13 +1449,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashAmountMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardSubmitterThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatInterval", wireType)
+			}
+			m.HeartbeatInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationInturnInterval", wireType)
+			}
+			m.AttestationInturnInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationInturnInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationKeptCount", wireType)
+			}
+			m.AttestationKeptCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationKeptCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmountMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpSlashMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -565,13 +1540,51 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashAmountMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpSlashMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpSlashCountingWindow", wireType)
+			}
+			m.SpSlashCountingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpSlashCountingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionMode", wireType)
+			}
+			m.SelectionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelectionMode |= ChallengeSelectionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardValidatorRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpSlashRiskWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -599,13 +1612,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardValidatorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpSlashRiskWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardSubmitterRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerBond", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -633,13 +1646,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardSubmitterRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChallengerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardSubmitterThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerBondBurnRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -667,15 +1680,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardSubmitterThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChallengerBondBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerRateLimit", wireType)
 			}
-			m.HeartbeatInterval = 0
+			m.ChallengerRateLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -685,16 +1698,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeartbeatInterval |= uint64(b&0x7F) << shift
+				m.ChallengerRateLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationInturnInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerRateLimitWindow", wireType)
 			}
-			m.AttestationInturnInterval = 0
+			m.ChallengerRateLimitWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -704,16 +1717,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttestationInturnInterval |= uint64(b&0x7F) << shift
+				m.ChallengerRateLimitWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationKeptCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeHistoryKeptBlocks", wireType)
 			}
-			m.AttestationKeptCount = 0
+			m.ChallengeHistoryKeptBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -723,16 +1736,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttestationKeptCount |= uint64(b&0x7F) << shift
+				m.ChallengeHistoryKeptBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEscrowPeriod", wireType)
+			}
+			m.SlashEscrowPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashEscrowPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSegmentCount", wireType)
+			}
+			m.ChallengeSegmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeSegmentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCurve", wireType)
+			}
+			m.SlashCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCurve |= SlashCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpSlashMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashSteps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -742,31 +1812,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpSlashMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashSteps = append(m.SlashSteps, SlashStep{})
+			if err := m.SlashSteps[len(m.SlashSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancySlashAmountSizeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedundancySlashAmountSizeRates = append(m.RedundancySlashAmountSizeRates, RedundancySlashRate{})
+			if err := m.RedundancySlashAmountSizeRates[len(m.RedundancySlashAmountSizeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpSlashCountingWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeRedundancyCount", wireType)
 			}
-			m.SpSlashCountingWindow = 0
+			m.ChallengeRedundancyCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -776,7 +1880,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpSlashCountingWindow |= uint64(b&0x7F) << shift
+				m.ChallengeRedundancyCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bnb-chain/greenfield/x/challenge/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 )
 
 func Test_validateParams(t *testing.T) {
@@ -29,7 +30,7 @@ func Test_validateParams(t *testing.T) {
 	require.Error(t, params.Validate())
 
 	params.SlashAmountMin = sdk.NewInt(1)
-	params.SlashAmountMax = sdk.NewInt(10)
+	params.SlashAmountMax = sdk.NewInt(1e18)
 	require.NoError(t, params.Validate())
 
 	// validate reward validator ratio
@@ -66,7 +67,87 @@ func Test_validateParams(t *testing.T) {
 	params.AttestationKeptCount = 0
 	require.Error(t, params.Validate())
 
-	// no error
+	// validate selection mode
 	params.AttestationKeptCount = 100
+	params.SelectionMode = types.ChallengeSelectionMode(100)
+	require.Error(t, params.Validate())
+
+	// validate sp slash risk weight
+	params.SelectionMode = types.CHALLENGE_SELECTION_MODE_WEIGHTED
+	params.SpSlashRiskWeight = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+
+	// validate challenger bond
+	params.SpSlashRiskWeight = sdk.NewDecWithPrec(5, 1)
+	params.ChallengerBond = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	// validate challenger bond burn ratio
+	params.ChallengerBond = sdk.NewInt(100)
+	params.ChallengerBondBurnRatio = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	// validate challenger rate limit window
+	params.ChallengerBondBurnRatio = sdk.NewDecWithPrec(5, 1)
+	params.ChallengerRateLimitWindow = 0
+	require.Error(t, params.Validate())
+
+	// validate challenge history kept blocks
+	params.ChallengerRateLimitWindow = 100
+	params.ChallengeHistoryKeptBlocks = 0
+	require.Error(t, params.Validate())
+
+	// validate challenge segment count
+	params.ChallengeHistoryKeptBlocks = 100
+	params.ChallengeSegmentCount = 0
+	require.Error(t, params.Validate())
+	params.ChallengeSegmentCount = types.MaxChallengeSegmentCount + 1
+	require.Error(t, params.Validate())
+
+	// validate slash curve
+	params.ChallengeSegmentCount = 4
+	params.SlashCurve = types.SlashCurve(100)
+	require.Error(t, params.Validate())
+
+	// validate slash steps
+	params.SlashCurve = types.SLASH_CURVE_STEPWISE
+	params.SlashSteps = nil
+	require.Error(t, params.Validate())
+	params.SlashSteps = []types.SlashStep{{ObjectSizeGb: 2, Amount: sdk.NewInt(100)}, {ObjectSizeGb: 1, Amount: sdk.NewInt(200)}}
+	require.Error(t, params.Validate())
+	params.SlashSteps = []types.SlashStep{{ObjectSizeGb: 1, Amount: sdk.NewInt(-1)}}
+	require.Error(t, params.Validate())
+	params.SlashSteps = []types.SlashStep{{ObjectSizeGb: 1, Amount: sdk.ZeroInt()}}
+	require.Error(t, params.Validate())
+	params.SlashSteps = []types.SlashStep{{ObjectSizeGb: 1, Amount: sdk.NewInt(2e18)}}
+	require.Error(t, params.Validate())
+
+	// validate redundancy slash amount size rates
+	params.SlashSteps = []types.SlashStep{{ObjectSizeGb: 1, Amount: sdk.NewInt(100)}, {ObjectSizeGb: 2, Amount: sdk.NewInt(200)}}
+	params.RedundancySlashAmountSizeRates = []types.RedundancySlashRate{
+		{RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, SizeRate: sdk.NewDec(-1)},
+	}
+	require.Error(t, params.Validate())
+	params.RedundancySlashAmountSizeRates = []types.RedundancySlashRate{
+		{RedundancyType: storagetypes.RedundancyType(100), SizeRate: sdk.NewDecWithPrec(1, 2)},
+	}
+	require.Error(t, params.Validate())
+	params.RedundancySlashAmountSizeRates = []types.RedundancySlashRate{
+		{RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, SizeRate: sdk.NewDecWithPrec(1, 2)},
+		{RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, SizeRate: sdk.NewDecWithPrec(2, 2)},
+	}
+	require.Error(t, params.Validate())
+
+	// validate challenge redundancy count
+	params.RedundancySlashAmountSizeRates = []types.RedundancySlashRate{
+		{RedundancyType: storagetypes.REDUNDANCY_REPLICA_TYPE, SizeRate: sdk.NewDecWithPrec(1, 2)},
+	}
+	params.ChallengeRedundancyCount = 0
+	require.Error(t, params.Validate())
+	params.ChallengeRedundancyCount = types.MaxChallengeRedundancyCount + 1
+	require.Error(t, params.Validate())
+
+	// no error
+	params.ChallengeRedundancyCount = 2
 	require.NoError(t, params.Validate())
 }
@@ -7,7 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -434,665 +435,2765 @@ func (m *SubmitInterval) GetEnd() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.challenge.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.challenge.QueryParamsResponse")
-	proto.RegisterType((*QueryAttestedChallengeRequest)(nil), "greenfield.challenge.QueryAttestedChallengeRequest")
-	proto.RegisterType((*QueryAttestedChallengeResponse)(nil), "greenfield.challenge.QueryAttestedChallengeResponse")
-	proto.RegisterType((*QueryLatestAttestedChallengesRequest)(nil), "greenfield.challenge.QueryLatestAttestedChallengesRequest")
-	proto.RegisterType((*QueryLatestAttestedChallengesResponse)(nil), "greenfield.challenge.QueryLatestAttestedChallengesResponse")
-	proto.RegisterType((*QueryInturnAttestationSubmitterRequest)(nil), "greenfield.challenge.QueryInturnAttestationSubmitterRequest")
-	proto.RegisterType((*QueryInturnAttestationSubmitterResponse)(nil), "greenfield.challenge.QueryInturnAttestationSubmitterResponse")
-	proto.RegisterType((*SubmitInterval)(nil), "greenfield.challenge.SubmitInterval")
+// QueryChallengeHistoryBySpRequest is request type for the Query/ChallengeHistoryBySp RPC method.
+type QueryChallengeHistoryBySpRequest struct {
+	// The id of the storage provider.
+	SpId       uint32             `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/challenge/query.proto", fileDescriptor_f6f1807fa0a2b619) }
-
-var fileDescriptor_f6f1807fa0a2b619 = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xfb, 0x25, 0x75, 0x8a, 0x0a, 0x2c, 0x39, 0x14, 0x2b, 0x98, 0xd6, 0x0a, 0x6d, 0xa8,
-	0x44, 0xdc, 0xa4, 0x3d, 0x54, 0x01, 0x0e, 0x04, 0x21, 0x14, 0x15, 0xa4, 0xd6, 0xdc, 0xb8, 0x58,
-	0xeb, 0x64, 0x71, 0x2c, 0x9c, 0x5d, 0xd7, 0xbb, 0xae, 0xc8, 0x15, 0xfe, 0x00, 0x12, 0x17, 0x7e,
-	0x06, 0x3f, 0xa3, 0x17, 0xa4, 0x4a, 0x08, 0x89, 0x13, 0x42, 0x09, 0x07, 0x7e, 0x06, 0xca, 0x7a,
-	0xe3, 0x36, 0xc4, 0x49, 0x68, 0x6f, 0xde, 0xf1, 0x7b, 0x6f, 0xde, 0x1b, 0xcf, 0x1a, 0xd6, 0xbd,
-	0x88, 0x10, 0xfa, 0xc6, 0x27, 0x41, 0xcb, 0x6a, 0xb6, 0x71, 0x10, 0x10, 0xea, 0x11, 0xeb, 0x38,
-	0x26, 0x51, 0xb7, 0x1c, 0x46, 0x4c, 0x30, 0x94, 0x3f, 0x47, 0x94, 0x53, 0x84, 0xbe, 0xdd, 0x64,
-	0xbc, 0xc3, 0xb8, 0xe5, 0x62, 0xae, 0xe0, 0xd6, 0x49, 0xc5, 0x25, 0x02, 0x57, 0xac, 0x10, 0x7b,
-	0x3e, 0xc5, 0xc2, 0x67, 0x34, 0x51, 0xd0, 0x6f, 0x27, 0x58, 0x47, 0x9e, 0xac, 0xe4, 0xa0, 0x5e,
-	0xe5, 0x3d, 0xe6, 0xb1, 0xa4, 0x3e, 0x78, 0x52, 0xd5, 0x82, 0xc7, 0x98, 0x17, 0x10, 0x0b, 0x87,
-	0xbe, 0x85, 0x29, 0x65, 0x42, 0xaa, 0x0d, 0x39, 0x1b, 0x99, 0x96, 0x43, 0x1c, 0xe1, 0xce, 0x10,
-	0x92, 0x9d, 0x4a, 0x74, 0x43, 0xa2, 0x10, 0x66, 0x1e, 0xd0, 0xd1, 0xc0, 0xf5, 0xa1, 0xa4, 0xd9,
-	0xe4, 0x38, 0x26, 0x5c, 0x98, 0x47, 0x70, 0x6b, 0xa4, 0xca, 0x43, 0x46, 0x39, 0x41, 0x35, 0x58,
-	0x4a, 0xe4, 0xd7, 0xb4, 0x75, 0xad, 0xb4, 0x52, 0x2d, 0x94, 0xb3, 0x66, 0x52, 0x4e, 0x58, 0xf5,
-	0x85, 0xd3, 0x9f, 0x77, 0x73, 0xb6, 0x62, 0x98, 0x75, 0xb8, 0x23, 0x25, 0x9f, 0x08, 0x41, 0xb8,
-	0x20, 0xad, 0xa7, 0x43, 0xb8, 0xea, 0x89, 0x36, 0xe0, 0x5a, 0x2a, 0xe1, 0xf8, 0x2d, 0xd9, 0x62,
-	0xc1, 0x5e, 0x49, 0x6b, 0x8d, 0x96, 0xe9, 0x81, 0x31, 0x49, 0x43, 0x39, 0x7c, 0x06, 0xcb, 0x29,
-	0x41, 0x99, 0xdc, 0xca, 0x36, 0x39, 0xae, 0x71, 0xce, 0x34, 0x37, 0xa1, 0x28, 0x1b, 0xbd, 0xc0,
-	0x03, 0xd0, 0x18, 0x34, 0x9d, 0x53, 0x08, 0xf7, 0x66, 0xe0, 0x94, 0xaf, 0xe7, 0x00, 0xa9, 0xfa,
-	0x60, 0x7a, 0xf3, 0x97, 0x31, 0x76, 0x81, 0x6a, 0x96, 0x60, 0x53, 0x76, 0x6c, 0x50, 0x11, 0x47,
-	0x34, 0xc1, 0xca, 0xad, 0x78, 0x15, 0xbb, 0x1d, 0x5f, 0x08, 0x12, 0x0d, 0xbd, 0x7d, 0xd6, 0x60,
-	0x6b, 0x26, 0x54, 0xd9, 0x33, 0x60, 0xc5, 0x0d, 0xb8, 0x13, 0xc6, 0xae, 0xf3, 0x96, 0x74, 0xe5,
-	0xe0, 0x96, 0xed, 0x65, 0x37, 0xe0, 0x87, 0xb1, 0x7b, 0x40, 0xba, 0xe8, 0x25, 0x5c, 0xe7, 0x92,
-	0xe4, 0xf8, 0x54, 0x90, 0xe8, 0x04, 0x07, 0x6b, 0x73, 0x72, 0xb8, 0xc5, 0xec, 0x0c, 0x49, 0x87,
-	0x86, 0xc2, 0xda, 0xab, 0x7c, 0xe4, 0x6c, 0xee, 0xc3, 0xea, 0x28, 0x02, 0xe5, 0x61, 0x91, 0x0b,
-	0x1c, 0x09, 0xf5, 0xd5, 0x93, 0x03, 0xba, 0x01, 0xf3, 0x84, 0xb6, 0x64, 0xab, 0x05, 0x7b, 0xf0,
-	0x58, 0xfd, 0xb3, 0x08, 0x8b, 0x32, 0x14, 0xfa, 0xa0, 0xc1, 0x52, 0xb2, 0x68, 0xa8, 0x94, 0x6d,
-	0x62, 0x7c, 0xaf, 0xf5, 0xfb, 0xff, 0x81, 0x4c, 0x46, 0x62, 0x16, 0xdf, 0x7f, 0xfb, 0xfd, 0x69,
-	0xce, 0x40, 0x05, 0x6b, 0xca, 0x35, 0x43, 0x5f, 0x34, 0xb8, 0x39, 0xf6, 0xc1, 0xd0, 0xee, 0x94,
-	0x36, 0x93, 0xf6, 0x5f, 0xdf, 0xbb, 0x1c, 0x49, 0xd9, 0xdc, 0x91, 0x36, 0xb7, 0x51, 0x29, 0xdb,
-	0x26, 0x56, 0x44, 0x27, 0x2d, 0xa1, 0xaf, 0x1a, 0xac, 0x4d, 0xda, 0x57, 0x54, 0x9b, 0x62, 0x62,
-	0xc6, 0x65, 0xd0, 0x1f, 0x5e, 0x89, 0xab, 0x72, 0xec, 0xcb, 0x1c, 0x55, 0xb4, 0x93, 0x9d, 0x23,
-	0x90, 0x7c, 0x67, 0x3c, 0x0e, 0x47, 0xdf, 0x35, 0xd0, 0x27, 0xaf, 0x38, 0x7a, 0x34, 0xc5, 0xd5,
-	0xcc, 0x4b, 0xa4, 0x3f, 0xbe, 0x22, 0x5b, 0xa5, 0xaa, 0xc9, 0x54, 0x7b, 0xa8, 0x9a, 0x9d, 0xca,
-	0x97, 0x0a, 0x2a, 0x95, 0x94, 0x70, 0xf8, 0x50, 0xa3, 0x7e, 0x70, 0xda, 0x33, 0xb4, 0xb3, 0x9e,
-	0xa1, 0xfd, 0xea, 0x19, 0xda, 0xc7, 0xbe, 0x91, 0x3b, 0xeb, 0x1b, 0xb9, 0x1f, 0x7d, 0x23, 0xf7,
-	0xba, 0xe2, 0xf9, 0xa2, 0x1d, 0xbb, 0xe5, 0x26, 0xeb, 0x58, 0x2e, 0x75, 0x1f, 0x34, 0xdb, 0xd8,
-	0xa7, 0x17, 0x3b, 0xbc, 0xfb, 0xf7, 0x67, 0xef, 0x2e, 0xc9, 0xbf, 0xfd, 0xee, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0x4b, 0xb6, 0x01, 0xe7, 0x06, 0x00, 0x00,
+func (m *QueryChallengeHistoryBySpRequest) Reset()         { *m = QueryChallengeHistoryBySpRequest{} }
+func (m *QueryChallengeHistoryBySpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengeHistoryBySpRequest) ProtoMessage()    {}
+func (*QueryChallengeHistoryBySpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{9}
+}
+func (m *QueryChallengeHistoryBySpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengeHistoryBySpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengeHistoryBySpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengeHistoryBySpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengeHistoryBySpRequest.Merge(m, src)
+}
+func (m *QueryChallengeHistoryBySpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengeHistoryBySpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengeHistoryBySpRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryChallengeHistoryBySpRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryChallengeHistoryBySpRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries the attested challenge by challenge id.
-	AttestedChallenge(ctx context.Context, in *QueryAttestedChallengeRequest, opts ...grpc.CallOption) (*QueryAttestedChallengeResponse, error)
-	// Queries the latest attested challenges.
-	LatestAttestedChallenges(ctx context.Context, in *QueryLatestAttestedChallengesRequest, opts ...grpc.CallOption) (*QueryLatestAttestedChallengesResponse, error)
-	// Queries the inturn challenger.
-	InturnAttestationSubmitter(ctx context.Context, in *QueryInturnAttestationSubmitterRequest, opts ...grpc.CallOption) (*QueryInturnAttestationSubmitterResponse, error)
+func (m *QueryChallengeHistoryBySpRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryChallengeHistoryByObjectRequest is request type for the Query/ChallengeHistoryByObject RPC method.
+type QueryChallengeHistoryByObjectRequest struct {
+	// The id of the object info.
+	ObjectId   string             `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryChallengeHistoryByObjectRequest) Reset()         { *m = QueryChallengeHistoryByObjectRequest{} }
+func (m *QueryChallengeHistoryByObjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengeHistoryByObjectRequest) ProtoMessage()    {}
+func (*QueryChallengeHistoryByObjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{10}
+}
+func (m *QueryChallengeHistoryByObjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengeHistoryByObjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengeHistoryByObjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengeHistoryByObjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengeHistoryByObjectRequest.Merge(m, src)
+}
+func (m *QueryChallengeHistoryByObjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengeHistoryByObjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengeHistoryByObjectRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChallengeHistoryByObjectRequest proto.InternalMessageInfo
+
+func (m *QueryChallengeHistoryByObjectRequest) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AttestedChallenge(ctx context.Context, in *QueryAttestedChallengeRequest, opts ...grpc.CallOption) (*QueryAttestedChallengeResponse, error) {
-	out := new(QueryAttestedChallengeResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/AttestedChallenge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChallengeHistoryByObjectRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LatestAttestedChallenges(ctx context.Context, in *QueryLatestAttestedChallengesRequest, opts ...grpc.CallOption) (*QueryLatestAttestedChallengesResponse, error) {
-	out := new(QueryLatestAttestedChallengesResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/LatestAttestedChallenges", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryChallengeHistoryResponse is response type for the Query/ChallengeHistoryBySp and
+// Query/ChallengeHistoryByObject RPC methods.
+type QueryChallengeHistoryResponse struct {
+	// The challenge records, ordered by challenge id.
+	Records    []ChallengeRecord   `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengeHistoryResponse) Reset()         { *m = QueryChallengeHistoryResponse{} }
+func (m *QueryChallengeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengeHistoryResponse) ProtoMessage()    {}
+func (*QueryChallengeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{11}
+}
+func (m *QueryChallengeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChallengeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengeHistoryResponse.Merge(m, src)
+}
+func (m *QueryChallengeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengeHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) InturnAttestationSubmitter(ctx context.Context, in *QueryInturnAttestationSubmitterRequest, opts ...grpc.CallOption) (*QueryInturnAttestationSubmitterResponse, error) {
-	out := new(QueryInturnAttestationSubmitterResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/InturnAttestationSubmitter", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChallengeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryChallengeHistoryResponse) GetRecords() []ChallengeRecord {
+	if m != nil {
+		return m.Records
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries the attested challenge by challenge id.
-	AttestedChallenge(context.Context, *QueryAttestedChallengeRequest) (*QueryAttestedChallengeResponse, error)
-	// Queries the latest attested challenges.
-	LatestAttestedChallenges(context.Context, *QueryLatestAttestedChallengesRequest) (*QueryLatestAttestedChallengesResponse, error)
-	// Queries the inturn challenger.
-	InturnAttestationSubmitter(context.Context, *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error)
+func (m *QueryChallengeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryPendingChallengesRequest is request type for the Query/PendingChallenges RPC method.
+type QueryPendingChallengesRequest struct {
+	// The id of the storage provider.
+	SpId       uint32             `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPendingChallengesRequest) Reset()         { *m = QueryPendingChallengesRequest{} }
+func (m *QueryPendingChallengesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChallengesRequest) ProtoMessage()    {}
+func (*QueryPendingChallengesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{12}
 }
-func (*UnimplementedQueryServer) AttestedChallenge(ctx context.Context, req *QueryAttestedChallengeRequest) (*QueryAttestedChallengeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttestedChallenge not implemented")
+func (m *QueryPendingChallengesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LatestAttestedChallenges(ctx context.Context, req *QueryLatestAttestedChallengesRequest) (*QueryLatestAttestedChallengesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestedChallenges not implemented")
+func (m *QueryPendingChallengesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChallengesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) InturnAttestationSubmitter(ctx context.Context, req *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InturnAttestationSubmitter not implemented")
+func (m *QueryPendingChallengesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChallengesRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPendingChallengesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChallengesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChallengesRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.challenge.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPendingChallengesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingChallengesRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_AttestedChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttestedChallengeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttestedChallenge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.challenge.Query/AttestedChallenge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttestedChallenge(ctx, req.(*QueryAttestedChallengeRequest))
+func (m *QueryPendingChallengesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LatestAttestedChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestAttestedChallengesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestAttestedChallenges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.challenge.Query/LatestAttestedChallenges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestAttestedChallenges(ctx, req.(*QueryLatestAttestedChallengesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPendingChallengesResponse is response type for the Query/PendingChallenges RPC method.
+type QueryPendingChallengesResponse struct {
+	// The pending challenges, ordered by challenge id.
+	Challenges []Challenge         `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_InturnAttestationSubmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInturnAttestationSubmitterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InturnAttestationSubmitter(ctx, in)
+func (m *QueryPendingChallengesResponse) Reset()         { *m = QueryPendingChallengesResponse{} }
+func (m *QueryPendingChallengesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChallengesResponse) ProtoMessage()    {}
+func (*QueryPendingChallengesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{13}
+}
+func (m *QueryPendingChallengesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChallengesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChallengesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.challenge.Query/InturnAttestationSubmitter",
+}
+func (m *QueryPendingChallengesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChallengesResponse.Merge(m, src)
+}
+func (m *QueryPendingChallengesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChallengesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChallengesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingChallengesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingChallengesResponse) GetChallenges() []Challenge {
+	if m != nil {
+		return m.Challenges
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InturnAttestationSubmitter(ctx, req.(*QueryInturnAttestationSubmitterRequest))
+	return nil
+}
+
+func (m *QueryPendingChallengesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.challenge.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AttestedChallenge",
-			Handler:    _Query_AttestedChallenge_Handler,
-		},
-		{
-			MethodName: "LatestAttestedChallenges",
-			Handler:    _Query_LatestAttestedChallenges_Handler,
-		},
-		{
-			MethodName: "InturnAttestationSubmitter",
-			Handler:    _Query_InturnAttestationSubmitter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/challenge/query.proto",
+// QuerySlashEscrowRequest is request type for the Query/SlashEscrow RPC method.
+type QuerySlashEscrowRequest struct {
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashEscrowRequest) Reset()         { *m = QuerySlashEscrowRequest{} }
+func (m *QuerySlashEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashEscrowRequest) ProtoMessage()    {}
+func (*QuerySlashEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{14}
+}
+func (m *QuerySlashEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashEscrowRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySlashEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashEscrowRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashEscrowRequest proto.InternalMessageInfo
+
+func (m *QuerySlashEscrowRequest) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySlashEscrowResponse is response type for the Query/SlashEscrow RPC method.
+type QuerySlashEscrowResponse struct {
+	Escrow SlashEscrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySlashEscrowResponse) Reset()         { *m = QuerySlashEscrowResponse{} }
+func (m *QuerySlashEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashEscrowResponse) ProtoMessage()    {}
+func (*QuerySlashEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{15}
+}
+func (m *QuerySlashEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAttestedChallengeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySlashEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashEscrowResponse.Merge(m, src)
 }
-
-func (m *QueryAttestedChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashEscrowResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAttestedChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChallengeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChallengeId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySlashEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashEscrowResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAttestedChallengeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySlashEscrowResponse proto.InternalMessageInfo
+
+func (m *QuerySlashEscrowResponse) GetEscrow() SlashEscrow {
+	if m != nil {
+		return m.Escrow
 	}
-	return dAtA[:n], nil
+	return SlashEscrow{}
 }
 
-func (m *QueryAttestedChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySpSlashAmountRequest is request type for the Query/SpSlashAmount RPC method.
+type QuerySpSlashAmountRequest struct {
+	// The id of the storage provider.
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
 }
 
-func (m *QueryAttestedChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Challenge != nil {
-		{
-			size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySpSlashAmountRequest) Reset()         { *m = QuerySpSlashAmountRequest{} }
+func (m *QuerySpSlashAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpSlashAmountRequest) ProtoMessage()    {}
+func (*QuerySpSlashAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{16}
+}
+func (m *QuerySpSlashAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpSlashAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpSlashAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLatestAttestedChallengesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySpSlashAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpSlashAmountRequest.Merge(m, src)
 }
-
-func (m *QueryLatestAttestedChallengesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpSlashAmountRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryLatestAttestedChallengesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySpSlashAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpSlashAmountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLatestAttestedChallengesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpSlashAmountRequest proto.InternalMessageInfo
+
+func (m *QuerySpSlashAmountRequest) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLatestAttestedChallengesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySpSlashAmountResponse is response type for the Query/SpSlashAmount RPC method.
+type QuerySpSlashAmountResponse struct {
+	// The slashed amount of the storage provider in the current slash counting window.
+	SlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=slash_amount,json=slashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slash_amount"`
+	// The max slash amount of a storage provider in a window.
+	MaxSlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_slash_amount,json=maxSlashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_slash_amount"`
+	// The amount the storage provider can still be slashed in the current window.
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_amount"`
+	// The height at which the current window ends.
+	WindowEndHeight uint64 `protobuf:"varint,4,opt,name=window_end_height,json=windowEndHeight,proto3" json:"window_end_height,omitempty"`
 }
 
-func (m *QueryLatestAttestedChallengesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Challenges) > 0 {
-		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySpSlashAmountResponse) Reset()         { *m = QuerySpSlashAmountResponse{} }
+func (m *QuerySpSlashAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpSlashAmountResponse) ProtoMessage()    {}
+func (*QuerySpSlashAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6f1807fa0a2b619, []int{17}
+}
+func (m *QuerySpSlashAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpSlashAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpSlashAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInturnAttestationSubmitterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySpSlashAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpSlashAmountResponse.Merge(m, src)
 }
-
-func (m *QueryInturnAttestationSubmitterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySpSlashAmountResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryInturnAttestationSubmitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySpSlashAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpSlashAmountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInturnAttestationSubmitterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpSlashAmountResponse proto.InternalMessageInfo
+
+func (m *QuerySpSlashAmountResponse) GetWindowEndHeight() uint64 {
+	if m != nil {
+		return m.WindowEndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryInturnAttestationSubmitterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.challenge.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.challenge.QueryParamsResponse")
+	proto.RegisterType((*QueryAttestedChallengeRequest)(nil), "greenfield.challenge.QueryAttestedChallengeRequest")
+	proto.RegisterType((*QueryAttestedChallengeResponse)(nil), "greenfield.challenge.QueryAttestedChallengeResponse")
+	proto.RegisterType((*QueryLatestAttestedChallengesRequest)(nil), "greenfield.challenge.QueryLatestAttestedChallengesRequest")
+	proto.RegisterType((*QueryLatestAttestedChallengesResponse)(nil), "greenfield.challenge.QueryLatestAttestedChallengesResponse")
+	proto.RegisterType((*QueryInturnAttestationSubmitterRequest)(nil), "greenfield.challenge.QueryInturnAttestationSubmitterRequest")
+	proto.RegisterType((*QueryInturnAttestationSubmitterResponse)(nil), "greenfield.challenge.QueryInturnAttestationSubmitterResponse")
+	proto.RegisterType((*SubmitInterval)(nil), "greenfield.challenge.SubmitInterval")
+	proto.RegisterType((*QueryChallengeHistoryBySpRequest)(nil), "greenfield.challenge.QueryChallengeHistoryBySpRequest")
+	proto.RegisterType((*QueryChallengeHistoryByObjectRequest)(nil), "greenfield.challenge.QueryChallengeHistoryByObjectRequest")
+	proto.RegisterType((*QueryChallengeHistoryResponse)(nil), "greenfield.challenge.QueryChallengeHistoryResponse")
+	proto.RegisterType((*QueryPendingChallengesRequest)(nil), "greenfield.challenge.QueryPendingChallengesRequest")
+	proto.RegisterType((*QueryPendingChallengesResponse)(nil), "greenfield.challenge.QueryPendingChallengesResponse")
+	proto.RegisterType((*QuerySlashEscrowRequest)(nil), "greenfield.challenge.QuerySlashEscrowRequest")
+	proto.RegisterType((*QuerySlashEscrowResponse)(nil), "greenfield.challenge.QuerySlashEscrowResponse")
+	proto.RegisterType((*QuerySpSlashAmountRequest)(nil), "greenfield.challenge.QuerySpSlashAmountRequest")
+	proto.RegisterType((*QuerySpSlashAmountResponse)(nil), "greenfield.challenge.QuerySpSlashAmountResponse")
 }
 
-func (m *QueryInturnAttestationSubmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubmitInterval != nil {
-		{
-			size, err := m.SubmitInterval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BlsPubKey) > 0 {
-		i -= len(m.BlsPubKey)
-		copy(dAtA[i:], m.BlsPubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsPubKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("greenfield/challenge/query.proto", fileDescriptor_f6f1807fa0a2b619) }
+
+var fileDescriptor_f6f1807fa0a2b619 = []byte{
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdc, 0x54,
+	0x10, 0x8f, 0xd3, 0x24, 0x90, 0x49, 0xf3, 0xef, 0x35, 0x12, 0x5b, 0x93, 0x6e, 0x12, 0x2b, 0x4d,
+	0x42, 0xa4, 0xac, 0xf3, 0xaf, 0x50, 0x42, 0xa2, 0xaa, 0x41, 0x81, 0xae, 0x0a, 0x22, 0xdd, 0xdc,
+	0xe0, 0x60, 0xd9, 0xeb, 0x57, 0xaf, 0xe9, 0xee, 0xb3, 0xeb, 0xf7, 0xb6, 0xc9, 0x2a, 0x44, 0x48,
+	0x70, 0xe4, 0x82, 0xd4, 0x0b, 0xdf, 0x00, 0x71, 0xa2, 0x07, 0x0e, 0x9c, 0x39, 0xf5, 0x82, 0x54,
+	0x15, 0x21, 0x21, 0x0e, 0x15, 0x4a, 0xf8, 0x14, 0x9c, 0xd0, 0xbe, 0xf7, 0xec, 0xf5, 0x62, 0xaf,
+	0x37, 0x1b, 0x85, 0xd3, 0xda, 0xb3, 0xf3, 0x9b, 0xf9, 0xcd, 0xbc, 0x99, 0x37, 0x63, 0x98, 0x75,
+	0x02, 0x8c, 0xc9, 0x43, 0x17, 0x57, 0x6d, 0xbd, 0x5c, 0x31, 0xab, 0x55, 0x4c, 0x1c, 0xac, 0x3f,
+	0xae, 0xe3, 0xa0, 0x51, 0xf0, 0x03, 0x8f, 0x79, 0x68, 0xaa, 0xa5, 0x51, 0x88, 0x34, 0xd4, 0xe5,
+	0xb2, 0x47, 0x6b, 0x1e, 0xd5, 0x2d, 0x93, 0x4a, 0x75, 0xfd, 0xc9, 0x9a, 0x85, 0x99, 0xb9, 0xa6,
+	0xfb, 0xa6, 0xe3, 0x12, 0x93, 0xb9, 0x1e, 0x11, 0x16, 0xd4, 0xeb, 0x42, 0xd7, 0xe0, 0x6f, 0xba,
+	0x78, 0x91, 0x7f, 0x4d, 0x39, 0x9e, 0xe3, 0x09, 0x79, 0xf3, 0x49, 0x4a, 0xa7, 0x1d, 0xcf, 0x73,
+	0xaa, 0x58, 0x37, 0x7d, 0x57, 0x37, 0x09, 0xf1, 0x18, 0xb7, 0x16, 0x62, 0xe6, 0x52, 0x29, 0xfb,
+	0x66, 0x60, 0xd6, 0x42, 0x95, 0xf4, 0xa8, 0x58, 0xc3, 0xc7, 0x52, 0x43, 0x9b, 0x02, 0xf4, 0xa0,
+	0xc9, 0x7a, 0x9f, 0xc3, 0x4a, 0xf8, 0x71, 0x1d, 0x53, 0xa6, 0x3d, 0x80, 0x6b, 0x6d, 0x52, 0xea,
+	0x7b, 0x84, 0x62, 0xb4, 0x05, 0x43, 0xc2, 0x7c, 0x4e, 0x99, 0x55, 0x96, 0x46, 0xd6, 0xa7, 0x0b,
+	0x69, 0x39, 0x29, 0x08, 0xd4, 0xee, 0xc0, 0xf3, 0x57, 0x33, 0x7d, 0x25, 0x89, 0xd0, 0x76, 0xe1,
+	0x06, 0x37, 0x79, 0x97, 0x31, 0x4c, 0x19, 0xb6, 0xdf, 0x0f, 0xd5, 0xa5, 0x4f, 0x34, 0x07, 0x57,
+	0x23, 0x13, 0x86, 0x6b, 0x73, 0x17, 0x03, 0xa5, 0x91, 0x48, 0x56, 0xb4, 0x35, 0x07, 0xf2, 0x9d,
+	0x6c, 0x48, 0x86, 0x7b, 0x30, 0x1c, 0x01, 0x24, 0xc9, 0xc5, 0x74, 0x92, 0x49, 0x1b, 0x2d, 0xa4,
+	0xb6, 0x00, 0xf3, 0xdc, 0xd1, 0x47, 0x66, 0x53, 0x29, 0xa1, 0x1a, 0xe5, 0xc9, 0x87, 0x9b, 0x5d,
+	0xf4, 0x24, 0xaf, 0x0f, 0x01, 0x22, 0xeb, 0xcd, 0xec, 0x5d, 0xe9, 0x85, 0x58, 0x0c, 0xaa, 0x2d,
+	0xc1, 0x02, 0xf7, 0x58, 0x24, 0xac, 0x1e, 0x10, 0xa1, 0xcb, 0xab, 0xe2, 0xa0, 0x6e, 0xd5, 0x5c,
+	0xc6, 0x70, 0x10, 0x72, 0xfb, 0x4e, 0x81, 0xc5, 0xae, 0xaa, 0x92, 0x5e, 0x1e, 0x46, 0xac, 0x2a,
+	0x35, 0xfc, 0xba, 0x65, 0x3c, 0xc2, 0x0d, 0x9e, 0xb8, 0xe1, 0xd2, 0xb0, 0x55, 0xa5, 0xfb, 0x75,
+	0xeb, 0x3e, 0x6e, 0xa0, 0x8f, 0x61, 0x9c, 0x72, 0x90, 0xe1, 0x12, 0x86, 0x83, 0x27, 0x66, 0x35,
+	0xd7, 0xcf, 0x93, 0x3b, 0x9f, 0x1e, 0x83, 0xf0, 0x50, 0x94, 0xba, 0xa5, 0x31, 0xda, 0xf6, 0xae,
+	0xdd, 0x86, 0xb1, 0x76, 0x0d, 0x34, 0x05, 0x83, 0x94, 0x99, 0x01, 0x93, 0xa7, 0x2e, 0x5e, 0xd0,
+	0x04, 0x5c, 0xc1, 0xc4, 0xe6, 0xae, 0x06, 0x4a, 0xcd, 0x47, 0xed, 0x4b, 0x98, 0xe5, 0x31, 0x45,
+	0xc9, 0xb9, 0xe7, 0x52, 0xe6, 0x05, 0x8d, 0xdd, 0xc6, 0x81, 0x1f, 0x16, 0xd2, 0x35, 0x18, 0xa4,
+	0x7e, 0x58, 0x41, 0xa3, 0xa5, 0x01, 0xea, 0x17, 0x6d, 0xf4, 0x01, 0x40, 0xab, 0x1f, 0x25, 0xf9,
+	0x85, 0x82, 0xec, 0xc1, 0x66, 0xf3, 0x16, 0x44, 0xaf, 0xcb, 0xe6, 0x2d, 0xec, 0x9b, 0x51, 0x65,
+	0x96, 0x62, 0x48, 0xed, 0x1b, 0x05, 0xe6, 0x3b, 0x30, 0xf8, 0xc4, 0xfa, 0x1c, 0x97, 0x59, 0xc8,
+	0xe2, 0x4d, 0x18, 0xf6, 0xb8, 0x20, 0x64, 0x32, 0x5c, 0x7a, 0x5d, 0x08, 0x2e, 0x91, 0xcd, 0x8f,
+	0x0a, 0xdc, 0x48, 0x65, 0x13, 0x6b, 0x88, 0xd7, 0x02, 0x5c, 0xf6, 0x02, 0x3b, 0xac, 0xba, 0x9b,
+	0xe9, 0x27, 0x16, 0x6b, 0xa5, 0xa6, 0xb6, 0x6c, 0xde, 0x10, 0xdb, 0xac, 0xdf, 0x04, 0xe1, 0xc5,
+	0xae, 0x84, 0x05, 0x87, 0x36, 0xc6, 0x5f, 0x48, 0xc2, 0xfb, 0x98, 0xd8, 0x2e, 0x71, 0x12, 0x2d,
+	0xf5, 0xff, 0x9e, 0xde, 0x33, 0x05, 0xf2, 0x9d, 0xdc, 0x47, 0x09, 0x4b, 0x76, 0xea, 0x4c, 0x97,
+	0x9c, 0xc9, 0x6c, 0xc5, 0x80, 0x97, 0x97, 0xb0, 0x6d, 0x78, 0x83, 0x33, 0x3e, 0xa8, 0x9a, 0xb4,
+	0xb2, 0x47, 0xcb, 0x81, 0x77, 0xd8, 0xc3, 0x8d, 0xf9, 0x19, 0xe4, 0x92, 0x68, 0x19, 0xe9, 0x1d,
+	0x18, 0xc2, 0x5c, 0x22, 0x2f, 0xca, 0xb9, 0x0e, 0xbd, 0xdc, 0x82, 0x86, 0x57, 0xba, 0x80, 0x69,
+	0xab, 0x70, 0x5d, 0x18, 0xf7, 0xb9, 0xce, 0xdd, 0x9a, 0x57, 0x27, 0x2c, 0xeb, 0x1c, 0xb5, 0x7f,
+	0xfa, 0x41, 0x4d, 0x83, 0x48, 0x46, 0x06, 0x5c, 0xa5, 0x4d, 0xb1, 0x61, 0x72, 0xb9, 0x68, 0x9b,
+	0xdd, 0xed, 0xa6, 0xd3, 0x3f, 0x5f, 0xcd, 0x2c, 0x38, 0x2e, 0xab, 0xd4, 0xad, 0x42, 0xd9, 0xab,
+	0xc9, 0xe1, 0x29, 0x7f, 0x56, 0xa8, 0xfd, 0x48, 0x0e, 0xb5, 0x22, 0x61, 0x2f, 0x7f, 0x5a, 0x01,
+	0x99, 0xe7, 0x22, 0x61, 0xa5, 0x11, 0xda, 0x72, 0x84, 0x1e, 0xc2, 0x44, 0xcd, 0x3c, 0x32, 0xda,
+	0x9c, 0xf4, 0x5f, 0x82, 0x93, 0xb1, 0x9a, 0x79, 0x14, 0x0b, 0x08, 0x39, 0x30, 0x11, 0xe0, 0x9a,
+	0xe9, 0x12, 0x97, 0x38, 0xa1, 0x9f, 0x2b, 0x97, 0xe0, 0x67, 0x3c, 0xb2, 0x2a, 0x1d, 0x2d, 0xc3,
+	0xe4, 0xa1, 0x4b, 0x6c, 0xef, 0xd0, 0xc0, 0xc4, 0x36, 0x2a, 0xd8, 0x75, 0x2a, 0x2c, 0x37, 0xc0,
+	0xeb, 0x60, 0x5c, 0xfc, 0xb1, 0x47, 0xec, 0x7b, 0x5c, 0xbc, 0xfe, 0x74, 0x14, 0x06, 0x79, 0xf2,
+	0xd1, 0xd7, 0x0a, 0x0c, 0x89, 0x21, 0x8d, 0x96, 0xd2, 0x0f, 0x3d, 0xb9, 0x13, 0xa8, 0x6f, 0x9d,
+	0x43, 0x53, 0x9c, 0xa3, 0x36, 0xff, 0xd5, 0x6f, 0x7f, 0x3f, 0xed, 0xcf, 0xa3, 0x69, 0x3d, 0x63,
+	0x45, 0x41, 0xcf, 0x14, 0x98, 0x4c, 0x0c, 0x3b, 0xb4, 0x91, 0xe1, 0xa6, 0xd3, 0xee, 0xa0, 0x6e,
+	0xf6, 0x06, 0x92, 0x34, 0x57, 0x39, 0xcd, 0x65, 0xb4, 0x94, 0x4e, 0xd3, 0x94, 0x40, 0x23, 0x12,
+	0xa1, 0x5f, 0x15, 0xc8, 0x75, 0x9a, 0xf5, 0x68, 0x2b, 0x83, 0x44, 0x97, 0x45, 0x42, 0x7d, 0xef,
+	0x42, 0x58, 0x19, 0xc7, 0x6d, 0x1e, 0xc7, 0x3a, 0x5a, 0x4d, 0x8f, 0xa3, 0xca, 0xf1, 0x46, 0x32,
+	0x1c, 0x8a, 0x7e, 0x57, 0x40, 0xed, 0xbc, 0x1e, 0xa0, 0xed, 0x0c, 0x56, 0x5d, 0x17, 0x10, 0x75,
+	0xe7, 0x82, 0x68, 0x19, 0xd5, 0x16, 0x8f, 0x6a, 0x13, 0xad, 0xa7, 0x47, 0xe5, 0x72, 0x0b, 0x86,
+	0xd9, 0x32, 0x61, 0xd0, 0x88, 0xf8, 0xcf, 0x0a, 0x4c, 0x26, 0xae, 0xf8, 0xcc, 0xd2, 0xea, 0x34,
+	0x8f, 0xd4, 0xcd, 0xde, 0x40, 0xe7, 0x3b, 0x12, 0x5f, 0x00, 0x63, 0x47, 0xa1, 0x1f, 0xf3, 0xdb,
+	0xf2, 0x04, 0xfd, 0xa2, 0xc0, 0x54, 0xda, 0x76, 0x83, 0xde, 0xce, 0x20, 0x92, 0xb1, 0x0e, 0xa9,
+	0x1b, 0x3d, 0xe0, 0x22, 0xfe, 0x3b, 0x9c, 0xff, 0x3b, 0xe8, 0x56, 0x3a, 0xff, 0xe8, 0xc9, 0xa8,
+	0x08, 0xa0, 0x61, 0x35, 0x0c, 0xea, 0x47, 0x41, 0xbc, 0x54, 0x20, 0xd7, 0x69, 0x41, 0xca, 0xec,
+	0x93, 0x2e, 0x5b, 0xd5, 0xc5, 0x82, 0xd9, 0xe3, 0xc1, 0xdc, 0x41, 0x3b, 0x3d, 0x04, 0x23, 0x56,
+	0x35, 0xfd, 0x38, 0xda, 0xe1, 0x4e, 0xd0, 0xf7, 0x0a, 0x8c, 0xc4, 0x86, 0x21, 0x5a, 0xc9, 0xe0,
+	0x92, 0x9c, 0xd6, 0x6a, 0xe1, 0xbc, 0xea, 0x92, 0xf5, 0xbb, 0x9c, 0xf5, 0x06, 0x5a, 0x4b, 0x67,
+	0x2d, 0x66, 0x98, 0x98, 0xc4, 0xfa, 0x71, 0x7c, 0x0f, 0x38, 0x41, 0x3f, 0x28, 0x30, 0xda, 0x36,
+	0x61, 0x91, 0x9e, 0xe5, 0x3c, 0x65, 0x7c, 0xab, 0xab, 0xe7, 0x07, 0x48, 0xbe, 0xb7, 0x38, 0x5f,
+	0x1d, 0xad, 0x74, 0xe0, 0xeb, 0xb7, 0x8d, 0xdd, 0xb0, 0x54, 0x76, 0xef, 0x3f, 0x3f, 0xcd, 0x2b,
+	0x2f, 0x4e, 0xf3, 0xca, 0x5f, 0xa7, 0x79, 0xe5, 0xdb, 0xb3, 0x7c, 0xdf, 0x8b, 0xb3, 0x7c, 0xdf,
+	0x1f, 0x67, 0xf9, 0xbe, 0x4f, 0xd7, 0x62, 0x23, 0xd2, 0x22, 0xd6, 0x4a, 0xb9, 0x62, 0xba, 0x24,
+	0x6e, 0xfc, 0xe8, 0xbf, 0xdf, 0xb4, 0xd6, 0x10, 0xff, 0xa8, 0xdd, 0xf8, 0x77, 0x00, 0xe0, 0xee,
+	0xe8, 0x37, 0xce, 0x0f, 0x00, 0x00,
 }
 
-func (m *SubmitInterval) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the attested challenge by challenge id.
+	AttestedChallenge(ctx context.Context, in *QueryAttestedChallengeRequest, opts ...grpc.CallOption) (*QueryAttestedChallengeResponse, error)
+	// Queries the latest attested challenges.
+	LatestAttestedChallenges(ctx context.Context, in *QueryLatestAttestedChallengesRequest, opts ...grpc.CallOption) (*QueryLatestAttestedChallengesResponse, error)
+	// Queries the inturn challenger.
+	InturnAttestationSubmitter(ctx context.Context, in *QueryInturnAttestationSubmitterRequest, opts ...grpc.CallOption) (*QueryInturnAttestationSubmitterResponse, error)
+	// Queries the pending (not expired) challenges of a storage provider.
+	PendingChallenges(ctx context.Context, in *QueryPendingChallengesRequest, opts ...grpc.CallOption) (*QueryPendingChallengesResponse, error)
+	// Queries the challenge history of a storage provider.
+	ChallengeHistoryBySp(ctx context.Context, in *QueryChallengeHistoryBySpRequest, opts ...grpc.CallOption) (*QueryChallengeHistoryResponse, error)
+	// Queries the challenge history of an object.
+	ChallengeHistoryByObject(ctx context.Context, in *QueryChallengeHistoryByObjectRequest, opts ...grpc.CallOption) (*QueryChallengeHistoryResponse, error)
+	// Queries the escrowed slash of a challenge.
+	SlashEscrow(ctx context.Context, in *QuerySlashEscrowRequest, opts ...grpc.CallOption) (*QuerySlashEscrowResponse, error)
+	// Queries the slashed amount of a storage provider in the current slash counting window, and the remaining cap.
+	SpSlashAmount(ctx context.Context, in *QuerySpSlashAmountRequest, opts ...grpc.CallOption) (*QuerySpSlashAmountResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SubmitInterval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttestedChallenge(ctx context.Context, in *QueryAttestedChallengeRequest, opts ...grpc.CallOption) (*QueryAttestedChallengeResponse, error) {
+	out := new(QueryAttestedChallengeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/AttestedChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubmitInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.End != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.End))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Start != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) LatestAttestedChallenges(ctx context.Context, in *QueryLatestAttestedChallengesRequest, opts ...grpc.CallOption) (*QueryLatestAttestedChallengesResponse, error) {
+	out := new(QueryLatestAttestedChallengesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/LatestAttestedChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) InturnAttestationSubmitter(ctx context.Context, in *QueryInturnAttestationSubmitterRequest, opts ...grpc.CallOption) (*QueryInturnAttestationSubmitterResponse, error) {
+	out := new(QueryInturnAttestationSubmitterResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/InturnAttestationSubmitter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) PendingChallenges(ctx context.Context, in *QueryPendingChallengesRequest, opts ...grpc.CallOption) (*QueryPendingChallengesResponse, error) {
+	out := new(QueryPendingChallengesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/PendingChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ChallengeHistoryBySp(ctx context.Context, in *QueryChallengeHistoryBySpRequest, opts ...grpc.CallOption) (*QueryChallengeHistoryResponse, error) {
+	out := new(QueryChallengeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/ChallengeHistoryBySp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAttestedChallengeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChallengeId != 0 {
-		n += 1 + sovQuery(uint64(m.ChallengeId))
+func (c *queryClient) ChallengeHistoryByObject(ctx context.Context, in *QueryChallengeHistoryByObjectRequest, opts ...grpc.CallOption) (*QueryChallengeHistoryResponse, error) {
+	out := new(QueryChallengeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/ChallengeHistoryByObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAttestedChallengeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Challenge != nil {
-		l = m.Challenge.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) SlashEscrow(ctx context.Context, in *QuerySlashEscrowRequest, opts ...grpc.CallOption) (*QuerySlashEscrowResponse, error) {
+	out := new(QuerySlashEscrowResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/SlashEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryLatestAttestedChallengesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SpSlashAmount(ctx context.Context, in *QuerySpSlashAmountRequest, opts ...grpc.CallOption) (*QuerySpSlashAmountResponse, error) {
+	out := new(QuerySpSlashAmountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Query/SpSlashAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryLatestAttestedChallengesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Challenges) > 0 {
-		for _, e := range m.Challenges {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the attested challenge by challenge id.
+	AttestedChallenge(context.Context, *QueryAttestedChallengeRequest) (*QueryAttestedChallengeResponse, error)
+	// Queries the latest attested challenges.
+	LatestAttestedChallenges(context.Context, *QueryLatestAttestedChallengesRequest) (*QueryLatestAttestedChallengesResponse, error)
+	// Queries the inturn challenger.
+	InturnAttestationSubmitter(context.Context, *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error)
+	// Queries the pending (not expired) challenges of a storage provider.
+	PendingChallenges(context.Context, *QueryPendingChallengesRequest) (*QueryPendingChallengesResponse, error)
+	// Queries the challenge history of a storage provider.
+	ChallengeHistoryBySp(context.Context, *QueryChallengeHistoryBySpRequest) (*QueryChallengeHistoryResponse, error)
+	// Queries the challenge history of an object.
+	ChallengeHistoryByObject(context.Context, *QueryChallengeHistoryByObjectRequest) (*QueryChallengeHistoryResponse, error)
+	// Queries the escrowed slash of a challenge.
+	SlashEscrow(context.Context, *QuerySlashEscrowRequest) (*QuerySlashEscrowResponse, error)
+	// Queries the slashed amount of a storage provider in the current slash counting window, and the remaining cap.
+	SpSlashAmount(context.Context, *QuerySpSlashAmountRequest) (*QuerySpSlashAmountResponse, error)
 }
 
-func (m *QueryInturnAttestationSubmitterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryInturnAttestationSubmitterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AttestedChallenge(ctx context.Context, req *QueryAttestedChallengeRequest) (*QueryAttestedChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestedChallenge not implemented")
+}
+func (*UnimplementedQueryServer) LatestAttestedChallenges(ctx context.Context, req *QueryLatestAttestedChallengesRequest) (*QueryLatestAttestedChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestedChallenges not implemented")
+}
+func (*UnimplementedQueryServer) InturnAttestationSubmitter(ctx context.Context, req *QueryInturnAttestationSubmitterRequest) (*QueryInturnAttestationSubmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InturnAttestationSubmitter not implemented")
+}
+func (*UnimplementedQueryServer) PendingChallenges(ctx context.Context, req *QueryPendingChallengesRequest) (*QueryPendingChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingChallenges not implemented")
+}
+func (*UnimplementedQueryServer) ChallengeHistoryBySp(ctx context.Context, req *QueryChallengeHistoryBySpRequest) (*QueryChallengeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeHistoryBySp not implemented")
+}
+func (*UnimplementedQueryServer) ChallengeHistoryByObject(ctx context.Context, req *QueryChallengeHistoryByObjectRequest) (*QueryChallengeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeHistoryByObject not implemented")
+}
+func (*UnimplementedQueryServer) SlashEscrow(ctx context.Context, req *QuerySlashEscrowRequest) (*QuerySlashEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashEscrow not implemented")
+}
+func (*UnimplementedQueryServer) SpSlashAmount(ctx context.Context, req *QuerySpSlashAmountRequest) (*QuerySpSlashAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpSlashAmount not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.BlsPubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.SubmitInterval != nil {
-		l = m.SubmitInterval.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SubmitInterval) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestedChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestedChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	if interceptor == nil {
+		return srv.(QueryServer).AttestedChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/AttestedChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestedChallenge(ctx, req.(*QueryAttestedChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestAttestedChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestAttestedChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAttestedChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/LatestAttestedChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAttestedChallenges(ctx, req.(*QueryLatestAttestedChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InturnAttestationSubmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInturnAttestationSubmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InturnAttestationSubmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/InturnAttestationSubmitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InturnAttestationSubmitter(ctx, req.(*QueryInturnAttestationSubmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/PendingChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingChallenges(ctx, req.(*QueryPendingChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChallengeHistoryBySp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChallengeHistoryBySpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChallengeHistoryBySp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/ChallengeHistoryBySp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChallengeHistoryBySp(ctx, req.(*QueryChallengeHistoryBySpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChallengeHistoryByObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChallengeHistoryByObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChallengeHistoryByObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/ChallengeHistoryByObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChallengeHistoryByObject(ctx, req.(*QueryChallengeHistoryByObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/SlashEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashEscrow(ctx, req.(*QuerySlashEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpSlashAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpSlashAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpSlashAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Query/SpSlashAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpSlashAmount(ctx, req.(*QuerySpSlashAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.challenge.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AttestedChallenge",
+			Handler:    _Query_AttestedChallenge_Handler,
+		},
+		{
+			MethodName: "LatestAttestedChallenges",
+			Handler:    _Query_LatestAttestedChallenges_Handler,
+		},
+		{
+			MethodName: "InturnAttestationSubmitter",
+			Handler:    _Query_InturnAttestationSubmitter_Handler,
+		},
+		{
+			MethodName: "PendingChallenges",
+			Handler:    _Query_PendingChallenges_Handler,
+		},
+		{
+			MethodName: "ChallengeHistoryBySp",
+			Handler:    _Query_ChallengeHistoryBySp_Handler,
+		},
+		{
+			MethodName: "ChallengeHistoryByObject",
+			Handler:    _Query_ChallengeHistoryByObject_Handler,
+		},
+		{
+			MethodName: "SlashEscrow",
+			Handler:    _Query_SlashEscrow_Handler,
+		},
+		{
+			MethodName: "SpSlashAmount",
+			Handler:    _Query_SpSlashAmount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/challenge/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestedChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestedChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestedChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestedChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestedChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestedChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Challenge != nil {
+		{
+			size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestedChallengesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestedChallengesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestedChallengesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestedChallengesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestedChallengesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestedChallengesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInturnAttestationSubmitterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInturnAttestationSubmitterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInturnAttestationSubmitterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInturnAttestationSubmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInturnAttestationSubmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInturnAttestationSubmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitInterval != nil {
+		{
+			size, err := m.SubmitInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengeHistoryBySpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengeHistoryBySpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengeHistoryBySpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengeHistoryByObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengeHistoryByObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengeHistoryByObjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChallengesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChallengesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChallengesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChallengesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChallengesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChallengesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpSlashAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpSlashAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpSlashAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpSlashAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpSlashAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpSlashAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSlashAmount.Size()
+		i -= size
+		if _, err := m.MaxSlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestedChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovQuery(uint64(m.ChallengeId))
+	}
+	return n
+}
+
+func (m *QueryAttestedChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Challenge != nil {
+		l = m.Challenge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestedChallengesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestedChallengesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInturnAttestationSubmitterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInturnAttestationSubmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitInterval != nil {
+		l = m.SubmitInterval.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmitInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Start != 0 {
 		n += 1 + sovQuery(uint64(m.Start))
 	}
-	if m.End != 0 {
-		n += 1 + sovQuery(uint64(m.End))
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	return n
+}
+
+func (m *QueryChallengeHistoryBySpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengeHistoryByObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingChallengesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingChallengesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovQuery(uint64(m.ChallengeId))
+	}
+	return n
+}
+
+func (m *QuerySlashEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpSlashAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovQuery(uint64(m.SpId))
+	}
+	return n
+}
+
+func (m *QuerySpSlashAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxSlashAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEndHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestedChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestedChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestedChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestedChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Challenge == nil {
+				m.Challenge = &AttestedChallenge{}
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, &AttestedChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitInterval == nil {
+				m.SubmitInterval = &SubmitInterval{}
+			}
+			if err := m.SubmitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChallengeHistoryBySpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChallengeHistoryBySpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChallengeHistoryBySpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryChallengeHistoryByObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChallengeHistoryByObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChallengeHistoryByObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1105,22 +3206,92 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChallengeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChallengeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ChallengeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1142,7 +3313,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChallengesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1165,15 +3336,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChallengesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1200,7 +3390,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1225,7 +3418,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChallengesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,17 +3441,17 @@ func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestedChallengeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChallengesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestedChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
 			}
-			m.ChallengeId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1268,64 +3461,29 @@ func (m *QueryAttestedChallengeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChallengeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Challenges = append(m.Challenges, Challenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestedChallengeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestedChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1352,10 +3510,10 @@ func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Challenge == nil {
-				m.Challenge = &AttestedChallenge{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1380,7 +3538,7 @@ func (m *QueryAttestedChallengeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,12 +3561,31 @@ func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1430,7 +3607,7 @@ func (m *QueryLatestAttestedChallengesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,15 +3630,15 @@ func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestedChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1488,8 +3665,7 @@ func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenges = append(m.Challenges, &AttestedChallenge{})
-			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1514,7 +3690,7 @@ func (m *QueryLatestAttestedChallengesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpSlashAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1537,12 +3713,31 @@ func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpSlashAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpSlashAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1564,7 +3759,7 @@ func (m *QueryInturnAttestationSubmitterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpSlashAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1587,15 +3782,15 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpSlashAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInturnAttestationSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpSlashAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1623,13 +3818,15 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1639,83 +3836,31 @@ func (m *QueryInturnAttestationSubmitterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitInterval == nil {
-				m.SubmitInterval = &SubmitInterval{}
-			}
-			if err := m.SubmitInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitInterval: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitInterval: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
 			}
-			m.Start = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1725,16 +3870,31 @@ func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEndHeight", wireType)
 			}
-			m.End = 0
+			m.WindowEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1744,7 +3904,7 @@ func (m *SubmitInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= uint64(b&0x7F) << shift
+				m.WindowEndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -186,6 +186,9 @@ type MsgAttest struct {
 	VoteValidatorSet []uint64 `protobuf:"fixed64,7,rep,packed,name=vote_validator_set,json=voteValidatorSet,proto3" json:"vote_validator_set,omitempty"`
 	// The aggregated BLS signature from the validators.
 	VoteAggSignature []byte `protobuf:"bytes,8,opt,name=vote_agg_signature,json=voteAggSignature,proto3" json:"vote_agg_signature,omitempty"`
+	// The sampled segment/piece indexes which failed the challenge, the slash amount scales with the number of them.
+	// It can be empty when the challenge succeeds, which is taken as one failed segment/piece.
+	FailedSegmentIndexes []uint32 `protobuf:"varint,9,rep,packed,name=failed_segment_indexes,json=failedSegmentIndexes,proto3" json:"failed_segment_indexes,omitempty"`
 }
 
 func (m *MsgAttest) Reset()         { *m = MsgAttest{} }
@@ -270,6 +273,13 @@ func (m *MsgAttest) GetVoteAggSignature() []byte {
 	return nil
 }
 
+func (m *MsgAttest) GetFailedSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.FailedSegmentIndexes
+	}
+	return nil
+}
+
 // MsgAttest defines the response of MsgAttestResponse.
 type MsgAttestResponse struct {
 }
@@ -307,6 +317,247 @@ func (m *MsgAttestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAttestResponse proto.InternalMessageInfo
 
+// MsgAppealSlash defines the message for a storage provider to appeal an escrowed slash.
+type MsgAppealSlash struct {
+	// The operator address of the slashed storage provider.
+	SpOperatorAddress string `protobuf:"bytes,1,opt,name=sp_operator_address,json=spOperatorAddress,proto3" json:"sp_operator_address,omitempty"`
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The failed segments/pieces of the escrowed slash, in the order of its failed segment indexes. Each of them is
+	// verified against the checksums of the object with the piece hashes.
+	SegmentProofs [][]byte `protobuf:"bytes,3,rep,name=segment_proofs,json=segmentProofs,proto3" json:"segment_proofs,omitempty"`
+	// The BLS signature of the storage provider over the appeal.
+	BlsSignature []byte `protobuf:"bytes,4,opt,name=bls_signature,json=blsSignature,proto3" json:"bls_signature,omitempty"`
+	// The hashes of all the segments/pieces stored by the storage provider for the object, whose integrity hash is
+	// in the checksums of the object.
+	PieceHashes [][]byte `protobuf:"bytes,5,rep,name=piece_hashes,json=pieceHashes,proto3" json:"piece_hashes,omitempty"`
+}
+
+func (m *MsgAppealSlash) Reset()         { *m = MsgAppealSlash{} }
+func (m *MsgAppealSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlash) ProtoMessage()    {}
+func (*MsgAppealSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{4}
+}
+func (m *MsgAppealSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlash.Merge(m, src)
+}
+func (m *MsgAppealSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealSlash proto.InternalMessageInfo
+
+func (m *MsgAppealSlash) GetSpOperatorAddress() string {
+	if m != nil {
+		return m.SpOperatorAddress
+	}
+	return ""
+}
+
+func (m *MsgAppealSlash) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *MsgAppealSlash) GetSegmentProofs() [][]byte {
+	if m != nil {
+		return m.SegmentProofs
+	}
+	return nil
+}
+
+func (m *MsgAppealSlash) GetBlsSignature() []byte {
+	if m != nil {
+		return m.BlsSignature
+	}
+	return nil
+}
+
+func (m *MsgAppealSlash) GetPieceHashes() [][]byte {
+	if m != nil {
+		return m.PieceHashes
+	}
+	return nil
+}
+
+// MsgAppealSlashResponse defines the response of MsgAppealSlash.
+type MsgAppealSlashResponse struct {
+}
+
+func (m *MsgAppealSlashResponse) Reset()         { *m = MsgAppealSlashResponse{} }
+func (m *MsgAppealSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlashResponse) ProtoMessage()    {}
+func (*MsgAppealSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{5}
+}
+func (m *MsgAppealSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlashResponse.Merge(m, src)
+}
+func (m *MsgAppealSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealSlashResponse proto.InternalMessageInfo
+
+// MsgAttestAppeal defines the message for attesting the appeal of an escrowed slash.
+type MsgAttestAppeal struct {
+	// The submitter address.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// Whether the appeal is upheld. The escrowed slash is returned to the storage provider if it is upheld,
+	// otherwise the escrowed slash is burned.
+	Upheld bool `protobuf:"varint,3,opt,name=upheld,proto3" json:"upheld,omitempty"`
+	// The validators participated in the attestation.
+	VoteValidatorSet []uint64 `protobuf:"fixed64,4,rep,packed,name=vote_validator_set,json=voteValidatorSet,proto3" json:"vote_validator_set,omitempty"`
+	// The aggregated BLS signature from the validators.
+	VoteAggSignature []byte `protobuf:"bytes,5,opt,name=vote_agg_signature,json=voteAggSignature,proto3" json:"vote_agg_signature,omitempty"`
+}
+
+func (m *MsgAttestAppeal) Reset()         { *m = MsgAttestAppeal{} }
+func (m *MsgAttestAppeal) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestAppeal) ProtoMessage()    {}
+func (*MsgAttestAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{6}
+}
+func (m *MsgAttestAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestAppeal.Merge(m, src)
+}
+func (m *MsgAttestAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestAppeal proto.InternalMessageInfo
+
+func (m *MsgAttestAppeal) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgAttestAppeal) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *MsgAttestAppeal) GetUpheld() bool {
+	if m != nil {
+		return m.Upheld
+	}
+	return false
+}
+
+func (m *MsgAttestAppeal) GetVoteValidatorSet() []uint64 {
+	if m != nil {
+		return m.VoteValidatorSet
+	}
+	return nil
+}
+
+func (m *MsgAttestAppeal) GetVoteAggSignature() []byte {
+	if m != nil {
+		return m.VoteAggSignature
+	}
+	return nil
+}
+
+// MsgAttestAppealResponse defines the response of MsgAttestAppeal.
+type MsgAttestAppealResponse struct {
+}
+
+func (m *MsgAttestAppealResponse) Reset()         { *m = MsgAttestAppealResponse{} }
+func (m *MsgAttestAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestAppealResponse) ProtoMessage()    {}
+func (*MsgAttestAppealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_516ed0ec90010e48, []int{7}
+}
+func (m *MsgAttestAppealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestAppealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestAppealResponse.Merge(m, src)
+}
+func (m *MsgAttestAppealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestAppealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestAppealResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -320,7 +571,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{4}
+	return fileDescriptor_516ed0ec90010e48, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -371,7 +622,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_516ed0ec90010e48, []int{5}
+	return fileDescriptor_516ed0ec90010e48, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,6 +656,10 @@ func init() {
 	proto.RegisterType((*MsgSubmitResponse)(nil), "greenfield.challenge.MsgSubmitResponse")
 	proto.RegisterType((*MsgAttest)(nil), "greenfield.challenge.MsgAttest")
 	proto.RegisterType((*MsgAttestResponse)(nil), "greenfield.challenge.MsgAttestResponse")
+	proto.RegisterType((*MsgAppealSlash)(nil), "greenfield.challenge.MsgAppealSlash")
+	proto.RegisterType((*MsgAppealSlashResponse)(nil), "greenfield.challenge.MsgAppealSlashResponse")
+	proto.RegisterType((*MsgAttestAppeal)(nil), "greenfield.challenge.MsgAttestAppeal")
+	proto.RegisterType((*MsgAttestAppealResponse)(nil), "greenfield.challenge.MsgAttestAppealResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "greenfield.challenge.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.challenge.MsgUpdateParamsResponse")
 }
@@ -412,51 +667,63 @@ func init() {
 func init() { proto.RegisterFile("greenfield/challenge/tx.proto", fileDescriptor_516ed0ec90010e48) }
 
 var fileDescriptor_516ed0ec90010e48 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x4f, 0xdb, 0x3c,
-	0x18, 0xc7, 0x9b, 0xb6, 0x6f, 0x5f, 0xea, 0x16, 0x78, 0x31, 0x48, 0x84, 0xbe, 0x5b, 0x1b, 0x3a,
-	0x4d, 0xab, 0xa6, 0xb5, 0x11, 0x4c, 0x42, 0x88, 0x5b, 0x7b, 0x1a, 0x9a, 0xd8, 0xa6, 0x54, 0x70,
-	0xd8, 0x25, 0x72, 0x1a, 0xcf, 0xcd, 0xd6, 0xc4, 0x51, 0xec, 0x56, 0x70, 0xdd, 0x27, 0x98, 0x34,
-	0xed, 0x4b, 0xec, 0x30, 0xed, 0xc0, 0x87, 0xe0, 0x88, 0x38, 0x4d, 0x3b, 0xa0, 0x09, 0x0e, 0xfb,
-	0x1a, 0x53, 0x6c, 0x27, 0x2d, 0x10, 0x06, 0xa7, 0xc6, 0xcf, 0xf3, 0xf3, 0xf3, 0x7f, 0xfa, 0x7f,
-	0x6c, 0x83, 0x87, 0x24, 0xc2, 0x38, 0x78, 0xe7, 0xe1, 0x91, 0x6b, 0x0e, 0x86, 0x68, 0x34, 0xc2,
-	0x01, 0xc1, 0x26, 0x3f, 0xec, 0x84, 0x11, 0xe5, 0x14, 0xae, 0x4c, 0xd3, 0x9d, 0x34, 0x5d, 0x5b,
-	0x1d, 0x50, 0xe6, 0x53, 0x66, 0xfa, 0x8c, 0x98, 0x93, 0x8d, 0xf8, 0x47, 0xe2, 0xb5, 0x35, 0x99,
-	0xb0, 0xc5, 0xca, 0x94, 0x0b, 0x95, 0x5a, 0x21, 0x94, 0x50, 0x19, 0x8f, 0xbf, 0x54, 0x74, 0x3d,
-	0x53, 0x3e, 0x44, 0x11, 0xf2, 0x93, 0x8d, 0x46, 0x76, 0x87, 0x47, 0x21, 0x56, 0x44, 0xf3, 0x6b,
-	0x1e, 0x94, 0xf7, 0x18, 0xe9, 0x8f, 0x1d, 0xdf, 0xe3, 0x70, 0x1b, 0x80, 0x14, 0x8b, 0x74, 0xcd,
-	0xd0, 0x5a, 0xe5, 0x9e, 0x7e, 0x76, 0xdc, 0x5e, 0x51, 0xed, 0x74, 0x5d, 0x37, 0xc2, 0x8c, 0xf5,
-	0x79, 0xe4, 0x05, 0xc4, 0x9a, 0x61, 0xe1, 0x0b, 0xb0, 0xcc, 0x42, 0x9b, 0x86, 0x38, 0x42, 0x9c,
-	0x46, 0x36, 0x92, 0xa0, 0x9e, 0xbf, 0xa3, 0xc4, 0x12, 0x0b, 0x5f, 0xab, 0x3d, 0x2a, 0x01, 0x1b,
-	0xa0, 0xe2, 0x8c, 0x07, 0x1f, 0x30, 0xb7, 0x03, 0xe4, 0x63, 0xbd, 0x10, 0x57, 0xb0, 0x80, 0x0c,
-	0xbd, 0x42, 0x3e, 0x8e, 0x01, 0xea, 0xbc, 0xc7, 0x03, 0x05, 0x14, 0x25, 0x20, 0x43, 0x02, 0x78,
-	0x04, 0xe6, 0x19, 0x26, 0x3e, 0x0e, 0xb8, 0xed, 0x05, 0x2e, 0x3e, 0xd4, 0xff, 0x31, 0xb4, 0xd6,
-	0xbc, 0x55, 0x55, 0xc1, 0xdd, 0x38, 0x06, 0xd7, 0x41, 0x35, 0x42, 0x81, 0x4b, 0x7d, 0xc5, 0x94,
-	0x0c, 0xad, 0x35, 0x67, 0x55, 0x64, 0x4c, 0x20, 0x3b, 0x8b, 0x1f, 0x7f, 0x7f, 0x7f, 0x3a, 0xf3,
-	0x27, 0x9b, 0x5b, 0x60, 0x29, 0xf5, 0xca, 0xc2, 0x2c, 0xa4, 0x01, 0xc3, 0x71, 0xa1, 0x14, 0xb1,
-	0x3d, 0x57, 0xb8, 0x56, 0xb4, 0x2a, 0x69, 0x6c, 0xd7, 0x6d, 0x7e, 0x2b, 0x08, 0x93, 0xbb, 0x9c,
-	0x63, 0xc6, 0xe1, 0x16, 0x28, 0x33, 0x51, 0x82, 0xdf, 0xc3, 0xe3, 0x29, 0x7a, 0x43, 0x28, 0x7f,
-	0x43, 0x08, 0x6e, 0x83, 0xb2, 0xb2, 0xc6, 0x73, 0xa5, 0x73, 0xbd, 0xff, 0x4f, 0xce, 0x1b, 0xb9,
-	0x9f, 0xe7, 0x8d, 0xe2, 0xbe, 0x17, 0xf0, 0xb3, 0xe3, 0x76, 0x45, 0xc9, 0xc4, 0x4b, 0x6b, 0x4e,
-	0xd2, 0xbb, 0x2e, 0xec, 0x64, 0xcf, 0x4f, 0x9a, 0x9b, 0x31, 0xa5, 0x2e, 0xa8, 0x4c, 0x28, 0xc7,
-	0x76, 0x84, 0xd9, 0x78, 0xc4, 0x85, 0xc3, 0x0b, 0x9b, 0x46, 0x27, 0xeb, 0xc8, 0x77, 0x0e, 0x28,
-	0xc7, 0x96, 0xe0, 0x2c, 0x30, 0x49, 0xbf, 0x61, 0x1b, 0xc0, 0xa9, 0xb7, 0xa9, 0x62, 0x49, 0x2a,
-	0x4e, 0x33, 0x89, 0xe2, 0x33, 0x00, 0x85, 0xe2, 0x04, 0x8d, 0x3c, 0x57, 0x34, 0xc9, 0x30, 0xd7,
-	0xff, 0x35, 0x0a, 0xad, 0x92, 0xf5, 0x5f, 0x9c, 0x39, 0x48, 0x12, 0x7d, 0xcc, 0x53, 0x1a, 0x11,
-	0x62, 0x33, 0x8f, 0x04, 0x88, 0x8f, 0x23, 0xac, 0xcf, 0x19, 0x5a, 0xab, 0x2a, 0xe9, 0x2e, 0x21,
-	0xfd, 0x24, 0xbe, 0xb3, 0x10, 0x4f, 0x7a, 0x6a, 0x75, 0x73, 0x59, 0x0c, 0x5a, 0xce, 0x2b, 0x19,
-	0x74, 0xf3, 0x8b, 0x06, 0x16, 0xf7, 0x18, 0xd9, 0x0f, 0x5d, 0xc4, 0xf1, 0x1b, 0x71, 0xcd, 0xe2,
-	0x59, 0xa2, 0x31, 0x1f, 0xd2, 0xc8, 0xe3, 0x47, 0x77, 0xcf, 0x32, 0x45, 0xe1, 0x0e, 0x28, 0xc9,
-	0x8b, 0x2a, 0xa6, 0x58, 0xd9, 0x7c, 0x90, 0xed, 0x9c, 0x54, 0xe9, 0x15, 0xe3, 0x19, 0x5a, 0x6a,
-	0x87, 0x6a, 0x36, 0xad, 0xd5, 0x5c, 0x03, 0xab, 0xd7, 0xda, 0x4a, 0x5a, 0xde, 0xfc, 0x9c, 0x07,
-	0x85, 0x3d, 0x46, 0xa0, 0x05, 0x4a, 0xea, 0x86, 0x37, 0xb2, 0x85, 0xd2, 0x63, 0x5d, 0x7b, 0x72,
-	0x07, 0x90, 0x9e, 0x7b, 0x0b, 0x94, 0xd4, 0x81, 0xbe, 0xbd, 0xa6, 0x04, 0xfe, 0x52, 0xf3, 0xaa,
-	0xc5, 0xd0, 0x05, 0xd5, 0x2b, 0xf6, 0x3e, 0xbe, 0x75, 0xe3, 0x2c, 0x56, 0x6b, 0xdf, 0x0b, 0x4b,
-	0x54, 0x7a, 0x2f, 0x4f, 0x2e, 0xea, 0xda, 0xe9, 0x45, 0x5d, 0xfb, 0x75, 0x51, 0xd7, 0x3e, 0x5d,
-	0xd6, 0x73, 0xa7, 0x97, 0xf5, 0xdc, 0x8f, 0xcb, 0x7a, 0xee, 0xed, 0x06, 0xf1, 0xf8, 0x70, 0xec,
-	0x74, 0x06, 0xd4, 0x37, 0x9d, 0xc0, 0x69, 0x0f, 0x86, 0xc8, 0x0b, 0xcc, 0x99, 0x47, 0xf4, 0xf0,
-	0xfa, 0x33, 0xea, 0x94, 0xc4, 0x3b, 0xfa, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x8b,
-	0xeb, 0x8a, 0x0d, 0x06, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0x4d, 0x4b, 0x56, 0xad, 0x93, 0xec, 0xd4, 0x8c, 0xe1, 0x30, 0x6a, 0x2b, 0xd3, 0x6a,
+	0x83, 0x08, 0x45, 0x24, 0x21, 0x6e, 0x11, 0x04, 0xde, 0xec, 0x29, 0x46, 0x91, 0x36, 0x38, 0x21,
+	0x19, 0xba, 0x10, 0x47, 0xf1, 0x99, 0x64, 0x4b, 0xf2, 0x08, 0xde, 0xc9, 0x70, 0xd6, 0x0e, 0x9d,
+	0xbb, 0xf4, 0x4b, 0x74, 0xea, 0x90, 0x0f, 0x91, 0x31, 0xc8, 0x54, 0x14, 0x45, 0x50, 0xd8, 0x05,
+	0xba, 0xf6, 0x23, 0x14, 0xbc, 0x3b, 0x9e, 0xa8, 0x98, 0x82, 0xe4, 0x21, 0x93, 0xc4, 0xf7, 0x7e,
+	0xf7, 0xde, 0xbb, 0xff, 0xbd, 0x77, 0x24, 0xfa, 0xcc, 0xcf, 0x00, 0x92, 0xb3, 0x10, 0x22, 0x6f,
+	0x34, 0x09, 0x48, 0x14, 0x41, 0xe2, 0xc3, 0x88, 0x5f, 0x0c, 0xd3, 0x8c, 0x72, 0x6a, 0xee, 0xce,
+	0xdc, 0x43, 0xed, 0xee, 0xdc, 0x99, 0x50, 0x16, 0x53, 0x36, 0x8a, 0x99, 0x3f, 0x3a, 0x7f, 0x98,
+	0xff, 0x48, 0xbc, 0x73, 0x57, 0x3a, 0x1c, 0xf1, 0x34, 0x92, 0x0f, 0xca, 0xb5, 0xeb, 0x53, 0x9f,
+	0x4a, 0x7b, 0xfe, 0x4f, 0x59, 0x0f, 0x2a, 0xd3, 0xa7, 0x24, 0x23, 0x71, 0xb1, 0xd0, 0xae, 0xae,
+	0xf0, 0x65, 0x0a, 0x8a, 0xe8, 0xfd, 0xb6, 0x8e, 0x9a, 0x4f, 0x99, 0x3f, 0x9e, 0xba, 0x71, 0xc8,
+	0xcd, 0xc7, 0x08, 0x69, 0x2c, 0xb3, 0x0c, 0xdb, 0xe8, 0x37, 0x4f, 0xac, 0xb7, 0xaf, 0x06, 0xbb,
+	0xaa, 0x9c, 0x63, 0xcf, 0xcb, 0x80, 0xb1, 0x31, 0xcf, 0xc2, 0xc4, 0xc7, 0x25, 0xd6, 0x7c, 0x82,
+	0x6e, 0xb3, 0xd4, 0xa1, 0x29, 0x64, 0x84, 0xd3, 0xcc, 0x21, 0x12, 0xb4, 0xd6, 0x97, 0x84, 0xd8,
+	0x61, 0xe9, 0x77, 0x6a, 0x8d, 0x72, 0x98, 0xfb, 0xa8, 0xe5, 0x4e, 0x27, 0x3f, 0x02, 0x77, 0x12,
+	0x12, 0x83, 0x55, 0xcb, 0x23, 0x60, 0x24, 0x4d, 0xdf, 0x92, 0x18, 0x72, 0x80, 0xba, 0x3f, 0xc0,
+	0x44, 0x01, 0x75, 0x09, 0x48, 0x93, 0x00, 0x3e, 0x47, 0x5b, 0x0c, 0xfc, 0x18, 0x12, 0xee, 0x84,
+	0x89, 0x07, 0x17, 0xd6, 0x86, 0x6d, 0xf4, 0xb7, 0x70, 0x5b, 0x19, 0x4f, 0x73, 0x9b, 0x79, 0x80,
+	0xda, 0x19, 0x49, 0x3c, 0x1a, 0x2b, 0xa6, 0x61, 0x1b, 0xfd, 0x4d, 0xdc, 0x92, 0x36, 0x81, 0x1c,
+	0xdd, 0xfa, 0xe9, 0xdf, 0xdf, 0xbf, 0x2c, 0x6d, 0xb2, 0xf7, 0x08, 0xed, 0x68, 0xad, 0x30, 0xb0,
+	0x94, 0x26, 0x0c, 0xf2, 0x40, 0x1a, 0x71, 0x42, 0x4f, 0xa8, 0x56, 0xc7, 0x2d, 0x6d, 0x3b, 0xf5,
+	0x7a, 0xff, 0xd4, 0x84, 0xc8, 0xc7, 0x9c, 0x03, 0xe3, 0xe6, 0x23, 0xd4, 0x64, 0x22, 0x04, 0x5f,
+	0x41, 0xe3, 0x19, 0x7a, 0x2d, 0xd1, 0xfa, 0xb5, 0x44, 0xe6, 0x63, 0xd4, 0x54, 0xd2, 0x84, 0x9e,
+	0x54, 0xee, 0xe4, 0x93, 0xd7, 0xef, 0xf6, 0xd7, 0xfe, 0x7c, 0xb7, 0x5f, 0x7f, 0x1e, 0x26, 0xfc,
+	0xed, 0xab, 0x41, 0x4b, 0xa5, 0xc9, 0x1f, 0xf1, 0xa6, 0xa4, 0x4f, 0x3d, 0x73, 0x58, 0x7d, 0x7e,
+	0x52, 0xdc, 0x8a, 0x53, 0x3a, 0x46, 0xad, 0x73, 0xca, 0xc1, 0xc9, 0x80, 0x4d, 0x23, 0x2e, 0x14,
+	0xde, 0x3e, 0xb4, 0x87, 0x55, 0x2d, 0x3f, 0x7c, 0x41, 0x39, 0x60, 0xc1, 0x61, 0x74, 0xae, 0xff,
+	0x9b, 0x03, 0x64, 0xce, 0xb4, 0xd5, 0x19, 0x1b, 0x32, 0xe3, 0xcc, 0x53, 0x64, 0x7c, 0x80, 0x4c,
+	0x91, 0xf1, 0x9c, 0x44, 0xa1, 0x27, 0x8a, 0x64, 0xc0, 0xad, 0x8f, 0xec, 0x5a, 0xbf, 0x81, 0x3f,
+	0xce, 0x3d, 0x2f, 0x0a, 0xc7, 0x18, 0xb8, 0xa6, 0x89, 0xef, 0x3b, 0x2c, 0xf4, 0x13, 0xc2, 0xa7,
+	0x19, 0x58, 0x9b, 0xb6, 0xd1, 0x6f, 0x4b, 0xfa, 0xd8, 0xf7, 0xc7, 0x85, 0xdd, 0xfc, 0x1a, 0xed,
+	0x9d, 0x91, 0x30, 0x02, 0xcf, 0x99, 0x6b, 0x1c, 0x60, 0x56, 0xd3, 0xae, 0xf5, 0xb7, 0xf0, 0xae,
+	0xf4, 0x8e, 0x4b, 0x0d, 0x04, 0xec, 0x68, 0x3b, 0xef, 0x8f, 0xd9, 0x01, 0xf5, 0x6e, 0xa3, 0x1d,
+	0x7d, 0xca, 0x45, 0x7b, 0xf4, 0x7e, 0x5e, 0x47, 0xdb, 0xb9, 0x35, 0x4d, 0x81, 0x44, 0xe3, 0x88,
+	0xb0, 0x60, 0xd1, 0xac, 0x18, 0x37, 0x9f, 0x95, 0x15, 0x5a, 0xe2, 0x1e, 0xda, 0x2e, 0xf6, 0x94,
+	0x66, 0x94, 0x9e, 0x31, 0xab, 0x66, 0xd7, 0xfa, 0x6d, 0x5c, 0x8c, 0xc8, 0x33, 0x61, 0xcc, 0x67,
+	0xc6, 0x8d, 0x58, 0x49, 0xaa, 0xba, 0x90, 0xaa, 0xed, 0x46, 0x6c, 0x26, 0xd3, 0x01, 0x6a, 0xa7,
+	0x21, 0x4c, 0xc0, 0x09, 0x08, 0x0b, 0x80, 0x59, 0x1b, 0x22, 0x52, 0x4b, 0xd8, 0x9e, 0x08, 0xd3,
+	0x91, 0x95, 0x6b, 0x52, 0xb5, 0xbd, 0x9e, 0x85, 0xf6, 0xe6, 0x75, 0xd0, 0x12, 0xfd, 0x67, 0xa0,
+	0x5b, 0x5a, 0x38, 0x09, 0x7c, 0xc8, 0x21, 0xd9, 0x43, 0x8d, 0x69, 0x1a, 0x40, 0x24, 0x27, 0x64,
+	0x13, 0xab, 0xa7, 0x05, 0x0d, 0x56, 0xbf, 0x51, 0x83, 0x6d, 0x54, 0x37, 0xd8, 0xb5, 0x56, 0xb9,
+	0x8b, 0xee, 0xbc, 0xb7, 0x63, 0xad, 0xc6, 0xaf, 0x52, 0x8d, 0xe7, 0xa9, 0x47, 0x38, 0x3c, 0x13,
+	0xb7, 0x79, 0xae, 0x06, 0x99, 0xf2, 0x80, 0x66, 0x21, 0x7f, 0xb9, 0x5c, 0x0d, 0x8d, 0x9a, 0x47,
+	0xa8, 0x21, 0xdf, 0x07, 0x42, 0x87, 0xd6, 0xe1, 0xa7, 0xd5, 0x03, 0x2a, 0xb3, 0x9c, 0xd4, 0xf3,
+	0xab, 0x02, 0xab, 0x15, 0xaa, 0x64, 0x1d, 0x4b, 0x95, 0x5c, 0x2e, 0xab, 0x28, 0xf9, 0xf0, 0xaf,
+	0x1a, 0xaa, 0x3d, 0x65, 0xbe, 0x89, 0x51, 0x43, 0xbd, 0x48, 0xf6, 0xab, 0x13, 0xe9, 0xdb, 0xb3,
+	0x73, 0x7f, 0x09, 0xa0, 0xaf, 0x57, 0x8c, 0x1a, 0xea, 0xde, 0x5c, 0x1c, 0x53, 0x02, 0x9d, 0xfb,
+	0x4b, 0x00, 0x1d, 0x93, 0xa0, 0x56, 0x79, 0x1e, 0xbf, 0x58, 0xbc, 0x6e, 0x46, 0x75, 0x1e, 0xac,
+	0x42, 0xe9, 0x14, 0x1e, 0x6a, 0xcf, 0xf5, 0xf3, 0xbd, 0x25, 0xb5, 0x49, 0xac, 0x33, 0x58, 0x09,
+	0x2b, 0x67, 0x99, 0xeb, 0x93, 0xc5, 0x59, 0xca, 0x58, 0x67, 0xb0, 0x12, 0x56, 0x64, 0x39, 0xf9,
+	0xe6, 0xf5, 0x65, 0xd7, 0x78, 0x73, 0xd9, 0x35, 0xfe, 0xbe, 0xec, 0x1a, 0xbf, 0x5c, 0x75, 0xd7,
+	0xde, 0x5c, 0x75, 0xd7, 0xfe, 0xb8, 0xea, 0xae, 0x7d, 0xff, 0xd0, 0x0f, 0x79, 0x30, 0x75, 0x87,
+	0x13, 0x1a, 0x8f, 0xdc, 0xc4, 0x1d, 0x4c, 0x02, 0x12, 0x26, 0xa3, 0xd2, 0x47, 0xc7, 0xc5, 0xfb,
+	0x9f, 0x1d, 0x6e, 0x43, 0x7c, 0x77, 0x7c, 0xf5, 0xff, 0x00, 0x26, 0xa7, 0x21, 0x50, 0x3d, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -473,6 +740,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Submit(ctx context.Context, in *MsgSubmit, opts ...grpc.CallOption) (*MsgSubmitResponse, error)
 	Attest(ctx context.Context, in *MsgAttest, opts ...grpc.CallOption) (*MsgAttestResponse, error)
+	AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error)
+	AttestAppeal(ctx context.Context, in *MsgAttestAppeal, opts ...grpc.CallOption) (*MsgAttestAppealResponse, error)
 	// UpdateParams defines a governance operation for updating the x/challenge module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -506,6 +775,24 @@ func (c *msgClient) Attest(ctx context.Context, in *MsgAttest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error) {
+	out := new(MsgAppealSlashResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/AppealSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestAppeal(ctx context.Context, in *MsgAttestAppeal, opts ...grpc.CallOption) (*MsgAttestAppealResponse, error) {
+	out := new(MsgAttestAppealResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/AttestAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/greenfield.challenge.Msg/UpdateParams", in, out, opts...)
@@ -519,6 +806,8 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	Submit(context.Context, *MsgSubmit) (*MsgSubmitResponse, error)
 	Attest(context.Context, *MsgAttest) (*MsgAttestResponse, error)
+	AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error)
+	AttestAppeal(context.Context, *MsgAttestAppeal) (*MsgAttestAppealResponse, error)
 	// UpdateParams defines a governance operation for updating the x/challenge module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -536,6 +825,12 @@ func (*UnimplementedMsgServer) Submit(ctx context.Context, req *MsgSubmit) (*Msg
 func (*UnimplementedMsgServer) Attest(ctx context.Context, req *MsgAttest) (*MsgAttestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Attest not implemented")
 }
+func (*UnimplementedMsgServer) AppealSlash(ctx context.Context, req *MsgAppealSlash) (*MsgAppealSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSlash not implemented")
+}
+func (*UnimplementedMsgServer) AttestAppeal(ctx context.Context, req *MsgAttestAppeal) (*MsgAttestAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestAppeal not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -580,6 +875,42 @@ func _Msg_Attest_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AppealSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Msg/AppealSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealSlash(ctx, req.(*MsgAppealSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.challenge.Msg/AttestAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestAppeal(ctx, req.(*MsgAttestAppeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -610,6 +941,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Attest",
 			Handler:    _Msg_Attest_Handler,
 		},
+		{
+			MethodName: "AppealSlash",
+			Handler:    _Msg_AppealSlash_Handler,
+		},
+		{
+			MethodName: "AttestAppeal",
+			Handler:    _Msg_AttestAppeal_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -733,6 +1072,24 @@ func (m *MsgAttest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedSegmentIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.FailedSegmentIndexes)*10)
+		var j1 int
+		for _, num := range m.FailedSegmentIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.VoteAggSignature) > 0 {
 		i -= len(m.VoteAggSignature)
 		copy(dAtA[i:], m.VoteAggSignature)
@@ -816,7 +1173,7 @@ func (m *MsgAttestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAppealSlash) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -826,37 +1183,57 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAppealSlash) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAppealSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.PieceHashes) > 0 {
+		for iNdEx := len(m.PieceHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PieceHashes[iNdEx])
+			copy(dAtA[i:], m.PieceHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PieceHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.BlsSignature) > 0 {
+		i -= len(m.BlsSignature)
+		copy(dAtA[i:], m.BlsSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SegmentProofs) > 0 {
+		for iNdEx := len(m.SegmentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SegmentProofs[iNdEx])
+			copy(dAtA[i:], m.SegmentProofs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SegmentProofs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpOperatorAddress) > 0 {
+		i -= len(m.SpOperatorAddress)
+		copy(dAtA[i:], m.SpOperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpOperatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAppealSlashResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -866,12 +1243,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAppealSlashResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAppealSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -879,24 +1256,171 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAttestAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Challenger)
+
+func (m *MsgAttestAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteAggSignature) > 0 {
+		i -= len(m.VoteAggSignature)
+		copy(dAtA[i:], m.VoteAggSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteAggSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VoteValidatorSet) > 0 {
+		for iNdEx := len(m.VoteValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.VoteValidatorSet[iNdEx]))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteValidatorSet)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Upheld {
+		i--
+		if m.Upheld {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestAppealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestAppealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenger)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -966,6 +1490,13 @@ func (m *MsgAttest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.FailedSegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.FailedSegmentIndexes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -978,6 +1509,82 @@ func (m *MsgAttestResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAppealSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	if len(m.SegmentProofs) > 0 {
+		for _, b := range m.SegmentProofs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.BlsSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PieceHashes) > 0 {
+		for _, b := range m.PieceHashes {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAppealSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	if m.Upheld {
+		n += 2
+	}
+	if len(m.VoteValidatorSet) > 0 {
+		n += 1 + sovTx(uint64(len(m.VoteValidatorSet)*8)) + len(m.VoteValidatorSet)*8
+	}
+	l = len(m.VoteAggSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestAppealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1577,51 +2184,127 @@ func (m *MsgAttest) Unmarshal(dAtA []byte) error {
 				m.VoteAggSignature = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAttestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAttestResponse: wiretype end group for non-group")
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedSegmentIndexes = append(m.FailedSegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedSegmentIndexes) == 0 {
+					m.FailedSegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedSegmentIndexes = append(m.FailedSegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSegmentIndexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: MsgAttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -1648,6 +2331,512 @@ func (m *MsgAttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAppealSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentProofs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SegmentProofs = append(m.SegmentProofs, make([]byte, postIndex-iNdEx))
+			copy(m.SegmentProofs[len(m.SegmentProofs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsSignature = append(m.BlsSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsSignature == nil {
+				m.BlsSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PieceHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PieceHashes = append(m.PieceHashes, make([]byte, postIndex-iNdEx))
+			copy(m.PieceHashes[len(m.PieceHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppealSlashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealSlashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestAppeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestAppeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upheld", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upheld = bool(v != 0)
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.VoteValidatorSet = append(m.VoteValidatorSet, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.VoteValidatorSet) == 0 {
+					m.VoteValidatorSet = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.VoteValidatorSet = append(m.VoteValidatorSet, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteValidatorSet", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteAggSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteAggSignature = append(m.VoteAggSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoteAggSignature == nil {
+				m.VoteAggSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestAppealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestAppealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,3 +14,12 @@ const RedundancyIndexPrimary = int32(-1)
 
 // BlsSignatureLength defines the length of bls signature
 const BlsSignatureLength = 96
+
+// GetFailedSegments returns the segment/piece indexes which failed the challenge of the escrowed slash, it falls back
+// to the challenged segment/piece index for the escrow without the failed segment/piece indexes.
+func (m *SlashEscrow) GetFailedSegments() []uint32 {
+	if len(m.FailedSegmentIndexes) == 0 {
+		return []uint32{m.SegmentIndex}
+	}
+	return m.FailedSegmentIndexes
+}
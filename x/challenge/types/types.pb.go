@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/bnb-chain/greenfield/x/sp/types"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -52,6 +54,38 @@ func (VoteResult) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9c297f0764c47d40, []int{0}
 }
 
+// SlashEscrowResult defines how the escrowed slash of a storage provider is settled.
+type SlashEscrowResult int32
+
+const (
+	// The escrowed slash is distributed as rewards, for the slash is not appealed or the appeal is not attested in time.
+	SLASH_ESCROW_DISTRIBUTED SlashEscrowResult = 0
+	// The appeal is upheld and the escrowed slash is returned to the deposit of the storage provider.
+	SLASH_ESCROW_RELEASED SlashEscrowResult = 1
+	// The appeal is rejected and the escrowed slash is burned.
+	SLASH_ESCROW_BURNED SlashEscrowResult = 2
+)
+
+var SlashEscrowResult_name = map[int32]string{
+	0: "SLASH_ESCROW_DISTRIBUTED",
+	1: "SLASH_ESCROW_RELEASED",
+	2: "SLASH_ESCROW_BURNED",
+}
+
+var SlashEscrowResult_value = map[string]int32{
+	"SLASH_ESCROW_DISTRIBUTED": 0,
+	"SLASH_ESCROW_RELEASED":    1,
+	"SLASH_ESCROW_BURNED":      2,
+}
+
+func (x SlashEscrowResult) String() string {
+	return proto.EnumName(SlashEscrowResult_name, int32(x))
+}
+
+func (SlashEscrowResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{1}
+}
+
 // Slash records the storage provider slashes, which will be pruned periodically.
 type Slash struct {
 	// The slashed storage provider.
@@ -115,6 +149,20 @@ type Challenge struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The height at which the challenge will be expired.
 	ExpiredHeight uint64 `protobuf:"varint,2,opt,name=expired_height,json=expiredHeight,proto3" json:"expired_height,omitempty"`
+	// The id of object info to be challenged.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The segment/piece index of the object info.
+	SegmentIndex uint32 `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// The storage provider to be challenged.
+	SpId uint32 `protobuf:"varint,5,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The storage provider to be challenged.
+	SpOperatorAddress string `protobuf:"bytes,6,opt,name=sp_operator_address,json=spOperatorAddress,proto3" json:"sp_operator_address,omitempty"`
+	// The redundancy index, which comes from the index of storage providers.
+	RedundancyIndex int32 `protobuf:"varint,7,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The challenger who submits the challenge, it is empty for the challenges triggered by blockchain.
+	ChallengerAddress string `protobuf:"bytes,8,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// All the sampled segment/piece indexes of the object info, the first one is the same as segment_index.
+	SegmentIndexes []uint32 `protobuf:"varint,9,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
 }
 
 func (m *Challenge) Reset()         { *m = Challenge{} }
@@ -164,6 +212,96 @@ func (m *Challenge) GetExpiredHeight() uint64 {
 	return 0
 }
 
+func (m *Challenge) GetSegmentIndex() uint32 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *Challenge) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *Challenge) GetSpOperatorAddress() string {
+	if m != nil {
+		return m.SpOperatorAddress
+	}
+	return ""
+}
+
+func (m *Challenge) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *Challenge) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
+	}
+	return ""
+}
+
+func (m *Challenge) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
+// ChallengeBond records the bond locked by a challenger for a user submitted challenge.
+type ChallengeBond struct {
+	// The address of challenger.
+	Challenger string `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// The locked bond amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ChallengeBond) Reset()         { *m = ChallengeBond{} }
+func (m *ChallengeBond) String() string { return proto.CompactTextString(m) }
+func (*ChallengeBond) ProtoMessage()    {}
+func (*ChallengeBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{2}
+}
+func (m *ChallengeBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeBond.Merge(m, src)
+}
+func (m *ChallengeBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeBond proto.InternalMessageInfo
+
+func (m *ChallengeBond) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
 // AttestedChallenge records the challenge which are attested.
 type AttestedChallenge struct {
 	// The id of the challenge.
@@ -176,7 +314,7 @@ func (m *AttestedChallenge) Reset()         { *m = AttestedChallenge{} }
 func (m *AttestedChallenge) String() string { return proto.CompactTextString(m) }
 func (*AttestedChallenge) ProtoMessage()    {}
 func (*AttestedChallenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c297f0764c47d40, []int{2}
+	return fileDescriptor_9c297f0764c47d40, []int{3}
 }
 func (m *AttestedChallenge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,6 +357,101 @@ func (m *AttestedChallenge) GetResult() VoteResult {
 	return CHALLENGE_FAILED
 }
 
+// ChallengeRecord records the attestation of a challenge, which is kept in the challenge history.
+type ChallengeRecord struct {
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The challenged storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The attestation result of the challenge.
+	Result VoteResult `protobuf:"varint,4,opt,name=result,proto3,enum=greenfield.challenge.VoteResult" json:"result,omitempty"`
+	// The slashed amount from the storage provider.
+	SlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=slash_amount,json=slashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slash_amount"`
+	// The address of challenger, it is empty for the challenges triggered by blockchain.
+	ChallengerAddress string `protobuf:"bytes,6,opt,name=challenger_address,json=challengerAddress,proto3" json:"challenger_address,omitempty"`
+	// The submitter of the challenge attestation.
+	SubmitterAddress string `protobuf:"bytes,7,opt,name=submitter_address,json=submitterAddress,proto3" json:"submitter_address,omitempty"`
+	// The height at which the challenge was attested.
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ChallengeRecord) Reset()         { *m = ChallengeRecord{} }
+func (m *ChallengeRecord) String() string { return proto.CompactTextString(m) }
+func (*ChallengeRecord) ProtoMessage()    {}
+func (*ChallengeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{4}
+}
+func (m *ChallengeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeRecord.Merge(m, src)
+}
+func (m *ChallengeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeRecord proto.InternalMessageInfo
+
+func (m *ChallengeRecord) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *ChallengeRecord) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *ChallengeRecord) GetResult() VoteResult {
+	if m != nil {
+		return m.Result
+	}
+	return CHALLENGE_FAILED
+}
+
+func (m *ChallengeRecord) GetChallengerAddress() string {
+	if m != nil {
+		return m.ChallengerAddress
+	}
+	return ""
+}
+
+func (m *ChallengeRecord) GetSubmitterAddress() string {
+	if m != nil {
+		return m.SubmitterAddress
+	}
+	return ""
+}
+
+func (m *ChallengeRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // AttestedChallengeIds stored fixed number of the latest attested challenge ids.
 // To use the storage more efficiently, a circular queue will be constructed using these fields.
 type AttestedChallengeIds struct {
@@ -234,7 +467,7 @@ func (m *AttestedChallengeIds) Reset()         { *m = AttestedChallengeIds{} }
 func (m *AttestedChallengeIds) String() string { return proto.CompactTextString(m) }
 func (*AttestedChallengeIds) ProtoMessage()    {}
 func (*AttestedChallengeIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c297f0764c47d40, []int{3}
+	return fileDescriptor_9c297f0764c47d40, []int{5}
 }
 func (m *AttestedChallengeIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,46 +517,265 @@ func (m *AttestedChallengeIds) GetCursor() int64 {
 	return 0
 }
 
+// SlashEscrow records the slashed funds of a storage provider which are held in the challenge module account.
+// The storage provider can appeal the slash before the release height, otherwise the funds are distributed as rewards.
+type SlashEscrow struct {
+	// The id of the challenge.
+	ChallengeId uint64 `protobuf:"varint,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// The slashed storage provider.
+	SpId uint32 `protobuf:"varint,2,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// The challenged object info.
+	ObjectId Uint `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The challenged segment/piece index of the object info.
+	SegmentIndex uint32 `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// The escrowed slash amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// The rewards to distribute when the escrow is released without a successful appeal.
+	Rewards []types.RewardInfo `protobuf:"bytes,6,rep,name=rewards,proto3" json:"rewards"`
+	// The height at which the escrow will be released, the appealed escrow is kept until the appeal is attested.
+	ReleaseHeight uint64 `protobuf:"varint,7,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	// Whether the storage provider has appealed the slash.
+	Appealed bool `protobuf:"varint,8,opt,name=appealed,proto3" json:"appealed,omitempty"`
+	// The challenged redundancy index of the object info.
+	RedundancyIndex int32 `protobuf:"varint,9,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The segment/piece indexes which failed the challenge, the appeal should prove all of them.
+	FailedSegmentIndexes []uint32 `protobuf:"varint,10,rep,packed,name=failed_segment_indexes,json=failedSegmentIndexes,proto3" json:"failed_segment_indexes,omitempty"`
+	// The timestamp when the storage provider is jailed due to the slash, it is 0 if the slash does not jail the storage
+	// provider. The jail is revoked if the appeal is upheld.
+	JailedAt int64 `protobuf:"varint,11,opt,name=jailed_at,json=jailedAt,proto3" json:"jailed_at,omitempty"`
+}
+
+func (m *SlashEscrow) Reset()         { *m = SlashEscrow{} }
+func (m *SlashEscrow) String() string { return proto.CompactTextString(m) }
+func (*SlashEscrow) ProtoMessage()    {}
+func (*SlashEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{6}
+}
+func (m *SlashEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEscrow.Merge(m, src)
+}
+func (m *SlashEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEscrow proto.InternalMessageInfo
+
+func (m *SlashEscrow) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+func (m *SlashEscrow) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SlashEscrow) GetSegmentIndex() uint32 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *SlashEscrow) GetRewards() []types.RewardInfo {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *SlashEscrow) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *SlashEscrow) GetAppealed() bool {
+	if m != nil {
+		return m.Appealed
+	}
+	return false
+}
+
+func (m *SlashEscrow) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *SlashEscrow) GetFailedSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.FailedSegmentIndexes
+	}
+	return nil
+}
+
+func (m *SlashEscrow) GetJailedAt() int64 {
+	if m != nil {
+		return m.JailedAt
+	}
+	return 0
+}
+
+// FollowUpChallenge records a challenge deferred since the challenged object is being moved, i.e., its bucket is
+// migrating or the challenged storage provider is swapping out. The storage provider which takes over the object will be
+// challenged once the move completes.
+type FollowUpChallenge struct {
+	// The id of object info to be challenged.
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// The redundancy index to be challenged.
+	RedundancyIndex int32 `protobuf:"varint,2,opt,name=redundancy_index,json=redundancyIndex,proto3" json:"redundancy_index,omitempty"`
+	// The sampled segment/piece indexes of the object info.
+	SegmentIndexes []uint32 `protobuf:"varint,3,rep,packed,name=segment_indexes,json=segmentIndexes,proto3" json:"segment_indexes,omitempty"`
+}
+
+func (m *FollowUpChallenge) Reset()         { *m = FollowUpChallenge{} }
+func (m *FollowUpChallenge) String() string { return proto.CompactTextString(m) }
+func (*FollowUpChallenge) ProtoMessage()    {}
+func (*FollowUpChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c297f0764c47d40, []int{7}
+}
+func (m *FollowUpChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FollowUpChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FollowUpChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FollowUpChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FollowUpChallenge.Merge(m, src)
+}
+func (m *FollowUpChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *FollowUpChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_FollowUpChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FollowUpChallenge proto.InternalMessageInfo
+
+func (m *FollowUpChallenge) GetRedundancyIndex() int32 {
+	if m != nil {
+		return m.RedundancyIndex
+	}
+	return 0
+}
+
+func (m *FollowUpChallenge) GetSegmentIndexes() []uint32 {
+	if m != nil {
+		return m.SegmentIndexes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("greenfield.challenge.VoteResult", VoteResult_name, VoteResult_value)
+	proto.RegisterEnum("greenfield.challenge.SlashEscrowResult", SlashEscrowResult_name, SlashEscrowResult_value)
 	proto.RegisterType((*Slash)(nil), "greenfield.challenge.Slash")
 	proto.RegisterType((*Challenge)(nil), "greenfield.challenge.Challenge")
+	proto.RegisterType((*ChallengeBond)(nil), "greenfield.challenge.ChallengeBond")
 	proto.RegisterType((*AttestedChallenge)(nil), "greenfield.challenge.AttestedChallenge")
+	proto.RegisterType((*ChallengeRecord)(nil), "greenfield.challenge.ChallengeRecord")
 	proto.RegisterType((*AttestedChallengeIds)(nil), "greenfield.challenge.AttestedChallengeIds")
+	proto.RegisterType((*SlashEscrow)(nil), "greenfield.challenge.SlashEscrow")
+	proto.RegisterType((*FollowUpChallenge)(nil), "greenfield.challenge.FollowUpChallenge")
 }
 
 func init() { proto.RegisterFile("greenfield/challenge/types.proto", fileDescriptor_9c297f0764c47d40) }
 
 var fileDescriptor_9c297f0764c47d40 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xd5, 0xca, 0x8a, 0xa9, 0x27, 0xc4, 0x38, 0x5b, 0xb7, 0xb8, 0x2e, 0x28, 0xc2, 0x50, 0x6a,
-	0x0a, 0x91, 0x68, 0x7a, 0xc9, 0xad, 0xd8, 0x8e, 0x9a, 0x98, 0x9a, 0x1e, 0x36, 0xa4, 0x87, 0x42,
-	0x11, 0x96, 0x76, 0x2b, 0x6d, 0x51, 0xb4, 0x42, 0xbb, 0x86, 0xb4, 0x5f, 0x50, 0xe8, 0xa5, 0xff,
-	0xd0, 0x5f, 0xe8, 0x47, 0xe4, 0x18, 0x7a, 0x2a, 0x3d, 0x84, 0x62, 0xff, 0x48, 0x91, 0xb4, 0x8d,
-	0x43, 0x93, 0xdc, 0xe6, 0xbd, 0x79, 0xf3, 0xde, 0x30, 0x0c, 0x38, 0x71, 0xc1, 0x58, 0xf6, 0x81,
-	0xb3, 0x94, 0x7a, 0x51, 0x32, 0x4f, 0x53, 0x96, 0xc5, 0xcc, 0x53, 0x9f, 0x72, 0x26, 0xdd, 0xbc,
-	0x10, 0x4a, 0xe0, 0xee, 0x5a, 0xe1, 0x5e, 0x29, 0xfa, 0x8f, 0x22, 0x21, 0x4f, 0x85, 0x0c, 0x2a,
-	0x8d, 0x57, 0x83, 0x7a, 0xa0, 0xdf, 0x8d, 0x45, 0x2c, 0x6a, 0xbe, 0xac, 0x6a, 0x76, 0x90, 0xc1,
-	0xc6, 0x71, 0x3a, 0x97, 0x09, 0xbe, 0x0f, 0x1b, 0x32, 0x0f, 0x38, 0xed, 0x21, 0x07, 0x0d, 0xb7,
-	0x88, 0x25, 0xf3, 0x29, 0xc5, 0xfb, 0xd0, 0x12, 0xe1, 0x47, 0x16, 0xa9, 0xb2, 0x61, 0x3a, 0x68,
-	0xd8, 0x1a, 0x3f, 0x3e, 0xbf, 0xdc, 0x31, 0x7e, 0x5f, 0xee, 0x58, 0x27, 0x3c, 0x53, 0x3f, 0x7f,
-	0xec, 0x6e, 0xea, 0x90, 0x12, 0x92, 0x7b, 0xb5, 0x7a, 0x4a, 0xf1, 0x43, 0x68, 0x26, 0x8c, 0xc7,
-	0x89, 0xea, 0x35, 0x1c, 0x34, 0xb4, 0x88, 0x46, 0x83, 0x31, 0xb4, 0x26, 0xff, 0xb6, 0xc5, 0x6d,
-	0x30, 0x75, 0xa0, 0x45, 0x4c, 0x4e, 0xf1, 0x13, 0x68, 0xb3, 0xb3, 0x9c, 0x17, 0x8c, 0x06, 0x7a,
-	0xd8, 0xac, 0x7a, 0x5b, 0x9a, 0x3d, 0xaa, 0x3d, 0xde, 0xc3, 0xf6, 0x48, 0x29, 0x26, 0x15, 0xa3,
-	0x77, 0x7b, 0xed, 0x43, 0xb3, 0x60, 0x72, 0x91, 0xd6, 0x1e, 0xed, 0x3d, 0xc7, 0xbd, 0xed, 0x60,
-	0xee, 0x5b, 0xa1, 0x18, 0xa9, 0x74, 0x44, 0xeb, 0x07, 0x5f, 0x11, 0x74, 0x6f, 0xf8, 0x4f, 0xa9,
-	0xc4, 0x18, 0x2c, 0xc9, 0x3f, 0x33, 0x1d, 0x52, 0xd5, 0xf8, 0x10, 0xe0, 0xca, 0x4c, 0xf6, 0x4c,
-	0xa7, 0x31, 0xdc, 0xdc, 0x7b, 0x7a, 0x7b, 0xd4, 0x0d, 0x4f, 0x72, 0x6d, 0xb4, 0x3c, 0x58, 0xb4,
-	0x28, 0xa4, 0x28, 0xaa, 0x83, 0x35, 0x88, 0x46, 0xcf, 0x5e, 0x02, 0xac, 0x77, 0xc4, 0x5d, 0xe8,
-	0x4c, 0x8e, 0x46, 0xb3, 0x99, 0xff, 0xe6, 0xd0, 0x0f, 0x5e, 0x8d, 0xa6, 0x33, 0xff, 0xa0, 0x63,
-	0xe0, 0x07, 0xb0, 0xbd, 0x66, 0x8f, 0x4f, 0x26, 0x13, 0xdf, 0x3f, 0xe8, 0xa0, 0xbe, 0xf5, 0xe5,
-	0xbb, 0x6d, 0x8c, 0x5f, 0x9f, 0x2f, 0x6d, 0x74, 0xb1, 0xb4, 0xd1, 0x9f, 0xa5, 0x8d, 0xbe, 0xad,
-	0x6c, 0xe3, 0x62, 0x65, 0x1b, 0xbf, 0x56, 0xb6, 0xf1, 0xee, 0x79, 0xcc, 0x55, 0xb2, 0x08, 0xdd,
-	0x48, 0x9c, 0x7a, 0x61, 0x16, 0xee, 0x46, 0xc9, 0x9c, 0x67, 0xde, 0xb5, 0xcf, 0x3b, 0xfb, 0xff,
-	0xf7, 0xc2, 0x66, 0xf5, 0x35, 0x2f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x68, 0xaf, 0x39, 0x21,
-	0xa0, 0x02, 0x00, 0x00,
+	// 943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6a, 0x1b, 0x47,
+	0x14, 0xd6, 0xea, 0xcf, 0xd2, 0x51, 0x6c, 0x4b, 0x63, 0x25, 0x5d, 0x3b, 0x45, 0x51, 0x55, 0xda,
+	0xa8, 0x01, 0xcb, 0x34, 0xed, 0x45, 0x0a, 0x85, 0x22, 0xc9, 0x1b, 0x7b, 0xa9, 0x48, 0x60, 0x64,
+	0xb5, 0x50, 0x28, 0xcb, 0x4a, 0x33, 0x96, 0x36, 0x59, 0xed, 0x2c, 0x3b, 0x23, 0xec, 0xf4, 0x09,
+	0x0a, 0xbd, 0xe9, 0x0b, 0x94, 0x52, 0xfa, 0x0a, 0x79, 0x86, 0x92, 0xcb, 0x90, 0xab, 0xd2, 0x8b,
+	0x50, 0xec, 0x77, 0xe8, 0x75, 0xd9, 0xd9, 0x91, 0x76, 0x95, 0xc8, 0xb8, 0x82, 0x40, 0xaf, 0xa4,
+	0x39, 0xe7, 0xcc, 0xf9, 0xce, 0x9c, 0xf3, 0xcd, 0x37, 0x0b, 0xf5, 0x71, 0x40, 0xa9, 0x77, 0xea,
+	0x50, 0x97, 0x1c, 0x8c, 0x26, 0xb6, 0xeb, 0x52, 0x6f, 0x4c, 0x0f, 0xc4, 0x33, 0x9f, 0xf2, 0x96,
+	0x1f, 0x30, 0xc1, 0x50, 0x35, 0x8e, 0x68, 0x2d, 0x22, 0xf6, 0x76, 0x47, 0x8c, 0x4f, 0x19, 0xb7,
+	0x64, 0xcc, 0x41, 0xb4, 0x88, 0x36, 0xec, 0x55, 0xc7, 0x6c, 0xcc, 0x22, 0x7b, 0xf8, 0x4f, 0x59,
+	0x77, 0x13, 0x40, 0xdc, 0x4f, 0x22, 0x34, 0x3c, 0xc8, 0xf5, 0x5d, 0x9b, 0x4f, 0xd0, 0x0e, 0xe4,
+	0xb8, 0x6f, 0x39, 0x44, 0xd7, 0xea, 0x5a, 0x73, 0x13, 0x67, 0xb9, 0x6f, 0x12, 0xf4, 0x00, 0x8a,
+	0x6c, 0xf8, 0x84, 0x8e, 0x44, 0xe8, 0x48, 0xd7, 0xb5, 0x66, 0xb1, 0x73, 0xfb, 0xc5, 0xeb, 0x3b,
+	0xa9, 0xbf, 0x5e, 0xdf, 0xc9, 0x0e, 0x1c, 0x4f, 0xbc, 0x7a, 0xbe, 0x5f, 0x52, 0xf8, 0xe1, 0x12,
+	0x17, 0xa2, 0x68, 0x93, 0xa0, 0x5b, 0x90, 0x9f, 0x50, 0x67, 0x3c, 0x11, 0x7a, 0xa6, 0xae, 0x35,
+	0xb3, 0x58, 0xad, 0x1a, 0xbf, 0x64, 0xa0, 0xd8, 0x9d, 0x9f, 0x04, 0x6d, 0x41, 0x5a, 0x21, 0x66,
+	0x71, 0xda, 0x21, 0xe8, 0x23, 0xd8, 0xa2, 0xe7, 0xbe, 0x13, 0x50, 0x62, 0xa9, 0xdd, 0x69, 0xe9,
+	0xdb, 0x54, 0xd6, 0x63, 0x69, 0x5c, 0x2e, 0x2b, 0xb3, 0x4e, 0x59, 0x1f, 0xc2, 0x26, 0xa7, 0xe3,
+	0x29, 0xf5, 0x84, 0xe5, 0x78, 0x84, 0x9e, 0xeb, 0x59, 0x79, 0xda, 0x1b, 0xca, 0x68, 0x86, 0xb6,
+	0xb8, 0x15, 0xb9, 0x44, 0x2b, 0x8e, 0x61, 0x87, 0xfb, 0x16, 0xf3, 0x69, 0x60, 0x0b, 0x16, 0x58,
+	0x36, 0x21, 0x01, 0xe5, 0x5c, 0xcf, 0x4b, 0x74, 0xfd, 0xd5, 0xf3, 0xfd, 0xaa, 0x42, 0x6c, 0x47,
+	0x9e, 0xbe, 0x08, 0x1c, 0x6f, 0x8c, 0x2b, 0xdc, 0x7f, 0xac, 0xf6, 0x28, 0x07, 0xfa, 0x04, 0xca,
+	0x01, 0x25, 0x33, 0x8f, 0xd8, 0xde, 0xe8, 0x99, 0x2a, 0x63, 0xa3, 0xae, 0x35, 0x73, 0x78, 0x3b,
+	0xb6, 0x47, 0x95, 0x1c, 0x01, 0x5a, 0x8c, 0x3d, 0xc6, 0x2c, 0x5c, 0x87, 0x19, 0xef, 0x99, 0x63,
+	0xde, 0x85, 0xed, 0xa5, 0x73, 0x53, 0xae, 0x17, 0xeb, 0x99, 0xe6, 0x26, 0xde, 0x4a, 0x9e, 0x9c,
+	0xf2, 0xc6, 0xaf, 0x1a, 0x6c, 0x2e, 0xe6, 0xd3, 0x61, 0x5e, 0xc8, 0x01, 0x88, 0xf3, 0xe9, 0xda,
+	0x35, 0xd8, 0x89, 0x58, 0x74, 0x02, 0x79, 0x7b, 0xca, 0x66, 0x9e, 0x50, 0xd4, 0xf9, 0x52, 0xcd,
+	0xe8, 0xe3, 0xb1, 0x23, 0x26, 0xb3, 0x61, 0x6b, 0xc4, 0xa6, 0x8a, 0xbd, 0xea, 0x67, 0x9f, 0x93,
+	0xa7, 0x8a, 0x9d, 0xa6, 0x9c, 0x22, 0x28, 0x0c, 0xd3, 0x13, 0x58, 0xe5, 0x6a, 0x7c, 0x0f, 0x95,
+	0xb6, 0x10, 0x94, 0x0b, 0x4a, 0xae, 0x26, 0xd2, 0x03, 0xc8, 0x07, 0x94, 0xcf, 0xdc, 0x08, 0x7a,
+	0xeb, 0x7e, 0xbd, 0xb5, 0xea, 0x26, 0xb5, 0xbe, 0x61, 0x82, 0x62, 0x19, 0x87, 0x55, 0x7c, 0xe3,
+	0x8f, 0x0c, 0x6c, 0x2f, 0xf2, 0x62, 0x3a, 0x62, 0x01, 0x41, 0x1f, 0xc0, 0x8d, 0xc5, 0x1e, 0x6b,
+	0x81, 0x53, 0x5a, 0xd8, 0x4c, 0x12, 0x73, 0x26, 0x7d, 0xd5, 0xf5, 0x59, 0x8b, 0xa7, 0x71, 0xfd,
+	0xd9, 0xf5, 0xea, 0x47, 0x16, 0xdc, 0xe0, 0xe1, 0x85, 0xb6, 0x54, 0xeb, 0x73, 0xef, 0xa0, 0xf5,
+	0x25, 0x99, 0xb1, 0x2d, 0x13, 0x5e, 0xc1, 0xc9, 0xfc, 0xfa, 0x9c, 0x34, 0xa0, 0xc2, 0x67, 0xc3,
+	0xa9, 0x23, 0x44, 0x22, 0xcf, 0xc6, 0x35, 0x79, 0xca, 0x8b, 0x2d, 0xf3, 0x34, 0xb1, 0xd2, 0x14,
+	0x96, 0x94, 0xe6, 0x27, 0x0d, 0xaa, 0x6f, 0x11, 0xc5, 0x24, 0x1c, 0x21, 0xc8, 0x72, 0xe7, 0x07,
+	0xaa, 0xa6, 0x28, 0xff, 0xa3, 0xa3, 0x04, 0xc9, 0xb9, 0x9e, 0xae, 0x67, 0x9a, 0xa5, 0xfb, 0x77,
+	0x57, 0xf7, 0xfc, 0xad, 0x9c, 0x09, 0xce, 0xcb, 0x6a, 0x46, 0xb3, 0x80, 0xb3, 0x40, 0xce, 0x3b,
+	0x83, 0xd5, 0xaa, 0xf1, 0x4f, 0x06, 0x4a, 0x52, 0x68, 0x0d, 0x3e, 0x0a, 0xd8, 0xd9, 0xff, 0x40,
+	0xa9, 0xff, 0x24, 0x7d, 0xf1, 0x95, 0xcd, 0xbd, 0xbb, 0x2b, 0x8b, 0xbe, 0x80, 0x8d, 0x80, 0x9e,
+	0xd9, 0x01, 0x09, 0x79, 0x12, 0xb6, 0x76, 0x37, 0xd9, 0x5a, 0xee, 0xb7, 0xb0, 0xf4, 0x9a, 0xde,
+	0x29, 0xeb, 0x64, 0x43, 0x44, 0x3c, 0x8f, 0x0f, 0x5f, 0x84, 0x80, 0xba, 0xd4, 0xe6, 0x74, 0xfe,
+	0x22, 0x6c, 0x44, 0x2f, 0x82, 0xb2, 0xaa, 0x17, 0x61, 0x0f, 0x0a, 0xb6, 0xef, 0x53, 0xdb, 0xa5,
+	0x44, 0xd2, 0xa0, 0x80, 0x17, 0xeb, 0x95, 0x7a, 0x5b, 0x5c, 0xad, 0xb7, 0x9f, 0xc3, 0xad, 0x53,
+	0xdb, 0x71, 0x29, 0xb1, 0xde, 0x54, 0x4b, 0x90, 0x6a, 0x59, 0x8d, 0xbc, 0xfd, 0x25, 0xcd, 0x44,
+	0xb7, 0xa1, 0xf8, 0x24, 0xda, 0x65, 0x0b, 0xbd, 0x24, 0xc7, 0x5e, 0x88, 0x0c, 0x6d, 0xd1, 0xf8,
+	0x4d, 0x83, 0xca, 0x43, 0xe6, 0xba, 0xec, 0x6c, 0xe0, 0xc7, 0x7a, 0xb5, 0x34, 0x46, 0x6d, 0x9d,
+	0x31, 0xae, 0x3a, 0x4d, 0x7a, 0xf5, 0x69, 0x56, 0x88, 0x7e, 0x66, 0x95, 0xe8, 0xdf, 0xfb, 0x0a,
+	0x20, 0x56, 0x12, 0x54, 0x85, 0x72, 0xf7, 0xb8, 0xdd, 0xeb, 0x19, 0x8f, 0x8e, 0x0c, 0xeb, 0x61,
+	0xdb, 0xec, 0x19, 0x87, 0xe5, 0x14, 0xba, 0x09, 0x95, 0xd8, 0xda, 0x1f, 0x74, 0xbb, 0x86, 0x71,
+	0x58, 0xd6, 0xf6, 0xb2, 0x3f, 0xfe, 0x5e, 0x4b, 0xdd, 0x7b, 0x0a, 0x95, 0x04, 0xb9, 0x55, 0x9e,
+	0xf7, 0x41, 0xef, 0xf7, 0xda, 0xfd, 0x63, 0xcb, 0xe8, 0x77, 0xf1, 0xe3, 0x6f, 0xad, 0x43, 0xb3,
+	0x7f, 0x82, 0xcd, 0xce, 0xe0, 0x44, 0xe6, 0xdb, 0x85, 0x9b, 0x4b, 0x5e, 0x6c, 0xf4, 0x8c, 0x76,
+	0x3f, 0xcc, 0x89, 0xde, 0x83, 0x9d, 0x25, 0x57, 0x67, 0x80, 0x1f, 0x19, 0x87, 0xe5, 0x74, 0x04,
+	0xd6, 0xf9, 0xfa, 0xc5, 0x45, 0x4d, 0x7b, 0x79, 0x51, 0xd3, 0xfe, 0xbe, 0xa8, 0x69, 0x3f, 0x5f,
+	0xd6, 0x52, 0x2f, 0x2f, 0x6b, 0xa9, 0x3f, 0x2f, 0x6b, 0xa9, 0xef, 0x3e, 0x4d, 0xb0, 0x74, 0xe8,
+	0x0d, 0xf7, 0x47, 0x13, 0xdb, 0xf1, 0x0e, 0x12, 0x1f, 0x3f, 0xe7, 0x6f, 0x7e, 0x67, 0x0d, 0xf3,
+	0xf2, 0x33, 0xe8, 0xb3, 0x7f, 0x07, 0x00, 0x94, 0x5a, 0x84, 0x65, 0x8c, 0x09, 0x00, 0x00,
 }
 
 func (m *Slash) Marshal() (dAtA []byte, err error) {
@@ -389,6 +841,63 @@ func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SegmentIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SegmentIndexes)*10)
+		var j1 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SpOperatorAddress) > 0 {
+		i -= len(m.SpOperatorAddress)
+		copy(dAtA[i:], m.SpOperatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SpOperatorAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SegmentIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.ExpiredHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiredHeight))
 		i--
@@ -402,7 +911,7 @@ func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AttestedChallenge) Marshal() (dAtA []byte, err error) {
+func (m *ChallengeBond) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -412,20 +921,60 @@ func (m *AttestedChallenge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AttestedChallenge) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChallengeBond) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AttestedChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChallengeBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestedChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestedChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestedChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Id != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
@@ -435,6 +984,83 @@ func (m *AttestedChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChallengeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SubmitterAddress) > 0 {
+		i -= len(m.SubmitterAddress)
+		copy(dAtA[i:], m.SubmitterAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SubmitterAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChallengerAddress) > 0 {
+		i -= len(m.ChallengerAddress)
+		copy(dAtA[i:], m.ChallengerAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChallengerAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AttestedChallengeIds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -482,6 +1108,177 @@ func (m *AttestedChallengeIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.JailedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FailedSegmentIndexes) > 0 {
+		dAtA4 := make([]byte, len(m.FailedSegmentIndexes)*10)
+		var j3 int
+		for _, num := range m.FailedSegmentIndexes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTypes(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Appealed {
+		i--
+		if m.Appealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SegmentIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FollowUpChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FollowUpChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FollowUpChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SegmentIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.SegmentIndexes)*10)
+		var j5 int
+		for _, num := range m.SegmentIndexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RedundancyIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -522,6 +1319,47 @@ func (m *Challenge) Size() (n int) {
 	if m.ExpiredHeight != 0 {
 		n += 1 + sovTypes(uint64(m.ExpiredHeight))
 	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SegmentIndex != 0 {
+		n += 1 + sovTypes(uint64(m.SegmentIndex))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = len(m.SpOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ChallengeBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -540,6 +1378,39 @@ func (m *AttestedChallenge) Size() (n int) {
 	return n
 }
 
+func (m *ChallengeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.ChallengerAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SubmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *AttestedChallengeIds) Size() (n int) {
 	if m == nil {
 		return 0
@@ -561,13 +1432,1106 @@ func (m *AttestedChallengeIds) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SlashEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengeId))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SegmentIndex != 0 {
+		n += 1 + sovTypes(uint64(m.SegmentIndex))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ReleaseHeight))
+	}
+	if m.Appealed {
+		n += 2
+	}
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	if len(m.FailedSegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.FailedSegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.JailedAt != 0 {
+		n += 1 + sovTypes(uint64(m.JailedAt))
+	}
+	return n
+}
+
+func (m *FollowUpChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RedundancyIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyIndex))
+	}
+	if len(m.SegmentIndexes) > 0 {
+		l = 0
+		for _, e := range m.SegmentIndexes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Slash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Challenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+			}
+			m.ExpiredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+			}
+			m.SegmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
+			}
+			m.RedundancyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChallengeBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestedChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestedChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= VoteResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChallengeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= VoteResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestedChallengeIds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestedChallengeIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, &AttestedChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			m.Cursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cursor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Slash) Unmarshal(dAtA []byte) error {
+func (m *SlashEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,13 +2554,32 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
@@ -615,7 +2598,7 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
@@ -649,11 +2632,11 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
 			}
-			m.Height = 0
+			m.SegmentIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -663,66 +2646,84 @@ func (m *Slash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.SegmentIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Challenge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rewards = append(m.Rewards, types.RewardInfo{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			m.Id = 0
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -732,16 +2733,16 @@ func (m *Challenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
 			}
-			m.ExpiredHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -751,66 +2752,17 @@ func (m *Challenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiredHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttestedChallenge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestedChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Appealed = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
 			}
-			m.Id = 0
+			m.RedundancyIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -820,16 +2772,92 @@ func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.RedundancyIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 10:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedSegmentIndexes = append(m.FailedSegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedSegmentIndexes) == 0 {
+					m.FailedSegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedSegmentIndexes = append(m.FailedSegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSegmentIndexes", wireType)
+			}
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedAt", wireType)
 			}
-			m.Result = 0
+			m.JailedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -839,7 +2867,7 @@ func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= VoteResult(b&0x7F) << shift
+				m.JailedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -865,7 +2893,7 @@ func (m *AttestedChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
+func (m *FollowUpChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -888,36 +2916,17 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestedChallengeIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: FollowUpChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestedChallengeIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FollowUpChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
-			}
-			m.Size_ = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -927,31 +2936,31 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenges = append(m.Challenges, &AttestedChallenge{})
-			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyIndex", wireType)
 			}
-			m.Cursor = 0
+			m.RedundancyIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -961,11 +2970,87 @@ func (m *AttestedChallengeIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cursor |= int64(b&0x7F) << shift
+				m.RedundancyIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SegmentIndexes = append(m.SegmentIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SegmentIndexes) == 0 {
+					m.SegmentIndexes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SegmentIndexes = append(m.SegmentIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndexes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
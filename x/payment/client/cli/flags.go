@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+	FlagFormat    = "format"
+)
@@ -30,6 +30,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdDynamicBalance())
 	cmd.AddCommand(CmdGetPaymentAccountsByOwner())
 	cmd.AddCommand(CmdListAutoSettleRecord())
+	cmd.AddCommand(CmdAutoSettleQueue())
+	cmd.AddCommand(CmdAutoResumeQueue())
+	cmd.AddCommand(CmdBillingStatement())
+	cmd.AddCommand(CmdShowPrepaidPlan())
 
 	return cmd
 }
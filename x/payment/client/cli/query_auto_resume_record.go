@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func CmdAutoResumeQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-resume-queue [account]",
+		Short: "list the auto resume queue with the position and the out flows to resume of each record, optionally filtered by account",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAutoResumeQueueRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.Account = args[0]
+			}
+
+			res, err := queryClient.AutoResumeQueue(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
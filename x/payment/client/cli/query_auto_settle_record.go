@@ -42,3 +42,40 @@ func CmdListAutoSettleRecord() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAutoSettleQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-settle-queue [account]",
+		Short: "list the auto settle queue with the position of each record, optionally filtered by account",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAutoSettleQueueRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				params.Account = args[0]
+			}
+
+			res, err := queryClient.AutoSettleQueue(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+const (
+	statementFormatJSON = "json"
+	statementFormatCSV  = "csv"
+)
+
+func CmdBillingStatement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statement [account]",
+		Short: "Export the billing statement of a stream account",
+		Long: `Export the outflows of a stream account aggregated by statement period and destination.
+The destinations are the virtual payment addresses of storage providers and the validator tax pool.`,
+		Example: "gnfd query payment statement 0x76d244CE05c3De4BbC6fDd7F56379B145709ade9 --start-time 1690000000 --format csv",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := cmd.Flags().GetInt64(FlagEndTime)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format != statementFormatJSON && format != statementFormatCSV {
+				return fmt.Errorf("invalid format %s, should be %s or %s", format, statementFormatJSON, statementFormatCSV)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBillingStatementRequest{
+				Account:    args[0],
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BillingStatement(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			if format == statementFormatJSON {
+				return clientCtx.PrintProto(res)
+			}
+			return printBillingStatementCSV(clientCtx, res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp from which the statement periods are exported")
+	cmd.Flags().Int64(FlagEndTime, 0, "The unix timestamp until which the statement periods are exported")
+	cmd.Flags().String(FlagFormat, statementFormatJSON, "The export format, json or csv")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// printBillingStatementCSV prints one line item per statement period and destination,
+// followed by the line items of every bucket which the destination is paid for.
+func printBillingStatementCSV(clientCtx client.Context, res *types.QueryBillingStatementResponse) error {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"period_start", "period_end", "bucket_id", "to_address", "amount"}); err != nil {
+		return err
+	}
+	for _, period := range res.Periods {
+		for _, line := range period.Lines {
+			record := []string{
+				strconv.FormatInt(period.PeriodStart, 10),
+				strconv.FormatInt(period.PeriodEnd, 10),
+				"",
+				line.ToAddress,
+				line.Amount.String(),
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		for _, line := range period.BucketLines {
+			record := []string{
+				strconv.FormatInt(period.PeriodStart, 10),
+				strconv.FormatInt(period.PeriodEnd, 10),
+				line.BucketId.String(),
+				line.ToAddress,
+				line.Amount.String(),
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return clientCtx.PrintString(buf.String())
+}
@@ -45,6 +45,17 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryDynamicBalanceResponse{},
 		},
+		{
+			"query prepaid-plan",
+			append(
+				[]string{
+					"prepaid-plan",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryPrepaidPlanResponse{},
+		},
 		{
 			"query get-payment-accounts-by-owner",
 			append(
@@ -66,6 +77,39 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryAutoSettleRecordsResponse{},
 		},
+		{
+			"query auto-settle-queue",
+			append(
+				[]string{
+					"auto-settle-queue",
+					sample.RandAccAddressHex(),
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryAutoSettleQueueResponse{},
+		},
+		{
+			"query auto-resume-queue",
+			append(
+				[]string{
+					"auto-resume-queue",
+					sample.RandAccAddressHex(),
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryAutoResumeQueueResponse{},
+		},
+		{
+			"query statement",
+			append(
+				[]string{
+					"statement",
+					sample.RandAccAddressHex(),
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryBillingStatementResponse{},
+		},
 		{
 			"query list-payment-account",
 			append(
@@ -5,6 +5,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -16,6 +17,16 @@ func (k Keeper) SetAutoResumeRecord(ctx sdk.Context, autoResumeRecord *types.Aut
 		autoResumeRecord.Timestamp,
 		sdk.MustAccAddressFromHex(autoResumeRecord.Addr),
 	), b)
+
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		k.setAutoResumeRecordIndex(ctx, sdk.MustAccAddressFromHex(autoResumeRecord.Addr), autoResumeRecord.Timestamp)
+	}
+}
+
+// setAutoResumeRecordIndex indexes the autoResumeRecord by address, the index is maintained since the Hulunbeier upgrade
+func (k Keeper) setAutoResumeRecordIndex(ctx sdk.Context, addr sdk.AccAddress, timestamp int64) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoResumeRecordByAddrKeyPrefix)
+	indexStore.Set(types.AutoRecordByAddrKey(addr, timestamp), []byte{0x00})
 }
 
 // GetAutoResumeRecord returns a autoResumeRecord from its index
@@ -51,6 +62,49 @@ func (k Keeper) RemoveAutoResumeRecord(
 		timestamp,
 		addr,
 	))
+
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoResumeRecordByAddrKeyPrefix)
+		indexStore.Delete(types.AutoRecordByAddrKey(addr, timestamp))
+	}
+}
+
+// GetAutoResumeRecordsByAddr returns the autoResumeRecords of addr via the index by address
+func (k Keeper) GetAutoResumeRecordsByAddr(ctx sdk.Context, addr sdk.AccAddress) (list []types.AutoResumeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoResumeRecordByAddrKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AutoRecordByAddrPrefix(addr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.AutoResumeRecord{
+			Timestamp: types.ParseAutoRecordByAddrKey(iterator.Key()[len(addr):]),
+			Addr:      addr.String(),
+		})
+	}
+	return
+}
+
+// BackfillAutoRecordIndexes indexes the existing auto settle and auto resume records by address. It is used when
+// upgrading a chain created without the indexes.
+func (k Keeper) BackfillAutoRecordIndexes(ctx sdk.Context) {
+	for _, record := range k.GetAllAutoSettleRecord(ctx) {
+		k.setAutoSettleRecordIndex(ctx, sdk.MustAccAddressFromHex(record.Addr), record.Timestamp)
+	}
+	for _, record := range k.getAllAutoResumeRecord(ctx) {
+		k.setAutoResumeRecordIndex(ctx, sdk.MustAccAddressFromHex(record.Addr), record.Timestamp)
+	}
+}
+
+// getAllAutoResumeRecord returns all autoResumeRecord
+func (k Keeper) getAllAutoResumeRecord(ctx sdk.Context) (list []types.AutoResumeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoResumeRecordKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.ParseAutoResumeRecordKey(iterator.Key()))
+	}
+	return
 }
 
 // ExistsAutoResumeRecord checks whether there exists a autoResumeRecord
@@ -59,6 +113,15 @@ func (k Keeper) ExistsAutoResumeRecord(
 	timestamp int64,
 	addr sdk.AccAddress,
 ) bool {
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		// the records of addr are sorted by timestamp in the index
+		records := k.GetAutoResumeRecordsByAddr(ctx, addr)
+		if len(records) == 0 {
+			return false
+		}
+		return timestamp <= 0 || records[0].Timestamp <= timestamp
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoResumeRecordKeyPrefix)
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
@@ -6,11 +6,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
 func TestAutoResumeRecord(t *testing.T) {
 	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = upgrade.WithUpgraded(ctx, gnfdtypes.Hulunbeier)
 
 	addr1 := sample.RandAccAddress()
 	record1 := &types.AutoResumeRecord{
@@ -41,6 +44,9 @@ func TestAutoResumeRecord(t *testing.T) {
 	exist = keeper.ExistsAutoResumeRecord(ctx, 0, addr2)
 	require.True(t, exist)
 
+	// index by address
+	require.Equal(t, []types.AutoResumeRecord{*record1}, keeper.GetAutoResumeRecordsByAddr(ctx, addr1))
+
 	// remove
 	keeper.RemoveAutoResumeRecord(ctx, record1.Timestamp, addr1)
 	keeper.RemoveAutoResumeRecord(ctx, record2.Timestamp, addr2)
@@ -49,4 +55,5 @@ func TestAutoResumeRecord(t *testing.T) {
 	require.True(t, !exist)
 	exist = keeper.ExistsAutoResumeRecord(ctx, 0, addr2)
 	require.True(t, !exist)
+	require.Empty(t, keeper.GetAutoResumeRecordsByAddr(ctx, addr1))
 }
@@ -5,6 +5,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -16,6 +17,16 @@ func (k Keeper) SetAutoSettleRecord(ctx sdk.Context, autoSettleRecord *types.Aut
 		autoSettleRecord.Timestamp,
 		sdk.MustAccAddressFromHex(autoSettleRecord.Addr),
 	), b)
+
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		k.setAutoSettleRecordIndex(ctx, sdk.MustAccAddressFromHex(autoSettleRecord.Addr), autoSettleRecord.Timestamp)
+	}
+}
+
+// setAutoSettleRecordIndex indexes the autoSettleRecord by address, the index is maintained since the Hulunbeier upgrade
+func (k Keeper) setAutoSettleRecordIndex(ctx sdk.Context, addr sdk.AccAddress, timestamp int64) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoSettleRecordByAddrKeyPrefix)
+	indexStore.Set(types.AutoRecordByAddrKey(addr, timestamp), []byte{0x00})
 }
 
 // RemoveAutoSettleRecord removes a autoSettleRecord from the store
@@ -29,6 +40,26 @@ func (k Keeper) RemoveAutoSettleRecord(
 		timestamp,
 		addr,
 	))
+
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoSettleRecordByAddrKeyPrefix)
+		indexStore.Delete(types.AutoRecordByAddrKey(addr, timestamp))
+	}
+}
+
+// GetAutoSettleRecordsByAddr returns the autoSettleRecords of addr via the index by address
+func (k Keeper) GetAutoSettleRecordsByAddr(ctx sdk.Context, addr sdk.AccAddress) (list []types.AutoSettleRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoSettleRecordByAddrKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, types.AutoRecordByAddrPrefix(addr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.AutoSettleRecord{
+			Timestamp: types.ParseAutoRecordByAddrKey(iterator.Key()[len(addr):]),
+			Addr:      addr.String(),
+		})
+	}
+	return
 }
 
 // GetAllAutoSettleRecord returns all autoSettleRecord
@@ -6,11 +6,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
 func TestAutoSettleRecord(t *testing.T) {
 	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = upgrade.WithUpgraded(ctx, gnfdtypes.Hulunbeier)
 
 	addr1 := sample.RandAccAddress()
 	record1 := &types.AutoSettleRecord{
@@ -39,4 +42,8 @@ func TestAutoSettleRecord(t *testing.T) {
 	require.True(t, len(records) == 1)
 	require.True(t, records[0].Addr == addr1.String())
 	require.True(t, records[0].Timestamp == 110)
+
+	// index by address
+	require.Equal(t, records, keeper.GetAutoSettleRecordsByAddr(ctx, addr1))
+	require.Empty(t, keeper.GetAutoSettleRecordsByAddr(ctx, addr2))
 }
@@ -0,0 +1,455 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// GetBillingStatementAmount returns the amount paid from addr to toAddr in the statement period starting at periodStart
+func (k Keeper) GetBillingStatementAmount(ctx sdk.Context, addr sdk.AccAddress, periodStart int64, toAddr sdk.AccAddress) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementKeyPrefix)
+	value := store.Get(types.BillingStatementKey(addr, periodStart, toAddr))
+	if value == nil {
+		return sdkmath.ZeroInt()
+	}
+	return types.ParseOutFlowValue(value)
+}
+
+// addBillingStatementAmount adds amount to the statement line of addr to toAddr in the period starting at periodStart
+func (k Keeper) addBillingStatementAmount(ctx sdk.Context, addr sdk.AccAddress, periodStart int64, toAddr sdk.AccAddress, amount sdkmath.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementKeyPrefix)
+	key := types.BillingStatementKey(addr, periodStart, toAddr)
+	value := store.Get(key)
+	if value == nil {
+		periodStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementPeriodKeyPrefix)
+		periodStore.Set(types.BillingStatementPeriodKey(periodStart, addr, toAddr), []byte{})
+	} else {
+		amount = amount.Add(types.ParseOutFlowValue(value))
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic("should not happen")
+	}
+	store.Set(key, bz)
+}
+
+// GetOutFlowStatementCheckpoint returns the timestamp until which the active out flow from addr to toAddr
+// has been recorded in the billing statement
+func (k Keeper) GetOutFlowStatementCheckpoint(ctx sdk.Context, addr, toAddr sdk.AccAddress) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowStatementCheckpointPrefix)
+	value := store.Get(types.OutFlowStatementCheckpointKey(addr, toAddr))
+	if value == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(value)), true
+}
+
+// GetBillingStatementStartTime returns the timestamp since which the billing statements are recorded
+func (k Keeper) GetBillingStatementStartTime(ctx sdk.Context) (int64, bool) {
+	value := ctx.KVStore(k.storeKey).Get(types.BillingStatementStartTimeKey)
+	if value == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(value)), true
+}
+
+// SetBillingStatementStartTime sets the timestamp since which the billing statements are recorded, it should be set
+// once when the billing statements are enabled.
+func (k Keeper) SetBillingStatementStartTime(ctx sdk.Context, startTime int64) {
+	ctx.KVStore(k.storeKey).Set(types.BillingStatementStartTimeKey, sdk.Uint64ToBigEndian(uint64(startTime)))
+}
+
+// outFlowStatementCheckpoint returns the checkpoint of the active out flow from addr to toAddr. The out flow which
+// has been active since the billing statements were enabled has no checkpoint, it is recorded since the start time.
+func (k Keeper) outFlowStatementCheckpoint(ctx sdk.Context, addr, toAddr sdk.AccAddress) (int64, bool) {
+	if checkpoint, found := k.GetOutFlowStatementCheckpoint(ctx, addr, toAddr); found {
+		return checkpoint, true
+	}
+	return k.GetBillingStatementStartTime(ctx)
+}
+
+// RecordOutFlowStatement records the amount streamed at the given rate from addr to toAddr since the last checkpoint
+// into the billing statement, and moves the checkpoint to the current block time.
+// The rate should be the rate of the active out flow before it is changed.
+func (k Keeper) RecordOutFlowStatement(ctx sdk.Context, addr, toAddr sdk.AccAddress, rate sdkmath.Int) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	params := k.GetParams(ctx)
+	if params.StatementPeriod == 0 {
+		return
+	}
+	now := ctx.BlockTime().Unix()
+	checkpoint, found := k.outFlowStatementCheckpoint(ctx, addr, toAddr)
+	if found && rate.IsPositive() {
+		from := maxInt64(checkpoint, retentionStart(now, params))
+		splitStatementPeriods(from, now, int64(params.StatementPeriod), func(periodStart, duration int64) {
+			k.addBillingStatementAmount(ctx, addr, periodStart, toAddr, rate.MulRaw(duration))
+		})
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowStatementCheckpointPrefix)
+	store.Set(types.OutFlowStatementCheckpointKey(addr, toAddr), sdk.Uint64ToBigEndian(uint64(now)))
+}
+
+// CloseOutFlowStatement records the amount streamed from addr to toAddr since the last checkpoint, and removes
+// the checkpoint since the out flow stops streaming, e.g. it is deleted or frozen.
+func (k Keeper) CloseOutFlowStatement(ctx sdk.Context, addr, toAddr sdk.AccAddress, rate sdkmath.Int) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	k.RecordOutFlowStatement(ctx, addr, toAddr, rate)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowStatementCheckpointPrefix)
+	store.Delete(types.OutFlowStatementCheckpointKey(addr, toAddr))
+}
+
+// GetBillingStatementBucketAmount returns the amount paid from addr to toAddr for the bucket in the statement period
+// starting at periodStart
+func (k Keeper) GetBillingStatementBucketAmount(ctx sdk.Context, addr sdk.AccAddress, periodStart int64,
+	bucketId sdkmath.Uint, toAddr sdk.AccAddress) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementBucketKeyPrefix)
+	value := store.Get(types.BillingStatementBucketKey(addr, periodStart, bucketId, toAddr))
+	if value == nil {
+		return sdkmath.ZeroInt()
+	}
+	return types.ParseOutFlowValue(value)
+}
+
+// addBillingStatementBucketAmount adds amount to the bucket statement line of addr to toAddr in the period starting at periodStart
+func (k Keeper) addBillingStatementBucketAmount(ctx sdk.Context, addr sdk.AccAddress, periodStart int64,
+	bucketId sdkmath.Uint, toAddr sdk.AccAddress, amount sdkmath.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementBucketKeyPrefix)
+	key := types.BillingStatementBucketKey(addr, periodStart, bucketId, toAddr)
+	value := store.Get(key)
+	if value == nil {
+		periodStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementBucketPeriodKeyPrefix)
+		periodStore.Set(types.BillingStatementBucketPeriodKey(periodStart, addr, bucketId, toAddr), []byte{})
+	} else {
+		amount = amount.Add(types.ParseOutFlowValue(value))
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic("should not happen")
+	}
+	store.Set(key, bz)
+}
+
+// GetBucketFlow returns the flow from addr to toAddr for the bucket
+func (k Keeper) GetBucketFlow(ctx sdk.Context, addr sdk.AccAddress, bucketId sdkmath.Uint, toAddr sdk.AccAddress) (*types.BucketFlow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketFlowKeyPrefix)
+	value := store.Get(types.BucketFlowKey(addr, bucketId, toAddr))
+	if value == nil {
+		return nil, false
+	}
+	var flow types.BucketFlow
+	k.cdc.MustUnmarshal(value, &flow)
+	return &flow, true
+}
+
+// GetBucketFlows returns the flows of all the buckets paid by addr
+func (k Keeper) GetBucketFlows(ctx sdk.Context, addr sdk.AccAddress) []types.BucketFlow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketFlowKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, addr.Bytes())
+	defer iterator.Close()
+
+	flows := make([]types.BucketFlow, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.BucketFlow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+	return flows
+}
+
+func (k Keeper) setBucketFlow(ctx sdk.Context, addr sdk.AccAddress, flow *types.BucketFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BucketFlowKeyPrefix)
+	key := types.BucketFlowKey(addr, flow.BucketId, sdk.MustAccAddressFromHex(flow.ToAddress))
+	if !flow.Rate.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(flow))
+}
+
+// recordBucketFlowStatement records the amount streamed by the bucket flow since its checkpoint into the billing
+// statement, and moves the checkpoint to the current block time.
+func (k Keeper) recordBucketFlowStatement(ctx sdk.Context, addr sdk.AccAddress, flow *types.BucketFlow, params types.Params) {
+	now := ctx.BlockTime().Unix()
+	if params.StatementPeriod > 0 && flow.Rate.IsPositive() {
+		toAddr := sdk.MustAccAddressFromHex(flow.ToAddress)
+		from := maxInt64(flow.Checkpoint, retentionStart(now, params))
+		splitStatementPeriods(from, now, int64(params.StatementPeriod), func(periodStart, duration int64) {
+			k.addBillingStatementBucketAmount(ctx, addr, periodStart, flow.BucketId, toAddr, flow.Rate.MulRaw(duration))
+		})
+	}
+	flow.Checkpoint = now
+}
+
+// RecordBucketFlows applies the flow changes of the bucket to its flows, which are used to record the bucket line
+// items of the billing statements. It should be called with the same flow changes applied to the stream records
+// via ApplyUserFlowsList.
+func (k Keeper) RecordBucketFlows(ctx sdk.Context, bucketId sdkmath.Uint, userFlowsList []types.UserFlows) {
+	params := k.GetParams(ctx)
+	for _, userFlows := range userFlowsList {
+		// the flows of a frozen account do not stream, they were recorded until it was frozen
+		streamRecord, found := k.GetStreamRecord(ctx, userFlows.From)
+		active := !found || streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE
+		for _, flowChange := range userFlows.Flows {
+			toAddr := sdk.MustAccAddressFromHex(flowChange.ToAddress)
+			flow, found := k.GetBucketFlow(ctx, userFlows.From, bucketId, toAddr)
+			if !found {
+				flow = &types.BucketFlow{BucketId: bucketId, ToAddress: toAddr.String(), Rate: sdkmath.ZeroInt()}
+			}
+			if active {
+				k.recordBucketFlowStatement(ctx, userFlows.From, flow, params)
+			} else {
+				flow.Checkpoint = ctx.BlockTime().Unix()
+			}
+			flow.Rate = flow.Rate.Add(flowChange.Rate)
+			k.setBucketFlow(ctx, userFlows.From, flow)
+		}
+	}
+}
+
+// checkpointBucketFlows moves the checkpoints of the bucket flows paid by addr to the current block time. The amount
+// streamed since the checkpoints is recorded if record is true, e.g. when the account is frozen, and is dropped
+// otherwise, e.g. when the account is resumed.
+func (k Keeper) checkpointBucketFlows(ctx sdk.Context, addr sdk.AccAddress, record bool) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	params := k.GetParams(ctx)
+	for _, flow := range k.GetBucketFlows(ctx, addr) {
+		if record {
+			k.recordBucketFlowStatement(ctx, addr, &flow, params)
+		} else {
+			flow.Checkpoint = ctx.BlockTime().Unix()
+		}
+		k.setBucketFlow(ctx, addr, &flow)
+	}
+}
+
+// PruneBillingStatements deletes the statement periods which are older than the retention time
+func (k Keeper) PruneBillingStatements(ctx sdk.Context) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	params := k.GetParams(ctx)
+	if params.StatementPeriod == 0 {
+		return
+	}
+	cutoff := retentionStart(ctx.BlockTime().Unix(), params)
+
+	store := ctx.KVStore(k.storeKey)
+	statementStore := prefix.NewStore(store, types.BillingStatementKeyPrefix)
+	periodStore := prefix.NewStore(store, types.BillingStatementPeriodKeyPrefix)
+	iterator := periodStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff)))
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if count >= types.MaxPrunedBillingStatementCount {
+			return
+		}
+		periodStart, addr, toAddr := types.ParseBillingStatementPeriodKey(iterator.Key())
+		statementStore.Delete(types.BillingStatementKey(addr, periodStart, toAddr))
+		periodStore.Delete(iterator.Key())
+		count++
+	}
+
+	bucketStatementStore := prefix.NewStore(store, types.BillingStatementBucketKeyPrefix)
+	bucketPeriodStore := prefix.NewStore(store, types.BillingStatementBucketPeriodKeyPrefix)
+	bucketIterator := bucketPeriodStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff)))
+	defer bucketIterator.Close()
+
+	for ; bucketIterator.Valid(); bucketIterator.Next() {
+		if count >= types.MaxPrunedBillingStatementCount {
+			return
+		}
+		periodStart, addr, bucketId, toAddr := types.ParseBillingStatementBucketPeriodKey(bucketIterator.Key())
+		bucketStatementStore.Delete(types.BillingStatementBucketKey(addr, periodStart, bucketId, toAddr))
+		bucketPeriodStore.Delete(bucketIterator.Key())
+		count++
+	}
+}
+
+// GetBillingStatement returns the statement periods of addr within [startTime, endTime) in ascending order.
+// The amount streamed by the active out flows but not recorded yet is included until the current block time.
+func (k Keeper) GetBillingStatement(ctx sdk.Context, addr sdk.AccAddress, startTime, endTime int64) []types.BillingStatementPeriod {
+	params := k.GetParams(ctx)
+	if params.StatementPeriod == 0 {
+		return nil
+	}
+	period := int64(params.StatementPeriod)
+	now := ctx.BlockTime().Unix()
+	if endTime <= 0 || endTime > now {
+		endTime = now + 1
+	}
+	startTime = maxInt64(startTime, retentionStart(now, params))
+	inRange := func(periodStart int64, amount sdkmath.Int) bool {
+		return periodStart+period > startTime && periodStart < endTime && amount.IsPositive()
+	}
+
+	type bucketLineKey struct {
+		bucketId uint64
+		toAddr   string
+	}
+	amounts := make(map[int64]map[string]sdkmath.Int)
+	bucketAmounts := make(map[int64]map[bucketLineKey]sdkmath.Int)
+	add := func(periodStart int64, toAddr string, amount sdkmath.Int) {
+		if !inRange(periodStart, amount) {
+			return
+		}
+		lines, ok := amounts[periodStart]
+		if !ok {
+			lines = make(map[string]sdkmath.Int)
+			amounts[periodStart] = lines
+		}
+		if current, ok := lines[toAddr]; ok {
+			amount = amount.Add(current)
+		}
+		lines[toAddr] = amount
+	}
+	addBucket := func(periodStart int64, bucketId sdkmath.Uint, toAddr string, amount sdkmath.Int) {
+		if !inRange(periodStart, amount) {
+			return
+		}
+		lines, ok := bucketAmounts[periodStart]
+		if !ok {
+			lines = make(map[bucketLineKey]sdkmath.Int)
+			bucketAmounts[periodStart] = lines
+		}
+		key := bucketLineKey{bucketId: bucketId.Uint64(), toAddr: toAddr}
+		if current, ok := lines[key]; ok {
+			amount = amount.Add(current)
+		}
+		lines[key] = amount
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, addr.Bytes())
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, periodStart, toAddr := types.ParseBillingStatementKey(iterator.Key())
+		add(periodStart, toAddr.String(), types.ParseOutFlowValue(iterator.Value()))
+	}
+
+	bucketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BillingStatementBucketKeyPrefix)
+	bucketIterator := storetypes.KVStorePrefixIterator(bucketStore, addr.Bytes())
+	defer bucketIterator.Close()
+	for ; bucketIterator.Valid(); bucketIterator.Next() {
+		_, periodStart, bucketId, toAddr := types.ParseBillingStatementBucketKey(bucketIterator.Key())
+		addBucket(periodStart, bucketId, toAddr.String(), types.ParseOutFlowValue(bucketIterator.Value()))
+	}
+
+	// the amount of the active out flows since their checkpoints is not recorded yet
+	for _, outFlow := range k.GetOutFlows(ctx, addr) {
+		if outFlow.Status != types.OUT_FLOW_STATUS_ACTIVE {
+			continue
+		}
+		toAddr := sdk.MustAccAddressFromHex(outFlow.ToAddress)
+		checkpoint, found := k.outFlowStatementCheckpoint(ctx, addr, toAddr)
+		if !found {
+			continue
+		}
+		splitStatementPeriods(maxInt64(checkpoint, startTime), now, period, func(periodStart, duration int64) {
+			add(periodStart, outFlow.ToAddress, outFlow.Rate.MulRaw(duration))
+		})
+	}
+	streamRecord, found := k.GetStreamRecord(ctx, addr)
+	if !found || streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE {
+		for _, flow := range k.GetBucketFlows(ctx, addr) {
+			flow := flow
+			splitStatementPeriods(maxInt64(flow.Checkpoint, startTime), now, period, func(periodStart, duration int64) {
+				addBucket(periodStart, flow.BucketId, flow.ToAddress, flow.Rate.MulRaw(duration))
+			})
+		}
+	}
+
+	for periodStart := range bucketAmounts {
+		if _, ok := amounts[periodStart]; !ok {
+			amounts[periodStart] = make(map[string]sdkmath.Int)
+		}
+	}
+	periods := make([]types.BillingStatementPeriod, 0, len(amounts))
+	for periodStart, lines := range amounts {
+		statementPeriod := types.BillingStatementPeriod{
+			PeriodStart: periodStart,
+			PeriodEnd:   periodStart + period,
+			TotalAmount: sdkmath.ZeroInt(),
+		}
+		for toAddr, amount := range lines {
+			statementPeriod.Lines = append(statementPeriod.Lines, types.BillingStatementLine{ToAddress: toAddr, Amount: amount})
+			statementPeriod.TotalAmount = statementPeriod.TotalAmount.Add(amount)
+		}
+		sort.Slice(statementPeriod.Lines, func(i, j int) bool {
+			return statementPeriod.Lines[i].ToAddress < statementPeriod.Lines[j].ToAddress
+		})
+		for key, amount := range bucketAmounts[periodStart] {
+			statementPeriod.BucketLines = append(statementPeriod.BucketLines, types.BillingStatementBucketLine{
+				BucketId:  sdkmath.NewUint(key.bucketId),
+				ToAddress: key.toAddr,
+				Amount:    amount,
+			})
+		}
+		sortBillingStatementBucketLines(statementPeriod.BucketLines)
+		periods = append(periods, statementPeriod)
+	}
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].PeriodStart < periods[j].PeriodStart
+	})
+	return periods
+}
+
+// sortBillingStatementBucketLines sorts the bucket lines by bucket id and destination
+func sortBillingStatementBucketLines(lines []types.BillingStatementBucketLine) {
+	sort.Slice(lines, func(i, j int) bool {
+		if !lines[i].BucketId.Equal(lines[j].BucketId) {
+			return lines[i].BucketId.LT(lines[j].BucketId)
+		}
+		return lines[i].ToAddress < lines[j].ToAddress
+	})
+}
+
+// retentionStart returns the start of the oldest statement period which is still retained
+func retentionStart(now int64, params types.Params) int64 {
+	period := int64(params.StatementPeriod)
+	start := now - int64(params.StatementRetentionTime)
+	if start <= 0 {
+		return 0
+	}
+	return start - start%period
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// splitStatementPeriods splits [from, to) by statement periods, and calls fn with the start of each period
+// and the duration within it
+func splitStatementPeriods(from, to, period int64, fn func(periodStart, duration int64)) {
+	for from < to {
+		periodStart := from - from%period
+		end := periodStart + period
+		if end > to {
+			end = to
+		}
+		fn(periodStart, end-from)
+		from = end
+	}
+}
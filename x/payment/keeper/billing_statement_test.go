@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func TestBillingStatement(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = upgrade.WithUpgraded(ctx, gnfdtypes.Hulunbeier)
+	params := keeper.GetParams(ctx)
+	period := int64(params.StatementPeriod)
+
+	start := int64(1690000000)
+	start = start - start%period
+	ctx = ctx.WithBlockTime(time.Unix(start+period-100, 0))
+
+	addr := sample.RandAccAddress()
+	toAddr1 := sample.RandAccAddress()
+	toAddr2 := sample.RandAccAddress()
+	outFlow1 := types.OutFlow{ToAddress: toAddr1.String(), Rate: math.NewInt(10)}
+	outFlow2 := types.OutFlow{ToAddress: toAddr2.String(), Rate: math.NewInt(1)}
+	keeper.MergeActiveOutFlows(ctx, addr, []types.OutFlow{outFlow1, outFlow2})
+
+	// the flows stream across the period boundary before the rate of the first flow is changed
+	ctx = ctx.WithBlockTime(time.Unix(start+period+50, 0))
+	keeper.MergeActiveOutFlows(ctx, addr, []types.OutFlow{outFlow1})
+	require.Equal(t, math.NewInt(1000), keeper.GetBillingStatementAmount(ctx, addr, start, toAddr1))
+	require.Equal(t, math.NewInt(500), keeper.GetBillingStatementAmount(ctx, addr, start+period, toAddr1))
+	require.Equal(t, math.ZeroInt(), keeper.GetBillingStatementAmount(ctx, addr, start, toAddr2))
+
+	ctx = ctx.WithBlockTime(time.Unix(start+period+60, 0))
+	response, err := keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Account: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(period), response.Period)
+	require.Equal(t, 2, len(response.Periods))
+
+	require.Equal(t, start, response.Periods[0].PeriodStart)
+	require.Equal(t, math.NewInt(1100), response.Periods[0].TotalAmount)
+	require.Equal(t, start+period, response.Periods[1].PeriodStart)
+	// 500 recorded, 10 seconds of 20/s and 60 seconds of 1/s not recorded yet
+	require.Equal(t, math.NewInt(500+200+60), response.Periods[1].TotalAmount)
+
+	totals := make(map[string]math.Int)
+	for _, line := range response.DestinationTotals {
+		totals[line.ToAddress] = line.Amount
+	}
+	require.Equal(t, math.NewInt(1700), totals[toAddr1.String()])
+	require.Equal(t, math.NewInt(160), totals[toAddr2.String()])
+
+	// delete the first flow
+	keeper.MergeActiveOutFlows(ctx, addr, []types.OutFlow{{ToAddress: toAddr1.String(), Rate: math.NewInt(-20)}})
+	_, found := keeper.GetOutFlowStatementCheckpoint(ctx, addr, toAddr1)
+	require.False(t, found)
+	require.Equal(t, math.NewInt(700), keeper.GetBillingStatementAmount(ctx, addr, start+period, toAddr1))
+
+	// filter by time
+	response, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Account: addr.String(), StartTime: start + period})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Periods))
+
+	// paginate by period
+	response, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{
+		Account:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Periods))
+	require.Equal(t, start, response.Periods[0].PeriodStart)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+	response, err = keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{
+		Account:    addr.String(),
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Periods))
+	require.Equal(t, start+period, response.Periods[0].PeriodStart)
+	require.Nil(t, response.Pagination.NextKey)
+
+	// prune the expired periods
+	ctx = ctx.WithBlockTime(time.Unix(start+period+int64(params.StatementRetentionTime), 0))
+	keeper.PruneBillingStatements(ctx)
+	require.Equal(t, math.ZeroInt(), keeper.GetBillingStatementAmount(ctx, addr, start, toAddr1))
+	require.Equal(t, math.NewInt(700), keeper.GetBillingStatementAmount(ctx, addr, start+period, toAddr1))
+}
+
+func TestBillingStatementBeforeUpgrade(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	params := keeper.GetParams(ctx)
+	period := int64(params.StatementPeriod)
+
+	start := int64(1690000000)
+	start = start - start%period
+	ctx = ctx.WithBlockTime(time.Unix(start+period-100, 0))
+
+	// nothing is recorded for the billing statements before the Hulunbeier upgrade
+	addr := sample.RandAccAddress()
+	toAddr := sample.RandAccAddress()
+	keeper.MergeActiveOutFlows(ctx, addr, []types.OutFlow{{ToAddress: toAddr.String(), Rate: math.NewInt(10)}})
+	ctx = ctx.WithBlockTime(time.Unix(start+period+50, 0))
+	keeper.MergeActiveOutFlows(ctx, addr, []types.OutFlow{{ToAddress: toAddr.String(), Rate: math.NewInt(10)}})
+	_, found := keeper.GetOutFlowStatementCheckpoint(ctx, addr, toAddr)
+	require.False(t, found)
+	require.Equal(t, math.ZeroInt(), keeper.GetBillingStatementAmount(ctx, addr, start, toAddr))
+}
+
+func TestBillingStatementWithoutCheckpoint(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = upgrade.WithUpgraded(ctx, gnfdtypes.Hulunbeier)
+	period := int64(keeper.GetParams(ctx).StatementPeriod)
+
+	start := int64(1690000000)
+	start = start - start%period
+	ctx = ctx.WithBlockTime(time.Unix(start+100, 0))
+
+	// the out flow is active before the billing statements are enabled, so it has no checkpoint
+	addr := sample.RandAccAddress()
+	toAddr := sample.RandAccAddress()
+	keeper.SetOutFlow(ctx, addr, &types.OutFlow{ToAddress: toAddr.String(), Rate: math.NewInt(10)})
+	ctx = ctx.WithBlockTime(time.Unix(start+200, 0))
+	require.Empty(t, keeper.GetBillingStatement(ctx, addr, 0, 0))
+
+	keeper.SetBillingStatementStartTime(ctx, start+200)
+	ctx = ctx.WithBlockTime(time.Unix(start+300, 0))
+	periods := keeper.GetBillingStatement(ctx, addr, 0, 0)
+	require.Equal(t, 1, len(periods))
+	require.Equal(t, math.NewInt(1000), periods[0].TotalAmount)
+
+	// the amount since the start time is recorded when the rate is changed
+	keeper.MergeActiveOutFlows(ctx, addr, []types.OutFlow{{ToAddress: toAddr.String(), Rate: math.NewInt(10)}})
+	require.Equal(t, math.NewInt(1000), keeper.GetBillingStatementAmount(ctx, addr, start, toAddr))
+	checkpoint, found := keeper.GetOutFlowStatementCheckpoint(ctx, addr, toAddr)
+	require.True(t, found)
+	require.Equal(t, start+300, checkpoint)
+}
+
+func TestBillingStatementBucketLines(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	ctx = upgrade.WithUpgraded(ctx, gnfdtypes.Hulunbeier)
+	params := keeper.GetParams(ctx)
+	period := int64(params.StatementPeriod)
+
+	start := int64(1690000000)
+	start = start - start%period
+	ctx = ctx.WithBlockTime(time.Unix(start+period-100, 0))
+
+	addr := sample.RandAccAddress()
+	toAddr := sample.RandAccAddress()
+	bucketId1, bucketId2 := math.NewUint(1), math.NewUint(2)
+	keeper.RecordBucketFlows(ctx, bucketId1, []types.UserFlows{{From: addr, Flows: []types.OutFlow{{ToAddress: toAddr.String(), Rate: math.NewInt(10)}}}})
+	keeper.RecordBucketFlows(ctx, bucketId2, []types.UserFlows{{From: addr, Flows: []types.OutFlow{{ToAddress: toAddr.String(), Rate: math.NewInt(1)}}}})
+
+	// the bucket flow streams across the period boundary before its rate is changed
+	ctx = ctx.WithBlockTime(time.Unix(start+period+50, 0))
+	keeper.RecordBucketFlows(ctx, bucketId1, []types.UserFlows{{From: addr, Flows: []types.OutFlow{{ToAddress: toAddr.String(), Rate: math.NewInt(-10)}}}})
+	_, found := keeper.GetBucketFlow(ctx, addr, bucketId1, toAddr)
+	require.False(t, found)
+	require.Equal(t, math.NewInt(1000), keeper.GetBillingStatementBucketAmount(ctx, addr, start, bucketId1, toAddr))
+	require.Equal(t, math.NewInt(500), keeper.GetBillingStatementBucketAmount(ctx, addr, start+period, bucketId1, toAddr))
+
+	ctx = ctx.WithBlockTime(time.Unix(start+period+60, 0))
+	response, err := keeper.BillingStatement(ctx, &types.QueryBillingStatementRequest{Account: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Periods))
+	require.Equal(t, []types.BillingStatementBucketLine{
+		{BucketId: bucketId1, ToAddress: toAddr.String(), Amount: math.NewInt(1000)},
+		{BucketId: bucketId2, ToAddress: toAddr.String(), Amount: math.NewInt(100)},
+	}, response.Periods[0].BucketLines)
+	// the second bucket is not recorded yet, it streams for 60 seconds in the second period
+	require.Equal(t, []types.BillingStatementBucketLine{
+		{BucketId: bucketId1, ToAddress: toAddr.String(), Amount: math.NewInt(500)},
+		{BucketId: bucketId2, ToAddress: toAddr.String(), Amount: math.NewInt(60)},
+	}, response.Periods[1].BucketLines)
+	require.Equal(t, []types.BillingStatementBucketLine{
+		{BucketId: bucketId1, ToAddress: toAddr.String(), Amount: math.NewInt(1500)},
+		{BucketId: bucketId2, ToAddress: toAddr.String(), Amount: math.NewInt(160)},
+	}, response.BucketTotals)
+
+	// prune the expired periods
+	ctx = ctx.WithBlockTime(time.Unix(start+period+int64(params.StatementRetentionTime), 0))
+	keeper.PruneBillingStatements(ctx)
+	require.Equal(t, math.ZeroInt(), keeper.GetBillingStatementBucketAmount(ctx, addr, start, bucketId1, toAddr))
+	require.Equal(t, math.NewInt(500), keeper.GetBillingStatementBucketAmount(ctx, addr, start+period, bucketId1, toAddr))
+}
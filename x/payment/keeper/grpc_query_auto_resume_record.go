@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) AutoResumeQueue(c context.Context, req *types.QueryAutoResumeQueueRequest) (*types.QueryAutoResumeQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var account sdk.AccAddress
+	if req.Account != "" {
+		var err error
+		account, err = sdk.AccAddressFromHexUnsafe(req.Account)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid account")
+		}
+	}
+
+	var entries []types.AutoResumeQueueEntry
+	store := ctx.KVStore(k.storeKey)
+	autoResumeRecordStore := prefix.NewStore(store, types.AutoResumeRecordKeyPrefix)
+	maxAutoResumeFlowCount := k.GetParams(ctx).MaxAutoResumeFlowCount
+
+	// the records of the account are looked up via the index by address instead of scanning the queue, the index is
+	// maintained since the Hulunbeier upgrade
+	if account != nil && ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		count, rate := k.GetFrozenOutFlowsSummary(ctx, account)
+		indexStore := prefix.NewStore(store, append(types.AutoResumeRecordByAddrKeyPrefix, types.AutoRecordByAddrPrefix(account)...))
+		pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+			record := types.AutoResumeRecord{Timestamp: types.ParseAutoRecordByAddrKey(key), Addr: account.String()}
+			position, outFlowsAhead := k.countAutoResumeRecordsBefore(ctx, autoResumeRecordStore, types.AutoResumeRecordKey(record.Timestamp, account))
+			entries = append(entries, types.AutoResumeQueueEntry{
+				Record:                record,
+				Position:              position,
+				RemainingOutFlowCount: count,
+				RemainingRate:         rate,
+				OutFlowsAhead:         outFlowsAhead,
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryAutoResumeQueueResponse{
+			Entries:                entries,
+			Pagination:             pageRes,
+			MaxAutoResumeFlowCount: maxAutoResumeFlowCount,
+		}, nil
+	}
+
+	// the records ahead of the first one are counted from the head of the queue, the following ones are consecutive
+	var position, outFlowsAhead uint64
+	started := false
+	pageRes, err := query.FilteredPaginate(autoResumeRecordStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if !started {
+			position, outFlowsAhead = k.countAutoResumeRecordsBefore(ctx, autoResumeRecordStore, key)
+			started = true
+		}
+
+		record := types.ParseAutoResumeRecordKey(key)
+		addr := sdk.MustAccAddressFromHex(record.Addr)
+		count, rate := k.GetFrozenOutFlowsSummary(ctx, addr)
+		defer func() {
+			position++
+			outFlowsAhead += count
+		}()
+
+		if account != nil && !addr.Equals(account) {
+			return false, nil
+		}
+		if accumulate {
+			entries = append(entries, types.AutoResumeQueueEntry{
+				Record:                record,
+				Position:              position,
+				RemainingOutFlowCount: count,
+				RemainingRate:         rate,
+				OutFlowsAhead:         outFlowsAhead,
+			})
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoResumeQueueResponse{
+		Entries:                entries,
+		Pagination:             pageRes,
+		MaxAutoResumeFlowCount: maxAutoResumeFlowCount,
+	}, nil
+}
+
+// countAutoResumeRecordsBefore returns the number of records ahead of the given key in the auto resume queue,
+// and the number of frozen out flows to resume of them
+func (k Keeper) countAutoResumeRecordsBefore(ctx sdk.Context, store prefix.Store, key []byte) (uint64, uint64) {
+	iterator := store.Iterator(nil, key)
+	defer iterator.Close()
+
+	var records, outFlows uint64
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ParseAutoResumeRecordKey(iterator.Key())
+		count, _ := k.GetFrozenOutFlowsSummary(ctx, sdk.MustAccAddressFromHex(record.Addr))
+		records++
+		outFlows += count
+	}
+	return records, outFlows
+}
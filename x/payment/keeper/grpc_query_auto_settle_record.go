@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 )
 
@@ -38,3 +39,81 @@ func (k Keeper) AutoSettleRecords(c context.Context, req *types.QueryAutoSettleR
 
 	return &types.QueryAutoSettleRecordsResponse{AutoSettleRecords: autoSettleRecords, Pagination: pageRes}, nil
 }
+
+func (k Keeper) AutoSettleQueue(c context.Context, req *types.QueryAutoSettleQueueRequest) (*types.QueryAutoSettleQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := query.CheckOffsetQueryNotAllowed(ctx, req.Pagination); err != nil {
+		return nil, err
+	}
+
+	var account sdk.AccAddress
+	if req.Account != "" {
+		var err error
+		account, err = sdk.AccAddressFromHexUnsafe(req.Account)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid account")
+		}
+	}
+
+	var entries []types.AutoSettleQueueEntry
+	store := ctx.KVStore(k.storeKey)
+	autoSettleRecordStore := prefix.NewStore(store, types.AutoSettleRecordKeyPrefix)
+
+	// the records of the account are looked up via the index by address instead of scanning the queue, the index is
+	// maintained since the Hulunbeier upgrade
+	if account != nil && ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		indexStore := prefix.NewStore(store, append(types.AutoSettleRecordByAddrKeyPrefix, types.AutoRecordByAddrPrefix(account)...))
+		pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+			record := types.AutoSettleRecord{Timestamp: types.ParseAutoRecordByAddrKey(key), Addr: account.String()}
+			position := countKeysBefore(autoSettleRecordStore, types.AutoSettleRecordKey(record.Timestamp, account))
+			entries = append(entries, types.AutoSettleQueueEntry{Record: record, Position: position})
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryAutoSettleQueueResponse{Entries: entries, Pagination: pageRes}, nil
+	}
+
+	// the position of the first record is counted from the head of the queue, the following ones are consecutive
+	var position uint64
+	started := false
+	pageRes, err := query.FilteredPaginate(autoSettleRecordStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if !started {
+			position = countKeysBefore(autoSettleRecordStore, key)
+			started = true
+		}
+		defer func() { position++ }()
+
+		record := types.ParseAutoSettleRecordKey(key)
+		if account != nil && !sdk.MustAccAddressFromHex(record.Addr).Equals(account) {
+			return false, nil
+		}
+		if accumulate {
+			entries = append(entries, types.AutoSettleQueueEntry{Record: record, Position: position})
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoSettleQueueResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// countKeysBefore returns the number of keys in the store which are less than the given key
+func countKeysBefore(store prefix.Store, key []byte) uint64 {
+	iterator := store.Iterator(nil, key)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func (k Keeper) BillingStatement(goCtx context.Context, req *types.QueryBillingStatementRequest) (*types.QueryBillingStatementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := sdk.AccAddressFromHexUnsafe(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account")
+	}
+	if req.EndTime > 0 && req.StartTime >= req.EndTime {
+		return nil, status.Error(codes.InvalidArgument, "start time should be less than end time")
+	}
+
+	periods := k.GetBillingStatement(ctx, account, req.StartTime, req.EndTime)
+
+	// the totals cover the whole requested time range regardless of pagination
+	totals := make(map[string]sdkmath.Int)
+	bucketTotals := make([]types.BillingStatementBucketLine, 0)
+	bucketTotalIndexes := make(map[string]int)
+	for _, period := range periods {
+		for _, line := range period.Lines {
+			if total, ok := totals[line.ToAddress]; ok {
+				totals[line.ToAddress] = total.Add(line.Amount)
+			} else {
+				totals[line.ToAddress] = line.Amount
+			}
+		}
+		for _, line := range period.BucketLines {
+			key := line.BucketId.String() + "/" + line.ToAddress
+			if i, ok := bucketTotalIndexes[key]; ok {
+				bucketTotals[i].Amount = bucketTotals[i].Amount.Add(line.Amount)
+			} else {
+				bucketTotalIndexes[key] = len(bucketTotals)
+				bucketTotals = append(bucketTotals, line)
+			}
+		}
+	}
+	destinationTotals := make([]types.BillingStatementLine, 0, len(totals))
+	for toAddr, amount := range totals {
+		destinationTotals = append(destinationTotals, types.BillingStatementLine{ToAddress: toAddr, Amount: amount})
+	}
+	sort.Slice(destinationTotals, func(i, j int) bool {
+		return destinationTotals[i].ToAddress < destinationTotals[j].ToAddress
+	})
+	sortBillingStatementBucketLines(bucketTotals)
+
+	periods, pageRes, err := paginateBillingStatementPeriods(periods, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBillingStatementResponse{
+		Periods:           periods,
+		DestinationTotals: destinationTotals,
+		Period:            k.GetParams(ctx).StatementPeriod,
+		BucketTotals:      bucketTotals,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// paginateBillingStatementPeriods pages the statement periods sorted by period start,
+// the key of a page is the big endian encoded start of its first period.
+func paginateBillingStatementPeriods(periods []types.BillingStatementPeriod, pageReq *query.PageRequest) ([]types.BillingStatementPeriod, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := uint64(0)
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		periodStart := int64(binary.BigEndian.Uint64(pageReq.Key))
+		start = uint64(sort.Search(len(periods), func(i int) bool {
+			return periods[i].PeriodStart >= periodStart
+		}))
+	} else {
+		start = pageReq.Offset
+	}
+	if start > uint64(len(periods)) {
+		start = uint64(len(periods))
+	}
+	end := start + limit
+	if end > uint64(len(periods)) {
+		end = uint64(len(periods))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(periods)) {
+		pageRes.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(pageRes.NextKey, uint64(periods[end].PeriodStart))
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(periods))
+	}
+	return periods[start:end], pageRes, nil
+}
@@ -70,6 +70,81 @@ func TestAutoSettleRecordQuery(t *testing.T) {
 	require.Equal(t, record, response.AutoSettleRecords[0])
 }
 
+func TestAutoSettleQueueQuery(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	params := types.DefaultParams()
+	err := keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	addr := sample.RandAccAddress()
+	for i := int64(1); i <= 3; i++ {
+		keeper.SetAutoSettleRecord(ctx, &types.AutoSettleRecord{Timestamp: i, Addr: sample.RandAccAddress().String()})
+	}
+	record := types.AutoSettleRecord{Timestamp: 4, Addr: addr.String()}
+	keeper.SetAutoSettleRecord(ctx, &record)
+
+	response, err := keeper.AutoSettleQueue(ctx, &types.QueryAutoSettleQueueRequest{Account: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Entries))
+	require.Equal(t, record, response.Entries[0].Record)
+	require.Equal(t, uint64(3), response.Entries[0].Position)
+
+	response, err = keeper.AutoSettleQueue(ctx, &types.QueryAutoSettleQueueRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(response.Entries))
+	for i, entry := range response.Entries {
+		require.Equal(t, uint64(i), entry.Position)
+	}
+}
+
+func TestAutoResumeQueueQuery(t *testing.T) {
+	keeper, ctx, _ := makePaymentKeeper(t)
+	params := types.DefaultParams()
+	err := keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	addr1 := sample.RandAccAddress()
+	addr2 := sample.RandAccAddress()
+	for i := 0; i < 3; i++ {
+		keeper.SetOutFlow(ctx, addr1, &types.OutFlow{
+			ToAddress: sample.RandAccAddress().String(),
+			Rate:      sdkmath.NewInt(10),
+			Status:    types.OUT_FLOW_STATUS_FROZEN,
+		})
+	}
+	keeper.SetOutFlow(ctx, addr2, &types.OutFlow{
+		ToAddress: sample.RandAccAddress().String(),
+		Rate:      sdkmath.NewInt(5),
+		Status:    types.OUT_FLOW_STATUS_FROZEN,
+	})
+	keeper.SetOutFlow(ctx, addr2, &types.OutFlow{
+		ToAddress: sample.RandAccAddress().String(),
+		Rate:      sdkmath.NewInt(7),
+		Status:    types.OUT_FLOW_STATUS_ACTIVE,
+	})
+	keeper.SetAutoResumeRecord(ctx, &types.AutoResumeRecord{Timestamp: 100, Addr: addr1.String()})
+	keeper.SetAutoResumeRecord(ctx, &types.AutoResumeRecord{Timestamp: 200, Addr: addr2.String()})
+
+	response, err := keeper.AutoResumeQueue(ctx, &types.QueryAutoResumeQueueRequest{Account: addr2.String()})
+	require.NoError(t, err)
+	require.Equal(t, params.MaxAutoResumeFlowCount, response.MaxAutoResumeFlowCount)
+	require.Equal(t, 1, len(response.Entries))
+	entry := response.Entries[0]
+	require.Equal(t, addr2.String(), entry.Record.Addr)
+	require.Equal(t, uint64(1), entry.Position)
+	require.Equal(t, uint64(1), entry.RemainingOutFlowCount)
+	require.Equal(t, sdkmath.NewInt(5), entry.RemainingRate)
+	require.Equal(t, uint64(3), entry.OutFlowsAhead)
+
+	response, err = keeper.AutoResumeQueue(ctx, &types.QueryAutoResumeQueueRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Entries))
+	require.Equal(t, uint64(0), response.Entries[0].Position)
+	require.Equal(t, uint64(3), response.Entries[0].RemainingOutFlowCount)
+	require.Equal(t, sdkmath.NewInt(30), response.Entries[0].RemainingRate)
+	require.Equal(t, uint64(0), response.Entries[0].OutFlowsAhead)
+}
+
 func TestDynamicBalanceQuery(t *testing.T) {
 	keeper, ctx, deepKeepers := makePaymentKeeper(t)
 	params := types.DefaultParams()
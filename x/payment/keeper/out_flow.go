@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -57,6 +58,26 @@ func (k Keeper) GetOutFlows(ctx sdk.Context, addr sdk.AccAddress) []types.OutFlo
 	return outFlows
 }
 
+// GetFrozenOutFlowsSummary returns the number and the total rate of frozen OutFlows for a specific from address
+func (k Keeper) GetFrozenOutFlowsSummary(ctx sdk.Context, addr sdk.AccAddress) (uint64, sdkmath.Int) {
+	key := types.OutFlowKey(addr, types.OUT_FLOW_STATUS_FROZEN, nil)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowKeyPrefix)
+	iterator := store.Iterator(key, nil)
+	defer iterator.Close()
+
+	var count uint64
+	rate := sdkmath.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		addrInKey, outFlow := types.ParseOutFlowKey(iterator.Key())
+		if !addrInKey.Equals(addr) || outFlow.Status != types.OUT_FLOW_STATUS_FROZEN {
+			break
+		}
+		count++
+		rate = rate.Add(types.ParseOutFlowValue(iterator.Value()))
+	}
+	return count, rate
+}
+
 // DeleteOutFlow set a specific OutFlow from the store
 func (k Keeper) DeleteOutFlow(ctx sdk.Context, key []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowKeyPrefix)
@@ -78,18 +99,29 @@ func (k Keeper) mergeOutFlows(ctx sdk.Context, addr sdk.AccAddress, status types
 	deltaCount := 0
 	for _, outFlow := range outFlows {
 		outFlow.Status = status
-		key := types.OutFlowKey(addr, outFlow.Status, sdk.MustAccAddressFromHex(outFlow.ToAddress))
+		toAddr := sdk.MustAccAddressFromHex(outFlow.ToAddress)
+		key := types.OutFlowKey(addr, outFlow.Status, toAddr)
 		value := store.Get(key)
 		if value == nil {
+			if status == types.OUT_FLOW_STATUS_ACTIVE {
+				k.RecordOutFlowStatement(ctx, addr, toAddr, sdkmath.ZeroInt())
+			}
 			k.SetOutFlow(ctx, addr, &outFlow)
 			deltaCount++
 			continue
 		}
-		outFlow.Rate = types.ParseOutFlowValue(value).Add(outFlow.Rate)
+		prevRate := types.ParseOutFlowValue(value)
+		outFlow.Rate = prevRate.Add(outFlow.Rate)
 		if outFlow.Rate.IsZero() {
+			if status == types.OUT_FLOW_STATUS_ACTIVE {
+				k.CloseOutFlowStatement(ctx, addr, toAddr, prevRate)
+			}
 			k.DeleteOutFlow(ctx, key)
 			deltaCount--
 		} else {
+			if status == types.OUT_FLOW_STATUS_ACTIVE {
+				k.RecordOutFlowStatement(ctx, addr, toAddr, prevRate)
+			}
 			k.SetOutFlow(ctx, addr, &outFlow)
 		}
 	}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+// GetPrepaidPlan returns a prepaid plan by its id
+func (k Keeper) GetPrepaidPlan(ctx sdk.Context, id uint64) (*types.PrepaidPlan, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrepaidPlanKeyPrefix)
+	b := store.Get(types.PrepaidPlanKey(id))
+	if b == nil {
+		return nil, false
+	}
+	var plan types.PrepaidPlan
+	k.cdc.MustUnmarshal(b, &plan)
+	return &plan, true
+}
+
+func (k Keeper) setPrepaidPlan(ctx sdk.Context, plan *types.PrepaidPlan) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrepaidPlanKeyPrefix)
+	store.Set(types.PrepaidPlanKey(plan.Id), k.cdc.MustMarshal(plan))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrepaidPlanExpiryKeyPrefix)
+	expiryStore.Set(types.PrepaidPlanExpiryKey(plan.EndTime, plan.Id), []byte{})
+}
+
+func (k Keeper) removePrepaidPlan(ctx sdk.Context, plan *types.PrepaidPlan) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrepaidPlanKeyPrefix)
+	store.Delete(types.PrepaidPlanKey(plan.Id))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrepaidPlanExpiryKeyPrefix)
+	expiryStore.Delete(types.PrepaidPlanExpiryKey(plan.EndTime, plan.Id))
+}
+
+func (k Keeper) nextPrepaidPlanId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if b := store.Get(types.PrepaidPlanSequenceKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	store.Set(types.PrepaidPlanSequenceKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// CreatePrepaidPlan creates a prepaid plan which covers the bucket for the given duration, the amount is transferred
+// from the static balance of the owner to the plan address upfront.
+func (k Keeper) CreatePrepaidPlan(ctx sdk.Context, owner sdk.AccAddress, bucketId sdkmath.Uint, chargeSize uint64,
+	duration int64, amount sdkmath.Int) (*types.PrepaidPlan, error) {
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("invalid prepaid amount: %s", amount)
+	}
+	now := ctx.BlockTime().Unix()
+	_, err := k.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(owner).WithStaticBalanceChange(amount.Neg()))
+	if err != nil {
+		return nil, fmt.Errorf("pay for prepaid plan failed: %w", err)
+	}
+
+	id := k.nextPrepaidPlanId(ctx)
+	planAddr := types.PrepaidPlanAddress(id)
+	_, err = k.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(planAddr).WithStaticBalanceChange(amount))
+	if err != nil {
+		return nil, fmt.Errorf("fund prepaid plan failed: %w", err)
+	}
+
+	plan := &types.PrepaidPlan{
+		Id:          id,
+		Owner:       owner.String(),
+		PlanAddress: planAddr.String(),
+		BucketId:    bucketId,
+		ChargeSize:  chargeSize,
+		PriceTime:   now,
+		StartTime:   now,
+		EndTime:     now + duration,
+		Amount:      amount,
+	}
+	k.setPrepaidPlan(ctx, plan)
+
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventCreatePrepaidPlan{
+		Id:         plan.Id,
+		Owner:      plan.Owner,
+		BucketId:   plan.BucketId,
+		ChargeSize: plan.ChargeSize,
+		EndTime:    plan.EndTime,
+		Amount:     plan.Amount,
+	})
+	return plan, nil
+}
+
+// ClosePrepaidPlan deletes the prepaid plan and refunds the balance left to the owner.
+// The flows paid by the plan should be removed before it is closed.
+func (k Keeper) ClosePrepaidPlan(ctx sdk.Context, id uint64) error {
+	plan, found := k.GetPrepaidPlan(ctx, id)
+	if !found {
+		return types.ErrPrepaidPlanNotFound
+	}
+	planAddr := sdk.MustAccAddressFromHex(plan.PlanAddress)
+
+	refund := sdkmath.ZeroInt()
+	streamRecord, found := k.GetStreamRecord(ctx, planAddr)
+	if found && streamRecord.Status == types.STREAM_ACCOUNT_STATUS_ACTIVE {
+		if !streamRecord.NetflowRate.IsZero() {
+			return fmt.Errorf("prepaid plan %d still has flows, netflow rate: %s", plan.Id, streamRecord.NetflowRate)
+		}
+		// settle the stream record before refunding
+		if err := k.UpdateStreamRecord(ctx, streamRecord, types.NewDefaultStreamRecordChangeWithAddr(planAddr)); err != nil {
+			return err
+		}
+		k.SetStreamRecord(ctx, streamRecord)
+		if streamRecord.StaticBalance.IsPositive() {
+			refund = streamRecord.StaticBalance
+			_, err := k.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(planAddr).WithStaticBalanceChange(refund.Neg()))
+			if err != nil {
+				return fmt.Errorf("withdraw from prepaid plan failed: %w", err)
+			}
+			_, err = k.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(sdk.MustAccAddressFromHex(plan.Owner)).WithStaticBalanceChange(refund))
+			if err != nil {
+				return fmt.Errorf("refund prepaid plan failed: %w", err)
+			}
+		}
+	}
+	k.removePrepaidPlan(ctx, plan)
+
+	_ = ctx.EventManager().EmitTypedEvents(&types.EventClosePrepaidPlan{
+		Id:     plan.Id,
+		Owner:  plan.Owner,
+		Refund: refund,
+	})
+	return nil
+}
+
+// PostponePrepaidPlanExpiry moves the end time of the prepaid plan to endTime, the plan keeps paying for the bucket
+// until then.
+func (k Keeper) PostponePrepaidPlanExpiry(ctx sdk.Context, id uint64, endTime int64) {
+	plan, found := k.GetPrepaidPlan(ctx, id)
+	if !found || endTime <= plan.EndTime {
+		return
+	}
+	k.removePrepaidPlan(ctx, plan)
+	plan.EndTime = endTime
+	k.setPrepaidPlan(ctx, plan)
+}
+
+// GetExpiredPrepaidPlans returns at most limit prepaid plans which expire before the current block time
+func (k Keeper) GetExpiredPrepaidPlans(ctx sdk.Context, limit uint64) []types.PrepaidPlan {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrepaidPlanExpiryKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().Unix()+1)))
+	defer iterator.Close()
+
+	plans := make([]types.PrepaidPlan, 0)
+	for ; iterator.Valid() && uint64(len(plans)) < limit; iterator.Next() {
+		_, id := types.ParsePrepaidPlanExpiryKey(iterator.Key())
+		plan, found := k.GetPrepaidPlan(ctx, id)
+		if !found {
+			panic("should not happen")
+		}
+		plans = append(plans, *plan)
+	}
+	return plans
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+func TestPrepaidPlan(t *testing.T) {
+	keeper, ctx, deps := makePaymentKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1690000000, 0))
+	deps.AccountKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+
+	owner := sample.RandAccAddress()
+	streamRecord := types.NewStreamRecord(owner, ctx.BlockTime().Unix())
+	streamRecord.StaticBalance = sdkmath.NewInt(1000)
+	keeper.SetStreamRecord(ctx, streamRecord)
+
+	// not enough balance
+	_, err := keeper.CreatePrepaidPlan(ctx, owner, sdk.NewUint(1), 1024, 100, sdkmath.NewInt(1001))
+	require.Error(t, err)
+
+	plan, err := keeper.CreatePrepaidPlan(ctx, owner, sdk.NewUint(1), 1024, 100, sdkmath.NewInt(600))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), plan.Id)
+	require.Equal(t, types.PrepaidPlanAddress(plan.Id).String(), plan.PlanAddress)
+	require.Equal(t, ctx.BlockTime().Unix()+100, plan.EndTime)
+
+	streamRecord, _ = keeper.GetStreamRecord(ctx, owner)
+	require.Equal(t, sdkmath.NewInt(400), streamRecord.StaticBalance)
+	planRecord, _ := keeper.GetStreamRecord(ctx, types.PrepaidPlanAddress(plan.Id))
+	require.Equal(t, sdkmath.NewInt(600), planRecord.StaticBalance)
+
+	stored, found := keeper.GetPrepaidPlan(ctx, plan.Id)
+	require.True(t, found)
+	require.Equal(t, *plan, *stored)
+	require.Empty(t, keeper.GetExpiredPrepaidPlans(ctx, 10))
+
+	// the plan expires and the balance left is refunded
+	ctx = ctx.WithBlockTime(time.Unix(plan.EndTime, 0))
+	expired := keeper.GetExpiredPrepaidPlans(ctx, 10)
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, plan.Id, expired[0].Id)
+
+	// the expiry is postponed
+	keeper.PostponePrepaidPlanExpiry(ctx, plan.Id, plan.EndTime+60)
+	require.Empty(t, keeper.GetExpiredPrepaidPlans(ctx, 10))
+	ctx = ctx.WithBlockTime(time.Unix(plan.EndTime+60, 0))
+	require.Equal(t, 1, len(keeper.GetExpiredPrepaidPlans(ctx, 10)))
+
+	require.NoError(t, keeper.ClosePrepaidPlan(ctx, plan.Id))
+	streamRecord, _ = keeper.GetStreamRecord(ctx, owner)
+	require.Equal(t, sdkmath.NewInt(1000), streamRecord.StaticBalance)
+	_, found = keeper.GetPrepaidPlan(ctx, plan.Id)
+	require.False(t, found)
+	require.Empty(t, keeper.GetExpiredPrepaidPlans(ctx, 10))
+	require.ErrorIs(t, keeper.ClosePrepaidPlan(ctx, plan.Id), types.ErrPrepaidPlanNotFound)
+}
@@ -241,6 +241,7 @@ func (k Keeper) ForceSettle(ctx sdk.Context, streamRecord *types.StreamRecord) e
 	streamRecord.StaticBalance = sdkmath.ZeroInt()
 	streamRecord.BufferBalance = sdkmath.ZeroInt()
 	streamRecord.Status = types.STREAM_ACCOUNT_STATUS_FROZEN
+	k.checkpointBucketFlows(ctx, sdk.MustAccAddressFromHex(streamRecord.Account), true)
 	// emit event
 	_ = ctx.EventManager().EmitTypedEvents(&types.EventForceSettle{
 		Addr:           streamRecord.Account,
@@ -327,6 +328,7 @@ func (k Keeper) AutoSettle(ctx sdk.Context) {
 				panic("should not happen")
 			}
 
+			k.CloseOutFlowStatement(ctx, addr, toAddr, outFlow.Rate)
 			flowStore.Delete(flowIterator.Key())
 
 			outFlow.Status = types.OUT_FLOW_STATUS_FROZEN
@@ -392,6 +394,7 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 		streamRecord.FrozenNetflowRate = sdkmath.ZeroInt()
 
 		addr := sdk.MustAccAddressFromHex(streamRecord.Account)
+		k.checkpointBucketFlows(ctx, addr, false)
 		frozenFlowKey := types.OutFlowKey(addr, types.OUT_FLOW_STATUS_FROZEN, nil)
 		flowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutFlowKeyPrefix)
 		flowIterator := flowStore.Iterator(frozenFlowKey, nil)
@@ -413,6 +416,7 @@ func (k Keeper) TryResumeStreamRecord(ctx sdk.Context, streamRecord *types.Strea
 				return fmt.Errorf("try resume, update receiver stream record failed: %w", err)
 			}
 
+			k.RecordOutFlowStatement(ctx, addr, toAddr, sdkmath.ZeroInt())
 			flowStore.Delete(flowIterator.Key())
 
 			outFlow.Status = types.OUT_FLOW_STATUS_ACTIVE
@@ -484,6 +488,7 @@ func (k Keeper) AutoResume(ctx sdk.Context) {
 				panic("should not happen")
 			}
 
+			k.RecordOutFlowStatement(ctx, addr, toAddr, sdkmath.ZeroInt())
 			flowStore.Delete(flowIterator.Key())
 
 			outFlow.Status = types.OUT_FLOW_STATUS_ACTIVE
@@ -505,6 +510,7 @@ func (k Keeper) AutoResume(ctx sdk.Context) {
 				panic("should not happen")
 			}
 			streamRecord.Status = types.STREAM_ACCOUNT_STATUS_ACTIVE
+			k.checkpointBucketFlows(ctx, addr, false)
 			change := types.NewDefaultStreamRecordChangeWithAddr(addr)
 			err := k.UpdateStreamRecord(ctx, streamRecord, change)
 			if err != nil {
@@ -25,7 +25,9 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 		oldParams.MaxAutoResumeFlowCount,
 		oldParams.FeeDenom,
 		types.DefaultWithdrawTimeLockThreshold,
-		types.DefaultWithdrawTimeLockDuration)
+		types.DefaultWithdrawTimeLockDuration,
+		0,
+		0)
 
 	store.Set(types.ParamsKey, cdc.MustMarshal(&newParams))
 
@@ -158,5 +158,6 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	ctx = ctx.WithValue(types.ForceUpdateStreamRecordKey, true)
 	am.keeper.AutoResume(ctx)
 	am.keeper.AutoSettle(ctx)
+	am.keeper.PruneBillingStatements(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,1330 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/billing_statement.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BillingStatementLine defines the amount streamed from a payer to one destination
+// (e.g. the virtual payment address of a SP or the validator tax pool)
+type BillingStatementLine struct {
+	// the address which receives the flow
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the amount paid to the destination
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *BillingStatementLine) Reset()         { *m = BillingStatementLine{} }
+func (m *BillingStatementLine) String() string { return proto.CompactTextString(m) }
+func (*BillingStatementLine) ProtoMessage()    {}
+func (*BillingStatementLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3f734098a0b83f, []int{0}
+}
+func (m *BillingStatementLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingStatementLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingStatementLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BillingStatementLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingStatementLine.Merge(m, src)
+}
+func (m *BillingStatementLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingStatementLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingStatementLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingStatementLine proto.InternalMessageInfo
+
+func (m *BillingStatementLine) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// BillingStatementBucketLine defines the amount streamed from a payer to one destination for one storage bucket
+type BillingStatementBucketLine struct {
+	// the id of the storage bucket
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// the address which receives the flow
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the amount paid to the destination for the bucket
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *BillingStatementBucketLine) Reset()         { *m = BillingStatementBucketLine{} }
+func (m *BillingStatementBucketLine) String() string { return proto.CompactTextString(m) }
+func (*BillingStatementBucketLine) ProtoMessage()    {}
+func (*BillingStatementBucketLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3f734098a0b83f, []int{1}
+}
+func (m *BillingStatementBucketLine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingStatementBucketLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingStatementBucketLine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BillingStatementBucketLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingStatementBucketLine.Merge(m, src)
+}
+func (m *BillingStatementBucketLine) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingStatementBucketLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingStatementBucketLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingStatementBucketLine proto.InternalMessageInfo
+
+func (m *BillingStatementBucketLine) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// BillingStatementPeriod defines the aggregated outflows of a payer within one statement period
+type BillingStatementPeriod struct {
+	// the unix timestamp of the start of the period, inclusive
+	PeriodStart int64 `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// the unix timestamp of the end of the period, exclusive
+	PeriodEnd int64 `protobuf:"varint,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// the amounts paid to each destination within the period
+	Lines []BillingStatementLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines"`
+	// the total amount paid within the period
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	// the amounts paid to each destination for each storage bucket within the period
+	BucketLines []BillingStatementBucketLine `protobuf:"bytes,5,rep,name=bucket_lines,json=bucketLines,proto3" json:"bucket_lines"`
+}
+
+func (m *BillingStatementPeriod) Reset()         { *m = BillingStatementPeriod{} }
+func (m *BillingStatementPeriod) String() string { return proto.CompactTextString(m) }
+func (*BillingStatementPeriod) ProtoMessage()    {}
+func (*BillingStatementPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3f734098a0b83f, []int{2}
+}
+func (m *BillingStatementPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BillingStatementPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BillingStatementPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BillingStatementPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingStatementPeriod.Merge(m, src)
+}
+func (m *BillingStatementPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *BillingStatementPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingStatementPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingStatementPeriod proto.InternalMessageInfo
+
+func (m *BillingStatementPeriod) GetPeriodStart() int64 {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return 0
+}
+
+func (m *BillingStatementPeriod) GetPeriodEnd() int64 {
+	if m != nil {
+		return m.PeriodEnd
+	}
+	return 0
+}
+
+func (m *BillingStatementPeriod) GetLines() []BillingStatementLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *BillingStatementPeriod) GetBucketLines() []BillingStatementBucketLine {
+	if m != nil {
+		return m.BucketLines
+	}
+	return nil
+}
+
+// BucketFlow defines the rate streamed from a payer to one destination for a storage bucket, and the timestamp until
+// which it has been recorded in the billing statement
+type BucketFlow struct {
+	// the id of the storage bucket
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// the address which receives the flow
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// the rate of the flow
+	Rate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rate"`
+	// the unix timestamp until which the flow has been recorded in the billing statement
+	Checkpoint int64 `protobuf:"varint,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *BucketFlow) Reset()         { *m = BucketFlow{} }
+func (m *BucketFlow) String() string { return proto.CompactTextString(m) }
+func (*BucketFlow) ProtoMessage()    {}
+func (*BucketFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e3f734098a0b83f, []int{3}
+}
+func (m *BucketFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BucketFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketFlow.Merge(m, src)
+}
+func (m *BucketFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketFlow proto.InternalMessageInfo
+
+func (m *BucketFlow) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *BucketFlow) GetCheckpoint() int64 {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BillingStatementLine)(nil), "greenfield.payment.BillingStatementLine")
+	proto.RegisterType((*BillingStatementBucketLine)(nil), "greenfield.payment.BillingStatementBucketLine")
+	proto.RegisterType((*BillingStatementPeriod)(nil), "greenfield.payment.BillingStatementPeriod")
+	proto.RegisterType((*BucketFlow)(nil), "greenfield.payment.BucketFlow")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/billing_statement.proto", fileDescriptor_9e3f734098a0b83f)
+}
+
+var fileDescriptor_9e3f734098a0b83f = []byte{
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0x6e, 0x92, 0xee, 0x62, 0xff, 0xf4, 0x34, 0x14, 0xa9, 0x05, 0xb3, 0x6b, 0x0f, 0x5a, 0x84,
+	0x26, 0xa0, 0x07, 0x2f, 0x22, 0x6c, 0x50, 0xa1, 0xe0, 0x61, 0x49, 0x15, 0x41, 0x0f, 0x21, 0xc9,
+	0x8c, 0xe9, 0xd0, 0x64, 0x26, 0x64, 0xa6, 0xe8, 0xbe, 0x81, 0x47, 0x0f, 0xbe, 0x82, 0x6f, 0xb0,
+	0x0f, 0xb1, 0xc7, 0x65, 0x4f, 0xb2, 0x87, 0x45, 0xda, 0x17, 0x91, 0xcc, 0x8c, 0xbb, 0xa5, 0x7a,
+	0x28, 0xd2, 0xc3, 0x9e, 0x32, 0xf3, 0xe5, 0x9f, 0xef, 0xfb, 0xfe, 0x7f, 0x3e, 0x06, 0x1e, 0xe7,
+	0x35, 0x21, 0xec, 0x13, 0x25, 0x05, 0x0e, 0xaa, 0xe4, 0xa4, 0x24, 0x4c, 0x06, 0x29, 0x2d, 0x0a,
+	0xca, 0xf2, 0x58, 0xc8, 0x44, 0x92, 0x06, 0xf1, 0xab, 0x9a, 0x4b, 0x8e, 0xd0, 0x4d, 0xad, 0x6f,
+	0x6a, 0x07, 0xf7, 0x32, 0x2e, 0x4a, 0x2e, 0x62, 0x55, 0x11, 0xe8, 0x8d, 0x2e, 0x1f, 0xf4, 0x72,
+	0x9e, 0x73, 0x8d, 0x37, 0x2b, 0x8d, 0x0e, 0x7f, 0x58, 0xd0, 0x0b, 0xb5, 0xc0, 0xf4, 0x0f, 0xff,
+	0x1b, 0xca, 0x08, 0x7a, 0x06, 0x20, 0x79, 0x9c, 0x60, 0x5c, 0x13, 0x21, 0xfa, 0xd6, 0xa1, 0x35,
+	0xea, 0x84, 0xfd, 0x8b, 0xd3, 0x71, 0xcf, 0x90, 0x1e, 0xe9, 0x3f, 0x53, 0x59, 0x53, 0x96, 0x47,
+	0x1d, 0xc9, 0x0d, 0x80, 0xde, 0xc2, 0x7e, 0x52, 0xf2, 0x05, 0x93, 0x7d, 0x5b, 0x1d, 0x7a, 0x7e,
+	0x76, 0x75, 0xd0, 0xba, 0xbc, 0x3a, 0x78, 0x98, 0x53, 0x39, 0x5b, 0xa4, 0x7e, 0xc6, 0x4b, 0x63,
+	0xcc, 0x7c, 0xc6, 0x02, 0xcf, 0x03, 0x79, 0x52, 0x11, 0xe1, 0x4f, 0x98, 0xbc, 0x38, 0x1d, 0x83,
+	0x91, 0x98, 0x30, 0x19, 0x19, 0xae, 0xe1, 0x57, 0x1b, 0x06, 0x9b, 0x3e, 0xc3, 0x45, 0x36, 0x27,
+	0xda, 0xed, 0x47, 0xe8, 0xa4, 0x6a, 0x17, 0x53, 0x6c, 0xcc, 0xbe, 0x30, 0xba, 0x8f, 0xb6, 0xd0,
+	0x7d, 0x47, 0x95, 0xb0, 0x6b, 0x84, 0x9b, 0x6d, 0x74, 0x47, 0x13, 0x4e, 0xf0, 0xc6, 0x28, 0xec,
+	0xff, 0x19, 0x85, 0xb3, 0xc3, 0x51, 0x5c, 0xda, 0x70, 0x77, 0x73, 0x14, 0xc7, 0xa4, 0xa6, 0x1c,
+	0xa3, 0x07, 0xd0, 0xad, 0xd4, 0xaa, 0x09, 0x4b, 0x2d, 0xd5, 0x24, 0x9c, 0xc8, 0xd5, 0xd8, 0xb4,
+	0x81, 0xd0, 0x7d, 0x00, 0x53, 0x42, 0x18, 0x56, 0xcd, 0x38, 0x51, 0x47, 0x23, 0xaf, 0x18, 0x46,
+	0x2f, 0x61, 0xaf, 0xa0, 0x8c, 0x88, 0xbe, 0x73, 0xe8, 0x8c, 0xdc, 0x27, 0x23, 0xff, 0xef, 0x90,
+	0xf9, 0xff, 0xca, 0x4b, 0xd8, 0x6e, 0x7a, 0x8b, 0xf4, 0x61, 0x14, 0x43, 0x57, 0x72, 0x99, 0x14,
+	0xb1, 0x69, 0xbf, 0xbd, 0x83, 0xf6, 0x5d, 0xc5, 0x78, 0xa4, 0x08, 0xd1, 0x7b, 0xe8, 0x9a, 0xfb,
+	0xd6, 0x6e, 0xf7, 0x94, 0x5b, 0x7f, 0x1b, 0xb7, 0x37, 0xa9, 0x31, 0x9e, 0xdd, 0xf4, 0x1a, 0x11,
+	0xc3, 0xef, 0x36, 0x80, 0xae, 0x78, 0x5d, 0xf0, 0xcf, 0xb7, 0x34, 0x57, 0xc7, 0xd0, 0xae, 0x13,
+	0x49, 0x76, 0x92, 0x2a, 0xc5, 0x84, 0x3c, 0x80, 0x6c, 0x46, 0xb2, 0x79, 0xc5, 0xa9, 0xb9, 0x2e,
+	0x27, 0x5a, 0x43, 0xc2, 0xc9, 0xd9, 0xd2, 0xb3, 0xce, 0x97, 0x9e, 0xf5, 0x6b, 0xe9, 0x59, 0xdf,
+	0x56, 0x5e, 0xeb, 0x7c, 0xe5, 0xb5, 0x7e, 0xae, 0xbc, 0xd6, 0x87, 0x60, 0x4d, 0x35, 0x65, 0xe9,
+	0x38, 0x9b, 0x25, 0x94, 0x05, 0x6b, 0xcf, 0xd8, 0x97, 0xeb, 0x87, 0x4c, 0x59, 0x48, 0xf7, 0xd5,
+	0xc3, 0xf3, 0xf4, 0xf7, 0x00, 0x83, 0xcd, 0x38, 0x97, 0xeb, 0x04, 0x00, 0x00,
+}
+
+func (m *BillingStatementLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingStatementLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingStatementLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBillingStatement(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BillingStatementBucketLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingStatementBucketLine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingStatementBucketLine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBillingStatement(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BillingStatementPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BillingStatementPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BillingStatementPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketLines) > 0 {
+		for iNdEx := len(m.BucketLines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BucketLines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PeriodEnd != 0 {
+		i = encodeVarintBillingStatement(dAtA, i, uint64(m.PeriodEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PeriodStart != 0 {
+		i = encodeVarintBillingStatement(dAtA, i, uint64(m.PeriodStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BucketFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checkpoint != 0 {
+		i = encodeVarintBillingStatement(dAtA, i, uint64(m.Checkpoint))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBillingStatement(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBillingStatement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBillingStatement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBillingStatement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BillingStatementLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBillingStatement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBillingStatement(uint64(l))
+	return n
+}
+
+func (m *BillingStatementBucketLine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovBillingStatement(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBillingStatement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBillingStatement(uint64(l))
+	return n
+}
+
+func (m *BillingStatementPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodStart != 0 {
+		n += 1 + sovBillingStatement(uint64(m.PeriodStart))
+	}
+	if m.PeriodEnd != 0 {
+		n += 1 + sovBillingStatement(uint64(m.PeriodEnd))
+	}
+	if len(m.Lines) > 0 {
+		for _, e := range m.Lines {
+			l = e.Size()
+			n += 1 + l + sovBillingStatement(uint64(l))
+		}
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovBillingStatement(uint64(l))
+	if len(m.BucketLines) > 0 {
+		for _, e := range m.BucketLines {
+			l = e.Size()
+			n += 1 + l + sovBillingStatement(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BucketFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovBillingStatement(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBillingStatement(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovBillingStatement(uint64(l))
+	if m.Checkpoint != 0 {
+		n += 1 + sovBillingStatement(uint64(m.Checkpoint))
+	}
+	return n
+}
+
+func sovBillingStatement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBillingStatement(x uint64) (n int) {
+	return sovBillingStatement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BillingStatementLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBillingStatement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BillingStatementLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BillingStatementLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBillingStatement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BillingStatementBucketLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBillingStatement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BillingStatementBucketLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BillingStatementBucketLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBillingStatement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BillingStatementPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBillingStatement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BillingStatementPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BillingStatementPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			m.PeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEnd", wireType)
+			}
+			m.PeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, BillingStatementLine{})
+			if err := m.Lines[len(m.Lines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketLines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketLines = append(m.BucketLines, BillingStatementBucketLine{})
+			if err := m.BucketLines[len(m.BucketLines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBillingStatement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBillingStatement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			m.Checkpoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checkpoint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBillingStatement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBillingStatement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBillingStatement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBillingStatement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBillingStatement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBillingStatement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBillingStatement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBillingStatement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBillingStatement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBillingStatement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBillingStatement = fmt.Errorf("proto: unexpected end of group")
+)
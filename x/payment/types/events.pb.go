@@ -416,6 +416,140 @@ func (m *EventFeePreview) GetFeePreviewType() FeePreviewType {
 	return FEE_PREVIEW_TYPE_PRELOCKED_FEE
 }
 
+// EventCreatePrepaidPlan is emitted when a prepaid plan is purchased
+type EventCreatePrepaidPlan struct {
+	// the id of the plan
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the payment account which pays for the plan
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the id of the bucket covered by the plan
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// the max charge size covered by the plan
+	ChargeSize uint64 `protobuf:"varint,4,opt,name=charge_size,json=chargeSize,proto3" json:"charge_size,omitempty"`
+	// the unix timestamp when the plan expires
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// the amount paid upfront
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventCreatePrepaidPlan) Reset()         { *m = EventCreatePrepaidPlan{} }
+func (m *EventCreatePrepaidPlan) String() string { return proto.CompactTextString(m) }
+func (*EventCreatePrepaidPlan) ProtoMessage()    {}
+func (*EventCreatePrepaidPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{6}
+}
+func (m *EventCreatePrepaidPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatePrepaidPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatePrepaidPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreatePrepaidPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatePrepaidPlan.Merge(m, src)
+}
+func (m *EventCreatePrepaidPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatePrepaidPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatePrepaidPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatePrepaidPlan proto.InternalMessageInfo
+
+func (m *EventCreatePrepaidPlan) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventCreatePrepaidPlan) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventCreatePrepaidPlan) GetChargeSize() uint64 {
+	if m != nil {
+		return m.ChargeSize
+	}
+	return 0
+}
+
+func (m *EventCreatePrepaidPlan) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// EventClosePrepaidPlan is emitted when a prepaid plan expires or its bucket is deleted
+type EventClosePrepaidPlan struct {
+	// the id of the plan
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the payment account which receives the refund
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the balance left in the plan which is refunded to the owner
+	Refund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=refund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refund"`
+}
+
+func (m *EventClosePrepaidPlan) Reset()         { *m = EventClosePrepaidPlan{} }
+func (m *EventClosePrepaidPlan) String() string { return proto.CompactTextString(m) }
+func (*EventClosePrepaidPlan) ProtoMessage()    {}
+func (*EventClosePrepaidPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_befcc80e27bc8df9, []int{7}
+}
+func (m *EventClosePrepaidPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClosePrepaidPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClosePrepaidPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClosePrepaidPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClosePrepaidPlan.Merge(m, src)
+}
+func (m *EventClosePrepaidPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClosePrepaidPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClosePrepaidPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClosePrepaidPlan proto.InternalMessageInfo
+
+func (m *EventClosePrepaidPlan) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventClosePrepaidPlan) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("greenfield.payment.FeePreviewType", FeePreviewType_name, FeePreviewType_value)
 	proto.RegisterType((*EventPaymentAccountUpdate)(nil), "greenfield.payment.EventPaymentAccountUpdate")
@@ -424,58 +558,68 @@ func init() {
 	proto.RegisterType((*EventDeposit)(nil), "greenfield.payment.EventDeposit")
 	proto.RegisterType((*EventWithdraw)(nil), "greenfield.payment.EventWithdraw")
 	proto.RegisterType((*EventFeePreview)(nil), "greenfield.payment.EventFeePreview")
+	proto.RegisterType((*EventCreatePrepaidPlan)(nil), "greenfield.payment.EventCreatePrepaidPlan")
+	proto.RegisterType((*EventClosePrepaidPlan)(nil), "greenfield.payment.EventClosePrepaidPlan")
 }
 
 func init() { proto.RegisterFile("greenfield/payment/events.proto", fileDescriptor_befcc80e27bc8df9) }
 
 var fileDescriptor_befcc80e27bc8df9 = []byte{
-	// 724 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x00, 0x01, 0xe6, 0x42, 0xc8, 0xf5, 0x45, 0xba, 0x01, 0xe9, 0x1a, 0x88, 0x74,
-	0x69, 0x5a, 0x35, 0x89, 0x44, 0xb7, 0x95, 0x2a, 0x52, 0x8c, 0x14, 0x15, 0xd1, 0xc8, 0x09, 0x45,
-	0xad, 0x54, 0x59, 0x13, 0xfb, 0x38, 0x58, 0xc4, 0x33, 0xd6, 0x78, 0x4c, 0x4a, 0x9f, 0xa0, 0xcb,
-	0x6e, 0xbb, 0xee, 0xa2, 0x2f, 0xc0, 0xb2, 0xdd, 0xb3, 0x44, 0xac, 0x2a, 0x16, 0xa8, 0x82, 0x55,
-	0xdf, 0xa2, 0xf2, 0xcc, 0x24, 0x04, 0x81, 0x14, 0x5a, 0x85, 0x55, 0x32, 0x27, 0x7f, 0xfe, 0xff,
-	0x3b, 0xe3, 0x33, 0x1e, 0xb4, 0xd4, 0x66, 0x00, 0xc4, 0xf3, 0xa1, 0xe3, 0x56, 0x42, 0x7c, 0x18,
-	0x00, 0xe1, 0x15, 0x38, 0x00, 0xc2, 0xa3, 0x72, 0xc8, 0x28, 0xa7, 0xba, 0x7e, 0x25, 0x28, 0x2b,
-	0xc1, 0xe2, 0x82, 0x43, 0xa3, 0x80, 0x46, 0xb6, 0x50, 0x54, 0xe4, 0x42, 0xca, 0x17, 0xe7, 0xdb,
-	0xb4, 0x4d, 0x65, 0x3d, 0xf9, 0xa6, 0xaa, 0x2b, 0xb7, 0xa4, 0xd0, 0x98, 0xdb, 0x5e, 0x87, 0x76,
-	0x95, 0x64, 0xf5, 0x16, 0x49, 0xc4, 0x19, 0xe0, 0xc0, 0x66, 0xe0, 0x50, 0xe6, 0x4a, 0x5d, 0xe1,
-	0x93, 0x86, 0x16, 0xcc, 0x04, 0xb0, 0x2e, 0x45, 0xeb, 0x8e, 0x43, 0x63, 0xc2, 0x77, 0x42, 0x17,
-	0x73, 0xd0, 0x1f, 0xa3, 0x71, 0xec, 0xba, 0x2c, 0xaf, 0x2d, 0x6b, 0xc5, 0xe9, 0x6a, 0xfe, 0xf4,
-	0xa8, 0x34, 0xaf, 0xf0, 0xd6, 0x5d, 0x97, 0x41, 0x14, 0x35, 0x38, 0xf3, 0x49, 0xdb, 0x12, 0x2a,
-	0xbd, 0x8c, 0x26, 0x68, 0x97, 0x00, 0xcb, 0xa7, 0x87, 0xc8, 0xa5, 0x4c, 0x37, 0x10, 0x62, 0xe0,
-	0xc5, 0xc4, 0xc5, 0xad, 0x0e, 0xe4, 0xc7, 0x96, 0xb5, 0xe2, 0x94, 0x35, 0x50, 0x29, 0x9c, 0x4d,
-	0xa0, 0x7f, 0x05, 0x5b, 0x43, 0x80, 0x5b, 0x82, 0x5b, 0x91, 0xad, 0xa1, 0x49, 0x2c, 0x51, 0x87,
-	0xc2, 0xf5, 0x84, 0xfa, 0xff, 0x28, 0xeb, 0xb0, 0xd8, 0xb5, 0xb9, 0x1f, 0x40, 0xc4, 0x71, 0x10,
-	0x0a, 0xd0, 0x31, 0x6b, 0x36, 0xa9, 0x36, 0x7b, 0x45, 0xdd, 0x46, 0x33, 0x04, 0x78, 0xb2, 0x97,
-	0x36, 0xc3, 0x5c, 0x82, 0x4d, 0x57, 0x9f, 0x1e, 0x9f, 0x2f, 0xa5, 0xce, 0xce, 0x97, 0x56, 0xdb,
-	0x3e, 0xdf, 0x8b, 0x5b, 0x65, 0x87, 0x06, 0xea, 0x51, 0xa9, 0x8f, 0x52, 0xe4, 0xee, 0x57, 0xf8,
-	0x61, 0x08, 0x51, 0xb9, 0x46, 0xf8, 0xe9, 0x51, 0x09, 0x29, 0x9a, 0x1a, 0xe1, 0xd6, 0x5f, 0xca,
-	0xd1, 0x4a, 0xd8, 0x3b, 0xe8, 0x1f, 0x8f, 0xd1, 0xf7, 0x40, 0xec, 0x6b, 0x39, 0xe3, 0x23, 0xc8,
-	0xf9, 0x5b, 0x1a, 0x6f, 0x0f, 0xa4, 0x39, 0x28, 0x1b, 0x71, 0xcc, 0x7d, 0xc7, 0x6e, 0xe1, 0x0e,
-	0x26, 0x0e, 0xe4, 0x27, 0x46, 0x10, 0x34, 0x2b, 0x3d, 0xab, 0xd2, 0x32, 0x09, 0x69, 0xc5, 0x9e,
-	0x07, 0xac, 0x1f, 0x92, 0x19, 0x45, 0x88, 0xf4, 0xec, 0x85, 0xd8, 0x68, 0xa6, 0x43, 0x9d, 0xfd,
-	0x7e, 0xc4, 0xe4, 0x28, 0x1e, 0x4c, 0xe2, 0xd8, 0x0b, 0x78, 0x86, 0x32, 0x49, 0x5b, 0x71, 0x94,
-	0x9f, 0x5a, 0xd6, 0x8a, 0xd9, 0xb5, 0x07, 0xe5, 0x9b, 0xa7, 0xb5, 0x2c, 0x87, 0x51, 0x9d, 0x93,
-	0x86, 0x90, 0x5b, 0xea, 0x6f, 0xfa, 0x43, 0x94, 0x8b, 0x80, 0xf3, 0x0e, 0x0c, 0xcc, 0xd8, 0xb4,
-	0x98, 0xb1, 0x39, 0x59, 0xef, 0x4f, 0x59, 0xe1, 0x8b, 0x86, 0x72, 0x62, 0xb8, 0x37, 0x29, 0x73,
-	0xa0, 0x21, 0x7e, 0xfd, 0xcd, 0xf3, 0x06, 0x48, 0xb9, 0xba, 0xfd, 0x2d, 0x49, 0x8f, 0x60, 0x4b,
-	0xb2, 0xca, 0x54, 0xed, 0x4a, 0xe1, 0xab, 0x86, 0x66, 0x04, 0xe9, 0x06, 0x84, 0x34, 0xf2, 0x79,
-	0x42, 0xe9, 0x31, 0x1a, 0x0c, 0xa7, 0x4c, 0x54, 0x7a, 0x11, 0xa5, 0x39, 0x1d, 0xfa, 0x4a, 0x48,
-	0x73, 0xaa, 0x37, 0x51, 0x06, 0x07, 0xe2, 0x48, 0x8f, 0xe2, 0xc8, 0x29, 0xaf, 0xc2, 0x37, 0x0d,
-	0xcd, 0x0a, 0xfc, 0x5d, 0x9f, 0xef, 0xb9, 0x0c, 0x77, 0x15, 0x91, 0x76, 0x07, 0xa2, 0x5e, 0xa7,
-	0xe9, 0x3b, 0x75, 0x7a, 0x3f, 0xfc, 0x3f, 0x35, 0x34, 0x27, 0x07, 0x05, 0xa0, 0xce, 0xe0, 0xc0,
-	0x87, 0xee, 0x1f, 0xbd, 0xfd, 0xb6, 0x50, 0xce, 0x03, 0xb0, 0x43, 0x69, 0x61, 0x27, 0xb1, 0xa2,
-	0xaf, 0xec, 0x5a, 0xe1, 0xb6, 0x31, 0xbf, 0x4a, 0x6b, 0x1e, 0x86, 0x60, 0x65, 0xbd, 0x6b, 0xeb,
-	0xfb, 0xe9, 0xf5, 0xd1, 0x5b, 0x94, 0xbd, 0x9e, 0xab, 0x17, 0x90, 0xb1, 0x69, 0x9a, 0x76, 0xdd,
-	0x32, 0x5f, 0xd5, 0xcc, 0x5d, 0xbb, 0xf9, 0xba, 0x2e, 0x16, 0x5b, 0x2f, 0x9f, 0xbf, 0x30, 0x37,
-	0xec, 0x4d, 0xd3, 0xcc, 0xa5, 0xf4, 0x15, 0xf4, 0xdf, 0x0d, 0xcd, 0xce, 0xf6, 0x80, 0x44, 0x5b,
-	0x1c, 0xff, 0xf0, 0xd9, 0x48, 0x55, 0x6b, 0xc7, 0x17, 0x86, 0x76, 0x72, 0x61, 0x68, 0x3f, 0x2e,
-	0x0c, 0xed, 0xe3, 0xa5, 0x91, 0x3a, 0xb9, 0x34, 0x52, 0xdf, 0x2f, 0x8d, 0xd4, 0x9b, 0xca, 0x00,
-	0x76, 0x8b, 0xb4, 0x4a, 0xce, 0x1e, 0xf6, 0x49, 0x65, 0xe0, 0x0e, 0x7d, 0xd7, 0xbf, 0x45, 0x45,
-	0x0f, 0xad, 0x8c, 0xb8, 0x3e, 0x9f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x68, 0xb8, 0x45, 0x52,
-	0xf1, 0x07, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x8e, 0xe3, 0x24, 0x2f, 0x89, 0x1b, 0x96, 0x02, 0x4e, 0x24, 0x36, 0xa9, 0x25,
+	0xda, 0x80, 0x88, 0x2d, 0x85, 0x2b, 0x02, 0x35, 0xad, 0x23, 0x59, 0x54, 0xc5, 0x5a, 0x3b, 0x54,
+	0x80, 0xd0, 0x68, 0xbc, 0xf3, 0xd6, 0x19, 0x65, 0x3d, 0xb3, 0x9a, 0x1d, 0x37, 0x24, 0x9f, 0x80,
+	0x23, 0x57, 0xce, 0x1c, 0x38, 0x71, 0xeb, 0x11, 0xce, 0xf4, 0x58, 0xf5, 0x84, 0x7a, 0xa8, 0x50,
+	0x72, 0xe2, 0x5b, 0xa0, 0x9d, 0x19, 0xbb, 0x8e, 0x1a, 0x29, 0x29, 0xda, 0x9e, 0xec, 0x79, 0xfe,
+	0xfb, 0xfd, 0x7f, 0xef, 0xed, 0x9b, 0x99, 0x85, 0xcd, 0xa1, 0x42, 0x14, 0x31, 0xc7, 0x84, 0xb5,
+	0x52, 0x7a, 0x32, 0x42, 0xa1, 0x5b, 0xf8, 0x18, 0x85, 0xce, 0x9a, 0xa9, 0x92, 0x5a, 0xfa, 0xfe,
+	0x2b, 0x41, 0xd3, 0x09, 0x36, 0xd6, 0x23, 0x99, 0x8d, 0x64, 0x46, 0x8c, 0xa2, 0x65, 0x17, 0x56,
+	0xbe, 0x71, 0x73, 0x28, 0x87, 0xd2, 0xc6, 0xf3, 0x6f, 0x2e, 0x7a, 0xeb, 0x12, 0x17, 0x39, 0xd6,
+	0x24, 0x4e, 0xe4, 0xb1, 0x93, 0xdc, 0xbe, 0x44, 0x92, 0x69, 0x85, 0x74, 0x44, 0x14, 0x46, 0x52,
+	0x31, 0xab, 0x6b, 0xfc, 0xe2, 0xc1, 0x7a, 0x3b, 0x07, 0xec, 0x5a, 0xd1, 0xdd, 0x28, 0x92, 0x63,
+	0xa1, 0x0f, 0x52, 0x46, 0x35, 0xfa, 0x9f, 0x42, 0x85, 0x32, 0xa6, 0xea, 0xde, 0x96, 0xb7, 0xbd,
+	0xb4, 0x57, 0x7f, 0xfe, 0x64, 0xe7, 0xa6, 0xc3, 0xbb, 0xcb, 0x98, 0xc2, 0x2c, 0xeb, 0x69, 0xc5,
+	0xc5, 0x30, 0x34, 0x2a, 0xbf, 0x09, 0xf3, 0xf2, 0x58, 0xa0, 0xaa, 0x97, 0xaf, 0x90, 0x5b, 0x99,
+	0x1f, 0x00, 0x28, 0x8c, 0xc7, 0x82, 0xd1, 0x41, 0x82, 0xf5, 0xb9, 0x2d, 0x6f, 0x7b, 0x31, 0x9c,
+	0x89, 0x34, 0x5e, 0xcc, 0xc3, 0x07, 0x86, 0xad, 0x67, 0xc0, 0x43, 0xc3, 0xed, 0xc8, 0x76, 0x61,
+	0x81, 0x5a, 0xd4, 0x2b, 0xe1, 0x26, 0x42, 0xff, 0x23, 0xa8, 0x45, 0x6a, 0xcc, 0x88, 0xe6, 0x23,
+	0xcc, 0x34, 0x1d, 0xa5, 0x06, 0x74, 0x2e, 0x5c, 0xcd, 0xa3, 0xfd, 0x49, 0xd0, 0x27, 0xb0, 0x22,
+	0x50, 0xe7, 0xbd, 0x24, 0x8a, 0x6a, 0x0b, 0xb6, 0xb4, 0xf7, 0xf9, 0xd3, 0x97, 0x9b, 0xa5, 0x17,
+	0x2f, 0x37, 0x6f, 0x0f, 0xb9, 0x3e, 0x1c, 0x0f, 0x9a, 0x91, 0x1c, 0xb9, 0x47, 0xe5, 0x3e, 0x76,
+	0x32, 0x76, 0xd4, 0xd2, 0x27, 0x29, 0x66, 0xcd, 0x8e, 0xd0, 0xcf, 0x9f, 0xec, 0x80, 0xa3, 0xe9,
+	0x08, 0x1d, 0x2e, 0xbb, 0x8c, 0x61, 0xce, 0x9e, 0xc0, 0xbb, 0xb1, 0x92, 0xa7, 0x28, 0xc8, 0x05,
+	0x9f, 0x4a, 0x01, 0x3e, 0xef, 0xd8, 0xc4, 0x0f, 0x67, 0xdc, 0x22, 0xa8, 0x65, 0x9a, 0x6a, 0x1e,
+	0x91, 0x01, 0x4d, 0xa8, 0x88, 0xb0, 0x3e, 0x5f, 0x80, 0xd1, 0xaa, 0xcd, 0xb9, 0x67, 0x53, 0xe6,
+	0x26, 0x83, 0x71, 0x1c, 0xa3, 0x9a, 0x9a, 0x54, 0x8b, 0x30, 0xb1, 0x39, 0x27, 0x26, 0x04, 0x56,
+	0x12, 0x19, 0x1d, 0x4d, 0x2d, 0x16, 0x8a, 0x78, 0x30, 0x79, 0xc6, 0x89, 0xc1, 0x97, 0x50, 0xcd,
+	0xcb, 0x1a, 0x67, 0xf5, 0xc5, 0x2d, 0x6f, 0xbb, 0xb6, 0x7b, 0xa7, 0xf9, 0xfa, 0x6e, 0x6d, 0xda,
+	0x61, 0x74, 0xfb, 0xa4, 0x67, 0xe4, 0xa1, 0xfb, 0x9b, 0xff, 0x31, 0xac, 0x65, 0xa8, 0x75, 0x82,
+	0x33, 0x33, 0xb6, 0x64, 0x66, 0xec, 0x86, 0x8d, 0x4f, 0xa7, 0xac, 0xf1, 0x9b, 0x07, 0x6b, 0x66,
+	0xb8, 0xf7, 0xa5, 0x8a, 0xb0, 0x67, 0x7e, 0x7d, 0xc3, 0xfd, 0x86, 0xe0, 0xb2, 0xb2, 0x69, 0x4b,
+	0xca, 0x05, 0xb4, 0xa4, 0xe6, 0x92, 0xba, 0xae, 0x34, 0xfe, 0xf0, 0x60, 0xc5, 0x90, 0xde, 0xc7,
+	0x54, 0x66, 0x5c, 0xe7, 0x94, 0xb1, 0x92, 0xa3, 0xab, 0x29, 0x73, 0x95, 0xbf, 0x0d, 0x65, 0x2d,
+	0xaf, 0x3c, 0x12, 0xca, 0x5a, 0xfa, 0x7d, 0xa8, 0xd2, 0x91, 0xd9, 0xd2, 0x45, 0x6c, 0x39, 0x97,
+	0xab, 0xf1, 0xa7, 0x07, 0xab, 0x06, 0xff, 0x11, 0xd7, 0x87, 0x4c, 0xd1, 0x63, 0x47, 0xe4, 0x5d,
+	0x83, 0x68, 0x52, 0x69, 0xf9, 0x5a, 0x95, 0xbe, 0x1d, 0xfe, 0x7f, 0x3d, 0xb8, 0x61, 0x07, 0x05,
+	0xb1, 0xab, 0xf0, 0x31, 0xc7, 0xe3, 0xff, 0x75, 0xfa, 0x3d, 0x80, 0xb5, 0x18, 0x91, 0xa4, 0x36,
+	0x05, 0xc9, 0x6d, 0x4d, 0x5d, 0xb5, 0xdd, 0xc6, 0x65, 0x63, 0xfe, 0xca, 0xad, 0x7f, 0x92, 0x62,
+	0x58, 0x8b, 0x2f, 0xac, 0xdf, 0x52, 0xad, 0x7f, 0x95, 0xe1, 0x7d, 0x53, 0xeb, 0x3d, 0x85, 0x54,
+	0xe7, 0x86, 0x29, 0xe5, 0xac, 0x9b, 0x50, 0xe1, 0xd7, 0xa0, 0xcc, 0x99, 0xa9, 0xb6, 0x12, 0x96,
+	0x39, 0x7b, 0xe3, 0xcb, 0xe6, 0x7b, 0x58, 0x1a, 0x8c, 0xa3, 0x23, 0xd4, 0x84, 0x33, 0xc7, 0xfc,
+	0x85, 0x63, 0xbe, 0x73, 0x0d, 0xe6, 0x03, 0x6e, 0xa0, 0x97, 0x9d, 0x45, 0xbe, 0x0c, 0x17, 0x6d,
+	0xc2, 0x0e, 0xf3, 0x37, 0x61, 0x39, 0x3a, 0xa4, 0x6a, 0x88, 0x24, 0xe3, 0xa7, 0xf6, 0x24, 0xaf,
+	0x84, 0x60, 0x43, 0x3d, 0x7e, 0x8a, 0xfe, 0x3a, 0x2c, 0xa2, 0xb0, 0x37, 0x8f, 0x39, 0x7e, 0xe7,
+	0xc2, 0x05, 0x14, 0xe6, 0xce, 0x99, 0xe9, 0x64, 0xb5, 0xc0, 0x4e, 0xfe, 0xee, 0xc1, 0x7b, 0xb6,
+	0x93, 0x89, 0xcc, 0x0a, 0x6d, 0x64, 0x1f, 0xaa, 0xf6, 0x8e, 0x2e, 0xe6, 0xc9, 0xdb, 0x5c, 0x9f,
+	0xfc, 0x00, 0xb5, 0x8b, 0x13, 0xe7, 0x37, 0x20, 0xd8, 0x6f, 0xb7, 0x49, 0x37, 0x6c, 0x7f, 0xd3,
+	0x69, 0x3f, 0x22, 0xfd, 0x6f, 0xbb, 0x66, 0xf1, 0xe0, 0xeb, 0x7b, 0x5f, 0xb5, 0xef, 0x93, 0xfd,
+	0x76, 0x7b, 0xad, 0xe4, 0xdf, 0x82, 0x0f, 0x5f, 0xd3, 0x1c, 0x3c, 0x9c, 0x91, 0x78, 0x1b, 0x95,
+	0x9f, 0x7e, 0x0d, 0x4a, 0x7b, 0x9d, 0xa7, 0x67, 0x81, 0xf7, 0xec, 0x2c, 0xf0, 0xfe, 0x39, 0x0b,
+	0xbc, 0x9f, 0xcf, 0x83, 0xd2, 0xb3, 0xf3, 0xa0, 0xf4, 0xf7, 0x79, 0x50, 0xfa, 0xae, 0x35, 0x83,
+	0x3d, 0x10, 0x83, 0x9d, 0xe8, 0x90, 0x72, 0xd1, 0x9a, 0x79, 0x7b, 0xfa, 0x71, 0xfa, 0xfe, 0x64,
+	0x6a, 0x18, 0x54, 0xcd, 0x8b, 0xd3, 0x67, 0xff, 0x0d, 0x00, 0xc2, 0xcf, 0xdb, 0x6c, 0xeb, 0x09,
+	0x00, 0x00,
 }
 
 func (m *EventPaymentAccountUpdate) Marshal() (dAtA []byte, err error) {
@@ -799,6 +943,116 @@ func (m *EventFeePreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCreatePrepaidPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatePrepaidPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatePrepaidPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChargeSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClosePrepaidPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClosePrepaidPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClosePrepaidPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refund.Size()
+		i -= size
+		if _, err := m.Refund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -933,6 +1187,50 @@ func (m *EventFeePreview) Size() (n int) {
 	return n
 }
 
+func (m *EventCreatePrepaidPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.ChargeSize != 0 {
+		n += 1 + sovEvents(uint64(m.ChargeSize))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovEvents(uint64(m.EndTime))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventClosePrepaidPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1929,6 +2227,348 @@ func (m *EventFeePreview) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCreatePrepaidPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatePrepaidPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatePrepaidPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSize", wireType)
+			}
+			m.ChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClosePrepaidPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClosePrepaidPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClosePrepaidPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
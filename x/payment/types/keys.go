@@ -31,6 +31,23 @@ var (
 	ParamsKey                    = []byte{0x07}
 	VersionedParamsKeyPrefix     = []byte{0x08}
 	DelayedWithdrawalKeyPrefix   = []byte{0x09}
+
+	BillingStatementKeyPrefix        = []byte{0x10}
+	BillingStatementPeriodKeyPrefix  = []byte{0x11}
+	OutFlowStatementCheckpointPrefix = []byte{0x12}
+
+	PrepaidPlanKeyPrefix       = []byte{0x13}
+	PrepaidPlanSequenceKey     = []byte{0x14}
+	PrepaidPlanExpiryKeyPrefix = []byte{0x15}
+
+	BucketFlowKeyPrefix                   = []byte{0x16}
+	BillingStatementBucketKeyPrefix       = []byte{0x17}
+	BillingStatementBucketPeriodKeyPrefix = []byte{0x18}
+
+	AutoSettleRecordByAddrKeyPrefix = []byte{0x19}
+	AutoResumeRecordByAddrKeyPrefix = []byte{0x1a}
+
+	BillingStatementStartTimeKey = []byte{0x1b}
 )
 
 // AutoSettleRecordKey returns the store key to retrieve a AutoSettleRecord from the index fields
@@ -79,6 +96,21 @@ func ParseAutoResumeRecordKey(key []byte) (res AutoResumeRecord) {
 	return
 }
 
+// AutoRecordByAddrKey returns the store key of the index of an auto settle or auto resume record by address
+func AutoRecordByAddrKey(addr sdk.AccAddress, timestamp int64) []byte {
+	return append(AutoRecordByAddrPrefix(addr), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
+
+// AutoRecordByAddrPrefix returns the prefix of the index keys of the auto settle or auto resume records of addr
+func AutoRecordByAddrPrefix(addr sdk.AccAddress) []byte {
+	return addr.Bytes()
+}
+
+// ParseAutoRecordByAddrKey parses the timestamp from the index key of a record with the address prefix stripped
+func ParseAutoRecordByAddrKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key))
+}
+
 // PaymentAccountKey returns the store key to retrieve a PaymentAccount from the index fields
 func PaymentAccountKey(
 	addr sdk.AccAddress,
@@ -148,3 +180,129 @@ func DelayedWithdrawalKey(
 ) []byte {
 	return account
 }
+
+// BillingStatementKey returns the store key to retrieve the amount paid from addr to toAddr in the statement period
+// starting at periodStart
+func BillingStatementKey(
+	addr sdk.AccAddress,
+	periodStart int64,
+	toAddr sdk.AccAddress,
+) []byte {
+	key := append([]byte{}, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(periodStart))...)
+	key = append(key, toAddr.Bytes()...)
+	return key
+}
+
+func ParseBillingStatementKey(key []byte) (addr sdk.AccAddress, periodStart int64, toAddr sdk.AccAddress) {
+	addr = key[0:20]
+	periodStart = int64(binary.BigEndian.Uint64(key[20:28]))
+	toAddr = key[28:]
+	return
+}
+
+// BillingStatementPeriodKey returns the store key indexing the billing statements by period, used for pruning
+func BillingStatementPeriodKey(
+	periodStart int64,
+	addr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(periodStart))
+	key = append(key, addr.Bytes()...)
+	key = append(key, toAddr.Bytes()...)
+	return key
+}
+
+func ParseBillingStatementPeriodKey(key []byte) (periodStart int64, addr sdk.AccAddress, toAddr sdk.AccAddress) {
+	periodStart = int64(binary.BigEndian.Uint64(key[0:8]))
+	addr = key[8:28]
+	toAddr = key[28:]
+	return
+}
+
+// OutFlowStatementCheckpointKey returns the store key to retrieve the timestamp until which the active out flow
+// from addr to toAddr has been recorded in the billing statement
+func OutFlowStatementCheckpointKey(
+	addr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+) []byte {
+	key := append([]byte{}, addr.Bytes()...)
+	return append(key, toAddr.Bytes()...)
+}
+
+// BucketFlowKey returns the store key to retrieve the flow from addr to toAddr for the bucket
+func BucketFlowKey(
+	addr sdk.AccAddress,
+	bucketId sdkmath.Uint,
+	toAddr sdk.AccAddress,
+) []byte {
+	key := append([]byte{}, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(bucketId.Uint64())...)
+	return append(key, toAddr.Bytes()...)
+}
+
+// BillingStatementBucketKey returns the store key to retrieve the amount paid from addr to toAddr for the bucket
+// in the statement period starting at periodStart
+func BillingStatementBucketKey(
+	addr sdk.AccAddress,
+	periodStart int64,
+	bucketId sdkmath.Uint,
+	toAddr sdk.AccAddress,
+) []byte {
+	key := append([]byte{}, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(periodStart))...)
+	key = append(key, sdk.Uint64ToBigEndian(bucketId.Uint64())...)
+	return append(key, toAddr.Bytes()...)
+}
+
+func ParseBillingStatementBucketKey(key []byte) (addr sdk.AccAddress, periodStart int64, bucketId sdkmath.Uint, toAddr sdk.AccAddress) {
+	addr = key[0:20]
+	periodStart = int64(binary.BigEndian.Uint64(key[20:28]))
+	bucketId = sdkmath.NewUint(binary.BigEndian.Uint64(key[28:36]))
+	toAddr = key[36:]
+	return
+}
+
+// BillingStatementBucketPeriodKey returns the store key indexing the bucket billing statements by period, used for pruning
+func BillingStatementBucketPeriodKey(
+	periodStart int64,
+	addr sdk.AccAddress,
+	bucketId sdkmath.Uint,
+	toAddr sdk.AccAddress,
+) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(periodStart))
+	key = append(key, addr.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(bucketId.Uint64())...)
+	return append(key, toAddr.Bytes()...)
+}
+
+func ParseBillingStatementBucketPeriodKey(key []byte) (periodStart int64, addr sdk.AccAddress, bucketId sdkmath.Uint, toAddr sdk.AccAddress) {
+	periodStart = int64(binary.BigEndian.Uint64(key[0:8]))
+	addr = key[8:28]
+	bucketId = sdkmath.NewUint(binary.BigEndian.Uint64(key[28:36]))
+	toAddr = key[36:]
+	return
+}
+
+// PrepaidPlanKey returns the store key to retrieve a PrepaidPlan by its id
+func PrepaidPlanKey(
+	id uint64,
+) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PrepaidPlanExpiryKey returns the store key indexing the prepaid plans by their end time
+func PrepaidPlanExpiryKey(
+	endTime int64,
+	id uint64,
+) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(endTime))
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	return key
+}
+
+func ParsePrepaidPlanExpiryKey(key []byte) (endTime int64, id uint64) {
+	endTime = int64(binary.BigEndian.Uint64(key[0:8]))
+	id = binary.BigEndian.Uint64(key[8:16])
+	return
+}
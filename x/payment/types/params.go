@@ -22,6 +22,8 @@ var (
 	KeyValidatorTaxRate          = []byte("ValidatorTaxRate")
 	KeyWithdrawTimeLockThreshold = []byte("WithdrawTimeLockThreshold")
 	KeyWithdrawTimeLockDuration  = []byte("WithdrawTimeLockDuration")
+	KeyStatementPeriod           = []byte("StatementPeriod")
+	KeyStatementRetentionTime    = []byte("StatementRetentionTime")
 
 	DefaultReserveTime      uint64  = 180 * 24 * 60 * 60       // 180 days
 	DefaultValidatorTaxRate sdk.Dec = sdk.NewDecWithPrec(1, 2) // 1%
@@ -33,6 +35,8 @@ var (
 	DefaultFeeDenom                  string = "BNB"
 	DefaultWithdrawTimeLockThreshold        = math.NewIntFromBigInt(big.NewInt(1e18)).MulRaw(100) // 100 BNB
 	DefaultWithdrawTimeLockDuration  uint64 = 24 * 60 * 60                                        // 1 day
+	DefaultStatementPeriod           uint64 = 24 * 60 * 60                                        // 1 day
+	DefaultStatementRetentionTime    uint64 = 90 * 24 * 60 * 60                                   // 90 days
 )
 
 // ParamKeyTable the param key table for launch module
@@ -51,6 +55,8 @@ func NewParams(
 	feeDenom string,
 	withdrawTimeLockThreshold math.Int,
 	withdrawTimeLockDuration uint64,
+	statementPeriod uint64,
+	statementRetentionTime uint64,
 ) Params {
 	return Params{
 		VersionedParams:           VersionedParams{ReserveTime: reserveTime, ValidatorTaxRate: validatorTaxRate},
@@ -61,6 +67,8 @@ func NewParams(
 		FeeDenom:                  feeDenom,
 		WithdrawTimeLockThreshold: &withdrawTimeLockThreshold,
 		WithdrawTimeLockDuration:  withdrawTimeLockDuration,
+		StatementPeriod:           statementPeriod,
+		StatementRetentionTime:    statementRetentionTime,
 	}
 }
 
@@ -76,6 +84,8 @@ func DefaultParams() Params {
 		DefaultFeeDenom,
 		DefaultWithdrawTimeLockThreshold,
 		DefaultWithdrawTimeLockDuration,
+		DefaultStatementPeriod,
+		DefaultStatementRetentionTime,
 	)
 }
 
@@ -91,6 +101,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
 		paramtypes.NewParamSetPair(KeyWithdrawTimeLockThreshold, &p.WithdrawTimeLockThreshold, validateWithdrawTimeLockThreshold),
 		paramtypes.NewParamSetPair(KeyWithdrawTimeLockDuration, &p.WithdrawTimeLockDuration, validateWithdrawTimeLockDuration),
+		paramtypes.NewParamSetPair(KeyStatementPeriod, &p.StatementPeriod, validateStatementPeriod),
+		paramtypes.NewParamSetPair(KeyStatementRetentionTime, &p.StatementRetentionTime, validateStatementRetentionTime),
 	}
 }
 
@@ -132,10 +144,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStatementPeriod(p.StatementPeriod); err != nil {
+		return err
+	}
+
+	if err := validateStatementRetentionTime(p.StatementRetentionTime); err != nil {
+		return err
+	}
+
 	if p.VersionedParams.ReserveTime <= p.ForcedSettleTime {
 		return fmt.Errorf("reserve time must be greater than force settle time")
 	}
 
+	if p.StatementPeriod > 0 && p.StatementRetentionTime < p.StatementPeriod {
+		return fmt.Errorf("statement retention time must not be less than statement period")
+	}
+
 	return nil
 }
 
@@ -261,3 +285,23 @@ func validateWithdrawTimeLockDuration(v interface{}) error {
 
 	return nil
 }
+
+// validateStatementPeriod validates the StatementPeriod param
+func validateStatementPeriod(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateStatementRetentionTime validates the StatementRetentionTime param
+func validateStatementRetentionTime(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -43,6 +43,10 @@ type Params struct {
 	WithdrawTimeLockThreshold *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=withdraw_time_lock_threshold,json=withdrawTimeLockThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"withdraw_time_lock_threshold,omitempty"`
 	// The duration of the time lock for a big amount withdrawal
 	WithdrawTimeLockDuration uint64 `protobuf:"varint,8,opt,name=withdraw_time_lock_duration,json=withdrawTimeLockDuration,proto3" json:"withdraw_time_lock_duration,omitempty" yaml:"withdraw_time_lock_duration"`
+	// The length in seconds of one billing statement period, e.g. one day. Zero disables billing statements.
+	StatementPeriod uint64 `protobuf:"varint,9,opt,name=statement_period,json=statementPeriod,proto3" json:"statement_period,omitempty" yaml:"statement_period"`
+	// The duration in seconds for which billing statement periods are kept before being pruned
+	StatementRetentionTime uint64 `protobuf:"varint,10,opt,name=statement_retention_time,json=statementRetentionTime,proto3" json:"statement_retention_time,omitempty" yaml:"statement_retention_time"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -127,6 +131,20 @@ func (m *Params) GetWithdrawTimeLockDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetStatementPeriod() uint64 {
+	if m != nil {
+		return m.StatementPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetStatementRetentionTime() uint64 {
+	if m != nil {
+		return m.StatementRetentionTime
+	}
+	return 0
+}
+
 // VersionedParams defines the parameters with multiple versions, each version is stored with different timestamp.
 type VersionedParams struct {
 	// Time duration which the buffer balance need to be reserved for NetOutFlow e.g. 6 month
@@ -183,44 +201,48 @@ func init() {
 func init() { proto.RegisterFile("greenfield/payment/params.proto", fileDescriptor_bd7d37632356c8f4) }
 
 var fileDescriptor_bd7d37632356c8f4 = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x18, 0x8d, 0xff, 0xbf, 0x2d, 0xed, 0x14, 0xa9, 0x91, 0xa9, 0xc0, 0x2d, 0x60, 0x17, 0x23, 0xaa,
-	0x6e, 0x6a, 0x0b, 0xd8, 0xa0, 0x8a, 0x4d, 0x43, 0x84, 0x14, 0xd1, 0x05, 0x32, 0x11, 0x0b, 0x36,
-	0xa3, 0x89, 0xfd, 0x25, 0x31, 0xf1, 0x78, 0xa2, 0xf1, 0x38, 0x97, 0xb7, 0xe0, 0x61, 0xd8, 0xf0,
-	0x06, 0x5d, 0x56, 0xac, 0x10, 0x0b, 0x0b, 0x25, 0x6b, 0x36, 0x79, 0x02, 0xe4, 0x19, 0xbb, 0xb9,
-	0xd1, 0x8a, 0xcd, 0x5c, 0xbe, 0x73, 0xe6, 0x9c, 0xd1, 0xa7, 0x33, 0x83, 0xac, 0x0e, 0x07, 0x88,
-	0xdb, 0x21, 0x44, 0x81, 0xdb, 0x27, 0x63, 0x0a, 0xb1, 0x70, 0xfb, 0x84, 0x13, 0x9a, 0x38, 0x7d,
-	0xce, 0x04, 0xd3, 0xf5, 0x39, 0xc1, 0x29, 0x08, 0x87, 0x07, 0x3e, 0x4b, 0x28, 0x4b, 0xb0, 0x64,
-	0xb8, 0x6a, 0xa3, 0xe8, 0x87, 0xfb, 0x1d, 0xd6, 0x61, 0xaa, 0x9e, 0xaf, 0x54, 0xd5, 0xfe, 0xbd,
-	0x89, 0xb6, 0xde, 0x4b, 0x55, 0xbd, 0x89, 0xaa, 0x03, 0xe0, 0x49, 0xc8, 0x62, 0x08, 0xb0, 0x72,
-	0x32, 0xb4, 0x23, 0xed, 0x64, 0xf7, 0xc5, 0x53, 0x67, 0xdd, 0xca, 0xf9, 0x58, 0x72, 0xd5, 0xf1,
-	0xda, 0xc6, 0x65, 0x66, 0x55, 0xbc, 0xbd, 0xc1, 0x72, 0x59, 0x07, 0xf4, 0xb0, 0x38, 0x81, 0x89,
-	0xef, 0xb3, 0x34, 0x16, 0x58, 0x8d, 0x51, 0x48, 0x43, 0x61, 0xfc, 0x77, 0xa4, 0x9d, 0x6c, 0xd4,
-	0x8e, 0x67, 0x99, 0x65, 0x8f, 0x09, 0x8d, 0xce, 0xec, 0x5b, 0xc8, 0xb6, 0x67, 0x14, 0xe8, 0xb9,
-	0x02, 0xdf, 0xe4, 0xc3, 0x45, 0x0e, 0xe9, 0xef, 0x90, 0xde, 0x66, 0xdc, 0x87, 0x00, 0x27, 0x20,
-	0x44, 0x04, 0x58, 0x84, 0x14, 0x8c, 0xff, 0xa5, 0xfa, 0xe3, 0x59, 0x66, 0x1d, 0x28, 0xf5, 0x75,
-	0x8e, 0xed, 0x55, 0x55, 0xf1, 0x83, 0xac, 0x35, 0x43, 0x0a, 0x3a, 0x41, 0x87, 0x94, 0x8c, 0x30,
-	0x49, 0x05, 0x2b, 0xa9, 0xed, 0x88, 0x0d, 0xd5, 0x5d, 0x8c, 0x0d, 0x29, 0xfa, 0x6c, 0x96, 0x59,
-	0x4f, 0x94, 0xe8, 0xcd, 0x5c, 0xdb, 0xbb, 0x4f, 0xc9, 0xe8, 0x3c, 0x15, 0x4c, 0xa9, 0xbf, 0x8d,
-	0xd8, 0x50, 0x5e, 0x7a, 0xc9, 0x82, 0x43, 0x92, 0xd2, 0x25, 0x8b, 0xcd, 0x1b, 0x2d, 0xd6, 0xb8,
-	0x73, 0x0b, 0x4f, 0x42, 0x73, 0x8b, 0xe7, 0x68, 0xa7, 0x0d, 0x80, 0x03, 0x88, 0x19, 0x35, 0xb6,
-	0x8e, 0xb4, 0x93, 0x9d, 0xda, 0xfe, 0x2c, 0xb3, 0xaa, 0x45, 0x27, 0x4a, 0xc8, 0xf6, 0xb6, 0xdb,
-	0x00, 0xf5, 0x7c, 0xa9, 0x8f, 0xd1, 0xa3, 0x61, 0x28, 0xba, 0x01, 0x27, 0x43, 0xd9, 0x1c, 0x1c,
-	0x31, 0xbf, 0x87, 0x45, 0x97, 0x43, 0xd2, 0x65, 0x51, 0x60, 0xdc, 0x91, 0x2a, 0xaf, 0x7e, 0x66,
-	0xd6, 0x71, 0x27, 0x14, 0xdd, 0xb4, 0xe5, 0xf8, 0x8c, 0x16, 0x31, 0x2b, 0xa6, 0xd3, 0x24, 0xe8,
-	0xb9, 0x62, 0xdc, 0x87, 0xc4, 0x69, 0xc4, 0xe2, 0xfb, 0xd7, 0x53, 0x54, 0xa4, 0xb0, 0x11, 0x0b,
-	0xef, 0xa0, 0x54, 0xcf, 0xdb, 0x7c, 0xc1, 0xfc, 0x5e, 0xb3, 0x94, 0xce, 0x73, 0xf2, 0x17, 0xeb,
-	0x20, 0xe5, 0x44, 0x84, 0x2c, 0x36, 0xb6, 0x57, 0x73, 0x72, 0x0b, 0xd9, 0xf6, 0x8c, 0x55, 0x9f,
-	0x7a, 0x09, 0x7d, 0xd3, 0xd0, 0xde, 0x4a, 0x72, 0xf5, 0x33, 0x74, 0x97, 0x43, 0x02, 0x7c, 0x50,
-	0xa4, 0x46, 0x93, 0x5e, 0x0f, 0x66, 0x99, 0x75, 0x4f, 0x79, 0x2d, 0xa2, 0xb6, 0xb7, 0x5b, 0x6c,
-	0x65, 0x54, 0x3e, 0x23, 0x7d, 0x40, 0xa2, 0x30, 0x20, 0x82, 0x71, 0x2c, 0xc8, 0x08, 0x73, 0x22,
-	0x40, 0xa6, 0x7a, 0xa7, 0xf6, 0x3a, 0x7f, 0x11, 0xff, 0xd8, 0xab, 0x3a, 0xf8, 0x0b, 0xbd, 0xaa,
-	0x83, 0xef, 0x55, 0xaf, 0x75, 0x9b, 0x64, 0xe4, 0x11, 0x01, 0xb5, 0xc6, 0xe5, 0xc4, 0xd4, 0xae,
-	0x26, 0xa6, 0xf6, 0x6b, 0x62, 0x6a, 0x5f, 0xa6, 0x66, 0xe5, 0x6a, 0x6a, 0x56, 0x7e, 0x4c, 0xcd,
-	0xca, 0x27, 0x77, 0xc1, 0xa1, 0x15, 0xb7, 0x4e, 0xfd, 0x2e, 0x09, 0x63, 0x77, 0xe1, 0x03, 0x19,
-	0x5d, 0x7f, 0x21, 0xd2, 0xae, 0xb5, 0x25, 0x5f, 0xff, 0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x71, 0xb1, 0x0e, 0x3a, 0x65, 0x04, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x18, 0xdb, 0xea, 0x21, 0xad, 0x0a, 0xd3, 0x96, 0x6d, 0xd0, 0x8c, 0x4c, 0x4c,
+	0xbb, 0xac, 0x15, 0x70, 0x41, 0x13, 0x97, 0x95, 0x6a, 0xd2, 0xc4, 0x0e, 0x93, 0xa9, 0x38, 0x20,
+	0xa1, 0xc8, 0x4d, 0x9e, 0xb6, 0x66, 0x71, 0x5c, 0x39, 0xee, 0xdb, 0xb7, 0xe0, 0xc0, 0x47, 0xe1,
+	0xc2, 0x37, 0xd8, 0x71, 0xe2, 0x84, 0x38, 0x44, 0x68, 0xfb, 0x06, 0xf9, 0x04, 0x28, 0x76, 0xd2,
+	0x57, 0x36, 0x71, 0xc9, 0xcb, 0xf3, 0xff, 0xfb, 0xf7, 0xb7, 0xec, 0xc7, 0x46, 0x76, 0x5b, 0x00,
+	0x84, 0x2d, 0x0a, 0x81, 0x5f, 0xed, 0x92, 0x11, 0x83, 0x50, 0x56, 0xbb, 0x44, 0x10, 0x16, 0x55,
+	0xba, 0x82, 0x4b, 0x6e, 0x9a, 0x13, 0x43, 0x25, 0x33, 0xec, 0x6c, 0x7b, 0x3c, 0x62, 0x3c, 0x72,
+	0x95, 0xa3, 0xaa, 0x7f, 0xb4, 0x7d, 0x67, 0xa3, 0xcd, 0xdb, 0x5c, 0xd7, 0xd3, 0x2f, 0x5d, 0x75,
+	0xbe, 0xad, 0xa0, 0xe5, 0x0b, 0x45, 0x35, 0x1b, 0xa8, 0xd4, 0x07, 0x11, 0x51, 0x1e, 0x82, 0xef,
+	0xea, 0x24, 0xcb, 0xd8, 0x33, 0x0e, 0xd7, 0x5e, 0xed, 0x57, 0x16, 0xa3, 0x2a, 0x1f, 0x73, 0xaf,
+	0x1e, 0x5e, 0x5b, 0xba, 0x8a, 0xed, 0x02, 0x5e, 0xef, 0xcf, 0x96, 0x4d, 0x40, 0xbb, 0xd9, 0x08,
+	0x97, 0x78, 0x1e, 0xef, 0x85, 0xd2, 0xd5, 0xcf, 0x80, 0x32, 0x2a, 0xad, 0x07, 0x7b, 0xc6, 0xe1,
+	0x52, 0xed, 0x20, 0x89, 0x6d, 0x67, 0x44, 0x58, 0x70, 0xec, 0xdc, 0x63, 0x76, 0xb0, 0x95, 0xa9,
+	0x27, 0x5a, 0x7c, 0x97, 0x3e, 0xce, 0x53, 0xc9, 0x7c, 0x8f, 0xcc, 0x16, 0x17, 0x1e, 0xf8, 0x6e,
+	0x04, 0x52, 0x06, 0xe0, 0x4a, 0xca, 0xc0, 0x7a, 0xa8, 0xe8, 0xcf, 0x92, 0xd8, 0xde, 0xd6, 0xf4,
+	0x45, 0x8f, 0x83, 0x4b, 0xba, 0xf8, 0x41, 0xd5, 0x1a, 0x94, 0x81, 0x49, 0xd0, 0x0e, 0x23, 0x43,
+	0x97, 0xf4, 0x24, 0xcf, 0xad, 0xad, 0x80, 0x0f, 0xf4, 0x5c, 0xac, 0x25, 0x05, 0x7d, 0x91, 0xc4,
+	0xf6, 0x73, 0x0d, 0xbd, 0xdb, 0xeb, 0xe0, 0x4d, 0x46, 0x86, 0x27, 0x3d, 0xc9, 0x35, 0xfd, 0x34,
+	0xe0, 0x03, 0x35, 0xe9, 0x99, 0x08, 0x01, 0x51, 0x8f, 0xcd, 0x44, 0x3c, 0xba, 0x33, 0x62, 0xc1,
+	0x3b, 0x89, 0xc0, 0x4a, 0x9a, 0x44, 0xbc, 0x44, 0xc5, 0x16, 0x80, 0xeb, 0x43, 0xc8, 0x99, 0xb5,
+	0xbc, 0x67, 0x1c, 0x16, 0x6b, 0x1b, 0x49, 0x6c, 0x97, 0xb2, 0x95, 0xc8, 0x25, 0x07, 0xaf, 0xb6,
+	0x00, 0xea, 0xe9, 0xa7, 0x39, 0x42, 0x4f, 0x07, 0x54, 0x76, 0x7c, 0x41, 0x06, 0x6a, 0x71, 0xdc,
+	0x80, 0x7b, 0x97, 0xae, 0xec, 0x08, 0x88, 0x3a, 0x3c, 0xf0, 0xad, 0x15, 0x45, 0x79, 0xf3, 0x3b,
+	0xb6, 0x0f, 0xda, 0x54, 0x76, 0x7a, 0xcd, 0x8a, 0xc7, 0x59, 0xd6, 0x66, 0xd9, 0xeb, 0x28, 0xf2,
+	0x2f, 0xab, 0x72, 0xd4, 0x85, 0xa8, 0x72, 0x16, 0xca, 0x9f, 0xdf, 0x8f, 0x50, 0xd6, 0x85, 0x67,
+	0xa1, 0xc4, 0xdb, 0x39, 0x3d, 0x5d, 0xe6, 0x73, 0xee, 0x5d, 0x36, 0x72, 0x74, 0xda, 0x27, 0xff,
+	0x88, 0xf6, 0x7b, 0x82, 0x48, 0xca, 0x43, 0x6b, 0x75, 0xbe, 0x4f, 0xee, 0x31, 0x3b, 0xd8, 0x9a,
+	0xcf, 0xa9, 0x67, 0x92, 0x79, 0x8a, 0x4a, 0x91, 0x24, 0x12, 0x54, 0x8f, 0x75, 0x41, 0x50, 0xee,
+	0x5b, 0x45, 0xc5, 0xde, 0x4d, 0x62, 0x7b, 0x4b, 0xb3, 0xe7, 0x1d, 0x0e, 0x5e, 0x1f, 0x97, 0x2e,
+	0x54, 0xc5, 0xfc, 0x8c, 0xac, 0x89, 0x4b, 0x80, 0x84, 0x30, 0xc5, 0xeb, 0xae, 0x43, 0x8a, 0xb7,
+	0x9f, 0xc4, 0xb6, 0x3d, 0xcf, 0x9b, 0x75, 0x3a, 0x78, 0x73, 0x2c, 0xe1, 0x5c, 0x49, 0xa7, 0xec,
+	0xfc, 0x30, 0xd0, 0xfa, 0xdc, 0x01, 0x33, 0x8f, 0xd1, 0x63, 0x01, 0x11, 0x88, 0x7e, 0xd6, 0xdc,
+	0x86, 0x8a, 0xd9, 0x4a, 0x62, 0xfb, 0x89, 0x8e, 0x99, 0x56, 0x1d, 0xbc, 0x96, 0xfd, 0xaa, 0x8e,
+	0xfe, 0x82, 0xcc, 0x3e, 0x09, 0xa8, 0x4f, 0x24, 0x17, 0xae, 0x24, 0x43, 0x57, 0x10, 0x09, 0xea,
+	0xf0, 0x15, 0x6b, 0x6f, 0xd3, 0x83, 0xfb, 0x9f, 0x5b, 0x5a, 0x07, 0x6f, 0x6a, 0x4b, 0xeb, 0xe0,
+	0xe1, 0xd2, 0x98, 0xdb, 0x20, 0x43, 0x4c, 0x24, 0xd4, 0xce, 0xae, 0x6e, 0xca, 0xc6, 0xf5, 0x4d,
+	0xd9, 0xf8, 0x73, 0x53, 0x36, 0xbe, 0xde, 0x96, 0x0b, 0xd7, 0xb7, 0xe5, 0xc2, 0xaf, 0xdb, 0x72,
+	0xe1, 0x53, 0x75, 0x2a, 0xa1, 0x19, 0x36, 0x8f, 0xbc, 0x0e, 0xa1, 0x61, 0x75, 0xea, 0x9e, 0x1b,
+	0x8e, 0x6f, 0x3a, 0x15, 0xd7, 0x5c, 0x56, 0x97, 0xd4, 0xeb, 0xbf, 0x03, 0x00, 0x23, 0x4f, 0x6f,
+	0xcf, 0x0c, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -243,6 +265,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StatementRetentionTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StatementRetentionTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StatementPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StatementPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.WithdrawTimeLockDuration != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawTimeLockDuration))
 		i--
@@ -380,6 +412,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawTimeLockDuration != 0 {
 		n += 1 + sovParams(uint64(m.WithdrawTimeLockDuration))
 	}
+	if m.StatementPeriod != 0 {
+		n += 1 + sovParams(uint64(m.StatementPeriod))
+	}
+	if m.StatementRetentionTime != 0 {
+		n += 1 + sovParams(uint64(m.StatementRetentionTime))
+	}
 	return n
 }
 
@@ -628,6 +666,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementPeriod", wireType)
+			}
+			m.StatementPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatementPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatementRetentionTime", wireType)
+			}
+			m.StatementRetentionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatementRetentionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
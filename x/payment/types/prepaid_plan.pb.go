@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: greenfield/payment/prepaid_plan.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PrepaidPlan defines a fixed-term storage commitment which is paid upfront.
+// The store fee of the covered bucket is streamed from the plan address at the price locked by the plan,
+// instead of from the payment account of the bucket.
+type PrepaidPlan struct {
+	// the unique id of the plan
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the payment account which pays for the plan and receives the refund when the plan is closed
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the virtual address which holds the prepaid balance and pays for the covered flows
+	PlanAddress string `protobuf:"bytes,3,opt,name=plan_address,json=planAddress,proto3" json:"plan_address,omitempty"`
+	// the id of the bucket covered by the plan
+	BucketId github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=bucket_id,json=bucketId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"bucket_id"`
+	// the max charge size of the bucket covered by the plan
+	ChargeSize uint64 `protobuf:"varint,5,opt,name=charge_size,json=chargeSize,proto3" json:"charge_size,omitempty"`
+	// the unix timestamp of the price locked by the plan
+	PriceTime int64 `protobuf:"varint,6,opt,name=price_time,json=priceTime,proto3" json:"price_time,omitempty"`
+	// the unix timestamp when the plan starts
+	StartTime int64 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// the unix timestamp when the plan expires
+	EndTime int64 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// the amount paid upfront, including the reserve for the covered flows which is refunded when the plan is closed
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PrepaidPlan) Reset()         { *m = PrepaidPlan{} }
+func (m *PrepaidPlan) String() string { return proto.CompactTextString(m) }
+func (*PrepaidPlan) ProtoMessage()    {}
+func (*PrepaidPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6315c1d8ed08bf8f, []int{0}
+}
+func (m *PrepaidPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepaidPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepaidPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrepaidPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepaidPlan.Merge(m, src)
+}
+func (m *PrepaidPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepaidPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepaidPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepaidPlan proto.InternalMessageInfo
+
+func (m *PrepaidPlan) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PrepaidPlan) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PrepaidPlan) GetPlanAddress() string {
+	if m != nil {
+		return m.PlanAddress
+	}
+	return ""
+}
+
+func (m *PrepaidPlan) GetChargeSize() uint64 {
+	if m != nil {
+		return m.ChargeSize
+	}
+	return 0
+}
+
+func (m *PrepaidPlan) GetPriceTime() int64 {
+	if m != nil {
+		return m.PriceTime
+	}
+	return 0
+}
+
+func (m *PrepaidPlan) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *PrepaidPlan) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PrepaidPlan)(nil), "greenfield.payment.PrepaidPlan")
+}
+
+func init() {
+	proto.RegisterFile("greenfield/payment/prepaid_plan.proto", fileDescriptor_6315c1d8ed08bf8f)
+}
+
+var fileDescriptor_6315c1d8ed08bf8f = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x93, 0x76, 0xeb, 0x1a, 0x07, 0x71, 0xb0, 0x76, 0xf0, 0x26, 0x91, 0x56, 0x48, 0x40,
+	0x2f, 0x49, 0x0e, 0x1c, 0x41, 0x48, 0xec, 0x96, 0xdb, 0x94, 0x8d, 0x0b, 0x1c, 0x22, 0x27, 0xfe,
+	0x93, 0x5a, 0x6b, 0xec, 0xc8, 0x76, 0x05, 0xdb, 0x53, 0xf0, 0x30, 0x93, 0x78, 0x85, 0x1d, 0xa7,
+	0x9e, 0x10, 0x87, 0x0a, 0xb5, 0x2f, 0x82, 0x62, 0x5b, 0xd0, 0x1b, 0x9c, 0x12, 0x7f, 0xbf, 0xcf,
+	0xdf, 0xdf, 0x9f, 0xf4, 0x47, 0x2f, 0x5a, 0x05, 0x20, 0x3e, 0x73, 0x58, 0xb1, 0xbc, 0xa7, 0xb7,
+	0x1d, 0x08, 0x93, 0xf7, 0x0a, 0x7a, 0xca, 0x59, 0xd5, 0xaf, 0xa8, 0xc8, 0x7a, 0x25, 0x8d, 0xc4,
+	0xf8, 0xaf, 0x2d, 0xf3, 0xb6, 0xf3, 0xb3, 0x46, 0xea, 0x4e, 0xea, 0xca, 0x3a, 0x72, 0x77, 0x70,
+	0xf6, 0xf3, 0xd3, 0x56, 0xb6, 0xd2, 0xe9, 0xc3, 0x9f, 0x53, 0x9f, 0x7f, 0x1f, 0xa3, 0xf8, 0xd2,
+	0x65, 0x5f, 0xae, 0xa8, 0xc0, 0x4f, 0xd1, 0x88, 0x33, 0x12, 0xce, 0xc3, 0xc5, 0x51, 0x39, 0xe2,
+	0x0c, 0x67, 0xe8, 0x58, 0x7e, 0x11, 0xa0, 0xc8, 0x68, 0x1e, 0x2e, 0xa2, 0x0b, 0xb2, 0xb9, 0x4f,
+	0x4f, 0x7d, 0xec, 0x7b, 0xc6, 0x14, 0x68, 0x7d, 0x65, 0x14, 0x17, 0x6d, 0xe9, 0x6c, 0xf8, 0x0d,
+	0x7a, 0x32, 0x3c, 0xb1, 0xa2, 0x0e, 0x92, 0xf1, 0x3f, 0xae, 0xc5, 0x83, 0xdb, 0x4b, 0xf8, 0x13,
+	0x8a, 0xea, 0x75, 0x73, 0x03, 0xa6, 0xe2, 0x8c, 0x1c, 0xd9, 0x9b, 0xef, 0x1e, 0xb6, 0xb3, 0xe0,
+	0xe7, 0x76, 0xf6, 0xaa, 0xe5, 0x66, 0xb9, 0xae, 0xb3, 0x46, 0x76, 0xbe, 0x96, 0xff, 0xa4, 0x9a,
+	0xdd, 0xe4, 0xe6, 0xb6, 0x07, 0x9d, 0x7d, 0xe0, 0xc2, 0x6c, 0xee, 0xd3, 0xd8, 0x0f, 0x1a, 0x8e,
+	0xe5, 0xd4, 0x05, 0x16, 0x0c, 0xcf, 0x50, 0xdc, 0x2c, 0xa9, 0x6a, 0xa1, 0xd2, 0xfc, 0x0e, 0xc8,
+	0xb1, 0xad, 0x88, 0x9c, 0x74, 0xc5, 0xef, 0x00, 0x3f, 0x43, 0xa8, 0x57, 0xbc, 0x81, 0xca, 0xf0,
+	0x0e, 0xc8, 0x64, 0x1e, 0x2e, 0xc6, 0x65, 0x64, 0x95, 0x6b, 0xde, 0x59, 0xac, 0x0d, 0x55, 0xc6,
+	0xe1, 0x13, 0x87, 0xad, 0x62, 0xf1, 0x19, 0x9a, 0x82, 0x60, 0x0e, 0x4e, 0x2d, 0x3c, 0x01, 0xc1,
+	0x2c, 0xba, 0x46, 0x13, 0xda, 0xc9, 0xb5, 0x30, 0x24, 0xb2, 0x9d, 0xde, 0xfa, 0x4e, 0x2f, 0xff,
+	0xa3, 0x53, 0x61, 0x2b, 0x21, 0x5f, 0xa9, 0x10, 0xa6, 0xf4, 0x59, 0x17, 0xc5, 0xc3, 0x2e, 0x09,
+	0x1f, 0x77, 0x49, 0xf8, 0x6b, 0x97, 0x84, 0xdf, 0xf6, 0x49, 0xf0, 0xb8, 0x4f, 0x82, 0x1f, 0xfb,
+	0x24, 0xf8, 0x98, 0x1f, 0xe4, 0xd6, 0xa2, 0x4e, 0x9b, 0x25, 0xe5, 0x22, 0x3f, 0x58, 0xaa, 0xaf,
+	0x7f, 0xd6, 0xca, 0x0e, 0xa9, 0x27, 0x76, 0x17, 0x5e, 0xff, 0x1e, 0x00, 0xeb, 0x09, 0xb6, 0x58,
+	0x79, 0x02, 0x00, 0x00,
+}
+
+func (m *PrepaidPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepaidPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepaidPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.EndTime != 0 {
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PriceTime != 0 {
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(m.PriceTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ChargeSize != 0 {
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(m.ChargeSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PlanAddress) > 0 {
+		i -= len(m.PlanAddress)
+		copy(dAtA[i:], m.PlanAddress)
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(len(m.PlanAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPrepaidPlan(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPrepaidPlan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPrepaidPlan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PrepaidPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPrepaidPlan(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPrepaidPlan(uint64(l))
+	}
+	l = len(m.PlanAddress)
+	if l > 0 {
+		n += 1 + l + sovPrepaidPlan(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovPrepaidPlan(uint64(l))
+	if m.ChargeSize != 0 {
+		n += 1 + sovPrepaidPlan(uint64(m.ChargeSize))
+	}
+	if m.PriceTime != 0 {
+		n += 1 + sovPrepaidPlan(uint64(m.PriceTime))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPrepaidPlan(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovPrepaidPlan(uint64(m.EndTime))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPrepaidPlan(uint64(l))
+	return n
+}
+
+func sovPrepaidPlan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPrepaidPlan(x uint64) (n int) {
+	return sovPrepaidPlan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PrepaidPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrepaidPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepaidPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepaidPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSize", wireType)
+			}
+			m.ChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTime", wireType)
+			}
+			m.PriceTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrepaidPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPrepaidPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrepaidPlan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrepaidPlan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrepaidPlan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPrepaidPlan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPrepaidPlan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPrepaidPlan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPrepaidPlan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrepaidPlan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPrepaidPlan = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -1139,22 +1140,24 @@ func (m *QueryAutoSettleRecordsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type QueryDelayedWithdrawalRequest struct {
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+type QueryAutoSettleQueueRequest struct {
+	// the address of the stream account to filter by; empty means all records
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelayedWithdrawalRequest) Reset()         { *m = QueryDelayedWithdrawalRequest{} }
-func (m *QueryDelayedWithdrawalRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelayedWithdrawalRequest) ProtoMessage()    {}
-func (*QueryDelayedWithdrawalRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAutoSettleQueueRequest) Reset()         { *m = QueryAutoSettleQueueRequest{} }
+func (m *QueryAutoSettleQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoSettleQueueRequest) ProtoMessage()    {}
+func (*QueryAutoSettleQueueRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f62e6684473ccf4a, []int{24}
 }
-func (m *QueryDelayedWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAutoSettleQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelayedWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAutoSettleQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelayedWithdrawalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAutoSettleQueueRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1164,41 +1167,50 @@ func (m *QueryDelayedWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryDelayedWithdrawalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelayedWithdrawalRequest.Merge(m, src)
+func (m *QueryAutoSettleQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoSettleQueueRequest.Merge(m, src)
 }
-func (m *QueryDelayedWithdrawalRequest) XXX_Size() int {
+func (m *QueryAutoSettleQueueRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelayedWithdrawalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelayedWithdrawalRequest.DiscardUnknown(m)
+func (m *QueryAutoSettleQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoSettleQueueRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelayedWithdrawalRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAutoSettleQueueRequest proto.InternalMessageInfo
 
-func (m *QueryDelayedWithdrawalRequest) GetAccount() string {
+func (m *QueryAutoSettleQueueRequest) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-type QueryDelayedWithdrawalResponse struct {
-	DelayedWithdrawal DelayedWithdrawalRecord `protobuf:"bytes,1,opt,name=delayed_withdrawal,json=delayedWithdrawal,proto3" json:"delayed_withdrawal"`
+func (m *QueryAutoSettleQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) Reset()         { *m = QueryDelayedWithdrawalResponse{} }
-func (m *QueryDelayedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelayedWithdrawalResponse) ProtoMessage()    {}
-func (*QueryDelayedWithdrawalResponse) Descriptor() ([]byte, []int) {
+type AutoSettleQueueEntry struct {
+	Record AutoSettleRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// the number of records ahead of the record in the queue
+	Position uint64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *AutoSettleQueueEntry) Reset()         { *m = AutoSettleQueueEntry{} }
+func (m *AutoSettleQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoSettleQueueEntry) ProtoMessage()    {}
+func (*AutoSettleQueueEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f62e6684473ccf4a, []int{25}
 }
-func (m *QueryDelayedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+func (m *AutoSettleQueueEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelayedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AutoSettleQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelayedWithdrawalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AutoSettleQueueEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1208,1461 +1220,1484 @@ func (m *QueryDelayedWithdrawalResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryDelayedWithdrawalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelayedWithdrawalResponse.Merge(m, src)
+func (m *AutoSettleQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoSettleQueueEntry.Merge(m, src)
 }
-func (m *QueryDelayedWithdrawalResponse) XXX_Size() int {
+func (m *AutoSettleQueueEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelayedWithdrawalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelayedWithdrawalResponse.DiscardUnknown(m)
+func (m *AutoSettleQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoSettleQueueEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelayedWithdrawalResponse proto.InternalMessageInfo
+var xxx_messageInfo_AutoSettleQueueEntry proto.InternalMessageInfo
 
-func (m *QueryDelayedWithdrawalResponse) GetDelayedWithdrawal() DelayedWithdrawalRecord {
+func (m *AutoSettleQueueEntry) GetRecord() AutoSettleRecord {
 	if m != nil {
-		return m.DelayedWithdrawal
+		return m.Record
 	}
-	return DelayedWithdrawalRecord{}
+	return AutoSettleRecord{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.payment.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.payment.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.payment.QueryParamsByTimestampRequest")
-	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.payment.QueryParamsByTimestampResponse")
-	proto.RegisterType((*QueryOutFlowsRequest)(nil), "greenfield.payment.QueryOutFlowsRequest")
-	proto.RegisterType((*QueryOutFlowsResponse)(nil), "greenfield.payment.QueryOutFlowsResponse")
-	proto.RegisterType((*QueryGetStreamRecordRequest)(nil), "greenfield.payment.QueryGetStreamRecordRequest")
-	proto.RegisterType((*QueryGetStreamRecordResponse)(nil), "greenfield.payment.QueryGetStreamRecordResponse")
-	proto.RegisterType((*QueryStreamRecordsRequest)(nil), "greenfield.payment.QueryStreamRecordsRequest")
-	proto.RegisterType((*QueryStreamRecordsResponse)(nil), "greenfield.payment.QueryStreamRecordsResponse")
-	proto.RegisterType((*QueryPaymentAccountCountRequest)(nil), "greenfield.payment.QueryPaymentAccountCountRequest")
-	proto.RegisterType((*QueryPaymentAccountCountResponse)(nil), "greenfield.payment.QueryPaymentAccountCountResponse")
-	proto.RegisterType((*QueryPaymentAccountCountsRequest)(nil), "greenfield.payment.QueryPaymentAccountCountsRequest")
-	proto.RegisterType((*QueryPaymentAccountCountsResponse)(nil), "greenfield.payment.QueryPaymentAccountCountsResponse")
-	proto.RegisterType((*QueryPaymentAccountRequest)(nil), "greenfield.payment.QueryPaymentAccountRequest")
-	proto.RegisterType((*QueryPaymentAccountResponse)(nil), "greenfield.payment.QueryPaymentAccountResponse")
-	proto.RegisterType((*QueryPaymentAccountsRequest)(nil), "greenfield.payment.QueryPaymentAccountsRequest")
-	proto.RegisterType((*QueryPaymentAccountsResponse)(nil), "greenfield.payment.QueryPaymentAccountsResponse")
-	proto.RegisterType((*QueryDynamicBalanceRequest)(nil), "greenfield.payment.QueryDynamicBalanceRequest")
-	proto.RegisterType((*QueryDynamicBalanceResponse)(nil), "greenfield.payment.QueryDynamicBalanceResponse")
-	proto.RegisterType((*QueryPaymentAccountsByOwnerRequest)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerRequest")
-	proto.RegisterType((*QueryPaymentAccountsByOwnerResponse)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerResponse")
-	proto.RegisterType((*QueryAutoSettleRecordsRequest)(nil), "greenfield.payment.QueryAutoSettleRecordsRequest")
-	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "greenfield.payment.QueryAutoSettleRecordsResponse")
-	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalRequest")
-	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalResponse")
+func (m *AutoSettleQueueEntry) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("greenfield/payment/query.proto", fileDescriptor_f62e6684473ccf4a) }
-
-var fileDescriptor_f62e6684473ccf4a = []byte{
-	// 1424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0x02, 0x09, 0xe4, 0x05, 0x12, 0x3c, 0x09, 0x28, 0x98, 0xd4, 0x81, 0x2d, 0x4a, 0x02,
-	0x21, 0xde, 0xc4, 0x29, 0x04, 0xaa, 0x52, 0x89, 0x14, 0x81, 0x50, 0x55, 0x05, 0x4c, 0x25, 0x24,
-	0xaa, 0x6a, 0x3b, 0xde, 0x1d, 0x1c, 0x37, 0xf6, 0xae, 0xd9, 0x1d, 0x93, 0x5a, 0x51, 0x2e, 0x48,
-	0xed, 0x19, 0xa9, 0xb7, 0x1e, 0x2b, 0xb5, 0xaa, 0xda, 0x1e, 0x91, 0x7a, 0x68, 0xaf, 0x95, 0x38,
-	0xd2, 0xf6, 0x52, 0xf5, 0x80, 0x2a, 0xd2, 0x3f, 0xa4, 0xf2, 0xec, 0x5b, 0x67, 0x3f, 0x66, 0xd7,
-	0x6b, 0xea, 0x5e, 0x12, 0xef, 0xce, 0xfb, 0xf8, 0xfd, 0xde, 0x9b, 0x37, 0xf3, 0xde, 0x42, 0xa1,
-	0xea, 0x30, 0x66, 0x3d, 0xac, 0xb1, 0xba, 0xa9, 0x35, 0x69, 0xbb, 0xc1, 0x2c, 0xae, 0x3d, 0x6a,
-	0x31, 0xa7, 0x5d, 0x6c, 0x3a, 0x36, 0xb7, 0x09, 0xd9, 0x5f, 0x2f, 0xe2, 0x7a, 0xfe, 0x82, 0x61,
-	0xbb, 0x0d, 0xdb, 0xd5, 0x2a, 0xd4, 0x65, 0x9e, 0xb0, 0xf6, 0x78, 0xa5, 0xc2, 0x38, 0x5d, 0xd1,
-	0x9a, 0xb4, 0x5a, 0xb3, 0x28, 0xaf, 0xd9, 0x96, 0xa7, 0x9f, 0x3f, 0xe5, 0xc9, 0xea, 0xe2, 0x49,
-	0xf3, 0x1e, 0x70, 0x69, 0xaa, 0x6a, 0x57, 0x6d, 0xef, 0x7d, 0xe7, 0x17, 0xbe, 0x9d, 0xa9, 0xda,
-	0x76, 0xb5, 0xce, 0x34, 0xda, 0xac, 0x69, 0xd4, 0xb2, 0x6c, 0x2e, 0xac, 0xf9, 0x3a, 0x8b, 0x12,
-	0xb8, 0xb4, 0xc5, 0x6d, 0xdd, 0x65, 0x9c, 0xd7, 0x99, 0xee, 0x30, 0xc3, 0x76, 0x4c, 0x14, 0x2e,
-	0x49, 0x84, 0x4d, 0x56, 0xa7, 0x6d, 0x66, 0xea, 0xdb, 0x35, 0xbe, 0x69, 0x3a, 0x74, 0x9b, 0xd6,
-	0xc3, 0x3a, 0x67, 0x25, 0x3a, 0x76, 0x8b, 0xeb, 0x0f, 0xeb, 0xf6, 0x36, 0x8a, 0xcc, 0x4a, 0x44,
-	0x9a, 0xd4, 0xa1, 0x0d, 0x1f, 0xe4, 0x82, 0x54, 0x40, 0xfc, 0xd7, 0xa9, 0x61, 0xd8, 0x2d, 0x8b,
-	0xa3, 0x64, 0xb1, 0xb7, 0xa4, 0x1e, 0x94, 0x9f, 0x93, 0xc8, 0xbb, 0xdc, 0x61, 0xb4, 0x11, 0x62,
-	0xa1, 0x4e, 0x01, 0xb9, 0xdb, 0xc9, 0xcb, 0x1d, 0x01, 0xab, 0xcc, 0x1e, 0xb5, 0x98, 0xcb, 0xd5,
-	0x0d, 0x98, 0x0c, 0xbd, 0x75, 0x9b, 0xb6, 0xe5, 0x32, 0x72, 0x05, 0x46, 0x3c, 0xf8, 0xd3, 0xca,
-	0x19, 0x65, 0x61, 0xac, 0x14, 0x44, 0xe5, 0xe7, 0xbc, 0xe8, 0xe9, 0xac, 0x1f, 0x7a, 0xfe, 0x72,
-	0x76, 0xa8, 0x8c, 0xf2, 0xea, 0x35, 0x78, 0x23, 0x60, 0x70, 0xbd, 0xfd, 0x61, 0xad, 0xc1, 0x5c,
-	0x4e, 0x1b, 0x4d, 0xf4, 0x48, 0x66, 0x60, 0x94, 0xfb, 0xef, 0x84, 0xf5, 0x83, 0xe5, 0xfd, 0x17,
-	0xea, 0x03, 0x28, 0x24, 0xa9, 0xff, 0x67, 0x68, 0xcb, 0x30, 0x25, 0x6c, 0x6f, 0xb4, 0xf8, 0xcd,
-	0xba, 0xbd, 0xed, 0xc7, 0x80, 0x4c, 0xc3, 0x61, 0x0c, 0xac, 0x30, 0x39, 0x5a, 0xf6, 0x1f, 0xd5,
-	0xfb, 0x70, 0x22, 0xa2, 0x81, 0x20, 0xde, 0x85, 0x51, 0x7f, 0x07, 0x74, 0x70, 0x1c, 0x5c, 0x18,
-	0x2b, 0x9d, 0x96, 0xe1, 0x40, 0x45, 0x04, 0x72, 0xc4, 0x46, 0x3b, 0xea, 0x1a, 0x9c, 0x16, 0x86,
-	0x6f, 0x31, 0x7e, 0x4f, 0xe4, 0xaa, 0x2c, 0x52, 0xd5, 0x1b, 0xd1, 0x16, 0xcc, 0xc8, 0x15, 0x11,
-	0xd8, 0xfb, 0x70, 0x2c, 0x94, 0x7c, 0x0c, 0xd2, 0x19, 0x19, 0xb8, 0xa0, 0x01, 0x44, 0x78, 0xd4,
-	0x0d, 0xbc, 0x53, 0x0d, 0x38, 0x25, 0x9c, 0x05, 0x05, 0xbb, 0x51, 0xbb, 0x09, 0xb0, 0x5f, 0xd9,
-	0xe8, 0x66, 0xae, 0x88, 0xd5, 0xdc, 0x39, 0x06, 0x8a, 0xde, 0x99, 0x81, 0xc7, 0x40, 0xf1, 0x0e,
-	0xad, 0x32, 0xd4, 0x2d, 0x07, 0x34, 0xd5, 0x67, 0x0a, 0xe4, 0x65, 0x5e, 0x90, 0xd0, 0x07, 0x30,
-	0x1e, 0x22, 0xe4, 0x87, 0x3b, 0x2b, 0xa3, 0x63, 0x41, 0x46, 0x2e, 0xb9, 0x15, 0x42, 0x7d, 0x40,
-	0xa0, 0x9e, 0xef, 0x89, 0xda, 0xc3, 0x12, 0x82, 0xbd, 0x06, 0xb3, 0xb8, 0x51, 0x85, 0xeb, 0xeb,
-	0x5e, 0x7e, 0xde, 0xeb, 0xfc, 0xf1, 0x23, 0x34, 0x05, 0xc3, 0xf6, 0xb6, 0xc5, 0x1c, 0xcc, 0xa1,
-	0xf7, 0xa0, 0x7e, 0xae, 0xc0, 0x99, 0x64, 0x4d, 0x64, 0x4d, 0xe1, 0x84, 0xb4, 0xe6, 0x31, 0xce,
-	0xf3, 0xf2, 0x3d, 0x1f, 0xb3, 0x87, 0x31, 0x98, 0x6c, 0xc6, 0x97, 0xd4, 0x4f, 0x93, 0x61, 0x0c,
-	0x3c, 0xc7, 0xbf, 0x29, 0x70, 0x36, 0xc5, 0x19, 0x92, 0x36, 0xe0, 0xa4, 0x94, 0xb4, 0x9f, 0xf2,
-	0x3e, 0x59, 0x4f, 0x49, 0x58, 0x0f, 0x70, 0x03, 0x2c, 0xe3, 0xb6, 0x0d, 0x03, 0xf0, 0x23, 0x47,
-	0xe0, 0x10, 0x35, 0x4d, 0x3f, 0xf5, 0xe2, 0xb7, 0xda, 0xc4, 0xa2, 0x8f, 0x6a, 0x20, 0xfd, 0xbb,
-	0x30, 0x11, 0xa1, 0x8f, 0x11, 0x57, 0x7b, 0xf3, 0x46, 0xca, 0xe3, 0x61, 0xca, 0x2a, 0x93, 0x7a,
-	0x1c, 0x78, 0x7a, 0x7f, 0x51, 0xf0, 0x54, 0x8a, 0xf9, 0x41, 0x6a, 0xf7, 0xe0, 0x78, 0x84, 0x9a,
-	0x9f, 0xd3, 0xec, 0xdc, 0x26, 0xc2, 0xdc, 0x06, 0x98, 0xc9, 0xcb, 0x98, 0xc9, 0x1b, 0x6d, 0x8b,
-	0x36, 0x6a, 0xc6, 0x3a, 0xad, 0x53, 0xcb, 0x60, 0xbd, 0xcf, 0xe2, 0x2f, 0x86, 0x31, 0xbc, 0x51,
-	0x45, 0x64, 0xcd, 0x60, 0xc2, 0xf4, 0x56, 0xf4, 0x8a, 0xb7, 0xe4, 0x59, 0x58, 0x7f, 0xa7, 0x43,
-	0xe8, 0xaf, 0x97, 0xb3, 0x73, 0xd5, 0x1a, 0xdf, 0x6c, 0x55, 0x8a, 0x86, 0xdd, 0xc0, 0x36, 0x08,
-	0xff, 0x2d, 0xb9, 0xe6, 0x96, 0xc6, 0xdb, 0x4d, 0xe6, 0x16, 0x6f, 0x5b, 0xfc, 0xf7, 0x67, 0x4b,
-	0x80, 0xb4, 0x6e, 0x5b, 0xbc, 0x3c, 0x6e, 0x86, 0xdc, 0xc5, 0x8f, 0xfc, 0x03, 0xaf, 0x7f, 0xe4,
-	0x93, 0x45, 0xc8, 0x19, 0x2d, 0xc7, 0xe9, 0x64, 0x6a, 0xff, 0x96, 0x3e, 0x28, 0x6e, 0xe9, 0xe3,
-	0xb8, 0xd0, 0xbd, 0x92, 0x89, 0x0e, 0x47, 0x2b, 0xd4, 0xda, 0xea, 0xb2, 0x3b, 0x34, 0x00, 0x76,
-	0x63, 0x1d, 0x8b, 0x3e, 0xb5, 0x1a, 0xe4, 0xe8, 0x63, 0x5a, 0xab, 0xd3, 0x4a, 0x9d, 0x75, 0xbd,
-	0x0c, 0x0f, 0xc0, 0xcb, 0xf1, 0xae, 0x59, 0xdf, 0xd5, 0x47, 0x00, 0x75, 0xdb, 0xd8, 0x62, 0xa6,
-	0xfe, 0x90, 0xb1, 0xe9, 0x91, 0x01, 0xf8, 0x18, 0xf5, 0xec, 0xdd, 0x64, 0x8c, 0x7c, 0x0c, 0x63,
-	0xc6, 0x26, 0xb5, 0xaa, 0x4c, 0x77, 0x28, 0x67, 0xd3, 0x87, 0x07, 0x60, 0x1d, 0x3c, 0x83, 0x65,
-	0xca, 0x99, 0xfa, 0x36, 0xa8, 0xb2, 0xf2, 0x5b, 0x6f, 0x6f, 0x74, 0x6e, 0x9c, 0xf4, 0xeb, 0x68,
-	0x03, 0xde, 0x4c, 0xd5, 0xc5, 0xbd, 0xbc, 0x00, 0xd1, 0xfa, 0x13, 0x05, 0x3c, 0x1a, 0x2b, 0x4b,
-	0xb5, 0x8a, 0x0d, 0xe0, 0xf5, 0x16, 0xb7, 0xef, 0x89, 0x0e, 0xfc, 0x7f, 0x6a, 0x1c, 0x7e, 0x55,
-	0xb0, 0x57, 0x94, 0x78, 0x42, 0xd4, 0x0f, 0x60, 0x32, 0x3e, 0x09, 0xf8, 0x47, 0xcf, 0x39, 0x59,
-	0x81, 0x44, 0x6d, 0x61, 0x91, 0xe4, 0x68, 0xd4, 0xc7, 0xe0, 0x8e, 0x9f, 0xab, 0x18, 0xb0, 0x1b,
-	0xde, 0x18, 0x72, 0xbf, 0x3b, 0x85, 0xf4, 0x3e, 0x81, 0x9e, 0xf8, 0x21, 0x90, 0xe8, 0x62, 0x08,
-	0x3e, 0x01, 0x12, 0x9f, 0x6f, 0x30, 0xea, 0x8b, 0xb2, 0x08, 0x48, 0x4c, 0x05, 0x03, 0x61, 0x46,
-	0x97, 0x4b, 0x3f, 0xe6, 0x60, 0x58, 0x80, 0x20, 0xbb, 0x30, 0xe2, 0x35, 0xde, 0x64, 0x4e, 0x66,
-	0x39, 0x3e, 0x7e, 0xe4, 0xe7, 0x7b, 0xca, 0x79, 0x34, 0x54, 0xf5, 0xc9, 0x1f, 0xff, 0x7c, 0x79,
-	0x60, 0x86, 0xe4, 0xb5, 0xc4, 0x49, 0x8b, 0x7c, 0xaf, 0x40, 0x2e, 0x36, 0x37, 0x90, 0x95, 0x1e,
-	0x2e, 0xe2, 0x23, 0x4a, 0xbe, 0xd4, 0x8f, 0x0a, 0x02, 0x2c, 0x0a, 0x80, 0x0b, 0x64, 0x2e, 0x19,
-	0xa0, 0xb6, 0xd3, 0x3d, 0x52, 0x77, 0xc9, 0x53, 0x05, 0x8e, 0xf8, 0x63, 0x05, 0x59, 0x48, 0x74,
-	0x18, 0x99, 0x55, 0xf2, 0xe7, 0x33, 0x48, 0x22, 0x22, 0x4d, 0x20, 0x3a, 0x4f, 0xe6, 0xb5, 0x94,
-	0xf9, 0xd5, 0xd5, 0x76, 0x70, 0x33, 0xed, 0x92, 0x6f, 0x15, 0x38, 0x1a, 0xbc, 0x20, 0x88, 0x96,
-	0xe8, 0x4c, 0x3e, 0xb7, 0xe4, 0x97, 0xb3, 0x2b, 0x20, 0xc8, 0x55, 0x01, 0x72, 0x89, 0x2c, 0x6a,
-	0xbd, 0xc6, 0xd8, 0x00, 0xd0, 0xaf, 0x14, 0x38, 0x16, 0x9a, 0x16, 0xc8, 0x52, 0xa2, 0x63, 0xd9,
-	0xec, 0x92, 0x2f, 0x66, 0x15, 0x47, 0x94, 0x17, 0x04, 0xca, 0x73, 0x44, 0xed, 0x89, 0xd2, 0x25,
-	0x3f, 0x2b, 0x30, 0x29, 0x69, 0x4a, 0xc9, 0x6a, 0xca, 0xa6, 0x4a, 0x1a, 0x21, 0xf2, 0x6f, 0xf5,
-	0xa7, 0x84, 0x70, 0xaf, 0x0a, 0xb8, 0xab, 0x64, 0x45, 0xcb, 0xfa, 0x2d, 0x41, 0xdb, 0x11, 0xb7,
-	0xc1, 0x2e, 0xf9, 0x49, 0x81, 0x29, 0x59, 0x93, 0x4e, 0xfa, 0x42, 0xd2, 0x0d, 0xf4, 0xa5, 0x3e,
-	0xb5, 0x90, 0x40, 0x49, 0x10, 0xb8, 0x48, 0x2e, 0x64, 0x26, 0xe0, 0x92, 0x6f, 0x14, 0x18, 0x0f,
-	0x1b, 0x25, 0xc5, 0x8c, 0xde, 0x7d, 0xb4, 0x5a, 0x66, 0xf9, 0xd7, 0xc0, 0xa9, 0xed, 0x74, 0x86,
-	0x80, 0x5d, 0xf2, 0xb5, 0x02, 0x13, 0x91, 0xcb, 0x96, 0x64, 0x75, 0xec, 0xf6, 0x2e, 0xb4, 0x84,
-	0x16, 0x5c, 0xbd, 0x28, 0xa0, 0xce, 0x91, 0x73, 0x19, 0xa0, 0xba, 0xe4, 0x3b, 0x05, 0xc6, 0xc3,
-	0x5d, 0x6d, 0x4a, 0x30, 0xa5, 0x7d, 0x73, 0x4a, 0x30, 0xe5, 0xed, 0xb2, 0x7a, 0x49, 0x20, 0xd4,
-	0xc8, 0x92, 0x0c, 0x61, 0xa4, 0x91, 0x0e, 0x1c, 0x06, 0xcf, 0x15, 0x38, 0x29, 0x6f, 0x5e, 0xc8,
-	0xe5, 0xac, 0x51, 0x0a, 0x77, 0x4a, 0xf9, 0xb5, 0xbe, 0xf5, 0x90, 0xc2, 0x35, 0x41, 0x61, 0x8d,
-	0x5c, 0xca, 0x12, 0x64, 0xbd, 0xd2, 0xd6, 0x45, 0xd5, 0x75, 0x8b, 0xef, 0x07, 0x05, 0x72, 0xb1,
-	0x66, 0x26, 0xe5, 0x02, 0x4b, 0x6a, 0xb1, 0x52, 0x2e, 0xb0, 0xc4, 0x5e, 0x29, 0xfd, 0xba, 0x90,
-	0x74, 0x51, 0xe4, 0x99, 0x02, 0xb9, 0x58, 0xb3, 0x90, 0x82, 0x36, 0xa9, 0xbf, 0x49, 0x41, 0x9b,
-	0xd8, 0xd6, 0xa8, 0x57, 0x04, 0xda, 0x12, 0x59, 0xd6, 0x32, 0x7d, 0xd0, 0xdd, 0xdf, 0x2f, 0xeb,
-	0xb7, 0x9f, 0xbf, 0x2a, 0x28, 0x2f, 0x5e, 0x15, 0x94, 0xbf, 0x5f, 0x15, 0x94, 0xa7, 0x7b, 0x85,
-	0xa1, 0x17, 0x7b, 0x85, 0xa1, 0x3f, 0xf7, 0x0a, 0x43, 0x0f, 0xb4, 0x40, 0x23, 0x5e, 0xb1, 0x2a,
-	0x4b, 0xc6, 0x26, 0xad, 0x59, 0x41, 0xfb, 0x9f, 0x75, 0x3d, 0x88, 0xae, 0xbc, 0x32, 0x22, 0xbe,
-	0xac, 0xae, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x73, 0x20, 0x3f, 0x0e, 0x31, 0x17, 0x00, 0x00,
+type QueryAutoSettleQueueResponse struct {
+	Entries    []AutoSettleQueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByTimestamp queries the parameter of the module by timestamp.
-	ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
-	// Queries our flows by account.
-	OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error)
-	// Queries a stream record by account.
-	StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error)
-	// Queries all stream records.
-	StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error)
-	// Queries the count of payment account by owner.
-	PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error)
-	// Queries all counts of payment account for all owners.
-	PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error)
-	// Queries a payment account by payment account address.
-	PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error)
-	// Queries all payment accounts.
-	PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error)
-	// Queries dynamic balance of a payment account.
-	DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error)
-	// Queries all payment accounts by a owner.
-	PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error)
-	// Queries all auto settle records.
-	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
-	// Queries delayed withdrawal of a account.
-	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+func (m *QueryAutoSettleQueueResponse) Reset()         { *m = QueryAutoSettleQueueResponse{} }
+func (m *QueryAutoSettleQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoSettleQueueResponse) ProtoMessage()    {}
+func (*QueryAutoSettleQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{26}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAutoSettleQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAutoSettleQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoSettleQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoSettleQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoSettleQueueResponse.Merge(m, src)
+}
+func (m *QueryAutoSettleQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoSettleQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoSettleQueueResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoSettleQueueResponse proto.InternalMessageInfo
+
+func (m *QueryAutoSettleQueueResponse) GetEntries() []AutoSettleQueueEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
-	out := new(QueryParamsByTimestampResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ParamsByTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAutoSettleQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error) {
-	out := new(QueryOutFlowsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/OutFlows", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAutoResumeQueueRequest struct {
+	// the address of the stream account to filter by; empty means all records
+	Account    string             `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error) {
-	out := new(QueryGetStreamRecordResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAutoResumeQueueRequest) Reset()         { *m = QueryAutoResumeQueueRequest{} }
+func (m *QueryAutoResumeQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoResumeQueueRequest) ProtoMessage()    {}
+func (*QueryAutoResumeQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{27}
+}
+func (m *QueryAutoResumeQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoResumeQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoResumeQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAutoResumeQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoResumeQueueRequest.Merge(m, src)
+}
+func (m *QueryAutoResumeQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoResumeQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoResumeQueueRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error) {
-	out := new(QueryStreamRecordsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoResumeQueueRequest proto.InternalMessageInfo
+
+func (m *QueryAutoResumeQueueRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error) {
-	out := new(QueryPaymentAccountCountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAutoResumeQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error) {
-	out := new(QueryPaymentAccountCountsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AutoResumeQueueEntry struct {
+	Record AutoResumeRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	// the number of records ahead of the record in the queue
+	Position uint64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// the number of frozen out flows of the stream account which are not resumed yet
+	RemainingOutFlowCount uint64 `protobuf:"varint,3,opt,name=remaining_out_flow_count,json=remainingOutFlowCount,proto3" json:"remaining_out_flow_count,omitempty"`
+	// the total rate of the frozen out flows which are not resumed yet
+	RemainingRate cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_rate,json=remainingRate,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_rate"`
+	// the number of frozen out flows of the records ahead, which are resumed before the record
+	OutFlowsAhead uint64 `protobuf:"varint,5,opt,name=out_flows_ahead,json=outFlowsAhead,proto3" json:"out_flows_ahead,omitempty"`
 }
 
-func (c *queryClient) PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error) {
-	out := new(QueryPaymentAccountResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AutoResumeQueueEntry) Reset()         { *m = AutoResumeQueueEntry{} }
+func (m *AutoResumeQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoResumeQueueEntry) ProtoMessage()    {}
+func (*AutoResumeQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{28}
+}
+func (m *AutoResumeQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoResumeQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoResumeQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AutoResumeQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoResumeQueueEntry.Merge(m, src)
+}
+func (m *AutoResumeQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoResumeQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoResumeQueueEntry.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error) {
-	out := new(QueryPaymentAccountsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AutoResumeQueueEntry proto.InternalMessageInfo
+
+func (m *AutoResumeQueueEntry) GetRecord() AutoResumeRecord {
+	if m != nil {
+		return m.Record
 	}
-	return out, nil
+	return AutoResumeRecord{}
 }
 
-func (c *queryClient) DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error) {
-	out := new(QueryDynamicBalanceResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DynamicBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AutoResumeQueueEntry) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error) {
-	out := new(QueryPaymentAccountsByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountsByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AutoResumeQueueEntry) GetRemainingOutFlowCount() uint64 {
+	if m != nil {
+		return m.RemainingOutFlowCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error) {
-	out := new(QueryAutoSettleRecordsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoSettleRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AutoResumeQueueEntry) GetOutFlowsAhead() uint64 {
+	if m != nil {
+		return m.OutFlowsAhead
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error) {
-	out := new(QueryDelayedWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DelayedWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryAutoResumeQueueResponse struct {
+	Entries    []AutoResumeQueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// the max number of out flows resumed in one block
+	MaxAutoResumeFlowCount uint64 `protobuf:"varint,3,opt,name=max_auto_resume_flow_count,json=maxAutoResumeFlowCount,proto3" json:"max_auto_resume_flow_count,omitempty"`
+}
+
+func (m *QueryAutoResumeQueueResponse) Reset()         { *m = QueryAutoResumeQueueResponse{} }
+func (m *QueryAutoResumeQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoResumeQueueResponse) ProtoMessage()    {}
+func (*QueryAutoResumeQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{29}
+}
+func (m *QueryAutoResumeQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoResumeQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoResumeQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAutoResumeQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoResumeQueueResponse.Merge(m, src)
+}
+func (m *QueryAutoResumeQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoResumeQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoResumeQueueResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByTimestamp queries the parameter of the module by timestamp.
-	ParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
-	// Queries our flows by account.
-	OutFlows(context.Context, *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error)
-	// Queries a stream record by account.
-	StreamRecord(context.Context, *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error)
-	// Queries all stream records.
-	StreamRecords(context.Context, *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error)
-	// Queries the count of payment account by owner.
-	PaymentAccountCount(context.Context, *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error)
-	// Queries all counts of payment account for all owners.
-	PaymentAccountCounts(context.Context, *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error)
-	// Queries a payment account by payment account address.
-	PaymentAccount(context.Context, *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error)
-	// Queries all payment accounts.
-	PaymentAccounts(context.Context, *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error)
-	// Queries dynamic balance of a payment account.
-	DynamicBalance(context.Context, *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error)
-	// Queries all payment accounts by a owner.
-	PaymentAccountsByOwner(context.Context, *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error)
-	// Queries all auto settle records.
-	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
-	// Queries delayed withdrawal of a account.
-	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+var xxx_messageInfo_QueryAutoResumeQueueResponse proto.InternalMessageInfo
+
+func (m *QueryAutoResumeQueueResponse) GetEntries() []AutoResumeQueueEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAutoResumeQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAutoResumeQueueResponse) GetMaxAutoResumeFlowCount() uint64 {
+	if m != nil {
+		return m.MaxAutoResumeFlowCount
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByTimestamp not implemented")
+
+type QueryDelayedWithdrawalRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
-func (*UnimplementedQueryServer) OutFlows(ctx context.Context, req *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutFlows not implemented")
+
+func (m *QueryDelayedWithdrawalRequest) Reset()         { *m = QueryDelayedWithdrawalRequest{} }
+func (m *QueryDelayedWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedWithdrawalRequest) ProtoMessage()    {}
+func (*QueryDelayedWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{30}
 }
-func (*UnimplementedQueryServer) StreamRecord(ctx context.Context, req *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamRecord not implemented")
+func (m *QueryDelayedWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) StreamRecords(ctx context.Context, req *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+func (m *QueryDelayedWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PaymentAccountCount(ctx context.Context, req *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCount not implemented")
+func (m *QueryDelayedWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedWithdrawalRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PaymentAccountCounts(ctx context.Context, req *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCounts not implemented")
+func (m *QueryDelayedWithdrawalRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PaymentAccount(ctx context.Context, req *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccount not implemented")
+func (m *QueryDelayedWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedWithdrawalRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PaymentAccounts(ctx context.Context, req *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccounts not implemented")
+
+var xxx_messageInfo_QueryDelayedWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedWithdrawalRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) DynamicBalance(ctx context.Context, req *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DynamicBalance not implemented")
+
+type QueryDelayedWithdrawalResponse struct {
+	DelayedWithdrawal DelayedWithdrawalRecord `protobuf:"bytes,1,opt,name=delayed_withdrawal,json=delayedWithdrawal,proto3" json:"delayed_withdrawal"`
 }
-func (*UnimplementedQueryServer) PaymentAccountsByOwner(ctx context.Context, req *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountsByOwner not implemented")
+
+func (m *QueryDelayedWithdrawalResponse) Reset()         { *m = QueryDelayedWithdrawalResponse{} }
+func (m *QueryDelayedWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedWithdrawalResponse) ProtoMessage()    {}
+func (*QueryDelayedWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{31}
 }
-func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleRecords not implemented")
+func (m *QueryDelayedWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
+func (m *QueryDelayedWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDelayedWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryDelayedWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedWithdrawalResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryDelayedWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedWithdrawalResponse) GetDelayedWithdrawal() DelayedWithdrawalRecord {
+	if m != nil {
+		return m.DelayedWithdrawal
 	}
-	return interceptor(ctx, in, info, handler)
+	return DelayedWithdrawalRecord{}
 }
 
-func _Query_ParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/ParamsByTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBillingStatementRequest struct {
+	// the address of the payer
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// the unix timestamp from which the periods are returned, inclusive; zero means no lower bound
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// the unix timestamp until which the periods are returned, exclusive; zero means no upper bound
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// pagination over the statement periods
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_OutFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutFlowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutFlows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/OutFlows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutFlows(ctx, req.(*QueryOutFlowsRequest))
+func (m *QueryBillingStatementRequest) Reset()         { *m = QueryBillingStatementRequest{} }
+func (m *QueryBillingStatementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingStatementRequest) ProtoMessage()    {}
+func (*QueryBillingStatementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{32}
+}
+func (m *QueryBillingStatementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBillingStatementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingStatementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBillingStatementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingStatementRequest.Merge(m, src)
+}
+func (m *QueryBillingStatementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBillingStatementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingStatementRequest.DiscardUnknown(m)
 }
 
-func _Query_StreamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStreamRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StreamRecord(ctx, in)
+var xxx_messageInfo_QueryBillingStatementRequest proto.InternalMessageInfo
+
+func (m *QueryBillingStatementRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/StreamRecord",
+	return ""
+}
+
+func (m *QueryBillingStatementRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StreamRecord(ctx, req.(*QueryGetStreamRecordRequest))
+	return 0
+}
+
+func (m *QueryBillingStatementRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_StreamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStreamRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBillingStatementRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).StreamRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/StreamRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StreamRecords(ctx, req.(*QueryStreamRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PaymentAccountCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccountCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountCount(ctx, req.(*QueryPaymentAccountCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBillingStatementResponse struct {
+	// the statement periods in ascending order, including the amount streamed but not yet recorded until the current block
+	Periods []BillingStatementPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods"`
+	// the total amounts paid to each destination over all the periods within the time range, regardless of pagination
+	DestinationTotals []BillingStatementLine `protobuf:"bytes,2,rep,name=destination_totals,json=destinationTotals,proto3" json:"destination_totals"`
+	// the length in seconds of one statement period
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// the total amounts paid to each destination for each storage bucket over all the periods within the time range
+	BucketTotals []BillingStatementBucketLine `protobuf:"bytes,4,rep,name=bucket_totals,json=bucketTotals,proto3" json:"bucket_totals"`
+	Pagination   *query.PageResponse          `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PaymentAccountCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountCountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountCounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccountCounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountCounts(ctx, req.(*QueryPaymentAccountCountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBillingStatementResponse) Reset()         { *m = QueryBillingStatementResponse{} }
+func (m *QueryBillingStatementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBillingStatementResponse) ProtoMessage()    {}
+func (*QueryBillingStatementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{33}
 }
-
-func _Query_PaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccount(ctx, req.(*QueryPaymentAccountRequest))
+func (m *QueryBillingStatementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBillingStatementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBillingStatementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBillingStatementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBillingStatementResponse.Merge(m, src)
+}
+func (m *QueryBillingStatementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBillingStatementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBillingStatementResponse.DiscardUnknown(m)
 }
 
-func _Query_PaymentAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccounts(ctx, req.(*QueryPaymentAccountsRequest))
+var xxx_messageInfo_QueryBillingStatementResponse proto.InternalMessageInfo
+
+func (m *QueryBillingStatementResponse) GetPeriods() []BillingStatementPeriod {
+	if m != nil {
+		return m.Periods
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DynamicBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDynamicBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DynamicBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/DynamicBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DynamicBalance(ctx, req.(*QueryDynamicBalanceRequest))
+func (m *QueryBillingStatementResponse) GetDestinationTotals() []BillingStatementLine {
+	if m != nil {
+		return m.DestinationTotals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PaymentAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentAccountsByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentAccountsByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/PaymentAccountsByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentAccountsByOwner(ctx, req.(*QueryPaymentAccountsByOwnerRequest))
+func (m *QueryBillingStatementResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_AutoSettleRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAutoSettleRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AutoSettleRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/AutoSettleRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AutoSettleRecords(ctx, req.(*QueryAutoSettleRecordsRequest))
+func (m *QueryBillingStatementResponse) GetBucketTotals() []BillingStatementBucketLine {
+	if m != nil {
+		return m.BucketTotals
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelayedWithdrawalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelayedWithdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.payment.Query/DelayedWithdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelayedWithdrawal(ctx, req.(*QueryDelayedWithdrawalRequest))
+func (m *QueryBillingStatementResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.payment.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByTimestamp",
-			Handler:    _Query_ParamsByTimestamp_Handler,
-		},
-		{
-			MethodName: "OutFlows",
-			Handler:    _Query_OutFlows_Handler,
-		},
-		{
-			MethodName: "StreamRecord",
-			Handler:    _Query_StreamRecord_Handler,
-		},
-		{
-			MethodName: "StreamRecords",
-			Handler:    _Query_StreamRecords_Handler,
-		},
-		{
-			MethodName: "PaymentAccountCount",
-			Handler:    _Query_PaymentAccountCount_Handler,
-		},
-		{
-			MethodName: "PaymentAccountCounts",
-			Handler:    _Query_PaymentAccountCounts_Handler,
-		},
-		{
-			MethodName: "PaymentAccount",
-			Handler:    _Query_PaymentAccount_Handler,
-		},
-		{
-			MethodName: "PaymentAccounts",
-			Handler:    _Query_PaymentAccounts_Handler,
-		},
-		{
-			MethodName: "DynamicBalance",
-			Handler:    _Query_DynamicBalance_Handler,
-		},
-		{
-			MethodName: "PaymentAccountsByOwner",
-			Handler:    _Query_PaymentAccountsByOwner_Handler,
-		},
-		{
-			MethodName: "AutoSettleRecords",
-			Handler:    _Query_AutoSettleRecords_Handler,
-		},
-		{
-			MethodName: "DelayedWithdrawal",
-			Handler:    _Query_DelayedWithdrawal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/payment/query.proto",
+type QueryPrepaidPlanRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPrepaidPlanRequest) Reset()         { *m = QueryPrepaidPlanRequest{} }
+func (m *QueryPrepaidPlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrepaidPlanRequest) ProtoMessage()    {}
+func (*QueryPrepaidPlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{34}
+}
+func (m *QueryPrepaidPlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrepaidPlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrepaidPlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPrepaidPlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrepaidPlanRequest.Merge(m, src)
+}
+func (m *QueryPrepaidPlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrepaidPlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrepaidPlanRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPrepaidPlanRequest proto.InternalMessageInfo
+
+func (m *QueryPrepaidPlanRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryPrepaidPlanResponse struct {
+	PrepaidPlan PrepaidPlan `protobuf:"bytes,1,opt,name=prepaid_plan,json=prepaidPlan,proto3" json:"prepaid_plan"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPrepaidPlanResponse) Reset()         { *m = QueryPrepaidPlanResponse{} }
+func (m *QueryPrepaidPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrepaidPlanResponse) ProtoMessage()    {}
+func (*QueryPrepaidPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f62e6684473ccf4a, []int{35}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPrepaidPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPrepaidPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrepaidPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPrepaidPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrepaidPlanResponse.Merge(m, src)
+}
+func (m *QueryPrepaidPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrepaidPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrepaidPlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPrepaidPlanResponse proto.InternalMessageInfo
+
+func (m *QueryPrepaidPlanResponse) GetPrepaidPlan() PrepaidPlan {
+	if m != nil {
+		return m.PrepaidPlan
 	}
-	return dAtA[:n], nil
+	return PrepaidPlan{}
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.payment.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.payment.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByTimestampRequest)(nil), "greenfield.payment.QueryParamsByTimestampRequest")
+	proto.RegisterType((*QueryParamsByTimestampResponse)(nil), "greenfield.payment.QueryParamsByTimestampResponse")
+	proto.RegisterType((*QueryOutFlowsRequest)(nil), "greenfield.payment.QueryOutFlowsRequest")
+	proto.RegisterType((*QueryOutFlowsResponse)(nil), "greenfield.payment.QueryOutFlowsResponse")
+	proto.RegisterType((*QueryGetStreamRecordRequest)(nil), "greenfield.payment.QueryGetStreamRecordRequest")
+	proto.RegisterType((*QueryGetStreamRecordResponse)(nil), "greenfield.payment.QueryGetStreamRecordResponse")
+	proto.RegisterType((*QueryStreamRecordsRequest)(nil), "greenfield.payment.QueryStreamRecordsRequest")
+	proto.RegisterType((*QueryStreamRecordsResponse)(nil), "greenfield.payment.QueryStreamRecordsResponse")
+	proto.RegisterType((*QueryPaymentAccountCountRequest)(nil), "greenfield.payment.QueryPaymentAccountCountRequest")
+	proto.RegisterType((*QueryPaymentAccountCountResponse)(nil), "greenfield.payment.QueryPaymentAccountCountResponse")
+	proto.RegisterType((*QueryPaymentAccountCountsRequest)(nil), "greenfield.payment.QueryPaymentAccountCountsRequest")
+	proto.RegisterType((*QueryPaymentAccountCountsResponse)(nil), "greenfield.payment.QueryPaymentAccountCountsResponse")
+	proto.RegisterType((*QueryPaymentAccountRequest)(nil), "greenfield.payment.QueryPaymentAccountRequest")
+	proto.RegisterType((*QueryPaymentAccountResponse)(nil), "greenfield.payment.QueryPaymentAccountResponse")
+	proto.RegisterType((*QueryPaymentAccountsRequest)(nil), "greenfield.payment.QueryPaymentAccountsRequest")
+	proto.RegisterType((*QueryPaymentAccountsResponse)(nil), "greenfield.payment.QueryPaymentAccountsResponse")
+	proto.RegisterType((*QueryDynamicBalanceRequest)(nil), "greenfield.payment.QueryDynamicBalanceRequest")
+	proto.RegisterType((*QueryDynamicBalanceResponse)(nil), "greenfield.payment.QueryDynamicBalanceResponse")
+	proto.RegisterType((*QueryPaymentAccountsByOwnerRequest)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerRequest")
+	proto.RegisterType((*QueryPaymentAccountsByOwnerResponse)(nil), "greenfield.payment.QueryPaymentAccountsByOwnerResponse")
+	proto.RegisterType((*QueryAutoSettleRecordsRequest)(nil), "greenfield.payment.QueryAutoSettleRecordsRequest")
+	proto.RegisterType((*QueryAutoSettleRecordsResponse)(nil), "greenfield.payment.QueryAutoSettleRecordsResponse")
+	proto.RegisterType((*QueryAutoSettleQueueRequest)(nil), "greenfield.payment.QueryAutoSettleQueueRequest")
+	proto.RegisterType((*AutoSettleQueueEntry)(nil), "greenfield.payment.AutoSettleQueueEntry")
+	proto.RegisterType((*QueryAutoSettleQueueResponse)(nil), "greenfield.payment.QueryAutoSettleQueueResponse")
+	proto.RegisterType((*QueryAutoResumeQueueRequest)(nil), "greenfield.payment.QueryAutoResumeQueueRequest")
+	proto.RegisterType((*AutoResumeQueueEntry)(nil), "greenfield.payment.AutoResumeQueueEntry")
+	proto.RegisterType((*QueryAutoResumeQueueResponse)(nil), "greenfield.payment.QueryAutoResumeQueueResponse")
+	proto.RegisterType((*QueryDelayedWithdrawalRequest)(nil), "greenfield.payment.QueryDelayedWithdrawalRequest")
+	proto.RegisterType((*QueryDelayedWithdrawalResponse)(nil), "greenfield.payment.QueryDelayedWithdrawalResponse")
+	proto.RegisterType((*QueryBillingStatementRequest)(nil), "greenfield.payment.QueryBillingStatementRequest")
+	proto.RegisterType((*QueryBillingStatementResponse)(nil), "greenfield.payment.QueryBillingStatementResponse")
+	proto.RegisterType((*QueryPrepaidPlanRequest)(nil), "greenfield.payment.QueryPrepaidPlanRequest")
+	proto.RegisterType((*QueryPrepaidPlanResponse)(nil), "greenfield.payment.QueryPrepaidPlanResponse")
 }
 
-func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("greenfield/payment/query.proto", fileDescriptor_f62e6684473ccf4a) }
+
+var fileDescriptor_f62e6684473ccf4a = []byte{
+	// 2013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0xea, 0xd3, 0x7a, 0xfa, 0xb2, 0xc6, 0xb2, 0x2a, 0xaf, 0x65, 0xca, 0xd9, 0x3a, 0x92,
+	0x2c, 0x59, 0x5c, 0x89, 0xae, 0xa3, 0x24, 0x68, 0x0a, 0x98, 0x4d, 0x9d, 0xba, 0x1f, 0xb0, 0x4c,
+	0x05, 0x08, 0xea, 0x22, 0xd8, 0x0e, 0xb9, 0x63, 0x6a, 0x2b, 0x72, 0x97, 0xde, 0x1d, 0x5a, 0x26,
+	0x04, 0x5d, 0x02, 0x34, 0xe7, 0x00, 0x3d, 0x14, 0xed, 0xb1, 0x45, 0x8b, 0xa2, 0xb9, 0xb4, 0x80,
+	0x81, 0x02, 0x6d, 0xaf, 0x01, 0x7c, 0x4c, 0xd3, 0x4b, 0xd1, 0x43, 0x50, 0xd8, 0xbd, 0xf6, 0x7f,
+	0x28, 0x38, 0xfb, 0x76, 0xb9, 0xbb, 0x9c, 0x25, 0x97, 0x2a, 0x0d, 0xe4, 0x62, 0x6b, 0x67, 0xde,
+	0xc7, 0xef, 0xbd, 0x37, 0xf3, 0x66, 0xe6, 0x47, 0xc8, 0x55, 0x5d, 0xc6, 0xec, 0x47, 0x16, 0xab,
+	0x99, 0x7a, 0x83, 0xb6, 0xea, 0xcc, 0xe6, 0xfa, 0xe3, 0x26, 0x73, 0x5b, 0xf9, 0x86, 0xeb, 0x70,
+	0x87, 0x90, 0xce, 0x7c, 0x1e, 0xe7, 0xd5, 0xcd, 0x8a, 0xe3, 0xd5, 0x1d, 0x4f, 0x2f, 0x53, 0x8f,
+	0xf9, 0xc2, 0xfa, 0x93, 0xdd, 0x32, 0xe3, 0x74, 0x57, 0x6f, 0xd0, 0xaa, 0x65, 0x53, 0x6e, 0x39,
+	0xb6, 0xaf, 0xaf, 0x5e, 0xf6, 0x65, 0x0d, 0xf1, 0xa5, 0xfb, 0x1f, 0x38, 0xb5, 0x58, 0x75, 0xaa,
+	0x8e, 0x3f, 0xde, 0xfe, 0x0b, 0x47, 0x57, 0xaa, 0x8e, 0x53, 0xad, 0x31, 0x9d, 0x36, 0x2c, 0x9d,
+	0xda, 0xb6, 0xc3, 0x85, 0xb5, 0x40, 0x67, 0x4b, 0x02, 0x97, 0x36, 0xb9, 0x63, 0xb8, 0xcc, 0x6b,
+	0xd6, 0x99, 0xe1, 0xb2, 0x8a, 0xe3, 0x9a, 0xfd, 0x84, 0x3d, 0xc6, 0x79, 0x2d, 0x21, 0xbc, 0x29,
+	0x11, 0x2e, 0x5b, 0xb5, 0x9a, 0x65, 0x57, 0x0d, 0x8f, 0x53, 0xce, 0xda, 0x23, 0x28, 0x5b, 0x90,
+	0xc8, 0x9a, 0xac, 0x46, 0x5b, 0xcc, 0x34, 0x8e, 0x2d, 0x7e, 0x68, 0xba, 0xf4, 0x98, 0xd6, 0xe2,
+	0xf6, 0x5f, 0x93, 0xe8, 0x38, 0x4d, 0x6e, 0x3c, 0xaa, 0x39, 0xc7, 0x28, 0xb2, 0x2a, 0x11, 0x69,
+	0x50, 0x97, 0xd6, 0x83, 0xe8, 0x37, 0xa4, 0x02, 0xe2, 0x7f, 0x83, 0x56, 0x2a, 0x4e, 0x33, 0x44,
+	0x98, 0xef, 0x2f, 0x69, 0x44, 0xe5, 0x5f, 0x97, 0xc9, 0xbb, 0xac, 0x41, 0x2d, 0xd3, 0x68, 0xd4,
+	0x68, 0x50, 0xcd, 0x35, 0x89, 0x98, 0xc7, 0x5d, 0x46, 0xeb, 0xb1, 0x60, 0xb5, 0x45, 0x20, 0x0f,
+	0xda, 0xeb, 0x62, 0x5f, 0xa0, 0x2f, 0xb1, 0xc7, 0x4d, 0xe6, 0x71, 0xed, 0x3e, 0x5c, 0x8c, 0x8d,
+	0x7a, 0x0d, 0xc7, 0xf6, 0x18, 0x79, 0x13, 0x26, 0xfc, 0x28, 0x97, 0x95, 0x6b, 0xca, 0xc6, 0x74,
+	0x41, 0xcd, 0x77, 0xaf, 0xb9, 0xbc, 0xaf, 0x53, 0x1c, 0x7b, 0xfe, 0xe5, 0xea, 0xb9, 0x12, 0xca,
+	0x6b, 0xef, 0xc0, 0xd5, 0x88, 0xc1, 0x62, 0xeb, 0x7d, 0xab, 0xce, 0x3c, 0x4e, 0xeb, 0x0d, 0xf4,
+	0x48, 0x56, 0x60, 0x8a, 0x07, 0x63, 0xc2, 0xfa, 0x68, 0xa9, 0x33, 0xa0, 0x3d, 0x84, 0x5c, 0x9a,
+	0xfa, 0xff, 0x0d, 0x6d, 0x07, 0x16, 0x85, 0xed, 0xfb, 0x4d, 0x7e, 0xb7, 0xe6, 0x1c, 0x07, 0x39,
+	0x20, 0xcb, 0x30, 0x89, 0xf9, 0x17, 0x26, 0xa7, 0x4a, 0xc1, 0xa7, 0xf6, 0x01, 0x5c, 0x4a, 0x68,
+	0x20, 0x88, 0x6f, 0xc1, 0x54, 0xb0, 0x50, 0xda, 0x38, 0x46, 0x37, 0xa6, 0x0b, 0x57, 0x64, 0x38,
+	0x50, 0x11, 0x81, 0x9c, 0x77, 0xd0, 0x8e, 0xb6, 0x07, 0x57, 0x84, 0xe1, 0xf7, 0x18, 0x3f, 0x10,
+	0xb5, 0x2a, 0x89, 0x52, 0xf5, 0x47, 0x74, 0x04, 0x2b, 0x72, 0x45, 0x04, 0xf6, 0x7d, 0x98, 0x8d,
+	0x15, 0x1f, 0x93, 0x74, 0x4d, 0x06, 0x2e, 0x6a, 0x00, 0x11, 0xce, 0x78, 0x91, 0x31, 0xad, 0x02,
+	0x97, 0x85, 0xb3, 0xa8, 0x60, 0x98, 0xb5, 0xbb, 0x00, 0x9d, 0xce, 0x82, 0x6e, 0xd6, 0xf2, 0xd8,
+	0x4d, 0xda, 0x6d, 0x28, 0xef, 0xf7, 0x2c, 0x6c, 0x43, 0xf9, 0x7d, 0x5a, 0x65, 0xa8, 0x5b, 0x8a,
+	0x68, 0x6a, 0xcf, 0x14, 0x50, 0x65, 0x5e, 0x30, 0xa0, 0x1f, 0xc2, 0x5c, 0x2c, 0xa0, 0x20, 0xdd,
+	0x59, 0x23, 0x9a, 0x8d, 0x46, 0xe4, 0x91, 0xf7, 0x62, 0xa8, 0x47, 0x04, 0xea, 0xf5, 0xbe, 0xa8,
+	0x7d, 0x2c, 0x31, 0xd8, 0x7b, 0xb0, 0x8a, 0x0b, 0x55, 0xb8, 0xbe, 0xe3, 0xd7, 0xe7, 0xdb, 0xed,
+	0x7f, 0x82, 0x0c, 0x2d, 0xc2, 0xb8, 0x73, 0x6c, 0x33, 0x17, 0x6b, 0xe8, 0x7f, 0x68, 0x3f, 0x53,
+	0xe0, 0x5a, 0xba, 0x26, 0x46, 0x4d, 0xe1, 0x92, 0xb4, 0x35, 0x60, 0x9e, 0xd7, 0xe5, 0x6b, 0xbe,
+	0xcb, 0x1e, 0xe6, 0xe0, 0x62, 0xa3, 0x7b, 0x4a, 0xfb, 0x69, 0x3a, 0x8c, 0xa1, 0xd7, 0xf8, 0xef,
+	0x0a, 0xbc, 0xd6, 0xc3, 0x19, 0x06, 0x5d, 0x81, 0x25, 0x69, 0xd0, 0x41, 0xc9, 0x07, 0x8c, 0x7a,
+	0x51, 0x12, 0xf5, 0x10, 0x17, 0xc0, 0x0e, 0x2e, 0xdb, 0x38, 0x80, 0x20, 0x73, 0x04, 0xc6, 0xa8,
+	0x69, 0x06, 0xa5, 0x17, 0x7f, 0x6b, 0x0d, 0xb8, 0x22, 0xd5, 0xc0, 0xf0, 0x1f, 0xc0, 0x7c, 0x22,
+	0x7c, 0xcc, 0xb8, 0xd6, 0x3f, 0x6e, 0x0c, 0x79, 0x2e, 0x1e, 0xb2, 0xc6, 0xa4, 0x1e, 0x87, 0x5e,
+	0xde, 0xbf, 0x29, 0xb0, 0x22, 0xf7, 0x83, 0xa1, 0x1d, 0xc0, 0x85, 0x44, 0x68, 0x41, 0x4d, 0xb3,
+	0xc7, 0x36, 0x1f, 0x8f, 0x6d, 0x88, 0x95, 0x7c, 0x03, 0x2b, 0xf9, 0x6e, 0xcb, 0xa6, 0x75, 0xab,
+	0x52, 0xa4, 0x35, 0x6a, 0x57, 0x58, 0xff, 0x5e, 0xfc, 0xf1, 0x38, 0x5c, 0x91, 0x2a, 0x62, 0xd4,
+	0x0c, 0xe6, 0x4d, 0x7f, 0xc6, 0x28, 0xfb, 0x53, 0xbe, 0x85, 0xe2, 0x37, 0xdb, 0x01, 0xfd, 0xeb,
+	0xcb, 0xd5, 0xb5, 0xaa, 0xc5, 0x0f, 0x9b, 0xe5, 0x7c, 0xc5, 0xa9, 0xe3, 0x35, 0x0c, 0xff, 0xdb,
+	0xf6, 0xcc, 0x23, 0x9d, 0xb7, 0x1a, 0xcc, 0xcb, 0xdf, 0xb3, 0xf9, 0x17, 0xcf, 0xb6, 0x01, 0xc3,
+	0xba, 0x67, 0xf3, 0xd2, 0x9c, 0x19, 0x73, 0xd7, 0xdd, 0xf2, 0x47, 0xce, 0xde, 0xf2, 0xc9, 0x16,
+	0x2c, 0x54, 0x9a, 0xae, 0xdb, 0xae, 0x54, 0xe7, 0x94, 0x1e, 0x15, 0xa7, 0xf4, 0x05, 0x9c, 0x08,
+	0x8f, 0x64, 0x62, 0xc0, 0x4c, 0x99, 0xda, 0x47, 0x61, 0x74, 0x63, 0x43, 0x88, 0x6e, 0xba, 0x6d,
+	0x31, 0x08, 0xcd, 0x82, 0x05, 0xfa, 0x84, 0x5a, 0x35, 0x5a, 0xae, 0xb1, 0xd0, 0xcb, 0xf8, 0x10,
+	0xbc, 0x5c, 0x08, 0xcd, 0x06, 0xae, 0x7e, 0x0c, 0x50, 0x73, 0x2a, 0x47, 0xcc, 0x34, 0x1e, 0x31,
+	0xb6, 0x3c, 0x31, 0x04, 0x1f, 0x53, 0xbe, 0xbd, 0xbb, 0x8c, 0x91, 0x0f, 0x61, 0xba, 0x72, 0x48,
+	0xed, 0x2a, 0x33, 0x5c, 0xca, 0xd9, 0xf2, 0xe4, 0x10, 0xac, 0x83, 0x6f, 0xb0, 0x44, 0x39, 0xd3,
+	0xde, 0x06, 0x4d, 0xb6, 0xfd, 0x8a, 0xad, 0xfb, 0xed, 0x13, 0xa7, 0xf7, 0x71, 0x74, 0x1f, 0xbe,
+	0xde, 0x53, 0x17, 0xd7, 0xf2, 0x06, 0x24, 0xf7, 0x9f, 0xd8, 0xc0, 0x53, 0x5d, 0xdb, 0x52, 0xab,
+	0xe2, 0x05, 0xf0, 0x4e, 0x93, 0x3b, 0x07, 0xe2, 0x52, 0xff, 0x8a, 0x2e, 0x0e, 0x9f, 0x29, 0x90,
+	0x4b, 0xf3, 0x84, 0xa8, 0x1f, 0xc2, 0xc5, 0xee, 0xc7, 0x45, 0xd0, 0x7a, 0xae, 0xcb, 0x36, 0x48,
+	0xd2, 0x16, 0x6e, 0x92, 0x05, 0x9a, 0xf4, 0x31, 0xbc, 0xf6, 0xf3, 0x4b, 0x05, 0xdb, 0x48, 0xc7,
+	0xf7, 0x83, 0x26, 0x6b, 0x86, 0x0d, 0xa8, 0x90, 0x68, 0x40, 0xc5, 0xe5, 0x2f, 0x9e, 0x6d, 0x2f,
+	0xa2, 0xa3, 0x3b, 0xa6, 0xe9, 0x32, 0xcf, 0x3b, 0xe0, 0xae, 0x65, 0x57, 0xc3, 0xd6, 0x44, 0xee,
+	0x4a, 0xc0, 0x9d, 0x25, 0xc7, 0x4f, 0x60, 0x31, 0x81, 0xea, 0x3b, 0x36, 0x77, 0x5b, 0xa4, 0x08,
+	0x13, 0xb1, 0xfb, 0xe5, 0x20, 0xb9, 0x44, 0x4d, 0xa2, 0xc2, 0xf9, 0x86, 0xe3, 0x59, 0x21, 0xc2,
+	0xb1, 0x52, 0xf8, 0xad, 0xfd, 0x29, 0x38, 0x51, 0xba, 0x72, 0x82, 0x95, 0xfd, 0x2e, 0x4c, 0x32,
+	0x9b, 0xbb, 0x16, 0x0b, 0xaa, 0xb9, 0xd1, 0x1b, 0x41, 0x07, 0x3b, 0xa2, 0x08, 0xd4, 0x5f, 0x51,
+	0x1d, 0x4b, 0xe2, 0xed, 0xfb, 0x95, 0xa9, 0xe3, 0xa7, 0x23, 0xb0, 0x98, 0x80, 0x35, 0x60, 0x21,
+	0x7d, 0xcd, 0x41, 0x0b, 0x49, 0xf6, 0x60, 0xd9, 0x65, 0x75, 0x6a, 0xd9, 0xed, 0x37, 0x7b, 0xf0,
+	0x64, 0xc2, 0xbb, 0xec, 0xa8, 0x90, 0xbd, 0x14, 0xce, 0xe3, 0x63, 0x49, 0x5c, 0xd4, 0x48, 0x09,
+	0xe6, 0x3a, 0x8a, 0xa2, 0x6b, 0xfa, 0xa7, 0xcb, 0x16, 0x76, 0xcd, 0x4b, 0x7e, 0x12, 0x3c, 0xf3,
+	0x28, 0x6f, 0x39, 0x7a, 0x9d, 0xf2, 0x43, 0x49, 0x93, 0x9c, 0x0d, 0x4d, 0xb4, 0xfb, 0x24, 0x59,
+	0x83, 0xf9, 0xf0, 0xd5, 0x66, 0xd0, 0x43, 0x46, 0x4d, 0x71, 0x98, 0x8c, 0x95, 0x66, 0x83, 0x87,
+	0xd9, 0x9d, 0xf6, 0xa0, 0xf6, 0xdf, 0xe8, 0xea, 0x8b, 0x55, 0x72, 0xe0, 0xd5, 0x97, 0x4c, 0xf8,
+	0xab, 0x5a, 0x7d, 0xe4, 0x6d, 0x50, 0xeb, 0xf4, 0xa9, 0x11, 0x25, 0x5e, 0xba, 0x52, 0xbd, 0x54,
+	0xa7, 0x4f, 0x3b, 0xa0, 0xc2, 0x5c, 0x6b, 0x6f, 0x61, 0xcb, 0x7e, 0xd7, 0xe7, 0x4b, 0x3e, 0x08,
+	0xe9, 0x92, 0xfe, 0x77, 0xa0, 0x8f, 0x82, 0x26, 0x2c, 0xd1, 0xc5, 0x64, 0xfd, 0x04, 0x48, 0x37,
+	0x11, 0x83, 0xcb, 0x6d, 0x4b, 0x96, 0x37, 0x89, 0xa9, 0x68, 0x2b, 0x36, 0x93, 0xd3, 0xda, 0x5f,
+	0x82, 0x7a, 0x15, 0x7d, 0x72, 0xe8, 0x20, 0xe0, 0x86, 0xfa, 0xe2, 0x27, 0x57, 0x01, 0x3c, 0x4e,
+	0x5d, 0xff, 0xb6, 0x23, 0xf2, 0x3f, 0x5a, 0x9a, 0x12, 0x23, 0xed, 0x6b, 0x0e, 0xb9, 0x0c, 0xe7,
+	0x99, 0x6d, 0xfa, 0x93, 0xfe, 0x2d, 0x68, 0x92, 0xd9, 0xa6, 0x98, 0x8a, 0x6f, 0xcd, 0xb1, 0x33,
+	0x6f, 0xcd, 0x8f, 0x47, 0xe1, 0x6a, 0x0a, 0x78, 0x4c, 0xe0, 0xf7, 0x60, 0xb2, 0xc1, 0x5c, 0xcb,
+	0x09, 0x4f, 0xae, 0x4d, 0x59, 0xd6, 0x92, 0xea, 0xfb, 0x42, 0x25, 0x58, 0x6f, 0x68, 0x80, 0x7c,
+	0xd8, 0x2e, 0x86, 0xc7, 0xd1, 0xb9, 0xc1, 0x1d, 0x4e, 0x6b, 0xde, 0xf2, 0x48, 0xfa, 0x22, 0x4e,
+	0x9a, 0xfd, 0x81, 0x65, 0xb3, 0x4e, 0x25, 0x42, 0x4b, 0xef, 0x0b, 0x43, 0x64, 0x09, 0x26, 0x7c,
+	0x4f, 0xb8, 0xe2, 0xf0, 0x8b, 0xfc, 0x08, 0x66, 0xcb, 0xcd, 0xca, 0x11, 0xe3, 0x81, 0xc7, 0x31,
+	0xe1, 0x31, 0x9f, 0xc5, 0x63, 0x51, 0x28, 0x46, 0xfc, 0xce, 0xf8, 0xa6, 0xd0, 0x65, 0x7c, 0x07,
+	0x8d, 0x9f, 0xbd, 0x7f, 0xdf, 0x80, 0xaf, 0xf9, 0x37, 0x21, 0x9f, 0x63, 0xdb, 0xaf, 0x51, 0x3b,
+	0x58, 0x3f, 0x73, 0x30, 0x62, 0xf9, 0x1d, 0x72, 0xac, 0x34, 0x62, 0x99, 0x9a, 0x09, 0xcb, 0xdd,
+	0xa2, 0x61, 0x6f, 0x98, 0x89, 0xb2, 0x74, 0xb8, 0xd0, 0x57, 0xa5, 0xef, 0x9c, 0x8e, 0x3a, 0x86,
+	0x36, 0xdd, 0xe8, 0x0c, 0x15, 0x3e, 0x5b, 0x82, 0x71, 0xe1, 0x86, 0x9c, 0xc2, 0x84, 0xcf, 0x68,
+	0x91, 0x35, 0x99, 0x9d, 0x6e, 0x5e, 0x4f, 0x5d, 0xef, 0x2b, 0xe7, 0xc3, 0xd5, 0xb4, 0x8f, 0xfe,
+	0xf1, 0x9f, 0x9f, 0x8f, 0xac, 0x10, 0x55, 0x4f, 0x65, 0x3a, 0xc9, 0x1f, 0x14, 0x58, 0xe8, 0x22,
+	0xe4, 0xc8, 0x6e, 0x1f, 0x17, 0xdd, 0xdc, 0x9f, 0x5a, 0x18, 0x44, 0x05, 0x01, 0xe6, 0x05, 0xc0,
+	0x0d, 0xb2, 0x96, 0x0e, 0x50, 0x3f, 0x09, 0xdf, 0x2a, 0xa7, 0xe4, 0x13, 0x05, 0xce, 0x07, 0x7c,
+	0x1d, 0xd9, 0x48, 0x75, 0x98, 0x20, 0x01, 0xd5, 0x1b, 0x19, 0x24, 0x11, 0x91, 0x2e, 0x10, 0xdd,
+	0x20, 0xeb, 0x7a, 0x0f, 0xfe, 0xd8, 0xd3, 0x4f, 0xb0, 0xc7, 0x9c, 0x92, 0xdf, 0x29, 0x30, 0x13,
+	0x7d, 0x79, 0x11, 0x3d, 0xd5, 0x99, 0x9c, 0x10, 0x54, 0x77, 0xb2, 0x2b, 0x20, 0xc8, 0x5b, 0x02,
+	0xe4, 0x36, 0xd9, 0xd2, 0xfb, 0xf1, 0xc3, 0x11, 0xa0, 0xbf, 0x52, 0x60, 0xf6, 0x20, 0xc6, 0x97,
+	0x6d, 0xa7, 0x3a, 0x96, 0x91, 0x82, 0x6a, 0x3e, 0xab, 0x38, 0xa2, 0xdc, 0x14, 0x28, 0xaf, 0x13,
+	0xad, 0x2f, 0x4a, 0x8f, 0xfc, 0x55, 0x81, 0x8b, 0x12, 0xb6, 0x87, 0xdc, 0xea, 0xb1, 0xa8, 0xd2,
+	0xb8, 0x39, 0xf5, 0x1b, 0x83, 0x29, 0x21, 0xdc, 0xb7, 0x04, 0xdc, 0x5b, 0x64, 0x57, 0xcf, 0xca,
+	0xe5, 0xeb, 0x27, 0xe2, 0x99, 0x75, 0x4a, 0xfe, 0xac, 0xc0, 0xe2, 0xbe, 0x8c, 0x90, 0x1a, 0x08,
+	0x49, 0x98, 0xe8, 0xdb, 0x03, 0x6a, 0x61, 0x00, 0x05, 0x11, 0xc0, 0x4d, 0xb2, 0x99, 0x39, 0x00,
+	0x8f, 0xfc, 0x56, 0x81, 0xb9, 0xb8, 0x51, 0x92, 0xcf, 0xe8, 0x3d, 0x40, 0xab, 0x67, 0x96, 0x3f,
+	0x03, 0x4e, 0xfd, 0xa4, 0xcd, 0xae, 0x9d, 0x92, 0x5f, 0x2b, 0x30, 0xbf, 0x9f, 0xe0, 0x88, 0xb2,
+	0x3a, 0xf6, 0xfa, 0x6f, 0xb4, 0x14, 0x6e, 0x4b, 0xbb, 0x29, 0xa0, 0xae, 0x91, 0xeb, 0x19, 0xa0,
+	0x7a, 0xe4, 0xf7, 0x0a, 0xcc, 0xc5, 0xe9, 0xa2, 0x1e, 0xc9, 0x94, 0x12, 0x52, 0xaa, 0x9e, 0x59,
+	0x1e, 0x11, 0xde, 0x16, 0x08, 0x75, 0xb2, 0x2d, 0x43, 0x98, 0x60, 0xa8, 0x22, 0xcd, 0xe0, 0xb9,
+	0x02, 0x4b, 0x72, 0x56, 0x80, 0xbc, 0x91, 0x35, 0x4b, 0x71, 0x0a, 0x42, 0xdd, 0x1b, 0x58, 0x0f,
+	0x43, 0x78, 0x47, 0x84, 0xb0, 0x47, 0x6e, 0x67, 0x49, 0xb2, 0x51, 0x6e, 0x19, 0x62, 0xd7, 0x85,
+	0x9b, 0xef, 0x53, 0x05, 0x16, 0xba, 0x58, 0x82, 0x1e, 0x07, 0x58, 0x1a, 0x77, 0xa1, 0x16, 0x06,
+	0x51, 0xc9, 0x72, 0x5c, 0x48, 0xe8, 0x09, 0xf2, 0x1b, 0x05, 0xe6, 0x13, 0x2f, 0xd7, 0x1e, 0x0b,
+	0x59, 0xce, 0x1a, 0xa8, 0x3b, 0xd9, 0x15, 0x10, 0xe7, 0xb6, 0xc0, 0xb9, 0x4e, 0x5e, 0xef, 0x87,
+	0xf3, 0xb1, 0x40, 0x14, 0xa0, 0x8c, 0xbc, 0x70, 0xfa, 0xa0, 0xec, 0x7e, 0x13, 0xab, 0x3b, 0xd9,
+	0x15, 0x32, 0xa3, 0xc4, 0xd7, 0x8f, 0x8f, 0xf2, 0x99, 0x02, 0x0b, 0x5d, 0xef, 0x89, 0x1e, 0x95,
+	0x4f, 0x7b, 0x02, 0xa9, 0x85, 0x41, 0x54, 0x10, 0xeb, 0x9b, 0x02, 0x6b, 0x81, 0xec, 0xe8, 0x99,
+	0x7e, 0x9c, 0x8e, 0xec, 0xbd, 0x3f, 0x2a, 0x70, 0x21, 0x79, 0x0f, 0x26, 0xe9, 0xc9, 0x4a, 0x79,
+	0xf7, 0xa8, 0xbb, 0x03, 0x68, 0x20, 0xe6, 0x3d, 0x81, 0x79, 0x97, 0xe8, 0x7a, 0x96, 0x1f, 0xdf,
+	0x23, 0x90, 0x7f, 0xa1, 0xc0, 0x74, 0xe4, 0x42, 0x4b, 0xb6, 0xd2, 0xf7, 0x7a, 0xd7, 0x05, 0x5b,
+	0xbd, 0x99, 0x4d, 0x38, 0xcb, 0x1a, 0x88, 0x5e, 0xbe, 0xf5, 0x13, 0xcb, 0x3c, 0x2d, 0xde, 0x7b,
+	0xfe, 0x22, 0xa7, 0x7c, 0xfe, 0x22, 0xa7, 0xfc, 0xfb, 0x45, 0x4e, 0xf9, 0xe4, 0x65, 0xee, 0xdc,
+	0xe7, 0x2f, 0x73, 0xe7, 0xfe, 0xf9, 0x32, 0x77, 0xee, 0xa1, 0x1e, 0xa1, 0x5e, 0xcb, 0x76, 0x79,
+	0xbb, 0x72, 0x48, 0x2d, 0x3b, 0x6a, 0xf4, 0x69, 0x68, 0x56, 0xf0, 0xb0, 0xe5, 0x09, 0xf1, 0x5b,
+	0xfa, 0xad, 0xff, 0x0d, 0x00, 0x24, 0x85, 0xba, 0xe6, 0xa3, 0x21, 0x00, 0x00,
 }
 
-func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByTimestamp queries the parameter of the module by timestamp.
+	ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error)
+	// Queries our flows by account.
+	OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error)
+	// Queries a stream record by account.
+	StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error)
+	// Queries all stream records.
+	StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error)
+	// Queries the count of payment account by owner.
+	PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error)
+	// Queries all counts of payment account for all owners.
+	PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error)
+	// Queries a payment account by payment account address.
+	PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error)
+	// Queries all payment accounts.
+	PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error)
+	// Queries dynamic balance of a payment account.
+	DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error)
+	// Queries all payment accounts by a owner.
+	PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error)
+	// Queries all auto settle records.
+	AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error)
+	// Queries the auto settle queue with the position of each record, filtered by account.
+	AutoSettleQueue(ctx context.Context, in *QueryAutoSettleQueueRequest, opts ...grpc.CallOption) (*QueryAutoSettleQueueResponse, error)
+	// Queries the auto resume queue with the position and the out flows to resume of each record, filtered by account.
+	AutoResumeQueue(ctx context.Context, in *QueryAutoResumeQueueRequest, opts ...grpc.CallOption) (*QueryAutoResumeQueueResponse, error)
+	// Queries delayed withdrawal of a account.
+	DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error)
+	// Queries the billing statement of a stream account.
+	BillingStatement(ctx context.Context, in *QueryBillingStatementRequest, opts ...grpc.CallOption) (*QueryBillingStatementResponse, error)
+	// Queries a prepaid plan by its id.
+	PrepaidPlan(ctx context.Context, in *QueryPrepaidPlanRequest, opts ...grpc.CallOption) (*QueryPrepaidPlanResponse, error)
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ParamsByTimestamp(ctx context.Context, in *QueryParamsByTimestampRequest, opts ...grpc.CallOption) (*QueryParamsByTimestampResponse, error) {
+	out := new(QueryParamsByTimestampResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/ParamsByTimestamp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OutFlows(ctx context.Context, in *QueryOutFlowsRequest, opts ...grpc.CallOption) (*QueryOutFlowsResponse, error) {
+	out := new(QueryOutFlowsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/OutFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOutFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) StreamRecord(ctx context.Context, in *QueryGetStreamRecordRequest, opts ...grpc.CallOption) (*QueryGetStreamRecordResponse, error) {
+	out := new(QueryGetStreamRecordResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StreamRecords(ctx context.Context, in *QueryStreamRecordsRequest, opts ...grpc.CallOption) (*QueryStreamRecordsResponse, error) {
+	out := new(QueryStreamRecordsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/StreamRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOutFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccountCount(ctx context.Context, in *QueryPaymentAccountCountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountResponse, error) {
+	out := new(QueryPaymentAccountCountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOutFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OutFlows) > 0 {
-		for iNdEx := len(m.OutFlows) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PaymentAccountCounts(ctx context.Context, in *QueryPaymentAccountCountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountCountsResponse, error) {
+	out := new(QueryPaymentAccountCountsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetStreamRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccount(ctx context.Context, in *QueryPaymentAccountRequest, opts ...grpc.CallOption) (*QueryPaymentAccountResponse, error) {
+	out := new(QueryPaymentAccountResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetStreamRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetStreamRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetStreamRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccounts(ctx context.Context, in *QueryPaymentAccountsRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsResponse, error) {
+	out := new(QueryPaymentAccountsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetStreamRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetStreamRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStreamRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DynamicBalance(ctx context.Context, in *QueryDynamicBalanceRequest, opts ...grpc.CallOption) (*QueryDynamicBalanceResponse, error) {
+	out := new(QueryDynamicBalanceResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DynamicBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStreamRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryStreamRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStreamRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PaymentAccountsByOwner(ctx context.Context, in *QueryPaymentAccountsByOwnerRequest, opts ...grpc.CallOption) (*QueryPaymentAccountsByOwnerResponse, error) {
+	out := new(QueryPaymentAccountsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PaymentAccountsByOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStreamRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryStreamRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StreamRecords) > 0 {
-		for iNdEx := len(m.StreamRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AutoSettleRecords(ctx context.Context, in *QueryAutoSettleRecordsRequest, opts ...grpc.CallOption) (*QueryAutoSettleRecordsResponse, error) {
+	out := new(QueryAutoSettleRecordsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoSettleRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AutoSettleQueue(ctx context.Context, in *QueryAutoSettleQueueRequest, opts ...grpc.CallOption) (*QueryAutoSettleQueueResponse, error) {
+	out := new(QueryAutoSettleQueueResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoSettleQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AutoResumeQueue(ctx context.Context, in *QueryAutoResumeQueueRequest, opts ...grpc.CallOption) (*QueryAutoResumeQueueResponse, error) {
+	out := new(QueryAutoResumeQueueResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/AutoResumeQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelayedWithdrawal(ctx context.Context, in *QueryDelayedWithdrawalRequest, opts ...grpc.CallOption) (*QueryDelayedWithdrawalResponse, error) {
+	out := new(QueryDelayedWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/DelayedWithdrawal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentAccountCount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) BillingStatement(ctx context.Context, in *QueryBillingStatementRequest, opts ...grpc.CallOption) (*QueryBillingStatementResponse, error) {
+	out := new(QueryBillingStatementResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/BillingStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PrepaidPlan(ctx context.Context, in *QueryPrepaidPlanRequest, opts ...grpc.CallOption) (*QueryPrepaidPlanResponse, error) {
+	out := new(QueryPrepaidPlanResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.payment.Query/PrepaidPlan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPaymentAccountCountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByTimestamp queries the parameter of the module by timestamp.
+	ParamsByTimestamp(context.Context, *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error)
+	// Queries our flows by account.
+	OutFlows(context.Context, *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error)
+	// Queries a stream record by account.
+	StreamRecord(context.Context, *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error)
+	// Queries all stream records.
+	StreamRecords(context.Context, *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error)
+	// Queries the count of payment account by owner.
+	PaymentAccountCount(context.Context, *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error)
+	// Queries all counts of payment account for all owners.
+	PaymentAccountCounts(context.Context, *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error)
+	// Queries a payment account by payment account address.
+	PaymentAccount(context.Context, *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error)
+	// Queries all payment accounts.
+	PaymentAccounts(context.Context, *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error)
+	// Queries dynamic balance of a payment account.
+	DynamicBalance(context.Context, *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error)
+	// Queries all payment accounts by a owner.
+	PaymentAccountsByOwner(context.Context, *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error)
+	// Queries all auto settle records.
+	AutoSettleRecords(context.Context, *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error)
+	// Queries the auto settle queue with the position of each record, filtered by account.
+	AutoSettleQueue(context.Context, *QueryAutoSettleQueueRequest) (*QueryAutoSettleQueueResponse, error)
+	// Queries the auto resume queue with the position and the out flows to resume of each record, filtered by account.
+	AutoResumeQueue(context.Context, *QueryAutoResumeQueueRequest) (*QueryAutoResumeQueueResponse, error)
+	// Queries delayed withdrawal of a account.
+	DelayedWithdrawal(context.Context, *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error)
+	// Queries the billing statement of a stream account.
+	BillingStatement(context.Context, *QueryBillingStatementRequest) (*QueryBillingStatementResponse, error)
+	// Queries a prepaid plan by its id.
+	PrepaidPlan(context.Context, *QueryPrepaidPlanRequest) (*QueryPrepaidPlanResponse, error)
 }
 
-func (m *QueryPaymentAccountCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPaymentAccountCountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByTimestamp(ctx context.Context, req *QueryParamsByTimestampRequest) (*QueryParamsByTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByTimestamp not implemented")
+}
+func (*UnimplementedQueryServer) OutFlows(ctx context.Context, req *QueryOutFlowsRequest) (*QueryOutFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutFlows not implemented")
+}
+func (*UnimplementedQueryServer) StreamRecord(ctx context.Context, req *QueryGetStreamRecordRequest) (*QueryGetStreamRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecord not implemented")
+}
+func (*UnimplementedQueryServer) StreamRecords(ctx context.Context, req *QueryStreamRecordsRequest) (*QueryStreamRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRecords not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountCount(ctx context.Context, req *QueryPaymentAccountCountRequest) (*QueryPaymentAccountCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCount not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountCounts(ctx context.Context, req *QueryPaymentAccountCountsRequest) (*QueryPaymentAccountCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountCounts not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccount(ctx context.Context, req *QueryPaymentAccountRequest) (*QueryPaymentAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccount not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccounts(ctx context.Context, req *QueryPaymentAccountsRequest) (*QueryPaymentAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccounts not implemented")
+}
+func (*UnimplementedQueryServer) DynamicBalance(ctx context.Context, req *QueryDynamicBalanceRequest) (*QueryDynamicBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicBalance not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAccountsByOwner(ctx context.Context, req *QueryPaymentAccountsByOwnerRequest) (*QueryPaymentAccountsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAccountsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) AutoSettleRecords(ctx context.Context, req *QueryAutoSettleRecordsRequest) (*QueryAutoSettleRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleRecords not implemented")
+}
+func (*UnimplementedQueryServer) AutoSettleQueue(ctx context.Context, req *QueryAutoSettleQueueRequest) (*QueryAutoSettleQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoSettleQueue not implemented")
+}
+func (*UnimplementedQueryServer) AutoResumeQueue(ctx context.Context, req *QueryAutoResumeQueueRequest) (*QueryAutoResumeQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoResumeQueue not implemented")
+}
+func (*UnimplementedQueryServer) DelayedWithdrawal(ctx context.Context, req *QueryDelayedWithdrawalRequest) (*QueryDelayedWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedWithdrawal not implemented")
+}
+func (*UnimplementedQueryServer) BillingStatement(ctx context.Context, req *QueryBillingStatementRequest) (*QueryBillingStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BillingStatement not implemented")
+}
+func (*UnimplementedQueryServer) PrepaidPlan(ctx context.Context, req *QueryPrepaidPlanRequest) (*QueryPrepaidPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepaidPlan not implemented")
 }
 
-func (m *QueryPaymentAccountCountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPaymentAccountCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PaymentAccountCounts) > 0 {
-		for iNdEx := len(m.PaymentAccountCounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentAccountCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ParamsByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByTimestampRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/ParamsByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByTimestamp(ctx, req.(*QueryParamsByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+func _Query_OutFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/OutFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutFlows(ctx, req.(*QueryOutFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StreamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStreamRecordRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/StreamRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecord(ctx, req.(*QueryGetStreamRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StreamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/StreamRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRecords(ctx, req.(*QueryStreamRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentAccount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_PaymentAccountCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccountCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountCount(ctx, req.(*QueryPaymentAccountCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccountCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountCountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccountCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountCounts(ctx, req.(*QueryPaymentAccountCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PaymentAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccount(ctx, req.(*QueryPaymentAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_PaymentAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccounts(ctx, req.(*QueryPaymentAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DynamicBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPaymentAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicBalance(ctx, in)
 	}
-	if len(m.PaymentAccounts) > 0 {
-		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/DynamicBalance",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicBalance(ctx, req.(*QueryDynamicBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentAccountsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAccountsByOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDynamicBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAccountsByOwner(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PaymentAccountsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAccountsByOwner(ctx, req.(*QueryPaymentAccountsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AutoSettleRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoSettleRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDynamicBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDynamicBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ChangeRate.Size()
-		i -= size
-		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).AutoSettleRecords(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LockedFee.Size()
-		i -= size
-		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/AutoSettleRecords",
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.AvailableBalance.Size()
-		i -= size
-		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoSettleRecords(ctx, req.(*QueryAutoSettleRecordsRequest))
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BankBalance.Size()
-		i -= size
-		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoSettleQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoSettleQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.CurrentTimestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTimestamp))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).AutoSettleQueue(ctx, in)
 	}
-	{
-		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/AutoSettleQueue",
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DynamicBalance.Size()
-		i -= size
-		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoSettleQueue(ctx, req.(*QueryAutoSettleQueueRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AutoResumeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoResumeQueueRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AutoResumeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/AutoResumeQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoResumeQueue(ctx, req.(*QueryAutoResumeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DelayedWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/DelayedWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedWithdrawal(ctx, req.(*QueryDelayedWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func _Query_BillingStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBillingStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BillingStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/BillingStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BillingStatement(ctx, req.(*QueryBillingStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrepaidPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrepaidPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrepaidPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.payment.Query/PrepaidPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrepaidPlan(ctx, req.(*QueryPrepaidPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.payment.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByTimestamp",
+			Handler:    _Query_ParamsByTimestamp_Handler,
+		},
+		{
+			MethodName: "OutFlows",
+			Handler:    _Query_OutFlows_Handler,
+		},
+		{
+			MethodName: "StreamRecord",
+			Handler:    _Query_StreamRecord_Handler,
+		},
+		{
+			MethodName: "StreamRecords",
+			Handler:    _Query_StreamRecords_Handler,
+		},
+		{
+			MethodName: "PaymentAccountCount",
+			Handler:    _Query_PaymentAccountCount_Handler,
+		},
+		{
+			MethodName: "PaymentAccountCounts",
+			Handler:    _Query_PaymentAccountCounts_Handler,
+		},
+		{
+			MethodName: "PaymentAccount",
+			Handler:    _Query_PaymentAccount_Handler,
+		},
+		{
+			MethodName: "PaymentAccounts",
+			Handler:    _Query_PaymentAccounts_Handler,
+		},
+		{
+			MethodName: "DynamicBalance",
+			Handler:    _Query_DynamicBalance_Handler,
+		},
+		{
+			MethodName: "PaymentAccountsByOwner",
+			Handler:    _Query_PaymentAccountsByOwner_Handler,
+		},
+		{
+			MethodName: "AutoSettleRecords",
+			Handler:    _Query_AutoSettleRecords_Handler,
+		},
+		{
+			MethodName: "AutoSettleQueue",
+			Handler:    _Query_AutoSettleQueue_Handler,
+		},
+		{
+			MethodName: "AutoResumeQueue",
+			Handler:    _Query_AutoResumeQueue_Handler,
+		},
+		{
+			MethodName: "DelayedWithdrawal",
+			Handler:    _Query_DelayedWithdrawal_Handler,
+		},
+		{
+			MethodName: "BillingStatement",
+			Handler:    _Query_BillingStatement_Handler,
+		},
+		{
+			MethodName: "PrepaidPlan",
+			Handler:    _Query_PrepaidPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/payment/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2672,29 +2707,30 @@ func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PaymentAccounts[iNdEx])
-			copy(dAtA[i:], m.PaymentAccounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccounts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2704,32 +2740,25 @@ func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAutoSettleRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByTimestampResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2739,32 +2768,83 @@ func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByTimestampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.AutoSettleRecords) > 0 {
-		for iNdEx := len(m.AutoSettleRecords) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutFlows) > 0 {
+		for iNdEx := len(m.OutFlows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AutoSettleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2778,7 +2858,7 @@ func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetStreamRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2788,12 +2868,12 @@ func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelayedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2808,7 +2888,7 @@ func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetStreamRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2818,18 +2898,18 @@ func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetStreamRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.DelayedWithdrawal.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2841,384 +2921,2662 @@ func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStreamRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStreamRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByTimestampResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOutFlowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStreamRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutFlowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStreamRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutFlows) > 0 {
-		for _, e := range m.OutFlows {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.StreamRecords) > 0 {
+		for iNdEx := len(m.StreamRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetStreamRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetStreamRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StreamRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStreamRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStreamRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.StreamRecords) > 0 {
-		for _, e := range m.StreamRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PaymentAccountCount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PaymentAccountCount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountCountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountCountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PaymentAccountCounts) > 0 {
-		for _, e := range m.PaymentAccountCounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PaymentAccountCounts) > 0 {
+		for iNdEx := len(m.PaymentAccountCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentAccountCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PaymentAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPaymentAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for _, e := range m.PaymentAccounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDynamicBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPaymentAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDynamicBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DynamicBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.StreamRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.CurrentTimestamp != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTimestamp))
+	{
+		size, err := m.PaymentAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.BankBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AvailableBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LockedFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ChangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentAccountsByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentAccountsByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PaymentAccounts) > 0 {
-		for _, s := range m.PaymentAccounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryPaymentAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAutoSettleRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAutoSettleRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AutoSettleRecords) > 0 {
-		for _, e := range m.AutoSettleRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDelayedWithdrawalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentAccounts) > 0 {
+		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChangeRate.Size()
+		i -= size
+		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LockedFee.Size()
+		i -= size
+		if _, err := m.LockedFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AvailableBalance.Size()
+		i -= size
+		if _, err := m.AvailableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BankBalance.Size()
+		i -= size
+		if _, err := m.BankBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CurrentTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.StreamRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DynamicBalance.Size()
+		i -= size
+		if _, err := m.DynamicBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for iNdEx := len(m.PaymentAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PaymentAccounts[iNdEx])
+			copy(dAtA[i:], m.PaymentAccounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoSettleRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoSettleRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AutoSettleRecords) > 0 {
+		for iNdEx := len(m.AutoSettleRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoSettleRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoSettleQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoSettleQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoSettleQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoSettleQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoSettleQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoSettleQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoSettleQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoResumeQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoResumeQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoResumeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoResumeQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoResumeQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoResumeQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutFlowsAhead != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutFlowsAhead))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RemainingRate.Size()
+		i -= size
+		if _, err := m.RemainingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RemainingOutFlowCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingOutFlowCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoResumeQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoResumeQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoResumeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAutoResumeFlowCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAutoResumeFlowCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelayedWithdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingStatementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingStatementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingStatementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBillingStatementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBillingStatementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBillingStatementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BucketTotals) > 0 {
+		for iNdEx := len(m.BucketTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BucketTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DestinationTotals) > 0 {
+		for iNdEx := len(m.DestinationTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrepaidPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrepaidPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrepaidPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrepaidPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrepaidPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrepaidPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PrepaidPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryParamsByTimestampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutFlows) > 0 {
+		for _, e := range m.OutFlows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetStreamRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStreamRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StreamRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamRecords) > 0 {
+		for _, e := range m.StreamRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAccountCount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccountCounts) > 0 {
+		for _, e := range m.PaymentAccountCounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for _, e := range m.PaymentAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDynamicBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDynamicBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DynamicBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StreamRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTimestamp))
+	}
+	l = m.BankBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AvailableBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentAccountsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentAccountsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentAccounts) > 0 {
+		for _, s := range m.PaymentAccounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoSettleRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoSettleRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoSettleRecords) > 0 {
+		for _, e := range m.AutoSettleRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoSettleQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoSettleQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	return n
+}
+
+func (m *QueryAutoSettleQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoResumeQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoResumeQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.RemainingOutFlowCount != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingOutFlowCount))
+	}
+	l = m.RemainingRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OutFlowsAhead != 0 {
+		n += 1 + sovQuery(uint64(m.OutFlowsAhead))
+	}
+	return n
+}
+
+func (m *QueryAutoResumeQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxAutoResumeFlowCount != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAutoResumeFlowCount))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DelayedWithdrawal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBillingStatementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBillingStatementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DestinationTotals) > 0 {
+		for _, e := range m.DestinationTotals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if len(m.BucketTotals) > 0 {
+		for _, e := range m.BucketTotals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrepaidPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPrepaidPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PrepaidPlan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutFlows = append(m.OutFlows, OutFlow{})
+			if err := m.OutFlows[len(m.OutFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStreamRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStreamRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStreamRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStreamRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamRecords = append(m.StreamRecords, StreamRecord{})
+			if err := m.StreamRecords[len(m.StreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryDelayedWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.DelayedWithdrawal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,12 +5599,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentAccountCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3268,7 +5659,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,15 +5682,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3326,7 +5717,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3351,7 +5745,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3374,17 +5768,17 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCounts", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3394,11 +5788,62 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccountCounts = append(m.PaymentAccountCounts, PaymentAccountCount{})
+			if err := m.PaymentAccountCounts[len(m.PaymentAccountCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3420,7 +5865,7 @@ func (m *QueryParamsByTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3443,17 +5888,17 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByTimestampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3463,24 +5908,23 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3503,7 +5947,7 @@ func (m *QueryParamsByTimestampResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3526,17 +5970,17 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutFlowsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3546,23 +5990,24 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := m.PaymentAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3585,7 +6030,7 @@ func (m *QueryOutFlowsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,15 +6053,15 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutFlowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutFlows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3643,8 +6088,10 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutFlows = append(m.OutFlows, OutFlow{})
-			if err := m.OutFlows[len(m.OutFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3669,7 +6116,7 @@ func (m *QueryOutFlowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3692,17 +6139,17 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStreamRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStreamRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3712,23 +6159,61 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAccounts = append(m.PaymentAccounts, PaymentAccount{})
+			if err := m.PaymentAccounts[len(m.PaymentAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3751,7 +6236,7 @@ func (m *QueryGetStreamRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3774,17 +6259,17 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStreamRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDynamicBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStreamRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDynamicBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3794,24 +6279,23 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3834,7 +6318,7 @@ func (m *QueryGetStreamRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3857,15 +6341,49 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDynamicBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDynamicBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3892,68 +6410,102 @@ func (m *QueryStreamRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTimestamp", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.CurrentTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamRecordsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3963,31 +6515,31 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamRecords = append(m.StreamRecords, StreamRecord{})
-			if err := m.StreamRecords[len(m.StreamRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3997,25 +6549,23 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4040,7 +6590,7 @@ func (m *QueryStreamRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4063,10 +6613,10 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4122,7 +6672,7 @@ func (m *QueryPaymentAccountCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4145,17 +6695,17 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4165,24 +6715,23 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentAccountCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PaymentAccounts = append(m.PaymentAccounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4205,7 +6754,7 @@ func (m *QueryPaymentAccountCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4228,10 +6777,10 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4291,7 +6840,7 @@ func (m *QueryPaymentAccountCountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4314,15 +6863,15 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccountCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4349,8 +6898,8 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccountCounts = append(m.PaymentAccountCounts, PaymentAccountCount{})
-			if err := m.PaymentAccountCounts[len(m.PaymentAccountCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoSettleRecords = append(m.AutoSettleRecords, AutoSettleRecord{})
+			if err := m.AutoSettleRecords[len(m.AutoSettleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4411,7 +6960,7 @@ func (m *QueryPaymentAccountCountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4434,15 +6983,15 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4470,61 +7019,11 @@ func (m *QueryPaymentAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4551,7 +7050,10 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4576,7 +7078,7 @@ func (m *QueryPaymentAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *AutoSettleQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4599,15 +7101,15 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoSettleQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoSettleQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4634,13 +7136,29 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4662,7 +7180,7 @@ func (m *QueryPaymentAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoSettleQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4685,15 +7203,15 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoSettleQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoSettleQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4720,8 +7238,8 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccounts = append(m.PaymentAccounts, PaymentAccount{})
-			if err := m.PaymentAccounts[len(m.PaymentAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, AutoSettleQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4782,7 +7300,7 @@ func (m *QueryPaymentAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoResumeQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4805,10 +7323,10 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDynamicBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoResumeQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDynamicBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoResumeQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4843,6 +7361,42 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4864,7 +7418,7 @@ func (m *QueryDynamicBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *AutoResumeQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4887,17 +7441,17 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDynamicBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoResumeQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDynamicBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoResumeQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4907,31 +7461,30 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DynamicBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamRecord", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			var msglen int
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4941,30 +7494,16 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Position |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StreamRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOutFlowCount", wireType)
 			}
-			m.CurrentTimestamp = 0
+			m.RemainingOutFlowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4974,14 +7513,14 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentTimestamp |= int64(b&0x7F) << shift
+				m.RemainingOutFlowCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5009,15 +7548,84 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BankBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemainingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutFlowsAhead", wireType)
+			}
+			m.OutFlowsAhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutFlowsAhead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoResumeQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoResumeQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoResumeQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5027,31 +7635,31 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AvailableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, AutoResumeQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5061,31 +7669,33 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoResumeFlowCount", wireType)
 			}
-			var stringLen uint64
+			m.MaxAutoResumeFlowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5095,26 +7705,11 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxAutoResumeFlowCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5136,7 +7731,7 @@ func (m *QueryDynamicBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5159,15 +7754,15 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5195,7 +7790,7 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5218,7 +7813,7 @@ func (m *QueryPaymentAccountsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5241,17 +7836,17 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentAccountsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5261,23 +7856,24 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAccounts = append(m.PaymentAccounts, string(dAtA[iNdEx:postIndex]))
+			if err := m.DelayedWithdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5300,7 +7896,7 @@ func (m *QueryPaymentAccountsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingStatementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,13 +7919,83 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBillingStatementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBillingStatementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5386,7 +8052,7 @@ func (m *QueryAutoSettleRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBillingStatementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5409,15 +8075,15 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBillingStatementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAutoSettleRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBillingStatementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettleRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5444,12 +8110,99 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AutoSettleRecords = append(m.AutoSettleRecords, AutoSettleRecord{})
-			if err := m.AutoSettleRecords[len(m.AutoSettleRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Periods = append(m.Periods, BillingStatementPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationTotals = append(m.DestinationTotals, BillingStatementLine{})
+			if err := m.DestinationTotals[len(m.DestinationTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketTotals = append(m.BucketTotals, BillingStatementBucketLine{})
+			if err := m.BucketTotals[len(m.BucketTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5506,7 +8259,7 @@ func (m *QueryAutoSettleRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPrepaidPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5529,17 +8282,17 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrepaidPlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrepaidPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5549,24 +8302,11 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5588,7 +8328,7 @@ func (m *QueryDelayedWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPrepaidPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5611,15 +8351,15 @@ func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrepaidPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelayedWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrepaidPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedWithdrawal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidPlan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5646,7 +8386,7 @@ func (m *QueryDelayedWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelayedWithdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrepaidPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,10 +12,20 @@ var (
 	ValidatorTaxPoolAddress = sdk.AccAddress(address.Module(ModuleName, []byte("validator-tax-pool"))[:sdk.EthAddressLength])
 )
 
+// PrepaidPlanAddress returns the virtual address which holds the prepaid balance of the plan
+func PrepaidPlanAddress(id uint64) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(ModuleName, append([]byte("prepaid-plan"), sdk.Uint64ToBigEndian(id)...))[:sdk.EthAddressLength])
+}
+
 const (
 	ForceUpdateStreamRecordKey = "force_update_stream_record"
 )
 
+const (
+	// MaxPrunedBillingStatementCount is the maximum number of expired billing statement lines pruned in one block
+	MaxPrunedBillingStatementCount = 100
+)
+
 const (
 	// GovernanceAddressLackBalanceLabel is the metrics label to notify that the governance account has no enough balance
 	GovernanceAddressLackBalanceLabel = "governance_address_lack_balance"
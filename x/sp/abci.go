@@ -14,6 +14,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		k.ForceUpdateMaintenanceRecords(ctx)
 	}
 
+	k.ApplyScheduledSpStoragePrices(ctx)
+	k.UnlockDepositUnbondings(ctx)
+
 	needUpdate := false
 	price, err := k.GetGlobalSpStorePriceByTime(ctx, ctx.BlockTime().Unix()+1)
 	if err != nil { // no global price yet
@@ -14,6 +14,7 @@ const (
 	FlagCreator            = "creator"
 	FlagExpiration         = "expiration"
 	FlagEndpoint           = "endpoint"
+	FlagFreeCapacity       = "free-capacity"
 
 	FlagMoniker     = "moniker"
 	FlagEditMoniker = "new-moniker"
@@ -28,6 +29,10 @@ const (
 	FlagStorePrice    = "store-price"
 	FlagFreeReadQuota = "free-read-quota"
 
+	FlagStorePriceTiers        = "store-price-tiers"
+	FlagReplicaStorePriceTiers = "replica-store-price-tiers"
+	FlagEffectiveTime          = "effective-time"
+
 	FlagSecurityContact = "security-contact"
 
 	FlagDuration = "duration"
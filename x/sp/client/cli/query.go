@@ -31,6 +31,10 @@ func GetQueryCmd() *cobra.Command {
 		CmdMaintenanceRecordsBySPOperatorAddress(),
 		CmdStorageProviderPrice(),
 		CmdStorageProviderGlobalPrice(),
+		CmdStorePriceTiers(),
+		CmdScheduledSpStoragePrices(),
+		CmdStorageProviderScorecard(),
+		CmdStorageProvidersByScore(),
 	)
 
 	return cmd
@@ -230,3 +234,131 @@ func CmdStorageProviderGlobalPrice() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdStorePriceTiers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-price-tiers [timestamp]",
+		Short: "Query the global store price tiers at a specific time(in unix), return latest tiers if timestamp is 0",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			ts, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).
+				StorePriceTiers(cmd.Context(), &types.QueryStorePriceTiersRequest{
+					Timestamp: ts,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdScheduledSpStoragePrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-prices",
+		Short: "Query the upcoming price changes scheduled by storage providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := types.NewQueryClient(clientCtx).
+				ScheduledSpStoragePrices(cmd.Context(), &types.QueryScheduledSpStoragePricesRequest{
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
+
+func CmdStorageProviderScorecard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scorecard [sp-id]",
+		Short: "Query the scorecard of the storage provider with specify sp id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryStorageProviderScorecardRequest{
+				Id: uint32(spID),
+			}
+
+			res, err := queryClient.StorageProviderScorecard(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStorageProvidersByScore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-providers-by-score",
+		Short: "Query the scorecards of all storage providers sorted by score",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryStorageProvidersByScoreRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.StorageProvidersByScore(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
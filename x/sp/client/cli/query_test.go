@@ -45,6 +45,48 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryStorageProviderResponse{},
 		},
+		{
+			"query scorecard",
+			append(
+				[]string{
+					"scorecard",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryStorageProviderScorecardResponse{},
+		},
+		{
+			"query storage-providers-by-score",
+			append(
+				[]string{
+					"storage-providers-by-score",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryStorageProvidersByScoreResponse{},
+		},
+		{
+			"query store-price-tiers",
+			append(
+				[]string{
+					"store-price-tiers",
+					"0",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryStorePriceTiersResponse{},
+		},
+		{
+			"query scheduled-prices",
+			append(
+				[]string{
+					"scheduled-prices",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryScheduledSpStoragePricesResponse{},
+		},
 		{
 			"query storage-provider-by-operator-address",
 			append(
@@ -19,6 +19,7 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/bnb-chain/greenfield/types/common"
 	"github.com/bnb-chain/greenfield/x/sp/types"
 )
 
@@ -35,10 +36,12 @@ func GetTxCmd() *cobra.Command {
 	spTxCmd.AddCommand(
 		CmdCreateStorageProvider(),
 		CmdDeposit(),
+		CmdWithdrawDeposit(),
 		CmdEditStorageProvider(),
 		CmdGrantDepositAuthorization(),
 		CmdUpdateStorageProviderStatus(),
 		CmdUpdateStorageProviderStoragePrice(),
+		CmdUnjailStorageProvider(),
 	)
 
 	return spTxCmd
@@ -250,6 +253,14 @@ func CmdEditStorageProvider() *cobra.Command {
 				blsPubKey,
 				blsProof,
 			)
+			// free capacity
+			if cmd.Flags().Changed(FlagFreeCapacity) {
+				freeCapacity, err := cmd.Flags().GetUint64(FlagFreeCapacity)
+				if err != nil {
+					return err
+				}
+				msg.FreeCapacity = &common.UInt64Value{Value: freeCapacity}
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -271,6 +282,7 @@ func CmdEditStorageProvider() *cobra.Command {
 	cmd.Flags().String(FlagApprovalAddress, "", "The approval address of storage provider")
 	cmd.Flags().String(FlagGcAddress, "", "The gc address of storage provider")
 	cmd.Flags().String(FlagMaintenanceAddress, "", "The maintenance address of storage provider")
+	cmd.Flags().Uint64(FlagFreeCapacity, 0, "The free storage capacity(in bytes) the storage provider advertises")
 
 	return cmd
 }
@@ -319,6 +331,50 @@ func CmdDeposit() *cobra.Command {
 	return cmd
 }
 
+func CmdWithdrawDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-deposit [sp-address] [fund-address] [value]",
+		Short: "SP withdraw staked tokens to funding account after the unbonding period",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+
+			fundAddress, err := sdk.AccAddressFromHexUnsafe(args[1])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDeposit(
+				fundAddress,
+				spAddress,
+				coin,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdGrantDepositAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "grant <grantee> --from <granter>",
@@ -729,9 +785,16 @@ E.g. the price is 0.02183945725, means approximately $0.018 / GB / Month.
 
 The free-read-quota unit is bytes, for 1GB free quota, it is 1073741824.
 
+The store price tiers by the total charge size of a bucket can be provided by --store-price-tiers, each tier is in the
+format of min-charge-size:store-price[:secondary-store-price], and the tiers are separated by comma.
+
+The price change can be scheduled in advance by --effective-time(in unix), which should be at least the notice days
+in the params later than now.
+
 Examples:
  $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824
-	`, version.AppName, types.ModuleName),
+ $ %s tx %s update-price 0x... 0.1469890427 0.02183945725 1073741824 --store-price-tiers 1099511627776:0.018,12094627905536:0.015:0.1
+	`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
 		),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -755,11 +818,34 @@ Examples:
 			if err != nil {
 				return err
 			}
+			tiersStr, err := cmd.Flags().GetString(FlagStorePriceTiers)
+			if err != nil {
+				return err
+			}
+			tiers, err := parseStorePriceTiers(tiersStr, types.RedundancyTypeEC)
+			if err != nil {
+				return err
+			}
+			replicaTiersStr, err := cmd.Flags().GetString(FlagReplicaStorePriceTiers)
+			if err != nil {
+				return err
+			}
+			replicaTiers, err := parseStorePriceTiers(replicaTiersStr, types.RedundancyTypeReplica)
+			if err != nil {
+				return err
+			}
+			tiers = append(tiers, replicaTiers...)
+			effectiveTime, err := cmd.Flags().GetInt64(FlagEffectiveTime)
+			if err != nil {
+				return err
+			}
 			msg := types.MsgUpdateSpStoragePrice{
-				SpAddress:     spAddress.String(),
-				ReadPrice:     readPrice,
-				StorePrice:    storePrice,
-				FreeReadQuota: quota,
+				SpAddress:       spAddress.String(),
+				ReadPrice:       readPrice,
+				StorePrice:      storePrice,
+				FreeReadQuota:   quota,
+				StorePriceTiers: tiers,
+				EffectiveTime:   effectiveTime,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -768,5 +854,76 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagStorePriceTiers, "", "store price tiers of the ec objects, e.g. 1099511627776:0.018,12094627905536:0.015:0.1")
+	cmd.Flags().String(FlagReplicaStorePriceTiers, "", "store price tiers of the replica objects, in the same format as --store-price-tiers")
+	cmd.Flags().Int64(FlagEffectiveTime, 0, "the time(in unix) the price takes effect, 0 means the earliest time allowed")
+	return cmd
+}
+
+// parseStorePriceTiers parses the store price tiers of the redundancy type in the format of
+// min-charge-size:store-price[:secondary-store-price],...
+func parseStorePriceTiers(tiersStr string, redundancyType int32) ([]types.StorePriceTier, error) {
+	tiers := make([]types.StorePriceTier, 0)
+	if strings.TrimSpace(tiersStr) == "" {
+		return tiers, nil
+	}
+	for _, tierStr := range strings.Split(tiersStr, ",") {
+		parts := strings.Split(strings.TrimSpace(tierStr), ":")
+		if len(parts) != 2 && len(parts) != 3 {
+			return nil, fmt.Errorf("invalid store price tier %s", tierStr)
+		}
+		minChargeSize, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		tier := types.StorePriceTier{
+			MinChargeSize:       minChargeSize,
+			SecondaryStorePrice: sdk.ZeroDec(),
+			RedundancyType:      redundancyType,
+		}
+		tier.StorePrice, err = sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) == 3 {
+			tier.SecondaryStorePrice, err = sdk.NewDecFromStr(parts[2])
+			if err != nil {
+				return nil, err
+			}
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, nil
+}
+
+func CmdUnjailStorageProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail [sp-address]",
+		Short: "Unjail a jailed storage provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`unjail the storage provider after the unjail cooldown, the total deposit of the storage provider should not be less than the min deposit.
+
+Examples:
+ $ %s tx %s unjail 0x...
+	`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			spAddress, err := sdk.AccAddressFromHexUnsafe(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUnjailStorageProvider(spAddress)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
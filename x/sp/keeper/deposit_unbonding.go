@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSlashExposure returns the amount the sp can still be slashed by challenges
+func (k Keeper) GetSlashExposure(ctx sdk.Context, spId uint32) sdkmath.Int {
+	if k.challengeKeeper == nil {
+		return sdkmath.ZeroInt()
+	}
+	return k.challengeKeeper.GetSpSlashExposure(ctx, spId)
+}
+
+// GetDepositUnbonding returns the withdrawn deposit of the sp which is unlocked at the unlock time
+func (k Keeper) GetDepositUnbonding(ctx sdk.Context, unlockTime int64, spId uint32) (types.DepositUnbonding, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepositUnbondingQueueKeyPrefix)
+	bz := store.Get(types.DepositUnbondingQueueKey(unlockTime, spId))
+	if bz == nil {
+		return types.DepositUnbonding{}, false
+	}
+	var unbonding types.DepositUnbonding
+	k.cdc.MustUnmarshal(bz, &unbonding)
+	return unbonding, true
+}
+
+// AddDepositUnbonding puts the withdrawn deposit into the unbonding queue,
+// it is merged with the one of the same sp which is unlocked at the same time.
+func (k Keeper) AddDepositUnbonding(ctx sdk.Context, unbonding types.DepositUnbonding) {
+	if existing, found := k.GetDepositUnbonding(ctx, unbonding.UnlockTime, unbonding.SpId); found {
+		unbonding.Amount = unbonding.Amount.Add(existing.Amount)
+	}
+	k.setDepositUnbonding(ctx, unbonding)
+}
+
+// setDepositUnbonding sets the withdrawn deposit in the unbonding queue, and indexes it by the sp id
+func (k Keeper) setDepositUnbonding(ctx sdk.Context, unbonding types.DepositUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.DepositUnbondingQueueKeyPrefix).Set(types.DepositUnbondingQueueKey(unbonding.UnlockTime, unbonding.SpId),
+		k.cdc.MustMarshal(&unbonding))
+	prefix.NewStore(store, types.DepositUnbondingBySpKeyPrefix).Set(types.DepositUnbondingBySpKey(unbonding.SpId, unbonding.UnlockTime),
+		[]byte{})
+}
+
+// removeDepositUnbonding removes the withdrawn deposit from the unbonding queue and its index
+func (k Keeper) removeDepositUnbonding(ctx sdk.Context, unlockTime int64, spId uint32) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.DepositUnbondingQueueKeyPrefix).Delete(types.DepositUnbondingQueueKey(unlockTime, spId))
+	prefix.NewStore(store, types.DepositUnbondingBySpKeyPrefix).Delete(types.DepositUnbondingBySpKey(spId, unlockTime))
+}
+
+// GetDepositUnbondingsBySp returns the withdrawn deposit of the sp which is still unbonding, ordered by the unlock time
+func (k Keeper) GetDepositUnbondingsBySp(ctx sdk.Context, spId uint32) []types.DepositUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepositUnbondingBySpKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DepositUnbondingBySpPrefix(spId))
+	defer iterator.Close()
+
+	unbondings := make([]types.DepositUnbonding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		unlockTime := int64(binary.BigEndian.Uint64(iterator.Key()[4:]))
+		if unbonding, found := k.GetDepositUnbonding(ctx, unlockTime, spId); found {
+			unbondings = append(unbondings, unbonding)
+		}
+	}
+	return unbondings
+}
+
+// GetSlashableDeposit returns the deposit of the sp which can be slashed, including the withdrawn deposit which is
+// still unbonding
+func (k Keeper) GetSlashableDeposit(ctx sdk.Context, sp *types.StorageProvider) sdkmath.Int {
+	slashable := sp.TotalDeposit
+	for _, unbonding := range k.GetDepositUnbondingsBySp(ctx, sp.Id) {
+		slashable = slashable.Add(unbonding.Amount)
+	}
+	return slashable
+}
+
+// deductSlashedDeposit deducts the slashed amount from the withdrawn deposit of the sp which is still unbonding
+// first, the ones unlocked earlier first, and then from the total deposit, so that a withdrawal does not escape
+// the slashes during the unbonding period. The amount should not exceed the slashable deposit, and the sp is not saved.
+func (k Keeper) deductSlashedDeposit(ctx sdk.Context, sp *types.StorageProvider, amount sdkmath.Int) {
+	left := amount
+	for _, unbonding := range k.GetDepositUnbondingsBySp(ctx, sp.Id) {
+		if !left.IsPositive() {
+			break
+		}
+		deducted := sdkmath.MinInt(left, unbonding.Amount)
+		unbonding.Amount = unbonding.Amount.Sub(deducted)
+		left = left.Sub(deducted)
+		if unbonding.Amount.IsZero() {
+			k.removeDepositUnbonding(ctx, unbonding.UnlockTime, unbonding.SpId)
+		} else {
+			k.setDepositUnbonding(ctx, unbonding)
+		}
+	}
+	sp.TotalDeposit = sp.TotalDeposit.Sub(left)
+}
+
+// UnlockDepositUnbondings sends the withdrawn deposit back to the funding addresses when the unbonding period ends
+func (k Keeper) UnlockDepositUnbondings(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepositUnbondingQueueKeyPrefix)
+	iterator := store.Iterator(nil, types.DepositUnbondingQueueKey(ctx.BlockTime().Unix()+1, 0))
+
+	unbondings := make([]types.DepositUnbonding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var unbonding types.DepositUnbonding
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+	iterator.Close()
+
+	denom := k.DepositDenomForSP(ctx)
+	for _, unbonding := range unbondings {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, unbonding.Amount))
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromHex(unbonding.FundingAddress), coins)
+		if err != nil {
+			ctx.Logger().Error("fail to unlock the withdrawn deposit", "sp", unbonding.SpId, "err", err)
+			continue
+		}
+		k.removeDepositUnbonding(ctx, unbonding.UnlockTime, unbonding.SpId)
+		if err = ctx.EventManager().EmitTypedEvents(&types.EventDepositUnlocked{
+			SpId:           unbonding.SpId,
+			FundingAddress: unbonding.FundingAddress,
+			Amount:         coins.String(),
+		}); err != nil {
+			ctx.Logger().Error("fail to emit deposit unlocked event", "sp", unbonding.SpId, "err", err)
+		}
+	}
+}
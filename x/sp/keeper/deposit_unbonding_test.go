@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/keeper"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestWithdrawDeposit() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000000, 0))
+	params := k.GetParams(ctx)
+
+	challengeKeeper := types.NewMockChallengeKeeper(gomock.NewController(s.T()))
+	challengeKeeper.EXPECT().GetSpSlashExposure(gomock.Any(), gomock.Any()).Return(sdkmath.NewInt(100)).AnyTimes()
+	k.SetChallengeKeeper(challengeKeeper)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	spAcc := sample.RandAccAddress()
+	fundingAcc := sample.RandAccAddress()
+	sp := &types.StorageProvider{
+		Id:              1,
+		OperatorAddress: spAcc.String(),
+		FundingAddress:  fundingAcc.String(),
+		Status:          types.STATUS_IN_SERVICE,
+		TotalDeposit:    params.MinDeposit.AddRaw(1000),
+	}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByFundingAddr(ctx, sp)
+
+	// the remaining deposit should cover the min deposit and the slash exposure
+	msg := types.NewMsgWithdrawDeposit(fundingAcc, spAcc, sdk.NewCoin(params.DepositDenom, sdkmath.NewInt(901)))
+	_, err := msgServer.WithdrawDeposit(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientDepositAmount)
+
+	msg.Withdraw.Amount = sdkmath.NewInt(400)
+	_, err = msgServer.WithdrawDeposit(ctx, msg)
+	s.Require().NoError(err)
+	_, err = msgServer.WithdrawDeposit(ctx, msg)
+	s.Require().NoError(err)
+
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(params.MinDeposit.AddRaw(200), sp.TotalDeposit)
+	unlockTime := ctx.BlockTime().Unix() + params.DepositUnbondingPeriod
+	unbonding, found := k.GetDepositUnbonding(ctx, unlockTime, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(800), unbonding.Amount)
+
+	// the deposit is locked before the unlock time
+	k.UnlockDepositUnbondings(ctx.WithBlockTime(time.Unix(unlockTime-1, 0)))
+	_, found = k.GetDepositUnbonding(ctx, unlockTime, sp.Id)
+	s.Require().True(found)
+
+	// the withdrawn deposit is slashed first during the unbonding period
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		Return(nil).AnyTimes()
+	s.Require().Equal(params.MinDeposit.AddRaw(1000), k.GetSlashableDeposit(ctx, sp))
+	err = k.SlashToModule(ctx, sp.Id, "challenge", sdk.NewCoin(params.DepositDenom, sdkmath.NewInt(300)))
+	s.Require().NoError(err)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(params.MinDeposit.AddRaw(200), sp.TotalDeposit)
+	unbonding, _ = k.GetDepositUnbonding(ctx, unlockTime, sp.Id)
+	s.Require().Equal(sdkmath.NewInt(500), unbonding.Amount)
+
+	// the slash exceeding the withdrawn deposit is deducted from the total deposit
+	err = k.SlashToModule(ctx, sp.Id, "challenge", sdk.NewCoin(params.DepositDenom, sdkmath.NewInt(600)))
+	s.Require().NoError(err)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().Equal(params.MinDeposit.AddRaw(100), sp.TotalDeposit)
+	_, found = k.GetDepositUnbonding(ctx, unlockTime, sp.Id)
+	s.Require().False(found)
+
+	// withdraw again and unlock it
+	sp.TotalDeposit = params.MinDeposit.AddRaw(900)
+	k.SetStorageProvider(ctx, sp)
+	msg.Withdraw.Amount = sdkmath.NewInt(800)
+	_, err = msgServer.WithdrawDeposit(ctx, msg)
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, fundingAcc,
+		sdk.NewCoins(sdk.NewCoin(params.DepositDenom, sdkmath.NewInt(800)))).Return(nil)
+	k.UnlockDepositUnbondings(ctx.WithBlockTime(time.Unix(unlockTime, 0)))
+	_, found = k.GetDepositUnbonding(ctx, unlockTime, sp.Id)
+	s.Require().False(found)
+	s.Require().Empty(k.GetDepositUnbondingsBySp(ctx, sp.Id))
+
+	// jailed sp is not allowed to withdraw
+	sp.Status = types.STATUS_IN_JAILED
+	k.SetStorageProvider(ctx, sp)
+	msg.Withdraw.Amount = sdkmath.NewInt(1)
+	_, err = msgServer.WithdrawDeposit(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrStorageProviderWithdrawNotAllow)
+}
+
+func (s *KeeperTestSuite) TestGetDepositUnbondingsBySp() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	fundingAddress := sample.RandAccAddress().String()
+	for _, unbonding := range []types.DepositUnbonding{
+		{SpId: 2, FundingAddress: fundingAddress, Amount: sdkmath.NewInt(1), UnlockTime: 1200},
+		{SpId: 1, FundingAddress: fundingAddress, Amount: sdkmath.NewInt(2), UnlockTime: 1100},
+		{SpId: 256, FundingAddress: fundingAddress, Amount: sdkmath.NewInt(3), UnlockTime: 1000},
+		{SpId: 2, FundingAddress: fundingAddress, Amount: sdkmath.NewInt(4), UnlockTime: 1100},
+	} {
+		k.AddDepositUnbonding(ctx, unbonding)
+	}
+
+	unbondings := k.GetDepositUnbondingsBySp(ctx, 2)
+	s.Require().Len(unbondings, 2)
+	s.Require().Equal(int64(1100), unbondings[0].UnlockTime)
+	s.Require().Equal(int64(1200), unbondings[1].UnlockTime)
+	s.Require().Len(k.GetDepositUnbondingsBySp(ctx, 1), 1)
+	s.Require().Len(k.GetDepositUnbondingsBySp(ctx, 256), 1)
+	s.Require().Empty(k.GetDepositUnbondingsBySp(ctx, 3))
+
+	// the index is removed along with the unlocked deposit
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		Return(nil).Times(3)
+	k.UnlockDepositUnbondings(ctx.WithBlockTime(time.Unix(1100, 0)))
+	unbondings = k.GetDepositUnbondingsBySp(ctx, 2)
+	s.Require().Len(unbondings, 1)
+	s.Require().Equal(int64(1200), unbondings[0].UnlockTime)
+	s.Require().Empty(k.GetDepositUnbondingsBySp(ctx, 1))
+	s.Require().Empty(k.GetDepositUnbondingsBySp(ctx, 256))
+}
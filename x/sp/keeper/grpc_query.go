@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"math/rand"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -78,6 +79,52 @@ func (k Keeper) QueryGlobalSpStorePriceByTime(goCtx context.Context, req *types.
 	return &types.QueryGlobalSpStorePriceByTimeResponse{GlobalSpStorePrice: price}, nil
 }
 
+func (k Keeper) StorePriceTiers(goCtx context.Context, req *types.QueryStorePriceTiersRequest) (*types.QueryStorePriceTiersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Timestamp < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid timestamp")
+	}
+	if req.Timestamp == 0 {
+		req.Timestamp = ctx.BlockTime().Unix() + 1
+	}
+
+	price, err := k.GetGlobalSpStorePriceByTime(ctx, req.Timestamp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "err: %s", err)
+	}
+	return &types.QueryStorePriceTiersResponse{
+		UpdateTimeSec: price.UpdateTimeSec,
+		Tiers:         price.GetAllStorePriceTiers(),
+	}, nil
+}
+
+func (k Keeper) ScheduledSpStoragePrices(goCtx context.Context, req *types.QueryScheduledSpStoragePricesRequest) (*types.QueryScheduledSpStoragePricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledSpStoragePriceQueueKeyPrefix)
+
+	var scheduledPrices []types.ScheduledSpStoragePrice
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key []byte, _ []byte) error {
+		_, spId := types.ParseScheduledSpStoragePriceQueueKey(key)
+		scheduled, found := k.GetScheduledSpStoragePrice(ctx, spId)
+		if found {
+			scheduledPrices = append(scheduledPrices, scheduled)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledSpStoragePricesResponse{ScheduledPrices: scheduledPrices, Pagination: pageRes}, nil
+}
+
 func (k Keeper) StorageProvider(goCtx context.Context, req *types.QueryStorageProviderRequest) (*types.QueryStorageProviderResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -138,3 +185,62 @@ func (k Keeper) StorageProviderMaintenanceRecordsByOperatorAddress(goCtx context
 	}
 	return &types.QueryStorageProviderMaintenanceRecordsResponse{Records: records}, nil
 }
+
+func (k Keeper) StorageProviderScorecard(goCtx context.Context, req *types.QueryStorageProviderScorecardRequest) (*types.QueryStorageProviderScorecardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetStorageProvider(ctx, req.Id); !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+	return &types.QueryStorageProviderScorecardResponse{Scorecard: k.GetSpScorecard(ctx, req.Id)}, nil
+}
+
+func (k Keeper) StorageProvidersByScore(goCtx context.Context, req *types.QueryStorageProvidersByScoreRequest) (*types.QueryStorageProvidersByScoreResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	offset, limit := uint64(0), uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) != 0 {
+			return nil, status.Error(codes.InvalidArgument, "key pagination is not supported, use offset instead")
+		}
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit != 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the number of storage providers is small, so the scorecards are sorted in memory
+	sps := k.GetAllStorageProviders(ctx)
+	scorecards := make([]types.SpScorecard, 0, len(sps))
+	for _, sp := range sps {
+		scorecards = append(scorecards, k.GetSpScorecard(ctx, sp.Id))
+	}
+	sort.Slice(scorecards, func(i, j int) bool {
+		if scorecards[i].Score != scorecards[j].Score {
+			return scorecards[i].Score > scorecards[j].Score
+		}
+		return scorecards[i].SpId < scorecards[j].SpId
+	})
+
+	total := uint64(len(scorecards))
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if limit < total-offset {
+		end = offset + limit
+	}
+
+	pageRes := &query.PageResponse{}
+	if req.Pagination != nil && req.Pagination.CountTotal {
+		pageRes.Total = total
+	}
+	return &types.QueryStorageProvidersByScoreResponse{Scorecards: scorecards[offset:end], Pagination: pageRes}, nil
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSpJailRecord returns the jail record of a jailed storage provider
+func (k Keeper) GetSpJailRecord(ctx sdk.Context, spId uint32) (*types.SpJailRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageProviderJailRecordKey(spId))
+	if bz == nil {
+		return nil, false
+	}
+	var record types.SpJailRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+// Jail puts the storage provider in service or in maintenance into jail, it can unjail itself after the cooldown.
+// A jailed storage provider is excluded from new bucket placement and the global store price.
+func (k Keeper) Jail(ctx sdk.Context, spId uint32, reason string) error {
+	sp, found := k.GetStorageProvider(ctx, spId)
+	if !found {
+		return types.ErrStorageProviderNotFound
+	}
+	if !sp.IsInService() && !sp.IsInMaintenance() {
+		return nil
+	}
+
+	preStatus := sp.Status
+	if sp.IsInMaintenance() {
+		k.closeMaintenanceRecord(ctx, sp)
+	}
+	sp.Status = types.STATUS_IN_JAILED
+	k.SetStorageProvider(ctx, sp)
+
+	now := ctx.BlockTime().Unix()
+	record := &types.SpJailRecord{
+		SpId:        sp.Id,
+		JailedAt:    now,
+		ReleaseTime: now + k.GetParams(ctx).UnjailCooldownDuration,
+		Reason:      reason,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStorageProviderJailRecordKey(sp.Id), k.cdc.MustMarshal(record))
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventJailStorageProvider{
+		SpId:        sp.Id,
+		SpAddress:   sp.OperatorAddress,
+		Reason:      reason,
+		ReleaseTime: record.ReleaseTime,
+	}, &types.EventUpdateStorageProviderStatus{
+		SpId:      sp.Id,
+		SpAddress: sp.OperatorAddress,
+		PreStatus: preStatus.String(),
+		NewStatus: sp.Status.String(),
+	})
+}
+
+// Unjail brings the jailed storage provider back to service after the cooldown, if its deposit is not less than
+// the min deposit.
+func (k Keeper) Unjail(ctx sdk.Context, sp *types.StorageProvider) error {
+	if !sp.IsJailed() {
+		return types.ErrStorageProviderNotJailed
+	}
+	record, found := k.GetSpJailRecord(ctx, sp.Id)
+	if found && ctx.BlockTime().Unix() < record.ReleaseTime {
+		return types.ErrStorageProviderUnjailNotAllow.Wrapf("wait until %d", record.ReleaseTime)
+	}
+	minDeposit := k.MinDeposit(ctx)
+	if sp.TotalDeposit.LT(minDeposit) {
+		return types.ErrStorageProviderUnjailNotAllow.Wrapf("not enough deposit, deposit=%s is less than min deposit=%s",
+			sp.TotalDeposit, minDeposit)
+	}
+
+	return k.releaseJail(ctx, sp)
+}
+
+// RevokeJail revokes the jail of the storage provider jailed at the timestamp, e.g. the slash which jailed it is
+// revoked by an upheld appeal. It does nothing if the storage provider is not jailed at the timestamp anymore.
+// The storage provider without enough deposit stays in jail, but it can unjail itself at once after topping up.
+func (k Keeper) RevokeJail(ctx sdk.Context, spId uint32, jailedAt int64) error {
+	sp, found := k.GetStorageProvider(ctx, spId)
+	if !found {
+		return types.ErrStorageProviderNotFound
+	}
+	if !sp.IsJailed() {
+		return nil
+	}
+	record, found := k.GetSpJailRecord(ctx, sp.Id)
+	if !found || record.JailedAt != jailedAt {
+		return nil
+	}
+	if sp.TotalDeposit.LT(k.MinDeposit(ctx)) {
+		record.ReleaseTime = ctx.BlockTime().Unix()
+		ctx.KVStore(k.storeKey).Set(types.GetStorageProviderJailRecordKey(sp.Id), k.cdc.MustMarshal(record))
+		return nil
+	}
+	return k.releaseJail(ctx, sp)
+}
+
+// releaseJail brings the jailed storage provider back to service, and removes its jail record
+func (k Keeper) releaseJail(ctx sdk.Context, sp *types.StorageProvider) error {
+	sp.Status = types.STATUS_IN_SERVICE
+	k.SetStorageProvider(ctx, sp)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStorageProviderJailRecordKey(sp.Id))
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventUnjailStorageProvider{
+		SpId:      sp.Id,
+		SpAddress: sp.OperatorAddress,
+	}, &types.EventUpdateStorageProviderStatus{
+		SpId:      sp.Id,
+		SpAddress: sp.OperatorAddress,
+		PreStatus: types.STATUS_IN_JAILED.String(),
+		NewStatus: sp.Status.String(),
+	})
+}
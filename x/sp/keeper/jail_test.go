@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestJailAndUnjail() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+	params := k.GetParams(ctx)
+
+	spAcc := sample.RandAccAddress()
+	sp := &types.StorageProvider{
+		Id:              1,
+		OperatorAddress: spAcc.String(),
+		Status:          types.STATUS_IN_SERVICE,
+		TotalDeposit:    params.MinDeposit.Sub(sdkmath.OneInt()),
+	}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+
+	err := k.Jail(ctx, sp.Id, types.JailReasonSlashExceeded)
+	s.Require().NoError(err)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsJailed())
+	record, found := k.GetSpJailRecord(ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.JailReasonSlashExceeded, record.Reason)
+	s.Require().Equal(int64(1000)+params.UnjailCooldownDuration, record.ReleaseTime)
+
+	// the sp cannot update its status while jailed
+	_, err = s.msgServer.UpdateSpStatus(ctx, types.NewMsgUpdateStorageProviderStatus(spAcc, types.STATUS_IN_MAINTENANCE, 100))
+	s.Require().ErrorIs(err, types.ErrStorageProviderStatusUpdateNotAllow)
+
+	// unjail is not allowed before the cooldown
+	_, err = s.msgServer.UnjailStorageProvider(ctx, types.NewMsgUnjailStorageProvider(spAcc))
+	s.Require().ErrorIs(err, types.ErrStorageProviderUnjailNotAllow)
+
+	// unjail is not allowed with insufficient deposit
+	ctx = ctx.WithBlockTime(time.Unix(record.ReleaseTime, 0))
+	_, err = s.msgServer.UnjailStorageProvider(ctx, types.NewMsgUnjailStorageProvider(spAcc))
+	s.Require().ErrorIs(err, types.ErrStorageProviderUnjailNotAllow)
+
+	sp.TotalDeposit = params.MinDeposit
+	k.SetStorageProvider(ctx, sp)
+	_, err = s.msgServer.UnjailStorageProvider(ctx, types.NewMsgUnjailStorageProvider(spAcc))
+	s.Require().NoError(err)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsInService())
+	_, found = k.GetSpJailRecord(ctx, sp.Id)
+	s.Require().False(found)
+
+	// an sp not jailed cannot be unjailed
+	_, err = s.msgServer.UnjailStorageProvider(ctx, types.NewMsgUnjailStorageProvider(spAcc))
+	s.Require().ErrorIs(err, types.ErrStorageProviderNotJailed)
+}
+
+func (s *KeeperTestSuite) TestJailMaintenanceOverstay() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	spAcc := sample.RandAccAddress()
+	sp := &types.StorageProvider{
+		Id:              2,
+		OperatorAddress: spAcc.String(),
+		Status:          types.STATUS_IN_SERVICE,
+		TotalDeposit:    k.GetParams(ctx).MinDeposit,
+	}
+	k.SetStorageProvider(ctx, sp)
+	s.Require().NoError(k.UpdateToInMaintenance(ctx, sp, 100))
+	k.SetStorageProvider(ctx, sp)
+
+	ctx = ctx.WithBlockTime(time.Unix(1101, 0))
+	k.ForceUpdateMaintenanceRecords(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsJailed())
+	record, found := k.GetSpJailRecord(ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(types.JailReasonMaintenanceOverstay, record.Reason)
+	s.Require().Equal(int64(100), k.GetSpScorecard(ctx, sp.Id).MaintenanceDuration)
+}
+
+func (s *KeeperTestSuite) TestRevokeJail() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+	params := k.GetParams(ctx)
+
+	sp := &types.StorageProvider{
+		Id:              3,
+		OperatorAddress: sample.RandAccAddress().String(),
+		Status:          types.STATUS_IN_SERVICE,
+		TotalDeposit:    params.MinDeposit.Sub(sdkmath.OneInt()),
+	}
+	k.SetStorageProvider(ctx, sp)
+	s.Require().NoError(k.Jail(ctx, sp.Id, types.JailReasonSlashExceeded))
+
+	// the jail at another timestamp is not revoked
+	s.Require().NoError(k.RevokeJail(ctx, sp.Id, 999))
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsJailed())
+
+	// the sp without enough deposit stays in jail, but the cooldown is over
+	ctx = ctx.WithBlockTime(time.Unix(1010, 0))
+	s.Require().NoError(k.RevokeJail(ctx, sp.Id, 1000))
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsJailed())
+	record, found := k.GetSpJailRecord(ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(int64(1010), record.ReleaseTime)
+
+	sp.TotalDeposit = params.MinDeposit
+	k.SetStorageProvider(ctx, sp)
+	s.Require().NoError(k.RevokeJail(ctx, sp.Id, 1000))
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsInService())
+	_, found = k.GetSpJailRecord(ctx, sp.Id)
+	s.Require().False(found)
+
+	// revoking the jail of an sp not jailed does nothing
+	s.Require().NoError(k.RevokeJail(ctx, sp.Id, 1000))
+}
+
+func (s *KeeperTestSuite) TestMaintenanceOverstayBeforeUpgrade() {
+	k := s.spKeeper
+	ctx := upgrade.WithUpgraded(s.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	spAcc := sample.RandAccAddress()
+	sp := &types.StorageProvider{
+		Id:              3,
+		OperatorAddress: spAcc.String(),
+		Status:          types.STATUS_IN_SERVICE,
+		TotalDeposit:    k.GetParams(ctx).MinDeposit,
+	}
+	k.SetStorageProvider(ctx, sp)
+	s.Require().NoError(k.UpdateToInMaintenance(ctx, sp, 100))
+	k.SetStorageProvider(ctx, sp)
+
+	// the sp overstaying the maintenance is brought back to service before the upgrade
+	ctx = ctx.WithBlockTime(time.Unix(1101, 0))
+	k.ForceUpdateMaintenanceRecords(ctx)
+	sp, _ = k.GetStorageProvider(ctx, sp.Id)
+	s.Require().True(sp.IsInService())
+	_, found := k.GetSpJailRecord(ctx, sp.Id)
+	s.Require().False(found)
+}
@@ -14,11 +14,12 @@ import (
 
 type (
 	Keeper struct {
-		cdc           codec.BinaryCodec
-		storeKey      storetypes.StoreKey
-		accountKeeper types.AccountKeeper
-		bankKeeper    types.BankKeeper
-		authzKeeper   types.AuthzKeeper
+		cdc             codec.BinaryCodec
+		storeKey        storetypes.StoreKey
+		accountKeeper   types.AccountKeeper
+		bankKeeper      types.BankKeeper
+		authzKeeper     types.AuthzKeeper
+		challengeKeeper types.ChallengeKeeper
 
 		spSequence sequence.Sequence[uint32]
 		authority  string
@@ -48,6 +49,11 @@ func NewKeeper(
 	return k
 }
 
+// SetChallengeKeeper sets the challenge keeper, which is created after the sp keeper
+func (k *Keeper) SetChallengeKeeper(challengeKeeper types.ChallengeKeeper) {
+	k.challengeKeeper = challengeKeeper
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
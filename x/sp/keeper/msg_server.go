@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/bnb-chain/greenfield/types/common"
 	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
 	"github.com/bnb-chain/greenfield/x/sp/types"
 )
@@ -239,6 +240,10 @@ func (k msgServer) EditStorageProvider(goCtx context.Context, msg *types.MsgEdit
 		sp.BlsKey = blsPk
 		changed = true
 	}
+	if msg.FreeCapacity != nil {
+		sp.FreeCapacity = &common.UInt64Value{Value: msg.FreeCapacity.Value}
+		changed = true
+	}
 
 	if !changed {
 		return nil, types.ErrStorageProviderNotChanged
@@ -261,6 +266,7 @@ func (k msgServer) EditStorageProvider(goCtx context.Context, msg *types.MsgEdit
 		GcAddress:          sp.GcAddress,
 		MaintenanceAddress: sp.MaintenanceAddress,
 		BlsKey:             hex.EncodeToString(sp.BlsKey),
+		FreeCapacity:       sp.FreeCapacity,
 	}); err != nil {
 		return nil, err
 	}
@@ -307,6 +313,58 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 	return &types.MsgDepositResponse{}, nil
 }
 
+func (k msgServer) WithdrawDeposit(goCtx context.Context, msg *types.MsgWithdrawDeposit) (*types.MsgWithdrawDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fundAcc := sdk.MustAccAddressFromHex(msg.Creator)
+
+	sp, found := k.GetStorageProviderByFundingAddr(ctx, fundAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if !sdk.MustAccAddressFromHex(sp.OperatorAddress).Equals(sdk.MustAccAddressFromHex(msg.SpAddress)) {
+		return nil, types.ErrDepositAccountNotAllowed.Wrap("the sp address mismatch")
+	}
+
+	if sp.Status == types.STATUS_IN_JAILED || sp.Status == types.STATUS_GRACEFUL_EXITING {
+		return nil, types.ErrStorageProviderWithdrawNotAllow.Wrapf("sp status: %s", sp.Status.String())
+	}
+
+	depositDenom := k.DepositDenomForSP(ctx)
+	if depositDenom != msg.Withdraw.GetDenom() {
+		return nil, errors.Wrapf(types.ErrInvalidDenom, "invalid coin denomination: got %s, expected %s", msg.Withdraw.Denom, depositDenom)
+	}
+
+	// the remaining deposit should cover the min deposit and the amount which can still be slashed
+	required := k.MinDeposit(ctx).Add(k.GetSlashExposure(ctx, sp.Id))
+	if sp.TotalDeposit.Sub(msg.Withdraw.Amount).LT(required) {
+		return nil, types.ErrInsufficientDepositAmount.Wrapf("the remaining deposit should be at least %s", required)
+	}
+
+	sp.TotalDeposit = sp.TotalDeposit.Sub(msg.Withdraw.Amount)
+	k.SetStorageProvider(ctx, sp)
+
+	unlockTime := ctx.BlockTime().Unix() + k.DepositUnbondingPeriod(ctx)
+	k.AddDepositUnbonding(ctx, types.DepositUnbonding{
+		SpId:           sp.Id,
+		FundingAddress: sp.FundingAddress,
+		Amount:         msg.Withdraw.Amount,
+		UnlockTime:     unlockTime,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvents(&types.EventWithdrawDeposit{
+		SpId:           sp.Id,
+		FundingAddress: msg.Creator,
+		Withdraw:       msg.Withdraw.String(),
+		TotalDeposit:   sp.TotalDeposit.String(),
+		UnlockTime:     unlockTime,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawDepositResponse{}, nil
+}
+
 func (k msgServer) UpdateSpStoragePrice(goCtx context.Context, msg *types.MsgUpdateSpStoragePrice) (*types.MsgUpdateSpStoragePriceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	spAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
@@ -321,21 +379,53 @@ func (k msgServer) UpdateSpStoragePrice(goCtx context.Context, msg *types.MsgUpd
 	}
 
 	params := k.GetParams(ctx)
-	if params.UpdateGlobalPriceInterval == 0 { // update price by month
-		blockTime := ctx.BlockTime().UTC()
-		days := params.UpdatePriceDisallowedDays
-		if IsLastDaysOfTheMonth(blockTime, int(days)) {
-			return nil, errors.Wrapf(types.ErrStorageProviderPriceUpdateNotAllow, "price cannot be updated in the last %d days of the month", days)
+	current := ctx.BlockTime().Unix()
+	if msg.EffectiveTime != 0 || params.PriceChangeNoticeDays != 0 { // schedule the price change in advance
+		earliest := current + int64(params.PriceChangeNoticeDays)*86400
+		effectiveTime := msg.EffectiveTime
+		if effectiveTime == 0 {
+			// take effect at the earliest time allowed
+			effectiveTime = earliest
+			if isPriceUpdateFrozen(params, effectiveTime) {
+				effectiveTime = getPriceUpdateFreezeEndTime(effectiveTime)
+			}
+		}
+		if effectiveTime < earliest {
+			return nil, errors.Wrapf(types.ErrStorageProviderPriceUpdateNotAllow,
+				"price change should be scheduled at least %d days in advance, the earliest effective time is %d",
+				params.PriceChangeNoticeDays, earliest)
+		}
+		if isPriceUpdateFrozen(params, effectiveTime) {
+			return nil, errors.Wrapf(types.ErrStorageProviderPriceUpdateNotAllow,
+				"price cannot take effect in the last %d days of the month", params.UpdatePriceDisallowedDays)
 		}
+		err := k.ScheduleSpStoragePrice(ctx, types.ScheduledSpStoragePrice{
+			SpId:            sp.Id,
+			EffectiveTime:   effectiveTime,
+			ReadPrice:       msg.ReadPrice,
+			FreeReadQuota:   msg.FreeReadQuota,
+			StorePrice:      msg.StorePrice,
+			StorePriceTiers: msg.StorePriceTiers,
+			ScheduledAt:     current,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgUpdateSpStoragePriceResponse{}, nil
+	}
+
+	if isPriceUpdateFrozen(params, current) {
+		return nil, errors.Wrapf(types.ErrStorageProviderPriceUpdateNotAllow,
+			"price cannot be updated in the last %d days of the month", params.UpdatePriceDisallowedDays)
 	}
 
-	current := ctx.BlockTime().Unix()
 	spStorePrice := types.SpStoragePrice{
-		UpdateTimeSec: current,
-		SpId:          sp.Id,
-		ReadPrice:     msg.ReadPrice,
-		StorePrice:    msg.StorePrice,
-		FreeReadQuota: msg.FreeReadQuota,
+		UpdateTimeSec:   current,
+		SpId:            sp.Id,
+		ReadPrice:       msg.ReadPrice,
+		StorePrice:      msg.StorePrice,
+		FreeReadQuota:   msg.FreeReadQuota,
+		StorePriceTiers: msg.StorePriceTiers,
 	}
 	k.SetSpStoragePrice(ctx, spStorePrice)
 
@@ -350,6 +440,18 @@ func IsLastDaysOfTheMonth(now time.Time, days int) bool {
 	return now.After(daysBack)
 }
 
+// isPriceUpdateFrozen returns whether the sp price can not be updated at the time, which is in the last
+// UpdatePriceDisallowedDays days of the month when the global price is updated by month
+func isPriceUpdateFrozen(params types.Params, t int64) bool {
+	return params.UpdateGlobalPriceInterval == 0 && IsLastDaysOfTheMonth(time.Unix(t, 0), int(params.UpdatePriceDisallowedDays))
+}
+
+// getPriceUpdateFreezeEndTime returns the time the price update freeze at the time ends, which is the start of the next month
+func getPriceUpdateFreezeEndTime(t int64) int64 {
+	year, month, _ := time.Unix(t, 0).UTC().Date()
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, time.FixedZone("UTC", 0)).Unix()
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
@@ -424,7 +526,9 @@ func (k msgServer) UpdateSpStatus(goCtx context.Context, msg *types.MsgUpdateSto
 			return nil, types.ErrStorageProviderStatusUpdateNotAllow
 		}
 		k.UpdateToInService(ctx, sp)
-	case types.STATUS_IN_JAILED, types.STATUS_GRACEFUL_EXITING:
+	case types.STATUS_IN_JAILED:
+		return nil, types.ErrStorageProviderStatusUpdateNotAllow.Wrap("jailed sp should be unjailed by MsgUnjailStorageProvider")
+	case types.STATUS_GRACEFUL_EXITING:
 		return nil, types.ErrStorageProviderStatusUpdateNotAllow
 	}
 	k.SetStorageProvider(ctx, sp)
@@ -439,3 +543,20 @@ func (k msgServer) UpdateSpStatus(goCtx context.Context, msg *types.MsgUpdateSto
 	}
 	return &types.MsgUpdateStorageProviderStatusResponse{}, nil
 }
+
+// UnjailStorageProvider brings the jailed SP back to service after the unjail cooldown.
+func (k msgServer) UnjailStorageProvider(goCtx context.Context, msg *types.MsgUnjailStorageProvider) (*types.MsgUnjailStorageProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAcc := sdk.MustAccAddressFromHex(msg.SpAddress)
+
+	sp, found := k.GetStorageProviderByOperatorAddr(ctx, operatorAcc)
+	if !found {
+		return nil, types.ErrStorageProviderNotFound
+	}
+
+	if err := k.Unjail(ctx, sp); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnjailStorageProviderResponse{}, nil
+}
@@ -22,6 +22,11 @@ func (k Keeper) SecondarySpStorePriceRatio(ctx sdk.Context) (res sdk.Dec) {
 	return params.SecondarySpStorePriceRatio
 }
 
+func (k Keeper) DepositUnbondingPeriod(ctx sdk.Context) (res int64) {
+	params := k.GetParams(ctx)
+	return params.DepositUnbondingPeriod
+}
+
 // GetParams returns the current sp module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetScheduledSpStoragePrice returns the pending price change scheduled by the sp
+func (k Keeper) GetScheduledSpStoragePrice(ctx sdk.Context, spId uint32) (types.ScheduledSpStoragePrice, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduledSpStoragePriceKey(spId))
+	if bz == nil {
+		return types.ScheduledSpStoragePrice{}, false
+	}
+	var scheduled types.ScheduledSpStoragePrice
+	k.cdc.MustUnmarshal(bz, &scheduled)
+	return scheduled, true
+}
+
+// ScheduleSpStoragePrice schedules a price change of the sp, it replaces the pending one if there is any.
+func (k Keeper) ScheduleSpStoragePrice(ctx sdk.Context, scheduled types.ScheduledSpStoragePrice) error {
+	k.deleteScheduledSpStoragePrice(ctx, scheduled.SpId)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledSpStoragePriceKey(scheduled.SpId), k.cdc.MustMarshal(&scheduled))
+	queueStore := prefix.NewStore(store, types.ScheduledSpStoragePriceQueueKeyPrefix)
+	queueStore.Set(types.ScheduledSpStoragePriceQueueKey(scheduled.EffectiveTime, scheduled.SpId), []byte{})
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSpStoragePriceScheduled{
+		SpId:            scheduled.SpId,
+		EffectiveTime:   scheduled.EffectiveTime,
+		ReadPrice:       scheduled.ReadPrice,
+		FreeReadQuota:   scheduled.FreeReadQuota,
+		StorePrice:      scheduled.StorePrice,
+		StorePriceTiers: scheduled.StorePriceTiers,
+	})
+}
+
+func (k Keeper) deleteScheduledSpStoragePrice(ctx sdk.Context, spId uint32) {
+	scheduled, found := k.GetScheduledSpStoragePrice(ctx, spId)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledSpStoragePriceKey(spId))
+	queueStore := prefix.NewStore(store, types.ScheduledSpStoragePriceQueueKeyPrefix)
+	queueStore.Delete(types.ScheduledSpStoragePriceQueueKey(scheduled.EffectiveTime, spId))
+}
+
+// ApplyScheduledSpStoragePrices applies the scheduled price changes which are effective at the current block time,
+// the global store price picks them up at its next update. If the price can not be updated now, since the params
+// are changed after the price changes are scheduled, they are postponed to the end of the price update freeze.
+func (k Keeper) ApplyScheduledSpStoragePrices(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	queueStore := prefix.NewStore(store, types.ScheduledSpStoragePriceQueueKeyPrefix)
+	now := ctx.BlockTime().Unix()
+	iterator := queueStore.Iterator(nil, types.ScheduledSpStoragePriceQueueKey(now+1, 0))
+	defer iterator.Close()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	frozen := isPriceUpdateFrozen(k.GetParams(ctx), now)
+	for _, key := range keys {
+		_, spId := types.ParseScheduledSpStoragePriceQueueKey(key)
+		scheduled, found := k.GetScheduledSpStoragePrice(ctx, spId)
+		if found && frozen {
+			scheduled.EffectiveTime = getPriceUpdateFreezeEndTime(now)
+			if err := k.ScheduleSpStoragePrice(ctx, scheduled); err != nil {
+				ctx.Logger().Error("fail to postpone scheduled sp storage price", "sp", spId, "err", err.Error())
+			}
+			continue
+		}
+		if found {
+			k.SetSpStoragePrice(ctx, types.SpStoragePrice{
+				SpId:            spId,
+				UpdateTimeSec:   now,
+				ReadPrice:       scheduled.ReadPrice,
+				FreeReadQuota:   scheduled.FreeReadQuota,
+				StorePrice:      scheduled.StorePrice,
+				StorePriceTiers: scheduled.StorePriceTiers,
+			})
+			store.Delete(types.GetScheduledSpStoragePriceKey(spId))
+		}
+		queueStore.Delete(key)
+	}
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleSpStoragePrice() {
+	k := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000000, 0))
+	noticeDuration := int64(k.GetParams(ctx).PriceChangeNoticeDays) * 86400
+
+	spAcc := sample.RandAccAddress()
+	sp := &types.StorageProvider{Id: 1, OperatorAddress: spAcc.String(), Status: types.STATUS_IN_SERVICE}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+	k.SetSpStoragePrice(ctx, types.SpStoragePrice{
+		SpId:       sp.Id,
+		ReadPrice:  sdk.NewDec(1),
+		StorePrice: sdk.NewDec(1),
+	})
+
+	msg := &types.MsgUpdateSpStoragePrice{
+		SpAddress:     spAcc.String(),
+		ReadPrice:     sdk.NewDec(2),
+		StorePrice:    sdk.NewDec(2),
+		FreeReadQuota: 100,
+		EffectiveTime: ctx.BlockTime().Unix() + noticeDuration - 1,
+	}
+	// not enough notice
+	_, err := s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrStorageProviderPriceUpdateNotAllow)
+
+	msg.EffectiveTime = ctx.BlockTime().Unix() + noticeDuration + 100
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().NoError(err)
+
+	// reschedule replaces the pending one
+	msg.StorePrice = sdk.NewDec(3)
+	msg.EffectiveTime = ctx.BlockTime().Unix() + noticeDuration
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().NoError(err)
+
+	res, err := k.ScheduledSpStoragePrices(ctx, &types.QueryScheduledSpStoragePricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(res.ScheduledPrices))
+	s.Require().Equal(msg.EffectiveTime, res.ScheduledPrices[0].EffectiveTime)
+	s.Require().Equal(sdk.NewDec(3), res.ScheduledPrices[0].StorePrice)
+
+	// the price is not changed before the effective time
+	k.ApplyScheduledSpStoragePrices(ctx.WithBlockTime(time.Unix(msg.EffectiveTime-1, 0)))
+	price, _ := k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(1), price.StorePrice)
+
+	ctx = ctx.WithBlockTime(time.Unix(msg.EffectiveTime, 0))
+	k.ApplyScheduledSpStoragePrices(ctx)
+	price, _ = k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(3), price.StorePrice)
+	s.Require().Equal(sdk.NewDec(2), price.ReadPrice)
+	s.Require().Equal(msg.EffectiveTime, price.UpdateTimeSec)
+	_, found := k.GetScheduledSpStoragePrice(ctx, sp.Id)
+	s.Require().False(found)
+
+	res, err = k.ScheduledSpStoragePrices(ctx, &types.QueryScheduledSpStoragePricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(0, len(res.ScheduledPrices))
+}
+
+func (s *KeeperTestSuite) TestScheduleSpStoragePriceWithFreeze() {
+	k := s.spKeeper
+	// 2023-01-10 00:00:00 UTC
+	ctx := s.ctx.WithBlockTime(time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC))
+	params := k.GetParams(ctx)
+	params.UpdateGlobalPriceInterval = 0
+	params.UpdatePriceDisallowedDays = 5
+	params.PriceChangeNoticeDays = 7
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	spAcc := sample.RandAccAddress()
+	sp := &types.StorageProvider{Id: 1, OperatorAddress: spAcc.String(), Status: types.STATUS_IN_SERVICE}
+	k.SetStorageProvider(ctx, sp)
+	k.SetStorageProviderByOperatorAddr(ctx, sp)
+	k.SetSpStoragePrice(ctx, types.SpStoragePrice{
+		SpId:       sp.Id,
+		ReadPrice:  sdk.NewDec(1),
+		StorePrice: sdk.NewDec(1),
+	})
+
+	// the price change without effective time is scheduled at the earliest time allowed
+	msg := &types.MsgUpdateSpStoragePrice{
+		SpAddress:  spAcc.String(),
+		ReadPrice:  sdk.NewDec(2),
+		StorePrice: sdk.NewDec(2),
+	}
+	_, err := s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().NoError(err)
+	scheduled, found := k.GetScheduledSpStoragePrice(ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(time.Date(2023, 1, 17, 0, 0, 0, 0, time.UTC).Unix(), scheduled.EffectiveTime)
+
+	// the price change can not take effect in the last days of the month
+	msg.EffectiveTime = time.Date(2023, 1, 30, 0, 0, 0, 0, time.UTC).Unix()
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().ErrorIs(err, types.ErrStorageProviderPriceUpdateNotAllow)
+
+	// the earliest time allowed is postponed to the next month if it is in the last days of the month
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 22, 0, 0, 0, 0, time.UTC))
+	msg.EffectiveTime = 0
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().NoError(err)
+	scheduled, _ = k.GetScheduledSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC).Unix(), scheduled.EffectiveTime)
+
+	// the scheduled price change falling into the freeze after the params change is postponed
+	msg.EffectiveTime = time.Date(2023, 2, 20, 0, 0, 0, 0, time.UTC).Unix()
+	_, err = s.msgServer.UpdateSpStoragePrice(ctx, msg)
+	s.Require().NoError(err)
+	params.UpdatePriceDisallowedDays = 10
+	s.Require().NoError(k.SetParams(ctx, params))
+	ctx = ctx.WithBlockTime(time.Unix(msg.EffectiveTime, 0))
+	k.ApplyScheduledSpStoragePrices(ctx)
+	price, _ := k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(1), price.StorePrice)
+	scheduled, found = k.GetScheduledSpStoragePrice(ctx, sp.Id)
+	s.Require().True(found)
+	s.Require().Equal(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC).Unix(), scheduled.EffectiveTime)
+
+	ctx = ctx.WithBlockTime(time.Unix(scheduled.EffectiveTime, 0))
+	k.ApplyScheduledSpStoragePrices(ctx)
+	price, _ = k.GetSpStoragePrice(ctx, sp.Id)
+	s.Require().Equal(sdk.NewDec(2), price.StorePrice)
+}
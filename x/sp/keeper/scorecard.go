@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+// GetSpScorecard returns the scorecard of the storage provider, a storage provider without any signal has a full score
+func (k Keeper) GetSpScorecard(ctx sdk.Context, spId uint32) types.SpScorecard {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageProviderScorecardKey(spId))
+	if bz == nil {
+		return types.NewSpScorecard(spId)
+	}
+	var scorecard types.SpScorecard
+	k.cdc.MustUnmarshal(bz, &scorecard)
+	return scorecard
+}
+
+// updateSpScorecard updates the scorecard of the storage provider, the scorecards are kept since the Hulunbeier upgrade
+func (k Keeper) updateSpScorecard(ctx sdk.Context, spId uint32, updateFunc func(scorecard *types.SpScorecard)) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	scorecard := k.GetSpScorecard(ctx, spId)
+	updateFunc(&scorecard)
+	scorecard.Score = scorecard.CalculateScore()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStorageProviderScorecardKey(spId), k.cdc.MustMarshal(&scorecard))
+}
+
+// RecordChallengeResult records the outcome of a challenge for the storage provider, a challenge is passed if it
+// expires without slashing the storage provider
+func (k Keeper) RecordChallengeResult(ctx sdk.Context, spId uint32, passed bool) {
+	k.updateSpScorecard(ctx, spId, func(scorecard *types.SpScorecard) {
+		if passed {
+			scorecard.ChallengePassCount++
+		} else {
+			scorecard.SlashCount++
+		}
+	})
+}
+
+// RecordRejectSealObject records an object rejected to seal by the storage provider
+func (k Keeper) RecordRejectSealObject(ctx sdk.Context, spId uint32) {
+	k.updateSpScorecard(ctx, spId, func(scorecard *types.SpScorecard) {
+		scorecard.RejectSealCount++
+	})
+}
+
+// RecordRejectMigrateBucket records a bucket migration rejected by the storage provider
+func (k Keeper) RecordRejectMigrateBucket(ctx sdk.Context, spId uint32) {
+	k.updateSpScorecard(ctx, spId, func(scorecard *types.SpScorecard) {
+		scorecard.RejectMigrateCount++
+	})
+}
+
+func (k Keeper) recordMaintenanceDuration(ctx sdk.Context, spId uint32, duration int64) {
+	if duration <= 0 {
+		return
+	}
+	k.updateSpScorecard(ctx, spId, func(scorecard *types.SpScorecard) {
+		scorecard.MaintenanceDuration += duration
+	})
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/bnb-chain/greenfield/x/sp/types"
+)
+
+func (s *KeeperTestSuite) TestStorageProvidersByScore() {
+	k := s.spKeeper
+	ctx := s.ctx
+	for id := uint32(1); id <= 3; id++ {
+		k.SetStorageProvider(ctx, &types.StorageProvider{Id: id})
+	}
+
+	// a storage provider without any signal has a full score
+	scorecard := k.GetSpScorecard(ctx, 1)
+	s.Require().Equal(uint32(types.MaxSpScore), scorecard.Score)
+
+	k.RecordChallengeResult(ctx, 1, true)
+	k.RecordChallengeResult(ctx, 1, false)
+	k.RecordRejectSealObject(ctx, 2)
+	k.RecordRejectMigrateBucket(ctx, 2)
+
+	scorecard = k.GetSpScorecard(ctx, 1)
+	s.Require().Equal(uint64(1), scorecard.ChallengePassCount)
+	s.Require().Equal(uint64(1), scorecard.SlashCount)
+	// 10000 * 2 / (2 + 1)
+	s.Require().Equal(uint32(6666), scorecard.Score)
+
+	scorecard = k.GetSpScorecard(ctx, 2)
+	s.Require().Equal(uint32(types.MaxSpScore-types.RejectSealScorePenalty-types.RejectMigrateScorePenalty), scorecard.Score)
+
+	res, err := k.StorageProvidersByScore(ctx, &types.QueryStorageProvidersByScoreRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	s.Require().Equal(3, len(res.Scorecards))
+	s.Require().Equal(uint32(3), res.Scorecards[0].SpId)
+	s.Require().Equal(uint32(2), res.Scorecards[1].SpId)
+	s.Require().Equal(uint32(1), res.Scorecards[2].SpId)
+
+	res, err = k.StorageProvidersByScore(ctx, &types.QueryStorageProvidersByScoreRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(res.Scorecards))
+	s.Require().Equal(uint32(2), res.Scorecards[0].SpId)
+}
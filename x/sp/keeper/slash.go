@@ -21,7 +21,7 @@ func (k Keeper) Slash(ctx sdk.Context, spID uint32, rewardInfos []types.RewardIn
 		}
 	}
 
-	if totalAmount.GT(sp.TotalDeposit) {
+	if totalAmount.GT(k.GetSlashableDeposit(ctx, sp)) {
 		return types.ErrInsufficientDepositAmount
 	}
 
@@ -37,7 +37,57 @@ func (k Keeper) Slash(ctx sdk.Context, spID uint32, rewardInfos []types.RewardIn
 		}
 	}
 
-	sp.TotalDeposit = sp.TotalDeposit.Sub(totalAmount)
+	k.deductSlashedDeposit(ctx, sp, totalAmount)
+	k.SetStorageProvider(ctx, sp)
+
+	return nil
+}
+
+// SlashToModule deducts the amount from the deposit of the storage provider, including the withdrawn deposit which is
+// still unbonding, and transfers it to the module account, which holds the slashed funds until they are distributed,
+// burned or restored.
+func (k Keeper) SlashToModule(ctx sdk.Context, spID uint32, moduleName string, amount sdk.Coin) error {
+	sp, found := k.GetStorageProvider(ctx, spID)
+	if !found {
+		return types.ErrStorageProviderNotFound
+	}
+
+	if k.DepositDenomForSP(ctx) != amount.Denom {
+		return types.ErrInvalidDenom.Wrapf("Expect: %s, actual: %s", k.DepositDenomForSP(ctx), amount.Denom)
+	}
+
+	if amount.Amount.GT(k.GetSlashableDeposit(ctx, sp)) {
+		return types.ErrInsufficientDepositAmount
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	k.deductSlashedDeposit(ctx, sp, amount.Amount)
+	k.SetStorageProvider(ctx, sp)
+
+	return nil
+}
+
+// RestoreSlashedDeposit transfers the slashed funds held by the module account back to the deposit of the storage provider.
+func (k Keeper) RestoreSlashedDeposit(ctx sdk.Context, spID uint32, moduleName string, amount sdk.Coin) error {
+	sp, found := k.GetStorageProvider(ctx, spID)
+	if !found {
+		return types.ErrStorageProviderNotFound
+	}
+
+	if k.DepositDenomForSP(ctx) != amount.Denom {
+		return types.ErrInvalidDenom.Wrapf("Expect: %s, actual: %s", k.DepositDenomForSP(ctx), amount.Denom)
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, moduleName, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	sp.TotalDeposit = sp.TotalDeposit.Add(amount.Amount)
 	k.SetStorageProvider(ctx, sp)
 
 	return nil
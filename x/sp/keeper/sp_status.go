@@ -5,6 +5,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/sp/types"
 )
 
@@ -50,6 +51,12 @@ func (k Keeper) UpdateToInMaintenance(ctx sdk.Context, sp *types.StorageProvider
 }
 
 func (k Keeper) UpdateToInService(ctx sdk.Context, sp *types.StorageProvider) {
+	k.closeMaintenanceRecord(ctx, sp)
+	sp.Status = types.STATUS_IN_SERVICE
+}
+
+// closeMaintenanceRecord sets the actual duration of the ongoing maintenance record of the sp
+func (k Keeper) closeMaintenanceRecord(ctx sdk.Context, sp *types.StorageProvider) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetStorageProviderMaintenanceRecordsKey(sdk.MustAccAddressFromHex(sp.OperatorAddress))
 	bz := store.Get(key)
@@ -57,13 +64,14 @@ func (k Keeper) UpdateToInService(ctx sdk.Context, sp *types.StorageProvider) {
 		var stats types.SpMaintenanceStats
 		k.cdc.MustUnmarshal(bz, &stats)
 		size := len(stats.Records)
-		if size != 0 {
+		// the record closed by force is kept since the Hulunbeier upgrade
+		if size != 0 && (stats.Records[size-1].ActualDuration == 0 || !ctx.IsUpgraded(gnfdtypes.Hulunbeier)) {
 			lastRecord := stats.Records[size-1]
 			lastRecord.ActualDuration = ctx.BlockTime().Unix() - lastRecord.RequestAt
 			store.Set(key, k.cdc.MustMarshal(&stats))
+			k.recordMaintenanceDuration(ctx, sp.Id, lastRecord.ActualDuration)
 		}
 	}
-	sp.Status = types.STATUS_IN_SERVICE
 }
 
 func (k Keeper) ForceUpdateMaintenanceRecords(ctx sdk.Context) {
@@ -79,22 +87,28 @@ func (k Keeper) ForceUpdateMaintenanceRecords(ctx sdk.Context) {
 			var stats types.SpMaintenanceStats
 			k.cdc.MustUnmarshal(bz, &stats)
 			size := len(stats.Records)
-			// force update any maintenance record that not been updated back to in_service after requested duration.
+			// force update any maintenance record that not been updated back to in_service after requested duration,
+			// the sp overstaying the maintenance is jailed since the Hulunbeier upgrade.
 			changed := false
 			if sp.Status != types.STATUS_IN_SERVICE {
 				for i := size - 1; i >= 0; i-- {
 					if stats.Records[i].GetActualDuration() == 0 && stats.Records[i].RequestAt+stats.Records[i].GetRequestDuration() < curTime {
 						stats.Records[i].ActualDuration = stats.Records[i].RequestDuration
 						store.Set(key, k.cdc.MustMarshal(&stats))
-						sp.Status = types.STATUS_IN_SERVICE
-						k.SetStorageProvider(ctx, sp)
+						k.recordMaintenanceDuration(ctx, sp.Id, stats.Records[i].ActualDuration)
 						changed = true
-						_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
-							SpId:      sp.Id,
-							SpAddress: sp.OperatorAddress,
-							PreStatus: types.STATUS_IN_MAINTENANCE.String(),
-							NewStatus: types.STATUS_IN_SERVICE.String(),
-						})
+						if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+							sp.Status = types.STATUS_IN_SERVICE
+							k.SetStorageProvider(ctx, sp)
+							_ = ctx.EventManager().EmitTypedEvents(&types.EventUpdateStorageProviderStatus{
+								SpId:      sp.Id,
+								SpAddress: sp.OperatorAddress,
+								PreStatus: types.STATUS_IN_MAINTENANCE.String(),
+								NewStatus: types.STATUS_IN_SERVICE.String(),
+							})
+						} else if err := k.Jail(ctx, sp.Id, types.JailReasonMaintenanceOverstay); err != nil {
+							ctx.Logger().Error("fail to jail sp overstaying maintenance", "sp", sp.Id, "err", err.Error())
+						}
 					}
 				}
 			}
@@ -14,11 +14,12 @@ import (
 // SetSpStoragePrice set a specific SpStoragePrice in the store from its index
 func (k Keeper) SetSpStoragePrice(ctx sdk.Context, spStoragePrice types.SpStoragePrice) {
 	event := &types.EventSpStoragePriceUpdate{
-		SpId:          spStoragePrice.SpId,
-		UpdateTimeSec: spStoragePrice.UpdateTimeSec,
-		ReadPrice:     spStoragePrice.ReadPrice,
-		StorePrice:    spStoragePrice.StorePrice,
-		FreeReadQuota: spStoragePrice.FreeReadQuota,
+		SpId:            spStoragePrice.SpId,
+		UpdateTimeSec:   spStoragePrice.UpdateTimeSec,
+		ReadPrice:       spStoragePrice.ReadPrice,
+		StorePrice:      spStoragePrice.StorePrice,
+		FreeReadQuota:   spStoragePrice.FreeReadQuota,
+		StorePriceTiers: spStoragePrice.StorePriceTiers,
 	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SpStoragePriceKeyPrefix)
 	key := types.SpStoragePriceKey(spStoragePrice.SpId)
@@ -69,6 +70,7 @@ func (k Keeper) SetGlobalSpStorePrice(ctx sdk.Context, globalSpStorePrice types.
 		PrimaryStorePrice:   globalSpStorePrice.PrimaryStorePrice,
 		SecondaryStorePrice: globalSpStorePrice.SecondaryStorePrice,
 		ReadPrice:           globalSpStorePrice.ReadPrice,
+		StorePriceTiers:     globalSpStorePrice.StorePriceTiers,
 	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GlobalSpStorePriceKeyPrefix)
 	key := types.GlobalSpStorePriceKey(
@@ -86,6 +88,7 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 	current := ctx.BlockTime().Unix()
 	storePrices := make([]sdk.Dec, 0)
 	readPrices := make([]sdk.Dec, 0)
+	spPrices := make([]types.SpStoragePrice, 0)
 	for _, sp := range sps {
 		if sp.Status == types.STATUS_IN_SERVICE || sp.Status == types.STATUS_IN_MAINTENANCE {
 			price, found := k.GetSpStoragePrice(ctx, sp.Id)
@@ -94,6 +97,7 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 			}
 			storePrices = append(storePrices, price.StorePrice)
 			readPrices = append(readPrices, price.ReadPrice)
+			spPrices = append(spPrices, price)
 		}
 	}
 	l := len(storePrices)
@@ -101,8 +105,9 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 		return nil
 	}
 
+	secondaryRatio := k.SecondarySpStorePriceRatio(ctx)
 	primaryStorePrice := k.calculateMedian(storePrices)
-	secondaryStorePrice := k.SecondarySpStorePriceRatio(ctx).Mul(primaryStorePrice)
+	secondaryStorePrice := secondaryRatio.Mul(primaryStorePrice)
 	readPrice := k.calculateMedian(readPrices)
 
 	globalSpStorePrice := types.GlobalSpStorePrice{
@@ -110,11 +115,58 @@ func (k Keeper) UpdateGlobalSpStorePrice(ctx sdk.Context) error {
 		SecondaryStorePrice: secondaryStorePrice,
 		ReadPrice:           readPrice,
 		UpdateTimeSec:       current,
+		StorePriceTiers:     k.calculateStorePriceTiers(spPrices, secondaryRatio, primaryStorePrice, secondaryStorePrice),
 	}
 	k.SetGlobalSpStorePrice(ctx, globalSpStorePrice)
 	return nil
 }
 
+// calculateStorePriceTiers calculates the global store price tiers of all redundancy types. Every min charge size
+// published by any sp is a candidate tier of each redundancy type, since an sp may charge a redundancy type by the tiers
+// of another, whose prices are the medians of the prices all sps charge for the type at that size. The tiers with the
+// same prices as the previous tier of the type are omitted.
+func (k Keeper) calculateStorePriceTiers(spPrices []types.SpStoragePrice, secondaryRatio sdk.Dec,
+	basePrimaryStorePrice, baseSecondaryStorePrice sdk.Dec) []types.GlobalStorePriceTier {
+	sizeSet := make(map[uint64]bool)
+	sizes := make([]uint64, 0)
+	for _, price := range spPrices {
+		for _, tier := range price.StorePriceTiers {
+			if !sizeSet[tier.MinChargeSize] {
+				sizeSet[tier.MinChargeSize] = true
+				sizes = append(sizes, tier.MinChargeSize)
+			}
+		}
+	}
+	sort.Slice(sizes, func(i, j int) bool { return sizes[i] < sizes[j] })
+
+	tiers := make([]types.GlobalStorePriceTier, 0)
+	for _, redundancyType := range types.StorePriceRedundancyTypes {
+		lastPrimary, lastSecondary := basePrimaryStorePrice, baseSecondaryStorePrice
+		for _, size := range sizes {
+			primaryPrices := make([]sdk.Dec, 0, len(spPrices))
+			secondaryPrices := make([]sdk.Dec, 0, len(spPrices))
+			for _, price := range spPrices {
+				primary, secondary := price.GetStorePriceByChargeSize(size, redundancyType, secondaryRatio)
+				primaryPrices = append(primaryPrices, primary)
+				secondaryPrices = append(secondaryPrices, secondary)
+			}
+			primary := k.calculateMedian(primaryPrices)
+			secondary := k.calculateMedian(secondaryPrices)
+			if primary.Equal(lastPrimary) && secondary.Equal(lastSecondary) {
+				continue
+			}
+			tiers = append(tiers, types.GlobalStorePriceTier{
+				MinChargeSize:       size,
+				PrimaryStorePrice:   primary,
+				SecondaryStorePrice: secondary,
+				RedundancyType:      redundancyType,
+			})
+			lastPrimary, lastSecondary = primary, secondary
+		}
+	}
+	return tiers
+}
+
 func (k Keeper) calculateMedian(prices []sdk.Dec) sdk.Dec {
 	l := len(prices)
 	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -87,3 +88,82 @@ func (s *KeeperTestSuite) TestGetGlobalSpStorePriceByTime() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateGlobalSpStorePriceWithTiers() {
+	keeper := s.spKeeper
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+	ratio := keeper.SecondarySpStorePriceRatio(ctx)
+
+	spPrices := []types.SpStoragePrice{
+		{
+			SpId:       1,
+			ReadPrice:  sdk.NewDec(10),
+			StorePrice: sdk.NewDec(100),
+			StorePriceTiers: []types.StorePriceTier{
+				{MinChargeSize: 1000, StorePrice: sdk.NewDec(80), SecondaryStorePrice: sdk.NewDec(20)},
+				{MinChargeSize: 1000, StorePrice: sdk.NewDec(120), SecondaryStorePrice: sdk.ZeroDec(), RedundancyType: types.RedundancyTypeReplica},
+			},
+		},
+		{
+			SpId:       2,
+			ReadPrice:  sdk.NewDec(10),
+			StorePrice: sdk.NewDec(100),
+			StorePriceTiers: []types.StorePriceTier{
+				{MinChargeSize: 1000, StorePrice: sdk.NewDec(60), SecondaryStorePrice: sdk.ZeroDec()},
+				{MinChargeSize: 5000, StorePrice: sdk.NewDec(40), SecondaryStorePrice: sdk.ZeroDec()},
+			},
+		},
+		{
+			SpId:       3,
+			ReadPrice:  sdk.NewDec(10),
+			StorePrice: sdk.NewDec(100),
+			StorePriceTiers: []types.StorePriceTier{
+				{MinChargeSize: 1000, StorePrice: sdk.NewDec(150), SecondaryStorePrice: sdk.ZeroDec(), RedundancyType: types.RedundancyTypeReplica},
+			},
+		},
+	}
+	for _, price := range spPrices {
+		keeper.SetStorageProvider(ctx, &types.StorageProvider{Id: price.SpId, Status: types.STATUS_IN_SERVICE})
+		keeper.SetSpStoragePrice(ctx, price)
+	}
+
+	err := keeper.UpdateGlobalSpStorePrice(ctx)
+	s.Require().NoError(err)
+	globalPrice, err := keeper.GetGlobalSpStorePriceByTime(ctx, ctx.BlockTime().Unix()+1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(100), globalPrice.PrimaryStorePrice)
+
+	// the median of the ec type at 1000 is 80 for primary, and the median of (20, 60 * ratio, 100 * ratio) for secondary,
+	// the median at 5000 is also 80 for primary, so it is omitted.
+	s.Require().Equal(2, len(globalPrice.StorePriceTiers))
+	tier := globalPrice.StorePriceTiers[0]
+	s.Require().Equal(types.RedundancyTypeEC, tier.RedundancyType)
+	s.Require().Equal(uint64(1000), tier.MinChargeSize)
+	s.Require().Equal(sdk.NewDec(80), tier.PrimaryStorePrice)
+	secondaryPrices := []sdk.Dec{sdk.NewDec(20), ratio.MulInt64(60), ratio.MulInt64(100)}
+	sort.Slice(secondaryPrices, func(i, j int) bool { return secondaryPrices[i].LT(secondaryPrices[j]) })
+	s.Require().Equal(secondaryPrices[1], tier.SecondaryStorePrice)
+
+	// the median of the replica type at 1000 is the median of (120, 60, 150), since sp 2 charges the replica
+	// objects by its ec tiers, and the median at 5000 is also 120, so it is omitted.
+	replicaTier := globalPrice.StorePriceTiers[1]
+	s.Require().Equal(types.RedundancyTypeReplica, replicaTier.RedundancyType)
+	s.Require().Equal(uint64(1000), replicaTier.MinChargeSize)
+	s.Require().Equal(sdk.NewDec(120), replicaTier.PrimaryStorePrice)
+	s.Require().Equal(ratio.MulInt64(120), replicaTier.SecondaryStorePrice)
+
+	primary, secondary := globalPrice.GetStorePriceByChargeSize(999, types.RedundancyTypeEC)
+	s.Require().Equal(globalPrice.PrimaryStorePrice, primary)
+	s.Require().Equal(globalPrice.SecondaryStorePrice, secondary)
+	primary, _ = globalPrice.GetStorePriceByChargeSize(100000, types.RedundancyTypeEC)
+	s.Require().Equal(sdk.NewDec(80), primary)
+	primary, _ = globalPrice.GetStorePriceByChargeSize(100000, types.RedundancyTypeReplica)
+	s.Require().Equal(sdk.NewDec(120), primary)
+
+	res, err := keeper.StorePriceTiers(ctx, &types.QueryStorePriceTiersRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(4, len(res.Tiers))
+	s.Require().Equal(uint64(0), res.Tiers[0].MinChargeSize)
+	s.Require().Equal(types.RedundancyTypeReplica, res.Tiers[2].RedundancyType)
+	s.Require().Equal(uint64(0), res.Tiers[2].MinChargeSize)
+}
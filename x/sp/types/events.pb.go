@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	common "github.com/bnb-chain/greenfield/types/common"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -191,6 +192,8 @@ type EventEditStorageProvider struct {
 	MaintenanceAddress string `protobuf:"bytes,8,opt,name=maintenance_address,json=maintenanceAddress,proto3" json:"maintenance_address,omitempty"`
 	// bls_key defines the bls pub key owned by storage provider used when sealing object
 	BlsKey string `protobuf:"bytes,9,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// free_capacity defines the remaining physical capacity in bytes reported by the storage provider
+	FreeCapacity *common.UInt64Value `protobuf:"bytes,10,opt,name=free_capacity,json=freeCapacity,proto3" json:"free_capacity,omitempty"`
 }
 
 func (m *EventEditStorageProvider) Reset()         { *m = EventEditStorageProvider{} }
@@ -289,6 +292,13 @@ func (m *EventEditStorageProvider) GetBlsKey() string {
 	return ""
 }
 
+func (m *EventEditStorageProvider) GetFreeCapacity() *common.UInt64Value {
+	if m != nil {
+		return m.FreeCapacity
+	}
+	return nil
+}
+
 // EventDeposit is emitted when sp deposit tokens.
 type EventDeposit struct {
 	// funding_address is the funding account address of the storage provider
@@ -364,6 +374,8 @@ type EventSpStoragePriceUpdate struct {
 	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in bnb wei per charge byte
 	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers by the total charge size of a bucket
+	StorePriceTiers []StorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *EventSpStoragePriceUpdate) Reset()         { *m = EventSpStoragePriceUpdate{} }
@@ -420,6 +432,90 @@ func (m *EventSpStoragePriceUpdate) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *EventSpStoragePriceUpdate) GetStorePriceTiers() []StorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+// EventSpStoragePriceScheduled is emitted when a sp schedules a price change
+type EventSpStoragePriceScheduled struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the time the price takes effect, in unix timestamp
+	EffectiveTime int64 `protobuf:"varint,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	// read price, in bnb wei per charge byte
+	ReadPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=read_price,json=readPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"read_price"`
+	// free read quota, in byte
+	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
+	// store price, in bnb wei per charge byte
+	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers by the total charge size of a bucket
+	StorePriceTiers []StorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
+}
+
+func (m *EventSpStoragePriceScheduled) Reset()         { *m = EventSpStoragePriceScheduled{} }
+func (m *EventSpStoragePriceScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventSpStoragePriceScheduled) ProtoMessage()    {}
+func (*EventSpStoragePriceScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{4}
+}
+func (m *EventSpStoragePriceScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSpStoragePriceScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSpStoragePriceScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSpStoragePriceScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSpStoragePriceScheduled.Merge(m, src)
+}
+func (m *EventSpStoragePriceScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSpStoragePriceScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSpStoragePriceScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSpStoragePriceScheduled proto.InternalMessageInfo
+
+func (m *EventSpStoragePriceScheduled) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventSpStoragePriceScheduled) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+func (m *EventSpStoragePriceScheduled) GetFreeReadQuota() uint64 {
+	if m != nil {
+		return m.FreeReadQuota
+	}
+	return 0
+}
+
+func (m *EventSpStoragePriceScheduled) GetStorePriceTiers() []StorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
 type EventGlobalSpStorePriceUpdate struct {
 	// update time, in unix timestamp
 	UpdateTimeSec int64 `protobuf:"varint,1,opt,name=update_time_sec,json=updateTimeSec,proto3" json:"update_time_sec,omitempty"`
@@ -429,13 +525,15 @@ type EventGlobalSpStorePriceUpdate struct {
 	PrimaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"primary_store_price"`
 	// secondary store price, in bnb wei per charge byte
 	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+	// store price tiers by the total charge size of a bucket
+	StorePriceTiers []GlobalStorePriceTier `protobuf:"bytes,5,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *EventGlobalSpStorePriceUpdate) Reset()         { *m = EventGlobalSpStorePriceUpdate{} }
 func (m *EventGlobalSpStorePriceUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventGlobalSpStorePriceUpdate) ProtoMessage()    {}
 func (*EventGlobalSpStorePriceUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685cbfa50fdf0841, []int{4}
+	return fileDescriptor_685cbfa50fdf0841, []int{5}
 }
 func (m *EventGlobalSpStorePriceUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,6 +569,13 @@ func (m *EventGlobalSpStorePriceUpdate) GetUpdateTimeSec() int64 {
 	return 0
 }
 
+func (m *EventGlobalSpStorePriceUpdate) GetStorePriceTiers() []GlobalStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
 // EventUpdateStorageProviderStatus is emitted when the SP update its status successfully
 type EventUpdateStorageProviderStatus struct {
 	// sp_id defines the identifier of storage provider which generated on-chain
@@ -487,7 +592,7 @@ func (m *EventUpdateStorageProviderStatus) Reset()         { *m = EventUpdateSto
 func (m *EventUpdateStorageProviderStatus) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateStorageProviderStatus) ProtoMessage()    {}
 func (*EventUpdateStorageProviderStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_685cbfa50fdf0841, []int{5}
+	return fileDescriptor_685cbfa50fdf0841, []int{6}
 }
 func (m *EventUpdateStorageProviderStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,71 +649,366 @@ func (m *EventUpdateStorageProviderStatus) GetNewStatus() string {
 	return ""
 }
 
+// EventJailStorageProvider is emitted when a SP is jailed
+type EventJailStorageProvider struct {
+	// sp_id defines the identifier of storage provider which generated on-chain
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// sp_address is the operator address of the storage provider
+	SpAddress string `protobuf:"bytes,2,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// reason is why the storage provider is jailed
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// release_time is the timestamp after which the storage provider can unjail itself
+	ReleaseTime int64 `protobuf:"varint,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+}
+
+func (m *EventJailStorageProvider) Reset()         { *m = EventJailStorageProvider{} }
+func (m *EventJailStorageProvider) String() string { return proto.CompactTextString(m) }
+func (*EventJailStorageProvider) ProtoMessage()    {}
+func (*EventJailStorageProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{7}
+}
+func (m *EventJailStorageProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJailStorageProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJailStorageProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJailStorageProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJailStorageProvider.Merge(m, src)
+}
+func (m *EventJailStorageProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJailStorageProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJailStorageProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJailStorageProvider proto.InternalMessageInfo
+
+func (m *EventJailStorageProvider) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventJailStorageProvider) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+func (m *EventJailStorageProvider) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventJailStorageProvider) GetReleaseTime() int64 {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return 0
+}
+
+// EventUnjailStorageProvider is emitted when a SP unjails itself successfully
+type EventUnjailStorageProvider struct {
+	// sp_id defines the identifier of storage provider which generated on-chain
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// sp_address is the operator address of the storage provider
+	SpAddress string `protobuf:"bytes,2,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+}
+
+func (m *EventUnjailStorageProvider) Reset()         { *m = EventUnjailStorageProvider{} }
+func (m *EventUnjailStorageProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnjailStorageProvider) ProtoMessage()    {}
+func (*EventUnjailStorageProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{8}
+}
+func (m *EventUnjailStorageProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnjailStorageProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnjailStorageProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnjailStorageProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnjailStorageProvider.Merge(m, src)
+}
+func (m *EventUnjailStorageProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnjailStorageProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnjailStorageProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnjailStorageProvider proto.InternalMessageInfo
+
+func (m *EventUnjailStorageProvider) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventUnjailStorageProvider) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+// EventWithdrawDeposit is emitted when a SP withdraws its deposit, the deposit is locked until the unlock time
+type EventWithdrawDeposit struct {
+	// sp_id defines the identifier of storage provider which generated on-chain
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// funding_address is the funding account address of the storage provider
+	FundingAddress string `protobuf:"bytes,2,opt,name=funding_address,json=fundingAddress,proto3" json:"funding_address,omitempty"`
+	// withdraw is the token coin withdrawn this message
+	Withdraw string `protobuf:"bytes,3,opt,name=withdraw,proto3" json:"withdraw,omitempty"`
+	// total_deposit is the total token coins this storage provider deposited after the withdrawal
+	TotalDeposit string `protobuf:"bytes,4,opt,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	// unlock_time is the timestamp when the withdrawn deposit is sent back to the funding address
+	UnlockTime int64 `protobuf:"varint,5,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+}
+
+func (m *EventWithdrawDeposit) Reset()         { *m = EventWithdrawDeposit{} }
+func (m *EventWithdrawDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawDeposit) ProtoMessage()    {}
+func (*EventWithdrawDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{9}
+}
+func (m *EventWithdrawDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawDeposit.Merge(m, src)
+}
+func (m *EventWithdrawDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawDeposit proto.InternalMessageInfo
+
+func (m *EventWithdrawDeposit) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventWithdrawDeposit) GetFundingAddress() string {
+	if m != nil {
+		return m.FundingAddress
+	}
+	return ""
+}
+
+func (m *EventWithdrawDeposit) GetWithdraw() string {
+	if m != nil {
+		return m.Withdraw
+	}
+	return ""
+}
+
+func (m *EventWithdrawDeposit) GetTotalDeposit() string {
+	if m != nil {
+		return m.TotalDeposit
+	}
+	return ""
+}
+
+func (m *EventWithdrawDeposit) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+// EventDepositUnlocked is emitted when the withdrawn deposit of a SP is unlocked and sent back to the funding address
+type EventDepositUnlocked struct {
+	// sp_id defines the identifier of storage provider which generated on-chain
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// funding_address is the funding account address of the storage provider
+	FundingAddress string `protobuf:"bytes,2,opt,name=funding_address,json=fundingAddress,proto3" json:"funding_address,omitempty"`
+	// amount is the token coin unlocked
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventDepositUnlocked) Reset()         { *m = EventDepositUnlocked{} }
+func (m *EventDepositUnlocked) String() string { return proto.CompactTextString(m) }
+func (*EventDepositUnlocked) ProtoMessage()    {}
+func (*EventDepositUnlocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_685cbfa50fdf0841, []int{10}
+}
+func (m *EventDepositUnlocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDepositUnlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDepositUnlocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDepositUnlocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDepositUnlocked.Merge(m, src)
+}
+func (m *EventDepositUnlocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDepositUnlocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDepositUnlocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDepositUnlocked proto.InternalMessageInfo
+
+func (m *EventDepositUnlocked) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventDepositUnlocked) GetFundingAddress() string {
+	if m != nil {
+		return m.FundingAddress
+	}
+	return ""
+}
+
+func (m *EventDepositUnlocked) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateStorageProvider)(nil), "greenfield.sp.EventCreateStorageProvider")
 	proto.RegisterType((*EventEditStorageProvider)(nil), "greenfield.sp.EventEditStorageProvider")
 	proto.RegisterType((*EventDeposit)(nil), "greenfield.sp.EventDeposit")
 	proto.RegisterType((*EventSpStoragePriceUpdate)(nil), "greenfield.sp.EventSpStoragePriceUpdate")
+	proto.RegisterType((*EventSpStoragePriceScheduled)(nil), "greenfield.sp.EventSpStoragePriceScheduled")
 	proto.RegisterType((*EventGlobalSpStorePriceUpdate)(nil), "greenfield.sp.EventGlobalSpStorePriceUpdate")
 	proto.RegisterType((*EventUpdateStorageProviderStatus)(nil), "greenfield.sp.EventUpdateStorageProviderStatus")
+	proto.RegisterType((*EventJailStorageProvider)(nil), "greenfield.sp.EventJailStorageProvider")
+	proto.RegisterType((*EventUnjailStorageProvider)(nil), "greenfield.sp.EventUnjailStorageProvider")
+	proto.RegisterType((*EventWithdrawDeposit)(nil), "greenfield.sp.EventWithdrawDeposit")
+	proto.RegisterType((*EventDepositUnlocked)(nil), "greenfield.sp.EventDepositUnlocked")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/events.proto", fileDescriptor_685cbfa50fdf0841) }
 
 var fileDescriptor_685cbfa50fdf0841 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xf6, 0x5a, 0x3f, 0xb6, 0x46, 0x56, 0xdc, 0xae, 0x13, 0x74, 0x2d, 0xc0, 0x8a, 0xa0, 0x02,
-	0x81, 0x51, 0x40, 0x12, 0x92, 0x1e, 0x72, 0x68, 0x51, 0x20, 0xb6, 0x82, 0x22, 0xe8, 0xa5, 0x5d,
-	0xb5, 0x97, 0x16, 0xc5, 0x82, 0xbb, 0x1c, 0x6f, 0x88, 0x48, 0x24, 0x4b, 0x52, 0x4e, 0x7d, 0xef,
-	0x03, 0xe4, 0xde, 0x77, 0xe8, 0x29, 0xc7, 0x3e, 0x40, 0x8e, 0x41, 0x4e, 0x45, 0x0f, 0x41, 0x61,
-	0x1f, 0xfa, 0x1a, 0xc5, 0x72, 0xb9, 0xd2, 0x4a, 0x30, 0x20, 0xd4, 0x56, 0x4e, 0x36, 0x39, 0xfc,
-	0xbe, 0x19, 0x7e, 0xf3, 0x71, 0xb4, 0xd0, 0x4e, 0x15, 0x22, 0x3f, 0x63, 0x38, 0xa1, 0x43, 0x2d,
-	0x87, 0x78, 0x8e, 0xdc, 0xe8, 0x81, 0x54, 0xc2, 0x08, 0xbf, 0xb5, 0x88, 0x0d, 0xb4, 0x6c, 0x77,
-	0x12, 0xa1, 0xa7, 0x42, 0x0f, 0x63, 0xa2, 0x71, 0x78, 0xfe, 0x30, 0x46, 0x43, 0x1e, 0x0e, 0x13,
-	0xc1, 0x78, 0x7e, 0xbc, 0x7d, 0x98, 0xc7, 0x23, 0xbb, 0x1a, 0xe6, 0x0b, 0x17, 0xba, 0x9b, 0x8a,
-	0x54, 0xe4, 0xfb, 0xd9, 0x7f, 0x05, 0x60, 0x39, 0xb7, 0xb9, 0x90, 0xe8, 0x00, 0xbd, 0xdf, 0x6b,
-	0xd0, 0x7e, 0x9a, 0xd5, 0x72, 0xaa, 0x90, 0x18, 0x1c, 0x1b, 0xa1, 0x48, 0x8a, 0xdf, 0x2a, 0x71,
-	0xce, 0x28, 0x2a, 0xff, 0x00, 0x6a, 0x5a, 0x46, 0x8c, 0x06, 0x5e, 0xd7, 0x3b, 0x6e, 0x85, 0x55,
-	0x2d, 0x9f, 0x51, 0xff, 0x31, 0x80, 0x96, 0x11, 0xa1, 0x54, 0xa1, 0xd6, 0xc1, 0x76, 0xd7, 0x3b,
-	0x6e, 0x9c, 0x04, 0xef, 0x5e, 0xf7, 0xef, 0xba, 0x52, 0x9e, 0xe4, 0x91, 0xb1, 0x51, 0x8c, 0xa7,
-	0x61, 0x43, 0x4b, 0xb7, 0xe1, 0x3f, 0x81, 0xfd, 0xb3, 0x19, 0xa7, 0x8c, 0xa7, 0x73, 0x74, 0x65,
-	0x0d, 0xfa, 0x8e, 0x03, 0x14, 0x14, 0x5f, 0xc0, 0x9e, 0x46, 0x32, 0x99, 0xe3, 0xab, 0x6b, 0xf0,
-	0xcd, 0xec, 0x74, 0x01, 0x3e, 0x85, 0x8f, 0x88, 0x94, 0x4a, 0x9c, 0x97, 0x08, 0x6a, 0x6b, 0x08,
-	0xf6, 0x0b, 0x44, 0x41, 0xf2, 0x18, 0x20, 0x4d, 0xe6, 0xf0, 0xfa, 0xba, 0xdb, 0xa7, 0x49, 0x01,
-	0x7c, 0x06, 0x07, 0x53, 0xc2, 0xb8, 0x41, 0x4e, 0x78, 0x82, 0x73, 0x86, 0x9d, 0x35, 0x0c, 0x7e,
-	0x09, 0x54, 0x50, 0xb5, 0x61, 0x17, 0x39, 0x95, 0x82, 0x71, 0x13, 0xec, 0x66, 0xf8, 0x70, 0xbe,
-	0xf6, 0xbf, 0x82, 0x96, 0x11, 0x86, 0x4c, 0x22, 0x8a, 0x52, 0x68, 0x66, 0x82, 0x46, 0xd7, 0x3b,
-	0x6e, 0x3e, 0x3a, 0x1c, 0x38, 0xf6, 0xcc, 0x55, 0x03, 0xe7, 0xaa, 0xc1, 0xa9, 0x60, 0x3c, 0xdc,
-	0xb3, 0xe7, 0x47, 0xf9, 0x71, 0xbf, 0x0f, 0x75, 0x6d, 0x88, 0x99, 0xe9, 0x00, 0xba, 0xde, 0xf1,
-	0x9d, 0x47, 0xf7, 0x06, 0x4b, 0xee, 0x1c, 0x8c, 0x6d, 0x30, 0x74, 0x87, 0xfc, 0x13, 0x68, 0x52,
-	0xd4, 0x89, 0x62, 0xd2, 0x30, 0xc1, 0x83, 0xa6, 0x4d, 0xd6, 0x5e, 0xc1, 0x8c, 0x16, 0x27, 0x4e,
-	0xaa, 0x6f, 0xde, 0xdf, 0xdf, 0x0a, 0xcb, 0x20, 0xff, 0x13, 0xd8, 0x89, 0x27, 0x3a, 0x7a, 0x81,
-	0x17, 0xc1, 0x9e, 0xbd, 0x4d, 0x3d, 0x9e, 0xe8, 0x6f, 0xf0, 0xa2, 0xf7, 0x6f, 0x05, 0x02, 0xeb,
-	0xce, 0xa7, 0x94, 0x99, 0x0f, 0xeb, 0xcd, 0xb2, 0xa4, 0x95, 0x15, 0x49, 0x57, 0xee, 0x58, 0xbd,
-	0xc9, 0x1d, 0x57, 0x8d, 0x5b, 0xbb, 0xad, 0x71, 0xeb, 0xb7, 0x33, 0xee, 0xce, 0xad, 0x8d, 0xbb,
-	0x7b, 0x03, 0xe3, 0x96, 0x3a, 0xdd, 0x58, 0xea, 0xf4, 0x2b, 0x0f, 0xf6, 0x6c, 0xa7, 0x0b, 0x1b,
-	0x5e, 0x33, 0x2b, 0xbc, 0xff, 0x39, 0x2b, 0x02, 0xd8, 0x29, 0xde, 0x80, 0x35, 0x42, 0x58, 0x2c,
-	0xfd, 0x4f, 0x57, 0xdf, 0x48, 0xde, 0xf1, 0xa5, 0x87, 0xd0, 0xfb, 0x73, 0x1b, 0x0e, 0x6d, 0x49,
-	0x63, 0x39, 0xb7, 0x1e, 0x4b, 0xf0, 0x07, 0x49, 0x89, 0xc1, 0xeb, 0xdd, 0xf7, 0x00, 0xf6, 0x67,
-	0x36, 0x1c, 0x19, 0x36, 0xc5, 0x48, 0x63, 0x62, 0x33, 0x57, 0xc2, 0x56, 0xbe, 0xfd, 0x3d, 0x9b,
-	0xe2, 0x18, 0x13, 0xff, 0x27, 0x00, 0x85, 0x84, 0x46, 0x32, 0x23, 0x74, 0x33, 0xf0, 0xcb, 0xcc,
-	0x33, 0x7f, 0xbf, 0xbf, 0xff, 0x20, 0x65, 0xe6, 0xf9, 0x2c, 0x1e, 0x24, 0x62, 0xea, 0x66, 0xbb,
-	0xfb, 0xd3, 0xd7, 0xf4, 0x85, 0x9b, 0xdd, 0x23, 0x4c, 0xde, 0xbd, 0xee, 0x83, 0x53, 0x61, 0x84,
-	0x49, 0xd8, 0xc8, 0xf8, 0x6c, 0x7d, 0x59, 0x11, 0x67, 0x0a, 0x31, 0xb2, 0x19, 0x7e, 0x99, 0x09,
-	0x43, 0xac, 0x63, 0xab, 0x61, 0x2b, 0xdb, 0x0e, 0x91, 0xd0, 0xef, 0xb2, 0x4d, 0xff, 0x67, 0x68,
-	0x6a, 0x23, 0x14, 0xba, 0x2a, 0x6a, 0x1b, 0xa8, 0x02, 0x2c, 0xa1, 0x2d, 0xa3, 0xf7, 0x5b, 0x05,
-	0x8e, 0xac, 0x7c, 0x5f, 0x4f, 0x44, 0x4c, 0x26, 0xb9, 0x88, 0x4b, 0x12, 0x5e, 0xa3, 0x96, 0xb7,
-	0x5e, 0xad, 0xed, 0xcd, 0xaa, 0x35, 0x81, 0x03, 0xa9, 0xd8, 0x94, 0xa8, 0x8b, 0xa8, 0xac, 0xc6,
-	0x26, 0x7a, 0xf2, 0xb1, 0x23, 0x5e, 0x5c, 0xdc, 0x97, 0x70, 0x4f, 0x63, 0x22, 0x38, 0x5d, 0xcd,
-	0x57, 0xdd, 0x40, 0xbe, 0x83, 0x39, 0xf5, 0x22, 0x63, 0xef, 0x0f, 0x0f, 0xba, 0xb6, 0x0d, 0xb9,
-	0xe8, 0x2b, 0x43, 0x34, 0x1f, 0xe6, 0x1b, 0x1e, 0xa5, 0x47, 0x00, 0x52, 0x61, 0xe4, 0x7e, 0x45,
-	0xf2, 0xa7, 0xd5, 0x90, 0x0a, 0x5d, 0xb2, 0x23, 0x00, 0x8e, 0x2f, 0x8b, 0x70, 0x35, 0x0f, 0x73,
-	0x7c, 0x99, 0x87, 0x4f, 0x46, 0x6f, 0x2e, 0x3b, 0xde, 0xdb, 0xcb, 0x8e, 0xf7, 0xcf, 0x65, 0xc7,
-	0x7b, 0x75, 0xd5, 0xd9, 0x7a, 0x7b, 0xd5, 0xd9, 0xfa, 0xeb, 0xaa, 0xb3, 0xf5, 0xe3, 0x67, 0x25,
-	0x55, 0x62, 0x1e, 0xf7, 0x93, 0xe7, 0x84, 0xf1, 0x61, 0xe9, 0xdb, 0xe6, 0xd7, 0xf9, 0xd7, 0x4d,
-	0x5c, 0xb7, 0x9f, 0x37, 0x9f, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x8d, 0xb2, 0x3d, 0x77,
-	0x09, 0x00, 0x00,
+	// 1084 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x3f, 0x12, 0x3f, 0x3b, 0xc9, 0xb7, 0x9b, 0xb4, 0x5f, 0xc7, 0x22, 0x4e, 0x70,
+	0x45, 0x15, 0x21, 0xc5, 0x56, 0x03, 0xa2, 0x07, 0x10, 0x52, 0x93, 0x54, 0x28, 0x70, 0x00, 0xd6,
+	0x0d, 0x48, 0x20, 0xb4, 0x1a, 0xef, 0xbe, 0x38, 0xd3, 0xac, 0x67, 0x86, 0x99, 0x71, 0x42, 0xfe,
+	0x01, 0x6e, 0x88, 0xde, 0x39, 0x72, 0x45, 0x9c, 0xfa, 0x47, 0xf4, 0x18, 0xf5, 0x84, 0x40, 0xaa,
+	0x50, 0xf2, 0x8f, 0xa0, 0x9d, 0x9d, 0x75, 0x36, 0x8e, 0x25, 0xd3, 0xc4, 0xb9, 0x71, 0xb2, 0x67,
+	0xde, 0x7c, 0xde, 0x7b, 0xf3, 0x3e, 0x9f, 0x99, 0x37, 0x0b, 0xb5, 0xae, 0x44, 0x64, 0xfb, 0x14,
+	0xa3, 0xb0, 0xa5, 0x44, 0x0b, 0x8f, 0x90, 0x69, 0xd5, 0x14, 0x92, 0x6b, 0xee, 0xce, 0x5d, 0xd8,
+	0x9a, 0x4a, 0xd4, 0xea, 0x01, 0x57, 0x3d, 0xae, 0x5a, 0x1d, 0xa2, 0xb0, 0x75, 0xf4, 0xb0, 0x83,
+	0x9a, 0x3c, 0x6c, 0x05, 0x9c, 0xb2, 0x64, 0x79, 0x6d, 0x39, 0xb1, 0xfb, 0x66, 0xd4, 0x4a, 0x06,
+	0xd6, 0xb4, 0xd4, 0xe5, 0x5d, 0x9e, 0xcc, 0xc7, 0xff, 0xec, 0xec, 0x6a, 0x26, 0x76, 0xc0, 0x7b,
+	0x3d, 0xce, 0x5a, 0xc7, 0x92, 0x08, 0x81, 0x32, 0xf5, 0x78, 0x39, 0x39, 0x7d, 0x22, 0xd0, 0x7a,
+	0x6c, 0xfc, 0x52, 0x80, 0xda, 0x93, 0x38, 0xd9, 0x6d, 0x89, 0x44, 0x63, 0x5b, 0x73, 0x49, 0xba,
+	0xf8, 0x85, 0xe4, 0x47, 0x34, 0x44, 0xe9, 0x2e, 0x42, 0x41, 0x09, 0x9f, 0x86, 0x55, 0x67, 0xcd,
+	0x59, 0x9f, 0xf3, 0xf2, 0x4a, 0xec, 0x86, 0xee, 0x23, 0x00, 0x25, 0x7c, 0x12, 0x86, 0x12, 0x95,
+	0xaa, 0x4e, 0xaf, 0x39, 0xeb, 0xa5, 0xad, 0xea, 0xab, 0x17, 0x1b, 0x4b, 0x36, 0xd7, 0xc7, 0x89,
+	0xa5, 0xad, 0x25, 0x65, 0x5d, 0xaf, 0xa4, 0x84, 0x9d, 0x70, 0x1f, 0xc3, 0xc2, 0x7e, 0x9f, 0x85,
+	0x94, 0x75, 0x07, 0xe8, 0xdc, 0x18, 0xf4, 0xbc, 0x05, 0xa4, 0x2e, 0x3e, 0x84, 0x8a, 0x42, 0x12,
+	0x0d, 0xf0, 0xf9, 0x31, 0xf8, 0x72, 0xbc, 0x3a, 0x05, 0x6f, 0xc3, 0xff, 0x88, 0x10, 0x92, 0x1f,
+	0x65, 0x1c, 0x14, 0xc6, 0x38, 0x58, 0x48, 0x11, 0xa9, 0x93, 0x47, 0x00, 0xdd, 0x60, 0x00, 0x2f,
+	0x8e, 0xdb, 0x7d, 0x37, 0x48, 0x81, 0xbb, 0xb0, 0xd8, 0x23, 0x94, 0x69, 0x64, 0x84, 0x05, 0x38,
+	0xf0, 0x30, 0x33, 0xc6, 0x83, 0x9b, 0x01, 0xa5, 0xae, 0x6a, 0x30, 0x8b, 0x2c, 0x14, 0x9c, 0x32,
+	0x5d, 0x9d, 0x8d, 0xf1, 0xde, 0x60, 0xec, 0x7e, 0x0c, 0x73, 0x9a, 0x6b, 0x12, 0xf9, 0x21, 0x0a,
+	0xae, 0xa8, 0xae, 0x96, 0xd6, 0x9c, 0xf5, 0xf2, 0xe6, 0x72, 0xd3, 0x7a, 0x8f, 0x65, 0xd7, 0xb4,
+	0xb2, 0x6b, 0x6e, 0x73, 0xca, 0xbc, 0x8a, 0x59, 0xbf, 0x93, 0x2c, 0x77, 0x37, 0xa0, 0xa8, 0x34,
+	0xd1, 0x7d, 0x55, 0x85, 0x35, 0x67, 0x7d, 0x7e, 0xf3, 0x6e, 0xf3, 0x92, 0x7c, 0x9b, 0x6d, 0x63,
+	0xf4, 0xec, 0x22, 0x77, 0x0b, 0xca, 0x21, 0xaa, 0x40, 0x52, 0xa1, 0x29, 0x67, 0xd5, 0xb2, 0x09,
+	0x56, 0x1b, 0xc2, 0xec, 0x5c, 0xac, 0xd8, 0xca, 0xbf, 0x7c, 0xbd, 0x3a, 0xe5, 0x65, 0x41, 0xee,
+	0xff, 0x61, 0xa6, 0x13, 0x29, 0xff, 0x10, 0x4f, 0xaa, 0x15, 0xb3, 0x9b, 0x62, 0x27, 0x52, 0x9f,
+	0xe1, 0x49, 0xe3, 0xb7, 0x3c, 0x54, 0x8d, 0x3a, 0x9f, 0x84, 0x54, 0xdf, 0xae, 0x36, 0xb3, 0x25,
+	0xcd, 0x0d, 0x95, 0x74, 0x68, 0x8f, 0xf9, 0xeb, 0xec, 0x71, 0x58, 0xb8, 0x85, 0x9b, 0x0a, 0xb7,
+	0x78, 0x33, 0xe1, 0xce, 0xdc, 0x58, 0xb8, 0xb3, 0xd7, 0x10, 0x6e, 0x86, 0xe9, 0x52, 0x96, 0x69,
+	0x77, 0x1b, 0xe6, 0xf6, 0x25, 0xa2, 0x1f, 0x10, 0x41, 0x02, 0xaa, 0x4f, 0x8c, 0xf8, 0xca, 0x9b,
+	0xf5, 0x6c, 0x91, 0x93, 0xbb, 0xad, 0xb9, 0xb7, 0xcb, 0xf4, 0x07, 0xef, 0x7f, 0x45, 0xa2, 0x3e,
+	0x7a, 0x95, 0x18, 0xb4, 0x6d, 0x31, 0x8d, 0xe7, 0x0e, 0x54, 0x8c, 0x5c, 0x52, 0x2d, 0x8f, 0xb8,
+	0x70, 0x9c, 0x37, 0xbc, 0x70, 0xaa, 0x30, 0x93, 0x1e, 0x24, 0xa3, 0x26, 0x2f, 0x1d, 0xba, 0xf7,
+	0x87, 0x0f, 0x5a, 0x22, 0x9b, 0x4b, 0xa7, 0xa9, 0xf1, 0x53, 0x0e, 0x96, 0x4d, 0x4a, 0x6d, 0x31,
+	0xd0, 0x2f, 0x0d, 0x70, 0x4f, 0x84, 0x44, 0xe3, 0x68, 0x09, 0x3f, 0x80, 0x85, 0xbe, 0x31, 0xfb,
+	0x9a, 0xf6, 0xd0, 0x57, 0x18, 0x98, 0xc8, 0x39, 0x6f, 0x2e, 0x99, 0x7e, 0x4a, 0x7b, 0xd8, 0xc6,
+	0xc0, 0xfd, 0x16, 0x40, 0x22, 0x09, 0x7d, 0x11, 0x3b, 0xb4, 0x17, 0xe9, 0x47, 0xb1, 0xf0, 0xfe,
+	0x7c, 0xbd, 0xfa, 0xa0, 0x4b, 0xf5, 0x41, 0xbf, 0x13, 0x97, 0xcc, 0x76, 0x10, 0xfb, 0xb3, 0xa1,
+	0xc2, 0x43, 0xdb, 0x00, 0x76, 0x30, 0x78, 0xf5, 0x62, 0x03, 0x6c, 0x15, 0x76, 0x30, 0xf0, 0x4a,
+	0xb1, 0x3f, 0x93, 0x5f, 0x9c, 0x84, 0xe1, 0xc3, 0x44, 0xf8, 0xbe, 0xcf, 0x35, 0x31, 0xb2, 0xcf,
+	0x7b, 0x86, 0x26, 0x0f, 0x49, 0xf8, 0x65, 0x3c, 0xe9, 0x7e, 0x07, 0x65, 0xa5, 0xb9, 0x44, 0x9b,
+	0x45, 0x61, 0x02, 0x59, 0x80, 0x71, 0x98, 0xa4, 0xf1, 0x39, 0xdc, 0xc9, 0xb8, 0xf7, 0x35, 0x45,
+	0x19, 0x2b, 0x3f, 0xb7, 0x5e, 0xde, 0x5c, 0xb9, 0x72, 0x2f, 0xa5, 0xa8, 0xa7, 0x14, 0xa5, 0x3d,
+	0x82, 0x0b, 0xea, 0xd2, 0xac, 0x6a, 0xfc, 0x9c, 0x83, 0xb7, 0x46, 0xf0, 0xd1, 0x0e, 0x0e, 0x30,
+	0xec, 0x47, 0x18, 0x8e, 0xa6, 0xe4, 0x1d, 0x98, 0xc7, 0xfd, 0x7d, 0x0c, 0x34, 0x3d, 0x4a, 0x58,
+	0x49, 0x19, 0x19, 0xcc, 0xc6, 0xa4, 0xfc, 0xc7, 0xc8, 0x75, 0x18, 0xf9, 0x2b, 0x07, 0x2b, 0x86,
+	0x91, 0x4f, 0x22, 0xde, 0x21, 0x51, 0xc2, 0xcb, 0xa5, 0x53, 0x32, 0xe2, 0x40, 0x38, 0xe3, 0x0f,
+	0xc4, 0xf4, 0x64, 0xcb, 0x1f, 0xc1, 0xa2, 0x90, 0xb4, 0x47, 0xe4, 0x89, 0x9f, 0x2d, 0xef, 0x24,
+	0x48, 0xbe, 0x63, 0x1d, 0x5f, 0x6c, 0xdc, 0x15, 0x70, 0x57, 0x61, 0xc0, 0x59, 0x38, 0x1c, 0x2f,
+	0x3f, 0x81, 0x78, 0x8b, 0x03, 0xd7, 0x99, 0x88, 0x7b, 0xa3, 0x78, 0x2d, 0x18, 0x5e, 0xef, 0x0f,
+	0xf1, 0x6a, 0x89, 0xfa, 0x57, 0xec, 0xfe, 0xee, 0xc0, 0x9a, 0x61, 0x37, 0xe1, 0x72, 0xa8, 0x87,
+	0x27, 0x6f, 0x89, 0x09, 0x77, 0xf2, 0x15, 0x00, 0x21, 0xd1, 0xb7, 0x8f, 0x98, 0xe4, 0x52, 0x2e,
+	0x09, 0x89, 0x36, 0xd8, 0x0a, 0x00, 0xc3, 0xe3, 0xd4, 0x9c, 0x4f, 0xcc, 0x0c, 0x8f, 0x13, 0x73,
+	0xe3, 0x57, 0xc7, 0x3e, 0x39, 0x3e, 0x25, 0x34, 0xba, 0xdd, 0x27, 0xc7, 0x3d, 0x28, 0x4a, 0x24,
+	0x8a, 0x33, 0x9b, 0xa4, 0x1d, 0xb9, 0x6f, 0x43, 0x45, 0x62, 0x84, 0x44, 0xd9, 0xbb, 0x26, 0x6f,
+	0xc4, 0x5e, 0xb6, 0x73, 0xb1, 0xda, 0x1b, 0xcf, 0xec, 0xab, 0x7d, 0x8f, 0x3d, 0xbb, 0xed, 0x34,
+	0x1b, 0xa7, 0x0e, 0x2c, 0x99, 0x60, 0x5f, 0x53, 0x7d, 0x10, 0x4a, 0x72, 0x9c, 0x76, 0xd7, 0x91,
+	0x61, 0x46, 0xb4, 0xdc, 0xe9, 0x37, 0x6c, 0xb9, 0x35, 0x98, 0x3d, 0xb6, 0xa1, 0xd2, 0xa7, 0x58,
+	0x3a, 0xbe, 0xda, 0x74, 0xf3, 0x57, 0x9b, 0xae, 0xbb, 0x0a, 0xe5, 0x3e, 0x8b, 0x78, 0x70, 0x98,
+	0xd4, 0xaf, 0x60, 0xea, 0x07, 0xc9, 0x94, 0x29, 0xdf, 0x8f, 0xe9, 0x96, 0x2c, 0x62, 0xcf, 0x98,
+	0x30, 0xbc, 0xb5, 0x2d, 0xdd, 0x83, 0x22, 0xe9, 0xf1, 0xfe, 0xe0, 0x6d, 0x69, 0x47, 0x5b, 0x3b,
+	0x2f, 0xcf, 0xea, 0xce, 0xe9, 0x59, 0xdd, 0xf9, 0xfb, 0xac, 0xee, 0x3c, 0x3f, 0xaf, 0x4f, 0x9d,
+	0x9e, 0xd7, 0xa7, 0xfe, 0x38, 0xaf, 0x4f, 0x7d, 0xf3, 0x6e, 0xe6, 0x68, 0x77, 0x58, 0x67, 0x23,
+	0x38, 0x20, 0x94, 0xb5, 0x32, 0x1f, 0x72, 0x3f, 0x0c, 0x3e, 0xe5, 0x3a, 0x45, 0xf3, 0x2d, 0xf7,
+	0xde, 0x3f, 0x03, 0x00, 0x9e, 0xff, 0x1b, 0xf0, 0x85, 0x0e, 0x00, 0x00,
 }
 
 func (m *EventCreateStorageProvider) Marshal() (dAtA []byte, err error) {
@@ -742,6 +1142,18 @@ func (m *EventEditStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.FreeCapacity != nil {
+		{
+			size, err := m.FreeCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.BlsKey) > 0 {
 		i -= len(m.BlsKey)
 		copy(dAtA[i:], m.BlsKey)
@@ -873,6 +1285,20 @@ func (m *EventSpStoragePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.StorePrice.Size()
 		i -= size
@@ -911,6 +1337,78 @@ func (m *EventSpStoragePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSpStoragePriceScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSpStoragePriceScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSpStoragePriceScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FreeReadQuota != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EffectiveTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventGlobalSpStorePriceUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -931,6 +1429,20 @@ func (m *EventGlobalSpStorePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.SecondaryStorePrice.Size()
 		i -= size
@@ -1018,6 +1530,184 @@ func (m *EventUpdateStorageProviderStatus) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *EventJailStorageProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJailStorageProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJailStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReleaseTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnjailStorageProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnjailStorageProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnjailStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TotalDeposit) > 0 {
+		i -= len(m.TotalDeposit)
+		copy(dAtA[i:], m.TotalDeposit)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalDeposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Withdraw) > 0 {
+		i -= len(m.Withdraw)
+		copy(dAtA[i:], m.Withdraw)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Withdraw)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDepositUnlocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDepositUnlocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDepositUnlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1121,6 +1811,10 @@ func (m *EventEditStorageProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.FreeCapacity != nil {
+		l = m.FreeCapacity.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -1164,6 +1858,40 @@ func (m *EventSpStoragePriceUpdate) Size() (n int) {
 	}
 	l = m.StorePrice.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSpStoragePriceScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveTime))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1182,6 +1910,12 @@ func (m *EventGlobalSpStorePriceUpdate) Size() (n int) {
 	n += 1 + l + sovEvents(uint64(l))
 	l = m.SecondaryStorePrice.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1209,21 +1943,107 @@ func (m *EventUpdateStorageProviderStatus) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+func (m *EventJailStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReleaseTime != 0 {
+		n += 1 + sovEvents(uint64(m.ReleaseTime))
+	}
+	return n
+}
+
+func (m *EventUnjailStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdrawDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Withdraw)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TotalDeposit)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UnlockTime != 0 {
+		n += 1 + sovEvents(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func (m *EventDepositUnlocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateStorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1867,11 +2687,812 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreeCapacity == nil {
+				m.FreeCapacity = &common.UInt64Value{}
+			}
+			if err := m.FreeCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSpStoragePriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSpStoragePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, StorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSpStoragePriceScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSpStoragePriceScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSpStoragePriceScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, StorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1899,13 +3520,15 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1915,23 +3538,25 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			m.StorePriceTiers = append(m.StorePriceTiers, GlobalStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1954,7 +3579,7 @@ func (m *EventEditStorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1977,15 +3602,34 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2013,11 +3657,11 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2045,11 +3689,11 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.PreStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2077,7 +3721,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = string(dAtA[iNdEx:postIndex])
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2100,7 +3744,7 @@ func (m *EventDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventJailStorageProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,10 +3767,10 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSpStoragePriceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventJailStorageProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSpStoragePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventJailStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2149,10 +3793,10 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
-			m.UpdateTimeSec = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2162,14 +3806,27 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2197,15 +3854,13 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
-			m.FreeReadQuota = 0
+			m.ReleaseTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2215,14 +3870,83 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				m.ReleaseTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnjailStorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailStorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2250,9 +3974,7 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2275,7 +3997,7 @@ func (m *EventSpStoragePriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2298,17 +4020,17 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdrawDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGlobalSpStorePriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdrawDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2318,14 +4040,14 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2353,13 +4075,11 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2387,13 +4107,11 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Withdraw = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2421,10 +4139,27 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TotalDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2446,7 +4181,7 @@ func (m *EventGlobalSpStorePriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
+func (m *EventDepositUnlocked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2469,10 +4204,10 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDepositUnlocked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateStorageProviderStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDepositUnlocked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2496,7 +4231,7 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2524,43 +4259,11 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2588,7 +4291,7 @@ func (m *EventUpdateStorageProviderStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7,6 +7,7 @@ package types
 import (
 	reflect "reflect"
 
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
@@ -195,6 +196,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx types.Context, senderModule, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx types.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -274,3 +289,40 @@ func (mr *MockAuthzKeeperMockRecorder) Update(ctx, grantee, granter, updated int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAuthzKeeper)(nil).Update), ctx, grantee, granter, updated)
 }
+
+// MockChallengeKeeper is a mock of ChallengeKeeper interface.
+type MockChallengeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockChallengeKeeperMockRecorder
+}
+
+// MockChallengeKeeperMockRecorder is the mock recorder for MockChallengeKeeper.
+type MockChallengeKeeperMockRecorder struct {
+	mock *MockChallengeKeeper
+}
+
+// NewMockChallengeKeeper creates a new mock instance.
+func NewMockChallengeKeeper(ctrl *gomock.Controller) *MockChallengeKeeper {
+	mock := &MockChallengeKeeper{ctrl: ctrl}
+	mock.recorder = &MockChallengeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChallengeKeeper) EXPECT() *MockChallengeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetSpSlashExposure mocks base method.
+func (m *MockChallengeKeeper) GetSpSlashExposure(ctx types.Context, spId uint32) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpSlashExposure", ctx, spId)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetSpSlashExposure indicates an expected call of GetSpSlashExposure.
+func (mr *MockChallengeKeeperMockRecorder) GetSpSlashExposure(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpSlashExposure", reflect.TypeOf((*MockChallengeKeeper)(nil).GetSpSlashExposure), ctx, spId)
+}
@@ -36,6 +36,12 @@ var (
 	StorageProviderSequenceKey       = []byte{0x31}
 
 	StorageProviderMaintenanceRecordPrefix = []byte{0x41}
+	StorageProviderScorecardPrefix         = []byte{0x42}
+	StorageProviderJailRecordPrefix        = []byte{0x43}
+	ScheduledSpStoragePriceKeyPrefix       = []byte{0x44}
+	ScheduledSpStoragePriceQueueKeyPrefix  = []byte{0x45}
+	DepositUnbondingQueueKeyPrefix         = []byte{0x46}
+	DepositUnbondingBySpKeyPrefix          = []byte{0x47} // prefix for each key to a deposit unbonding index, by sp id
 )
 
 // GetStorageProviderKey creates the key for the provider with address
@@ -123,3 +129,60 @@ func ParseGlobalSpStorePriceKey(key []byte) (timestamp int64) {
 func GetStorageProviderMaintenanceRecordsKey(spAddr sdk.AccAddress) []byte {
 	return append(StorageProviderMaintenanceRecordPrefix, spAddr.Bytes()...)
 }
+
+func GetStorageProviderScorecardKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(StorageProviderScorecardPrefix, idBytes...)
+}
+
+func GetStorageProviderJailRecordKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(StorageProviderJailRecordPrefix, idBytes...)
+}
+
+// GetScheduledSpStoragePriceKey creates the key for the scheduled price change of the sp
+func GetScheduledSpStoragePriceKey(spId uint32) []byte {
+	idBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(idBytes, spId)
+	return append(ScheduledSpStoragePriceKeyPrefix, idBytes...)
+}
+
+// ScheduledSpStoragePriceQueueKey creates the key in the queue of scheduled price changes, sorted by effective time
+func ScheduledSpStoragePriceQueueKey(effectiveTime int64, spId uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(effectiveTime))
+	binary.BigEndian.PutUint32(key[8:], spId)
+	return key
+}
+
+// ParseScheduledSpStoragePriceQueueKey parses the effective time and sp id from the key in the queue
+func ParseScheduledSpStoragePriceQueueKey(key []byte) (effectiveTime int64, spId uint32) {
+	effectiveTime = int64(binary.BigEndian.Uint64(key[:8]))
+	spId = binary.BigEndian.Uint32(key[8:])
+	return
+}
+
+// DepositUnbondingQueueKey creates the key of the deposit unbonding queue, ordered by the unlock time
+func DepositUnbondingQueueKey(unlockTime int64, spId uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(unlockTime))
+	binary.BigEndian.PutUint32(key[8:], spId)
+	return key
+}
+
+// DepositUnbondingBySpKey creates the key of the deposit unbonding index, ordered by the sp id and then the unlock time
+func DepositUnbondingBySpKey(spId uint32, unlockTime int64) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint32(key, spId)
+	binary.BigEndian.PutUint64(key[4:], uint64(unlockTime))
+	return key
+}
+
+// DepositUnbondingBySpPrefix creates the prefix of the deposit unbonding index of the sp
+func DepositUnbondingBySpPrefix(spId uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, spId)
+	return key
+}
@@ -19,6 +19,8 @@ const (
 	TypeMsgUpdateSpStoragePrice        = "update_sp_storage_price"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgUpdateStorageProviderStatus = "update_storage_provider_status"
+	TypeMsgUnjailStorageProvider       = "unjail_storage_provider"
+	TypeMsgWithdrawDeposit             = "withdraw_deposit"
 )
 
 var (
@@ -29,6 +31,8 @@ var (
 	_ sdk.Msg = &MsgUpdateSpStoragePrice{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgUpdateStorageProviderStatus{}
+	_ sdk.Msg = &MsgUnjailStorageProvider{}
+	_ sdk.Msg = &MsgWithdrawDeposit{}
 )
 
 // NewMsgCreateStorageProvider creates a new MsgCreateStorageProvider instance.
@@ -267,6 +271,57 @@ func (msg *MsgDeposit) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawDeposit creates a new MsgWithdrawDeposit instance
+func NewMsgWithdrawDeposit(fundAddress sdk.AccAddress, spAddress sdk.AccAddress, withdraw sdk.Coin) *MsgWithdrawDeposit {
+	return &MsgWithdrawDeposit{
+		Creator:   fundAddress.String(),
+		SpAddress: spAddress.String(),
+		Withdraw:  withdraw,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgWithdrawDeposit) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgWithdrawDeposit) Type() string {
+	return TypeMsgWithdrawDeposit
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgWithdrawDeposit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgWithdrawDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgWithdrawDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sp address (%s)", err)
+	}
+
+	if !msg.Withdraw.IsValid() || !msg.Withdraw.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid withdraw amount")
+	}
+
+	return nil
+}
+
 func (msg *MsgUpdateSpStoragePrice) Route() string {
 	return RouterKey
 }
@@ -299,6 +354,12 @@ func (msg *MsgUpdateSpStoragePrice) ValidateBasic() error {
 	if msg.StorePrice.IsNil() || msg.StorePrice.IsNegative() {
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid store price (%s)", msg.StorePrice)
 	}
+	if err := ValidateStorePriceTiers(msg.StorePriceTiers); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid store price tiers (%s)", err)
+	}
+	if msg.EffectiveTime < 0 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid effective time (%d)", msg.EffectiveTime)
+	}
 	return nil
 }
 
@@ -374,6 +435,46 @@ func (msg *MsgUpdateStorageProviderStatus) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgUnjailStorageProvider creates a new MsgUnjailStorageProvider instance
+func NewMsgUnjailStorageProvider(spAddress sdk.AccAddress) *MsgUnjailStorageProvider {
+	return &MsgUnjailStorageProvider{
+		SpAddress: spAddress.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) Type() string {
+	return TypeMsgUnjailStorageProvider
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg *MsgUnjailStorageProvider) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgUnjailStorageProvider) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.SpAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
+
 func validateBlsKeyAndProof(blsKey, blsProof string) error {
 	blsPk, err := hex.DecodeString(blsKey)
 	if err != nil || len(blsPk) != sdk.BLSPubKeyLength {
@@ -117,3 +117,30 @@ func TestMsgDeposit_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgWithdrawDeposit_ValidateBasic(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	pk2 := ed25519.GenPrivKey().PubKey()
+	fundAddr := sdk.AccAddress(pk1.Address())
+	spAddr := sdk.AccAddress(pk2.Address())
+	tests := []struct {
+		name                   string
+		fundAddress, spAddress sdk.AccAddress
+		withdraw               sdk.Coin
+		err                    error
+	}{
+		{"basic", fundAddr, spAddr, coinPos, nil},
+		{"zero amount", fundAddr, spAddr, coinZero, sdkerrors.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := NewMsgWithdrawDeposit(tt.fundAddress, tt.spAddress, tt.withdraw)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -26,6 +26,12 @@ const (
 	DefaultUpdateGlobalPriceInterval uint64 = 0 // 0 means the global price will be updated at the first day of each month
 	// UpdatePriceDisallowedDays defines the days, counting backward from the end of a month, in which sp is not allowed to update its price
 	DefaultUpdatePriceDisallowedDays uint32 = 2
+	// DefaultUnjailCooldownDuration defines the seconds a jailed sp needs to wait before it can unjail itself
+	DefaultUnjailCooldownDuration int64 = 259200 // 3 days
+	// DefaultPriceChangeNoticeDays defines the min days in advance a sp should schedule its price change
+	DefaultPriceChangeNoticeDays uint32 = 7
+	// DefaultDepositUnbondingPeriod defines the seconds the withdrawn deposit of a sp is locked
+	DefaultDepositUnbondingPeriod int64 = 604800 // 7 days
 )
 
 var (
@@ -44,6 +50,9 @@ var (
 	KeyNumOfLockUpBlocksForMaintenance            = []byte("NumOfLockUpBlocksForMaintenance")
 	KeyUpdateGlobalPriceInterval                  = []byte("UpdateGlobalPriceInterval")
 	KeyUpdatePriceDisallowedDays                  = []byte("UpdatePriceDisallowedDays")
+	KeyUnjailCooldownDuration                     = []byte("UnjailCooldownDuration")
+	KeyPriceChangeNoticeDays                      = []byte("PriceChangeNoticeDays")
+	KeyDepositUnbondingPeriod                     = []byte("DepositUnbondingPeriod")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -56,7 +65,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRatio sdk.Dec,
 	historicalBlocksForMaintenanceRecords, maintenanceDurationQuota, lockUpBlocksForMaintenance int64,
-	updateGlobalPriceInterval uint64, updatePriceDisallowedDays uint32) Params {
+	updateGlobalPriceInterval uint64, updatePriceDisallowedDays uint32, unjailCooldownDuration int64,
+	priceChangeNoticeDays uint32, depositUnbondingPeriod int64) Params {
 	return Params{
 		DepositDenom:               depositDenom,
 		MinDeposit:                 minDeposit,
@@ -66,6 +76,9 @@ func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRa
 		NumOfLockupBlocksForMaintenance:            lockUpBlocksForMaintenance,
 		UpdateGlobalPriceInterval:                  updateGlobalPriceInterval,
 		UpdatePriceDisallowedDays:                  updatePriceDisallowedDays,
+		UnjailCooldownDuration:                     unjailCooldownDuration,
+		PriceChangeNoticeDays:                      priceChangeNoticeDays,
+		DepositUnbondingPeriod:                     depositUnbondingPeriod,
 	}
 }
 
@@ -73,7 +86,8 @@ func NewParams(depositDenom string, minDeposit math.Int, secondarySpStorePriceRa
 func DefaultParams() Params {
 	return NewParams(DefaultDepositDenom, DefaultMinDeposit, DefaultSecondarySpStorePriceRatio,
 		DefaultNumOfHistoricalBlocksForMaintenanceRecords, DefaultMaintenanceDurationQuota, DefaultNumOfLockUpBlocksForMaintenance,
-		DefaultUpdateGlobalPriceInterval, DefaultUpdatePriceDisallowedDays)
+		DefaultUpdateGlobalPriceInterval, DefaultUpdatePriceDisallowedDays, DefaultUnjailCooldownDuration,
+		DefaultPriceChangeNoticeDays, DefaultDepositUnbondingPeriod)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -87,6 +101,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyNumOfLockUpBlocksForMaintenance, &p.NumOfLockupBlocksForMaintenance, validateLockUpBlocksForMaintenance),
 		paramtypes.NewParamSetPair(KeyUpdateGlobalPriceInterval, &p.UpdateGlobalPriceInterval, validateUpdateGlobalPriceInterval),
 		paramtypes.NewParamSetPair(KeyUpdatePriceDisallowedDays, &p.UpdatePriceDisallowedDays, validateUpdatePriceDisallowedDays),
+		paramtypes.NewParamSetPair(KeyUnjailCooldownDuration, &p.UnjailCooldownDuration, validateUnjailCooldownDuration),
+		paramtypes.NewParamSetPair(KeyPriceChangeNoticeDays, &p.PriceChangeNoticeDays, validatePriceChangeNoticeDays),
+		paramtypes.NewParamSetPair(KeyDepositUnbondingPeriod, &p.DepositUnbondingPeriod, validateDepositUnbondingPeriod),
 	}
 }
 
@@ -118,6 +135,15 @@ func (p Params) Validate() error {
 	if err := validateUpdatePriceDisallowedDays(p.UpdatePriceDisallowedDays); err != nil {
 		return err
 	}
+	if err := validateUnjailCooldownDuration(p.UnjailCooldownDuration); err != nil {
+		return err
+	}
+	if err := validatePriceChangeNoticeDays(p.PriceChangeNoticeDays); err != nil {
+		return err
+	}
+	if err := validateDepositUnbondingPeriod(p.DepositUnbondingPeriod); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -221,3 +247,33 @@ func validateUpdatePriceDisallowedDays(i interface{}) error {
 	}
 	return nil
 }
+
+func validateUnjailCooldownDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return errors.New("UnjailCooldownDuration cannot be negative")
+	}
+	return nil
+}
+
+func validatePriceChangeNoticeDays(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDepositUnbondingPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return errors.New("DepositUnbondingPeriod cannot be negative")
+	}
+	return nil
+}
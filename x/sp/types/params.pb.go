@@ -43,6 +43,12 @@ type Params struct {
 	UpdateGlobalPriceInterval uint64 `protobuf:"varint,7,opt,name=update_global_price_interval,json=updateGlobalPriceInterval,proto3" json:"update_global_price_interval,omitempty" yaml:"update_global_price_interval"`
 	// the days counting backwards from end of a month in which a sp cannot update its price
 	UpdatePriceDisallowedDays uint32 `protobuf:"varint,8,opt,name=update_price_disallowed_days,json=updatePriceDisallowedDays,proto3" json:"update_price_disallowed_days,omitempty" yaml:"update_price_disallowed_days"`
+	// the seconds a jailed sp needs to wait before it can unjail itself
+	UnjailCooldownDuration int64 `protobuf:"varint,9,opt,name=unjail_cooldown_duration,json=unjailCooldownDuration,proto3" json:"unjail_cooldown_duration,omitempty" yaml:"unjail_cooldown_duration"`
+	// the min days in advance a sp should schedule its price change
+	PriceChangeNoticeDays uint32 `protobuf:"varint,10,opt,name=price_change_notice_days,json=priceChangeNoticeDays,proto3" json:"price_change_notice_days,omitempty" yaml:"price_change_notice_days"`
+	// the seconds the withdrawn deposit of a sp is locked before it is sent back to the funding address
+	DepositUnbondingPeriod int64 `protobuf:"varint,11,opt,name=deposit_unbonding_period,json=depositUnbondingPeriod,proto3" json:"deposit_unbonding_period,omitempty" yaml:"deposit_unbonding_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -119,6 +125,27 @@ func (m *Params) GetUpdatePriceDisallowedDays() uint32 {
 	return 0
 }
 
+func (m *Params) GetUnjailCooldownDuration() int64 {
+	if m != nil {
+		return m.UnjailCooldownDuration
+	}
+	return 0
+}
+
+func (m *Params) GetPriceChangeNoticeDays() uint32 {
+	if m != nil {
+		return m.PriceChangeNoticeDays
+	}
+	return 0
+}
+
+func (m *Params) GetDepositUnbondingPeriod() int64 {
+	if m != nil {
+		return m.DepositUnbondingPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "greenfield.sp.Params")
 }
@@ -126,43 +153,49 @@ func init() {
 func init() { proto.RegisterFile("greenfield/sp/params.proto", fileDescriptor_a5353d8e6e407d7e) }
 
 var fileDescriptor_a5353d8e6e407d7e = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x5a, 0x42, 0x31, 0x74, 0xb1, 0x18, 0xdc, 0x08, 0xd9, 0xc1, 0xe5, 0x47, 0x54,
-	0xd4, 0x18, 0x89, 0x01, 0xa9, 0x30, 0x45, 0x16, 0x10, 0x09, 0x44, 0x71, 0x37, 0x24, 0x74, 0x3a,
-	0xfb, 0x2e, 0xc9, 0xa9, 0xf6, 0xdd, 0x71, 0x77, 0x06, 0xb2, 0x20, 0xfe, 0x04, 0x46, 0xc6, 0xf2,
-	0x3f, 0xf0, 0x47, 0x74, 0xac, 0x98, 0x10, 0x83, 0x85, 0x92, 0x85, 0x39, 0x03, 0x33, 0xba, 0xb3,
-	0x95, 0x1a, 0x29, 0xad, 0xd4, 0xc9, 0xf6, 0xfb, 0x7e, 0xdf, 0xfb, 0xbc, 0x67, 0x3f, 0x9f, 0xdd,
-	0x19, 0x0b, 0x8c, 0xe9, 0x88, 0xe0, 0x0c, 0x85, 0x92, 0x87, 0x1c, 0x0a, 0x98, 0xcb, 0x3e, 0x17,
-	0x4c, 0x31, 0x67, 0xf3, 0x54, 0xeb, 0x4b, 0xde, 0xd9, 0x4a, 0x99, 0xcc, 0x99, 0x04, 0x46, 0x0c,
-	0xab, 0x87, 0xca, 0xd9, 0xb9, 0x31, 0x66, 0x63, 0x56, 0xc5, 0xf5, 0x5d, 0x15, 0x0d, 0xfe, 0xb6,
-	0xed, 0xf6, 0xbe, 0x29, 0xe8, 0x6c, 0xdb, 0x9b, 0x08, 0x73, 0x26, 0x89, 0x02, 0x08, 0x53, 0x96,
-	0xbb, 0x56, 0xd7, 0xea, 0x5d, 0x8d, 0xaf, 0xd7, 0xc1, 0x48, 0xc7, 0x9c, 0xb7, 0xf6, 0xb5, 0x9c,
-	0x50, 0x50, 0xc7, 0xdc, 0x4b, 0xda, 0x32, 0x78, 0x72, 0x5c, 0xfa, 0xad, 0x5f, 0xa5, 0x7f, 0x77,
-	0x4c, 0xd4, 0xa4, 0x48, 0xfa, 0x29, 0xcb, 0x6b, 0x76, 0x7d, 0xd9, 0x95, 0xe8, 0x30, 0x54, 0x53,
-	0x8e, 0x65, 0x7f, 0x48, 0xd5, 0x8f, 0xef, 0xbb, 0x76, 0xdd, 0xda, 0x90, 0xaa, 0xd8, 0xce, 0x09,
-	0x8d, 0xaa, 0x7a, 0xce, 0x67, 0xcb, 0xf6, 0x24, 0x4e, 0x19, 0x45, 0x50, 0x4c, 0x81, 0xe4, 0x40,
-	0x2a, 0x26, 0x30, 0xe0, 0x82, 0xa4, 0x18, 0x08, 0xa8, 0x08, 0x73, 0xd7, 0x2e, 0x8c, 0x8c, 0x70,
-	0xda, 0x40, 0x46, 0x38, 0x8d, 0x3b, 0x4b, 0xc6, 0x01, 0x3f, 0xd0, 0x84, 0x7d, 0x0d, 0x88, 0x75,
-	0x7d, 0xe7, 0x9b, 0x65, 0x3f, 0xa0, 0x45, 0x0e, 0xd8, 0x08, 0x4c, 0x88, 0xc6, 0x93, 0x14, 0x66,
-	0x20, 0xc9, 0x58, 0x7a, 0x28, 0xc1, 0x88, 0x09, 0x90, 0x43, 0x42, 0x15, 0xa6, 0x90, 0xea, 0x96,
-	0x70, 0xca, 0x04, 0x92, 0xee, 0x7a, 0xd7, 0xea, 0xad, 0x0d, 0x1e, 0x2f, 0x4a, 0xff, 0xd1, 0x14,
-	0xe6, 0xd9, 0x5e, 0x70, 0xd1, 0x0a, 0x41, 0xbc, 0x43, 0x8b, 0xfc, 0xd5, 0xe8, 0xf9, 0x32, 0x61,
-	0x60, 0xfc, 0x4f, 0x99, 0x78, 0x79, 0xea, 0x8e, 0x2b, 0xb3, 0x93, 0xda, 0x9d, 0x66, 0x0d, 0x54,
-	0x98, 0x57, 0x43, 0xc1, 0xbb, 0x82, 0x29, 0xe8, 0x5e, 0x36, 0xcd, 0xdc, 0x59, 0x94, 0xfe, 0xad,
-	0xaa, 0x99, 0xb3, 0xbd, 0x41, 0xec, 0x36, 0xc4, 0xa8, 0xd6, 0x5e, 0x6b, 0xc9, 0xf9, 0x64, 0xdf,
-	0xae, 0xa7, 0xd0, 0x9d, 0x14, 0xfc, 0x8c, 0x09, 0xdc, 0xb6, 0xc1, 0x85, 0x8b, 0xd2, 0xbf, 0xff,
-	0xdf, 0xec, 0xe7, 0x66, 0x05, 0xb1, 0x6f, 0xe6, 0x7d, 0x61, 0x4c, 0xab, 0x66, 0x75, 0x26, 0xf6,
-	0xcd, 0x82, 0x23, 0xa8, 0x30, 0x18, 0x67, 0x2c, 0x81, 0x59, 0xbd, 0x05, 0xda, 0x20, 0xde, 0xc3,
-	0xcc, 0xbd, 0xd2, 0xb5, 0x7a, 0xeb, 0x83, 0x7b, 0x8b, 0xd2, 0xdf, 0xae, 0xb8, 0xe7, 0xb9, 0x83,
-	0x78, 0xab, 0x92, 0x9f, 0x19, 0xd5, 0x7c, 0xef, 0x61, 0xad, 0x35, 0x48, 0x55, 0x12, 0x22, 0x12,
-	0x66, 0x19, 0xfb, 0x80, 0x11, 0x40, 0x70, 0x2a, 0xdd, 0x8d, 0xae, 0xd5, 0xdb, 0x5c, 0x41, 0x5a,
-	0xe9, 0x5e, 0x92, 0x0c, 0x23, 0x5a, 0x8a, 0x11, 0x9c, 0xca, 0xbd, 0x8d, 0xaf, 0x47, 0x7e, 0xeb,
-	0xcf, 0x91, 0x6f, 0x0d, 0xa2, 0xe3, 0x99, 0x67, 0x9d, 0xcc, 0x3c, 0xeb, 0xf7, 0xcc, 0xb3, 0xbe,
-	0xcc, 0xbd, 0xd6, 0xc9, 0xdc, 0x6b, 0xfd, 0x9c, 0x7b, 0xad, 0x37, 0x3b, 0x8d, 0x95, 0x4e, 0x68,
-	0xb2, 0x9b, 0x4e, 0x20, 0xa1, 0x61, 0xe3, 0x0c, 0xf8, 0xa8, 0x4f, 0x01, 0xb3, 0xda, 0x49, 0xdb,
-	0xfc, 0xc5, 0x0f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd4, 0x74, 0x7e, 0x23, 0x04, 0x00,
-	0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0xdb, 0xde, 0xde, 0x76, 0x7a, 0xbb, 0xb1, 0xa0, 0x72, 0x23, 0x64, 0x07, 0x97,
+	0x3f, 0x51, 0x51, 0x13, 0x24, 0x16, 0x48, 0x85, 0x55, 0x6a, 0x01, 0x95, 0xf8, 0x53, 0x5c, 0xb1,
+	0x41, 0x54, 0xa3, 0xb1, 0x67, 0xe2, 0x0c, 0xb5, 0xe7, 0x18, 0x8f, 0x4d, 0xc9, 0x06, 0xf1, 0x08,
+	0x88, 0x15, 0xcb, 0xf2, 0x0e, 0x3c, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xc2, 0x42, 0xed, 0x86, 0x75,
+	0x9e, 0x00, 0x79, 0xec, 0xa4, 0x06, 0x35, 0x95, 0xba, 0x4a, 0x72, 0x7e, 0xdf, 0x9c, 0xef, 0x3b,
+	0x9e, 0xf8, 0xa0, 0x66, 0x90, 0x30, 0x26, 0xfa, 0x9c, 0x85, 0xb4, 0x2b, 0xe3, 0x6e, 0x4c, 0x12,
+	0x12, 0xc9, 0x4e, 0x9c, 0x40, 0x0a, 0xfa, 0xd2, 0x29, 0xeb, 0xc8, 0xb8, 0xb9, 0xe2, 0x83, 0x8c,
+	0x40, 0x62, 0x05, 0xbb, 0xe5, 0x8f, 0x52, 0xd9, 0xbc, 0x14, 0x40, 0x00, 0x65, 0xbd, 0xf8, 0x56,
+	0x56, 0xed, 0x4f, 0x0b, 0x68, 0x6e, 0x5b, 0x35, 0xd4, 0x57, 0xd1, 0x12, 0x65, 0x31, 0x48, 0x9e,
+	0x62, 0xca, 0x04, 0x44, 0x86, 0xd6, 0xd2, 0xda, 0x0b, 0xee, 0xff, 0x55, 0xd1, 0x29, 0x6a, 0xfa,
+	0x2e, 0x5a, 0x8c, 0xb8, 0xc0, 0x55, 0xcd, 0xf8, 0xa7, 0x90, 0xf4, 0xee, 0x1f, 0xe6, 0x56, 0xe3,
+	0x47, 0x6e, 0xdd, 0x08, 0x78, 0x3a, 0xc8, 0xbc, 0x8e, 0x0f, 0x51, 0xe5, 0x5d, 0x7d, 0xac, 0x4b,
+	0xba, 0xd7, 0x4d, 0x87, 0x31, 0x93, 0x9d, 0x2d, 0x91, 0x7e, 0xfb, 0xba, 0x8e, 0xaa, 0x68, 0x5b,
+	0x22, 0x75, 0x51, 0xc4, 0x85, 0x53, 0xf6, 0xd3, 0x3f, 0x68, 0xc8, 0x94, 0xcc, 0x07, 0x41, 0x49,
+	0x32, 0xc4, 0x32, 0xc6, 0x32, 0x85, 0x84, 0xe1, 0x38, 0xe1, 0x3e, 0xc3, 0x09, 0x49, 0x39, 0x18,
+	0x33, 0x17, 0xb6, 0x74, 0x98, 0x5f, 0xb3, 0x74, 0x98, 0xef, 0x36, 0x27, 0x1e, 0x3b, 0xf1, 0x4e,
+	0xe1, 0xb0, 0x5d, 0x18, 0xb8, 0x45, 0x7f, 0xfd, 0x8b, 0x86, 0x6e, 0x8b, 0x2c, 0xc2, 0xd0, 0xc7,
+	0x03, 0x5e, 0xd8, 0x73, 0x9f, 0x84, 0xd8, 0x0b, 0xc1, 0xdf, 0x93, 0xb8, 0x0f, 0x09, 0x8e, 0x08,
+	0x17, 0x29, 0x13, 0x44, 0x14, 0x91, 0x98, 0x0f, 0x09, 0x95, 0xc6, 0x6c, 0x4b, 0x6b, 0xcf, 0xf4,
+	0xee, 0x8d, 0x72, 0xeb, 0xee, 0x90, 0x44, 0xe1, 0x86, 0x7d, 0xd1, 0x0e, 0xb6, 0xbb, 0x26, 0xb2,
+	0xe8, 0x59, 0xff, 0xd1, 0xe4, 0x40, 0x4f, 0xe9, 0x1f, 0x40, 0xf2, 0xe4, 0x54, 0xed, 0x96, 0x62,
+	0xdd, 0x47, 0xcd, 0x7a, 0x0f, 0x9a, 0xa9, 0x47, 0x23, 0xf0, 0x9b, 0x0c, 0x52, 0x62, 0xfc, 0xab,
+	0xc2, 0x5c, 0x1f, 0xe5, 0xd6, 0xd5, 0x32, 0xcc, 0x74, 0xad, 0xed, 0x1a, 0x35, 0xe8, 0x54, 0xec,
+	0x79, 0x81, 0xf4, 0xf7, 0xe8, 0x5a, 0x35, 0x45, 0x91, 0x24, 0x8b, 0xa7, 0x4c, 0x60, 0xcc, 0x29,
+	0xbb, 0xee, 0x28, 0xb7, 0x6e, 0xfd, 0x31, 0xfb, 0xb9, 0xa7, 0x6c, 0xd7, 0x52, 0xf3, 0x3e, 0x56,
+	0xa2, 0xb3, 0x66, 0xd5, 0x07, 0xe8, 0x4a, 0x16, 0x53, 0x92, 0x32, 0x1c, 0x84, 0xe0, 0x91, 0xb0,
+	0xfa, 0x17, 0x14, 0x82, 0xe4, 0x2d, 0x09, 0x8d, 0xff, 0x5a, 0x5a, 0x7b, 0xb6, 0x77, 0x73, 0x94,
+	0x5b, 0xab, 0xa5, 0xef, 0x79, 0x6a, 0xdb, 0x5d, 0x29, 0xf1, 0x43, 0x45, 0xd5, 0x7d, 0x6f, 0x55,
+	0xac, 0xe6, 0x54, 0x1e, 0xa2, 0x5c, 0x92, 0x30, 0x84, 0x7d, 0x46, 0x31, 0x25, 0x43, 0x69, 0xcc,
+	0xb7, 0xb4, 0xf6, 0xd2, 0x19, 0x4e, 0x67, 0xaa, 0x27, 0x4e, 0xca, 0xc3, 0x99, 0x40, 0x87, 0x0c,
+	0xa5, 0xbe, 0x8b, 0x8c, 0x4c, 0xbc, 0x26, 0x3c, 0xc4, 0x3e, 0x40, 0x48, 0x61, 0x5f, 0x4c, 0x2e,
+	0xc4, 0x58, 0x50, 0xcf, 0x71, 0x75, 0x94, 0x5b, 0x56, 0xe5, 0x32, 0x45, 0x69, 0xbb, 0xcb, 0x25,
+	0xda, 0xac, 0xc8, 0xf8, 0xde, 0xf4, 0x57, 0xc8, 0x28, 0x33, 0xf9, 0x03, 0x22, 0x02, 0x86, 0x05,
+	0xa4, 0x2a, 0x61, 0x31, 0x04, 0x52, 0x43, 0xd4, 0xda, 0x4f, 0x53, 0xda, 0xee, 0x65, 0x85, 0x36,
+	0x15, 0x79, 0xaa, 0xc0, 0x38, 0xfc, 0x78, 0x41, 0x64, 0xc2, 0x03, 0x41, 0xb9, 0x08, 0x70, 0xcc,
+	0x12, 0x0e, 0xd4, 0x58, 0xfc, 0x3b, 0xfc, 0x34, 0xa5, 0xed, 0x2e, 0x57, 0xe8, 0xc5, 0x98, 0x6c,
+	0x2b, 0xb0, 0x31, 0xff, 0xf9, 0xc0, 0x6a, 0xfc, 0x3a, 0xb0, 0xb4, 0x9e, 0x73, 0x78, 0x6c, 0x6a,
+	0x47, 0xc7, 0xa6, 0xf6, 0xf3, 0xd8, 0xd4, 0x3e, 0x9e, 0x98, 0x8d, 0xa3, 0x13, 0xb3, 0xf1, 0xfd,
+	0xc4, 0x6c, 0xbc, 0x5c, 0xab, 0xbd, 0xee, 0x9e, 0xf0, 0xd6, 0xfd, 0x01, 0xe1, 0xa2, 0x5b, 0xdb,
+	0x8f, 0xef, 0x8a, 0x0d, 0xa9, 0x5e, 0x7b, 0x6f, 0x4e, 0x6d, 0xb8, 0x3b, 0xbf, 0x07, 0x00, 0x59,
+	0x2a, 0x44, 0x68, 0x3f, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -208,6 +241,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.UpdatePriceDisallowedDays != that1.UpdatePriceDisallowedDays {
 		return false
 	}
+	if this.UnjailCooldownDuration != that1.UnjailCooldownDuration {
+		return false
+	}
+	if this.PriceChangeNoticeDays != that1.PriceChangeNoticeDays {
+		return false
+	}
+	if this.DepositUnbondingPeriod != that1.DepositUnbondingPeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -230,6 +272,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DepositUnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositUnbondingPeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PriceChangeNoticeDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceChangeNoticeDays))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UnjailCooldownDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnjailCooldownDuration))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.UpdatePriceDisallowedDays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.UpdatePriceDisallowedDays))
 		i--
@@ -325,6 +382,15 @@ func (m *Params) Size() (n int) {
 	if m.UpdatePriceDisallowedDays != 0 {
 		n += 1 + sovParams(uint64(m.UpdatePriceDisallowedDays))
 	}
+	if m.UnjailCooldownDuration != 0 {
+		n += 1 + sovParams(uint64(m.UnjailCooldownDuration))
+	}
+	if m.PriceChangeNoticeDays != 0 {
+		n += 1 + sovParams(uint64(m.PriceChangeNoticeDays))
+	}
+	if m.DepositUnbondingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.DepositUnbondingPeriod))
+	}
 	return n
 }
 
@@ -558,6 +624,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailCooldownDuration", wireType)
+			}
+			m.UnjailCooldownDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailCooldownDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceChangeNoticeDays", wireType)
+			}
+			m.PriceChangeNoticeDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceChangeNoticeDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositUnbondingPeriod", wireType)
+			}
+			m.DepositUnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositUnbondingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxStorePriceTiers is the max number of store price tiers a storage provider can publish for a redundancy type
+const MaxStorePriceTiers = 5
+
+// The redundancy types the store price tiers are keyed by, they are the values of greenfield.storage.RedundancyType,
+// which can not be imported here since the storage module depends on this module.
+const (
+	RedundancyTypeEC      int32 = 0
+	RedundancyTypeReplica int32 = 1
+)
+
+// StorePriceRedundancyTypes are all the redundancy types which can have store price tiers, in ascending order
+var StorePriceRedundancyTypes = []int32{RedundancyTypeEC, RedundancyTypeReplica}
+
+// ValidateStorePriceTiers checks the tiers of each redundancy type are sorted by min charge size in strictly
+// ascending order, and the prices are not negative. The secondary store price is optional.
+func ValidateStorePriceTiers(tiers []StorePriceTier) error {
+	lastMinChargeSizes := make(map[int32]uint64)
+	tierCounts := make(map[int32]int)
+	for i, tier := range tiers {
+		if tier.RedundancyType != RedundancyTypeEC && tier.RedundancyType != RedundancyTypeReplica {
+			return fmt.Errorf("invalid redundancy type of tier %d (%d)", i, tier.RedundancyType)
+		}
+		tierCounts[tier.RedundancyType]++
+		if tierCounts[tier.RedundancyType] > MaxStorePriceTiers {
+			return fmt.Errorf("too many store price tiers of redundancy type %d, max: %d", tier.RedundancyType, MaxStorePriceTiers)
+		}
+		lastMinChargeSize := lastMinChargeSizes[tier.RedundancyType]
+		if tier.MinChargeSize <= lastMinChargeSize {
+			return fmt.Errorf("min charge size of tier %d should be larger than %d", i, lastMinChargeSize)
+		}
+		if tier.StorePrice.IsNil() || tier.StorePrice.IsNegative() {
+			return fmt.Errorf("invalid store price of tier %d (%s)", i, tier.StorePrice)
+		}
+		if !tier.SecondaryStorePrice.IsNil() && tier.SecondaryStorePrice.IsNegative() {
+			return fmt.Errorf("invalid secondary store price of tier %d (%s)", i, tier.SecondaryStorePrice)
+		}
+		lastMinChargeSizes[tier.RedundancyType] = tier.MinChargeSize
+	}
+	return nil
+}
+
+// GetStorePriceByChargeSize returns the primary and secondary store price of the sp for the objects of the redundancy
+// type in a bucket with the total charge size, the secondary price falls back to the primary price multiplied by the
+// secondary price ratio. An sp publishing no tiers for the replica type charges the replica objects by its ec tiers.
+func (p *SpStoragePrice) GetStorePriceByChargeSize(totalChargeSize uint64, redundancyType int32, secondaryRatio sdk.Dec) (sdk.Dec, sdk.Dec) {
+	if !p.hasStorePriceTiers(redundancyType) {
+		redundancyType = RedundancyTypeEC
+	}
+	primary, secondary := p.StorePrice, sdk.ZeroDec()
+	for _, tier := range p.StorePriceTiers {
+		if tier.RedundancyType != redundancyType {
+			continue
+		}
+		if tier.MinChargeSize > totalChargeSize {
+			break
+		}
+		primary, secondary = tier.StorePrice, tier.SecondaryStorePrice
+	}
+	if secondary.IsNil() || secondary.IsZero() {
+		secondary = secondaryRatio.Mul(primary)
+	}
+	return primary, secondary
+}
+
+func (p *SpStoragePrice) hasStorePriceTiers(redundancyType int32) bool {
+	for _, tier := range p.StorePriceTiers {
+		if tier.RedundancyType == redundancyType {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStorePriceTiersByRedundancyType returns the tiers of the redundancy type, sorted by min charge size in ascending order
+func (p *GlobalSpStorePrice) GetStorePriceTiersByRedundancyType(redundancyType int32) []GlobalStorePriceTier {
+	tiers := make([]GlobalStorePriceTier, 0, len(p.StorePriceTiers))
+	for _, tier := range p.StorePriceTiers {
+		if tier.RedundancyType == redundancyType {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// GetStorePriceTierIndex returns the index of the tier of the redundancy type for a bucket with the total charge size,
+// 0 stands for the base store price.
+func (p *GlobalSpStorePrice) GetStorePriceTierIndex(totalChargeSize uint64, redundancyType int32) int {
+	tiers := p.GetStorePriceTiersByRedundancyType(redundancyType)
+	return sort.Search(len(tiers), func(i int) bool {
+		return tiers[i].MinChargeSize > totalChargeSize
+	})
+}
+
+// GetStorePriceByChargeSize returns the primary and secondary store price for the objects of the redundancy type
+// in a bucket with the total charge size
+func (p *GlobalSpStorePrice) GetStorePriceByChargeSize(totalChargeSize uint64, redundancyType int32) (sdk.Dec, sdk.Dec) {
+	tiers := p.GetStorePriceTiersByRedundancyType(redundancyType)
+	index := sort.Search(len(tiers), func(i int) bool {
+		return tiers[i].MinChargeSize > totalChargeSize
+	})
+	if index == 0 {
+		return p.PrimaryStorePrice, p.SecondaryStorePrice
+	}
+	tier := tiers[index-1]
+	return tier.PrimaryStorePrice, tier.SecondaryStorePrice
+}
+
+// GetAllStorePriceTiers returns the whole tier tables of all redundancy types, each of them includes the base store
+// price as the first tier
+func (p *GlobalSpStorePrice) GetAllStorePriceTiers() []GlobalStorePriceTier {
+	tiers := make([]GlobalStorePriceTier, 0, len(p.StorePriceTiers)+len(StorePriceRedundancyTypes))
+	for _, redundancyType := range StorePriceRedundancyTypes {
+		tiers = append(tiers, GlobalStorePriceTier{
+			MinChargeSize:       0,
+			PrimaryStorePrice:   p.PrimaryStorePrice,
+			SecondaryStorePrice: p.SecondaryStorePrice,
+			RedundancyType:      redundancyType,
+		})
+		tiers = append(tiers, p.GetStorePriceTiersByRedundancyType(redundancyType)...)
+	}
+	return tiers
+}
+
+// IsStorePriceTiersEqual returns whether the tier tables of the two global store prices are the same
+func (p *GlobalSpStorePrice) IsStorePriceTiersEqual(other *GlobalSpStorePrice) bool {
+	tiers, otherTiers := p.GetAllStorePriceTiers(), other.GetAllStorePriceTiers()
+	if len(tiers) != len(otherTiers) {
+		return false
+	}
+	for i := range tiers {
+		if tiers[i].RedundancyType != otherTiers[i].RedundancyType ||
+			tiers[i].MinChargeSize != otherTiers[i].MinChargeSize ||
+			!tiers[i].PrimaryStorePrice.Equal(otherTiers[i].PrimaryStorePrice) ||
+			!tiers[i].SecondaryStorePrice.Equal(otherTiers[i].SecondaryStorePrice) {
+			return false
+		}
+	}
+	return true
+}
@@ -390,6 +390,202 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) GetGlobalSpStorePrice() GlobalSp
 	return GlobalSpStorePrice{}
 }
 
+type QueryStorePriceTiersRequest struct {
+	// unix timestamp in seconds. If it's 0, it will return the latest tiers.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *QueryStorePriceTiersRequest) Reset()         { *m = QueryStorePriceTiersRequest{} }
+func (m *QueryStorePriceTiersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorePriceTiersRequest) ProtoMessage()    {}
+func (*QueryStorePriceTiersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{8}
+}
+func (m *QueryStorePriceTiersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorePriceTiersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorePriceTiersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorePriceTiersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorePriceTiersRequest.Merge(m, src)
+}
+func (m *QueryStorePriceTiersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorePriceTiersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorePriceTiersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorePriceTiersRequest proto.InternalMessageInfo
+
+func (m *QueryStorePriceTiersRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type QueryStorePriceTiersResponse struct {
+	// update time of the global store price, unix timestamp in seconds
+	UpdateTimeSec int64 `protobuf:"varint,1,opt,name=update_time_sec,json=updateTimeSec,proto3" json:"update_time_sec,omitempty"`
+	// store price tiers sorted by redundancy type and min charge size in ascending order, the first tier of each
+	// redundancy type is the base store price
+	Tiers []GlobalStorePriceTier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *QueryStorePriceTiersResponse) Reset()         { *m = QueryStorePriceTiersResponse{} }
+func (m *QueryStorePriceTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorePriceTiersResponse) ProtoMessage()    {}
+func (*QueryStorePriceTiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{9}
+}
+func (m *QueryStorePriceTiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorePriceTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorePriceTiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorePriceTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorePriceTiersResponse.Merge(m, src)
+}
+func (m *QueryStorePriceTiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorePriceTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorePriceTiersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorePriceTiersResponse proto.InternalMessageInfo
+
+func (m *QueryStorePriceTiersResponse) GetUpdateTimeSec() int64 {
+	if m != nil {
+		return m.UpdateTimeSec
+	}
+	return 0
+}
+
+func (m *QueryStorePriceTiersResponse) GetTiers() []GlobalStorePriceTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+type QueryScheduledSpStoragePricesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledSpStoragePricesRequest) Reset()         { *m = QueryScheduledSpStoragePricesRequest{} }
+func (m *QueryScheduledSpStoragePricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledSpStoragePricesRequest) ProtoMessage()    {}
+func (*QueryScheduledSpStoragePricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{10}
+}
+func (m *QueryScheduledSpStoragePricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledSpStoragePricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledSpStoragePricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledSpStoragePricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledSpStoragePricesRequest.Merge(m, src)
+}
+func (m *QueryScheduledSpStoragePricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledSpStoragePricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledSpStoragePricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledSpStoragePricesRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledSpStoragePricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryScheduledSpStoragePricesResponse struct {
+	ScheduledPrices []ScheduledSpStoragePrice `protobuf:"bytes,1,rep,name=scheduled_prices,json=scheduledPrices,proto3" json:"scheduled_prices"`
+	Pagination      *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledSpStoragePricesResponse) Reset()         { *m = QueryScheduledSpStoragePricesResponse{} }
+func (m *QueryScheduledSpStoragePricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledSpStoragePricesResponse) ProtoMessage()    {}
+func (*QueryScheduledSpStoragePricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{11}
+}
+func (m *QueryScheduledSpStoragePricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledSpStoragePricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledSpStoragePricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledSpStoragePricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledSpStoragePricesResponse.Merge(m, src)
+}
+func (m *QueryScheduledSpStoragePricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledSpStoragePricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledSpStoragePricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledSpStoragePricesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledSpStoragePricesResponse) GetScheduledPrices() []ScheduledSpStoragePrice {
+	if m != nil {
+		return m.ScheduledPrices
+	}
+	return nil
+}
+
+func (m *QueryScheduledSpStoragePricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryStorageProviderRequest struct {
 	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -398,7 +594,7 @@ func (m *QueryStorageProviderRequest) Reset()         { *m = QueryStorageProvide
 func (m *QueryStorageProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageProviderRequest) ProtoMessage()    {}
 func (*QueryStorageProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dd9c8aad3b7a6d, []int{8}
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{12}
 }
 func (m *QueryStorageProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +638,7 @@ func (m *QueryStorageProviderResponse) Reset()         { *m = QueryStorageProvid
 func (m *QueryStorageProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageProviderResponse) ProtoMessage()    {}
 func (*QueryStorageProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dd9c8aad3b7a6d, []int{9}
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{13}
 }
 func (m *QueryStorageProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +686,7 @@ func (m *QueryStorageProviderByOperatorAddressRequest) String() string {
 }
 func (*QueryStorageProviderByOperatorAddressRequest) ProtoMessage() {}
 func (*QueryStorageProviderByOperatorAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dd9c8aad3b7a6d, []int{10}
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{14}
 }
 func (m *QueryStorageProviderByOperatorAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,7 +734,7 @@ func (m *QueryStorageProviderByOperatorAddressResponse) String() string {
 }
 func (*QueryStorageProviderByOperatorAddressResponse) ProtoMessage() {}
 func (*QueryStorageProviderByOperatorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dd9c8aad3b7a6d, []int{11}
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{15}
 }
 func (m *QueryStorageProviderByOperatorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +782,7 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) String() string {
 }
 func (*QueryStorageProviderMaintenanceRecordsRequest) ProtoMessage() {}
 func (*QueryStorageProviderMaintenanceRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dd9c8aad3b7a6d, []int{12}
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{16}
 }
 func (m *QueryStorageProviderMaintenanceRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -634,7 +830,7 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) String() string {
 }
 func (*QueryStorageProviderMaintenanceRecordsResponse) ProtoMessage() {}
 func (*QueryStorageProviderMaintenanceRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48dd9c8aad3b7a6d, []int{13}
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{17}
 }
 func (m *QueryStorageProviderMaintenanceRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,6 +866,194 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) GetRecords() []*Mainten
 	return nil
 }
 
+type QueryStorageProviderScorecardRequest struct {
+	// id of the storage provider
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryStorageProviderScorecardRequest) Reset()         { *m = QueryStorageProviderScorecardRequest{} }
+func (m *QueryStorageProviderScorecardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderScorecardRequest) ProtoMessage()    {}
+func (*QueryStorageProviderScorecardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{18}
+}
+func (m *QueryStorageProviderScorecardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderScorecardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderScorecardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderScorecardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderScorecardRequest.Merge(m, src)
+}
+func (m *QueryStorageProviderScorecardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderScorecardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderScorecardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProviderScorecardRequest proto.InternalMessageInfo
+
+func (m *QueryStorageProviderScorecardRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryStorageProviderScorecardResponse struct {
+	Scorecard SpScorecard `protobuf:"bytes,1,opt,name=scorecard,proto3" json:"scorecard"`
+}
+
+func (m *QueryStorageProviderScorecardResponse) Reset()         { *m = QueryStorageProviderScorecardResponse{} }
+func (m *QueryStorageProviderScorecardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderScorecardResponse) ProtoMessage()    {}
+func (*QueryStorageProviderScorecardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{19}
+}
+func (m *QueryStorageProviderScorecardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProviderScorecardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProviderScorecardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProviderScorecardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderScorecardResponse.Merge(m, src)
+}
+func (m *QueryStorageProviderScorecardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProviderScorecardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderScorecardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProviderScorecardResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProviderScorecardResponse) GetScorecard() SpScorecard {
+	if m != nil {
+		return m.Scorecard
+	}
+	return SpScorecard{}
+}
+
+type QueryStorageProvidersByScoreRequest struct {
+	// pagination defines an optional pagination for the request, only offset and limit are supported.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageProvidersByScoreRequest) Reset()         { *m = QueryStorageProvidersByScoreRequest{} }
+func (m *QueryStorageProvidersByScoreRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProvidersByScoreRequest) ProtoMessage()    {}
+func (*QueryStorageProvidersByScoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{20}
+}
+func (m *QueryStorageProvidersByScoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProvidersByScoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProvidersByScoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProvidersByScoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProvidersByScoreRequest.Merge(m, src)
+}
+func (m *QueryStorageProvidersByScoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProvidersByScoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProvidersByScoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProvidersByScoreRequest proto.InternalMessageInfo
+
+func (m *QueryStorageProvidersByScoreRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStorageProvidersByScoreResponse struct {
+	// scorecards sorted by score in descending order, the ones with the same score are sorted by sp id
+	Scorecards []SpScorecard `protobuf:"bytes,1,rep,name=scorecards,proto3" json:"scorecards"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageProvidersByScoreResponse) Reset()         { *m = QueryStorageProvidersByScoreResponse{} }
+func (m *QueryStorageProvidersByScoreResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProvidersByScoreResponse) ProtoMessage()    {}
+func (*QueryStorageProvidersByScoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48dd9c8aad3b7a6d, []int{21}
+}
+func (m *QueryStorageProvidersByScoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProvidersByScoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProvidersByScoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProvidersByScoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProvidersByScoreResponse.Merge(m, src)
+}
+func (m *QueryStorageProvidersByScoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProvidersByScoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProvidersByScoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProvidersByScoreResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProvidersByScoreResponse) GetScorecards() []SpScorecard {
+	if m != nil {
+		return m.Scorecards
+	}
+	return nil
+}
+
+func (m *QueryStorageProvidersByScoreResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.sp.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.sp.QueryParamsResponse")
@@ -679,73 +1063,101 @@ func init() {
 	proto.RegisterType((*QuerySpStoragePriceResponse)(nil), "greenfield.sp.QuerySpStoragePriceResponse")
 	proto.RegisterType((*QueryGlobalSpStorePriceByTimeRequest)(nil), "greenfield.sp.QueryGlobalSpStorePriceByTimeRequest")
 	proto.RegisterType((*QueryGlobalSpStorePriceByTimeResponse)(nil), "greenfield.sp.QueryGlobalSpStorePriceByTimeResponse")
+	proto.RegisterType((*QueryStorePriceTiersRequest)(nil), "greenfield.sp.QueryStorePriceTiersRequest")
+	proto.RegisterType((*QueryStorePriceTiersResponse)(nil), "greenfield.sp.QueryStorePriceTiersResponse")
+	proto.RegisterType((*QueryScheduledSpStoragePricesRequest)(nil), "greenfield.sp.QueryScheduledSpStoragePricesRequest")
+	proto.RegisterType((*QueryScheduledSpStoragePricesResponse)(nil), "greenfield.sp.QueryScheduledSpStoragePricesResponse")
 	proto.RegisterType((*QueryStorageProviderRequest)(nil), "greenfield.sp.QueryStorageProviderRequest")
 	proto.RegisterType((*QueryStorageProviderResponse)(nil), "greenfield.sp.QueryStorageProviderResponse")
 	proto.RegisterType((*QueryStorageProviderByOperatorAddressRequest)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressRequest")
 	proto.RegisterType((*QueryStorageProviderByOperatorAddressResponse)(nil), "greenfield.sp.QueryStorageProviderByOperatorAddressResponse")
 	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsRequest)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsRequest")
 	proto.RegisterType((*QueryStorageProviderMaintenanceRecordsResponse)(nil), "greenfield.sp.QueryStorageProviderMaintenanceRecordsResponse")
+	proto.RegisterType((*QueryStorageProviderScorecardRequest)(nil), "greenfield.sp.QueryStorageProviderScorecardRequest")
+	proto.RegisterType((*QueryStorageProviderScorecardResponse)(nil), "greenfield.sp.QueryStorageProviderScorecardResponse")
+	proto.RegisterType((*QueryStorageProvidersByScoreRequest)(nil), "greenfield.sp.QueryStorageProvidersByScoreRequest")
+	proto.RegisterType((*QueryStorageProvidersByScoreResponse)(nil), "greenfield.sp.QueryStorageProvidersByScoreResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/query.proto", fileDescriptor_48dd9c8aad3b7a6d) }
 
 var fileDescriptor_48dd9c8aad3b7a6d = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x4f, 0xf4, 0x44,
-	0x18, 0xde, 0x2e, 0xba, 0x5f, 0x98, 0x2f, 0xdf, 0x07, 0x19, 0x21, 0x48, 0x65, 0x17, 0xa8, 0xa2,
-	0xec, 0x02, 0xad, 0xec, 0x4a, 0x62, 0x40, 0x13, 0x25, 0x44, 0xd4, 0x84, 0x80, 0xd5, 0x8b, 0x5c,
-	0x9a, 0xe9, 0x76, 0x28, 0x4d, 0x76, 0x3b, 0x43, 0xa7, 0x10, 0x37, 0x84, 0x0b, 0xfe, 0x01, 0x13,
-	0xf5, 0xe2, 0xc5, 0xbf, 0xc3, 0xc1, 0x03, 0x89, 0x17, 0xe3, 0xc1, 0x18, 0xf0, 0x4f, 0x78, 0x33,
-	0x9d, 0x99, 0xee, 0xd2, 0xd9, 0x2e, 0x5b, 0xc9, 0x77, 0xdb, 0xce, 0xfb, 0x3e, 0xef, 0xfb, 0x3c,
-	0xef, 0xbc, 0x7d, 0xb6, 0x60, 0xde, 0x8f, 0x30, 0x0e, 0x4f, 0x02, 0xdc, 0xf1, 0x2c, 0x46, 0xad,
-	0xb3, 0x73, 0x1c, 0xf5, 0x4c, 0x1a, 0x91, 0x98, 0xc0, 0x17, 0x83, 0x90, 0xc9, 0xa8, 0xde, 0x68,
-	0x13, 0xd6, 0x25, 0xcc, 0x72, 0x11, 0xc3, 0x22, 0xcf, 0xba, 0xd8, 0x74, 0x71, 0x8c, 0x36, 0x2d,
-	0x8a, 0xfc, 0x20, 0x44, 0x71, 0x40, 0x42, 0x01, 0xd5, 0xe7, 0x45, 0xae, 0xc3, 0x9f, 0x2c, 0xf1,
-	0x20, 0x43, 0x33, 0x3e, 0xf1, 0x89, 0x38, 0x4f, 0x7e, 0xc9, 0xd3, 0x05, 0x9f, 0x10, 0xbf, 0x83,
-	0x2d, 0x44, 0x03, 0x0b, 0x85, 0x21, 0x89, 0x79, 0xb5, 0x14, 0xa3, 0x67, 0x49, 0x52, 0x14, 0xa1,
-	0x6e, 0x1a, 0x53, 0x04, 0xc4, 0x3d, 0x8a, 0x65, 0xc8, 0x98, 0x01, 0xf0, 0xab, 0x84, 0xe7, 0x11,
-	0xcf, 0xb7, 0xf1, 0xd9, 0x39, 0x66, 0xb1, 0xf1, 0x25, 0x78, 0x23, 0x73, 0xca, 0x28, 0x09, 0x19,
-	0x86, 0x2d, 0x50, 0x11, 0x75, 0xdf, 0xd4, 0x96, 0xb4, 0xd5, 0xe7, 0xcd, 0x59, 0x33, 0x23, 0xdf,
-	0x14, 0xe9, 0xbb, 0xaf, 0xdd, 0xfc, 0xb5, 0x58, 0xb2, 0x65, 0xaa, 0x71, 0x02, 0x16, 0x78, 0xad,
-	0xaf, 0x63, 0x12, 0x21, 0x1f, 0x1f, 0x45, 0xe4, 0x22, 0xf0, 0x70, 0x94, 0xf6, 0x82, 0x9f, 0x01,
-	0x30, 0x98, 0x8d, 0x2c, 0xfc, 0xae, 0x29, 0xe7, 0x91, 0x0c, 0xd2, 0x14, 0x03, 0x97, 0x83, 0x34,
-	0x8f, 0x90, 0x8f, 0x25, 0xd6, 0x7e, 0x80, 0x34, 0x7e, 0xd1, 0x40, 0x75, 0x44, 0x23, 0x49, 0xff,
-	0x7d, 0x30, 0xc1, 0x68, 0xc2, 0x7d, 0x62, 0xf5, 0x79, 0xb3, 0xa6, 0x70, 0x57, 0x50, 0x76, 0x92,
-	0x0a, 0xf7, 0x33, 0xdc, 0xca, 0x9c, 0xdb, 0x7b, 0x63, 0xb9, 0x89, 0x76, 0x19, 0x72, 0x5b, 0x40,
-	0x17, 0xdc, 0x68, 0xbf, 0x4f, 0xd0, 0x4e, 0x65, 0xc0, 0x39, 0xf0, 0x8c, 0x51, 0x07, 0x79, 0x5e,
-	0xc4, 0xf5, 0x4f, 0xda, 0x15, 0x46, 0x3f, 0xf5, 0xbc, 0xc8, 0xe8, 0x80, 0xb7, 0x72, 0x61, 0x52,
-	0xd0, 0x01, 0x98, 0x66, 0xd4, 0x61, 0x22, 0xe4, 0xd0, 0x24, 0x26, 0x07, 0x58, 0x55, 0xd5, 0x65,
-	0x0a, 0xc8, 0x1b, 0x7a, 0xc9, 0x32, 0xa7, 0xc6, 0x1e, 0x78, 0x87, 0x77, 0xdb, 0xef, 0x10, 0x17,
-	0x75, 0x04, 0x44, 0x02, 0x7a, 0xdf, 0x04, 0xdd, 0x3e, 0xdd, 0x05, 0x30, 0x19, 0x07, 0x5d, 0xcc,
-	0x62, 0xd4, 0xa5, 0xbc, 0xdf, 0x84, 0x3d, 0x38, 0x30, 0xbe, 0xd7, 0xc0, 0xca, 0x98, 0x32, 0x92,
-	0xfe, 0x31, 0x98, 0xf5, 0x79, 0x8e, 0x23, 0x55, 0x64, 0x35, 0x2c, 0x2b, 0x1a, 0x72, 0xea, 0x09,
-	0x1d, 0xd0, 0x1f, 0x8a, 0x18, 0x1b, 0xe9, 0xe4, 0x94, 0x6b, 0x95, 0x12, 0x5e, 0x82, 0x72, 0xe0,
-	0xf1, 0x3e, 0x2f, 0xec, 0x72, 0xe0, 0x19, 0xa7, 0xf9, 0x4b, 0xda, 0xa7, 0xfa, 0x39, 0x98, 0x62,
-	0xd9, 0x90, 0x24, 0x39, 0x6e, 0x8d, 0x54, 0x98, 0xf1, 0x2d, 0x58, 0xcf, 0xeb, 0xb4, 0xdb, 0x3b,
-	0xa4, 0x38, 0x42, 0x31, 0x89, 0x92, 0x8b, 0xc7, 0xac, 0xff, 0x7a, 0xd4, 0xc1, 0x34, 0x91, 0x11,
-	0xbe, 0x21, 0x98, 0x31, 0xb9, 0x24, 0x53, 0x24, 0x8b, 0x30, 0x7a, 0x60, 0xa3, 0x60, 0xe9, 0x57,
-	0xae, 0xea, 0x38, 0xbf, 0xf5, 0x01, 0x0a, 0xc2, 0x18, 0x87, 0x28, 0x4c, 0x96, 0xb6, 0x4d, 0x22,
-	0xef, 0x29, 0xb2, 0x3a, 0xc0, 0x2c, 0x5a, 0x5b, 0xea, 0xda, 0x06, 0xcf, 0x22, 0x71, 0x24, 0x5f,
-	0xf6, 0x25, 0x45, 0xcf, 0x10, 0xd6, 0x4e, 0x01, 0xcd, 0x7f, 0x27, 0xc1, 0xeb, 0xbc, 0x1d, 0x0c,
-	0x41, 0x45, 0x18, 0x1a, 0x54, 0x37, 0x71, 0xd8, 0x31, 0x75, 0xe3, 0xb1, 0x14, 0x41, 0xcb, 0xa8,
-	0x5e, 0xff, 0xfe, 0xcf, 0x8f, 0xe5, 0x39, 0x38, 0x6b, 0xe5, 0x79, 0x35, 0xfc, 0x49, 0x03, 0xd3,
-	0xaa, 0x77, 0xc1, 0xb5, 0xbc, 0xba, 0x23, 0xac, 0x54, 0x5f, 0x2f, 0x96, 0x2c, 0xe9, 0xac, 0x70,
-	0x3a, 0x8b, 0xb0, 0x9a, 0xa1, 0xd3, 0x37, 0x93, 0x94, 0xc1, 0xaf, 0x9a, 0xfc, 0x33, 0xc8, 0x7a,
-	0x08, 0xac, 0xe7, 0x36, 0xcb, 0xf3, 0x37, 0xbd, 0x51, 0x24, 0x55, 0xb2, 0xda, 0xe4, 0xac, 0xd6,
-	0x60, 0x5d, 0x19, 0x92, 0x6a, 0x74, 0xd6, 0xa5, 0xb4, 0xcc, 0x2b, 0xf8, 0x5b, 0xea, 0xfc, 0xa3,
-	0x1c, 0x07, 0xb6, 0xf2, 0x08, 0x8c, 0xb1, 0x39, 0xfd, 0x83, 0xff, 0x07, 0x92, 0xfc, 0x3f, 0xe1,
-	0xfc, 0xb7, 0xe1, 0x87, 0x0a, 0xff, 0x5c, 0xa7, 0x73, 0xdc, 0x9e, 0x93, 0x38, 0xa7, 0x75, 0xd9,
-	0xf7, 0xcf, 0x2b, 0xf8, 0xb3, 0x06, 0xa6, 0x94, 0x4b, 0x83, 0x8d, 0x02, 0x37, 0x9b, 0xf2, 0x5e,
-	0x2b, 0x94, 0x2b, 0xe9, 0xd6, 0x39, 0xdd, 0xb7, 0xe1, 0xf2, 0x63, 0x4b, 0x60, 0x5d, 0x06, 0xde,
-	0x15, 0xfc, 0x53, 0x03, 0x4b, 0xe3, 0xac, 0x05, 0xee, 0x14, 0x68, 0x3e, 0xca, 0xeb, 0xf4, 0x8f,
-	0x9e, 0x06, 0x96, 0x52, 0x76, 0xb8, 0x94, 0x2d, 0xd8, 0x52, 0x37, 0x47, 0x51, 0x93, 0x0c, 0x5d,
-	0xf5, 0x1e, 0x78, 0x5d, 0x06, 0xcd, 0xb1, 0x06, 0x33, 0x2c, 0xb7, 0x08, 0xe3, 0x91, 0x26, 0xa8,
-	0x7f, 0xfc, 0x44, 0xb4, 0x14, 0x7c, 0xc8, 0x05, 0x7f, 0x01, 0xf7, 0xc7, 0x09, 0xee, 0x0e, 0x6a,
-	0x38, 0xd2, 0xe7, 0xf2, 0x86, 0xb0, 0xbb, 0x77, 0x73, 0x57, 0xd3, 0x6e, 0xef, 0x6a, 0xda, 0xdf,
-	0x77, 0x35, 0xed, 0x87, 0xfb, 0x5a, 0xe9, 0xf6, 0xbe, 0x56, 0xfa, 0xe3, 0xbe, 0x56, 0x3a, 0x6e,
-	0xf8, 0x41, 0x7c, 0x7a, 0xee, 0x9a, 0x6d, 0xd2, 0xb5, 0xdc, 0xd0, 0xdd, 0x68, 0x9f, 0xa2, 0x20,
-	0x7c, 0xd8, 0xf6, 0xbb, 0xfe, 0x87, 0xa5, 0x5b, 0xe1, 0x5f, 0x96, 0xad, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0x64, 0xa6, 0xac, 0x37, 0x0b, 0x00, 0x00,
+	// 1185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0xdc, 0x54,
+	0x14, 0x8e, 0xa7, 0x34, 0x55, 0x4e, 0x95, 0x4e, 0x74, 0x69, 0x94, 0xd4, 0x24, 0xd3, 0xd4, 0x79,
+	0xd0, 0xbc, 0xec, 0x66, 0x92, 0x56, 0xa8, 0xe1, 0x51, 0xa2, 0x8a, 0x00, 0x52, 0xd5, 0x30, 0xa9,
+	0x84, 0xc8, 0x66, 0xe4, 0x19, 0xdf, 0x3a, 0x96, 0x66, 0x7c, 0x5d, 0x5f, 0xa7, 0x62, 0x14, 0x65,
+	0x53, 0x16, 0x6c, 0x91, 0x80, 0x0d, 0x1b, 0xf8, 0x0b, 0x2c, 0x58, 0xb0, 0x60, 0x5f, 0x24, 0x16,
+	0x91, 0xd8, 0x20, 0x16, 0x08, 0x25, 0xfc, 0x10, 0xe4, 0x7b, 0x8f, 0x9d, 0xb1, 0xc7, 0x8e, 0x27,
+	0xd1, 0xec, 0x66, 0xee, 0x3d, 0xdf, 0x39, 0xdf, 0x77, 0xee, 0xeb, 0x93, 0xe1, 0x96, 0xed, 0x53,
+	0xea, 0x3e, 0x77, 0x68, 0xcb, 0x32, 0xb8, 0x67, 0xbc, 0x38, 0xa0, 0x7e, 0x47, 0xf7, 0x7c, 0x16,
+	0x30, 0x32, 0x7a, 0x36, 0xa5, 0x73, 0x4f, 0x5d, 0x6a, 0x32, 0xde, 0x66, 0xdc, 0x68, 0x98, 0x9c,
+	0xca, 0x38, 0xe3, 0xe5, 0x5a, 0x83, 0x06, 0xe6, 0x9a, 0xe1, 0x99, 0xb6, 0xe3, 0x9a, 0x81, 0xc3,
+	0x5c, 0x09, 0x55, 0x6f, 0xc9, 0xd8, 0xba, 0xf8, 0x67, 0xc8, 0x3f, 0x38, 0x75, 0xd3, 0x66, 0x36,
+	0x93, 0xe3, 0xe1, 0x2f, 0x1c, 0x9d, 0xb2, 0x19, 0xb3, 0x5b, 0xd4, 0x30, 0x3d, 0xc7, 0x30, 0x5d,
+	0x97, 0x05, 0x22, 0x5b, 0x84, 0x51, 0x93, 0x24, 0x3d, 0xd3, 0x37, 0xdb, 0xd1, 0x5c, 0x4a, 0x40,
+	0xd0, 0xf1, 0x28, 0x4e, 0x69, 0x37, 0x81, 0x7c, 0x16, 0xf2, 0xdc, 0x11, 0xf1, 0x35, 0xfa, 0xe2,
+	0x80, 0xf2, 0x40, 0xfb, 0x14, 0xde, 0x4c, 0x8c, 0x72, 0x8f, 0xb9, 0x9c, 0x92, 0x75, 0x18, 0x96,
+	0x79, 0x27, 0x95, 0x19, 0xe5, 0xee, 0xf5, 0xea, 0xb8, 0x9e, 0x90, 0xaf, 0xcb, 0xf0, 0xad, 0x37,
+	0x5e, 0xff, 0x73, 0x7b, 0xa8, 0x86, 0xa1, 0xda, 0x73, 0x98, 0x12, 0xb9, 0x76, 0x03, 0xe6, 0x9b,
+	0x36, 0xdd, 0xf1, 0xd9, 0x4b, 0xc7, 0xa2, 0x7e, 0x54, 0x8b, 0x7c, 0x04, 0x70, 0xd6, 0x1b, 0x4c,
+	0xbc, 0xa0, 0x63, 0x3f, 0xc2, 0x46, 0xea, 0xb2, 0xe1, 0xd8, 0x48, 0x7d, 0xc7, 0xb4, 0x29, 0x62,
+	0x6b, 0x5d, 0x48, 0xed, 0x07, 0x05, 0xa6, 0x73, 0x0a, 0x21, 0xfd, 0x7b, 0x70, 0x85, 0x7b, 0x21,
+	0xf7, 0x2b, 0x77, 0xaf, 0x57, 0x2b, 0x29, 0xee, 0x29, 0x54, 0x2d, 0x0c, 0x25, 0xdb, 0x09, 0x6e,
+	0x25, 0xc1, 0xed, 0xed, 0x42, 0x6e, 0xb2, 0x5c, 0x82, 0xdc, 0x7d, 0x50, 0x25, 0x37, 0x2f, 0xae,
+	0xe3, 0x34, 0x23, 0x19, 0x64, 0x02, 0xae, 0x71, 0xaf, 0x6e, 0x5a, 0x96, 0x2f, 0xf4, 0x8f, 0xd4,
+	0x86, 0xb9, 0xf7, 0xa1, 0x65, 0xf9, 0x5a, 0x0b, 0xde, 0xca, 0x84, 0xa1, 0xa0, 0x27, 0x30, 0xc6,
+	0xbd, 0x3a, 0x97, 0x53, 0x75, 0x2f, 0x9c, 0xc3, 0x06, 0x4e, 0xa7, 0xd5, 0x25, 0x12, 0xe0, 0x0a,
+	0xdd, 0xe0, 0x89, 0x51, 0xed, 0x31, 0xcc, 0x89, 0x6a, 0xdb, 0x2d, 0xd6, 0x30, 0x5b, 0x12, 0x82,
+	0x80, 0xce, 0x33, 0xa7, 0x1d, 0xd3, 0x9d, 0x82, 0x91, 0xc0, 0x69, 0x53, 0x1e, 0x98, 0x6d, 0x4f,
+	0xd4, 0xbb, 0x52, 0x3b, 0x1b, 0xd0, 0xbe, 0x52, 0x60, 0xbe, 0x20, 0x0d, 0xd2, 0xdf, 0x83, 0x71,
+	0x5b, 0xc4, 0xd4, 0x51, 0x45, 0x52, 0xc3, 0x9d, 0x94, 0x86, 0x8c, 0x7c, 0x52, 0x07, 0xb1, 0x7b,
+	0x66, 0xb4, 0xcd, 0xa8, 0x73, 0xf1, 0xd0, 0x33, 0xa7, 0x6b, 0xd3, 0x9d, 0x2f, 0xe1, 0x6b, 0x05,
+	0xa6, 0xb2, 0xd1, 0xc8, 0x7c, 0x01, 0xca, 0x07, 0x9e, 0x65, 0x06, 0xb4, 0x1e, 0x82, 0xea, 0x9c,
+	0x36, 0x31, 0xc9, 0xa8, 0x1c, 0x0e, 0x65, 0xee, 0xd2, 0x26, 0xf9, 0x00, 0xae, 0x06, 0x21, 0x70,
+	0xb2, 0x24, 0xf6, 0xdc, 0x6c, 0xb6, 0xa2, 0x44, 0x11, 0xd4, 0x24, 0x71, 0x9a, 0x8b, 0x4b, 0xb2,
+	0xdb, 0xdc, 0xa7, 0xd6, 0x41, 0x8b, 0x5a, 0xc9, 0x85, 0x1c, 0xf8, 0x21, 0xfa, 0x3d, 0x5a, 0xbc,
+	0xfc, 0x82, 0xd8, 0x82, 0xcf, 0x61, 0x8c, 0x47, 0x31, 0x72, 0xd9, 0xa2, 0x93, 0xb5, 0x90, 0xde,
+	0x7b, 0xd9, 0xa9, 0x50, 0x68, 0x39, 0xce, 0x22, 0x0b, 0x0c, 0xee, 0xcc, 0xad, 0x76, 0x6d, 0x81,
+	0xee, 0x93, 0x8d, 0x2d, 0xbb, 0x01, 0x25, 0xc7, 0x12, 0xad, 0x1a, 0xad, 0x95, 0x1c, 0x4b, 0xdb,
+	0xcf, 0xbe, 0xa7, 0x62, 0xc1, 0x1f, 0x43, 0x99, 0x27, 0xa7, 0xb0, 0xcf, 0x45, 0x37, 0x49, 0x1a,
+	0xa6, 0x7d, 0x01, 0x2b, 0x59, 0x95, 0xb6, 0x3a, 0x4f, 0x3d, 0xea, 0x9b, 0x01, 0xf3, 0xc3, 0xb3,
+	0x4f, 0x79, 0xbc, 0xb8, 0x8b, 0x30, 0xc6, 0x70, 0x46, 0x5c, 0x12, 0x94, 0x73, 0xbc, 0x27, 0xca,
+	0x2c, 0x89, 0xd0, 0x3a, 0xb0, 0xda, 0x67, 0xea, 0x81, 0xab, 0xda, 0xcb, 0x2e, 0xfd, 0xc4, 0x74,
+	0xdc, 0x80, 0xba, 0xa6, 0x1b, 0xde, 0x5b, 0x4d, 0xe6, 0x5b, 0x97, 0x91, 0xd5, 0x02, 0xbd, 0xdf,
+	0xdc, 0xa8, 0xeb, 0x21, 0x5c, 0xf3, 0xe5, 0x10, 0xee, 0xca, 0x99, 0x94, 0x9e, 0x1e, 0x6c, 0x2d,
+	0x02, 0x68, 0x0f, 0x60, 0x2e, 0xab, 0xda, 0x6e, 0x93, 0xf9, 0xb4, 0x69, 0xfa, 0x56, 0xde, 0x0e,
+	0xb2, 0x61, 0xbe, 0x00, 0x87, 0xe4, 0xde, 0x87, 0x11, 0x1e, 0x0d, 0x62, 0xbb, 0xd5, 0xde, 0x0b,
+	0x3b, 0x8a, 0xc0, 0x83, 0x72, 0x06, 0xd1, 0xda, 0x30, 0x9b, 0x55, 0x88, 0x6f, 0x75, 0x04, 0x68,
+	0xd0, 0x97, 0xc2, 0xcf, 0x0a, 0xcc, 0x9d, 0x5f, 0x0f, 0x75, 0x3d, 0x02, 0x88, 0x49, 0x46, 0x7d,
+	0x2f, 0x16, 0xd6, 0x85, 0x19, 0xd8, 0xe1, 0xaf, 0x1e, 0x97, 0xe1, 0xaa, 0xe0, 0x4c, 0x5c, 0x18,
+	0x96, 0xbe, 0x84, 0xa4, 0x1f, 0x94, 0x5e, 0xe3, 0xa3, 0x6a, 0xe7, 0x85, 0xc8, 0x32, 0xda, 0xf4,
+	0xab, 0x3f, 0xff, 0xfb, 0xb6, 0x34, 0x41, 0xc6, 0x8d, 0x2c, 0xcb, 0x45, 0xbe, 0x53, 0x60, 0x2c,
+	0xdd, 0x28, 0xb2, 0x9c, 0x95, 0x37, 0xc7, 0x11, 0xa9, 0x2b, 0xfd, 0x05, 0x23, 0x9d, 0x79, 0x41,
+	0xe7, 0x36, 0x99, 0x4e, 0xd0, 0x89, 0x3d, 0x41, 0xc4, 0xe0, 0x47, 0x05, 0x3d, 0x5d, 0xf2, 0x16,
+	0x26, 0x8b, 0x99, 0xc5, 0xb2, 0x6c, 0x8a, 0xba, 0xd4, 0x4f, 0x28, 0xb2, 0x5a, 0x13, 0xac, 0x96,
+	0xc9, 0x62, 0xaa, 0x49, 0x69, 0xbf, 0x62, 0x1c, 0xa2, 0xf3, 0x39, 0x22, 0x7f, 0x44, 0x06, 0x2e,
+	0xcf, 0x38, 0x90, 0xf5, 0x2c, 0x02, 0x05, 0x6e, 0x45, 0xdd, 0xb8, 0x18, 0x08, 0xf9, 0x3f, 0x12,
+	0xfc, 0x1f, 0x92, 0x77, 0x52, 0xfc, 0x33, 0x0d, 0x4b, 0xbd, 0xd1, 0x11, 0x46, 0xc0, 0x38, 0x8c,
+	0x3d, 0xc4, 0x11, 0xf9, 0x49, 0x81, 0x72, 0xca, 0x3f, 0x90, 0xa5, 0xbc, 0x95, 0xed, 0xb5, 0x28,
+	0xea, 0x72, 0x5f, 0xb1, 0x48, 0x77, 0x43, 0xd0, 0xd5, 0xc9, 0x4a, 0xba, 0xdd, 0x5d, 0x24, 0x85,
+	0xa3, 0x48, 0x50, 0xfc, 0x55, 0x81, 0xc9, 0xbc, 0x87, 0x3e, 0xbb, 0xd9, 0x05, 0x3e, 0x44, 0xdd,
+	0xb8, 0x18, 0x08, 0xd9, 0x57, 0x05, 0xfb, 0x15, 0xb2, 0x94, 0x66, 0x1f, 0x1b, 0x8c, 0xf4, 0xb6,
+	0xe1, 0xe4, 0x7b, 0x6c, 0x6f, 0xd7, 0x99, 0xc8, 0x6f, 0x6f, 0xef, 0xf3, 0xaf, 0x2e, 0xf7, 0x15,
+	0x8b, 0x04, 0x17, 0x05, 0xc1, 0x59, 0x72, 0xe7, 0xbc, 0x33, 0x66, 0x1c, 0x3a, 0xd6, 0x11, 0xf9,
+	0x5b, 0x81, 0x99, 0xa2, 0xd7, 0x97, 0x6c, 0xf6, 0x51, 0x3c, 0xcf, 0x0e, 0xa8, 0xef, 0x5e, 0x0e,
+	0x8c, 0x52, 0x36, 0x85, 0x94, 0xfb, 0x64, 0x3d, 0x63, 0xa7, 0x74, 0xab, 0x09, 0xf7, 0x74, 0xfa,
+	0x79, 0x26, 0xaf, 0x4a, 0x50, 0x2d, 0x7c, 0x83, 0x7b, 0xe5, 0xf6, 0xc3, 0x38, 0xd7, 0x27, 0xa8,
+	0xef, 0x5d, 0x12, 0x8d, 0x82, 0x9f, 0x0a, 0xc1, 0x9f, 0x90, 0xed, 0x22, 0xc1, 0xed, 0xb3, 0x1c,
+	0x75, 0xb4, 0x02, 0x99, 0x4d, 0xf8, 0x2d, 0x3c, 0x35, 0x39, 0x4f, 0x7c, 0xce, 0xa9, 0x39, 0xdf,
+	0x48, 0xa8, 0x1b, 0x17, 0x03, 0xa1, 0xb0, 0x07, 0x42, 0xd8, 0x3d, 0xa2, 0x17, 0x09, 0x8b, 0xdf,
+	0x57, 0xb9, 0x43, 0x7f, 0x51, 0x60, 0x22, 0xe7, 0x25, 0x27, 0xd5, 0x7e, 0x9e, 0x9e, 0xa4, 0xcd,
+	0x50, 0xd7, 0x2f, 0x84, 0x29, 0x7a, 0x1f, 0xd2, 0x0f, 0x57, 0xb8, 0x04, 0x42, 0xc0, 0xd6, 0xe3,
+	0xd7, 0x27, 0x15, 0xe5, 0xf8, 0xa4, 0xa2, 0xfc, 0x7b, 0x52, 0x51, 0xbe, 0x39, 0xad, 0x0c, 0x1d,
+	0x9f, 0x56, 0x86, 0xfe, 0x3a, 0xad, 0x0c, 0xed, 0x2d, 0xd9, 0x4e, 0xb0, 0x7f, 0xd0, 0xd0, 0x9b,
+	0xac, 0x6d, 0x34, 0xdc, 0xc6, 0x6a, 0x73, 0xdf, 0x74, 0xdc, 0xee, 0xc4, 0x5f, 0xc6, 0x9f, 0x3d,
+	0x1a, 0xc3, 0xe2, 0xbb, 0xc7, 0xfa, 0xff, 0x03, 0x00, 0x7d, 0x3a, 0x62, 0x63, 0xd5, 0x11, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -768,12 +1180,20 @@ type QueryClient interface {
 	QuerySpStoragePrice(ctx context.Context, in *QuerySpStoragePriceRequest, opts ...grpc.CallOption) (*QuerySpStoragePriceResponse, error)
 	// get global store price by time
 	QueryGlobalSpStorePriceByTime(ctx context.Context, in *QueryGlobalSpStorePriceByTimeRequest, opts ...grpc.CallOption) (*QueryGlobalSpStorePriceByTimeResponse, error)
+	// get the global store price tier tables by time, the first tier of each redundancy type is the base store price
+	StorePriceTiers(ctx context.Context, in *QueryStorePriceTiersRequest, opts ...grpc.CallOption) (*QueryStorePriceTiersResponse, error)
+	// Queries the upcoming price changes scheduled by the storage providers, sorted by effective time.
+	ScheduledSpStoragePrices(ctx context.Context, in *QueryScheduledSpStoragePricesRequest, opts ...grpc.CallOption) (*QueryScheduledSpStoragePricesResponse, error)
 	// Queries a storage provider with specify id
 	StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error)
 	// Queries a StorageProvider by specify operator address.
 	StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error)
 	// Queries a StorageProvider by specify operator address.
 	StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+	// Queries the scorecard of a storage provider with specify id
+	StorageProviderScorecard(ctx context.Context, in *QueryStorageProviderScorecardRequest, opts ...grpc.CallOption) (*QueryStorageProviderScorecardResponse, error)
+	// Queries the scorecards of all storage providers sorted by score in descending order.
+	StorageProvidersByScore(ctx context.Context, in *QueryStorageProvidersByScoreRequest, opts ...grpc.CallOption) (*QueryStorageProvidersByScoreResponse, error)
 }
 
 type queryClient struct {
@@ -820,28 +1240,64 @@ func (c *queryClient) QueryGlobalSpStorePriceByTime(ctx context.Context, in *Que
 	return out, nil
 }
 
-func (c *queryClient) StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error) {
-	out := new(QueryStorageProviderResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProvider", in, out, opts...)
+func (c *queryClient) StorePriceTiers(ctx context.Context, in *QueryStorePriceTiersRequest, opts ...grpc.CallOption) (*QueryStorePriceTiersResponse, error) {
+	out := new(QueryStorePriceTiersResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorePriceTiers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error) {
-	out := new(QueryStorageProviderByOperatorAddressResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderByOperatorAddress", in, out, opts...)
+func (c *queryClient) ScheduledSpStoragePrices(ctx context.Context, in *QueryScheduledSpStoragePricesRequest, opts ...grpc.CallOption) (*QueryScheduledSpStoragePricesResponse, error) {
+	out := new(QueryScheduledSpStoragePricesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/ScheduledSpStoragePrices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
-	out := new(QueryStorageProviderMaintenanceRecordsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderMaintenanceRecordsByOperatorAddress", in, out, opts...)
-	if err != nil {
+func (c *queryClient) StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error) {
+	out := new(QueryStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageProviderByOperatorAddress(ctx context.Context, in *QueryStorageProviderByOperatorAddressRequest, opts ...grpc.CallOption) (*QueryStorageProviderByOperatorAddressResponse, error) {
+	out := new(QueryStorageProviderByOperatorAddressResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderByOperatorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, in *QueryStorageProviderMaintenanceRecordsRequest, opts ...grpc.CallOption) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
+	out := new(QueryStorageProviderMaintenanceRecordsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderMaintenanceRecordsByOperatorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageProviderScorecard(ctx context.Context, in *QueryStorageProviderScorecardRequest, opts ...grpc.CallOption) (*QueryStorageProviderScorecardResponse, error) {
+	out := new(QueryStorageProviderScorecardResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProviderScorecard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageProvidersByScore(ctx context.Context, in *QueryStorageProvidersByScoreRequest, opts ...grpc.CallOption) (*QueryStorageProvidersByScoreResponse, error) {
+	out := new(QueryStorageProvidersByScoreResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Query/StorageProvidersByScore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return out, nil
@@ -857,12 +1313,20 @@ type QueryServer interface {
 	QuerySpStoragePrice(context.Context, *QuerySpStoragePriceRequest) (*QuerySpStoragePriceResponse, error)
 	// get global store price by time
 	QueryGlobalSpStorePriceByTime(context.Context, *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error)
+	// get the global store price tier tables by time, the first tier of each redundancy type is the base store price
+	StorePriceTiers(context.Context, *QueryStorePriceTiersRequest) (*QueryStorePriceTiersResponse, error)
+	// Queries the upcoming price changes scheduled by the storage providers, sorted by effective time.
+	ScheduledSpStoragePrices(context.Context, *QueryScheduledSpStoragePricesRequest) (*QueryScheduledSpStoragePricesResponse, error)
 	// Queries a storage provider with specify id
 	StorageProvider(context.Context, *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error)
 	// Queries a StorageProvider by specify operator address.
 	StorageProviderByOperatorAddress(context.Context, *QueryStorageProviderByOperatorAddressRequest) (*QueryStorageProviderByOperatorAddressResponse, error)
 	// Queries a StorageProvider by specify operator address.
 	StorageProviderMaintenanceRecordsByOperatorAddress(context.Context, *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error)
+	// Queries the scorecard of a storage provider with specify id
+	StorageProviderScorecard(context.Context, *QueryStorageProviderScorecardRequest) (*QueryStorageProviderScorecardResponse, error)
+	// Queries the scorecards of all storage providers sorted by score in descending order.
+	StorageProvidersByScore(context.Context, *QueryStorageProvidersByScoreRequest) (*QueryStorageProvidersByScoreResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -881,6 +1345,12 @@ func (*UnimplementedQueryServer) QuerySpStoragePrice(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) QueryGlobalSpStorePriceByTime(ctx context.Context, req *QueryGlobalSpStorePriceByTimeRequest) (*QueryGlobalSpStorePriceByTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryGlobalSpStorePriceByTime not implemented")
 }
+func (*UnimplementedQueryServer) StorePriceTiers(ctx context.Context, req *QueryStorePriceTiersRequest) (*QueryStorePriceTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorePriceTiers not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledSpStoragePrices(ctx context.Context, req *QueryScheduledSpStoragePricesRequest) (*QueryScheduledSpStoragePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledSpStoragePrices not implemented")
+}
 func (*UnimplementedQueryServer) StorageProvider(ctx context.Context, req *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageProvider not implemented")
 }
@@ -890,6 +1360,12 @@ func (*UnimplementedQueryServer) StorageProviderByOperatorAddress(ctx context.Co
 func (*UnimplementedQueryServer) StorageProviderMaintenanceRecordsByOperatorAddress(ctx context.Context, req *QueryStorageProviderMaintenanceRecordsRequest) (*QueryStorageProviderMaintenanceRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderMaintenanceRecordsByOperatorAddress not implemented")
 }
+func (*UnimplementedQueryServer) StorageProviderScorecard(ctx context.Context, req *QueryStorageProviderScorecardRequest) (*QueryStorageProviderScorecardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviderScorecard not implemented")
+}
+func (*UnimplementedQueryServer) StorageProvidersByScore(ctx context.Context, req *QueryStorageProvidersByScoreRequest) (*QueryStorageProvidersByScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProvidersByScore not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -967,6 +1443,42 @@ func _Query_QueryGlobalSpStorePriceByTime_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorePriceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorePriceTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorePriceTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorePriceTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorePriceTiers(ctx, req.(*QueryStorePriceTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledSpStoragePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledSpStoragePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledSpStoragePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/ScheduledSpStoragePrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledSpStoragePrices(ctx, req.(*QueryScheduledSpStoragePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_StorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStorageProviderRequest)
 	if err := dec(in); err != nil {
@@ -1021,6 +1533,42 @@ func _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageProviderScorecard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderScorecardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviderScorecard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProviderScorecard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviderScorecard(ctx, req.(*QueryStorageProviderScorecardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageProvidersByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProvidersByScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProvidersByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Query/StorageProvidersByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProvidersByScore(ctx, req.(*QueryStorageProvidersByScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.sp.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1041,6 +1589,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryGlobalSpStorePriceByTime",
 			Handler:    _Query_QueryGlobalSpStorePriceByTime_Handler,
 		},
+		{
+			MethodName: "StorePriceTiers",
+			Handler:    _Query_StorePriceTiers_Handler,
+		},
+		{
+			MethodName: "ScheduledSpStoragePrices",
+			Handler:    _Query_ScheduledSpStoragePrices_Handler,
+		},
 		{
 			MethodName: "StorageProvider",
 			Handler:    _Query_StorageProvider_Handler,
@@ -1053,6 +1609,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StorageProviderMaintenanceRecordsByOperatorAddress",
 			Handler:    _Query_StorageProviderMaintenanceRecordsByOperatorAddress_Handler,
 		},
+		{
+			MethodName: "StorageProviderScorecard",
+			Handler:    _Query_StorageProviderScorecard_Handler,
+		},
+		{
+			MethodName: "StorageProvidersByScore",
+			Handler:    _Query_StorageProvidersByScore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/sp/query.proto",
@@ -1322,6 +1886,160 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStorePriceTiersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorePriceTiersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorePriceTiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorePriceTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorePriceTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorePriceTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledSpStoragePricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledSpStoragePricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledSpStoragePricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledSpStoragePricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledSpStoragePricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledSpStoragePricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledPrices) > 0 {
+		for iNdEx := len(m.ScheduledPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1517,24 +2235,169 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryStorageProviderScorecardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryStorageProviderScorecardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderScorecardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderScorecardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderScorecardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderScorecardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Scorecard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProvidersByScoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProvidersByScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProvidersByScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProvidersByScoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProvidersByScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProvidersByScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scorecards) > 0 {
+		for iNdEx := len(m.Scorecards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scorecards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -1627,6 +2490,68 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStorePriceTiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *QueryStorePriceTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateTimeSec != 0 {
+		n += 1 + sovQuery(uint64(m.UpdateTimeSec))
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledSpStoragePricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledSpStoragePricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledPrices) > 0 {
+		for _, e := range m.ScheduledPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryStorageProviderRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1706,6 +2631,61 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStorageProviderScorecardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderScorecardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Scorecard.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProvidersByScoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProvidersByScoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scorecards) > 0 {
+		for _, e := range m.Scorecards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1731,16 +2711,691 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sps = append(m.Sps, &StorageProvider{})
+			if err := m.Sps[len(m.Sps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpStoragePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpStoragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpStoragePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpStoragePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpStoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorePriceTiersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorePriceTiersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorePriceTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1762,7 +3417,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorePriceTiersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1785,15 +3440,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorePriceTiersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorePriceTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1820,7 +3494,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tiers = append(m.Tiers, GlobalStorePriceTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1845,7 +3520,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledSpStoragePricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1868,10 +3543,10 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledSpStoragePricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledSpStoragePricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1931,7 +3606,7 @@ func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledSpStoragePricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,15 +3629,15 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledSpStoragePricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledSpStoragePricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledPrices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1989,8 +3664,8 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sps = append(m.Sps, &StorageProvider{})
-			if err := m.Sps[len(m.Sps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledPrices = append(m.ScheduledPrices, ScheduledSpStoragePrice{})
+			if err := m.ScheduledPrices[len(m.ScheduledPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2051,7 +3726,7 @@ func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,17 +3749,17 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpStoragePriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpStoragePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2094,24 +3769,11 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2133,7 +3795,7 @@ func (m *QuerySpStoragePriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2156,15 +3818,15 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpStoragePriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpStoragePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpStoragePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2191,7 +3853,10 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpStoragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StorageProvider == nil {
+				m.StorageProvider = &StorageProvider{}
+			}
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2216,7 +3881,7 @@ func (m *QuerySpStoragePriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2239,17 +3904,17 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2259,11 +3924,24 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2285,7 +3963,7 @@ func (m *QueryGlobalSpStorePriceByTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,15 +3986,15 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalSpStorePriceByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSpStorePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2343,7 +4021,10 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalSpStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StorageProvider == nil {
+				m.StorageProvider = &StorageProvider{}
+			}
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2368,7 +4049,7 @@ func (m *QueryGlobalSpStorePriceByTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2391,17 +4072,17 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2411,11 +4092,24 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2437,7 +4131,7 @@ func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2460,15 +4154,15 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2495,10 +4189,8 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageProvider == nil {
-				m.StorageProvider = &StorageProvider{}
-			}
-			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &MaintenanceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2523,7 +4215,7 @@ func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderScorecardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2546,17 +4238,17 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderScorecardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderScorecardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2566,24 +4258,11 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2605,7 +4284,7 @@ func (m *QueryStorageProviderByOperatorAddressRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProviderScorecardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2628,15 +4307,15 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProviderScorecardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderByOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProviderScorecardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scorecard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2663,10 +4342,7 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageProvider == nil {
-				m.StorageProvider = &StorageProvider{}
-			}
-			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scorecard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2691,7 +4367,7 @@ func (m *QueryStorageProviderByOperatorAddressResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProvidersByScoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2714,17 +4390,17 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProvidersByScoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProvidersByScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2734,23 +4410,27 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2773,7 +4453,7 @@ func (m *QueryStorageProviderMaintenanceRecordsRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProvidersByScoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2796,15 +4476,15 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProvidersByScoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageProviderMaintenanceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProvidersByScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scorecards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2831,8 +4511,44 @@ func (m *QueryStorageProviderMaintenanceRecordsResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &MaintenanceRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scorecards = append(m.Scorecards, SpScorecard{})
+			if err := m.Scorecards[len(m.Scorecards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,38 @@
+package types
+
+const (
+	// MaxSpScore is the score of a storage provider without any negative signal
+	MaxSpScore = 10000
+
+	// RejectSealScorePenalty is the score deducted for each object the sp rejected to seal
+	RejectSealScorePenalty = 5
+	// RejectMigrateScorePenalty is the score deducted for each bucket migration the sp rejected
+	RejectMigrateScorePenalty = 50
+	// MaintenanceScorePenaltyPeriod is the seconds in maintenance mode for which one score is deducted
+	MaintenanceScorePenaltyPeriod = 3600
+)
+
+// NewSpScorecard returns the scorecard of a storage provider without any signal
+func NewSpScorecard(spId uint32) SpScorecard {
+	return SpScorecard{
+		SpId:  spId,
+		Score: MaxSpScore,
+	}
+}
+
+// CalculateScore calculates the score from the signals of the scorecard. The challenge outcomes scale the max score
+// by the ratio of passed challenges, where a pass and a slash weigh the same, and then the penalties of the
+// rejections and maintenance durations are deducted.
+func (s *SpScorecard) CalculateScore() uint32 {
+	passed := s.ChallengePassCount + 1
+	score := MaxSpScore * passed / (passed + s.SlashCount)
+
+	penalty := s.RejectSealCount*RejectSealScorePenalty + s.RejectMigrateCount*RejectMigrateScorePenalty
+	if s.MaintenanceDuration > 0 {
+		penalty += uint64(s.MaintenanceDuration) / MaintenanceScorePenaltyPeriod
+	}
+	if penalty >= score {
+		return 0
+	}
+	return uint32(score - penalty)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	common "github.com/bnb-chain/greenfield/types/common"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -344,6 +345,8 @@ type MsgEditStorageProvider struct {
 	// bls_key defines the bls pub key of the Storage provider for sealing object
 	BlsKey   string `protobuf:"bytes,8,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
 	BlsProof string `protobuf:"bytes,9,opt,name=bls_proof,json=blsProof,proto3" json:"bls_proof,omitempty"`
+	// free_capacity is the remaining physical capacity of the storage provider in bytes, nil means no change
+	FreeCapacity *common.UInt64Value `protobuf:"bytes,10,opt,name=free_capacity,json=freeCapacity,proto3" json:"free_capacity,omitempty"`
 }
 
 func (m *MsgEditStorageProvider) Reset()         { *m = MsgEditStorageProvider{} }
@@ -442,6 +445,13 @@ func (m *MsgEditStorageProvider) GetBlsProof() string {
 	return ""
 }
 
+func (m *MsgEditStorageProvider) GetFreeCapacity() *common.UInt64Value {
+	if m != nil {
+		return m.FreeCapacity
+	}
+	return nil
+}
+
 // MsgEditStorageProviderResponse defines the Msg/EditStorageProvider response type.
 type MsgEditStorageProviderResponse struct {
 }
@@ -488,6 +498,13 @@ type MsgUpdateSpStoragePrice struct {
 	FreeReadQuota uint64 `protobuf:"varint,3,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in bnb wei per charge byte
 	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers by the redundancy type and the total charge size of a bucket, the tiers of each redundancy type
+	// should be sorted by min charge size in ascending order
+	StorePriceTiers []StorePriceTier `protobuf:"bytes,5,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
+	// the time the price takes effect, unix timestamp in seconds. It should be at least price_change_notice_days later
+	// than now and not in the last update_price_disallowed_days days of a month. If it's 0, the price takes effect at
+	// the earliest time allowed, which is now if price_change_notice_days is 0.
+	EffectiveTime int64 `protobuf:"varint,6,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
 }
 
 func (m *MsgUpdateSpStoragePrice) Reset()         { *m = MsgUpdateSpStoragePrice{} }
@@ -537,6 +554,20 @@ func (m *MsgUpdateSpStoragePrice) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateSpStoragePrice) GetStorePriceTiers() []StorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+func (m *MsgUpdateSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
 type MsgUpdateSpStoragePriceResponse struct {
 }
 
@@ -771,6 +802,192 @@ func (m *MsgUpdateStorageProviderStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateStorageProviderStatusResponse proto.InternalMessageInfo
 
+// MsgUnjailStorageProvider is used to unjail a SP by itself after the cooldown, its deposit should not be less
+// than the min deposit.
+type MsgUnjailStorageProvider struct {
+	// sp_address defines the operator address
+	SpAddress string `protobuf:"bytes,1,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+}
+
+func (m *MsgUnjailStorageProvider) Reset()         { *m = MsgUnjailStorageProvider{} }
+func (m *MsgUnjailStorageProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailStorageProvider) ProtoMessage()    {}
+func (*MsgUnjailStorageProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{12}
+}
+func (m *MsgUnjailStorageProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailStorageProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailStorageProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailStorageProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailStorageProvider.Merge(m, src)
+}
+func (m *MsgUnjailStorageProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailStorageProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailStorageProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailStorageProvider proto.InternalMessageInfo
+
+func (m *MsgUnjailStorageProvider) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+// MsgUnjailStorageProviderResponse defines the MsgUnjailStorageProvider response type.
+type MsgUnjailStorageProviderResponse struct {
+}
+
+func (m *MsgUnjailStorageProviderResponse) Reset()         { *m = MsgUnjailStorageProviderResponse{} }
+func (m *MsgUnjailStorageProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailStorageProviderResponse) ProtoMessage()    {}
+func (*MsgUnjailStorageProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{13}
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailStorageProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailStorageProviderResponse.Merge(m, src)
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailStorageProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailStorageProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailStorageProviderResponse proto.InternalMessageInfo
+
+// MsgWithdrawDeposit defines a SDK message for withdrawing the deposit of a storage provider.
+// The withdrawn deposit is locked for the unbonding period before it is sent back to the funding address.
+type MsgWithdrawDeposit struct {
+	// creator is the msg signer, it should be sp's fund address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sp_address is the operator address of sp
+	SpAddress string `protobuf:"bytes,2,opt,name=sp_address,json=spAddress,proto3" json:"sp_address,omitempty"`
+	// withdraw is the amount of token to withdraw from the deposit
+	Withdraw types.Coin `protobuf:"bytes,3,opt,name=withdraw,proto3" json:"withdraw"`
+}
+
+func (m *MsgWithdrawDeposit) Reset()         { *m = MsgWithdrawDeposit{} }
+func (m *MsgWithdrawDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDeposit) ProtoMessage()    {}
+func (*MsgWithdrawDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{14}
+}
+func (m *MsgWithdrawDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDeposit.Merge(m, src)
+}
+func (m *MsgWithdrawDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDeposit proto.InternalMessageInfo
+
+func (m *MsgWithdrawDeposit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgWithdrawDeposit) GetSpAddress() string {
+	if m != nil {
+		return m.SpAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawDeposit) GetWithdraw() types.Coin {
+	if m != nil {
+		return m.Withdraw
+	}
+	return types.Coin{}
+}
+
+// MsgWithdrawDepositResponse defines the Msg/WithdrawDeposit response type.
+type MsgWithdrawDepositResponse struct {
+}
+
+func (m *MsgWithdrawDepositResponse) Reset()         { *m = MsgWithdrawDepositResponse{} }
+func (m *MsgWithdrawDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDepositResponse) ProtoMessage()    {}
+func (*MsgWithdrawDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f630c2933caa1bce, []int{15}
+}
+func (m *MsgWithdrawDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDepositResponse.Merge(m, src)
+}
+func (m *MsgWithdrawDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDepositResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStorageProvider)(nil), "greenfield.sp.MsgCreateStorageProvider")
 	proto.RegisterType((*MsgCreateStorageProviderResponse)(nil), "greenfield.sp.MsgCreateStorageProviderResponse")
@@ -784,74 +1001,90 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.sp.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateStorageProviderStatus)(nil), "greenfield.sp.MsgUpdateStorageProviderStatus")
 	proto.RegisterType((*MsgUpdateStorageProviderStatusResponse)(nil), "greenfield.sp.MsgUpdateStorageProviderStatusResponse")
+	proto.RegisterType((*MsgUnjailStorageProvider)(nil), "greenfield.sp.MsgUnjailStorageProvider")
+	proto.RegisterType((*MsgUnjailStorageProviderResponse)(nil), "greenfield.sp.MsgUnjailStorageProviderResponse")
+	proto.RegisterType((*MsgWithdrawDeposit)(nil), "greenfield.sp.MsgWithdrawDeposit")
+	proto.RegisterType((*MsgWithdrawDepositResponse)(nil), "greenfield.sp.MsgWithdrawDepositResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/sp/tx.proto", fileDescriptor_f630c2933caa1bce) }
 
 var fileDescriptor_f630c2933caa1bce = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xc6, 0xae, 0x1d, 0x3f, 0x27, 0x36, 0xda, 0xa6, 0xcd, 0x7a, 0x91, 0x1c, 0xd7, 0x12,
-	0x26, 0xaa, 0x64, 0x5b, 0x49, 0x05, 0x15, 0xa5, 0x97, 0x26, 0x46, 0x08, 0x21, 0x4b, 0xc1, 0x11,
-	0x1c, 0x40, 0xc8, 0x9a, 0xdd, 0x9d, 0x6c, 0x56, 0xb1, 0x77, 0xb6, 0x33, 0x63, 0xab, 0xb9, 0xf2,
-	0x07, 0x20, 0x24, 0xfe, 0x11, 0x0e, 0xe5, 0x1f, 0xe0, 0x42, 0x8f, 0x55, 0x4f, 0x88, 0x43, 0x85,
-	0x92, 0x03, 0x37, 0xce, 0x1c, 0xd1, 0xec, 0xce, 0x8e, 0x77, 0xfd, 0xa3, 0x76, 0x93, 0x72, 0xb2,
-	0x67, 0xde, 0xf7, 0xbe, 0x79, 0x33, 0xf3, 0xbd, 0x6f, 0x77, 0xe1, 0xae, 0x4b, 0x31, 0xf6, 0x4f,
-	0x3d, 0x3c, 0x70, 0xda, 0x2c, 0x68, 0xf3, 0x67, 0xad, 0x80, 0x12, 0x4e, 0xf4, 0xad, 0xc9, 0x7c,
-	0x8b, 0x05, 0x66, 0xd5, 0x26, 0x6c, 0x48, 0x58, 0xdb, 0x42, 0x0c, 0xb7, 0xc7, 0xfb, 0x16, 0xe6,
-	0x68, 0xbf, 0x6d, 0x13, 0xcf, 0x8f, 0xe0, 0xe6, 0x8e, 0x8c, 0x0f, 0x99, 0xdb, 0x1e, 0xef, 0x8b,
-	0x1f, 0x19, 0xa8, 0x44, 0x81, 0x7e, 0x38, 0x6a, 0x47, 0x03, 0x19, 0xda, 0x76, 0x89, 0x4b, 0xa2,
-	0x79, 0xf1, 0x4f, 0xce, 0x9a, 0xe9, 0x82, 0x02, 0x44, 0xd1, 0x30, 0xce, 0xa8, 0x4c, 0x15, 0x7b,
-	0x11, 0x60, 0x19, 0xaa, 0xff, 0x9c, 0x07, 0xa3, 0xcb, 0xdc, 0x23, 0x8a, 0x11, 0xc7, 0x27, 0x9c,
-	0x50, 0xe4, 0xe2, 0x63, 0x4a, 0xc6, 0x9e, 0x83, 0xa9, 0x7e, 0x00, 0x79, 0x5b, 0x04, 0x08, 0x35,
-	0xb4, 0x9a, 0xb6, 0x57, 0x38, 0x34, 0x5e, 0x3d, 0x6f, 0x6e, 0xcb, 0x62, 0x9e, 0x38, 0x0e, 0xc5,
-	0x8c, 0x9d, 0x70, 0xea, 0xf9, 0x6e, 0x2f, 0x06, 0xea, 0x87, 0x50, 0x74, 0x30, 0xb3, 0xa9, 0x17,
-	0x70, 0x8f, 0xf8, 0xc6, 0x7a, 0x4d, 0xdb, 0x2b, 0x1e, 0x98, 0xad, 0xd4, 0xb1, 0xb4, 0x3a, 0x13,
-	0xc4, 0x61, 0xf6, 0xc5, 0xeb, 0xdd, 0xb5, 0x5e, 0x32, 0x49, 0x7f, 0x08, 0xc0, 0x82, 0x3e, 0x8a,
-	0x16, 0x30, 0x32, 0x4b, 0x96, 0x2e, 0xb0, 0x40, 0x4e, 0xe8, 0x4f, 0xa0, 0x7c, 0x3a, 0xf2, 0x1d,
-	0xcf, 0x77, 0x55, 0x76, 0x76, 0x49, 0x76, 0x49, 0x26, 0xc4, 0x14, 0x9f, 0xc2, 0x26, 0xc3, 0x68,
-	0xa0, 0xf2, 0x6f, 0x2d, 0xc9, 0x2f, 0x0a, 0x74, 0x9c, 0x7c, 0x04, 0xef, 0xa1, 0x20, 0xa0, 0x64,
-	0x9c, 0x20, 0xc8, 0x2d, 0x21, 0x28, 0xc7, 0x19, 0x31, 0xc9, 0x43, 0x00, 0xd7, 0x56, 0xe9, 0xf9,
-	0x65, 0xbb, 0x77, 0xed, 0x38, 0xf1, 0x0b, 0xb8, 0x3d, 0x44, 0x9e, 0xcf, 0xb1, 0x8f, 0x7c, 0x1b,
-	0x2b, 0x86, 0x8d, 0x25, 0x0c, 0x7a, 0x22, 0x29, 0xa6, 0x32, 0x61, 0x03, 0xfb, 0x4e, 0x40, 0x3c,
-	0x9f, 0x1b, 0x05, 0x91, 0xdf, 0x53, 0x63, 0xfd, 0x13, 0xc8, 0x3b, 0x38, 0x20, 0xcc, 0xe3, 0x06,
-	0x84, 0xb7, 0x5b, 0x69, 0x49, 0x5e, 0xa1, 0xf2, 0x96, 0x54, 0x79, 0xeb, 0x88, 0x78, 0xf1, 0xe5,
-	0xc6, 0x78, 0xfd, 0x3b, 0x00, 0x8a, 0x91, 0xd3, 0x0f, 0xa8, 0x67, 0x63, 0xa3, 0x18, 0x16, 0xf6,
-	0x58, 0x40, 0xfe, 0x7c, 0xbd, 0xdb, 0x70, 0x3d, 0x7e, 0x36, 0xb2, 0x5a, 0x36, 0x19, 0x4a, 0xbd,
-	0xcb, 0x9f, 0x26, 0x73, 0xce, 0xa5, 0x66, 0x3b, 0xd8, 0x7e, 0xf5, 0xbc, 0x09, 0x72, 0xb9, 0x0e,
-	0xb6, 0x7b, 0x05, 0xc1, 0x77, 0x2c, 0xe8, 0xf4, 0x06, 0x94, 0x4f, 0x29, 0xc6, 0xfd, 0x70, 0x85,
-	0xa7, 0x23, 0xc2, 0x91, 0xb1, 0x59, 0xd3, 0xf6, 0xb2, 0xbd, 0x2d, 0x31, 0xdd, 0xc3, 0xc8, 0xf9,
-	0x4a, 0x4c, 0xea, 0xdf, 0x43, 0x91, 0x71, 0x42, 0xb1, 0xac, 0x62, 0xeb, 0x1d, 0x54, 0x01, 0x21,
-	0x61, 0x54, 0xc6, 0x0e, 0xe4, 0xad, 0x01, 0xeb, 0x9f, 0xe3, 0x0b, 0xa3, 0x14, 0x9e, 0x5c, 0xce,
-	0x1a, 0xb0, 0x2f, 0xf1, 0x85, 0xfe, 0x3e, 0x14, 0x44, 0x20, 0xa0, 0x84, 0x9c, 0x1a, 0xe5, 0xe8,
-	0x50, 0xad, 0x01, 0x3b, 0x16, 0xe3, 0x47, 0x9b, 0x3f, 0xfc, 0xfd, 0xcb, 0xfd, 0xb8, 0x89, 0xea,
-	0x75, 0xa8, 0x2d, 0x6a, 0xca, 0x1e, 0x66, 0x01, 0xf1, 0x19, 0xae, 0xff, 0xa6, 0x01, 0x74, 0x99,
-	0xdb, 0x91, 0x47, 0x7b, 0x9d, 0x5e, 0x4d, 0xf7, 0xd9, 0xfa, 0xea, 0x7d, 0x96, 0x90, 0x40, 0xe6,
-	0xed, 0x24, 0x30, 0xb5, 0xd1, 0x6d, 0xd0, 0x27, 0x7b, 0x50, 0x5b, 0xfb, 0x37, 0x03, 0x77, 0xbb,
-	0xcc, 0xfd, 0xcc, 0xf1, 0xf8, 0xb4, 0x25, 0xa5, 0x4b, 0xd6, 0x56, 0x2f, 0x39, 0xa9, 0xe8, 0xf5,
-	0x29, 0x45, 0x3f, 0x4e, 0x7b, 0x56, 0x66, 0x99, 0x67, 0xa5, 0xdd, 0x6a, 0xda, 0x31, 0xb2, 0x37,
-	0x75, 0x8c, 0x5b, 0x37, 0x73, 0x8c, 0xdc, 0x8d, 0x1d, 0x23, 0x7f, 0x0d, 0xc7, 0x48, 0xc8, 0x7e,
-	0x63, 0xb1, 0xec, 0x0b, 0x53, 0xb2, 0x2f, 0x0b, 0x35, 0x24, 0x6e, 0xb4, 0x5e, 0x83, 0xea, 0xfc,
-	0x9b, 0x57, 0xe2, 0xf8, 0x7d, 0x1d, 0x76, 0xba, 0xcc, 0xfd, 0x3a, 0x70, 0x44, 0x73, 0x04, 0x0a,
-	0x26, 0x7a, 0xef, 0xda, 0xea, 0x48, 0x1b, 0xd3, 0xfa, 0xff, 0x6e, 0x4c, 0x99, 0x15, 0x8c, 0x29,
-	0xfb, 0x6e, 0x8d, 0x69, 0xf6, 0xac, 0xef, 0xc1, 0xee, 0x82, 0x83, 0x54, 0x87, 0xfd, 0xa3, 0x06,
-	0x65, 0x85, 0x39, 0x0e, 0xdf, 0x29, 0xf4, 0x8f, 0xa1, 0x80, 0x46, 0xfc, 0x8c, 0x50, 0x8f, 0x5f,
-	0x2c, 0x3f, 0x63, 0x05, 0xd5, 0x1f, 0x40, 0x2e, 0x7a, 0x2b, 0x91, 0x2f, 0x05, 0x77, 0xa6, 0x1a,
-	0x2c, 0xa2, 0x97, 0x7e, 0x21, 0xa1, 0x8f, 0x4a, 0xa2, 0xe8, 0x09, 0x49, 0xbd, 0x92, 0xb8, 0xfc,
-	0x28, 0x41, 0xd5, 0xfa, 0xab, 0x16, 0x6a, 0x47, 0xee, 0x27, 0xad, 0x9e, 0x13, 0x8e, 0xf8, 0x88,
-	0x5d, 0x5f, 0x1f, 0x4d, 0xc8, 0xb1, 0x90, 0x22, 0xac, 0xbd, 0x34, 0x53, 0x7b, 0xc4, 0xdf, 0x93,
-	0x20, 0x61, 0x36, 0xce, 0x88, 0x22, 0xe5, 0x26, 0x99, 0x9e, 0x1a, 0xcf, 0x5e, 0xc3, 0x1e, 0x34,
-	0xde, 0x5c, 0x76, 0xbc, 0xc3, 0x83, 0x7f, 0xb2, 0x90, 0xe9, 0x32, 0x57, 0x7f, 0x0a, 0x77, 0xe6,
-	0xbf, 0xb0, 0x7d, 0x38, 0x55, 0xd6, 0xa2, 0x87, 0x88, 0xd9, 0x5e, 0x11, 0x18, 0x2f, 0xad, 0x7f,
-	0x0e, 0xf9, 0xf8, 0x49, 0x53, 0x99, 0xcd, 0x95, 0x21, 0xf3, 0xde, 0xc2, 0x90, 0x22, 0x3a, 0x87,
-	0xdb, 0xf3, 0x7c, 0xfd, 0x83, 0xd9, 0xcc, 0x39, 0x30, 0xb3, 0xb9, 0x12, 0x4c, 0x2d, 0xe6, 0xc3,
-	0xf6, 0x5c, 0x9f, 0x68, 0xcc, 0xd2, 0xcc, 0xc3, 0x99, 0xad, 0xd5, 0x70, 0x6a, 0xbd, 0x31, 0x94,
-	0x26, 0xf1, 0x50, 0x09, 0xcd, 0x85, 0x0c, 0xf3, 0x6e, 0xda, 0xfc, 0xe8, 0xad, 0xe0, 0x6a, 0xdd,
-	0x6f, 0x60, 0x33, 0xd5, 0xa2, 0xd5, 0x45, 0x34, 0x51, 0xdc, 0x6c, 0xbc, 0x39, 0x1e, 0xf3, 0x1e,
-	0x76, 0x5e, 0x5c, 0x56, 0xb5, 0x97, 0x97, 0x55, 0xed, 0xaf, 0xcb, 0xaa, 0xf6, 0xd3, 0x55, 0x75,
-	0xed, 0xe5, 0x55, 0x75, 0xed, 0x8f, 0xab, 0xea, 0xda, 0xb7, 0xf7, 0x13, 0x76, 0x64, 0xf9, 0x56,
-	0xd3, 0x3e, 0x43, 0x9e, 0xdf, 0x4e, 0x7c, 0x67, 0x3c, 0x53, 0x5f, 0x1a, 0x56, 0x2e, 0xfc, 0xd4,
-	0x78, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x22, 0xdb, 0xd4, 0x34, 0x0d, 0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0xae, 0x1d, 0x3f, 0x27, 0x36, 0x6c, 0xd3, 0xd6, 0x59, 0xc0, 0x49, 0x2d, 0x35,
+	0x84, 0x4a, 0xb6, 0x95, 0x14, 0x5a, 0x91, 0xf6, 0xd2, 0x24, 0x08, 0x55, 0x28, 0x22, 0x38, 0x4d,
+	0x91, 0x40, 0xc8, 0x1a, 0xef, 0x8e, 0x37, 0x4b, 0xec, 0x9d, 0xcd, 0xcc, 0xd8, 0x69, 0xae, 0xfc,
+	0x00, 0x84, 0xc4, 0x1f, 0xe1, 0x50, 0x8e, 0x08, 0xa9, 0xa7, 0x1e, 0x38, 0x54, 0x3d, 0x21, 0x0e,
+	0x15, 0x4a, 0x0e, 0xfc, 0x0d, 0x34, 0xbb, 0xb3, 0x93, 0xf5, 0x7a, 0x5d, 0xbb, 0x49, 0x10, 0x27,
+	0x7b, 0xe6, 0x7d, 0xef, 0x7b, 0x6f, 0x66, 0xbe, 0xf7, 0x66, 0x16, 0xae, 0xdb, 0x14, 0x63, 0xb7,
+	0xed, 0xe0, 0x8e, 0x55, 0x67, 0x5e, 0x9d, 0x3f, 0xad, 0x79, 0x94, 0x70, 0xa2, 0xcf, 0x9d, 0xcd,
+	0xd7, 0x98, 0x67, 0x94, 0x4d, 0xc2, 0xba, 0x84, 0xd5, 0x5b, 0x88, 0xe1, 0x7a, 0x7f, 0xb5, 0x85,
+	0x39, 0x5a, 0xad, 0x9b, 0xc4, 0x71, 0x03, 0xb8, 0x71, 0x43, 0xda, 0xbb, 0xcc, 0xae, 0xf7, 0x57,
+	0xc5, 0x8f, 0x34, 0x2c, 0x04, 0x86, 0xa6, 0x3f, 0xaa, 0x07, 0x03, 0x69, 0x9a, 0xb7, 0x89, 0x4d,
+	0x82, 0x79, 0xf1, 0x4f, 0xce, 0x2e, 0x46, 0x12, 0x32, 0x49, 0xb7, 0x4b, 0xdc, 0xfa, 0x11, 0x45,
+	0x9e, 0x87, 0xa9, 0x04, 0x18, 0x83, 0x19, 0x7b, 0x88, 0xa2, 0x6e, 0x48, 0xb9, 0x10, 0x5b, 0xcd,
+	0xb1, 0x87, 0xa5, 0xa9, 0xf2, 0x73, 0x16, 0x4a, 0xdb, 0xcc, 0xde, 0xa4, 0x18, 0x71, 0xbc, 0xcb,
+	0x09, 0x45, 0x36, 0xde, 0xa1, 0xa4, 0xef, 0x58, 0x98, 0xea, 0x6b, 0x90, 0x35, 0x85, 0x81, 0xd0,
+	0x92, 0xb6, 0xa4, 0xad, 0xe4, 0x36, 0x4a, 0xaf, 0x9e, 0x55, 0xe7, 0x65, 0xb6, 0x0f, 0x2d, 0x8b,
+	0x62, 0xc6, 0x76, 0x39, 0x75, 0x5c, 0xbb, 0x11, 0x02, 0xf5, 0x0d, 0xc8, 0x5b, 0x98, 0x99, 0xd4,
+	0xf1, 0xb8, 0x43, 0xdc, 0xd2, 0xf4, 0x92, 0xb6, 0x92, 0x5f, 0x33, 0x6a, 0x03, 0xfb, 0x56, 0xdb,
+	0x3a, 0x43, 0x6c, 0xa4, 0x5f, 0xbc, 0x5e, 0x9c, 0x6a, 0x44, 0x9d, 0xf4, 0x7b, 0x00, 0xcc, 0x6b,
+	0xa2, 0x20, 0x40, 0x29, 0x35, 0x26, 0x74, 0x8e, 0x79, 0x72, 0x42, 0x7f, 0x08, 0xc5, 0x76, 0xcf,
+	0xb5, 0x1c, 0xd7, 0x56, 0xde, 0xe9, 0x31, 0xde, 0x05, 0xe9, 0x10, 0x52, 0xdc, 0x87, 0x59, 0x86,
+	0x51, 0x47, 0xf9, 0x5f, 0x19, 0xe3, 0x9f, 0x17, 0xe8, 0xd0, 0x79, 0x13, 0xde, 0x41, 0x9e, 0x47,
+	0x49, 0x3f, 0x42, 0x90, 0x19, 0x43, 0x50, 0x0c, 0x3d, 0x42, 0x92, 0x7b, 0x00, 0xb6, 0xa9, 0xdc,
+	0xb3, 0xe3, 0x56, 0x6f, 0x9b, 0xa1, 0xe3, 0x23, 0xb8, 0xda, 0x45, 0x8e, 0xcb, 0xb1, 0x8b, 0x5c,
+	0x13, 0x2b, 0x86, 0x99, 0x31, 0x0c, 0x7a, 0xc4, 0x29, 0xa4, 0x32, 0x60, 0x06, 0xbb, 0x96, 0x47,
+	0x1c, 0x97, 0x97, 0x72, 0xc2, 0xbf, 0xa1, 0xc6, 0xfa, 0xa7, 0x90, 0xb5, 0xb0, 0x47, 0x98, 0xc3,
+	0x4b, 0xe0, 0x9f, 0xee, 0x42, 0x4d, 0xf2, 0x8a, 0x32, 0xa8, 0xc9, 0x32, 0xa8, 0x6d, 0x12, 0x27,
+	0x3c, 0xdc, 0x10, 0xaf, 0x7f, 0x0b, 0x40, 0x31, 0xb2, 0x9a, 0x1e, 0x75, 0x4c, 0x5c, 0xca, 0xfb,
+	0x89, 0x3d, 0x10, 0x90, 0xbf, 0x5e, 0x2f, 0x2e, 0xdb, 0x0e, 0xdf, 0xef, 0xb5, 0x6a, 0x26, 0xe9,
+	0xca, 0x82, 0x90, 0x3f, 0x55, 0x66, 0x1d, 0x48, 0xcd, 0x6e, 0x61, 0xf3, 0xd5, 0xb3, 0x2a, 0xc8,
+	0x70, 0x5b, 0xd8, 0x6c, 0xe4, 0x04, 0xdf, 0x8e, 0xa0, 0xd3, 0x97, 0xa1, 0xd8, 0xa6, 0x18, 0x37,
+	0xfd, 0x08, 0x87, 0x3d, 0xc2, 0x51, 0x69, 0x76, 0x49, 0x5b, 0x49, 0x37, 0xe6, 0xc4, 0x74, 0x03,
+	0x23, 0xeb, 0x2b, 0x31, 0xa9, 0x7f, 0x07, 0x79, 0xc6, 0x09, 0xc5, 0x32, 0x8b, 0xb9, 0x4b, 0xc8,
+	0x02, 0x7c, 0xc2, 0x20, 0x8d, 0x1b, 0x90, 0x6d, 0x75, 0x58, 0xf3, 0x00, 0x1f, 0x97, 0x0a, 0xfe,
+	0xce, 0x65, 0x5a, 0x1d, 0xf6, 0x05, 0x3e, 0xd6, 0xdf, 0x83, 0x9c, 0x30, 0x78, 0x94, 0x90, 0x76,
+	0xa9, 0x18, 0x6c, 0x6a, 0xab, 0xc3, 0x76, 0xc4, 0x78, 0x7d, 0xf6, 0x87, 0x7f, 0x7e, 0xb9, 0x1d,
+	0x16, 0x51, 0xa5, 0x02, 0x4b, 0xa3, 0x8a, 0xb2, 0x81, 0x99, 0x47, 0x5c, 0x86, 0x2b, 0xcf, 0x35,
+	0x80, 0x6d, 0x66, 0x6f, 0xc9, 0xad, 0x3d, 0x4f, 0xad, 0x0e, 0xd6, 0xd9, 0xf4, 0xe4, 0x75, 0x16,
+	0x91, 0x40, 0xea, 0xed, 0x24, 0x10, 0x5b, 0xe8, 0x3c, 0xe8, 0x67, 0x6b, 0x50, 0x4b, 0xfb, 0x2d,
+	0x0d, 0xd7, 0xb7, 0x99, 0xfd, 0x99, 0xe5, 0xf0, 0x78, 0x4b, 0x1a, 0x4c, 0x59, 0x9b, 0x3c, 0xe5,
+	0xa8, 0xa2, 0xa7, 0x63, 0x8a, 0x7e, 0x30, 0xd8, 0xb3, 0x52, 0xe3, 0x7a, 0xd6, 0x60, 0xb7, 0x8a,
+	0x77, 0x8c, 0xf4, 0x45, 0x3b, 0xc6, 0x95, 0x8b, 0x75, 0x8c, 0xcc, 0x85, 0x3b, 0x46, 0xf6, 0x1c,
+	0x1d, 0x23, 0x22, 0xfb, 0x99, 0xd1, 0xb2, 0xcf, 0x0d, 0xca, 0x5e, 0xdf, 0x04, 0xbf, 0x38, 0x9b,
+	0x26, 0xf2, 0x90, 0xe9, 0xf0, 0x63, 0xd9, 0x51, 0xca, 0xd1, 0xbd, 0x0f, 0xae, 0xbb, 0xda, 0xde,
+	0x23, 0x97, 0xdf, 0xfd, 0xf8, 0x09, 0xea, 0xf4, 0x70, 0x63, 0x56, 0x38, 0x6d, 0x4a, 0x9f, 0xf5,
+	0xa2, 0x90, 0x54, 0x44, 0x16, 0x95, 0x25, 0x28, 0x27, 0xcb, 0x47, 0x29, 0xec, 0xf7, 0x14, 0xdc,
+	0xd8, 0x66, 0xf6, 0x9e, 0x67, 0x89, 0x0a, 0xf3, 0x14, 0x4c, 0x14, 0xf0, 0xb9, 0x25, 0x36, 0xd8,
+	0xdd, 0xa6, 0xff, 0xf3, 0xee, 0x96, 0x9a, 0xa0, 0xbb, 0xa5, 0x2f, 0xb9, 0xbb, 0x7d, 0x09, 0xef,
+	0x46, 0xe8, 0x9b, 0xdc, 0xc1, 0x54, 0x08, 0x36, 0xb5, 0x92, 0x5f, 0xfb, 0x20, 0x56, 0x30, 0xbb,
+	0xca, 0xeb, 0xb1, 0x83, 0xa9, 0xec, 0x03, 0x45, 0x36, 0x30, 0xcb, 0xf4, 0x5b, 0x50, 0xc0, 0xed,
+	0x36, 0x36, 0xb9, 0xd3, 0x17, 0x74, 0x5d, 0xec, 0xeb, 0x37, 0xd5, 0x98, 0x53, 0xb3, 0x8f, 0x9d,
+	0x2e, 0x1e, 0x3e, 0xe3, 0x9b, 0xb0, 0x38, 0xe2, 0x00, 0xd5, 0x21, 0xff, 0xa8, 0x41, 0x51, 0x61,
+	0x76, 0xfc, 0x07, 0x91, 0x7e, 0x17, 0x72, 0xa8, 0xc7, 0xf7, 0x09, 0x15, 0x62, 0x1b, 0x7b, 0xb6,
+	0x0a, 0xaa, 0xdf, 0x81, 0x4c, 0xf0, 0xa4, 0x92, 0x2f, 0x9a, 0x6b, 0xb1, 0xc5, 0x06, 0xf4, 0x72,
+	0x91, 0x12, 0xba, 0x5e, 0x10, 0x49, 0x9f, 0x91, 0x54, 0x16, 0x22, 0xa2, 0x0b, 0x1c, 0x54, 0xae,
+	0xbf, 0x6a, 0x50, 0x56, 0xb6, 0x98, 0x6a, 0x77, 0x39, 0xe2, 0x3d, 0x76, 0x7e, 0x5d, 0x56, 0x21,
+	0xc3, 0x7c, 0x0a, 0x3f, 0xf7, 0xc2, 0x50, 0xee, 0x01, 0x7f, 0x43, 0x82, 0x44, 0xa7, 0xb4, 0x7a,
+	0x14, 0xa9, 0x56, 0x98, 0x6a, 0xa8, 0xf1, 0xf0, 0x31, 0xac, 0xc0, 0xf2, 0x9b, 0xd3, 0x56, 0x2b,
+	0xb4, 0xfc, 0x87, 0xe6, 0x9e, 0xfb, 0x3d, 0x72, 0x3a, 0x97, 0xd5, 0xd5, 0x87, 0xf3, 0x09, 0x6e,
+	0xce, 0xc4, 0x28, 0x2a, 0x93, 0x3f, 0x34, 0xff, 0xd6, 0xf9, 0xda, 0xe1, 0xfb, 0x16, 0x45, 0x47,
+	0xff, 0xcb, 0x0d, 0x7a, 0x1f, 0x66, 0x8e, 0x64, 0xfc, 0x49, 0xaf, 0x50, 0xe5, 0x10, 0xbb, 0x43,
+	0xdf, 0x07, 0x63, 0x78, 0x35, 0xe1, 0x62, 0xd7, 0x9e, 0x67, 0x20, 0xb5, 0xcd, 0x6c, 0xfd, 0x10,
+	0xae, 0x25, 0x3f, 0xf2, 0x3f, 0x8c, 0xa9, 0x61, 0xd4, 0xc3, 0xc3, 0xa8, 0x4f, 0x08, 0x0c, 0x43,
+	0xeb, 0x9f, 0x43, 0x36, 0xdc, 0xdb, 0x85, 0x61, 0x5f, 0x69, 0x32, 0x6e, 0x8e, 0x34, 0x29, 0xa2,
+	0x03, 0xb8, 0x9a, 0xf4, 0x16, 0xb8, 0x35, 0xec, 0x99, 0x00, 0x33, 0xaa, 0x13, 0xc1, 0x54, 0x30,
+	0x17, 0xe6, 0x13, 0xaf, 0x85, 0xe5, 0x61, 0x9a, 0x24, 0x9c, 0x51, 0x9b, 0x0c, 0xa7, 0xe2, 0xf5,
+	0xa1, 0x70, 0x66, 0xf7, 0x0b, 0xb0, 0x3a, 0x92, 0x21, 0xa9, 0xc0, 0x8c, 0x4f, 0xde, 0x0a, 0xae,
+	0xe2, 0x1e, 0xc2, 0xb5, 0xe4, 0x62, 0x4c, 0x10, 0x44, 0x22, 0xd0, 0xa8, 0x4f, 0x08, 0x54, 0x21,
+	0x9b, 0x50, 0x8c, 0x17, 0x5d, 0xc2, 0xe9, 0xc7, 0x20, 0xc6, 0x47, 0x63, 0x21, 0x2a, 0xc0, 0x13,
+	0x98, 0x1d, 0xe8, 0xf6, 0xe5, 0x51, 0x5b, 0x13, 0xd8, 0x8d, 0xe5, 0x37, 0xdb, 0x43, 0xde, 0x8d,
+	0xad, 0x17, 0x27, 0x65, 0xed, 0xe5, 0x49, 0x59, 0xfb, 0xfb, 0xa4, 0xac, 0xfd, 0x74, 0x5a, 0x9e,
+	0x7a, 0x79, 0x5a, 0x9e, 0xfa, 0xf3, 0xb4, 0x3c, 0xf5, 0xcd, 0xed, 0xc8, 0x8d, 0xda, 0x72, 0x5b,
+	0x55, 0x73, 0x1f, 0x39, 0x6e, 0x3d, 0xf2, 0xbd, 0xfd, 0x54, 0x7d, 0x71, 0xb7, 0x32, 0xfe, 0x27,
+	0xf7, 0x9d, 0x7f, 0x07, 0x00, 0x6a, 0xd9, 0xc5, 0x9e, 0x5d, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -871,6 +1104,8 @@ type MsgClient interface {
 	EditStorageProvider(ctx context.Context, in *MsgEditStorageProvider, opts ...grpc.CallOption) (*MsgEditStorageProviderResponse, error)
 	UpdateSpStoragePrice(ctx context.Context, in *MsgUpdateSpStoragePrice, opts ...grpc.CallOption) (*MsgUpdateSpStoragePriceResponse, error)
 	UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProviderStatus, opts ...grpc.CallOption) (*MsgUpdateStorageProviderStatusResponse, error)
+	UnjailStorageProvider(ctx context.Context, in *MsgUnjailStorageProvider, opts ...grpc.CallOption) (*MsgUnjailStorageProviderResponse, error)
+	WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/sp module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -931,6 +1166,24 @@ func (c *msgClient) UpdateSpStatus(ctx context.Context, in *MsgUpdateStorageProv
 	return out, nil
 }
 
+func (c *msgClient) UnjailStorageProvider(ctx context.Context, in *MsgUnjailStorageProvider, opts ...grpc.CallOption) (*MsgUnjailStorageProviderResponse, error) {
+	out := new(MsgUnjailStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UnjailStorageProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error) {
+	out := new(MsgWithdrawDepositResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/WithdrawDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/greenfield.sp.Msg/UpdateParams", in, out, opts...)
@@ -947,6 +1200,8 @@ type MsgServer interface {
 	EditStorageProvider(context.Context, *MsgEditStorageProvider) (*MsgEditStorageProviderResponse, error)
 	UpdateSpStoragePrice(context.Context, *MsgUpdateSpStoragePrice) (*MsgUpdateSpStoragePriceResponse, error)
 	UpdateSpStatus(context.Context, *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error)
+	UnjailStorageProvider(context.Context, *MsgUnjailStorageProvider) (*MsgUnjailStorageProviderResponse, error)
+	WithdrawDeposit(context.Context, *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/sp module parameters.
 	// The authority is defined in the keeper.
 	//
@@ -973,6 +1228,12 @@ func (*UnimplementedMsgServer) UpdateSpStoragePrice(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) UpdateSpStatus(ctx context.Context, req *MsgUpdateStorageProviderStatus) (*MsgUpdateStorageProviderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpStatus not implemented")
 }
+func (*UnimplementedMsgServer) UnjailStorageProvider(ctx context.Context, req *MsgUnjailStorageProvider) (*MsgUnjailStorageProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailStorageProvider not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawDeposit(ctx context.Context, req *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDeposit not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1071,6 +1332,42 @@ func _Msg_UpdateSpStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailStorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailStorageProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailStorageProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/UnjailStorageProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailStorageProvider(ctx, req.(*MsgUnjailStorageProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.sp.Msg/WithdrawDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDeposit(ctx, req.(*MsgWithdrawDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1113,6 +1410,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSpStatus",
 			Handler:    _Msg_UpdateSpStatus_Handler,
 		},
+		{
+			MethodName: "UnjailStorageProvider",
+			Handler:    _Msg_UnjailStorageProvider_Handler,
+		},
+		{
+			MethodName: "WithdrawDeposit",
+			Handler:    _Msg_WithdrawDeposit_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1373,6 +1678,18 @@ func (m *MsgEditStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.FreeCapacity != nil {
+		{
+			size, err := m.FreeCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.BlsProof) > 0 {
 		i -= len(m.BlsProof)
 		copy(dAtA[i:], m.BlsProof)
@@ -1487,6 +1804,25 @@ func (m *MsgUpdateSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.StorePrice.Size()
 		i -= size
@@ -1671,36 +2007,159 @@ func (m *MsgUpdateStorageProviderStatusResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUnjailStorageProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateStorageProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUnjailStorageProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailStorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailStorageProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FundingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdraw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SpAddress) > 0 {
+		i -= len(m.SpAddress)
+		copy(dAtA[i:], m.SpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.SealAddress)
 	if l > 0 {
@@ -1821,6 +2280,10 @@ func (m *MsgEditStorageProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.FreeCapacity != nil {
+		l = m.FreeCapacity.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1850,6 +2313,15 @@ func (m *MsgUpdateSpStoragePrice) Size() (n int) {
 	}
 	l = m.StorePrice.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovTx(uint64(m.EffectiveTime))
+	}
 	return n
 }
 
@@ -1914,6 +2386,56 @@ func (m *MsgUpdateStorageProviderStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnjailStorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailStorageProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Withdraw.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3011,6 +3533,42 @@ func (m *MsgEditStorageProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.BlsProof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreeCapacity == nil {
+				m.FreeCapacity = &common.UInt64Value{}
+			}
+			if err := m.FreeCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3230,6 +3788,59 @@ func (m *MsgUpdateSpStoragePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, StorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			m.EffectiveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3636,6 +4247,335 @@ func (m *MsgUpdateStorageProviderStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnjailStorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailStorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailStorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailStorageProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailStorageProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,11 @@ const (
 	MaxWebsiteLength                      = 140
 	MaxDetailsLength                      = 280
 	MaintenanceRecordsGCFrequencyInBlocks = 100
+
+	// JailReasonSlashExceeded is the jail reason when the slash amount of the sp exceeds the max amount in the counting window
+	JailReasonSlashExceeded = "slash amount exceeds the max amount"
+	// JailReasonMaintenanceOverstay is the jail reason when the sp stays in maintenance longer than requested
+	JailReasonMaintenanceOverstay = "maintenance overstay"
 )
 
 // NewStorageProvider constructs a new StorageProvider
@@ -98,6 +103,10 @@ func (sp *StorageProvider) IsInMaintenance() bool {
 	return sp.GetStatus() == STATUS_IN_MAINTENANCE
 }
 
+func (sp *StorageProvider) IsJailed() bool {
+	return sp.GetStatus() == STATUS_IN_JAILED
+}
+
 func (sp *StorageProvider) GetTotalDeposit() math.Int { return sp.TotalDeposit }
 
 // constant used in flags to indicate that description field should not be updated
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	common "github.com/bnb-chain/greenfield/types/common"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -166,6 +167,8 @@ type StorageProvider struct {
 	Description Description `protobuf:"bytes,11,opt,name=description,proto3" json:"description"`
 	// bls_key defines the bls pub key of the Storage provider for sealing object and completing migration
 	BlsKey []byte `protobuf:"bytes,12,opt,name=bls_key,json=blsKey,proto3" json:"bls_key,omitempty"`
+	// free_capacity defines the remaining physical capacity in bytes reported by the storage provider, nil if not reported
+	FreeCapacity *common.UInt64Value `protobuf:"bytes,13,opt,name=free_capacity,json=freeCapacity,proto3" json:"free_capacity,omitempty"`
 }
 
 func (m *StorageProvider) Reset()         { *m = StorageProvider{} }
@@ -278,6 +281,13 @@ func (m *StorageProvider) GetBlsKey() []byte {
 	return nil
 }
 
+func (m *StorageProvider) GetFreeCapacity() *common.UInt64Value {
+	if m != nil {
+		return m.FreeCapacity
+	}
+	return nil
+}
+
 type RewardInfo struct {
 	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -342,6 +352,8 @@ type SpStoragePrice struct {
 	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
 	// store price, in bnb wei per charge byte
 	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers by the redundancy type and the total charge size of a bucket, the store price applies to the sizes below the first tier of each redundancy type
+	StorePriceTiers []StorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *SpStoragePrice) Reset()         { *m = SpStoragePrice{} }
@@ -398,6 +410,160 @@ func (m *SpStoragePrice) GetFreeReadQuota() uint64 {
 	return 0
 }
 
+func (m *SpStoragePrice) GetStorePriceTiers() []StorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+// ScheduledSpStoragePrice is a price change scheduled by a storage provider, which takes effect at the effective time
+type ScheduledSpStoragePrice struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the time the price takes effect, unix timestamp in seconds
+	EffectiveTime int64 `protobuf:"varint,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	// read price, in bnb wei per charge byte
+	ReadPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=read_price,json=readPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"read_price"`
+	// free read quota, in byte
+	FreeReadQuota uint64 `protobuf:"varint,4,opt,name=free_read_quota,json=freeReadQuota,proto3" json:"free_read_quota,omitempty"`
+	// store price, in bnb wei per charge byte
+	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price tiers by the total charge size of a bucket
+	StorePriceTiers []StorePriceTier `protobuf:"bytes,6,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
+	// the time the price change is scheduled, unix timestamp in seconds
+	ScheduledAt int64 `protobuf:"varint,7,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+}
+
+func (m *ScheduledSpStoragePrice) Reset()         { *m = ScheduledSpStoragePrice{} }
+func (m *ScheduledSpStoragePrice) String() string { return proto.CompactTextString(m) }
+func (*ScheduledSpStoragePrice) ProtoMessage()    {}
+func (*ScheduledSpStoragePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{4}
+}
+func (m *ScheduledSpStoragePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledSpStoragePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledSpStoragePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledSpStoragePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledSpStoragePrice.Merge(m, src)
+}
+func (m *ScheduledSpStoragePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledSpStoragePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledSpStoragePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledSpStoragePrice proto.InternalMessageInfo
+
+func (m *ScheduledSpStoragePrice) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *ScheduledSpStoragePrice) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+func (m *ScheduledSpStoragePrice) GetFreeReadQuota() uint64 {
+	if m != nil {
+		return m.FreeReadQuota
+	}
+	return 0
+}
+
+func (m *ScheduledSpStoragePrice) GetStorePriceTiers() []StorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+func (m *ScheduledSpStoragePrice) GetScheduledAt() int64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+// StorePriceTier is a store price published by a storage provider for the buckets
+// whose total charge size is not less than min_charge_size.
+type StorePriceTier struct {
+	// min total charge size of a bucket for the tier, in byte
+	MinChargeSize uint64 `protobuf:"varint,1,opt,name=min_charge_size,json=minChargeSize,proto3" json:"min_charge_size,omitempty"`
+	// store price as a primary sp, in bnb wei per charge byte
+	StorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=store_price,json=storePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"store_price"`
+	// store price as a secondary sp which stores the redundancy pieces, in bnb wei per charge byte.
+	// If it is zero, the store price multiplied by the secondary sp store price ratio is used.
+	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+	// redundancy type of the objects the tier applies to, the value of greenfield.storage.RedundancyType
+	RedundancyType int32 `protobuf:"varint,4,opt,name=redundancy_type,json=redundancyType,proto3" json:"redundancy_type,omitempty"`
+}
+
+func (m *StorePriceTier) Reset()         { *m = StorePriceTier{} }
+func (m *StorePriceTier) String() string { return proto.CompactTextString(m) }
+func (*StorePriceTier) ProtoMessage()    {}
+func (*StorePriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{5}
+}
+func (m *StorePriceTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorePriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorePriceTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorePriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorePriceTier.Merge(m, src)
+}
+func (m *StorePriceTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorePriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorePriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorePriceTier proto.InternalMessageInfo
+
+func (m *StorePriceTier) GetMinChargeSize() uint64 {
+	if m != nil {
+		return m.MinChargeSize
+	}
+	return 0
+}
+
+func (m *StorePriceTier) GetRedundancyType() int32 {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return 0
+}
+
 // global sp store price, the price for all sps
 type GlobalSpStorePrice struct {
 	// update time, unix timestamp in seconds
@@ -408,13 +574,15 @@ type GlobalSpStorePrice struct {
 	PrimaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"primary_store_price"`
 	// secondary store price, in bnb wei per charge byte
 	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+	// store price tiers by the redundancy type and the total charge size of a bucket, the store prices above apply to the sizes below the first tier of each redundancy type
+	StorePriceTiers []GlobalStorePriceTier `protobuf:"bytes,5,rep,name=store_price_tiers,json=storePriceTiers,proto3" json:"store_price_tiers"`
 }
 
 func (m *GlobalSpStorePrice) Reset()         { *m = GlobalSpStorePrice{} }
 func (m *GlobalSpStorePrice) String() string { return proto.CompactTextString(m) }
 func (*GlobalSpStorePrice) ProtoMessage()    {}
 func (*GlobalSpStorePrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{4}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{6}
 }
 func (m *GlobalSpStorePrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +618,72 @@ func (m *GlobalSpStorePrice) GetUpdateTimeSec() int64 {
 	return 0
 }
 
+func (m *GlobalSpStorePrice) GetStorePriceTiers() []GlobalStorePriceTier {
+	if m != nil {
+		return m.StorePriceTiers
+	}
+	return nil
+}
+
+// GlobalStorePriceTier is the global store price for the buckets whose total charge size is not less than min_charge_size.
+type GlobalStorePriceTier struct {
+	// min total charge size of a bucket for the tier, in byte
+	MinChargeSize uint64 `protobuf:"varint,1,opt,name=min_charge_size,json=minChargeSize,proto3" json:"min_charge_size,omitempty"`
+	// primary store price, in bnb wei per charge byte
+	PrimaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=primary_store_price,json=primaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"primary_store_price"`
+	// secondary store price, in bnb wei per charge byte
+	SecondaryStorePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=secondary_store_price,json=secondaryStorePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"secondary_store_price"`
+	// redundancy type of the objects the tier applies to, the value of greenfield.storage.RedundancyType
+	RedundancyType int32 `protobuf:"varint,4,opt,name=redundancy_type,json=redundancyType,proto3" json:"redundancy_type,omitempty"`
+}
+
+func (m *GlobalStorePriceTier) Reset()         { *m = GlobalStorePriceTier{} }
+func (m *GlobalStorePriceTier) String() string { return proto.CompactTextString(m) }
+func (*GlobalStorePriceTier) ProtoMessage()    {}
+func (*GlobalStorePriceTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{7}
+}
+func (m *GlobalStorePriceTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalStorePriceTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalStorePriceTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalStorePriceTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalStorePriceTier.Merge(m, src)
+}
+func (m *GlobalStorePriceTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalStorePriceTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalStorePriceTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalStorePriceTier proto.InternalMessageInfo
+
+func (m *GlobalStorePriceTier) GetMinChargeSize() uint64 {
+	if m != nil {
+		return m.MinChargeSize
+	}
+	return 0
+}
+
+func (m *GlobalStorePriceTier) GetRedundancyType() int32 {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return 0
+}
+
 type SpMaintenanceStats struct {
 	Records []*MaintenanceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
@@ -458,7 +692,7 @@ func (m *SpMaintenanceStats) Reset()         { *m = SpMaintenanceStats{} }
 func (m *SpMaintenanceStats) String() string { return proto.CompactTextString(m) }
 func (*SpMaintenanceStats) ProtoMessage()    {}
 func (*SpMaintenanceStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{5}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{8}
 }
 func (m *SpMaintenanceStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +744,7 @@ func (m *MaintenanceRecord) Reset()         { *m = MaintenanceRecord{} }
 func (m *MaintenanceRecord) String() string { return proto.CompactTextString(m) }
 func (*MaintenanceRecord) ProtoMessage()    {}
 func (*MaintenanceRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a9af9b5be8c2eeb, []int{6}
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{9}
 }
 func (m *MaintenanceRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,164 +801,450 @@ func (m *MaintenanceRecord) GetRequestAt() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("greenfield.sp.Status", Status_name, Status_value)
-	proto.RegisterType((*Description)(nil), "greenfield.sp.Description")
-	proto.RegisterType((*StorageProvider)(nil), "greenfield.sp.StorageProvider")
-	proto.RegisterType((*RewardInfo)(nil), "greenfield.sp.RewardInfo")
-	proto.RegisterType((*SpStoragePrice)(nil), "greenfield.sp.SpStoragePrice")
-	proto.RegisterType((*GlobalSpStorePrice)(nil), "greenfield.sp.GlobalSpStorePrice")
-	proto.RegisterType((*SpMaintenanceStats)(nil), "greenfield.sp.SpMaintenanceStats")
-	proto.RegisterType((*MaintenanceRecord)(nil), "greenfield.sp.MaintenanceRecord")
+// SpScorecard keeps track of the signals of the service quality of a storage provider
+type SpScorecard struct {
+	// id of the storage provider
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the number of heartbeat challenges the sp passed
+	ChallengePassCount uint64 `protobuf:"varint,2,opt,name=challenge_pass_count,json=challengePassCount,proto3" json:"challenge_pass_count,omitempty"`
+	// the number of challenges the sp failed and was slashed for
+	SlashCount uint64 `protobuf:"varint,3,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// the total seconds the sp stayed in maintenance mode
+	MaintenanceDuration int64 `protobuf:"varint,4,opt,name=maintenance_duration,json=maintenanceDuration,proto3" json:"maintenance_duration,omitempty"`
+	// the number of objects the sp rejected to seal
+	RejectSealCount uint64 `protobuf:"varint,5,opt,name=reject_seal_count,json=rejectSealCount,proto3" json:"reject_seal_count,omitempty"`
+	// the number of bucket migrations the sp rejected as the dest sp
+	RejectMigrateCount uint64 `protobuf:"varint,6,opt,name=reject_migrate_count,json=rejectMigrateCount,proto3" json:"reject_migrate_count,omitempty"`
+	// the score calculated from the signals above, ranging from 0 to 10000
+	Score uint32 `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func init() { proto.RegisterFile("greenfield/sp/types.proto", fileDescriptor_7a9af9b5be8c2eeb) }
-
-var fileDescriptor_7a9af9b5be8c2eeb = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xc1, 0x6e, 0x23, 0x45,
-	0x10, 0xf5, 0xd8, 0x8e, 0xb3, 0x29, 0x27, 0xb1, 0xd3, 0x49, 0xd8, 0x49, 0x10, 0xde, 0xc8, 0x87,
-	0x10, 0x56, 0x8a, 0xad, 0x0d, 0x87, 0x95, 0x80, 0x8b, 0x63, 0x9b, 0xc8, 0xb0, 0x1b, 0x2d, 0xe3,
-	0x2c, 0x42, 0x20, 0x34, 0x6a, 0x4f, 0x57, 0x9c, 0x56, 0xec, 0xee, 0xd9, 0xee, 0x76, 0x16, 0xff,
-	0x01, 0x47, 0xbe, 0x80, 0x03, 0x7c, 0x01, 0xd2, 0x9e, 0xf8, 0x82, 0x3d, 0xae, 0xf6, 0x84, 0x38,
-	0xac, 0x50, 0xf2, 0x15, 0xdc, 0xd0, 0xcc, 0xf4, 0x8c, 0x4d, 0x38, 0x58, 0x48, 0x39, 0xd9, 0xf5,
-	0xaa, 0xde, 0xeb, 0x9a, 0xea, 0xaa, 0x9a, 0x81, 0x9d, 0xa1, 0x42, 0x14, 0xe7, 0x1c, 0x47, 0xac,
-	0xa9, 0xc3, 0xa6, 0x99, 0x86, 0xa8, 0x1b, 0xa1, 0x92, 0x46, 0x92, 0xb5, 0x99, 0xab, 0xa1, 0xc3,
-	0xdd, 0x5a, 0x20, 0xf5, 0x58, 0xea, 0xe6, 0x80, 0x6a, 0x6c, 0x5e, 0x3d, 0x1a, 0xa0, 0xa1, 0x8f,
-	0x9a, 0x81, 0xe4, 0x22, 0x09, 0xdf, 0xdd, 0x49, 0xfc, 0x7e, 0x6c, 0x35, 0x13, 0xc3, 0xba, 0xb6,
-	0x86, 0x72, 0x28, 0x13, 0x3c, 0xfa, 0x97, 0xa0, 0xf5, 0x5f, 0x1c, 0x28, 0x77, 0x50, 0x07, 0x8a,
-	0x87, 0x86, 0x4b, 0x41, 0x5c, 0x58, 0x1e, 0x4b, 0xc1, 0x2f, 0x51, 0xb9, 0xce, 0x9e, 0x73, 0xb0,
-	0xe2, 0xa5, 0x26, 0xd9, 0x85, 0x7b, 0x9c, 0xa1, 0x30, 0xdc, 0x4c, 0xdd, 0x7c, 0xec, 0xca, 0xec,
-	0x88, 0xf5, 0x12, 0x07, 0x9a, 0x1b, 0x74, 0x0b, 0x09, 0xcb, 0x9a, 0xe4, 0x23, 0xa8, 0x6a, 0x0c,
-	0x26, 0x8a, 0x9b, 0xa9, 0x1f, 0x48, 0x61, 0x68, 0x60, 0xdc, 0x62, 0x1c, 0x52, 0x49, 0xf1, 0x76,
-	0x02, 0x47, 0x22, 0x0c, 0x0d, 0xe5, 0x23, 0xed, 0x2e, 0x25, 0x22, 0xd6, 0xac, 0xff, 0xbe, 0x04,
-	0x95, 0xbe, 0x91, 0x8a, 0x0e, 0xf1, 0x99, 0x92, 0x57, 0x9c, 0xa1, 0x22, 0xeb, 0x90, 0xe7, 0x2c,
-	0xce, 0x71, 0xcd, 0xcb, 0x73, 0x46, 0xda, 0x50, 0x95, 0x21, 0x2a, 0x6a, 0xa4, 0xf2, 0x29, 0x63,
-	0x0a, 0xb5, 0x4e, 0xd2, 0x3c, 0x76, 0xdf, 0xbe, 0x3a, 0xdc, 0xb2, 0xa5, 0x68, 0x25, 0x9e, 0xbe,
-	0x51, 0x5c, 0x0c, 0xbd, 0x4a, 0xca, 0xb0, 0x30, 0x69, 0x41, 0xe5, 0x7c, 0x22, 0x18, 0x17, 0xc3,
-	0x4c, 0xa3, 0xb0, 0x40, 0x63, 0xdd, 0x12, 0x52, 0x89, 0x4f, 0x61, 0x55, 0x23, 0x1d, 0x65, 0xfc,
-	0xe2, 0x02, 0x7e, 0x39, 0x8a, 0x4e, 0xc9, 0x6d, 0xa8, 0xd2, 0x30, 0x54, 0xf2, 0x6a, 0x4e, 0x60,
-	0x69, 0xd1, 0x43, 0xa4, 0x8c, 0x54, 0xe4, 0x31, 0xc0, 0x30, 0xc8, 0xe8, 0xa5, 0x05, 0xf4, 0x95,
-	0x61, 0x90, 0x12, 0x7b, 0xb0, 0x39, 0xa6, 0x5c, 0x18, 0x14, 0x54, 0x04, 0x98, 0x29, 0x2c, 0x2f,
-	0x50, 0x20, 0x73, 0xa4, 0x54, 0x8a, 0xc2, 0x9a, 0x91, 0x86, 0x8e, 0x7c, 0x86, 0xa1, 0xd4, 0xdc,
-	0xb8, 0xf7, 0x62, 0x91, 0xcf, 0x5e, 0xbf, 0x7b, 0x90, 0xfb, 0xf3, 0xdd, 0x83, 0xfd, 0x21, 0x37,
-	0x17, 0x93, 0x41, 0x23, 0x90, 0x63, 0xdb, 0xa4, 0xf6, 0xe7, 0x50, 0xb3, 0x4b, 0xdb, 0xff, 0x3d,
-	0x61, 0xde, 0xbe, 0x3a, 0x04, 0x7b, 0x64, 0x4f, 0x18, 0x6f, 0x35, 0x96, 0xec, 0x24, 0x8a, 0xe4,
-	0x10, 0x4a, 0xda, 0x50, 0x33, 0xd1, 0xee, 0xca, 0x9e, 0x73, 0xb0, 0x7e, 0xb4, 0xdd, 0xf8, 0xd7,
-	0xa8, 0x34, 0xfa, 0xb1, 0xd3, 0xb3, 0x41, 0x51, 0xfb, 0xa2, 0x60, 0xa1, 0xe4, 0xc2, 0xb8, 0x90,
-	0xb4, 0x6f, 0x6a, 0x93, 0x63, 0x28, 0xb3, 0xd9, 0x0c, 0xb8, 0xe5, 0x3d, 0xe7, 0xa0, 0x7c, 0xb4,
-	0x7b, 0x4b, 0x6f, 0x6e, 0x4a, 0x8e, 0x8b, 0xd1, 0x73, 0x78, 0xf3, 0x24, 0x72, 0x1f, 0x96, 0x07,
-	0x23, 0xed, 0x5f, 0xe2, 0xd4, 0x5d, 0xdd, 0x73, 0x0e, 0x56, 0xbd, 0xd2, 0x60, 0xa4, 0xbf, 0xc4,
-	0x69, 0x7d, 0x0a, 0xe0, 0xe1, 0x4b, 0xaa, 0x58, 0x4f, 0x9c, 0x4b, 0x72, 0x04, 0xcb, 0x69, 0x5d,
-	0x9d, 0x05, 0x75, 0x4d, 0x03, 0xc9, 0x63, 0x28, 0xd1, 0xb1, 0x9c, 0x08, 0x13, 0x37, 0x74, 0xf9,
-	0x68, 0xa7, 0x61, 0xe3, 0xa3, 0x2d, 0xd0, 0xb0, 0x5b, 0xa0, 0xd1, 0x96, 0x3c, 0x4d, 0xcc, 0x86,
-	0xd7, 0x7f, 0xcb, 0xc3, 0x7a, 0x3f, 0xcc, 0x26, 0x87, 0x07, 0x48, 0x36, 0x61, 0x49, 0x87, 0x7e,
-	0x36, 0x39, 0x45, 0x1d, 0xf6, 0x18, 0xd9, 0x87, 0xca, 0x24, 0x64, 0xd4, 0xa0, 0x6f, 0xf8, 0x18,
-	0x7d, 0x8d, 0x41, 0x7c, 0x52, 0xc1, 0x5b, 0x4b, 0xe0, 0x33, 0x3e, 0xc6, 0x3e, 0x06, 0xe4, 0x3b,
-	0x00, 0x85, 0x94, 0xf9, 0x61, 0x24, 0x65, 0x27, 0xe3, 0xff, 0x5c, 0x69, 0x07, 0x83, 0xb9, 0x2b,
-	0xed, 0x60, 0xe0, 0xad, 0x44, 0x7a, 0x49, 0x66, 0xfb, 0x50, 0x39, 0x57, 0x88, 0x7e, 0x7c, 0xc2,
-	0x8b, 0x89, 0x34, 0x34, 0x9e, 0x9d, 0xa2, 0xb7, 0x16, 0xc1, 0x1e, 0x52, 0xf6, 0x55, 0x04, 0x92,
-	0xef, 0xa1, 0xac, 0x8d, 0x54, 0x68, 0xb3, 0x58, 0xba, 0x83, 0x2c, 0x20, 0x16, 0x8c, 0xd3, 0xa8,
-	0xff, 0x9d, 0x07, 0x72, 0x32, 0x92, 0x03, 0x3a, 0x4a, 0x2a, 0x87, 0x59, 0x76, 0xb7, 0x4b, 0xe4,
-	0x2c, 0x2e, 0x51, 0xfe, 0x6e, 0x4b, 0x34, 0x82, 0xcd, 0x50, 0xf1, 0x31, 0x55, 0x53, 0x7f, 0xbe,
-	0x04, 0x77, 0x71, 0x11, 0x1b, 0x56, 0x78, 0xee, 0x91, 0x43, 0xd8, 0xd6, 0x18, 0x48, 0xc1, 0x6e,
-	0x9f, 0x57, 0xbc, 0x83, 0xf3, 0x36, 0x33, 0xe9, 0xd9, 0x89, 0xf5, 0x67, 0x40, 0xfa, 0xe1, 0xd3,
-	0xd9, 0x36, 0x89, 0x46, 0x58, 0x93, 0x4f, 0x60, 0x59, 0x61, 0x20, 0x15, 0x8b, 0x46, 0xa6, 0x70,
-	0x50, 0x3e, 0xda, 0xbb, 0x35, 0x99, 0x73, 0x0c, 0x2f, 0x0e, 0xf4, 0x52, 0x42, 0xfd, 0x67, 0x07,
-	0x36, 0xfe, 0xe3, 0x26, 0xef, 0x41, 0xe9, 0x02, 0xf9, 0xf0, 0xc2, 0xd8, 0x3b, 0xb4, 0x56, 0xf4,
-	0xb2, 0x52, 0xf8, 0x62, 0x82, 0xda, 0xf8, 0x6c, 0xa2, 0x68, 0xbc, 0x0c, 0x92, 0x41, 0xa8, 0x58,
-	0xbc, 0x63, 0x61, 0xf2, 0x21, 0x54, 0x68, 0x60, 0x26, 0xd1, 0x86, 0x4b, 0x23, 0x0b, 0x71, 0xe4,
-	0x7a, 0x02, 0x67, 0x81, 0x1f, 0x44, 0x0d, 0x91, 0x68, 0xd2, 0xe4, 0xd5, 0x57, 0x88, 0xae, 0x34,
-	0x46, 0x5a, 0xe6, 0xa1, 0x86, 0x52, 0xb2, 0xa8, 0xc8, 0x36, 0x6c, 0xf4, 0xcf, 0x5a, 0x67, 0xcf,
-	0xfb, 0x7e, 0xef, 0xd4, 0xef, 0x77, 0xbd, 0xaf, 0x7b, 0xed, 0x6e, 0x35, 0x47, 0xb6, 0xa0, 0x3a,
-	0x83, 0xbf, 0x68, 0xf5, 0x9e, 0x74, 0x3b, 0x55, 0x87, 0xbc, 0x0f, 0xf7, 0x2d, 0x7a, 0xe2, 0xb5,
-	0xda, 0xdd, 0xcf, 0x9f, 0x3f, 0xf1, 0xbb, 0xdf, 0xf4, 0xce, 0x7a, 0xa7, 0x27, 0xd5, 0x3c, 0xd9,
-	0x81, 0xed, 0x19, 0xe5, 0x69, 0xab, 0x77, 0x7a, 0xd6, 0x3d, 0x6d, 0x9d, 0xb6, 0xbb, 0xd5, 0xc2,
-	0x6e, 0xf1, 0xc7, 0x5f, 0x6b, 0xb9, 0xe3, 0xce, 0xeb, 0xeb, 0x9a, 0xf3, 0xe6, 0xba, 0xe6, 0xfc,
-	0x75, 0x5d, 0x73, 0x7e, 0xba, 0xa9, 0xe5, 0xde, 0xdc, 0xd4, 0x72, 0x7f, 0xdc, 0xd4, 0x72, 0xdf,
-	0x3e, 0x9c, 0xbb, 0xcc, 0x81, 0x18, 0x1c, 0x06, 0x17, 0x94, 0x8b, 0xe6, 0xdc, 0xe7, 0xc9, 0x0f,
-	0xd9, 0x07, 0xca, 0xa0, 0x14, 0x7f, 0x41, 0x7c, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4,
-	0xb8, 0x2c, 0x5d, 0xbe, 0x08, 0x00, 0x00,
+func (m *SpScorecard) Reset()         { *m = SpScorecard{} }
+func (m *SpScorecard) String() string { return proto.CompactTextString(m) }
+func (*SpScorecard) ProtoMessage()    {}
+func (*SpScorecard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{10}
 }
-
-func (m *Description) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpScorecard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpScorecard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpScorecard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SpScorecard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpScorecard.Merge(m, src)
+}
+func (m *SpScorecard) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpScorecard) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpScorecard.DiscardUnknown(m)
 }
 
-func (m *Description) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SpScorecard proto.InternalMessageInfo
+
+func (m *SpScorecard) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
 }
 
-func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Details) > 0 {
-		i -= len(m.Details)
-		copy(dAtA[i:], m.Details)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Details)))
-		i--
-		dAtA[i] = 0x2a
+func (m *SpScorecard) GetChallengePassCount() uint64 {
+	if m != nil {
+		return m.ChallengePassCount
 	}
-	if len(m.SecurityContact) > 0 {
-		i -= len(m.SecurityContact)
-		copy(dAtA[i:], m.SecurityContact)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SecurityContact)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *SpScorecard) GetSlashCount() uint64 {
+	if m != nil {
+		return m.SlashCount
 	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *SpScorecard) GetMaintenanceDuration() int64 {
+	if m != nil {
+		return m.MaintenanceDuration
 	}
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *SpScorecard) GetRejectSealCount() uint64 {
+	if m != nil {
+		return m.RejectSealCount
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *SpScorecard) GetRejectMigrateCount() uint64 {
+	if m != nil {
+		return m.RejectMigrateCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *StorageProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpScorecard) GetScore() uint32 {
+	if m != nil {
+		return m.Score
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StorageProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpJailRecord is to keep track of the jailing of a sp
+type SpJailRecord struct {
+	// id of the storage provider
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// the timestamp when the sp is jailed
+	JailedAt int64 `protobuf:"varint,2,opt,name=jailed_at,json=jailedAt,proto3" json:"jailed_at,omitempty"`
+	// the timestamp after which the sp can unjail itself
+	ReleaseTime int64 `protobuf:"varint,3,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+	// the reason why the sp is jailed
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *StorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+func (m *SpJailRecord) Reset()         { *m = SpJailRecord{} }
+func (m *SpJailRecord) String() string { return proto.CompactTextString(m) }
+func (*SpJailRecord) ProtoMessage()    {}
+func (*SpJailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{11}
+}
+func (m *SpJailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpJailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpJailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpJailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpJailRecord.Merge(m, src)
+}
+func (m *SpJailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpJailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpJailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpJailRecord proto.InternalMessageInfo
+
+func (m *SpJailRecord) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpJailRecord) GetJailedAt() int64 {
+	if m != nil {
+		return m.JailedAt
+	}
+	return 0
+}
+
+func (m *SpJailRecord) GetReleaseTime() int64 {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return 0
+}
+
+func (m *SpJailRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// DepositUnbonding is the deposit withdrawn by a storage provider, which is sent back to the funding address at the unlock time
+type DepositUnbonding struct {
+	// sp id
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// funding_address is the account the deposit is sent back to
+	FundingAddress string `protobuf:"bytes,2,opt,name=funding_address,json=fundingAddress,proto3" json:"funding_address,omitempty"`
+	// amount is the amount of the withdrawn deposit
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unlock_time is the time the deposit is unlocked, unix timestamp in seconds
+	UnlockTime int64 `protobuf:"varint,4,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+}
+
+func (m *DepositUnbonding) Reset()         { *m = DepositUnbonding{} }
+func (m *DepositUnbonding) String() string { return proto.CompactTextString(m) }
+func (*DepositUnbonding) ProtoMessage()    {}
+func (*DepositUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9af9b5be8c2eeb, []int{12}
+}
+func (m *DepositUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositUnbonding.Merge(m, src)
+}
+func (m *DepositUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositUnbonding proto.InternalMessageInfo
+
+func (m *DepositUnbonding) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *DepositUnbonding) GetFundingAddress() string {
+	if m != nil {
+		return m.FundingAddress
+	}
+	return ""
+}
+
+func (m *DepositUnbonding) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("greenfield.sp.Status", Status_name, Status_value)
+	proto.RegisterType((*Description)(nil), "greenfield.sp.Description")
+	proto.RegisterType((*StorageProvider)(nil), "greenfield.sp.StorageProvider")
+	proto.RegisterType((*RewardInfo)(nil), "greenfield.sp.RewardInfo")
+	proto.RegisterType((*SpStoragePrice)(nil), "greenfield.sp.SpStoragePrice")
+	proto.RegisterType((*ScheduledSpStoragePrice)(nil), "greenfield.sp.ScheduledSpStoragePrice")
+	proto.RegisterType((*StorePriceTier)(nil), "greenfield.sp.StorePriceTier")
+	proto.RegisterType((*GlobalSpStorePrice)(nil), "greenfield.sp.GlobalSpStorePrice")
+	proto.RegisterType((*GlobalStorePriceTier)(nil), "greenfield.sp.GlobalStorePriceTier")
+	proto.RegisterType((*SpMaintenanceStats)(nil), "greenfield.sp.SpMaintenanceStats")
+	proto.RegisterType((*MaintenanceRecord)(nil), "greenfield.sp.MaintenanceRecord")
+	proto.RegisterType((*SpScorecard)(nil), "greenfield.sp.SpScorecard")
+	proto.RegisterType((*SpJailRecord)(nil), "greenfield.sp.SpJailRecord")
+	proto.RegisterType((*DepositUnbonding)(nil), "greenfield.sp.DepositUnbonding")
+}
+
+func init() { proto.RegisterFile("greenfield/sp/types.proto", fileDescriptor_7a9af9b5be8c2eeb) }
+
+var fileDescriptor_7a9af9b5be8c2eeb = []byte{
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x8f, 0xff, 0x66, 0xf2, 0x1c, 0xff, 0x49, 0x8f, 0xb3, 0xe3, 0x64, 0xb5, 0x4e, 0x30, 0x62,
+	0x08, 0x23, 0xc5, 0xde, 0x09, 0x88, 0x95, 0x80, 0x8b, 0xe3, 0x84, 0x91, 0x97, 0x9d, 0x30, 0x74,
+	0x3b, 0x2b, 0x04, 0x42, 0xad, 0x72, 0xf5, 0x8b, 0x5d, 0x9b, 0x76, 0x57, 0x6f, 0x55, 0x39, 0x83,
+	0x97, 0x1b, 0x27, 0x8e, 0x7c, 0x00, 0xc4, 0x01, 0xce, 0x5c, 0xd0, 0x7e, 0x07, 0xf6, 0x38, 0xda,
+	0x13, 0xe2, 0xb0, 0x82, 0x99, 0x0f, 0x02, 0xea, 0xaa, 0xea, 0x8e, 0x27, 0x1b, 0x94, 0x2c, 0xca,
+	0xc0, 0x85, 0x53, 0x52, 0xbf, 0xf7, 0x7e, 0xbf, 0xae, 0x7a, 0xaf, 0x5e, 0xd5, 0x2b, 0xc3, 0xd6,
+	0x44, 0x20, 0x46, 0x67, 0x0c, 0xc3, 0xa0, 0x27, 0xe3, 0x9e, 0x5a, 0xc4, 0x28, 0xbb, 0xb1, 0xe0,
+	0x8a, 0x3b, 0xd5, 0x4b, 0x53, 0x57, 0xc6, 0xdb, 0x6d, 0xca, 0xe5, 0x8c, 0xcb, 0xde, 0x98, 0x48,
+	0xec, 0x5d, 0x3c, 0x1e, 0xa3, 0x22, 0x8f, 0x7b, 0x94, 0xb3, 0xc8, 0xb8, 0x6f, 0x6f, 0x19, 0xbb,
+	0xaf, 0x47, 0x3d, 0x33, 0xb0, 0xa6, 0xe6, 0x84, 0x4f, 0xb8, 0xc1, 0x93, 0xff, 0x2c, 0xba, 0xb3,
+	0xf4, 0x69, 0xca, 0x67, 0x33, 0x1e, 0xf5, 0x9e, 0x0b, 0x12, 0xc7, 0x28, 0x8c, 0x43, 0xe7, 0x0f,
+	0x39, 0xa8, 0x1c, 0xa1, 0xa4, 0x82, 0xc5, 0x8a, 0xf1, 0xc8, 0x69, 0xc1, 0xea, 0x8c, 0x47, 0xec,
+	0x1c, 0x45, 0x2b, 0xb7, 0x9b, 0xdb, 0x5b, 0x73, 0xd3, 0xa1, 0xb3, 0x0d, 0xf7, 0x58, 0x80, 0x91,
+	0x62, 0x6a, 0xd1, 0xca, 0x6b, 0x53, 0x36, 0x4e, 0x58, 0xcf, 0x71, 0x2c, 0x99, 0xc2, 0x56, 0xc1,
+	0xb0, 0xec, 0xd0, 0xf9, 0x16, 0x34, 0x24, 0xd2, 0xb9, 0x60, 0x6a, 0xe1, 0x53, 0x1e, 0x29, 0x42,
+	0x55, 0xab, 0xa8, 0x5d, 0xea, 0x29, 0x3e, 0x30, 0x70, 0x22, 0x12, 0xa0, 0x22, 0x2c, 0x94, 0xad,
+	0x92, 0x11, 0xb1, 0xc3, 0xce, 0x3f, 0x4b, 0x50, 0xf7, 0x14, 0x17, 0x64, 0x82, 0xcf, 0x04, 0xbf,
+	0x60, 0x01, 0x0a, 0xa7, 0x06, 0x79, 0x16, 0xe8, 0x39, 0x56, 0xdd, 0x3c, 0x0b, 0x9c, 0x01, 0x34,
+	0x78, 0x8c, 0x82, 0x28, 0x2e, 0x7c, 0x12, 0x04, 0x02, 0xa5, 0x34, 0xd3, 0x3c, 0x6c, 0x7d, 0xfe,
+	0xe9, 0x7e, 0xd3, 0xc6, 0xaa, 0x6f, 0x2c, 0x9e, 0x12, 0x2c, 0x9a, 0xb8, 0xf5, 0x94, 0x61, 0x61,
+	0xa7, 0x0f, 0xf5, 0xb3, 0x79, 0x14, 0xb0, 0x68, 0x92, 0x69, 0x14, 0x6e, 0xd0, 0xa8, 0x59, 0x42,
+	0x2a, 0xf1, 0x7d, 0x58, 0x97, 0x48, 0xc2, 0x8c, 0x5f, 0xbc, 0x81, 0x5f, 0x49, 0xbc, 0x53, 0xf2,
+	0x00, 0x1a, 0x24, 0x8e, 0x05, 0xbf, 0x58, 0x12, 0x28, 0xdd, 0xb4, 0x88, 0x94, 0x91, 0x8a, 0xbc,
+	0x07, 0x30, 0xa1, 0x19, 0xbd, 0x7c, 0x03, 0x7d, 0x6d, 0x42, 0x53, 0xe2, 0x10, 0xee, 0xcf, 0x08,
+	0x8b, 0x14, 0x46, 0x24, 0xa2, 0x98, 0x29, 0xac, 0xde, 0xa0, 0xe0, 0x2c, 0x91, 0x52, 0x29, 0x02,
+	0x55, 0xc5, 0x15, 0x09, 0xfd, 0x00, 0x63, 0x2e, 0x99, 0x6a, 0xdd, 0xd3, 0x22, 0x3f, 0xf8, 0xec,
+	0x8b, 0x9d, 0x95, 0xbf, 0x7d, 0xb1, 0xf3, 0x70, 0xc2, 0xd4, 0x74, 0x3e, 0xee, 0x52, 0x3e, 0xb3,
+	0xbb, 0xd8, 0xfe, 0xd9, 0x97, 0xc1, 0xb9, 0x2d, 0x90, 0x61, 0xa4, 0x3e, 0xff, 0x74, 0x1f, 0xec,
+	0x27, 0x87, 0x91, 0x72, 0xd7, 0xb5, 0xe4, 0x91, 0x51, 0x74, 0xf6, 0xa1, 0x2c, 0x15, 0x51, 0x73,
+	0xd9, 0x5a, 0xdb, 0xcd, 0xed, 0xd5, 0x0e, 0x36, 0xbb, 0xaf, 0xd5, 0x52, 0xd7, 0xd3, 0x46, 0xd7,
+	0x3a, 0x25, 0xdb, 0x17, 0xa3, 0x20, 0xe6, 0x2c, 0x52, 0x2d, 0x30, 0xdb, 0x37, 0x1d, 0x3b, 0x87,
+	0x50, 0x09, 0x2e, 0x6b, 0xa0, 0x55, 0xd9, 0xcd, 0xed, 0x55, 0x0e, 0xb6, 0xaf, 0xe8, 0x2d, 0x55,
+	0xc9, 0x61, 0x31, 0x59, 0x87, 0xbb, 0x4c, 0x72, 0x1e, 0xc0, 0xea, 0x38, 0x94, 0xfe, 0x39, 0x2e,
+	0x5a, 0xeb, 0xbb, 0xb9, 0xbd, 0x75, 0xb7, 0x3c, 0x0e, 0xe5, 0x8f, 0x70, 0xe1, 0x0c, 0xa0, 0x7a,
+	0x26, 0x10, 0x7d, 0x4a, 0x62, 0x42, 0x93, 0xe2, 0xa9, 0x6a, 0xf9, 0xf6, 0xb2, 0xbc, 0x29, 0xcd,
+	0xee, 0xe9, 0x30, 0x52, 0xdf, 0xfd, 0xce, 0x87, 0x24, 0x9c, 0xa3, 0xbb, 0x9e, 0x90, 0x06, 0x96,
+	0xd3, 0x59, 0x00, 0xb8, 0xf8, 0x9c, 0x88, 0x60, 0x18, 0x9d, 0x71, 0xe7, 0x00, 0x56, 0xd3, 0xe4,
+	0xe4, 0x6e, 0x48, 0x4e, 0xea, 0xe8, 0xbc, 0x07, 0x65, 0x32, 0xe3, 0xf3, 0x48, 0xe9, 0xaa, 0xa8,
+	0x1c, 0x6c, 0x75, 0xad, 0x7f, 0x72, 0xd6, 0x74, 0xed, 0x59, 0xd3, 0x1d, 0x70, 0x96, 0xae, 0xce,
+	0xba, 0x77, 0x7e, 0x5d, 0x80, 0x9a, 0x17, 0x67, 0xe5, 0xc7, 0x28, 0x3a, 0xf7, 0xa1, 0x24, 0x63,
+	0x3f, 0x2b, 0xbf, 0xa2, 0x8c, 0x87, 0x81, 0xf3, 0x10, 0xea, 0xf3, 0x38, 0x20, 0x0a, 0x7d, 0xc5,
+	0x66, 0xe8, 0x4b, 0xa4, 0xfa, 0x4b, 0x05, 0xb7, 0x6a, 0xe0, 0x11, 0x9b, 0xa1, 0x87, 0xd4, 0xf9,
+	0x39, 0x80, 0x40, 0x12, 0xf8, 0x71, 0x22, 0xd5, 0x2a, 0x7c, 0xe5, 0x7d, 0x71, 0x84, 0x74, 0x69,
+	0x5f, 0x1c, 0x21, 0x75, 0xd7, 0x12, 0x3d, 0x33, 0xb3, 0x87, 0x50, 0xd7, 0xc1, 0xd6, 0x5f, 0xf8,
+	0x78, 0xce, 0x15, 0xd1, 0x05, 0x58, 0x74, 0x75, 0x0e, 0x5c, 0x24, 0xc1, 0x4f, 0x12, 0xd0, 0xf9,
+	0x05, 0x54, 0xa4, 0xe2, 0x02, 0xed, 0x2c, 0x4a, 0x77, 0x30, 0x0b, 0xd0, 0x82, 0x66, 0x1a, 0x3f,
+	0x86, 0x8d, 0x25, 0x79, 0x5f, 0x31, 0x14, 0x49, 0x25, 0x16, 0xf6, 0x2a, 0x07, 0xef, 0x7c, 0x69,
+	0x9b, 0xa6, 0xac, 0x11, 0x43, 0x61, 0x63, 0x5f, 0x97, 0xaf, 0xa1, 0xb2, 0xf3, 0xa7, 0x02, 0x3c,
+	0xf0, 0xe8, 0x14, 0x83, 0x79, 0x88, 0xc1, 0x6d, 0xb2, 0xf1, 0x0d, 0xa8, 0xe1, 0xd9, 0x19, 0x52,
+	0xc5, 0x2e, 0x4c, 0x42, 0xd2, 0x64, 0x64, 0x68, 0x92, 0x8f, 0xff, 0x27, 0xe3, 0x3f, 0x48, 0x86,
+	0xf3, 0x35, 0x58, 0x97, 0x69, 0x2e, 0x7c, 0xa2, 0xf4, 0x01, 0x59, 0x70, 0x2b, 0x19, 0xd6, 0x57,
+	0x9d, 0x3f, 0xe7, 0xa1, 0xf6, 0xba, 0x58, 0x12, 0x8d, 0x19, 0x8b, 0x7c, 0x3a, 0x25, 0x62, 0x82,
+	0xbe, 0x64, 0x9f, 0xa0, 0x4e, 0x58, 0xd1, 0xad, 0xce, 0x58, 0x34, 0xd0, 0xa8, 0xc7, 0x3e, 0xc1,
+	0xab, 0xd1, 0xc8, 0xdf, 0x71, 0x34, 0x62, 0xd8, 0x94, 0x48, 0x79, 0x14, 0x10, 0xb1, 0xf0, 0x97,
+	0x3f, 0x74, 0x17, 0xc9, 0xbf, 0x9f, 0x49, 0x5f, 0x2e, 0xde, 0xf9, 0x26, 0xd4, 0x05, 0x06, 0xf3,
+	0x28, 0x20, 0x11, 0x5d, 0xf8, 0x09, 0x55, 0x6f, 0x83, 0x92, 0x5b, 0xbb, 0x84, 0x47, 0x8b, 0x18,
+	0x3b, 0x2f, 0x0a, 0xe0, 0x3c, 0x09, 0xf9, 0x98, 0x84, 0x5e, 0xbc, 0xc4, 0xbf, 0xe6, 0x60, 0xc9,
+	0xdd, 0x7c, 0xb0, 0xe4, 0xef, 0x76, 0x2f, 0x87, 0x70, 0x3f, 0x16, 0x6c, 0xf6, 0x26, 0x82, 0xb6,
+	0x61, 0x85, 0xbd, 0x5b, 0x24, 0xa9, 0xf8, 0xa6, 0x92, 0x74, 0x7a, 0x5d, 0x91, 0x94, 0x74, 0x91,
+	0x7c, 0xfd, 0x4a, 0x91, 0xd8, 0x14, 0xdd, 0xea, 0xdc, 0xfa, 0x4b, 0x1e, 0x9a, 0xd7, 0xf9, 0xdf,
+	0xba, 0x1a, 0xfe, 0x4d, 0xdc, 0xf3, 0xff, 0xe5, 0xb8, 0xff, 0xef, 0x8b, 0xe3, 0x19, 0x38, 0x5e,
+	0xfc, 0xf4, 0xb2, 0xd3, 0x4a, 0xda, 0x1b, 0xe9, 0x7c, 0x0f, 0x56, 0x05, 0x52, 0x2e, 0x82, 0xa4,
+	0x13, 0x48, 0x92, 0xb5, 0x7b, 0x25, 0x59, 0x4b, 0x0c, 0x57, 0x3b, 0xba, 0x29, 0xa1, 0xf3, 0xfb,
+	0x1c, 0x6c, 0x7c, 0xc9, 0xec, 0xbc, 0x05, 0xe5, 0x29, 0xb2, 0xc9, 0x54, 0xd9, 0x22, 0xb3, 0xa3,
+	0xa4, 0x91, 0x17, 0xf8, 0xf1, 0x1c, 0xa5, 0xf2, 0x83, 0xb9, 0x20, 0xba, 0x51, 0x32, 0x57, 0x4a,
+	0xdd, 0xe2, 0x47, 0x16, 0x4e, 0xd6, 0x44, 0xa8, 0x9a, 0x27, 0xdd, 0x5f, 0xea, 0x59, 0xd0, 0x9e,
+	0x35, 0x03, 0x67, 0x8e, 0xef, 0x00, 0x58, 0x6e, 0x72, 0x8c, 0x16, 0xb5, 0xcf, 0x9a, 0x45, 0xfa,
+	0xaa, 0xf3, 0xbb, 0x3c, 0x54, 0xbc, 0xd8, 0xa3, 0x5c, 0x20, 0x25, 0x22, 0xb8, 0xfe, 0xa2, 0x7b,
+	0x17, 0x9a, 0x74, 0x4a, 0xc2, 0x10, 0xa3, 0x09, 0xfa, 0x31, 0x91, 0xd2, 0xa7, 0x59, 0x97, 0x53,
+	0x74, 0x9d, 0xcc, 0xf6, 0x8c, 0x48, 0x39, 0x48, 0x2c, 0xce, 0x0e, 0x54, 0x64, 0x48, 0xe4, 0xd4,
+	0x3a, 0x16, 0xb4, 0x23, 0x68, 0xc8, 0x38, 0x3c, 0x86, 0xe6, 0x72, 0x1f, 0x9c, 0x2d, 0xc2, 0x4c,
+	0x70, 0xb9, 0x47, 0xce, 0x56, 0xf2, 0x08, 0x36, 0x04, 0x7e, 0x84, 0x54, 0xf9, 0xba, 0xf9, 0x37,
+	0xca, 0x25, 0xad, 0x5c, 0x37, 0x06, 0x0f, 0x49, 0x68, 0xe4, 0xdf, 0x85, 0xa6, 0xf5, 0x9d, 0xb1,
+	0x89, 0x48, 0xce, 0x35, 0xe3, 0x5e, 0x36, 0x33, 0x36, 0xb6, 0xa7, 0xc6, 0x64, 0x18, 0x4d, 0x28,
+	0xc9, 0x24, 0x0a, 0xfa, 0xa6, 0xa9, 0xba, 0x66, 0xd0, 0xf9, 0x15, 0xac, 0x7b, 0xf1, 0xfb, 0x84,
+	0x85, 0x36, 0x73, 0xd7, 0x86, 0xe7, 0x6d, 0x58, 0xfb, 0x88, 0x30, 0x7b, 0x51, 0x99, 0x7c, 0xdd,
+	0x33, 0x40, 0x5f, 0x25, 0x17, 0x99, 0xc0, 0x10, 0x89, 0xb4, 0x2d, 0x82, 0xc9, 0x52, 0xc5, 0x62,
+	0xba, 0x41, 0x78, 0x0b, 0xca, 0x02, 0x89, 0xb4, 0xab, 0x5f, 0x73, 0xed, 0xa8, 0xf3, 0x8f, 0x1c,
+	0x34, 0x6c, 0x27, 0x7e, 0x1a, 0x8d, 0xb9, 0x7e, 0x02, 0x5d, 0x3f, 0x83, 0x6b, 0xde, 0x54, 0xf9,
+	0xaf, 0xf8, 0xa6, 0x1a, 0x65, 0xbd, 0x6b, 0xe1, 0x0e, 0x9e, 0x11, 0x56, 0x2b, 0xd9, 0x07, 0xf3,
+	0x28, 0xe4, 0xf4, 0xdc, 0x2c, 0xde, 0x64, 0x17, 0x0c, 0x94, 0xac, 0xfd, 0x91, 0x84, 0xb2, 0x79,
+	0x44, 0x38, 0x9b, 0xb0, 0xe1, 0x8d, 0xfa, 0xa3, 0x53, 0xcf, 0x1f, 0x9e, 0xf8, 0xde, 0xb1, 0xfb,
+	0xe1, 0x70, 0x70, 0xdc, 0x58, 0x71, 0x9a, 0xd0, 0xb8, 0x84, 0xdf, 0xef, 0x0f, 0x3f, 0x38, 0x3e,
+	0x6a, 0xe4, 0x9c, 0xb7, 0xe1, 0x81, 0x45, 0x9f, 0xb8, 0xfd, 0xc1, 0xf1, 0x0f, 0x4f, 0x3f, 0xf0,
+	0x8f, 0x7f, 0x3a, 0x1c, 0x0d, 0x4f, 0x9e, 0x34, 0xf2, 0xce, 0x16, 0x6c, 0x5e, 0x52, 0x9e, 0xf6,
+	0x87, 0x27, 0xa3, 0xe3, 0x93, 0xfe, 0xc9, 0xe0, 0xb8, 0x51, 0xd8, 0x2e, 0xfe, 0xe6, 0x8f, 0xed,
+	0x95, 0xc3, 0xa3, 0xcf, 0x5e, 0xb6, 0x73, 0x2f, 0x5e, 0xb6, 0x73, 0x7f, 0x7f, 0xd9, 0xce, 0xfd,
+	0xf6, 0x55, 0x7b, 0xe5, 0xc5, 0xab, 0xf6, 0xca, 0x5f, 0x5f, 0xb5, 0x57, 0x7e, 0xf6, 0x68, 0x69,
+	0xb5, 0xe3, 0x68, 0xbc, 0x4f, 0xa7, 0x84, 0x45, 0xbd, 0xa5, 0x07, 0xfe, 0x2f, 0xb3, 0x5f, 0x17,
+	0xc6, 0x65, 0xfd, 0xba, 0xff, 0xf6, 0xbf, 0x06, 0x00, 0x5f, 0xb0, 0xce, 0x63, 0x7b, 0x10, 0x00,
+	0x00,
+}
+
+func (m *Description) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Description) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SecurityContact) > 0 {
+		i -= len(m.SecurityContact)
+		copy(dAtA[i:], m.SecurityContact)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SecurityContact)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreeCapacity != nil {
+		{
+			size, err := m.FreeCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.BlsKey) > 0 {
 		i -= len(m.BlsKey)
 		copy(dAtA[i:], m.BlsKey)
@@ -874,6 +1394,20 @@ func (m *SpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.StorePrice.Size()
 		i -= size
@@ -912,7 +1446,7 @@ func (m *SpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GlobalSpStorePrice) Marshal() (dAtA []byte, err error) {
+func (m *ScheduledSpStoragePrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -922,36 +1456,50 @@ func (m *GlobalSpStorePrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobalSpStorePrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduledSpStoragePrice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GlobalSpStorePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduledSpStoragePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.SecondaryStorePrice.Size()
-		i -= size
-		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ScheduledAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
 	{
-		size := m.PrimaryStorePrice.Size()
+		size := m.StorePrice.Size()
 		i -= size
-		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x2a
+	if m.FreeReadQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FreeReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ReadPrice.Size()
 		i -= size
@@ -961,16 +1509,21 @@ func (m *GlobalSpStorePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.UpdateTimeSec != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
+	dAtA[i] = 0x1a
+	if m.EffectiveTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EffectiveTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SpMaintenanceStats) Marshal() (dAtA []byte, err error) {
+func (m *StorePriceTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -980,32 +1533,210 @@ func (m *SpMaintenanceStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpMaintenanceStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorePriceTier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpMaintenanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorePriceTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.RedundancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StorePrice.Size()
+		i -= size
+		if _, err := m.StorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinChargeSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinChargeSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSpStorePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSpStorePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSpStorePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorePriceTiers) > 0 {
+		for iNdEx := len(m.StorePriceTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorePriceTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReadPrice.Size()
+		i -= size
+		if _, err := m.ReadPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.UpdateTimeSec != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateTimeSec))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalStorePriceTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalStorePriceTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalStorePriceTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedundancyType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SecondaryStorePrice.Size()
+		i -= size
+		if _, err := m.SecondaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PrimaryStorePrice.Size()
+		i -= size
+		if _, err := m.PrimaryStorePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinChargeSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinChargeSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpMaintenanceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpMaintenanceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpMaintenanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *MaintenanceRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1050,62 +1781,215 @@ func (m *MaintenanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SpScorecard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SpScorecard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpScorecard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Score != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.RejectMigrateCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RejectMigrateCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.RejectSealCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RejectSealCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.MaintenanceDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaintenanceDuration))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.SlashCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.ChallengePassCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChallengePassCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StorageProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpJailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SpJailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpJailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.FundingAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ReleaseTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReleaseTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JailedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.JailedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FundingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.SealAddress)
 	if l > 0 {
@@ -1138,6 +2022,10 @@ func (m *StorageProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.FreeCapacity != nil {
+		l = m.FreeCapacity.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1175,6 +2063,62 @@ func (m *SpStoragePrice) Size() (n int) {
 	}
 	l = m.StorePrice.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledSpStoragePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.EffectiveTime != 0 {
+		n += 1 + sovTypes(uint64(m.EffectiveTime))
+	}
+	l = m.ReadPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.FreeReadQuota != 0 {
+		n += 1 + sovTypes(uint64(m.FreeReadQuota))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovTypes(uint64(m.ScheduledAt))
+	}
+	return n
+}
+
+func (m *StorePriceTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinChargeSize != 0 {
+		n += 1 + sovTypes(uint64(m.MinChargeSize))
+	}
+	l = m.StorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SecondaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RedundancyType != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyType))
+	}
 	return n
 }
 
@@ -1193,6 +2137,31 @@ func (m *GlobalSpStorePrice) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.SecondaryStorePrice.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StorePriceTiers) > 0 {
+		for _, e := range m.StorePriceTiers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GlobalStorePriceTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinChargeSize != 0 {
+		n += 1 + sovTypes(uint64(m.MinChargeSize))
+	}
+	l = m.PrimaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SecondaryStorePrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RedundancyType != 0 {
+		n += 1 + sovTypes(uint64(m.RedundancyType))
+	}
 	return n
 }
 
@@ -1208,37 +2177,1093 @@ func (m *SpMaintenanceStats) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MaintenanceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.RequestDuration != 0 {
+		n += 1 + sovTypes(uint64(m.RequestDuration))
+	}
+	if m.ActualDuration != 0 {
+		n += 1 + sovTypes(uint64(m.ActualDuration))
+	}
+	if m.RequestAt != 0 {
+		n += 1 + sovTypes(uint64(m.RequestAt))
+	}
+	return n
+}
+
+func (m *SpScorecard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.ChallengePassCount != 0 {
+		n += 1 + sovTypes(uint64(m.ChallengePassCount))
+	}
+	if m.SlashCount != 0 {
+		n += 1 + sovTypes(uint64(m.SlashCount))
+	}
+	if m.MaintenanceDuration != 0 {
+		n += 1 + sovTypes(uint64(m.MaintenanceDuration))
+	}
+	if m.RejectSealCount != 0 {
+		n += 1 + sovTypes(uint64(m.RejectSealCount))
+	}
+	if m.RejectMigrateCount != 0 {
+		n += 1 + sovTypes(uint64(m.RejectMigrateCount))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTypes(uint64(m.Score))
+	}
+	return n
+}
+
+func (m *SpJailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.JailedAt != 0 {
+		n += 1 + sovTypes(uint64(m.JailedAt))
+	}
+	if m.ReleaseTime != 0 {
+		n += 1 + sovTypes(uint64(m.ReleaseTime))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.UnlockTime != 0 {
+		n += 1 + sovTypes(uint64(m.UnlockTime))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsKey == nil {
+				m.BlsKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreeCapacity == nil {
+				m.FreeCapacity = &common.UInt64Value{}
+			}
+			if err := m.FreeCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpStoragePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+			}
+			m.UpdateTimeSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorePriceTiers = append(m.StorePriceTiers, StorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MaintenanceRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	if m.RequestDuration != 0 {
-		n += 1 + sovTypes(uint64(m.RequestDuration))
-	}
-	if m.ActualDuration != 0 {
-		n += 1 + sovTypes(uint64(m.ActualDuration))
-	}
-	if m.RequestAt != 0 {
-		n += 1 + sovTypes(uint64(m.RequestAt))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *ScheduledSpStoragePrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,17 +3286,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledSpStoragePrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledSpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			var stringLen uint64
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1281,29 +3306,16 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			m.EffectiveTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1313,27 +3325,14 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EffectiveTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1361,11 +3360,32 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+			}
+			m.FreeReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1393,13 +3413,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1409,24 +3431,45 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
+			m.StorePriceTiers = append(m.StorePriceTiers, StorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1448,7 +3491,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageProvider) Unmarshal(dAtA []byte) error {
+func (m *StorePriceTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,17 +3514,17 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorePriceTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorePriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinChargeSize", wireType)
 			}
-			m.Id = 0
+			m.MinChargeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1491,14 +3534,14 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.MinChargeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1526,11 +3569,13 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1558,13 +3603,84 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSpStorePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSpStorePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
 			}
-			var stringLen uint64
+			m.UpdateTimeSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1574,27 +3690,14 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdateTimeSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SealAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1622,11 +3725,13 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApprovalAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1654,11 +3759,13 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1686,13 +3793,15 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaintenanceAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePriceTiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1702,31 +3811,81 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StorePriceTiers = append(m.StorePriceTiers, GlobalStorePriceTier{})
+			if err := m.StorePriceTiers[len(m.StorePriceTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalStorePriceTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalStorePriceTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalStorePriceTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinChargeSize", wireType)
 			}
-			m.Status = 0
+			m.MinChargeSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1736,14 +3895,14 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				m.MinChargeSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1771,13 +3930,15 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1787,30 +3948,31 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
 			}
-			var byteLen int
+			m.RedundancyType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1820,26 +3982,11 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.RedundancyType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlsKey = append(m.BlsKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlsKey == nil {
-				m.BlsKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1861,7 +4008,7 @@ func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardInfo) Unmarshal(dAtA []byte) error {
+func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1884,47 +4031,15 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpMaintenanceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpMaintenanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1951,7 +4066,8 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &MaintenanceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1976,7 +4092,7 @@ func (m *RewardInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1999,17 +4115,17 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpStoragePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpStoragePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SpId = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2019,16 +4135,16 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpId |= uint32(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDuration", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.RequestDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2038,50 +4154,16 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.RequestDuration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreeReadQuota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualDuration", wireType)
 			}
-			m.FreeReadQuota = 0
+			m.ActualDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2091,16 +4173,16 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FreeReadQuota |= uint64(b&0x7F) << shift
+				m.ActualDuration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorePrice", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestAt", wireType)
 			}
-			var stringLen uint64
+			m.RequestAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2110,26 +4192,11 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2151,7 +4218,7 @@ func (m *SpStoragePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
+func (m *SpScorecard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2174,17 +4241,17 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalSpStorePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpScorecard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalSpStorePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpScorecard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimeSec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.UpdateTimeSec = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2194,16 +4261,16 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateTimeSec |= int64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePassCount", wireType)
 			}
-			var stringLen uint64
+			m.ChallengePassCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2213,31 +4280,16 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChallengePassCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ReadPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryStorePrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
 			}
-			var stringLen uint64
+			m.SlashCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2247,31 +4299,35 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceDuration", wireType)
 			}
-			if err := m.PrimaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaintenanceDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryStorePrice", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectSealCount", wireType)
 			}
-			var stringLen uint64
+			m.RejectSealCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2281,26 +4337,49 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RejectSealCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectMigrateCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.RejectMigrateCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectMigrateCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			if err := m.SecondaryStorePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2322,7 +4401,7 @@ func (m *GlobalSpStorePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
+func (m *SpJailRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2345,17 +4424,74 @@ func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpMaintenanceStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpJailRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpMaintenanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpJailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedAt", wireType)
+			}
+			m.JailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			m.ReleaseTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2365,25 +4501,23 @@ func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &MaintenanceRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2406,7 +4540,7 @@ func (m *SpMaintenanceStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
+func (m *DepositUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2429,17 +4563,17 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
 			}
-			m.Height = 0
+			m.SpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2449,16 +4583,16 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.SpId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
 			}
-			m.RequestDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2468,16 +4602,29 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestDuration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ActualDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2487,16 +4634,31 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActualDuration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			m.RequestAt = 0
+			m.UnlockTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2506,7 +4668,7 @@ func (m *MaintenanceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestAt |= int64(b&0x7F) << shift
+				m.UnlockTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -38,6 +39,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdHeadGroupMember(),
 		CmdQueryAccountPolicy(),
 		CmdQueryGroupPolicy(),
+		CmdEstimateStorageCost(),
+		CmdEarlyDeletionPenalty(),
 	)
 
 	return storageQueryCmd
@@ -414,3 +417,91 @@ Examples:
 
 	return cmd
 }
+
+func CmdEstimateStorageCost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-storage-cost [primary-sp-address] [payload-size]",
+		Short: "Estimate the cost of storing an object and charging read quota with the current price",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argPayloadSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			redundancyTypeFlag, _ := cmd.Flags().GetString(FlagRedundancyType)
+			var redundancyType types.RedundancyType
+			if redundancyTypeFlag == "EC" {
+				redundancyType = types.REDUNDANCY_EC_TYPE
+			} else if redundancyTypeFlag == "Replica" {
+				redundancyType = types.REDUNDANCY_REPLICA_TYPE
+			} else {
+				return types.ErrInvalidRedundancyType
+			}
+
+			chargedReadQuota, err := cmd.Flags().GetUint64(FlagChargedReadQuota)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEstimateStorageCostRequest{
+				PrimarySpAddress: args[0],
+				PayloadSize:      argPayloadSize,
+				RedundancyType:   redundancyType,
+				ReadQuota:        chargedReadQuota,
+			}
+
+			res, err := queryClient.QueryEstimateStorageCost(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRedundancyType, "EC", "The redundancy type, EC or Replica")
+	cmd.Flags().Uint64(FlagChargedReadQuota, 0, "The read quota of the bucket in bytes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEarlyDeletionPenalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "early-deletion-penalty [bucket-name] [object-name]",
+		Short: "Query the penalty charged if the object is deleted before the reserve time now",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEarlyDeletionPenaltyRequest{
+				BucketName: args[0],
+				ObjectName: args[1],
+			}
+
+			res, err := queryClient.QueryEarlyDeletionPenalty(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
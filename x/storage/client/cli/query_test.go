@@ -128,6 +128,31 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryVerifyPermissionResponse{},
 		},
+		{
+			"query estimate-storage-cost",
+			append(
+				[]string{
+					"estimate-storage-cost",
+					sample.RandAccAddressHex(),
+					"1024",
+					"--charged-read-quota=1024",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryEstimateStorageCostResponse{},
+		},
+		{
+			"query early-deletion-penalty",
+			append(
+				[]string{
+					"early-deletion-penalty",
+					"bucket",
+					"object",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryEarlyDeletionPenaltyResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
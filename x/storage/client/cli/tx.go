@@ -41,6 +41,9 @@ func GetTxCmd() *cobra.Command {
 		CmdDiscontinueBucket(),
 		CmdMigrateBucket(),
 		CmdCancelMigrateBucket(),
+		CmdCreatePrepaidPlan(),
+		CmdSubmitReadReceipt(),
+		CmdClaimReadFee(),
 	)
 
 	cmd.AddCommand(
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	cmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func CmdSubmitReadReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-read-receipt [bucket-name] [bucket-id] [read-bytes] [start-time] [end-time] [nonce] [owner-signature]",
+		Short: "submit the bytes served to a bucket during a period, which are acknowledged by the bucket owner",
+		Long: "submit the bytes served to a bucket during a period, the owner signature is the hex encoded signature of the bucket owner over the receipt, " +
+			"which is bound to the chain id of the client",
+		Args: cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBucketName := args[0]
+			argBucketId, err := cmath.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+			argReadBytes, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argStartTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argEndTime, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			argNonce, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return err
+			}
+			argOwnerSignature, err := hex.DecodeString(args[6])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSubmitReadReceipt(
+				clientCtx.GetFromAddress(),
+				argBucketName,
+				argBucketId,
+				clientCtx.ChainID,
+				argReadBytes,
+				argStartTime,
+				argEndTime,
+				argNonce,
+				argOwnerSignature,
+			)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,6 +16,12 @@ func BeginBlocker(ctx sdk.Context, keeper Keeper) {
 }
 
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
+	ctx = ctx.WithValue(paymenttypes.ForceUpdateStreamRecordKey, true)
+
+	// close expired prepaid plans
+	keeper.ExpirePrepaidPlans(ctx)
+
 	deletionMax := keeper.DiscontinueDeletionMax(ctx)
 	if deletionMax == 0 {
 		return
@@ -23,9 +29,6 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 	blockTime := ctx.BlockTime().Unix()
 
-	// set ForceUpdateStreamRecordKey to true in context to force update frozen stream record
-	ctx = ctx.WithValue(paymenttypes.ForceUpdateStreamRecordKey, true)
-
 	// delete objects
 	deleted, err := keeper.DeleteDiscontinueObjectsUntil(ctx, blockTime, deletionMax)
 	if err != nil {
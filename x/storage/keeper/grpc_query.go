@@ -328,6 +328,68 @@ func (k Keeper) QueryLockFee(c context.Context, req *types.QueryLockFeeRequest)
 	return &types.QueryLockFeeResponse{Amount: amount}, nil
 }
 
+func (k Keeper) QueryEstimateStorageCost(c context.Context, req *types.QueryEstimateStorageCostRequest) (*types.QueryEstimateStorageCostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	primaryAcc, err := sdk.AccAddressFromHexUnsafe(req.PrimarySpAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid primary storage provider address")
+	}
+
+	if _, ok := types.RedundancyType_name[int32(req.RedundancyType)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid redundancy type")
+	}
+
+	_, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, primaryAcc)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound
+	}
+
+	res, err := k.EstimateStorageCost(ctx, req.PayloadSize, req.ReadQuota, req.RedundancyType)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (k Keeper) QueryEarlyDeletionPenalty(c context.Context, req *types.QueryEarlyDeletionPenaltyRequest) (*types.QueryEarlyDeletionPenaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bucketInfo, found := k.GetBucketInfo(ctx, req.BucketName)
+	if !found {
+		return nil, types.ErrNoSuchBucket
+	}
+	objectInfo, found := k.GetObjectInfo(ctx, req.BucketName, req.ObjectName)
+	if !found {
+		return nil, types.ErrNoSuchObject
+	}
+
+	res := &types.QueryEarlyDeletionPenaltyResponse{
+		Amount:         math.ZeroInt(),
+		PaymentAddress: bucketInfo.PaymentAddress,
+	}
+	// only the store fee of sealed objects is charged, the locked fee of the created ones is unlocked on deletion
+	if objectInfo.ObjectStatus != types.OBJECT_STATUS_SEALED {
+		return res, nil
+	}
+
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	duration, amount, err := k.GetEarlyDeletionPenalty(ctx, bucketInfo, internalBucketInfo, objectInfo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.ChargedDuration = duration
+	res.Amount = amount
+	return res, nil
+}
+
 func (k Keeper) HeadBucketExtra(c context.Context, req *types.QueryHeadBucketExtraRequest) (*types.QueryHeadBucketExtraResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -388,8 +450,10 @@ func (k Keeper) QueryQuotaUpdateTime(c context.Context, req *types.QueryQuoteUpd
 	}
 
 	updateAt, _ := k.getQuotaUpdateTime(ctx, bucketInfo.Id) // the bucket exists, so it will always get a result
+	readRecord, _ := k.GetBucketReadRecord(ctx, bucketInfo.Id)
 	return &types.QueryQuoteUpdateTimeResponse{
-		UpdateAt: int64(updateAt),
+		UpdateAt:   int64(updateAt),
+		ReadRecord: readRecord,
 	}, nil
 }
 
@@ -228,11 +228,20 @@ func (k Keeper) DeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketNam
 }
 
 func (k Keeper) doDeleteBucket(ctx sdk.Context, operator sdk.AccAddress, bucketInfo *types.BucketInfo) error {
+	// close the prepaid plan of the bucket and refund the balance left
+	internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucketInfo.Id)
+	if found && internalBucketInfo.PrepaidPlanId != 0 {
+		if err := k.paymentKeeper.ClosePrepaidPlan(ctx, internalBucketInfo.PrepaidPlanId); err != nil {
+			return types.ErrChargeFailed.Wrapf("close prepaid plan failed: %s", err)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetBucketKey(bucketInfo.BucketName))
 	store.Delete(types.GetBucketByIDKey(bucketInfo.Id))
 	store.Delete(types.GetQuotaKey(bucketInfo.Id))
 	store.Delete(types.GetInternalBucketInfoKey(bucketInfo.Id))
+	store.Delete(types.GetBucketReadRecordKey(bucketInfo.Id))
 	store.Delete(types.GetMigrationBucketKey(bucketInfo.Id))
 
 	err := k.appendResourceIdForGarbageCollection(ctx, resource.RESOURCE_TYPE_BUCKET, bucketInfo.Id)
@@ -790,6 +799,7 @@ func (k Keeper) SealObject(
 
 	obz := k.cdc.MustMarshal(objectInfo)
 	store.Set(types.GetObjectByIDKey(objectInfo.Id), obz)
+	k.AddSealedObjectSample(ctx, objectInfo)
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventSealObject{
 		Operator:             spSealAcc.String(),
@@ -915,6 +925,7 @@ func (k Keeper) doDeleteObject(ctx sdk.Context, operator sdk.AccAddress, bucketI
 
 	store.Delete(types.GetObjectKey(bucketInfo.BucketName, objectInfo.ObjectName))
 	store.Delete(types.GetObjectByIDKey(objectInfo.Id))
+	k.RemoveSealedObjectSample(ctx, objectInfo)
 
 	// when object was not sealed, the lvg id is 0 by default.
 	if objectInfo.LocalVirtualGroupId != 0 {
@@ -1119,6 +1130,9 @@ func (k Keeper) RejectSealObject(ctx sdk.Context, operator sdk.AccAddress, bucke
 	if err != nil {
 		return err
 	}
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) && !k.isExcusedRejection(ctx, sp, bucketInfo) {
+		k.spKeeper.RecordRejectSealObject(ctx, sp.Id)
+	}
 
 	bbz := k.cdc.MustMarshal(bucketInfo)
 
@@ -1185,6 +1199,7 @@ func (k Keeper) DiscontinueObject(ctx sdk.Context, operator sdk.AccAddress, buck
 		// update object status
 		object.ObjectStatus = types.OBJECT_STATUS_DISCONTINUED
 		store.Set(types.GetObjectByIDKey(object.Id), k.cdc.MustMarshal(object))
+		k.RemoveSealedObjectSample(ctx, object)
 	}
 
 	deleteAt := ctx.BlockTime().Unix() + k.DiscontinueConfirmPeriod(ctx)
@@ -2100,6 +2115,9 @@ func (k Keeper) RejectBucketMigration(ctx sdk.Context, operator sdk.AccAddress,
 	bucketInfo.BucketStatus = types.BUCKET_STATUS_CREATED
 	k.SetBucketInfo(ctx, bucketInfo)
 	store.Delete(types.GetMigrationBucketKey(bucketInfo.Id))
+	if ctx.IsUpgraded(gnfdtypes.Hulunbeier) && !k.isExcusedRejection(ctx, sp, bucketInfo) {
+		k.spKeeper.RecordRejectMigrateBucket(ctx, sp.Id)
+	}
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventRejectMigrateBucket{
 		Operator:   operator.String(),
@@ -2111,6 +2129,17 @@ func (k Keeper) RejectBucketMigration(ctx sdk.Context, operator sdk.AccAddress,
 	return nil
 }
 
+// isExcusedRejection returns whether the storage provider is allowed to reject serving the bucket without affecting
+// its scorecard, i.e. it is in maintenance mode, or the bucket is not paid since it is discontinued or its payment
+// account is frozen.
+func (k Keeper) isExcusedRejection(ctx sdk.Context, sp *sptypes.StorageProvider, bucketInfo *types.BucketInfo) bool {
+	if sp.Status == sptypes.STATUS_IN_MAINTENANCE || bucketInfo.BucketStatus == types.BUCKET_STATUS_DISCONTINUED {
+		return true
+	}
+	streamRecord, found := k.paymentKeeper.GetStreamRecord(ctx, sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress))
+	return found && streamRecord.Status == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN
+}
+
 func (k Keeper) GetMigrationBucketInfo(ctx sdk.Context, bucketID sdkmath.Uint) (*types.MigrationBucketInfo, bool) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -2124,6 +2153,25 @@ func (k Keeper) GetMigrationBucketInfo(ctx sdk.Context, bucketID sdkmath.Uint) (
 	return &migrationBucketInfo, true
 }
 
+// GetMigrationBucketIDsOfSP returns the ids of the buckets migrating away from the sp and the buckets migrating to the sp
+func (k Keeper) GetMigrationBucketIDsOfSP(ctx sdk.Context, spID uint32) (srcBucketIDs, dstBucketIDs []sdkmath.Uint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MigrateBucketPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var migrationBucketInfo types.MigrationBucketInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &migrationBucketInfo)
+		if migrationBucketInfo.SrcSpId == spID {
+			srcBucketIDs = append(srcBucketIDs, migrationBucketInfo.BucketId)
+		}
+		if migrationBucketInfo.DstSpId == spID {
+			dstBucketIDs = append(dstBucketIDs, migrationBucketInfo.BucketId)
+		}
+	}
+	return srcBucketIDs, dstBucketIDs
+}
+
 func (k Keeper) DeleteMigrationBucketInfo(ctx sdk.Context, bucketID sdkmath.Uint) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetMigrationBucketKey(bucketID))
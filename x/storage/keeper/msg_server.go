@@ -709,6 +709,51 @@ func (k msgServer) SetTag(goCtx context.Context, msg *types.MsgSetTag) (*types.M
 	return &types.MsgSetTagResponse{}, nil
 }
 
+func (k msgServer) CreatePrepaidPlan(goCtx context.Context, msg *types.MsgCreatePrepaidPlan) (*types.MsgCreatePrepaidPlanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	planId, err := k.Keeper.CreatePrepaidPlan(ctx, operatorAddr, msg.BucketName, msg.ChargeSize, msg.Months)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreatePrepaidPlanResponse{PlanId: planId}, nil
+}
+
+func (k msgServer) SubmitReadReceipt(goCtx context.Context, msg *types.MsgSubmitReadReceipt) (*types.MsgSubmitReadReceiptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	err := k.Keeper.SubmitReadReceipt(ctx, operatorAddr, msg.BucketName, &types.SubmitReadReceiptOptions{
+		BucketId:        msg.BucketId,
+		ChainId:         msg.ChainId,
+		ReadBytes:       msg.ReadBytes,
+		StartTime:       msg.StartTime,
+		EndTime:         msg.EndTime,
+		Nonce:           msg.Nonce,
+		OwnerSignature:  msg.OwnerSignature,
+		ReceiptMsgBytes: msg.GetReceiptBytes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitReadReceiptResponse{}, nil
+}
+
+func (k msgServer) ClaimReadFee(goCtx context.Context, msg *types.MsgClaimReadFee) (*types.MsgClaimReadFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.Operator)
+
+	claimed, amount, err := k.Keeper.ClaimReadFee(ctx, operatorAddr, msg.BucketName)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgClaimReadFeeResponse{ClaimedReadBytes: claimed, Amount: amount}, nil
+}
+
 func (k Keeper) verifyGVGSignatures(ctx sdk.Context, bucketID math.Uint, dstSP *sptypes.StorageProvider, gvgMappings []*storagetypes.GVGMapping) error {
 	// verify secondary sp signature
 	for _, newLvg2gvg := range gvgMappings {
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// GetSealedObjectSampleCounts returns the number of sealed objects of each size class in the sampling index,
+// the result is indexed by size class.
+func (k Keeper) GetSealedObjectSampleCounts(ctx sdk.Context) []uint64 {
+	counts := make([]uint64, types.SealedObjectSizeClasses)
+	bz := ctx.KVStore(k.storeKey).Get(types.SealedObjectSampleCountsKey)
+	for i := 0; i < len(bz)/8 && i < len(counts); i++ {
+		counts[i] = binary.BigEndian.Uint64(bz[i*8:])
+	}
+	return counts
+}
+
+func (k Keeper) setSealedObjectSampleCounts(ctx sdk.Context, counts []uint64) {
+	bz := make([]byte, 8*len(counts))
+	for i, count := range counts {
+		binary.BigEndian.PutUint64(bz[i*8:], count)
+	}
+	ctx.KVStore(k.storeKey).Set(types.SealedObjectSampleCountsKey, bz)
+}
+
+// sealedObjectSampleSizeLen is the length of the encoded total payload size of a size class, the total of the
+// largest size classes can exceed uint64.
+const sealedObjectSampleSizeLen = 16
+
+// GetSealedObjectSampleSizes returns the total payload size of the sealed objects of each size class in the sampling
+// index, the result is indexed by size class.
+func (k Keeper) GetSealedObjectSampleSizes(ctx sdk.Context) []sdkmath.Uint {
+	sizes := make([]sdkmath.Uint, types.SealedObjectSizeClasses)
+	bz := ctx.KVStore(k.storeKey).Get(types.SealedObjectSampleSizesKey)
+	for i := range sizes {
+		if (i+1)*sealedObjectSampleSizeLen > len(bz) {
+			sizes[i] = sdkmath.ZeroUint()
+			continue
+		}
+		sizes[i] = sdkmath.NewUintFromBigInt(new(big.Int).SetBytes(bz[i*sealedObjectSampleSizeLen : (i+1)*sealedObjectSampleSizeLen]))
+	}
+	return sizes
+}
+
+func (k Keeper) setSealedObjectSampleSizes(ctx sdk.Context, sizes []sdkmath.Uint) {
+	bz := make([]byte, sealedObjectSampleSizeLen*len(sizes))
+	for i, size := range sizes {
+		size.BigInt().FillBytes(bz[i*sealedObjectSampleSizeLen : (i+1)*sealedObjectSampleSizeLen])
+	}
+	ctx.KVStore(k.storeKey).Set(types.SealedObjectSampleSizesKey, bz)
+}
+
+// GetSealedObjectSample returns the id of the object at the index of the size class in the sampling index
+func (k Keeper) GetSealedObjectSample(ctx sdk.Context, sizeClass uint32, index uint64) (sdkmath.Uint, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSealedObjectSampleKey(sizeClass, index))
+	if bz == nil {
+		return sdkmath.ZeroUint(), false
+	}
+	return k.objectSeq.DecodeSequence(bz), true
+}
+
+// AddSealedObjectSample appends a sealed object to its size class in the sampling index, empty objects are skipped
+// since they cannot be challenged. The sampling index is maintained since the Hulunbeier upgrade.
+func (k Keeper) AddSealedObjectSample(ctx sdk.Context, objectInfo *types.ObjectInfo) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	k.addSealedObjectSample(ctx, objectInfo)
+}
+
+func (k Keeper) addSealedObjectSample(ctx sdk.Context, objectInfo *types.ObjectInfo) {
+	if objectInfo.PayloadSize == 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	positionKey := types.GetSealedObjectSamplePositionKey(objectInfo.Id)
+	if store.Has(positionKey) {
+		return
+	}
+
+	counts := k.GetSealedObjectSampleCounts(ctx)
+	sizeClass := types.SealedObjectSizeClass(objectInfo.PayloadSize)
+	index := counts[sizeClass]
+	store.Set(types.GetSealedObjectSampleKey(sizeClass, index), k.objectSeq.EncodeSequence(objectInfo.Id))
+	store.Set(positionKey, encodeSamplePosition(sizeClass, index))
+
+	counts[sizeClass]++
+	k.setSealedObjectSampleCounts(ctx, counts)
+
+	sizes := k.GetSealedObjectSampleSizes(ctx)
+	sizes[sizeClass] = sizes[sizeClass].AddUint64(objectInfo.PayloadSize)
+	k.setSealedObjectSampleSizes(ctx, sizes)
+}
+
+// RemoveSealedObjectSample removes an object from the sampling index by moving the last object of its size class
+// into its position, so that each size class stays dense. It is a no-op if the object is not indexed.
+func (k Keeper) RemoveSealedObjectSample(ctx sdk.Context, objectInfo *types.ObjectInfo) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	positionKey := types.GetSealedObjectSamplePositionKey(objectInfo.Id)
+	bz := store.Get(positionKey)
+	if bz == nil {
+		return
+	}
+	sizeClass, index := decodeSamplePosition(bz)
+
+	counts := k.GetSealedObjectSampleCounts(ctx)
+	last := counts[sizeClass] - 1
+	if index != last {
+		lastId, found := k.GetSealedObjectSample(ctx, sizeClass, last)
+		if !found {
+			panic("should not happen")
+		}
+		store.Set(types.GetSealedObjectSampleKey(sizeClass, index), k.objectSeq.EncodeSequence(lastId))
+		store.Set(types.GetSealedObjectSamplePositionKey(lastId), encodeSamplePosition(sizeClass, index))
+	}
+	store.Delete(types.GetSealedObjectSampleKey(sizeClass, last))
+	store.Delete(positionKey)
+
+	counts[sizeClass] = last
+	k.setSealedObjectSampleCounts(ctx, counts)
+
+	sizes := k.GetSealedObjectSampleSizes(ctx)
+	sizes[sizeClass] = sizes[sizeClass].SubUint64(objectInfo.PayloadSize)
+	k.setSealedObjectSampleSizes(ctx, sizes)
+}
+
+// backfillBatchSize is the max number of entries collected at a time by the backfills of the upgrade
+const backfillBatchSize = 10000
+
+// BackfillSealedObjectSamples adds the existing sealed objects to the sampling index, which only tracks the objects
+// sealed after the upgrade. The objects are added in batches to bound the memory used.
+func (k Keeper) BackfillSealedObjectSamples(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ObjectByIDPrefix)
+	var start []byte
+	for {
+		// collect a batch of the objects first, the store should not be written while being iterated
+		objects := make([]*types.ObjectInfo, 0, backfillBatchSize)
+		iterator := store.Iterator(start, nil)
+		start = nil
+		for ; iterator.Valid(); iterator.Next() {
+			if len(objects) == backfillBatchSize {
+				start = append([]byte{}, iterator.Key()...)
+				break
+			}
+			var objectInfo types.ObjectInfo
+			k.cdc.MustUnmarshal(iterator.Value(), &objectInfo)
+			if objectInfo.ObjectStatus == types.OBJECT_STATUS_SEALED && objectInfo.PayloadSize != 0 {
+				objects = append(objects, &types.ObjectInfo{Id: objectInfo.Id, PayloadSize: objectInfo.PayloadSize})
+			}
+		}
+		iterator.Close()
+
+		for _, objectInfo := range objects {
+			k.addSealedObjectSample(ctx, objectInfo)
+		}
+		if start == nil {
+			return
+		}
+	}
+}
+
+func encodeSamplePosition(sizeClass uint32, index uint64) []byte {
+	bz := make([]byte, 9)
+	bz[0] = byte(sizeClass)
+	binary.BigEndian.PutUint64(bz[1:], index)
+	return bz
+}
+
+func decodeSamplePosition(bz []byte) (uint32, uint64) {
+	return uint32(bz[0]), binary.BigEndian.Uint64(bz[1:])
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func (s *TestSuite) TestSealedObjectSample() {
+	objects := []*types.ObjectInfo{
+		{Id: sdk.NewUint(1), PayloadSize: 1000},
+		{Id: sdk.NewUint(2), PayloadSize: 1023},
+		{Id: sdk.NewUint(3), PayloadSize: 600},
+		{Id: sdk.NewUint(4), PayloadSize: 1 << 30},
+		{Id: sdk.NewUint(5), PayloadSize: 0},
+	}
+	// the objects are not indexed before the upgrade
+	s.storageKeeper.AddSealedObjectSample(upgrade.WithUpgraded(s.ctx), objects[0])
+	s.Require().Equal(uint64(0), s.storageKeeper.GetSealedObjectSampleCounts(s.ctx)[types.SealedObjectSizeClass(1000)])
+
+	for _, object := range objects {
+		s.storageKeeper.AddSealedObjectSample(s.ctx, object)
+	}
+	// adding an object twice is a no-op
+	s.storageKeeper.AddSealedObjectSample(s.ctx, objects[0])
+
+	smallClass := types.SealedObjectSizeClass(1000)
+	largeClass := types.SealedObjectSizeClass(1 << 30)
+	s.Require().Equal(uint32(10), smallClass)
+	s.Require().Equal(uint32(31), largeClass)
+
+	counts := s.storageKeeper.GetSealedObjectSampleCounts(s.ctx)
+	s.Require().Len(counts, types.SealedObjectSizeClasses)
+	s.Require().Equal(uint64(3), counts[smallClass])
+	s.Require().Equal(uint64(1), counts[largeClass])
+	s.Require().Equal(uint64(0), counts[0]) // empty objects are not indexed
+	sizes := s.storageKeeper.GetSealedObjectSampleSizes(s.ctx)
+	s.Require().Len(sizes, types.SealedObjectSizeClasses)
+	s.Require().Equal(sdk.NewUint(2623), sizes[smallClass])
+	s.Require().Equal(sdk.NewUint(1<<30), sizes[largeClass])
+
+	// removing the first object moves the last one of the size class into its position
+	s.storageKeeper.RemoveSealedObjectSample(s.ctx, objects[0])
+	counts = s.storageKeeper.GetSealedObjectSampleCounts(s.ctx)
+	s.Require().Equal(uint64(2), counts[smallClass])
+	s.Require().Equal(sdk.NewUint(1623), s.storageKeeper.GetSealedObjectSampleSizes(s.ctx)[smallClass])
+	id, found := s.storageKeeper.GetSealedObjectSample(s.ctx, smallClass, 0)
+	s.Require().True(found)
+	s.Require().Equal(objects[2].Id, id)
+	id, found = s.storageKeeper.GetSealedObjectSample(s.ctx, smallClass, 1)
+	s.Require().True(found)
+	s.Require().Equal(objects[1].Id, id)
+	_, found = s.storageKeeper.GetSealedObjectSample(s.ctx, smallClass, 2)
+	s.Require().False(found)
+
+	// removing an object which is not indexed is a no-op
+	s.storageKeeper.RemoveSealedObjectSample(s.ctx, objects[4])
+	s.storageKeeper.RemoveSealedObjectSample(s.ctx, objects[0])
+
+	// the moved object can still be removed
+	s.storageKeeper.RemoveSealedObjectSample(s.ctx, objects[2])
+	s.storageKeeper.RemoveSealedObjectSample(s.ctx, objects[3])
+	counts = s.storageKeeper.GetSealedObjectSampleCounts(s.ctx)
+	s.Require().Equal(uint64(1), counts[smallClass])
+	s.Require().Equal(uint64(0), counts[largeClass])
+	sizes = s.storageKeeper.GetSealedObjectSampleSizes(s.ctx)
+	s.Require().Equal(sdk.NewUint(1023), sizes[smallClass])
+	s.Require().True(sizes[largeClass].IsZero())
+	id, found = s.storageKeeper.GetSealedObjectSample(s.ctx, smallClass, 0)
+	s.Require().True(found)
+	s.Require().Equal(objects[1].Id, id)
+}
+
+func (s *TestSuite) TestBackfillSealedObjectSamples() {
+	objects := []*types.ObjectInfo{
+		{Id: sdk.NewUint(1), PayloadSize: 1000, ObjectStatus: types.OBJECT_STATUS_SEALED},
+		{Id: sdk.NewUint(2), PayloadSize: 1000, ObjectStatus: types.OBJECT_STATUS_CREATED},
+		{Id: sdk.NewUint(3), PayloadSize: 0, ObjectStatus: types.OBJECT_STATUS_SEALED},
+		{Id: sdk.NewUint(4), PayloadSize: 1 << 30, ObjectStatus: types.OBJECT_STATUS_SEALED},
+	}
+	for _, object := range objects {
+		s.storageKeeper.SetObjectInfo(s.ctx, object)
+	}
+
+	// the upgrade is not applied yet when the upgrade handler backfills the sampling index
+	s.storageKeeper.BackfillSealedObjectSamples(upgrade.WithUpgraded(s.ctx))
+
+	counts := s.storageKeeper.GetSealedObjectSampleCounts(s.ctx)
+	s.Require().Equal(uint64(1), counts[types.SealedObjectSizeClass(1000)])
+	s.Require().Equal(uint64(1), counts[types.SealedObjectSizeClass(1<<30)])
+	s.Require().Equal(uint64(0), counts[0])
+	id, found := s.storageKeeper.GetSealedObjectSample(s.ctx, types.SealedObjectSizeClass(1000), 0)
+	s.Require().True(found)
+	s.Require().Equal(objects[0].Id, id)
+	s.Require().Equal(sdk.NewUint(1000), s.storageKeeper.GetSealedObjectSampleSizes(s.ctx)[types.SealedObjectSizeClass(1000)])
+}
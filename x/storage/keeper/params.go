@@ -23,6 +23,29 @@ func (k Keeper) GetExpectSecondarySPNumForECObject(ctx sdk.Context, createTime i
 	return versionParams.RedundantParityChunkNum + versionParams.RedundantDataChunkNum
 }
 
+// GetRedundancyChargeSize returns the total size stored on the primary sp and the secondary sps for an object of
+// chargeSize with the redundancy type. An EC object is split into data chunks and extended with parity chunks, each
+// of which is stored on one secondary sp, while each secondary sp stores a full replica of a replica object.
+func (k Keeper) GetRedundancyChargeSize(ctx sdk.Context, chargeSize uint64, redundancyType types.RedundancyType, createTime int64) (uint64, error) {
+	versionParams, err := k.GetVersionedParamsWithTs(ctx, createTime)
+	if err != nil {
+		return 0, fmt.Errorf("get versioned params failed: %d %w", createTime, err)
+	}
+	secondarySPNum := uint64(versionParams.RedundantParityChunkNum + versionParams.RedundantDataChunkNum)
+	switch redundancyType {
+	case types.REDUNDANCY_EC_TYPE:
+		dataChunkNum := uint64(versionParams.RedundantDataChunkNum)
+		if dataChunkNum == 0 {
+			return 0, fmt.Errorf("invalid redundant data chunk num: %d", dataChunkNum)
+		}
+		return chargeSize + (chargeSize+dataChunkNum-1)/dataChunkNum*secondarySPNum, nil
+	case types.REDUNDANCY_REPLICA_TYPE:
+		return chargeSize * (1 + secondarySPNum), nil
+	default:
+		return 0, types.ErrInvalidRedundancyType
+	}
+}
+
 func (k Keeper) MaxPayloadSize(ctx sdk.Context) (res uint64) {
 	params := k.GetParams(ctx)
 	return params.MaxPayloadSize
@@ -5,8 +5,10 @@ import (
 
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/payment/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
@@ -28,6 +30,7 @@ func (k Keeper) ChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.Bu
 		ctx.Logger().Error("charge initial read fee failed", "bucket", bucketInfo.BucketName, "err", err.Error())
 		return err
 	}
+	k.recordBucketFlows(ctx, bucketInfo.Id, []types.UserFlows{bill})
 	return nil
 }
 
@@ -50,6 +53,7 @@ func (k Keeper) UnChargeBucketReadFee(ctx sdk.Context, bucketInfo *storagetypes.
 		ctx.Logger().Error("uncharge bucket read fee failed", "bucket", bucketInfo.BucketName, "err", err.Error())
 		return err
 	}
+	k.recordBucketFlows(ctx, bucketInfo.Id, []types.UserFlows{bill})
 	return nil
 }
 
@@ -69,29 +73,52 @@ func (k Keeper) GetBucketReadBill(ctx sdk.Context, bucketInfo *storagetypes.Buck
 		return userFlows, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
 	}
 
-	// primary sp total rate
-	primaryTotalFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+	}
 
-	if primaryTotalFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
-			ToAddress: gvgFamily.VirtualPaymentAddress,
-			Rate:      primaryTotalFlowRate,
-		})
+	userFlows.Flows = append(userFlows.Flows, k.calculateReadBill(price, versionedParams, gvgFamily, bucketInfo.ChargedReadQuota)...)
+	return userFlows, nil
+}
+
+// ChargeReadReceiptFee charges the payment account of the bucket for the receipted read bytes beyond the charged read
+// quota. The bytes are priced as the read quota of a month in GetBucketReadBill at the price time of the bucket. The
+// fee is paid to the funding address of the sp which served the bytes, rather than the virtual payment address of the
+// current family of the bucket, since the bucket could have been migrated to another sp after the bytes were served.
+func (k Keeper) ChargeReadReceiptFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, sp *sptypes.StorageProvider, readBytes uint64) (sdkmath.Int, error) {
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
 	}
 
 	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
 	if err != nil {
-		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
+		return sdkmath.ZeroInt(), fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
 	}
-	validatorTaxRate := versionedParams.ValidatorTaxRate.MulInt(primaryTotalFlowRate).TruncateInt()
-	if validatorTaxRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
-			ToAddress: types.ValidatorTaxPoolAddress.String(),
-			Rate:      validatorTaxRate,
-		})
+
+	total := sdkmath.ZeroInt()
+	for _, flow := range k.calculateReadFlows(price, versionedParams, sp.FundingAddress, readBytes) {
+		amount := flow.Rate.MulRaw(storagetypes.SecondsPerMonth)
+		_, err = k.paymentKeeper.UpdateStreamRecordByAddr(ctx,
+			types.NewDefaultStreamRecordChangeWithAddr(sdk.MustAccAddressFromHex(flow.ToAddress)).WithStaticBalanceChange(amount))
+		if err != nil {
+			return sdkmath.ZeroInt(), fmt.Errorf("pay address %s failed: %s %w", flow.ToAddress, bucketInfo.BucketName, err)
+		}
+		total = total.Add(amount)
 	}
 
-	return userFlows, nil
+	paymentAddr := sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
+	streamRecord, err := k.paymentKeeper.UpdateStreamRecordByAddr(ctx, types.NewDefaultStreamRecordChangeWithAddr(paymentAddr).
+		WithStaticBalanceChange(total.Neg()))
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("subtracting from payment account failed: %s %w", bucketInfo.BucketName, err)
+	}
+	if streamRecord.StaticBalance.IsNegative() {
+		return sdkmath.ZeroInt(), fmt.Errorf("static balance is not enough for %s, lacks %s", bucketInfo.BucketName, streamRecord.StaticBalance.Neg().String())
+	}
+	return total, nil
 }
 
 func (k Keeper) UpdateBucketInfoAndCharge(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -182,6 +209,7 @@ func (k Keeper) IsPriceChanged(ctx sdk.Context, primarySpId uint32, priceTime in
 	return !(prePrice.ReadPrice.Equal(currentPrice.ReadPrice) &&
 			prePrice.PrimaryStorePrice.Equal(currentPrice.PrimaryStorePrice) &&
 			prePrice.SecondaryStorePrice.Equal(currentPrice.SecondaryStorePrice) &&
+			prePrice.IsStorePriceTiersEqual(&currentPrice) &&
 			preParams.ValidatorTaxRate.Equal(currentParams.ValidatorTaxRate)),
 		&prePrice, preParams.ValidatorTaxRate, &currentPrice, currentParams.ValidatorTaxRate, nil
 }
@@ -193,12 +221,15 @@ func (k Keeper) ChargeObjectStoreFee(ctx sdk.Context, primarySpId uint32, bucket
 		return fmt.Errorf("get charge size failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
 	}
 
-	priceChanged, _, _, _, _, err := k.IsPriceChanged(ctx, primarySpId, internalBucketInfo.PriceTime)
+	priceChanged, prePrice, _, _, _, err := k.IsPriceChanged(ctx, primarySpId, internalBucketInfo.PriceTime)
 	if err != nil {
 		return fmt.Errorf("check whether price changed failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
 	}
 
-	if !priceChanged {
+	// the bill of a bucket with prepaid plan is always recalculated, since the part exceeding the plan may change,
+	// and so is the bill of a bucket moving to another store price tier, since all its lvgs are charged by the new tier
+	if !priceChanged && internalBucketInfo.PrepaidPlanId == 0 &&
+		!isStorePriceTierChanged(prePrice, internalBucketInfo.TotalChargeSize, internalBucketInfo.TotalChargeSize+chargeSize) {
 		_, err := k.ChargeViaObjectChange(ctx, bucketInfo, internalBucketInfo, objectInfo, chargeSize, false)
 		if err != nil {
 			return fmt.Errorf("apply object store bill failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
@@ -210,7 +241,7 @@ func (k Keeper) ChargeObjectStoreFee(ctx sdk.Context, primarySpId uint32, bucket
 		ibi.TotalChargeSize += chargeSize
 		for _, lvg := range ibi.LocalVirtualGroups {
 			if lvg.Id == objectInfo.LocalVirtualGroupId {
-				lvg.TotalChargeSize = lvg.TotalChargeSize + chargeSize
+				lvg.AddChargeSize(chargeSize, objectInfo.RedundancyType)
 				break
 			}
 		}
@@ -225,7 +256,18 @@ func (k Keeper) UnChargeObjectStoreFee(ctx sdk.Context, primarySpId uint32, buck
 		return fmt.Errorf("get charge size failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
 	}
 
-	userFlows, err := k.ChargeViaObjectChange(ctx, bucketInfo, internalBucketInfo, objectInfo, chargeSize, true)
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
+	}
+
+	var userFlows []types.OutFlow
+	if internalBucketInfo.PrepaidPlanId == 0 &&
+		!isStorePriceTierChanged(&price, internalBucketInfo.TotalChargeSize, internalBucketInfo.TotalChargeSize-chargeSize) {
+		userFlows, err = k.ChargeViaObjectChange(ctx, bucketInfo, internalBucketInfo, objectInfo, chargeSize, true)
+	} else {
+		userFlows, err = k.unChargeObjectStoreFeeViaBucketChange(ctx, bucketInfo, internalBucketInfo, objectInfo, chargeSize)
+	}
 	if err != nil {
 		return fmt.Errorf("apply object store bill failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
 	}
@@ -246,6 +288,46 @@ func (k Keeper) UnChargeObjectStoreFee(ctx sdk.Context, primarySpId uint32, buck
 	return nil
 }
 
+// unChargeObjectStoreFeeViaBucketChange uncharges the store fee of the object by recalculating the bill of the bucket,
+// and returns the store flows of the object for early deletion usage
+func (k Keeper) unChargeObjectStoreFeeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfo *storagetypes.ObjectInfo, chargeSize uint64) ([]types.OutFlow, error) {
+	objectFlows, err := k.getObjectStoreFlowsForDeletion(ctx, bucketInfo, internalBucketInfo, objectInfo, chargeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var lvg *storagetypes.LocalVirtualGroup
+	for _, l := range internalBucketInfo.LocalVirtualGroups {
+		if l.Id == objectInfo.LocalVirtualGroupId {
+			lvg = l
+			break
+		}
+	}
+
+	err = k.ChargeViaBucketChange(ctx, bucketInfo, internalBucketInfo, func(bi *storagetypes.BucketInfo, ibi *storagetypes.InternalBucketInfo) error {
+		ibi.TotalChargeSize = ibi.TotalChargeSize - chargeSize
+		lvg.SubChargeSize(chargeSize, objectInfo.RedundancyType)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objectFlows, nil
+}
+
+// isStorePriceTierChanged returns whether the store price tier of any redundancy type changes when the total charge
+// size of a bucket changes
+func isStorePriceTierChanged(price *sptypes.GlobalSpStorePrice, preTotalChargeSize, newTotalChargeSize uint64) bool {
+	for _, redundancyType := range sptypes.StorePriceRedundancyTypes {
+		if price.GetStorePriceTierIndex(preTotalChargeSize, redundancyType) !=
+			price.GetStorePriceTierIndex(newTotalChargeSize, redundancyType) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) ChargeObjectStoreFeeForEarlyDeletion(ctx sdk.Context, userFlows []types.OutFlow, bucketInfo *storagetypes.BucketInfo, objectInfo *storagetypes.ObjectInfo, timeToPay int64) error {
 	totalStaticBalanceChange := sdkmath.NewInt(0)
 	for _, flow := range userFlows {
@@ -263,7 +345,116 @@ func (k Keeper) ChargeObjectStoreFeeForEarlyDeletion(ctx sdk.Context, userFlows
 	if err != nil {
 		return fmt.Errorf("subtracting from payment account failed: %s %s %s", bucketInfo.BucketName, objectInfo.ObjectName, err)
 	}
-	return nil
+	return ctx.EventManager().EmitTypedEvents(&storagetypes.EventChargeEarlyDeletion{
+		ObjectId:        objectInfo.Id,
+		BucketName:      bucketInfo.BucketName,
+		ObjectName:      objectInfo.ObjectName,
+		PaymentAddress:  bucketInfo.PaymentAddress,
+		ChargedDuration: timeToPay,
+		Amount:          totalStaticBalanceChange,
+	})
+}
+
+// GetEarlyDeletionPenalty returns the duration and the amount which will be charged from the payment account
+// of the bucket if the sealed object is deleted at the current block time.
+func (k Keeper) GetEarlyDeletionPenalty(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfo *storagetypes.ObjectInfo) (int64, sdkmath.Int, error) {
+	versionParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
+	if err != nil {
+		return 0, sdkmath.ZeroInt(), fmt.Errorf("failed to get versioned params: %w", err)
+	}
+	timeToPay := objectInfo.CreateAt + int64(versionParams.ReserveTime) - ctx.BlockTime().Unix()
+	if timeToPay <= 0 {
+		return 0, sdkmath.ZeroInt(), nil
+	}
+
+	chargeSize, err := k.GetObjectChargeSize(ctx, objectInfo.PayloadSize, objectInfo.CreateAt)
+	if err != nil {
+		return 0, sdkmath.ZeroInt(), fmt.Errorf("get charge size failed: %s %s %w", bucketInfo.BucketName, objectInfo.ObjectName, err)
+	}
+	userFlows, err := k.getObjectStoreFlowsForDeletion(ctx, bucketInfo, internalBucketInfo, objectInfo, chargeSize)
+	if err != nil {
+		return 0, sdkmath.ZeroInt(), err
+	}
+	amount := sdkmath.ZeroInt()
+	for _, flow := range userFlows {
+		amount = amount.Add(flow.Rate.Abs().MulRaw(timeToPay))
+	}
+	return timeToPay, amount, nil
+}
+
+// getObjectStoreFlowsForDeletion returns the store flows of the payment account which are removed when the object
+// is deleted, the same as the ones UnChargeObjectStoreFee uses for early deletion, without changing any state.
+func (k Keeper) getObjectStoreFlowsForDeletion(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo, objectInfo *storagetypes.ObjectInfo, chargeSize uint64) ([]types.OutFlow, error) {
+	priceTime := internalBucketInfo.PriceTime
+
+	var lvg *storagetypes.LocalVirtualGroup
+	for _, l := range internalBucketInfo.LocalVirtualGroups {
+		if l.Id == objectInfo.LocalVirtualGroupId {
+			lvg = l
+			break
+		}
+	}
+	if lvg == nil {
+		return nil, fmt.Errorf("get LVG failed: %d", objectInfo.LocalVirtualGroupId)
+	}
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if !found {
+		return nil, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+	}
+	gvg, found := k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
+	if !found {
+		return nil, fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
+	}
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get storage price failed: %d %w", priceTime, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, priceTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator tax rate: %w, time: %d", err, priceTime)
+	}
+
+	// the store price tier before the deletion is used
+	totalChargeSize := internalBucketInfo.TotalChargeSize
+	if plan, found := k.getPrepaidPlan(ctx, internalBucketInfo); found {
+		// only the store fee exceeding the prepaid plan is paid by the payment account, so the object is charged for
+		// the decrease of it, the part already paid by the plan is not charged again
+		newLVGs := make([]*storagetypes.LocalVirtualGroup, 0, len(internalBucketInfo.LocalVirtualGroups))
+		for _, l := range internalBucketInfo.LocalVirtualGroups {
+			newLVG := *l
+			if l.Id == lvg.Id {
+				newLVG.SubChargeSize(chargeSize, objectInfo.RedundancyType)
+			}
+			newLVGs = append(newLVGs, &newLVG)
+		}
+		_, preExcessLVGs := splitLVGsByPrepaidPlan(internalBucketInfo.LocalVirtualGroups, plan.ChargeSize)
+		_, newExcessLVGs := splitLVGsByPrepaidPlan(newLVGs, plan.ChargeSize)
+		preOutFlows, err := k.calculateBucketStoreBill(ctx, price, versionedParams, gvgFamily,
+			&storagetypes.InternalBucketInfo{TotalChargeSize: totalChargeSize, LocalVirtualGroups: preExcessLVGs})
+		if err != nil {
+			return nil, err
+		}
+		newOutFlows, err := k.calculateBucketStoreBill(ctx, price, versionedParams, gvgFamily,
+			&storagetypes.InternalBucketInfo{TotalChargeSize: totalChargeSize, LocalVirtualGroups: newExcessLVGs})
+		if err != nil {
+			return nil, err
+		}
+		objectFlows := make([]types.OutFlow, 0)
+		for _, flow := range k.paymentKeeper.MergeOutFlows(append(getNegFlows(preOutFlows), newOutFlows...)) {
+			if flow.Rate.IsNegative() {
+				objectFlows = append(objectFlows, flow)
+			}
+		}
+		return objectFlows, nil
+	}
+
+	newLVG := *lvg
+	newLVG.SubChargeSize(chargeSize, objectInfo.RedundancyType)
+	preOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg, totalChargeSize)
+	newOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, &newLVG, totalChargeSize)
+	return k.paymentKeeper.MergeOutFlows(append(getNegFlows(preOutFlows), newOutFlows...)), nil
 }
 
 func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
@@ -271,7 +462,7 @@ func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.
 	changeFunc func(bi *storagetypes.BucketInfo, ibi *storagetypes.InternalBucketInfo) error) error {
 
 	// get previous bill
-	prevBill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+	prevBills, err := k.GetBucketReadStoreBills(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
 		return fmt.Errorf("charge via bucket change failed, get bucket bill failed, bucket: %s, err: %s", bucketInfo.BucketName, err.Error())
 	}
@@ -281,13 +472,13 @@ func (k Keeper) ChargeViaBucketChange(ctx sdk.Context, bucketInfo *storagetypes.
 	}
 	// calculate new bill
 	internalBucketInfo.PriceTime = ctx.BlockTime().Unix()
-	newBill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+	newBills, err := k.GetBucketReadStoreBills(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
 		return fmt.Errorf("get new bucket bill failed: %s %w", bucketInfo.BucketName, err)
 	}
 
 	// charge according to bill change
-	err = k.ApplyBillChanges(ctx, prevBill, newBill)
+	err = k.ApplyBillChanges(ctx, bucketInfo.Id, prevBills, newBills)
 	if err != nil {
 		ctx.Logger().Error("charge via bucket change failed", "bucket", bucketInfo.BucketName, "err", err.Error())
 		return err
@@ -329,16 +520,16 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 		return nil, fmt.Errorf("failed to get validator tax rate: %w, time: %d", err, internalBucketInfo.PriceTime)
 	}
 
-	preOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+	preOutFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)
 	var newOutFlows []types.OutFlow
 	if !delete { // seal object
 		internalBucketInfo.TotalChargeSize = internalBucketInfo.TotalChargeSize + chargeSize
-		lvg.TotalChargeSize = lvg.TotalChargeSize + chargeSize
-		newOutFlows = k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+		lvg.AddChargeSize(chargeSize, objectInfo.RedundancyType)
+		newOutFlows = k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)
 	} else { // delete object
 		internalBucketInfo.TotalChargeSize = internalBucketInfo.TotalChargeSize - chargeSize
-		lvg.TotalChargeSize = lvg.TotalChargeSize - chargeSize
-		newOutFlows = k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
+		lvg.SubChargeSize(chargeSize, objectInfo.RedundancyType)
+		newOutFlows = k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)
 	}
 
 	userFlows.Flows = append(userFlows.Flows, getNegFlows(preOutFlows)...)
@@ -349,16 +540,24 @@ func (k Keeper) ChargeViaObjectChange(ctx sdk.Context, bucketInfo *storagetypes.
 			"object", objectInfo.ObjectName, "err", err.Error())
 		return nil, err
 	}
+	k.recordBucketFlows(ctx, bucketInfo.Id, []types.UserFlows{userFlows})
 	// merge outflows for early deletion usage
 	return k.paymentKeeper.MergeOutFlows(userFlows.Flows), nil
 }
 
+// calculateLVGStoreBill calculates the store bill of the lvg, the store price tier is picked by the total charge size
+// of the bucket, the objects of each redundancy type are charged by the tier of the type.
 func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpStorePrice, params types.VersionedParams,
-	gvgFamily *vgtypes.GlobalVirtualGroupFamily, gvg *vgtypes.GlobalVirtualGroup, lvg *storagetypes.LocalVirtualGroup) []types.OutFlow {
+	gvgFamily *vgtypes.GlobalVirtualGroupFamily, gvg *vgtypes.GlobalVirtualGroup, lvg *storagetypes.LocalVirtualGroup,
+	totalChargeSize uint64) []types.OutFlow {
 	outFlows := make([]types.OutFlow, 0)
+	ecPrimaryStorePrice, ecSecondaryStorePrice := price.GetStorePriceByChargeSize(totalChargeSize, int32(storagetypes.REDUNDANCY_EC_TYPE))
+	replicaPrimaryStorePrice, replicaSecondaryStorePrice := price.GetStorePriceByChargeSize(totalChargeSize, int32(storagetypes.REDUNDANCY_REPLICA_TYPE))
+	ecChargeSize := sdkmath.NewIntFromUint64(lvg.GetECChargeSize())
+	replicaChargeSize := sdkmath.NewIntFromUint64(lvg.ReplicaChargeSize)
 
 	// primary sp
-	primaryStoreFlowRate := price.PrimaryStorePrice.MulInt(sdkmath.NewIntFromUint64(lvg.TotalChargeSize)).TruncateInt()
+	primaryStoreFlowRate := ecPrimaryStorePrice.MulInt(ecChargeSize).Add(replicaPrimaryStorePrice.MulInt(replicaChargeSize)).TruncateInt()
 	if primaryStoreFlowRate.IsPositive() {
 		outFlows = append(outFlows, types.OutFlow{
 			ToAddress: gvgFamily.VirtualPaymentAddress,
@@ -367,7 +566,7 @@ func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpSto
 	}
 
 	//secondary sp
-	secondaryStoreFlowRate := price.SecondaryStorePrice.MulInt(sdkmath.NewIntFromUint64(lvg.TotalChargeSize)).TruncateInt()
+	secondaryStoreFlowRate := ecSecondaryStorePrice.MulInt(ecChargeSize).Add(replicaSecondaryStorePrice.MulInt(replicaChargeSize)).TruncateInt()
 	secondaryStoreFlowRate = secondaryStoreFlowRate.MulRaw(int64(len(gvg.SecondarySpIds)))
 	if secondaryStoreFlowRate.IsPositive() {
 		outFlows = append(outFlows, types.OutFlow{
@@ -387,6 +586,37 @@ func (k Keeper) calculateLVGStoreBill(ctx sdk.Context, price sptypes.GlobalSpSto
 	return outFlows
 }
 
+func (k Keeper) calculateReadBill(price sptypes.GlobalSpStorePrice, params types.VersionedParams,
+	gvgFamily *vgtypes.GlobalVirtualGroupFamily, chargedReadQuota uint64) []types.OutFlow {
+	return k.calculateReadFlows(price, params, gvgFamily.VirtualPaymentAddress, chargedReadQuota)
+}
+
+// calculateReadFlows returns the flows of the read quota, which are paid to the address of the primary sp and
+// the validator tax pool.
+func (k Keeper) calculateReadFlows(price sptypes.GlobalSpStorePrice, params types.VersionedParams,
+	spAddress string, readQuota uint64) []types.OutFlow {
+	outFlows := make([]types.OutFlow, 0)
+
+	// primary sp
+	primaryReadFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(readQuota)).TruncateInt()
+	if primaryReadFlowRate.IsPositive() {
+		outFlows = append(outFlows, types.OutFlow{
+			ToAddress: spAddress,
+			Rate:      primaryReadFlowRate,
+		})
+	}
+
+	validatorTaxReadFlowRate := params.ValidatorTaxRate.MulInt(primaryReadFlowRate).TruncateInt()
+	if validatorTaxReadFlowRate.IsPositive() {
+		outFlows = append(outFlows, types.OutFlow{
+			ToAddress: types.ValidatorTaxPoolAddress.String(),
+			Rate:      validatorTaxReadFlowRate,
+		})
+	}
+
+	return outFlows
+}
+
 func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) (userFlows types.UserFlows, err error) {
 	userFlows.From = sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress)
@@ -407,75 +637,236 @@ func (k Keeper) GetBucketReadStoreBill(ctx sdk.Context, bucketInfo *storagetypes
 		return userFlows, fmt.Errorf("get storage price failed: %d %w", internalBucketInfo.PriceTime, err)
 	}
 
-	primaryReadFlowRate := price.ReadPrice.MulInt(sdkmath.NewIntFromUint64(bucketInfo.ChargedReadQuota)).TruncateInt()
-	if primaryReadFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
-			ToAddress: gvgFamily.VirtualPaymentAddress,
-			Rate:      primaryReadFlowRate,
-		})
-	}
-
 	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, internalBucketInfo.PriceTime)
 	if err != nil {
 		return userFlows, fmt.Errorf("failed to get validator tax rate: %d %w", internalBucketInfo.PriceTime, err)
 	}
-	validatorTaxReadFlowRate := versionedParams.ValidatorTaxRate.MulInt(primaryReadFlowRate).TruncateInt()
-	if validatorTaxReadFlowRate.IsPositive() {
-		userFlows.Flows = append(userFlows.Flows, types.OutFlow{
-			ToAddress: types.ValidatorTaxPoolAddress.String(),
-			Rate:      validatorTaxReadFlowRate,
-		})
-	}
+	userFlows.Flows = append(userFlows.Flows, k.calculateReadBill(price, versionedParams, gvgFamily, bucketInfo.ChargedReadQuota)...)
 
 	// calculate store fee
+	storeFlows, err := k.calculateBucketStoreBill(ctx, price, versionedParams, gvgFamily, internalBucketInfo)
+	if err != nil {
+		return userFlows, err
+	}
+	userFlows.Flows = append(userFlows.Flows, storeFlows...)
+
+	return userFlows, nil
+}
+
+func (k Keeper) calculateBucketStoreBill(ctx sdk.Context, price sptypes.GlobalSpStorePrice, params types.VersionedParams,
+	gvgFamily *vgtypes.GlobalVirtualGroupFamily, internalBucketInfo *storagetypes.InternalBucketInfo) ([]types.OutFlow, error) {
+	outFlows := make([]types.OutFlow, 0)
 	// be noted, here we split the fee calculation for each lvg, to make sure each lvg's calculation is precise
 	for _, lvg := range internalBucketInfo.LocalVirtualGroups {
 		//secondary sp
 		gvg, found := k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
 		if !found {
-			return userFlows, fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
+			return nil, fmt.Errorf("get GVG failed: %d, %s", lvg.GlobalVirtualGroupId, lvg.String())
 		}
-		outFlows := k.calculateLVGStoreBill(ctx, price, versionedParams, gvgFamily, gvg, lvg)
-		userFlows.Flows = append(userFlows.Flows, outFlows...)
+		outFlows = append(outFlows, k.calculateLVGStoreBill(ctx, price, params, gvgFamily, gvg, lvg, internalBucketInfo.TotalChargeSize)...)
 	}
+	return outFlows, nil
+}
 
-	return userFlows, nil
+// GetBucketReadStoreBills returns the bills of the bucket. If the bucket has a prepaid plan, the store fee of up to the
+// charge size of the plan is paid by the plan at the price locked by it, and the read fee and the store fee exceeding
+// the plan are paid by the payment account, otherwise it is the same as GetBucketReadStoreBill.
+func (k Keeper) GetBucketReadStoreBills(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
+	internalBucketInfo *storagetypes.InternalBucketInfo) ([]types.UserFlows, error) {
+	plan, found := k.getPrepaidPlan(ctx, internalBucketInfo)
+	if !found {
+		bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+		if err != nil {
+			return nil, err
+		}
+		return []types.UserFlows{bill}, nil
+	}
+
+	coveredLVGs, excessLVGs := splitLVGsByPrepaidPlan(internalBucketInfo.LocalVirtualGroups, plan.ChargeSize)
+	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, &storagetypes.InternalBucketInfo{
+		PriceTime:          internalBucketInfo.PriceTime,
+		TotalChargeSize:    internalBucketInfo.TotalChargeSize,
+		LocalVirtualGroups: excessLVGs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storeBill := types.UserFlows{From: sdk.MustAccAddressFromHex(plan.PlanAddress)}
+	if internalBucketInfo.TotalChargeSize == 0 {
+		return []types.UserFlows{bill, storeBill}, nil
+	}
+	gvgFamily, found := k.virtualGroupKeeper.GetGVGFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	if !found {
+		return nil, fmt.Errorf("get GVG family failed: %d", bucketInfo.GlobalVirtualGroupFamilyId)
+	}
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, plan.PriceTime)
+	if err != nil {
+		return nil, fmt.Errorf("get storage price failed: %d %w", plan.PriceTime, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, plan.PriceTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator tax rate: %d %w", plan.PriceTime, err)
+	}
+	// the plan is charged by the store price tier it was purchased at
+	storeBill.Flows, err = k.calculateBucketStoreBill(ctx, price, versionedParams, gvgFamily, &storagetypes.InternalBucketInfo{
+		TotalChargeSize:    plan.ChargeSize,
+		LocalVirtualGroups: coveredLVGs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []types.UserFlows{bill, storeBill}, nil
 }
 
 func (k Keeper) UnChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
-	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+	bills, err := k.GetBucketReadStoreBills(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
 		return fmt.Errorf("get bucket bill failed: %s %s", bucketInfo.BucketName, err.Error())
 	}
-	bill.Flows = getNegFlows(bill.Flows)
-	err = k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{bill})
+	for i := range bills {
+		bills[i].Flows = getNegFlows(bills[i].Flows)
+	}
+	err = k.paymentKeeper.ApplyUserFlowsList(ctx, bills)
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
+	k.recordBucketFlows(ctx, bucketInfo.Id, bills)
 	return nil
 }
 
 func (k Keeper) ChargeBucketReadStoreFee(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo,
 	internalBucketInfo *storagetypes.InternalBucketInfo) error {
 	internalBucketInfo.PriceTime = ctx.BlockTime().Unix()
-	bill, err := k.GetBucketReadStoreBill(ctx, bucketInfo, internalBucketInfo)
+	bills, err := k.GetBucketReadStoreBills(ctx, bucketInfo, internalBucketInfo)
 	if err != nil {
 		return fmt.Errorf("get bucket bill failed: %s %s", bucketInfo.BucketName, err.Error())
 	}
-	err = k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{bill})
+	err = k.paymentKeeper.ApplyUserFlowsList(ctx, bills)
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %s %w", bucketInfo.BucketName, err)
 	}
+	k.recordBucketFlows(ctx, bucketInfo.Id, bills)
 	return nil
 }
 
-func (k Keeper) ApplyBillChanges(ctx sdk.Context, prevFlows, currentFlows types.UserFlows) error {
-	prevFlows.Flows = getNegFlows(prevFlows.Flows)
-	err := k.paymentKeeper.ApplyUserFlowsList(ctx, []types.UserFlows{prevFlows, currentFlows})
+func (k Keeper) ApplyBillChanges(ctx sdk.Context, bucketId sdkmath.Uint, prevBills, currentBills []types.UserFlows) error {
+	userFlowsList := make([]types.UserFlows, 0, len(prevBills)+len(currentBills))
+	for _, bill := range prevBills {
+		userFlowsList = append(userFlowsList, types.UserFlows{From: bill.From, Flows: getNegFlows(bill.Flows)})
+	}
+	userFlowsList = append(userFlowsList, currentBills...)
+	err := k.paymentKeeper.ApplyUserFlowsList(ctx, userFlowsList)
 	if err != nil {
 		return fmt.Errorf("apply user flows list failed: %w", err)
 	}
+	k.recordBucketFlows(ctx, bucketId, userFlowsList)
+	return nil
+}
+
+// recordBucketFlows records the flow changes of the bucket for the per-bucket line items of the billing statements,
+// which are enabled by the Hulunbeier upgrade.
+func (k Keeper) recordBucketFlows(ctx sdk.Context, bucketId sdkmath.Uint, userFlowsList []types.UserFlows) {
+	if !ctx.IsUpgraded(gnfdtypes.Hulunbeier) {
+		return
+	}
+	k.paymentKeeper.RecordBucketFlows(ctx, bucketId, userFlowsList)
+}
+
+// BackfillBucketFlows records the current bills of all the buckets as their bucket flows, which are used by the
+// per-bucket line items of the billing statements. It is used when upgrading a chain created without bucket flows.
+func (k Keeper) BackfillBucketFlows(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storagetypes.BucketByIDPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bucketInfo storagetypes.BucketInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &bucketInfo)
+		internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucketInfo.Id)
+		if !found {
+			continue
+		}
+		bills, err := k.GetBucketReadStoreBills(ctx, &bucketInfo, internalBucketInfo)
+		if err != nil {
+			return fmt.Errorf("get bucket bill failed: %s %w", bucketInfo.BucketName, err)
+		}
+		k.paymentKeeper.RecordBucketFlows(ctx, bucketInfo.Id, bills)
+	}
+	return nil
+}
+
+// BackfillReplicaChargeSize sets the replica charge size of the lvgs by their sealed objects of the replica type,
+// which are charged by the replica store price tiers. It is used when upgrading a chain created without the replica
+// charge size of lvgs. The buckets are backfilled in batches to bound the memory used.
+func (k Keeper) BackfillReplicaChargeSize(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storagetypes.BucketByIDPrefix)
+	var start []byte
+	for {
+		// collect a batch of the buckets first, the store should not be written while being iterated
+		buckets := make([]*storagetypes.BucketInfo, 0, backfillBatchSize)
+		iterator := store.Iterator(start, nil)
+		start = nil
+		for ; iterator.Valid(); iterator.Next() {
+			if len(buckets) == backfillBatchSize {
+				start = append([]byte{}, iterator.Key()...)
+				break
+			}
+			var bucketInfo storagetypes.BucketInfo
+			k.cdc.MustUnmarshal(iterator.Value(), &bucketInfo)
+			buckets = append(buckets, &bucketInfo)
+		}
+		iterator.Close()
+
+		for _, bucketInfo := range buckets {
+			if err := k.backfillBucketReplicaChargeSize(ctx, bucketInfo); err != nil {
+				return err
+			}
+		}
+		if start == nil {
+			return nil
+		}
+	}
+}
+
+// backfillBucketReplicaChargeSize sets the replica charge size of the lvgs of the bucket by its sealed objects of
+// the replica type
+func (k Keeper) backfillBucketReplicaChargeSize(ctx sdk.Context, bucketInfo *storagetypes.BucketInfo) error {
+	internalBucketInfo, found := k.GetInternalBucketInfo(ctx, bucketInfo.Id)
+	if !found {
+		return nil
+	}
+
+	replicaChargeSizes := make(map[uint32]uint64)
+	store := ctx.KVStore(k.storeKey)
+	iterator := prefix.NewStore(store, storagetypes.GetObjectKeyOnlyBucketPrefix(bucketInfo.BucketName)).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(storagetypes.GetObjectByIDKey(k.objectSeq.DecodeSequence(iterator.Value())))
+		if bz == nil {
+			continue
+		}
+		var objectInfo storagetypes.ObjectInfo
+		k.cdc.MustUnmarshal(bz, &objectInfo)
+		if objectInfo.RedundancyType != storagetypes.REDUNDANCY_REPLICA_TYPE || objectInfo.LocalVirtualGroupId == 0 {
+			continue
+		}
+		chargeSize, err := k.GetObjectChargeSize(ctx, objectInfo.PayloadSize, objectInfo.CreateAt)
+		if err != nil {
+			iterator.Close()
+			return fmt.Errorf("get charge size failed: %s %s %w", objectInfo.BucketName, objectInfo.ObjectName, err)
+		}
+		replicaChargeSizes[objectInfo.LocalVirtualGroupId] += chargeSize
+	}
+	iterator.Close()
+
+	if len(replicaChargeSizes) == 0 {
+		return nil
+	}
+	for _, lvg := range internalBucketInfo.LocalVirtualGroups {
+		lvg.ReplicaChargeSize = replicaChargeSizes[lvg.Id]
+	}
+	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
 	return nil
 }
 
@@ -526,3 +917,59 @@ func (k Keeper) GetObjectChargeSize(ctx sdk.Context, payloadSize uint64, ts int6
 		return payloadSize, nil
 	}
 }
+
+// EstimateStorageCost estimates the cost of storing an object of payloadSize with the redundancy type and charging
+// readQuota for a new bucket, with the current price and the same calculation as the bill of a bucket
+func (k Keeper) EstimateStorageCost(ctx sdk.Context, payloadSize uint64, readQuota uint64,
+	redundancyType storagetypes.RedundancyType) (*storagetypes.QueryEstimateStorageCostResponse, error) {
+	now := ctx.BlockTime().Unix()
+	lockAmount, err := k.GetObjectLockFee(ctx, now, payloadSize)
+	if err != nil {
+		return nil, err
+	}
+	chargeSize, err := k.GetObjectChargeSize(ctx, payloadSize, now)
+	if err != nil {
+		return nil, err
+	}
+	totalChargeSize, err := k.GetRedundancyChargeSize(ctx, chargeSize, redundancyType, now)
+	if err != nil {
+		return nil, err
+	}
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("get storage price failed: %d %w", now, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versioned params: %d %w", now, err)
+	}
+
+	outFlows := k.calculateReadBill(price, versionedParams, &vgtypes.GlobalVirtualGroupFamily{}, readQuota)
+	outFlows = append(outFlows, k.calculateExpectedStoreBill(ctx, price, versionedParams, now, chargeSize, redundancyType)...)
+	rate := sdkmath.ZeroInt()
+	for _, flow := range outFlows {
+		rate = rate.Add(flow.Rate)
+	}
+
+	return &storagetypes.QueryEstimateStorageCostResponse{
+		LockAmount:       lockAmount,
+		NetflowRateDelta: rate.Neg(),
+		ReserveBuffer:    rate.Mul(sdkmath.NewIntFromUint64(versionedParams.ReserveTime)),
+		MonthlyCost:      rate.MulRaw(storagetypes.SecondsPerMonth),
+		ChargeSize:       chargeSize,
+		TotalChargeSize:  totalChargeSize,
+	}, nil
+}
+
+// calculateExpectedStoreBill calculates the store bill of chargeSize of the redundancy type, assuming it is stored
+// on a gvg with the expected number of secondary sps
+func (k Keeper) calculateExpectedStoreBill(ctx sdk.Context, price sptypes.GlobalSpStorePrice, params types.VersionedParams,
+	priceTime int64, chargeSize uint64, redundancyType storagetypes.RedundancyType) []types.OutFlow {
+	gvgFamily := &vgtypes.GlobalVirtualGroupFamily{}
+	gvg := &vgtypes.GlobalVirtualGroup{
+		SecondarySpIds: make([]uint32, k.GetExpectSecondarySPNumForECObject(ctx, priceTime)),
+	}
+	lvg := &storagetypes.LocalVirtualGroup{}
+	lvg.AddChargeSize(chargeSize, redundancyType)
+	return k.calculateLVGStoreBill(ctx, price, params, gvgFamily, gvg, lvg, chargeSize)
+}
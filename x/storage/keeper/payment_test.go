@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/testutil/upgrade"
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
 	"github.com/bnb-chain/greenfield/x/challenge"
 	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
@@ -53,7 +55,7 @@ func (s *TestSuite) SetupTest() {
 		DB:  testCtx.DB,
 		CMS: testCtx.CMS,
 	}
-	s.ctx = testCtx.Ctx
+	s.ctx = upgrade.WithUpgraded(testCtx.Ctx, gnfdtypes.Hulunbeier)
 
 	ctrl := gomock.NewController(s.T())
 
@@ -126,6 +128,59 @@ func (s *TestSuite) TestGetObjectLockFee() {
 	s.Require().True(amount.Equal(expectedAmount))
 }
 
+func (s *TestSuite) TestEstimateStorageCost() {
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	payloadSize := int64(10 * 1024 * 1024)
+	readQuota := int64(1024)
+	res, err := s.storageKeeper.EstimateStorageCost(s.ctx, uint64(payloadSize), uint64(readQuota), types.REDUNDANCY_EC_TYPE)
+	s.Require().NoError(err)
+
+	lockAmount, err := s.storageKeeper.GetObjectLockFee(s.ctx, s.ctx.BlockTime().Unix(), uint64(payloadSize))
+	s.Require().NoError(err)
+	s.Require().Equal(lockAmount, res.LockAmount)
+	s.Require().Equal(uint64(payloadSize), res.ChargeSize)
+
+	secondarySPNum := int64(s.storageKeeper.GetExpectSecondarySPNumForECObject(s.ctx, s.ctx.BlockTime().Unix()))
+	storeRate := price.PrimaryStorePrice.MulInt64(payloadSize).TruncateInt().
+		Add(price.SecondaryStorePrice.MulInt64(payloadSize).TruncateInt().MulRaw(secondarySPNum))
+	readRate := price.ReadPrice.MulInt64(readQuota).TruncateInt()
+	taxRate := params.VersionedParams.ValidatorTaxRate.MulInt(storeRate).TruncateInt().
+		Add(params.VersionedParams.ValidatorTaxRate.MulInt(readRate).TruncateInt())
+	rate := storeRate.Add(readRate).Add(taxRate)
+	s.Require().Equal(rate.Neg(), res.NetflowRateDelta)
+	s.Require().Equal(rate.MulRaw(int64(params.VersionedParams.ReserveTime)), res.ReserveBuffer)
+	s.Require().Equal(rate.MulRaw(types.SecondsPerMonth), res.MonthlyCost)
+	// each secondary sp stores one chunk of an EC object
+	storageParams, err := s.storageKeeper.GetVersionedParamsWithTs(s.ctx, s.ctx.BlockTime().Unix())
+	s.Require().NoError(err)
+	dataChunkNum := int64(storageParams.RedundantDataChunkNum)
+	s.Require().Equal(uint64(payloadSize+payloadSize/dataChunkNum*secondarySPNum), res.TotalChargeSize)
+
+	// each secondary sp stores a full replica of a replica object
+	res, err = s.storageKeeper.EstimateStorageCost(s.ctx, uint64(payloadSize), uint64(readQuota), types.REDUNDANCY_REPLICA_TYPE)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(payloadSize*(1+secondarySPNum)), res.TotalChargeSize)
+
+	// the primary sp should exist
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
+		Return(nil, false)
+	_, err = s.queryClient.QueryEstimateStorageCost(s.ctx, &types.QueryEstimateStorageCostRequest{
+		PrimarySpAddress: sample.RandAccAddress().String(),
+		PayloadSize:      uint64(payloadSize),
+	})
+	s.Require().ErrorContains(err, sptypes.ErrStorageProviderNotFound.Error())
+}
+
 func (s *TestSuite) TestGetBucketReadBill() {
 	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
 		Id:                    1,
@@ -307,3 +362,448 @@ func (s *TestSuite) TestGetBucketReadStoreBill() {
 	taxPoolRate = params.VersionedParams.ValidatorTaxRate.MulInt(primaryStoreRate.Add(gvg2StoreRate)).TruncateInt()
 	s.Require().Equal(flows.Flows[7].Rate, taxPoolRate)
 }
+
+func (s *TestSuite) TestGetBucketReadStoreBillWithStorePriceTiers() {
+	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).
+		Return(gvgFamily, true).AnyTimes()
+
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+		StorePriceTiers: []sptypes.GlobalStorePriceTier{
+			{MinChargeSize: 200, PrimaryStorePrice: sdk.NewDec(800), SecondaryStorePrice: sdk.NewDec(400)},
+			{MinChargeSize: 1000, PrimaryStorePrice: sdk.NewDec(600), SecondaryStorePrice: sdk.NewDec(300)},
+			{MinChargeSize: 1000, PrimaryStorePrice: sdk.NewDec(900), SecondaryStorePrice: sdk.NewDec(450),
+				RedundancyType: int32(types.REDUNDANCY_REPLICA_TYPE)},
+		},
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	gvg := &virtualgroupmoduletypes.GlobalVirtualGroup{
+		Id:                    1,
+		SecondarySpIds:        []uint32{101, 102, 103, 104, 105, 106},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).
+		Return(gvg, true).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+
+	tests := []struct {
+		totalChargeSize uint64
+		primaryPrice    sdk.Dec
+		secondaryPrice  sdk.Dec
+	}{
+		{100, price.PrimaryStorePrice, price.SecondaryStorePrice},
+		{200, price.StorePriceTiers[0].PrimaryStorePrice, price.StorePriceTiers[0].SecondaryStorePrice},
+		{999, price.StorePriceTiers[0].PrimaryStorePrice, price.StorePriceTiers[0].SecondaryStorePrice},
+		{5000, price.StorePriceTiers[1].PrimaryStorePrice, price.StorePriceTiers[1].SecondaryStorePrice},
+	}
+	for _, tt := range tests {
+		internalBucketInfo := &types.InternalBucketInfo{
+			TotalChargeSize: tt.totalChargeSize,
+			LocalVirtualGroups: []*types.LocalVirtualGroup{
+				{Id: 1, TotalChargeSize: tt.totalChargeSize, GlobalVirtualGroupId: gvg.Id},
+			},
+		}
+		flows, err := s.storageKeeper.GetBucketReadStoreBill(s.ctx, bucketInfo, internalBucketInfo)
+		s.Require().NoError(err)
+
+		primaryStoreRate := tt.primaryPrice.MulInt64(int64(tt.totalChargeSize)).TruncateInt()
+		s.Require().Equal(gvgFamily.VirtualPaymentAddress, flows.Flows[0].ToAddress)
+		s.Require().Equal(primaryStoreRate, flows.Flows[0].Rate)
+
+		secondaryStoreRate := tt.secondaryPrice.MulInt64(int64(tt.totalChargeSize)).TruncateInt().
+			MulRaw(int64(len(gvg.SecondarySpIds)))
+		s.Require().Equal(gvg.VirtualPaymentAddress, flows.Flows[1].ToAddress)
+		s.Require().Equal(secondaryStoreRate, flows.Flows[1].Rate)
+	}
+
+	// the replica objects are charged by the tier of the replica type
+	internalBucketInfo := &types.InternalBucketInfo{
+		TotalChargeSize: 5000,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, TotalChargeSize: 5000, ReplicaChargeSize: 2000, GlobalVirtualGroupId: gvg.Id},
+		},
+	}
+	flows, err := s.storageKeeper.GetBucketReadStoreBill(s.ctx, bucketInfo, internalBucketInfo)
+	s.Require().NoError(err)
+	primaryStoreRate := price.StorePriceTiers[1].PrimaryStorePrice.MulInt64(3000).
+		Add(price.StorePriceTiers[2].PrimaryStorePrice.MulInt64(2000)).TruncateInt()
+	s.Require().Equal(primaryStoreRate, flows.Flows[0].Rate)
+	secondaryStoreRate := price.StorePriceTiers[1].SecondaryStorePrice.MulInt64(3000).
+		Add(price.StorePriceTiers[2].SecondaryStorePrice.MulInt64(2000)).TruncateInt().
+		MulRaw(int64(len(gvg.SecondarySpIds)))
+	s.Require().Equal(secondaryStoreRate, flows.Flows[1].Rate)
+}
+
+func (s *TestSuite) TestIsPriceChangedWithStorePriceTiers() {
+	prePrice := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+		StorePriceTiers: []sptypes.GlobalStorePriceTier{
+			{MinChargeSize: 200, PrimaryStorePrice: sdk.NewDec(800), SecondaryStorePrice: sdk.NewDec(400)},
+		},
+	}
+	currentPrice := prePrice
+	currentPrice.StorePriceTiers = []sptypes.GlobalStorePriceTier{
+		{MinChargeSize: 200, PrimaryStorePrice: sdk.NewDec(700), SecondaryStorePrice: sdk.NewDec(400)},
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), int64(1)).Return(prePrice, nil).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), s.ctx.BlockTime().Unix()).Return(currentPrice, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	// only the tier changes, the base prices are the same
+	changed, _, _, _, _, err := s.storageKeeper.IsPriceChanged(s.ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().True(changed)
+
+	changed, _, _, _, _, err = s.storageKeeper.IsPriceChanged(s.ctx, 1, s.ctx.BlockTime().Unix())
+	s.Require().NoError(err)
+	s.Require().False(changed)
+}
+
+func (s *TestSuite) TestGetPrepaidPlanAmount() {
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+		StorePriceTiers: []sptypes.GlobalStorePriceTier{
+			{MinChargeSize: 1000, PrimaryStorePrice: sdk.NewDec(600), SecondaryStorePrice: sdk.NewDec(300)},
+			{MinChargeSize: 1000, PrimaryStorePrice: sdk.NewDec(900), SecondaryStorePrice: sdk.NewDec(450),
+				RedundancyType: int32(types.REDUNDANCY_REPLICA_TYPE)},
+		},
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	// 600 bytes of the ec type and 400 bytes of the replica type are stored, the other 500 bytes of the plan are
+	// priced by the replica type which is more expensive
+	internalBucketInfo := &types.InternalBucketInfo{
+		TotalChargeSize: 1000,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, TotalChargeSize: 1000, ReplicaChargeSize: 400},
+		},
+	}
+	amount, err := s.storageKeeper.GetPrepaidPlanAmount(s.ctx, internalBucketInfo, 1500, 1)
+	s.Require().NoError(err)
+
+	secondarySPNum := int64(s.storageKeeper.GetExpectSecondarySPNumForECObject(s.ctx, s.ctx.BlockTime().Unix()))
+	primaryRate := sdk.NewInt(600*600 + 900*900)
+	secondaryRate := sdk.NewInt(300*600 + 450*900).MulRaw(secondarySPNum)
+	taxRate := params.VersionedParams.ValidatorTaxRate.MulInt(primaryRate.Add(secondaryRate)).TruncateInt()
+	duration := types.SecondsPerMonth + int64(params.VersionedParams.ReserveTime)
+	s.Require().Equal(primaryRate.Add(secondaryRate).Add(taxRate).MulRaw(duration), amount)
+
+	// the plan of an empty bucket is priced by the more expensive redundancy type as a whole
+	amount, err = s.storageKeeper.GetPrepaidPlanAmount(s.ctx, &types.InternalBucketInfo{}, 1500, 1)
+	s.Require().NoError(err)
+	primaryRate = sdk.NewInt(900 * 1500)
+	secondaryRate = sdk.NewInt(450 * 1500).MulRaw(secondarySPNum)
+	taxRate = params.VersionedParams.ValidatorTaxRate.MulInt(primaryRate.Add(secondaryRate)).TruncateInt()
+	s.Require().Equal(primaryRate.Add(secondaryRate).Add(taxRate).MulRaw(duration), amount)
+}
+
+func (s *TestSuite) TestGetBucketReadStoreBillsWithPrepaidPlan() {
+	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).
+		Return(gvgFamily, true).AnyTimes()
+	gvg := &virtualgroupmoduletypes.GlobalVirtualGroup{
+		Id:                    1,
+		SecondarySpIds:        []uint32{101, 102, 103, 104, 105, 106},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).
+		Return(gvg, true).AnyTimes()
+
+	// the price is doubled after the plan is purchased
+	planPrice := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}
+	currentPrice := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(200),
+		PrimaryStorePrice:   sdk.NewDec(2000),
+		SecondaryStorePrice: sdk.NewDec(1000),
+	}
+	planPriceTime := s.ctx.BlockTime().Unix() - 100
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), planPriceTime).
+		Return(planPrice, nil).AnyTimes()
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(currentPrice, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	plan := &paymenttypes.PrepaidPlan{
+		Id:          1,
+		PlanAddress: paymenttypes.PrepaidPlanAddress(1).String(),
+		ChargeSize:  150,
+		PriceTime:   planPriceTime,
+	}
+	s.paymentKeeper.EXPECT().GetPrepaidPlan(gomock.Any(), plan.Id).
+		Return(plan, true).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+		ChargedReadQuota:           100,
+	}
+	lvg := &types.LocalVirtualGroup{
+		Id:                   1,
+		TotalChargeSize:      100,
+		GlobalVirtualGroupId: gvg.Id,
+	}
+	internalBucketInfo := &types.InternalBucketInfo{
+		PriceTime:          s.ctx.BlockTime().Unix(),
+		TotalChargeSize:    100,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{lvg},
+		PrepaidPlanId:      plan.Id,
+	}
+
+	// the read fee is paid by the payment account at the current price, the store fee is paid by the plan
+	bills, err := s.storageKeeper.GetBucketReadStoreBills(s.ctx, bucketInfo, internalBucketInfo)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(bills))
+	s.Require().Equal(bucketInfo.PaymentAddress, bills[0].From.String())
+	readRate := currentPrice.ReadPrice.MulInt64(int64(bucketInfo.ChargedReadQuota)).TruncateInt()
+	s.Require().Equal(readRate, bills[0].Flows[0].Rate)
+	s.Require().Equal(plan.PlanAddress, bills[1].From.String())
+	primaryStoreRate := planPrice.PrimaryStorePrice.MulInt64(int64(lvg.TotalChargeSize)).TruncateInt()
+	s.Require().Equal(gvgFamily.VirtualPaymentAddress, bills[1].Flows[0].ToAddress)
+	s.Require().Equal(primaryStoreRate, bills[1].Flows[0].Rate)
+
+	// the plan is exceeded, only the exceeding part is streamed from the payment account at the current price
+	internalBucketInfo.TotalChargeSize = 200
+	lvg.TotalChargeSize = 200
+	bills, err = s.storageKeeper.GetBucketReadStoreBills(s.ctx, bucketInfo, internalBucketInfo)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(bills))
+	s.Require().Equal(bucketInfo.PaymentAddress, bills[0].From.String())
+	s.Require().Equal(readRate, bills[0].Flows[0].Rate)
+	excessPrimaryStoreRate := currentPrice.PrimaryStorePrice.MulInt64(int64(lvg.TotalChargeSize - plan.ChargeSize)).TruncateInt()
+	s.Require().Equal(gvgFamily.VirtualPaymentAddress, bills[0].Flows[2].ToAddress)
+	s.Require().Equal(excessPrimaryStoreRate, bills[0].Flows[2].Rate)
+	s.Require().Equal(plan.PlanAddress, bills[1].From.String())
+	coveredPrimaryStoreRate := planPrice.PrimaryStorePrice.MulInt64(int64(plan.ChargeSize)).TruncateInt()
+	s.Require().Equal(coveredPrimaryStoreRate, bills[1].Flows[0].Rate)
+}
+
+func (s *TestSuite) TestRebindBucketToFamily() {
+	srcFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	dstFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    2,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), srcFamily.Id).
+		Return(srcFamily, true).AnyTimes()
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), dstFamily.Id).
+		Return(dstFamily, true).AnyTimes()
+	gvg := &virtualgroupmoduletypes.GlobalVirtualGroup{
+		Id:                    1,
+		FamilyId:              srcFamily.Id,
+		SecondarySpIds:        []uint32{101, 102, 103, 104, 105, 106},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).
+		DoAndReturn(func(_ sdk.Context, _ uint32) (*virtualgroupmoduletypes.GlobalVirtualGroup, bool) {
+			return gvg, true
+		}).AnyTimes()
+
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: srcFamily.Id,
+		ChargedReadQuota:           100,
+		BucketStatus:               types.BUCKET_STATUS_CREATED,
+	}
+	s.storageKeeper.SetBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{
+		PriceTime:       s.ctx.BlockTime().Unix(),
+		TotalChargeSize: 100,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{
+			{Id: 1, TotalChargeSize: 100, StoredSize: 80, GlobalVirtualGroupId: gvg.Id},
+		},
+	})
+	operator := sample.RandAccAddress()
+
+	// the gvg storing objects of the bucket is not moved yet
+	_, err := s.storageKeeper.RebindBucketToFamily(s.ctx, operator, bucketInfo.Id, srcFamily.Id, dstFamily.Id)
+	s.Require().ErrorIs(err, types.ErrInvalidGlobalVirtualGroup)
+
+	// the store fee of the primary sp is moved from the source family to the destination family
+	gvg.FamilyId = dstFamily.Id
+	var userFlowsList []paymenttypes.UserFlows
+	s.paymentKeeper.EXPECT().ApplyUserFlowsList(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, list []paymenttypes.UserFlows) error {
+			userFlowsList = list
+			return nil
+		})
+	s.paymentKeeper.EXPECT().RecordBucketFlows(gomock.Any(), bucketInfo.Id, gomock.Any())
+	storedSizes, err := s.storageKeeper.RebindBucketToFamily(s.ctx, operator, bucketInfo.Id, srcFamily.Id, dstFamily.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(map[uint32]uint64{gvg.Id: 80}, storedSizes)
+	s.Require().Equal(2, len(userFlowsList))
+	primaryStoreRate := price.PrimaryStorePrice.MulInt64(100).TruncateInt()
+	s.Require().Equal(srcFamily.VirtualPaymentAddress, userFlowsList[0].Flows[2].ToAddress)
+	s.Require().Equal(primaryStoreRate.Neg(), userFlowsList[0].Flows[2].Rate)
+	s.Require().Equal(dstFamily.VirtualPaymentAddress, userFlowsList[1].Flows[2].ToAddress)
+	s.Require().Equal(primaryStoreRate, userFlowsList[1].Flows[2].Rate)
+
+	bucketInfo, found := s.storageKeeper.GetBucketInfoById(s.ctx, bucketInfo.Id)
+	s.Require().True(found)
+	s.Require().Equal(dstFamily.Id, bucketInfo.GlobalVirtualGroupFamilyId)
+
+	// the bucket is not served by the source family any more
+	_, err = s.storageKeeper.RebindBucketToFamily(s.ctx, operator, bucketInfo.Id, srcFamily.Id, dstFamily.Id)
+	s.Require().ErrorIs(err, types.ErrInvalidGlobalVirtualGroup)
+}
+
+func (s *TestSuite) TestGetEarlyDeletionPenalty() {
+	gvgFamily := &virtualgroupmoduletypes.GlobalVirtualGroupFamily{
+		Id:                    1,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVGFamily(gomock.Any(), gomock.Any()).
+		Return(gvgFamily, true).AnyTimes()
+	gvg := &virtualgroupmoduletypes.GlobalVirtualGroup{
+		Id:                    1,
+		SecondarySpIds:        []uint32{101, 102, 103, 104, 105, 106},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	}
+	s.virtualGroupKeeper.EXPECT().GetGVG(gomock.Any(), gvg.Id).
+		Return(gvg, true).AnyTimes()
+
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(100),
+		PrimaryStorePrice:   sdk.NewDec(1000),
+		SecondaryStorePrice: sdk.NewDec(500),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+
+	s.paymentKeeper.EXPECT().MergeOutFlows(gomock.Any()).
+		DoAndReturn(func(flows []paymenttypes.OutFlow) []paymenttypes.OutFlow {
+			merged := make([]paymenttypes.OutFlow, 0)
+			for _, flow := range flows {
+				found := false
+				for i := range merged {
+					if merged[i].ToAddress == flow.ToAddress {
+						merged[i].Rate = merged[i].Rate.Add(flow.Rate)
+						found = true
+					}
+				}
+				if !found {
+					merged = append(merged, flow)
+				}
+			}
+			return merged
+		}).AnyTimes()
+
+	payloadSize := s.storageKeeper.GetParams(s.ctx).VersionedParams.MinChargeSize * 2
+	// the plan covers half of the bucket, the object is in the part exceeding it
+	plan := &paymenttypes.PrepaidPlan{
+		Id:          1,
+		PlanAddress: paymenttypes.PrepaidPlanAddress(1).String(),
+		ChargeSize:  payloadSize,
+		PriceTime:   s.ctx.BlockTime().Unix(),
+	}
+	s.paymentKeeper.EXPECT().GetPrepaidPlan(gomock.Any(), plan.Id).
+		Return(plan, true).AnyTimes()
+
+	bucketInfo := &types.BucketInfo{
+		BucketName:                 "bucketname",
+		Id:                         sdk.NewUint(1),
+		PaymentAddress:             sample.RandAccAddress().String(),
+		GlobalVirtualGroupFamilyId: gvgFamily.Id,
+	}
+	internalBucketInfo := &types.InternalBucketInfo{
+		PriceTime:       s.ctx.BlockTime().Unix(),
+		TotalChargeSize: payloadSize * 2,
+		LocalVirtualGroups: []*types.LocalVirtualGroup{{
+			Id:                   1,
+			TotalChargeSize:      payloadSize * 2,
+			GlobalVirtualGroupId: gvg.Id,
+		}},
+		PrepaidPlanId: plan.Id,
+	}
+	elapsed := int64(100)
+	objectInfo := &types.ObjectInfo{
+		BucketName:          bucketInfo.BucketName,
+		ObjectName:          "objectname",
+		PayloadSize:         payloadSize,
+		LocalVirtualGroupId: 1,
+		CreateAt:            s.ctx.BlockTime().Unix() - elapsed,
+	}
+
+	storageParams := s.storageKeeper.GetParams(s.ctx)
+	err := s.storageKeeper.SetVersionedParamsWithTs(s.ctx.WithBlockTime(time.Unix(0, 0)), storageParams.VersionedParams)
+	s.Require().NoError(err)
+
+	duration, amount, err := s.storageKeeper.GetEarlyDeletionPenalty(s.ctx, bucketInfo, internalBucketInfo, objectInfo)
+	s.Require().NoError(err)
+	expectedDuration := int64(params.VersionedParams.ReserveTime) - elapsed
+	s.Require().Equal(expectedDuration, duration)
+	primaryStoreRate := price.PrimaryStorePrice.MulInt64(int64(payloadSize)).TruncateInt()
+	secondaryStoreRate := price.SecondaryStorePrice.MulInt64(int64(payloadSize)).TruncateInt().MulRaw(int64(len(gvg.SecondarySpIds)))
+	taxRate := params.VersionedParams.ValidatorTaxRate.MulInt(primaryStoreRate.Add(secondaryStoreRate)).TruncateInt()
+	s.Require().Equal(primaryStoreRate.Add(secondaryStoreRate).Add(taxRate).MulRaw(expectedDuration), amount)
+
+	// no penalty if the object is already paid by the plan
+	plan.ChargeSize = payloadSize * 2
+	duration, amount, err = s.storageKeeper.GetEarlyDeletionPenalty(s.ctx, bucketInfo, internalBucketInfo, objectInfo)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedDuration, duration)
+	s.Require().True(amount.IsZero())
+
+	// no penalty after the reserve time
+	objectInfo.CreateAt = s.ctx.BlockTime().Unix() - int64(params.VersionedParams.ReserveTime)
+	duration, amount, err = s.storageKeeper.GetEarlyDeletionPenalty(s.ctx, bucketInfo, internalBucketInfo, objectInfo)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), duration)
+	s.Require().True(amount.IsZero())
+}
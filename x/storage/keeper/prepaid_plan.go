@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+	vgtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// CreatePrepaidPlan purchases a prepaid plan for the bucket, which pays for the store fee of up to chargeSize bytes
+// for the given months at the current price. The plan is paid upfront by the payment account of the bucket.
+func (k Keeper) CreatePrepaidPlan(ctx sdk.Context, operator sdk.AccAddress, bucketName string, chargeSize uint64, months uint32) (uint64, error) {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return 0, types.ErrNoSuchBucket
+	}
+	if bucketInfo.Owner != operator.String() {
+		return 0, types.ErrAccessDenied.Wrapf("only the bucket owner can create a prepaid plan for the bucket(%s)", bucketName)
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return 0, err
+	}
+
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	if internalBucketInfo.PrepaidPlanId != 0 {
+		return 0, types.ErrPrepaidPlanExists.Wrapf("plan id: %d", internalBucketInfo.PrepaidPlanId)
+	}
+
+	amount, err := k.GetPrepaidPlanAmount(ctx, internalBucketInfo, chargeSize, months)
+	if err != nil {
+		return 0, err
+	}
+	duration := int64(months) * types.SecondsPerMonth
+	plan, err := k.paymentKeeper.CreatePrepaidPlan(ctx, sdk.MustAccAddressFromHex(bucketInfo.PaymentAddress),
+		bucketInfo.Id, chargeSize, duration, amount)
+	if err != nil {
+		return 0, types.ErrChargeFailed.Wrapf("create prepaid plan failed: %s", err)
+	}
+
+	err = k.ChargeViaBucketChange(ctx, bucketInfo, internalBucketInfo, func(bi *types.BucketInfo, ibi *types.InternalBucketInfo) error {
+		ibi.PrepaidPlanId = plan.Id
+		return nil
+	})
+	if err != nil {
+		return 0, types.ErrChargeFailed.Wrapf("charge via prepaid plan failed: %s", err)
+	}
+	k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+	return plan.Id, nil
+}
+
+// GetPrepaidPlanAmount returns the amount to pay upfront for a prepaid plan of the bucket covering chargeSize bytes for
+// the given months at the current store price. The stored objects covered by the plan are priced by their redundancy
+// types, and the rest of the charge size is priced by the more expensive redundancy type, since the redundancy type of
+// the objects to store is unknown. It includes the reserve for the covered flows, the unused amount is refunded when
+// the plan is closed.
+func (k Keeper) GetPrepaidPlanAmount(ctx sdk.Context, internalBucketInfo *types.InternalBucketInfo, chargeSize uint64,
+	months uint32) (sdkmath.Int, error) {
+	now := ctx.BlockTime().Unix()
+	price, err := k.spKeeper.GetGlobalSpStorePriceByTime(ctx, now)
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("get storage price failed: %d %w", now, err)
+	}
+	versionedParams, err := k.paymentKeeper.GetVersionedParamsWithTs(ctx, now)
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("failed to get versioned params: %d %w", now, err)
+	}
+
+	covered := &types.LocalVirtualGroup{}
+	coveredLVGs, _ := splitLVGsByPrepaidPlan(internalBucketInfo.LocalVirtualGroups, chargeSize)
+	for _, lvg := range coveredLVGs {
+		covered.TotalChargeSize += lvg.TotalChargeSize
+		covered.ReplicaChargeSize += lvg.ReplicaChargeSize
+	}
+	rate := sdkmath.ZeroInt()
+	for _, redundancyType := range []types.RedundancyType{types.REDUNDANCY_EC_TYPE, types.REDUNDANCY_REPLICA_TYPE} {
+		lvg := *covered
+		lvg.AddChargeSize(chargeSize-covered.TotalChargeSize, redundancyType)
+		rate = sdkmath.MaxInt(rate, k.getPrepaidPlanRate(ctx, price, versionedParams, now, &lvg, chargeSize))
+	}
+	duration := int64(months)*types.SecondsPerMonth + int64(versionedParams.ReserveTime)
+	return rate.MulRaw(duration), nil
+}
+
+// getPrepaidPlanRate returns the total rate of the store flows of the lvg, priced by the tier of the charge size
+func (k Keeper) getPrepaidPlanRate(ctx sdk.Context, price sptypes.GlobalSpStorePrice, params paymenttypes.VersionedParams,
+	priceTime int64, lvg *types.LocalVirtualGroup, chargeSize uint64) sdkmath.Int {
+	gvg := &vgtypes.GlobalVirtualGroup{
+		SecondarySpIds: make([]uint32, k.GetExpectSecondarySPNumForECObject(ctx, priceTime)),
+	}
+	rate := sdkmath.ZeroInt()
+	for _, flow := range k.calculateLVGStoreBill(ctx, price, params, &vgtypes.GlobalVirtualGroupFamily{}, gvg, lvg, chargeSize) {
+		rate = rate.Add(flow.Rate)
+	}
+	return rate
+}
+
+// getPrepaidPlan returns the prepaid plan of the bucket
+func (k Keeper) getPrepaidPlan(ctx sdk.Context, internalBucketInfo *types.InternalBucketInfo) (*paymenttypes.PrepaidPlan, bool) {
+	if internalBucketInfo.PrepaidPlanId == 0 {
+		return nil, false
+	}
+	return k.paymentKeeper.GetPrepaidPlan(ctx, internalBucketInfo.PrepaidPlanId)
+}
+
+// splitLVGsByPrepaidPlan splits the charge size of the lvgs into the part covered by a prepaid plan and the part
+// exceeding it, the plan covers the lvgs in order until its charge size is used up. The objects of the ec type in a
+// lvg are covered before the ones of the replica type.
+func splitLVGsByPrepaidPlan(lvgs []*types.LocalVirtualGroup, planChargeSize uint64) (covered, excess []*types.LocalVirtualGroup) {
+	left := planChargeSize
+	for _, lvg := range lvgs {
+		coveredLVG, excessLVG := *lvg, *lvg
+		if coveredLVG.TotalChargeSize > left {
+			coveredLVG.TotalChargeSize = left
+		}
+		coveredLVG.ReplicaChargeSize = 0
+		if coveredLVG.TotalChargeSize > lvg.GetECChargeSize() {
+			coveredLVG.ReplicaChargeSize = coveredLVG.TotalChargeSize - lvg.GetECChargeSize()
+		}
+		excessLVG.TotalChargeSize = lvg.TotalChargeSize - coveredLVG.TotalChargeSize
+		excessLVG.ReplicaChargeSize = lvg.ReplicaChargeSize - coveredLVG.ReplicaChargeSize
+		left -= coveredLVG.TotalChargeSize
+		covered = append(covered, &coveredLVG)
+		excess = append(excess, &excessLVG)
+	}
+	return covered, excess
+}
+
+// ExpirePrepaidPlans closes the expired prepaid plans, the store fee of the covered buckets falls back to
+// be streamed from their payment accounts. If a plan fails to be closed, its expiry is postponed by
+// PrepaidPlanExpiryRetryInterval and it keeps covering the bucket until then.
+func (k Keeper) ExpirePrepaidPlans(ctx sdk.Context) {
+	for _, plan := range k.paymentKeeper.GetExpiredPrepaidPlans(ctx, types.MaxExpiredPrepaidPlanCount) {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.expirePrepaidPlan(cacheCtx, plan.Id, plan.BucketId); err != nil {
+			ctx.Logger().Error("fail to close expired prepaid plan", "plan", plan.Id, "bucket", plan.BucketId.String(), "err", err.Error())
+			k.paymentKeeper.PostponePrepaidPlanExpiry(ctx, plan.Id, ctx.BlockTime().Unix()+types.PrepaidPlanExpiryRetryInterval)
+			continue
+		}
+		write()
+	}
+}
+
+func (k Keeper) expirePrepaidPlan(ctx sdk.Context, planId uint64, bucketId sdkmath.Uint) error {
+	bucketInfo, found := k.GetBucketInfoById(ctx, bucketId)
+	if found {
+		internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+		if internalBucketInfo.PrepaidPlanId == planId {
+			err := k.ChargeViaBucketChange(ctx, bucketInfo, internalBucketInfo, func(bi *types.BucketInfo, ibi *types.InternalBucketInfo) error {
+				ibi.PrepaidPlanId = 0
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("charge bucket for expired prepaid plan failed: %w", err)
+			}
+			k.SetInternalBucketInfo(ctx, bucketInfo.Id, internalBucketInfo)
+		}
+	}
+	return k.paymentKeeper.ClosePrepaidPlan(ctx, planId)
+}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// GetBucketReadRecord returns the read receipts record of the bucket
+func (k Keeper) GetBucketReadRecord(ctx sdk.Context, bucketID sdkmath.Uint) (*types.BucketReadRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetBucketReadRecordKey(bucketID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var record types.BucketReadRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+func (k Keeper) setBucketReadRecord(ctx sdk.Context, bucketID sdkmath.Uint, record *types.BucketReadRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(record)
+	store.Set(types.GetBucketReadRecordKey(bucketID), bz)
+}
+
+// getReadQuotaPeriodStart returns the start time of the read quota period containing the timestamp, the periods last
+// a month and are aligned to the quota update time of the bucket.
+func getReadQuotaPeriodStart(quotaUpdateTime, timestamp int64) int64 {
+	return quotaUpdateTime + (timestamp-quotaUpdateTime)/types.SecondsPerMonth*types.SecondsPerMonth
+}
+
+// periodReadBytes is the receipted read bytes in a read quota period.
+type periodReadBytes struct {
+	periodStart int64
+	readBytes   uint64
+}
+
+// splitReadReceipt splits the read bytes of a receipt period into the read quota periods it spans, in proportion to the
+// overlapped time. The last period takes the remainder, so that the split read bytes sum up to the read bytes.
+func splitReadReceipt(quotaUpdateTime, startTime, endTime int64, readBytes uint64) []periodReadBytes {
+	duration := sdkmath.NewInt(endTime - startTime)
+	total := sdkmath.NewIntFromUint64(readBytes)
+
+	splits := make([]periodReadBytes, 0, 1)
+	remaining := readBytes
+	for from := startTime; ; {
+		periodStart := getReadQuotaPeriodStart(quotaUpdateTime, from)
+		to := periodStart + types.SecondsPerMonth
+		if to >= endTime {
+			splits = append(splits, periodReadBytes{periodStart: periodStart, readBytes: remaining})
+			return splits
+		}
+		split := total.MulRaw(to - from).Quo(duration).Uint64()
+		splits = append(splits, periodReadBytes{periodStart: periodStart, readBytes: split})
+		remaining -= split
+		from = to
+	}
+}
+
+// SubmitReadReceipt records an aggregated read receipt of the bucket, which is submitted by the sp serving the read
+// bytes, and signed by the bucket owner. The signed receipt is bound to the sp, the bucket id and the chain id. The
+// receipts of a sp in a bucket are ordered by nonce and cannot overlap in time, so that they cannot be replayed. The
+// read bytes are split into the read quota periods the receipt spans, and the read bytes beyond the charged read quota
+// of each period become claimable by the sp. A receipt cannot start before the current read quota period of the
+// bucket, whose read bytes may have been claimed already.
+func (k Keeper) SubmitReadReceipt(ctx sdk.Context, operator sdk.AccAddress, bucketName string, opts *types.SubmitReadReceiptOptions) error {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return types.ErrNoSuchBucket
+	}
+	if !bucketInfo.Id.Equal(opts.BucketId) {
+		return types.ErrInvalidReadReceipt.Wrapf("the receipt is signed for the bucket id %s, but the bucket id is %s", opts.BucketId, bucketInfo.Id)
+	}
+	if opts.ChainId != ctx.ChainID() {
+		return types.ErrInvalidReadReceipt.Wrapf("the receipt is signed for the chain id %s", opts.ChainId)
+	}
+	sp, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, operator)
+	if !found {
+		return sptypes.ErrStorageProviderNotFound.Wrapf("the operator %s is not a storage provider", operator.String())
+	}
+
+	err := gnfdtypes.VerifySignature(sdk.MustAccAddressFromHex(bucketInfo.Owner), sdk.Keccak256(opts.ReceiptMsgBytes), opts.OwnerSignature)
+	if err != nil {
+		return types.ErrInvalidReadReceipt.Wrapf("verify owner signature error: %s", err)
+	}
+
+	record, found := k.GetBucketReadRecord(ctx, bucketInfo.Id)
+	if !found {
+		record = &types.BucketReadRecord{}
+	}
+	sequence := getSpReadSequence(record, sp.Id)
+	if opts.Nonce != sequence.Nonce+1 {
+		return types.ErrInvalidReadReceipt.Wrapf("invalid nonce %d, the next nonce should be %d", opts.Nonce, sequence.Nonce+1)
+	}
+
+	if opts.EndTime > ctx.BlockTime().Unix() {
+		return types.ErrInvalidReadReceipt.Wrapf("the receipt period ends in the future: %d", opts.EndTime)
+	}
+	quotaUpdateTime, _ := k.getQuotaUpdateTime(ctx, bucketInfo.Id) // the bucket exists, so it will always get a result
+	if opts.StartTime < int64(quotaUpdateTime) {
+		return types.ErrInvalidReadReceipt.Wrapf("the receipt period should start after the quota update time %d", quotaUpdateTime)
+	}
+	if opts.StartTime < sequence.LastReceiptEnd {
+		return types.ErrInvalidReadReceipt.Wrapf("the receipt period overlaps with the last receipt ending at %d", sequence.LastReceiptEnd)
+	}
+	if opts.StartTime < record.QuotaPeriodStart {
+		return types.ErrInvalidReadReceipt.Wrapf("the receipt period should start in the current read quota period starting at %d", record.QuotaPeriodStart)
+	}
+
+	claimable := uint64(0)
+	for _, split := range splitReadReceipt(int64(quotaUpdateTime), opts.StartTime, opts.EndTime, opts.ReadBytes) {
+		// start a new read quota period
+		if split.periodStart != record.QuotaPeriodStart {
+			record.QuotaPeriodStart = split.periodStart
+			record.PeriodReadBytes = 0
+		}
+
+		// the read bytes within the charged read quota are already paid
+		covered := bucketInfo.ChargedReadQuota
+		if record.PeriodReadBytes > covered {
+			covered = record.PeriodReadBytes
+		}
+		record.PeriodReadBytes += split.readBytes
+		if record.PeriodReadBytes > covered {
+			claimable += record.PeriodReadBytes - covered
+		}
+	}
+	spClaimable := addSpReadClaim(record, sp.Id, claimable)
+	record.TotalReadBytes += opts.ReadBytes
+	sequence.Nonce = opts.Nonce
+	sequence.LastReceiptEnd = opts.EndTime
+	k.setBucketReadRecord(ctx, bucketInfo.Id, record)
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventSubmitReadReceipt{
+		BucketId:           bucketInfo.Id,
+		BucketName:         bucketInfo.BucketName,
+		ReadBytes:          opts.ReadBytes,
+		StartTime:          opts.StartTime,
+		EndTime:            opts.EndTime,
+		ClaimableReadBytes: spClaimable,
+		SpId:               sp.Id,
+		Nonce:              opts.Nonce,
+	})
+}
+
+// getSpReadSequence returns the read receipt sequence of the sp in the record, which is added if not found.
+func getSpReadSequence(record *types.BucketReadRecord, spId uint32) *types.SpReadSequence {
+	for i := range record.SpSequences {
+		if record.SpSequences[i].SpId == spId {
+			return &record.SpSequences[i]
+		}
+	}
+	record.SpSequences = append(record.SpSequences, types.SpReadSequence{SpId: spId})
+	return &record.SpSequences[len(record.SpSequences)-1]
+}
+
+// addSpReadClaim adds the claimable read bytes of the sp to the record, and returns the claimable read bytes of the sp.
+func addSpReadClaim(record *types.BucketReadRecord, spId uint32, readBytes uint64) uint64 {
+	for i := range record.SpClaims {
+		if record.SpClaims[i].SpId == spId {
+			record.SpClaims[i].ClaimableReadBytes += readBytes
+			return record.SpClaims[i].ClaimableReadBytes
+		}
+	}
+	if readBytes == 0 {
+		return 0
+	}
+	record.SpClaims = append(record.SpClaims, types.SpReadClaim{SpId: spId, ClaimableReadBytes: readBytes})
+	return readBytes
+}
+
+// ClaimReadFee charges the payment account of the bucket for the claimable read bytes served by the sp, which is the
+// claimer. The sp can claim the read fee even if the bucket has been migrated to another sp.
+func (k Keeper) ClaimReadFee(ctx sdk.Context, operator sdk.AccAddress, bucketName string) (uint64, sdkmath.Int, error) {
+	bucketInfo, found := k.GetBucketInfo(ctx, bucketName)
+	if !found {
+		return 0, sdkmath.ZeroInt(), types.ErrNoSuchBucket
+	}
+	sp, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, operator)
+	if !found {
+		return 0, sdkmath.ZeroInt(), sptypes.ErrStorageProviderNotFound.Wrapf("the operator %s is not a storage provider", operator.String())
+	}
+
+	record, found := k.GetBucketReadRecord(ctx, bucketInfo.Id)
+	if !found {
+		return 0, sdkmath.ZeroInt(), types.ErrNoClaimableReadFee
+	}
+	index := -1
+	for i, claim := range record.SpClaims {
+		if claim.SpId == sp.Id {
+			index = i
+			break
+		}
+	}
+	if index < 0 || record.SpClaims[index].ClaimableReadBytes == 0 {
+		return 0, sdkmath.ZeroInt(), types.ErrNoClaimableReadFee
+	}
+
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	claimed := record.SpClaims[index].ClaimableReadBytes
+	amount, err := k.ChargeReadReceiptFee(ctx, bucketInfo, internalBucketInfo, sp, claimed)
+	if err != nil {
+		return 0, sdkmath.ZeroInt(), types.ErrChargeFailed.Wrapf("charge read receipt fee failed: %s", err)
+	}
+
+	record.SpClaims = append(record.SpClaims[:index], record.SpClaims[index+1:]...)
+	record.TotalClaimedReadBytes += claimed
+	k.setBucketReadRecord(ctx, bucketInfo.Id, record)
+
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventClaimReadFee{
+		BucketId:         bucketInfo.Id,
+		BucketName:       bucketInfo.BucketName,
+		SpId:             sp.Id,
+		PaymentAddress:   bucketInfo.PaymentAddress,
+		ClaimedReadBytes: claimed,
+		Amount:           amount,
+		FundingAddress:   sp.FundingAddress,
+	}); err != nil {
+		return 0, sdkmath.ZeroInt(), err
+	}
+	return claimed, amount, nil
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func (s *TestSuite) submitReadReceipt(signer *ecdsa.PrivateKey, operator sdk.AccAddress, bucketInfo *types.BucketInfo,
+	readBytes uint64, startTime, endTime int64, nonce uint64,
+) error {
+	return s.submitReadReceiptMsg(signer, types.NewMsgSubmitReadReceipt(operator, bucketInfo.BucketName, bucketInfo.Id,
+		s.ctx.ChainID(), readBytes, startTime, endTime, nonce, nil))
+}
+
+func (s *TestSuite) submitReadReceiptMsg(signer *ecdsa.PrivateKey, msg *types.MsgSubmitReadReceipt) error {
+	sig, err := ethcrypto.Sign(sdk.Keccak256(msg.GetReceiptBytes()), signer)
+	s.Require().NoError(err)
+
+	return s.storageKeeper.SubmitReadReceipt(s.ctx, sdk.MustAccAddressFromHex(msg.Operator), msg.BucketName, &types.SubmitReadReceiptOptions{
+		BucketId:        msg.BucketId,
+		ChainId:         msg.ChainId,
+		ReadBytes:       msg.ReadBytes,
+		StartTime:       msg.StartTime,
+		EndTime:         msg.EndTime,
+		Nonce:           msg.Nonce,
+		OwnerSignature:  sig,
+		ReceiptMsgBytes: msg.GetReceiptBytes(),
+	})
+}
+
+func (s *TestSuite) mockReadReceiptSp(id uint32) (sdk.AccAddress, *sptypes.StorageProvider) {
+	operator := sample.RandAccAddress()
+	sp := &sptypes.StorageProvider{
+		Id:              id,
+		OperatorAddress: operator.String(),
+		FundingAddress:  sample.RandAccAddress().String(),
+	}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Eq(operator)).
+		Return(sp, true).AnyTimes()
+	return operator, sp
+}
+
+func (s *TestSuite) TestSubmitReadReceipt() {
+	ownerKey, err := ethcrypto.GenerateKey()
+	s.Require().NoError(err)
+	owner := sdk.AccAddress(ethcrypto.PubkeyToAddress(ownerKey.PublicKey).Bytes())
+	otherKey, err := ethcrypto.GenerateKey()
+	s.Require().NoError(err)
+
+	operator, _ := s.mockReadReceiptSp(1)
+	newOperator, _ := s.mockReadReceiptSp(2)
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), gomock.Any()).
+		Return(nil, false).AnyTimes()
+
+	now := s.ctx.BlockTime().Unix()
+	bucketInfo := &types.BucketInfo{
+		Owner:            owner.String(),
+		BucketName:       "bucketname",
+		Id:               sdk.NewUint(1),
+		PaymentAddress:   sample.RandAccAddress().String(),
+		ChargedReadQuota: 100,
+		BucketStatus:     types.BUCKET_STATUS_CREATED,
+		CreateAt:         now - 1000,
+	}
+
+	// case 1: bucket does not exist
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 50, now-900, now-800, 1)
+	s.Require().ErrorIs(err, types.ErrNoSuchBucket)
+
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	// case 2: the operator is not a storage provider
+	err = s.submitReadReceipt(ownerKey, sample.RandAccAddress(), bucketInfo, 50, now-900, now-800, 1)
+	s.Require().ErrorIs(err, sptypes.ErrStorageProviderNotFound)
+
+	// case 3: the receipt is not signed by the bucket owner
+	err = s.submitReadReceipt(otherKey, operator, bucketInfo, 50, now-900, now-800, 1)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 4: the receipt is signed for another bucket with the same name
+	err = s.submitReadReceiptMsg(ownerKey, types.NewMsgSubmitReadReceipt(operator, bucketInfo.BucketName, sdk.NewUint(2),
+		s.ctx.ChainID(), 50, now-900, now-800, 1, nil))
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 5: the receipt is signed for another chain
+	err = s.submitReadReceiptMsg(ownerKey, types.NewMsgSubmitReadReceipt(operator, bucketInfo.BucketName, bucketInfo.Id,
+		"other_chain", 50, now-900, now-800, 1, nil))
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 6: invalid nonce
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 50, now-900, now-800, 2)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 7: the receipt period ends in the future
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 50, now-900, now+1, 1)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 8: the receipt period starts before the quota update time
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 50, now-1100, now-900, 1)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 9: the read bytes are within the charged read quota
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 80, now-900, now-800, 1)
+	s.Require().NoError(err)
+	record, found := s.storageKeeper.GetBucketReadRecord(s.ctx, bucketInfo.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(80), record.PeriodReadBytes)
+	s.Require().Equal([]types.SpReadSequence{{SpId: 1, Nonce: 1, LastReceiptEnd: now - 800}}, record.SpSequences)
+	s.Require().Empty(record.SpClaims)
+
+	// case 10: the receipt is replayed
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 80, now-900, now-800, 1)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 11: the receipt period overlaps with the last one
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 50, now-850, now-700, 2)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 12: the read bytes exceed the charged read quota
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 50, now-800, now-700, 2)
+	s.Require().NoError(err)
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 30, now-700, now-600, 3)
+	s.Require().NoError(err)
+	record, _ = s.storageKeeper.GetBucketReadRecord(s.ctx, bucketInfo.Id)
+	s.Require().Equal(uint64(160), record.PeriodReadBytes)
+	s.Require().Equal([]types.SpReadClaim{{SpId: 1, ClaimableReadBytes: 60}}, record.SpClaims)
+	s.Require().Equal(uint64(160), record.TotalReadBytes)
+	s.Require().Equal([]types.SpReadSequence{{SpId: 1, Nonce: 3, LastReceiptEnd: now - 600}}, record.SpSequences)
+
+	// case 13: the bucket is served by another sp after migration, whose receipts have their own nonces
+	err = s.submitReadReceipt(ownerKey, newOperator, bucketInfo, 20, now-650, now-500, 1)
+	s.Require().NoError(err)
+	record, _ = s.storageKeeper.GetBucketReadRecord(s.ctx, bucketInfo.Id)
+	s.Require().Equal([]types.SpReadClaim{
+		{SpId: 1, ClaimableReadBytes: 60},
+		{SpId: 2, ClaimableReadBytes: 20},
+	}, record.SpClaims)
+
+	// case 14: the receipt signed for a sp cannot be submitted by another sp
+	msg := types.NewMsgSubmitReadReceipt(newOperator, bucketInfo.BucketName, bucketInfo.Id, s.ctx.ChainID(), 10, now-600, now-500, 4, nil)
+	sig, err := ethcrypto.Sign(sdk.Keccak256(msg.GetReceiptBytes()), ownerKey)
+	s.Require().NoError(err)
+	msg.Operator = operator.String()
+	msg.OwnerSignature = sig
+	_, err = s.msgServer.SubmitReadReceipt(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+
+	// case 15: the nonces of the sp are not consumed by the other sp
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 10, now-600, now-500, 4)
+	s.Require().NoError(err)
+	record, _ = s.storageKeeper.GetBucketReadRecord(s.ctx, bucketInfo.Id)
+	s.Require().Equal([]types.SpReadSequence{
+		{SpId: 1, Nonce: 4, LastReceiptEnd: now - 500},
+		{SpId: 2, Nonce: 1, LastReceiptEnd: now - 500},
+	}, record.SpSequences)
+}
+
+func (s *TestSuite) TestSubmitReadReceiptAcrossQuotaPeriods() {
+	ownerKey, err := ethcrypto.GenerateKey()
+	s.Require().NoError(err)
+	owner := sdk.AccAddress(ethcrypto.PubkeyToAddress(ownerKey.PublicKey).Bytes())
+	operator, _ := s.mockReadReceiptSp(1)
+
+	now := s.ctx.BlockTime().Unix()
+	bucketInfo := &types.BucketInfo{
+		Owner:            owner.String(),
+		BucketName:       "bucketname",
+		Id:               sdk.NewUint(1),
+		PaymentAddress:   sample.RandAccAddress().String(),
+		ChargedReadQuota: 100,
+		BucketStatus:     types.BUCKET_STATUS_CREATED,
+		CreateAt:         now - 2*types.SecondsPerMonth,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+
+	// the read bytes are split evenly into two quota periods, each of which is within the charged read quota
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 200,
+		now-types.SecondsPerMonth-100, now-types.SecondsPerMonth+100, 1)
+	s.Require().NoError(err)
+	record, found := s.storageKeeper.GetBucketReadRecord(s.ctx, bucketInfo.Id)
+	s.Require().True(found)
+	s.Require().Equal(now-types.SecondsPerMonth, record.QuotaPeriodStart)
+	s.Require().Equal(uint64(100), record.PeriodReadBytes)
+	s.Require().Equal(uint64(200), record.TotalReadBytes)
+	s.Require().Empty(record.SpClaims)
+
+	// the receipt of another sp cannot start before the current read quota period
+	newOperator, _ := s.mockReadReceiptSp(2)
+	err = s.submitReadReceipt(ownerKey, newOperator, bucketInfo, 50, now-types.SecondsPerMonth-50, now-types.SecondsPerMonth+50, 1)
+	s.Require().ErrorIs(err, types.ErrInvalidReadReceipt)
+}
+
+func (s *TestSuite) TestClaimReadFee() {
+	ownerKey, err := ethcrypto.GenerateKey()
+	s.Require().NoError(err)
+	owner := sdk.AccAddress(ethcrypto.PubkeyToAddress(ownerKey.PublicKey).Bytes())
+
+	operator, sp := s.mockReadReceiptSp(1)
+	newOperator, _ := s.mockReadReceiptSp(2)
+
+	price := sptypes.GlobalSpStorePrice{
+		ReadPrice:           sdk.NewDec(2),
+		PrimaryStorePrice:   sdk.NewDec(1),
+		SecondaryStorePrice: sdk.NewDec(1),
+	}
+	s.spKeeper.EXPECT().GetGlobalSpStorePriceByTime(gomock.Any(), gomock.Any()).
+		Return(price, nil).AnyTimes()
+	params := paymenttypes.DefaultParams()
+	s.paymentKeeper.EXPECT().GetVersionedParamsWithTs(gomock.Any(), gomock.Any()).
+		Return(params.VersionedParams, nil).AnyTimes()
+	changes := make(map[string]sdkmath.Int)
+	s.paymentKeeper.EXPECT().UpdateStreamRecordByAddr(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, change *paymenttypes.StreamRecordChange) (*paymenttypes.StreamRecord, error) {
+			changes[change.Addr.String()] = change.StaticBalanceChange
+			return &paymenttypes.StreamRecord{StaticBalance: sdkmath.ZeroInt()}, nil
+		}).AnyTimes()
+
+	now := s.ctx.BlockTime().Unix()
+	bucketInfo := &types.BucketInfo{
+		Owner:            owner.String(),
+		BucketName:       "bucketname",
+		Id:               sdk.NewUint(1),
+		PaymentAddress:   sample.RandAccAddress().String(),
+		ChargedReadQuota: 100,
+		BucketStatus:     types.BUCKET_STATUS_CREATED,
+		CreateAt:         now - 1000,
+	}
+	s.storageKeeper.StoreBucketInfo(s.ctx, bucketInfo)
+	s.storageKeeper.SetInternalBucketInfo(s.ctx, bucketInfo.Id, &types.InternalBucketInfo{PriceTime: now - 1000})
+
+	// case 1: nothing to claim
+	_, _, err = s.storageKeeper.ClaimReadFee(s.ctx, operator, bucketInfo.BucketName)
+	s.Require().ErrorIs(err, types.ErrNoClaimableReadFee)
+
+	err = s.submitReadReceipt(ownerKey, operator, bucketInfo, 160, now-900, now-800, 1)
+	s.Require().NoError(err)
+	err = s.submitReadReceipt(ownerKey, newOperator, bucketInfo, 10, now-800, now-700, 1)
+	s.Require().NoError(err)
+
+	// case 2: the old sp claims the read fee of the bytes it served after migration
+	claimed, amount, err := s.storageKeeper.ClaimReadFee(s.ctx, operator, bucketInfo.BucketName)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(60), claimed)
+
+	spAmount := price.ReadPrice.MulInt64(60).TruncateInt()
+	taxAmount := params.VersionedParams.ValidatorTaxRate.MulInt(spAmount).TruncateInt()
+	s.Require().Equal(spAmount.MulRaw(types.SecondsPerMonth), changes[sp.FundingAddress])
+	s.Require().Equal(taxAmount.MulRaw(types.SecondsPerMonth), changes[paymenttypes.ValidatorTaxPoolAddress.String()])
+	s.Require().Equal(spAmount.Add(taxAmount).MulRaw(types.SecondsPerMonth), amount)
+	s.Require().Equal(amount.Neg(), changes[bucketInfo.PaymentAddress])
+
+	record, _ := s.storageKeeper.GetBucketReadRecord(s.ctx, bucketInfo.Id)
+	s.Require().Equal([]types.SpReadClaim{{SpId: 2, ClaimableReadBytes: 10}}, record.SpClaims)
+	s.Require().Equal(uint64(60), record.TotalClaimedReadBytes)
+
+	// case 3: the claimed read fee cannot be claimed again
+	_, _, err = s.storageKeeper.ClaimReadFee(s.ctx, operator, bucketInfo.BucketName)
+	s.Require().ErrorIs(err, types.ErrNoClaimableReadFee)
+}
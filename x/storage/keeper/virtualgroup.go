@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/prysmaticlabs/prysm/crypto/bls"
@@ -215,6 +217,63 @@ func (k Keeper) SealEmptyObjectOnVirtualGroup(ctx sdk.Context, bucketInfo *types
 	return k.SealObjectOnVirtualGroup(ctx, bucketInfo, gvgID, objectInfo)
 }
 
+// RebindBucketToFamily rebinds the bucket to another family of the same primary sp, after the gvgs storing its objects
+// have been moved into the family. The store fee of the primary sp is streamed to the virtual payment account of the
+// new family at the same price. It returns the size stored by the bucket in each gvg.
+func (k Keeper) RebindBucketToFamily(ctx sdk.Context, operator sdk.AccAddress, bucketID math.Uint, srcFamilyID, dstFamilyID uint32) (map[uint32]uint64, error) {
+	bucketInfo, found := k.GetBucketInfoById(ctx, bucketID)
+	if !found {
+		return nil, types.ErrNoSuchBucket.Wrapf("bucket id: %s", bucketID.String())
+	}
+	if bucketInfo.GlobalVirtualGroupFamilyId != srcFamilyID {
+		return nil, types.ErrInvalidGlobalVirtualGroup.Wrapf("the bucket(ID: %s) is not served by the family(ID: %d)", bucketID.String(), srcFamilyID)
+	}
+	if err := bucketInfo.CheckBucketStatus(); err != nil {
+		return nil, err
+	}
+
+	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketInfo.Id)
+	storedSizes := make(map[uint32]uint64)
+	for _, lvg := range internalBucketInfo.LocalVirtualGroups {
+		gvg, found := k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
+		if !found {
+			return nil, vgtypes.ErrGVGNotExist.Wrapf("gvg id: %d", lvg.GlobalVirtualGroupId)
+		}
+		if gvg.FamilyId != dstFamilyID {
+			return nil, types.ErrInvalidGlobalVirtualGroup.Wrapf("the gvg(ID: %d) storing objects of the bucket(ID: %s) is not moved into the family(ID: %d)",
+				gvg.Id, bucketID.String(), dstFamilyID)
+		}
+		storedSizes[gvg.Id] += lvg.StoredSize
+	}
+
+	prevBills, err := k.GetBucketReadStoreBills(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return nil, fmt.Errorf("get bucket bill failed, bucket: %s, err: %w", bucketInfo.BucketName, err)
+	}
+	bucketInfo.GlobalVirtualGroupFamilyId = dstFamilyID
+	newBills, err := k.GetBucketReadStoreBills(ctx, bucketInfo, internalBucketInfo)
+	if err != nil {
+		return nil, fmt.Errorf("get new bucket bill failed, bucket: %s, err: %w", bucketInfo.BucketName, err)
+	}
+	if err = k.ApplyBillChanges(ctx, bucketInfo.Id, prevBills, newBills); err != nil {
+		return nil, err
+	}
+	k.SetBucketInfo(ctx, bucketInfo)
+
+	if err = ctx.EventManager().EmitTypedEvents(&types.EventUpdateBucketInfo{
+		Operator:                   operator.String(),
+		BucketName:                 bucketInfo.BucketName,
+		BucketId:                   bucketInfo.Id,
+		ChargedReadQuota:           bucketInfo.ChargedReadQuota,
+		PaymentAddress:             bucketInfo.PaymentAddress,
+		Visibility:                 bucketInfo.Visibility,
+		GlobalVirtualGroupFamilyId: bucketInfo.GlobalVirtualGroupFamilyId,
+	}); err != nil {
+		return nil, err
+	}
+	return storedSizes, nil
+}
+
 func (k Keeper) GetObjectGVG(ctx sdk.Context, bucketID math.Uint, lvgID uint32) (*vgtypes.GlobalVirtualGroup, bool) {
 	internalBucketInfo := k.MustGetInternalBucketInfo(ctx, bucketID)
 
@@ -226,3 +285,16 @@ func (k Keeper) GetObjectGVG(ctx sdk.Context, bucketID math.Uint, lvgID uint32)
 	return k.virtualGroupKeeper.GetGVG(ctx, lvg.GlobalVirtualGroupId)
 
 }
+
+// IsSPSwappingOut returns whether the sp is swapping out from the gvg, either as the primary sp of its family
+// or as a secondary sp of the gvg itself.
+func (k Keeper) IsSPSwappingOut(ctx sdk.Context, gvg *vgtypes.GlobalVirtualGroup, spID uint32) bool {
+	if spID == gvg.PrimarySpId {
+		swapOutInfo, found := k.virtualGroupKeeper.GetSwapOutInfo(ctx, gvg.FamilyId, gvg.Id)
+		if found && swapOutInfo.SpId == spID {
+			return true
+		}
+	}
+	swapOutInfo, found := k.virtualGroupKeeper.GetSwapOutInfo(ctx, vgtypes.NoSpecifiedFamilyId, gvg.Id)
+	return found && swapOutInfo.SpId == spID
+}
@@ -27,6 +27,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCompleteMigrateBucket{}, "storage/CompleteMigrateBucket", nil)
 	cdc.RegisterConcrete(&MsgCancelMigrateBucket{}, "storage/CancelMigrateBucket", nil)
 	cdc.RegisterConcrete(&MsgRejectMigrateBucket{}, "storage/RejectMigrateBucket", nil)
+	cdc.RegisterConcrete(&MsgCreatePrepaidPlan{}, "storage/CreatePrepaidPlan", nil)
+	cdc.RegisterConcrete(&MsgSubmitReadReceipt{}, "storage/SubmitReadReceipt", nil)
+	cdc.RegisterConcrete(&MsgClaimReadFee{}, "storage/ClaimReadFee", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -115,6 +118,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRejectMigrateBucket{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePrepaidPlan{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitReadReceipt{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimReadFee{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
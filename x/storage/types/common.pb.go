@@ -391,6 +391,9 @@ type LocalVirtualGroup struct {
 	// total_charge_size is the total charged size of the objects in the LVG.
 	// Notice that the minimum unit of charge is 128K
 	TotalChargeSize uint64 `protobuf:"varint,4,opt,name=total_charge_size,json=totalChargeSize,proto3" json:"total_charge_size,omitempty"`
+	// replica_charge_size is the part of total_charge_size charged for the objects of REDUNDANCY_REPLICA_TYPE,
+	// which is billed by the store price tiers of the replica type.
+	ReplicaChargeSize uint64 `protobuf:"varint,5,opt,name=replica_charge_size,json=replicaChargeSize,proto3" json:"replica_charge_size,omitempty"`
 }
 
 func (m *LocalVirtualGroup) Reset()         { *m = LocalVirtualGroup{} }
@@ -454,6 +457,13 @@ func (m *LocalVirtualGroup) GetTotalChargeSize() uint64 {
 	return 0
 }
 
+func (m *LocalVirtualGroup) GetReplicaChargeSize() uint64 {
+	if m != nil {
+		return m.ReplicaChargeSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("greenfield.storage.SourceType", SourceType_name, SourceType_value)
 	proto.RegisterEnum("greenfield.storage.BucketStatus", BucketStatus_name, BucketStatus_value)
@@ -469,59 +479,59 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/common.proto", fileDescriptor_4eff6c0fa4aaf4c9) }
 
 var fileDescriptor_4eff6c0fa4aaf4c9 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
+	// 832 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x34, 0xc0, 0x76, 0xb6, 0x94, 0xd4, 0x2a, 0xa4, 0x4d, 0x90, 0x53, 0x7a, 0x2a,
-	0x95, 0xb6, 0x39, 0x20, 0xa4, 0x95, 0xd8, 0x8b, 0xed, 0x98, 0xec, 0xb0, 0xa9, 0x13, 0xcd, 0xd8,
-	0x91, 0xca, 0x65, 0xe4, 0x97, 0xc1, 0x1d, 0xd6, 0xf1, 0x58, 0x9e, 0x31, 0x22, 0xfb, 0x09, 0x90,
-	0xb8, 0x20, 0xbe, 0x00, 0x07, 0x0e, 0x7c, 0x01, 0xbe, 0x02, 0x68, 0x8f, 0x2b, 0x4e, 0x88, 0xc3,
-	0x0a, 0xb5, 0x5f, 0x04, 0xf9, 0x25, 0xd9, 0x04, 0x42, 0xb5, 0x82, 0x5b, 0xe6, 0xf9, 0xff, 0xf3,
-	0xcc, 0xef, 0x79, 0x9e, 0xf1, 0x0c, 0xe8, 0x47, 0x19, 0xa5, 0xc9, 0x17, 0x8c, 0xc6, 0xe1, 0x40,
-	0x48, 0x9e, 0x79, 0x11, 0x1d, 0x04, 0x7c, 0x3e, 0xe7, 0xc9, 0x45, 0x9a, 0x71, 0xc9, 0x55, 0xf5,
-	0x95, 0xe1, 0xa2, 0x36, 0x74, 0x8f, 0x03, 0x2e, 0xe6, 0x5c, 0x90, 0xd2, 0x31, 0xa8, 0x16, 0x95,
-	0xbd, 0x7b, 0x18, 0xf1, 0x88, 0x57, 0xf1, 0xe2, 0x57, 0x15, 0x3d, 0xfd, 0x55, 0x01, 0xef, 0x63,
-	0x1a, 0xf0, 0x24, 0xf4, 0xb2, 0x05, 0x4e, 0x31, 0xf5, 0xe2, 0x89, 0xff, 0x25, 0x0d, 0x24, 0x66,
-	0x51, 0x32, 0xe4, 0x81, 0x7a, 0x0c, 0xee, 0x05, 0xd7, 0x1e, 0x4b, 0x08, 0x0b, 0x8f, 0x94, 0x13,
-	0xe5, 0x6c, 0x17, 0xbd, 0x55, 0xae, 0x61, 0xa8, 0x7e, 0x0c, 0x3a, 0x51, 0xcc, 0x7d, 0x2f, 0x26,
-	0x5f, 0xb1, 0x4c, 0xe6, 0x5e, 0x4c, 0xa2, 0x8c, 0xe7, 0x69, 0xe1, 0x6c, 0x9e, 0x28, 0x67, 0x6f,
-	0xa3, 0xc3, 0x4a, 0x9e, 0x55, 0xea, 0xa8, 0x10, 0x61, 0xa8, 0x3e, 0x04, 0xbb, 0xbc, 0xdc, 0xa2,
-	0x30, 0xee, 0x14, 0x29, 0x8d, 0xde, 0xf3, 0x97, 0xfd, 0xc6, 0x1f, 0x2f, 0xfb, 0x2d, 0x97, 0x25,
-	0xf2, 0xb7, 0x9f, 0x1f, 0xdc, 0xaf, 0xc9, 0x8b, 0x25, 0xba, 0x57, 0xb9, 0x61, 0xa8, 0x76, 0x0b,
-	0x16, 0x1a, 0x3c, 0x15, 0xf9, 0xfc, 0xa8, 0x75, 0xa2, 0x9c, 0xed, 0xa1, 0xd5, 0xfa, 0xf4, 0x17,
-	0x05, 0x80, 0xd1, 0x6c, 0x74, 0xe9, 0xa5, 0x29, 0x4b, 0x22, 0xf5, 0x11, 0xe8, 0x89, 0x2c, 0x20,
-	0xff, 0xc6, 0xa7, 0x94, 0x7c, 0x1d, 0x91, 0x05, 0xa3, 0x6d, 0x88, 0x8f, 0x40, 0x2f, 0x14, 0x92,
-	0xdc, 0x5d, 0x5d, 0x27, 0x14, 0x72, 0xeb, 0xbf, 0x3f, 0x01, 0x5d, 0xb1, 0x6c, 0x29, 0x11, 0x29,
-	0xf1, 0x63, 0x41, 0x04, 0x8b, 0x12, 0x4f, 0xe6, 0x19, 0x2d, 0x2b, 0xde, 0x43, 0x1d, 0xf1, 0xaa,
-	0xe9, 0x46, 0x2c, 0xf0, 0x52, 0x3e, 0xfd, 0xa1, 0x09, 0x3e, 0x58, 0x1b, 0xc8, 0x25, 0x8b, 0x32,
-	0x4f, 0x32, 0x9e, 0x18, 0x79, 0xf0, 0x94, 0xbe, 0xce, 0x54, 0x3e, 0x04, 0x07, 0x05, 0x7b, 0x9a,
-	0xb1, 0x79, 0xbd, 0xff, 0x8a, 0x78, 0x3f, 0x14, 0x72, 0x5a, 0xc5, 0x71, 0x5d, 0xe6, 0x5d, 0x4d,
-	0xda, 0xf9, 0x5f, 0x4d, 0x6a, 0xdd, 0xdd, 0xa4, 0x87, 0x60, 0xd7, 0x2f, 0x4b, 0x2a, 0xbc, 0x6f,
-	0xbc, 0xc6, 0x29, 0xa8, 0xdc, 0x30, 0x3c, 0xfd, 0x49, 0x01, 0x07, 0x63, 0x1e, 0x6c, 0x66, 0x54,
-	0xf7, 0x41, 0x73, 0x35, 0xd7, 0x26, 0xfb, 0xcf, 0x87, 0xb3, 0x0f, 0xee, 0x17, 0xdf, 0x12, 0x0d,
-	0x89, 0x60, 0xcf, 0xaa, 0x61, 0xb5, 0x10, 0xa8, 0x42, 0x98, 0x3d, 0xa3, 0xea, 0x39, 0x38, 0x90,
-	0x5c, 0x7a, 0x31, 0x09, 0xae, 0xbd, 0x2c, 0xa2, 0x95, 0xad, 0x55, 0xda, 0xde, 0x29, 0x05, 0xb3,
-	0x8c, 0x17, 0xde, 0xf3, 0x6f, 0x15, 0x00, 0x30, 0xcf, 0xb3, 0x80, 0x3a, 0x8b, 0x94, 0xaa, 0xef,
-	0x01, 0x15, 0x4f, 0x5c, 0x64, 0x5a, 0xc4, 0xb9, 0x9a, 0x5a, 0x64, 0x82, 0xe0, 0x08, 0xda, 0xed,
-	0x86, 0xaa, 0x81, 0xee, 0x7a, 0xfc, 0x12, 0x22, 0x34, 0x41, 0x64, 0x6a, 0xd9, 0x43, 0x68, 0x8f,
-	0xda, 0x8a, 0xda, 0x07, 0xbd, 0x75, 0xdd, 0xc0, 0x26, 0x31, 0xd1, 0x04, 0x63, 0x62, 0x3e, 0xd6,
-	0xa1, 0xdd, 0x6e, 0xfe, 0x3d, 0xc1, 0x64, 0xba, 0xa1, 0xef, 0x74, 0x5b, 0xdf, 0xfc, 0xa8, 0x35,
-	0xce, 0x63, 0xb0, 0x57, 0x1f, 0x22, 0xe9, 0xc9, 0x5c, 0xa8, 0xc7, 0xe0, 0x5d, 0xc3, 0x35, 0x9f,
-	0x58, 0x0e, 0xc1, 0x8e, 0xee, 0xb8, 0x98, 0x98, 0xc8, 0xd2, 0x1d, 0x6b, 0x58, 0x11, 0x6d, 0x4a,
-	0x43, 0x88, 0xcd, 0x89, 0xed, 0x40, 0xdb, 0xb5, 0x86, 0x6d, 0x45, 0xed, 0x81, 0xce, 0xa6, 0x7e,
-	0x09, 0x47, 0x48, 0x77, 0x0a, 0xdc, 0x66, 0xbd, 0xdb, 0x13, 0xb0, 0x8f, 0x68, 0x98, 0x27, 0xa1,
-	0x97, 0x04, 0x8b, 0x65, 0xf9, 0xc8, 0x1a, 0xba, 0xf6, 0x50, 0xb7, 0xcd, 0x2b, 0x62, 0x99, 0x25,
-	0x6c, 0xbb, 0x51, 0x24, 0x5b, 0x8b, 0x23, 0x6b, 0x3a, 0x86, 0xa6, 0x5e, 0x89, 0x4a, 0x9d, 0x8c,
-	0x81, 0xbd, 0xfa, 0x56, 0x5a, 0xa1, 0x4f, 0x8c, 0xcf, 0x2c, 0x73, 0x0b, 0xfa, 0x11, 0x38, 0xdc,
-	0x94, 0xb0, 0xa5, 0x8f, 0x4b, 0x68, 0x0d, 0x74, 0x37, 0x95, 0x8d, 0xa2, 0x96, 0xdc, 0xdf, 0x2b,
-	0x60, 0x7f, 0xc6, 0x04, 0xf3, 0x59, 0xcc, 0x64, 0x05, 0xde, 0x07, 0xbd, 0x19, 0xc4, 0xd0, 0x80,
-	0x63, 0xe8, 0x5c, 0x55, 0x2d, 0x76, 0x6d, 0x3c, 0xb5, 0x4c, 0xf8, 0x29, 0x2c, 0xf7, 0xdc, 0x62,
-	0x98, 0xba, 0xc6, 0x18, 0x9a, 0x04, 0x59, 0x7a, 0xdd, 0xaf, 0x7f, 0x18, 0x10, 0x9c, 0xe9, 0x8e,
-	0xd5, 0x6e, 0x6e, 0x13, 0xa1, 0xfd, 0xd8, 0x42, 0xd0, 0x59, 0x8e, 0xce, 0x80, 0xcf, 0x6f, 0x34,
-	0xe5, 0xc5, 0x8d, 0xa6, 0xfc, 0x79, 0xa3, 0x29, 0xdf, 0xdd, 0x6a, 0x8d, 0x17, 0xb7, 0x5a, 0xe3,
-	0xf7, 0x5b, 0xad, 0xf1, 0xf9, 0x20, 0x62, 0xf2, 0x3a, 0xf7, 0x2f, 0x02, 0x3e, 0x1f, 0xf8, 0x89,
-	0xff, 0xa0, 0xbc, 0x05, 0x06, 0x6b, 0x4f, 0xc7, 0xd7, 0xab, 0xc7, 0x43, 0x2e, 0x52, 0x2a, 0xfc,
-	0x37, 0xcb, 0x7b, 0xff, 0xa3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x56, 0x70, 0xa5, 0xe0, 0x5f,
-	0x06, 0x00, 0x00,
+	0x18, 0xc7, 0xe3, 0x34, 0x0b, 0xdb, 0xd9, 0x52, 0x52, 0x53, 0x48, 0x9b, 0x20, 0xa7, 0xf4, 0x54,
+	0x2a, 0x6d, 0x73, 0x40, 0x48, 0x2b, 0xb1, 0x17, 0xdb, 0x31, 0xd9, 0x61, 0x53, 0x27, 0x9a, 0x71,
+	0x22, 0x95, 0xcb, 0xc8, 0x2f, 0x83, 0x3b, 0xac, 0xe3, 0xb1, 0x3c, 0x63, 0x44, 0xf7, 0x13, 0x20,
+	0x71, 0x41, 0x7c, 0x01, 0x0e, 0x7c, 0x05, 0xbe, 0x02, 0x68, 0x8f, 0x2b, 0x2e, 0x20, 0x0e, 0x2b,
+	0xd4, 0x7e, 0x11, 0xe4, 0x97, 0xa4, 0x31, 0x84, 0x6a, 0x05, 0xb7, 0xcc, 0xf3, 0xff, 0xe5, 0x99,
+	0xff, 0xf3, 0x3c, 0xe3, 0x19, 0xd0, 0x0f, 0x53, 0x4a, 0xe3, 0x2f, 0x18, 0x8d, 0x82, 0x81, 0x90,
+	0x3c, 0x75, 0x43, 0x3a, 0xf0, 0xf9, 0x62, 0xc1, 0xe3, 0xb3, 0x24, 0xe5, 0x92, 0xab, 0xea, 0x2d,
+	0x70, 0x56, 0x01, 0xdd, 0x43, 0x9f, 0x8b, 0x05, 0x17, 0xa4, 0x20, 0x06, 0xe5, 0xa2, 0xc4, 0xbb,
+	0xfb, 0x21, 0x0f, 0x79, 0x19, 0xcf, 0x7f, 0x95, 0xd1, 0xe3, 0x5f, 0x14, 0xf0, 0x3e, 0xa6, 0x3e,
+	0x8f, 0x03, 0x37, 0xbd, 0xc2, 0x09, 0xa6, 0x6e, 0x34, 0xf1, 0xbe, 0xa4, 0xbe, 0xc4, 0x2c, 0x8c,
+	0x87, 0xdc, 0x57, 0x0f, 0xc1, 0x7d, 0xff, 0xd2, 0x65, 0x31, 0x61, 0xc1, 0x81, 0x72, 0xa4, 0x9c,
+	0x6c, 0xa3, 0x37, 0x8b, 0x35, 0x0c, 0xd4, 0x8f, 0x41, 0x27, 0x8c, 0xb8, 0xe7, 0x46, 0xe4, 0x2b,
+	0x96, 0xca, 0xcc, 0x8d, 0x48, 0x98, 0xf2, 0x2c, 0xc9, 0xc9, 0xe6, 0x91, 0x72, 0xf2, 0x16, 0xda,
+	0x2f, 0xe5, 0x79, 0xa9, 0x8e, 0x72, 0x11, 0x06, 0xea, 0x23, 0xb0, 0xcd, 0x8b, 0x2d, 0x72, 0x70,
+	0x2b, 0x4f, 0x69, 0xf4, 0x5e, 0xbc, 0xea, 0x37, 0xfe, 0x78, 0xd5, 0x6f, 0xcd, 0x58, 0x2c, 0x7f,
+	0xfd, 0xe9, 0xe1, 0x83, 0xca, 0x79, 0xbe, 0x44, 0xf7, 0x4b, 0x1a, 0x06, 0x6a, 0x37, 0xf7, 0x42,
+	0xfd, 0x67, 0x22, 0x5b, 0x1c, 0xb4, 0x8e, 0x94, 0x93, 0x1d, 0xb4, 0x5a, 0x1f, 0xff, 0xac, 0x00,
+	0x30, 0x9a, 0x8f, 0xce, 0xdd, 0x24, 0x61, 0x71, 0xa8, 0x3e, 0x06, 0x3d, 0x91, 0xfa, 0xe4, 0xdf,
+	0xfc, 0x29, 0x85, 0xbf, 0x8e, 0x48, 0xfd, 0xd1, 0x26, 0x8b, 0x8f, 0x41, 0x2f, 0x10, 0x92, 0xdc,
+	0x5d, 0x5d, 0x27, 0x10, 0x72, 0xe3, 0xbf, 0x3f, 0x01, 0x5d, 0xb1, 0x6c, 0x29, 0x11, 0x09, 0xf1,
+	0x22, 0x41, 0x04, 0x0b, 0x63, 0x57, 0x66, 0x29, 0x2d, 0x2a, 0xde, 0x41, 0x1d, 0x71, 0xdb, 0x74,
+	0x23, 0x12, 0x78, 0x29, 0x1f, 0xff, 0xd0, 0x04, 0x1f, 0xac, 0x0d, 0xe4, 0x9c, 0x85, 0xa9, 0x2b,
+	0x19, 0x8f, 0x8d, 0xcc, 0x7f, 0x46, 0x5f, 0x67, 0x2a, 0x1f, 0x82, 0xbd, 0xdc, 0x7b, 0x92, 0xb2,
+	0x45, 0xb5, 0xff, 0xca, 0xf1, 0x6e, 0x20, 0xe4, 0xb4, 0x8c, 0xe3, 0xaa, 0xcc, 0xbb, 0x9a, 0xb4,
+	0xf5, 0xbf, 0x9a, 0xd4, 0xba, 0xbb, 0x49, 0x8f, 0xc0, 0xb6, 0x57, 0x94, 0x94, 0xb3, 0xf7, 0x5e,
+	0xe3, 0x14, 0x94, 0x34, 0x0c, 0x8e, 0x7f, 0x53, 0xc0, 0xde, 0x98, 0xfb, 0xf5, 0x8c, 0xea, 0x2e,
+	0x68, 0xae, 0xe6, 0xda, 0x64, 0xff, 0xf9, 0x70, 0xf6, 0xc1, 0x83, 0xfc, 0x5b, 0xa2, 0x01, 0x11,
+	0xec, 0x79, 0x39, 0xac, 0x16, 0x02, 0x65, 0x08, 0xb3, 0xe7, 0x54, 0x3d, 0x05, 0x7b, 0x92, 0x4b,
+	0x37, 0x22, 0xfe, 0xa5, 0x9b, 0x86, 0xb4, 0xc4, 0x5a, 0x05, 0xf6, 0x76, 0x21, 0x98, 0x45, 0xbc,
+	0x60, 0xcf, 0xc0, 0x3b, 0x29, 0x4d, 0x22, 0xe6, 0xbb, 0x35, 0xfa, 0x5e, 0x41, 0xef, 0x55, 0xd2,
+	0x2d, 0x7f, 0xfa, 0xad, 0x02, 0x00, 0xe6, 0x59, 0xea, 0x53, 0xe7, 0x2a, 0xa1, 0xea, 0x7b, 0x40,
+	0xc5, 0x93, 0x19, 0x32, 0x2d, 0xe2, 0x5c, 0x4c, 0x2d, 0x32, 0x41, 0x70, 0x04, 0xed, 0x76, 0x43,
+	0xd5, 0x40, 0x77, 0x3d, 0x7e, 0x0e, 0x11, 0x9a, 0x20, 0x32, 0xb5, 0xec, 0x21, 0xb4, 0x47, 0x6d,
+	0x45, 0xed, 0x83, 0xde, 0xba, 0x6e, 0x60, 0x93, 0x98, 0x68, 0x82, 0x31, 0x31, 0x9f, 0xe8, 0xd0,
+	0x6e, 0x37, 0xff, 0x9e, 0x60, 0x32, 0xad, 0xe9, 0x5b, 0xdd, 0xd6, 0x37, 0x3f, 0x6a, 0x8d, 0xd3,
+	0x08, 0xec, 0x54, 0x87, 0x4e, 0xba, 0x32, 0x13, 0xea, 0x21, 0x78, 0xd7, 0x98, 0x99, 0x4f, 0x2d,
+	0x87, 0x60, 0x47, 0x77, 0x66, 0x98, 0x98, 0xc8, 0xd2, 0x1d, 0x6b, 0x58, 0x3a, 0xaa, 0x4b, 0x43,
+	0x88, 0xcd, 0x89, 0xed, 0x40, 0x7b, 0x66, 0x0d, 0xdb, 0x8a, 0xda, 0x03, 0x9d, 0xba, 0x7e, 0x0e,
+	0x47, 0x48, 0x77, 0x72, 0xbb, 0xcd, 0x6a, 0xb7, 0xa7, 0x60, 0x17, 0xd1, 0x20, 0x8b, 0x03, 0x37,
+	0xf6, 0xaf, 0x96, 0xe5, 0x23, 0x6b, 0x38, 0xb3, 0x87, 0xba, 0x6d, 0x5e, 0x10, 0xcb, 0x2c, 0xcc,
+	0xb6, 0x1b, 0x79, 0xb2, 0xb5, 0x38, 0xb2, 0xa6, 0x63, 0x68, 0xea, 0xa5, 0xa8, 0x54, 0xc9, 0x18,
+	0xd8, 0xa9, 0x6e, 0xb1, 0x95, 0xf5, 0x89, 0xf1, 0x99, 0x65, 0x6e, 0xb0, 0x7e, 0x00, 0xf6, 0xeb,
+	0x12, 0xb6, 0xf4, 0x71, 0x61, 0x5a, 0x03, 0xdd, 0xba, 0x52, 0x2b, 0x6a, 0xe9, 0xfb, 0x7b, 0x05,
+	0xec, 0xce, 0x99, 0x60, 0x1e, 0x8b, 0x98, 0x2c, 0x8d, 0xf7, 0x41, 0x6f, 0x0e, 0x31, 0x34, 0xe0,
+	0x18, 0x3a, 0x17, 0x65, 0x8b, 0x67, 0x36, 0x9e, 0x5a, 0x26, 0xfc, 0x14, 0x16, 0x7b, 0x6e, 0x00,
+	0xa6, 0x33, 0x63, 0x0c, 0x4d, 0x82, 0x2c, 0xbd, 0xea, 0xd7, 0x3f, 0x00, 0x04, 0xe7, 0xba, 0x63,
+	0xb5, 0x9b, 0x9b, 0x44, 0x68, 0x3f, 0xb1, 0x10, 0x74, 0x96, 0xa3, 0x33, 0xe0, 0x8b, 0x6b, 0x4d,
+	0x79, 0x79, 0xad, 0x29, 0x7f, 0x5e, 0x6b, 0xca, 0x77, 0x37, 0x5a, 0xe3, 0xe5, 0x8d, 0xd6, 0xf8,
+	0xfd, 0x46, 0x6b, 0x7c, 0x3e, 0x08, 0x99, 0xbc, 0xcc, 0xbc, 0x33, 0x9f, 0x2f, 0x06, 0x5e, 0xec,
+	0x3d, 0x2c, 0x6e, 0x8d, 0xc1, 0xda, 0x53, 0xf3, 0xf5, 0xea, 0xb1, 0x91, 0x57, 0x09, 0x15, 0xde,
+	0x1b, 0xc5, 0x3b, 0xf1, 0xd1, 0x5f, 0x03, 0x00, 0x0f, 0x29, 0x17, 0xbe, 0x8f, 0x06, 0x00, 0x00,
 }
 
 func (m *SecondarySpSealObjectSignDoc) Marshal() (dAtA []byte, err error) {
@@ -691,6 +701,11 @@ func (m *LocalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplicaChargeSize != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.ReplicaChargeSize))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.TotalChargeSize != 0 {
 		i = encodeVarintCommon(dAtA, i, uint64(m.TotalChargeSize))
 		i--
@@ -808,6 +823,9 @@ func (m *LocalVirtualGroup) Size() (n int) {
 	if m.TotalChargeSize != 0 {
 		n += 1 + sovCommon(uint64(m.TotalChargeSize))
 	}
+	if m.ReplicaChargeSize != 0 {
+		n += 1 + sovCommon(uint64(m.ReplicaChargeSize))
+	}
 	return n
 }
 
@@ -1386,6 +1404,25 @@ func (m *LocalVirtualGroup) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaChargeSize", wireType)
+			}
+			m.ReplicaChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
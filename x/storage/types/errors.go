@@ -49,4 +49,7 @@ var (
 	ErrMigrationBucketFailed     = errors.Register(ModuleName, 3202, "migrate bucket failed.")
 	ErrVirtualGroupOperateFailed = errors.Register(ModuleName, 3203, "operate virtual group failed.")
 	ErrInvalidBlsPubKey          = errors.Register(ModuleName, 3204, "invalid bls public key")
+	ErrPrepaidPlanExists         = errors.Register(ModuleName, 3205, "the bucket already has a prepaid plan")
+	ErrInvalidReadReceipt        = errors.Register(ModuleName, 3206, "invalid read receipt")
+	ErrNoClaimableReadFee        = errors.Register(ModuleName, 3207, "no claimable read fee")
 )
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/bnb-chain/greenfield/types/resource"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -2373,6 +2374,268 @@ func (m *EventSetTag) GetTags() *ResourceTags {
 	return nil
 }
 
+type EventChargeEarlyDeletion struct {
+	// object_id define an u256 id for object
+	ObjectId Uint `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,customtype=Uint" json:"object_id"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// object_name define the name of the object
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// payment_address is the payment account charged for the early deletion
+	PaymentAddress string `protobuf:"bytes,4,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// charged_duration is the seconds left to the reserve time of the object, which are charged upfront
+	ChargedDuration int64 `protobuf:"varint,5,opt,name=charged_duration,json=chargedDuration,proto3" json:"charged_duration,omitempty"`
+	// amount is the total amount charged from the payment account
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventChargeEarlyDeletion) Reset()         { *m = EventChargeEarlyDeletion{} }
+func (m *EventChargeEarlyDeletion) String() string { return proto.CompactTextString(m) }
+func (*EventChargeEarlyDeletion) ProtoMessage()    {}
+func (*EventChargeEarlyDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{31}
+}
+func (m *EventChargeEarlyDeletion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChargeEarlyDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChargeEarlyDeletion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChargeEarlyDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChargeEarlyDeletion.Merge(m, src)
+}
+func (m *EventChargeEarlyDeletion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChargeEarlyDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChargeEarlyDeletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChargeEarlyDeletion proto.InternalMessageInfo
+
+func (m *EventChargeEarlyDeletion) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventChargeEarlyDeletion) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *EventChargeEarlyDeletion) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *EventChargeEarlyDeletion) GetChargedDuration() int64 {
+	if m != nil {
+		return m.ChargedDuration
+	}
+	return 0
+}
+
+type EventSubmitReadReceipt struct {
+	// bucket_id define an u256 id for bucket
+	BucketId Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// read_bytes is the receipted read bytes
+	ReadBytes uint64 `protobuf:"varint,3,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// start_time is the start timestamp of the receipt period
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the end timestamp of the receipt period
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// claimable_read_bytes is the read bytes beyond the charged read quota which the sp can claim after the receipt
+	ClaimableReadBytes uint64 `protobuf:"varint,6,opt,name=claimable_read_bytes,json=claimableReadBytes,proto3" json:"claimable_read_bytes,omitempty"`
+	// sp_id is the id of the sp which served the read bytes
+	SpId uint32 `protobuf:"varint,7,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// nonce is the nonce of the receipt
+	Nonce uint64 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *EventSubmitReadReceipt) Reset()         { *m = EventSubmitReadReceipt{} }
+func (m *EventSubmitReadReceipt) String() string { return proto.CompactTextString(m) }
+func (*EventSubmitReadReceipt) ProtoMessage()    {}
+func (*EventSubmitReadReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{32}
+}
+func (m *EventSubmitReadReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubmitReadReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubmitReadReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubmitReadReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubmitReadReceipt.Merge(m, src)
+}
+func (m *EventSubmitReadReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubmitReadReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubmitReadReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubmitReadReceipt proto.InternalMessageInfo
+
+func (m *EventSubmitReadReceipt) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventSubmitReadReceipt) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *EventSubmitReadReceipt) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EventSubmitReadReceipt) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *EventSubmitReadReceipt) GetClaimableReadBytes() uint64 {
+	if m != nil {
+		return m.ClaimableReadBytes
+	}
+	return 0
+}
+
+func (m *EventSubmitReadReceipt) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventSubmitReadReceipt) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type EventClaimReadFee struct {
+	// bucket_id define an u256 id for bucket
+	BucketId Uint `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// bucket_name define the name of the bucket
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// sp_id is the id of the sp which claims the read fee
+	SpId uint32 `protobuf:"varint,3,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// payment_address is the payment account charged for the read fee
+	PaymentAddress string `protobuf:"bytes,4,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	// claimed_read_bytes is the read bytes claimed
+	ClaimedReadBytes uint64 `protobuf:"varint,5,opt,name=claimed_read_bytes,json=claimedReadBytes,proto3" json:"claimed_read_bytes,omitempty"`
+	// amount is the total amount charged from the payment account, including the validator tax
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// funding_address is the funding address of the sp which receives the read fee
+	FundingAddress string `protobuf:"bytes,7,opt,name=funding_address,json=fundingAddress,proto3" json:"funding_address,omitempty"`
+}
+
+func (m *EventClaimReadFee) Reset()         { *m = EventClaimReadFee{} }
+func (m *EventClaimReadFee) String() string { return proto.CompactTextString(m) }
+func (*EventClaimReadFee) ProtoMessage()    {}
+func (*EventClaimReadFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_946dcba4f763ddc4, []int{33}
+}
+func (m *EventClaimReadFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimReadFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimReadFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimReadFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimReadFee.Merge(m, src)
+}
+func (m *EventClaimReadFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimReadFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimReadFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimReadFee proto.InternalMessageInfo
+
+func (m *EventClaimReadFee) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *EventClaimReadFee) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *EventClaimReadFee) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *EventClaimReadFee) GetClaimedReadBytes() uint64 {
+	if m != nil {
+		return m.ClaimedReadBytes
+	}
+	return 0
+}
+
+func (m *EventClaimReadFee) GetFundingAddress() string {
+	if m != nil {
+		return m.FundingAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateBucket)(nil), "greenfield.storage.EventCreateBucket")
 	proto.RegisterType((*EventDeleteBucket)(nil), "greenfield.storage.EventDeleteBucket")
@@ -2405,119 +2668,138 @@ func init() {
 	proto.RegisterType((*EventRejectMigrateBucket)(nil), "greenfield.storage.EventRejectMigrateBucket")
 	proto.RegisterType((*EventCompleteMigrationBucket)(nil), "greenfield.storage.EventCompleteMigrationBucket")
 	proto.RegisterType((*EventSetTag)(nil), "greenfield.storage.EventSetTag")
+	proto.RegisterType((*EventChargeEarlyDeletion)(nil), "greenfield.storage.EventChargeEarlyDeletion")
+	proto.RegisterType((*EventSubmitReadReceipt)(nil), "greenfield.storage.EventSubmitReadReceipt")
+	proto.RegisterType((*EventClaimReadFee)(nil), "greenfield.storage.EventClaimReadFee")
 }
 
 func init() { proto.RegisterFile("greenfield/storage/events.proto", fileDescriptor_946dcba4f763ddc4) }
 
 var fileDescriptor_946dcba4f763ddc4 = []byte{
-	// 1706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x25, 0x4a, 0x96, 0x47, 0x96, 0x14, 0xb3, 0x6e, 0xa2, 0x3a, 0xa9, 0xac, 0xf0, 0x90,
-	0x3a, 0x45, 0x23, 0x15, 0x4e, 0x5a, 0xe4, 0x16, 0xf8, 0x23, 0x2d, 0x84, 0x36, 0x1f, 0xa5, 0x9d,
-	0x1c, 0x7a, 0x21, 0x46, 0xe4, 0x98, 0x66, 0x43, 0x72, 0x58, 0xce, 0xc8, 0x89, 0xf2, 0x0f, 0xf4,
-	0x54, 0x20, 0x40, 0x51, 0xa0, 0xbd, 0xe4, 0x5c, 0xa0, 0x28, 0xd0, 0x43, 0xae, 0xbd, 0xa7, 0xb7,
-	0x34, 0xbd, 0xf4, 0x03, 0xc8, 0x2e, 0x92, 0x53, 0x16, 0x58, 0xec, 0x9e, 0xf7, 0xb4, 0xe0, 0xcc,
-	0x90, 0x22, 0x45, 0x39, 0x32, 0x95, 0x75, 0xec, 0xec, 0x4d, 0x1c, 0xfd, 0x66, 0xf4, 0xde, 0x9b,
-	0xdf, 0xfb, 0xbd, 0x37, 0x43, 0x81, 0x55, 0x2b, 0x40, 0xc8, 0xdb, 0xb3, 0x91, 0x63, 0x76, 0x09,
-	0xc5, 0x01, 0xb4, 0x50, 0x17, 0x1d, 0x20, 0x8f, 0x92, 0x8e, 0x1f, 0x60, 0x8a, 0x15, 0x65, 0x04,
-	0xe8, 0x08, 0xc0, 0xca, 0xf7, 0x0c, 0x4c, 0x5c, 0x4c, 0x74, 0x86, 0xe8, 0xf2, 0x07, 0x0e, 0x5f,
-	0x59, 0xb6, 0xb0, 0x85, 0xf9, 0x78, 0xf8, 0x49, 0x8c, 0xae, 0x5a, 0x18, 0x5b, 0x0e, 0xea, 0xb2,
-	0xa7, 0xfe, 0x60, 0xaf, 0x4b, 0x6d, 0x17, 0x11, 0x0a, 0x5d, 0x3f, 0x06, 0x8c, 0xcc, 0x08, 0x10,
-	0xc1, 0x83, 0xc0, 0x40, 0x5d, 0x3a, 0xf4, 0x11, 0x99, 0x00, 0x88, 0xec, 0x34, 0xb0, 0xeb, 0x62,
-	0x4f, 0x00, 0x5a, 0x13, 0x00, 0x89, 0x05, 0xd4, 0x7f, 0xcb, 0x60, 0xe9, 0x66, 0xe8, 0xd8, 0x56,
-	0x80, 0x20, 0x45, 0x9b, 0x03, 0xe3, 0x01, 0xa2, 0x4a, 0x07, 0x94, 0xf0, 0x43, 0x0f, 0x05, 0x4d,
-	0xa9, 0x2d, 0xad, 0x2d, 0x6c, 0x36, 0x5f, 0x3e, 0xbb, 0xb2, 0x2c, 0xfc, 0xd9, 0x30, 0xcd, 0x00,
-	0x11, 0xb2, 0x43, 0x03, 0xdb, 0xb3, 0x34, 0x0e, 0x53, 0x56, 0x41, 0xb5, 0xcf, 0x66, 0xea, 0x1e,
-	0x74, 0x51, 0xb3, 0x10, 0xce, 0xd2, 0x00, 0x1f, 0xba, 0x0d, 0x5d, 0xa4, 0x6c, 0x02, 0x70, 0x60,
-	0x13, 0xbb, 0x6f, 0x3b, 0x36, 0x1d, 0x36, 0x8b, 0x6d, 0x69, 0xad, 0xbe, 0xae, 0x76, 0xb2, 0x31,
-	0xec, 0xdc, 0x8f, 0x51, 0xbb, 0x43, 0x1f, 0x69, 0x89, 0x59, 0xca, 0x79, 0xb0, 0x60, 0x30, 0x23,
-	0x75, 0x48, 0x9b, 0x72, 0x5b, 0x5a, 0x2b, 0x6a, 0x15, 0x3e, 0xb0, 0x41, 0x95, 0xeb, 0x60, 0x41,
-	0x58, 0x60, 0x9b, 0xcd, 0x12, 0xb3, 0xfa, 0xfc, 0xf3, 0x57, 0xab, 0x73, 0xff, 0x7b, 0xb5, 0x2a,
-	0xdf, 0xb3, 0x3d, 0xfa, 0xf2, 0xd9, 0x95, 0xaa, 0xf0, 0x20, 0x7c, 0xd4, 0x2a, 0x1c, 0xdd, 0x33,
-	0x95, 0x1b, 0xa0, 0xca, 0x03, 0xab, 0x87, 0x71, 0x69, 0x96, 0x99, 0x6d, 0xad, 0x49, 0xb6, 0xed,
-	0x30, 0x18, 0xb7, 0x8b, 0xc4, 0x9f, 0x95, 0x1f, 0x01, 0xc5, 0xd8, 0x87, 0x81, 0x85, 0x4c, 0x3d,
-	0x40, 0xd0, 0xd4, 0x7f, 0x3b, 0xc0, 0x14, 0x36, 0xe7, 0xdb, 0xd2, 0x9a, 0xac, 0x9d, 0x11, 0xdf,
-	0x68, 0x08, 0x9a, 0xbf, 0x0a, 0xc7, 0x95, 0x0d, 0xd0, 0xf0, 0xe1, 0xd0, 0x45, 0x1e, 0xd5, 0x21,
-	0x0f, 0x65, 0xb3, 0x32, 0x25, 0xc8, 0x75, 0x31, 0x41, 0x8c, 0x2a, 0x2a, 0xa8, 0xf9, 0x81, 0xed,
-	0xc2, 0x60, 0xa8, 0x13, 0x3f, 0xf4, 0x77, 0xa1, 0x2d, 0xad, 0xd5, 0xb4, 0xaa, 0x18, 0xdc, 0xf1,
-	0x7b, 0xa6, 0xb2, 0x09, 0x5a, 0x96, 0x83, 0xfb, 0xd0, 0xd1, 0x0f, 0xec, 0x80, 0x0e, 0xa0, 0xa3,
-	0x5b, 0x01, 0x1e, 0xf8, 0xfa, 0x1e, 0x74, 0x6d, 0x67, 0x18, 0x4e, 0x02, 0x6c, 0xd2, 0x0a, 0x47,
-	0xdd, 0xe7, 0xa0, 0x9f, 0x87, 0x98, 0x9f, 0x31, 0x48, 0xcf, 0x54, 0xae, 0x83, 0x32, 0xa1, 0x90,
-	0x0e, 0x48, 0xb3, 0xca, 0x82, 0xd2, 0x9e, 0x14, 0x14, 0xce, 0x98, 0x1d, 0x86, 0xd3, 0x04, 0x5e,
-	0xfd, 0x53, 0x41, 0xb0, 0x6a, 0x1b, 0x39, 0x28, 0x66, 0xd5, 0x35, 0x50, 0xc1, 0x3e, 0x0a, 0x20,
-	0xc5, 0xd3, 0x89, 0x15, 0x23, 0x47, 0x5c, 0x2c, 0xcc, 0xc4, 0xc5, 0x62, 0x86, 0x8b, 0x29, 0xaa,
-	0xc8, 0x79, 0xa8, 0x32, 0x3d, 0xa8, 0xa5, 0x69, 0x41, 0x55, 0x7f, 0x57, 0x04, 0xdf, 0x65, 0xa1,
-	0xb9, 0xe7, 0x9b, 0x71, 0xc2, 0xf5, 0xbc, 0x3d, 0x3c, 0x63, 0x78, 0xa6, 0xa6, 0x5e, 0xca, 0xdd,
-	0x62, 0x1e, 0x77, 0x27, 0x13, 0x5b, 0x3e, 0x84, 0xd8, 0x3f, 0xc8, 0x12, 0x9b, 0xe5, 0x61, 0x86,
-	0xbe, 0x69, 0x2d, 0x28, 0xcf, 0xa4, 0x05, 0xd3, 0x77, 0x62, 0x7e, 0xea, 0x4e, 0xfc, 0x45, 0x02,
-	0x67, 0x39, 0x49, 0x6d, 0x62, 0x60, 0x8f, 0xda, 0xde, 0x20, 0x62, 0x6a, 0x2a, 0x66, 0x52, 0x9e,
-	0x98, 0x4d, 0xdd, 0x8e, 0xb3, 0xa0, 0x1c, 0x20, 0x48, 0xb0, 0x27, 0x98, 0x29, 0x9e, 0x42, 0x75,
-	0x33, 0x59, 0xb2, 0x24, 0xd4, 0x8d, 0x0f, 0x6c, 0x50, 0xf5, 0x0f, 0xe5, 0x94, 0x4a, 0xdf, 0xe9,
-	0xff, 0x06, 0x19, 0x54, 0x59, 0x07, 0xf3, 0x4c, 0xff, 0x8e, 0xc0, 0x97, 0x08, 0xf8, 0xcd, 0x67,
-	0xd3, 0x2a, 0xa8, 0x62, 0x66, 0x0e, 0x07, 0xc8, 0x1c, 0xc0, 0x87, 0xb2, 0xfc, 0x2b, 0xe7, 0x89,
-	0xe5, 0x75, 0xb0, 0x20, 0x96, 0x16, 0xfb, 0x39, 0x6d, 0x26, 0x47, 0xf7, 0xcc, 0xac, 0x42, 0x56,
-	0xb2, 0x0a, 0x79, 0x11, 0x2c, 0xfa, 0x70, 0xe8, 0x60, 0x68, 0xea, 0xc4, 0x7e, 0x8c, 0x98, 0x88,
-	0xca, 0x5a, 0x55, 0x8c, 0xed, 0xd8, 0x8f, 0xc7, 0xab, 0x16, 0x98, 0x89, 0xa9, 0x17, 0xc1, 0x62,
-	0x48, 0xae, 0x30, 0x2d, 0x58, 0x7d, 0xa9, 0xb2, 0x00, 0x55, 0xc5, 0x18, 0x2b, 0x20, 0xa9, 0xc2,
-	0xb6, 0x98, 0x29, 0x6c, 0x91, 0x08, 0xd7, 0x0e, 0x17, 0x61, 0x4e, 0x88, 0xb4, 0x08, 0x2b, 0xbf,
-	0x00, 0x8d, 0x00, 0x99, 0x03, 0xcf, 0x84, 0x9e, 0x31, 0xe4, 0x3f, 0x5e, 0x3f, 0xdc, 0x05, 0x2d,
-	0x86, 0x32, 0x17, 0xea, 0x41, 0xea, 0x79, 0xbc, 0x4a, 0x36, 0x72, 0x57, 0xc9, 0x0b, 0x60, 0xc1,
-	0xd8, 0x47, 0xc6, 0x03, 0x32, 0x70, 0x49, 0xf3, 0x4c, 0xbb, 0xb8, 0xb6, 0xa8, 0x8d, 0x06, 0x94,
-	0xab, 0xe0, 0xac, 0x83, 0x8d, 0x4c, 0x3a, 0xdb, 0x66, 0x73, 0x89, 0xed, 0xdc, 0x77, 0xd8, 0xb7,
-	0xc9, 0x34, 0xee, 0x99, 0xea, 0x17, 0x12, 0x38, 0xc7, 0xb3, 0x02, 0x7a, 0x06, 0x72, 0x52, 0xb9,
-	0x71, 0x4c, 0x62, 0x3a, 0xc6, 0xf6, 0x62, 0x86, 0xed, 0x19, 0xe6, 0xc9, 0x59, 0xe6, 0xa5, 0x78,
-	0x5d, 0xce, 0xc1, 0x6b, 0xf5, 0x6d, 0x01, 0x34, 0x98, 0xc7, 0x3b, 0x08, 0x3a, 0x27, 0xec, 0x69,
-	0xca, 0x8b, 0x52, 0x9e, 0xec, 0x1c, 0x51, 0xba, 0x9c, 0x93, 0xd2, 0x3f, 0x01, 0xe7, 0x26, 0xca,
-	0x7e, 0xac, 0xf7, 0xcb, 0x59, 0xbd, 0xef, 0x99, 0xef, 0x60, 0x57, 0xe5, 0x70, 0x76, 0x3d, 0x2d,
-	0x8a, 0x58, 0x6f, 0x61, 0x7f, 0xf8, 0x5e, 0xb1, 0xbe, 0x04, 0x1a, 0x24, 0x30, 0xf4, 0x6c, 0xbc,
-	0x6b, 0x24, 0x30, 0x36, 0x47, 0x21, 0x17, 0xb8, 0x6c, 0xd8, 0x43, 0xdc, 0x9d, 0x51, 0xe4, 0x2f,
-	0x81, 0x86, 0x49, 0x68, 0x6a, 0x3d, 0x2e, 0xbb, 0x35, 0x93, 0xd0, 0xf4, 0x7a, 0x21, 0x2e, 0xb9,
-	0x5e, 0x29, 0xc6, 0x25, 0xd6, 0xbb, 0x01, 0x6a, 0x89, 0xdf, 0x3d, 0x1a, 0x27, 0xab, 0xb1, 0x49,
-	0xac, 0x85, 0xae, 0x25, 0x7e, 0xe8, 0x68, 0x62, 0x5d, 0x8d, 0x6d, 0x98, 0x75, 0x83, 0xbe, 0x92,
-	0x52, 0x4d, 0xe6, 0x69, 0x4a, 0x07, 0x39, 0x4f, 0x3a, 0x1c, 0xee, 0x7c, 0xe9, 0x70, 0xe7, 0xff,
-	0x29, 0x89, 0x36, 0x52, 0x43, 0x2c, 0x4f, 0x4e, 0x99, 0x1e, 0xe4, 0x09, 0xc0, 0xc4, 0x46, 0x4c,
-	0x38, 0x33, 0x66, 0x96, 0x34, 0xa9, 0xbb, 0x1d, 0xfd, 0x6a, 0x21, 0x4f, 0xd8, 0x67, 0x6a, 0xc4,
-	0x7e, 0x5f, 0x48, 0x75, 0xef, 0x82, 0xc0, 0xc7, 0xd8, 0xbd, 0x1f, 0x23, 0xef, 0xd2, 0xdd, 0x4d,
-	0x69, 0x96, 0xee, 0x46, 0xfd, 0x52, 0x02, 0x67, 0x12, 0x8d, 0x29, 0x63, 0x67, 0xee, 0xdb, 0x83,
-	0xef, 0x03, 0xc0, 0x29, 0x9f, 0x88, 0xc1, 0x02, 0x1b, 0x61, 0x1e, 0xfe, 0x14, 0x54, 0xe2, 0x8c,
-	0x38, 0xc2, 0xf9, 0x65, 0xde, 0x12, 0xaa, 0x3f, 0xd6, 0xb2, 0xc8, 0xb9, 0x5b, 0x96, 0x65, 0x50,
-	0x42, 0x8f, 0x68, 0x00, 0x85, 0x6a, 0xf2, 0x07, 0xf5, 0xcf, 0x91, 0xcb, 0x5c, 0x76, 0xc6, 0x5c,
-	0x2e, 0xcc, 0xe2, 0x72, 0xf1, 0x5d, 0x2e, 0xcb, 0x47, 0x77, 0x59, 0xfd, 0xaf, 0x24, 0x6a, 0xd6,
-	0x2f, 0x11, 0x3c, 0x10, 0xa6, 0xdd, 0x00, 0x75, 0x17, 0xb9, 0x7d, 0x14, 0xc4, 0xc7, 0xb2, 0x69,
-	0xdb, 0x52, 0xe3, 0xf8, 0xe8, 0xbc, 0x76, 0x4a, 0x7c, 0xfb, 0xbc, 0x20, 0x54, 0x82, 0xa7, 0x1e,
-	0x73, 0xee, 0x16, 0x33, 0xf4, 0x03, 0x5d, 0x2c, 0x1c, 0x8f, 0x5f, 0xca, 0xdd, 0x68, 0x7f, 0x88,
-	0x4e, 0x71, 0xb8, 0x47, 0xcd, 0x52, 0xbb, 0xb8, 0x56, 0x5d, 0xff, 0xe1, 0x24, 0xa6, 0xb2, 0x00,
-	0x24, 0x5c, 0xdf, 0x46, 0x14, 0xda, 0x8e, 0xb6, 0x28, 0x56, 0xd8, 0xc5, 0x1b, 0xa6, 0xa9, 0x6c,
-	0x83, 0xa5, 0xc4, 0x8a, 0x5c, 0xbb, 0x9a, 0xe5, 0x76, 0xf1, 0x9d, 0x4e, 0x36, 0xe2, 0x25, 0x38,
-	0xaf, 0xd5, 0xff, 0x17, 0xe2, 0x0a, 0xe3, 0xa1, 0x87, 0xdf, 0x9a, 0x70, 0x8f, 0xa9, 0x42, 0x29,
-	0xb7, 0x2a, 0x6c, 0x83, 0x79, 0x11, 0x2a, 0x16, 0xd3, 0x7c, 0x1b, 0x15, 0x4d, 0x55, 0xff, 0x18,
-	0xd5, 0xbc, 0x0c, 0x46, 0xf9, 0x31, 0x28, 0x73, 0xd4, 0xd4, 0xe0, 0x0a, 0x9c, 0xd2, 0x03, 0x0d,
-	0xf4, 0xc8, 0xb7, 0x03, 0x48, 0x6d, 0xec, 0xe9, 0xd4, 0x16, 0x2a, 0x5a, 0x5d, 0x5f, 0xe9, 0xf0,
-	0x1b, 0xe6, 0x4e, 0x74, 0xc3, 0xdc, 0xd9, 0x8d, 0x6e, 0x98, 0x37, 0xe5, 0x27, 0x9f, 0xac, 0x4a,
-	0x5a, 0x7d, 0x34, 0x31, 0xfc, 0x4a, 0xfd, 0x4c, 0x4a, 0x15, 0x38, 0x66, 0xdd, 0xcd, 0x50, 0xf7,
-	0x3e, 0xee, 0x5d, 0x9f, 0x2c, 0xe5, 0xcf, 0xa3, 0x0e, 0xf2, 0x96, 0x1d, 0x04, 0x38, 0x78, 0xaf,
-	0x6b, 0xca, 0x7c, 0xf7, 0x70, 0xb9, 0xae, 0x1d, 0x55, 0x50, 0x33, 0x11, 0xa1, 0xba, 0xb1, 0x0f,
-	0x6d, 0x6f, 0xd4, 0x17, 0x56, 0xc3, 0xc1, 0xad, 0x70, 0xac, 0x67, 0xaa, 0x7f, 0x8f, 0xce, 0xc2,
-	0x49, 0x57, 0x34, 0x44, 0x06, 0x0e, 0x0d, 0x3b, 0x1d, 0x71, 0xde, 0x92, 0xd8, 0xc4, 0xe8, 0x34,
-	0x75, 0xc2, 0x26, 0xbf, 0x4d, 0x47, 0xff, 0xa3, 0xed, 0xdf, 0x8f, 0xe2, 0xeb, 0xbf, 0xd2, 0xdb,
-	0xc3, 0x7d, 0x7d, 0xdf, 0xed, 0x39, 0x61, 0x9f, 0xfe, 0x11, 0x35, 0x42, 0xdc, 0xa7, 0x53, 0xd5,
-	0xfb, 0x65, 0xec, 0x97, 0xb3, 0xf6, 0xff, 0x35, 0x92, 0xe0, 0x84, 0xfd, 0x53, 0xb6, 0xe4, 0x04,
-	0xad, 0x3d, 0x10, 0x04, 0xda, 0xa1, 0xd0, 0x41, 0x77, 0xb1, 0x63, 0x1b, 0xc3, 0x2d, 0x07, 0x41,
-	0x6f, 0xe0, 0x2b, 0x2b, 0xa0, 0xd2, 0x77, 0xb0, 0xf1, 0xe0, 0xf6, 0xc0, 0x65, 0xf6, 0x16, 0xb5,
-	0xf8, 0x39, 0x2c, 0x77, 0xe2, 0x34, 0x63, 0x7b, 0x7b, 0x58, 0x94, 0x85, 0x89, 0xe5, 0x8e, 0x97,
-	0xfd, 0xf0, 0x2c, 0xa3, 0x01, 0x33, 0xfe, 0xac, 0xbe, 0x94, 0xc0, 0xb2, 0x88, 0x92, 0xc5, 0xeb,
-	0xc4, 0x07, 0x94, 0xc9, 0x5c, 0xaf, 0x2b, 0x2e, 0x83, 0x25, 0x93, 0x50, 0x7d, 0xd2, 0xf5, 0x5b,
-	0xdd, 0x24, 0xf4, 0xee, 0xe8, 0x06, 0x4e, 0xfd, 0x9b, 0x04, 0x56, 0x12, 0x37, 0x87, 0xa7, 0xdd,
-	0xb5, 0x90, 0xaa, 0xcd, 0xc4, 0x69, 0x9f, 0xdb, 0x8b, 0x4e, 0xab, 0xb5, 0x4f, 0x0b, 0xe0, 0x82,
-	0xb8, 0x39, 0x73, 0xfd, 0x90, 0x48, 0xa7, 0x9e, 0x3a, 0xd3, 0x5f, 0x27, 0xc9, 0x53, 0xdf, 0x96,
-	0x5e, 0x06, 0x4b, 0x24, 0x30, 0xc6, 0xe8, 0xc7, 0x65, 0xb3, 0x4e, 0x02, 0x23, 0x49, 0x3f, 0x1d,
-	0x54, 0xc5, 0x2d, 0x2e, 0xdd, 0x85, 0x56, 0x98, 0xbf, 0xd1, 0xcb, 0x7d, 0x71, 0xc3, 0x11, 0x3f,
-	0x2b, 0xd7, 0x80, 0x4c, 0xa1, 0x45, 0x44, 0xe2, 0xb6, 0x27, 0xdf, 0xdc, 0x8b, 0xee, 0x14, 0x5a,
-	0x44, 0x63, 0xe8, 0xcd, 0xde, 0xf3, 0xd7, 0x2d, 0xe9, 0xc5, 0xeb, 0x96, 0xf4, 0xe9, 0xeb, 0x96,
-	0xf4, 0xe4, 0x4d, 0x6b, 0xee, 0xc5, 0x9b, 0xd6, 0xdc, 0x7f, 0xde, 0xb4, 0xe6, 0x7e, 0xdd, 0xb5,
-	0x6c, 0xba, 0x3f, 0xe8, 0x77, 0x0c, 0xec, 0x76, 0xfb, 0x5e, 0xff, 0x0a, 0x93, 0x9c, 0x6e, 0xe2,
-	0x4f, 0x02, 0x8f, 0xd2, 0x7f, 0x13, 0xe8, 0x97, 0x59, 0xeb, 0x78, 0xf5, 0xeb, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xb7, 0x14, 0x16, 0x12, 0x21, 0x00, 0x00,
+	// 1956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x77, 0xb9, 0xab, 0xdd, 0x59, 0xad, 0xd6, 0x62, 0x54, 0x67, 0xa3, 0x24, 0xab, 0x0d,
+	0x0f, 0xae, 0x5c, 0xd4, 0xab, 0xc0, 0x49, 0x0b, 0x1f, 0x0a, 0x18, 0xfa, 0x70, 0x8a, 0x45, 0x9b,
+	0xc4, 0xa5, 0x94, 0x1c, 0x7a, 0x21, 0x66, 0x39, 0x23, 0x9a, 0x35, 0xc9, 0x61, 0x39, 0x43, 0xc5,
+	0x9b, 0x7f, 0xa0, 0xa7, 0x00, 0x01, 0x8a, 0x02, 0xed, 0x25, 0xe7, 0x16, 0x45, 0x81, 0x1e, 0x72,
+	0xed, 0xdd, 0xbd, 0xa5, 0xee, 0xa5, 0x4d, 0x81, 0xb4, 0xb0, 0x4f, 0x29, 0x50, 0xb4, 0xe7, 0x9e,
+	0x8a, 0xf9, 0x20, 0x97, 0x14, 0x57, 0x5e, 0x51, 0x8e, 0x62, 0xb9, 0x27, 0x69, 0x1e, 0xdf, 0x0c,
+	0xdf, 0xfb, 0xcd, 0x6f, 0xde, 0x7b, 0x7c, 0xb3, 0x60, 0xc3, 0x8d, 0x31, 0x0e, 0x0f, 0x3d, 0xec,
+	0xa3, 0x2d, 0xca, 0x48, 0x0c, 0x5d, 0xbc, 0x85, 0x8f, 0x70, 0xc8, 0xe8, 0x28, 0x8a, 0x09, 0x23,
+	0x86, 0x31, 0x53, 0x18, 0x29, 0x85, 0xf5, 0x97, 0x1c, 0x42, 0x03, 0x42, 0x6d, 0xa1, 0xb1, 0x25,
+	0x07, 0x52, 0x7d, 0x7d, 0xcd, 0x25, 0x2e, 0x91, 0x72, 0xfe, 0x9f, 0x92, 0x6e, 0xb8, 0x84, 0xb8,
+	0x3e, 0xde, 0x12, 0xa3, 0x49, 0x72, 0xb8, 0xc5, 0xbc, 0x00, 0x53, 0x06, 0x83, 0x28, 0x53, 0x98,
+	0x99, 0x11, 0x63, 0x4a, 0x92, 0xd8, 0xc1, 0x5b, 0x6c, 0x1a, 0x61, 0x3a, 0x47, 0x21, 0xb5, 0xd3,
+	0x21, 0x41, 0x40, 0x42, 0xa5, 0x30, 0x98, 0xa3, 0x90, 0x5b, 0xc0, 0xfc, 0xb3, 0x0e, 0x56, 0x6f,
+	0x73, 0xc7, 0x76, 0x63, 0x0c, 0x19, 0xde, 0x49, 0x9c, 0x7b, 0x98, 0x19, 0x23, 0xd0, 0x20, 0x1f,
+	0x84, 0x38, 0xee, 0x6b, 0x43, 0x6d, 0xb3, 0xbd, 0xd3, 0x7f, 0xf8, 0xe9, 0xf5, 0x35, 0xe5, 0xcf,
+	0x36, 0x42, 0x31, 0xa6, 0x74, 0x9f, 0xc5, 0x5e, 0xe8, 0x5a, 0x52, 0xcd, 0xd8, 0x00, 0x9d, 0x89,
+	0x98, 0x69, 0x87, 0x30, 0xc0, 0xfd, 0x1a, 0x9f, 0x65, 0x01, 0x29, 0x7a, 0x07, 0x06, 0xd8, 0xd8,
+	0x01, 0xe0, 0xc8, 0xa3, 0xde, 0xc4, 0xf3, 0x3d, 0x36, 0xed, 0xd7, 0x87, 0xda, 0xe6, 0xca, 0x0d,
+	0x73, 0x54, 0xc6, 0x70, 0xf4, 0x7e, 0xa6, 0x75, 0x30, 0x8d, 0xb0, 0x95, 0x9b, 0x65, 0xbc, 0x0c,
+	0xda, 0x8e, 0x30, 0xd2, 0x86, 0xac, 0xaf, 0x0f, 0xb5, 0xcd, 0xba, 0xd5, 0x92, 0x82, 0x6d, 0x66,
+	0xdc, 0x04, 0x6d, 0x65, 0x81, 0x87, 0xfa, 0x0d, 0x61, 0xf5, 0xcb, 0x0f, 0xbe, 0xd8, 0xb8, 0xf4,
+	0xf9, 0x17, 0x1b, 0xfa, 0x7b, 0x5e, 0xc8, 0x1e, 0x7e, 0x7a, 0xbd, 0xa3, 0x3c, 0xe0, 0x43, 0xab,
+	0x25, 0xb5, 0xc7, 0xc8, 0xb8, 0x05, 0x3a, 0x12, 0x58, 0x9b, 0xe3, 0xd2, 0x6f, 0x0a, 0xdb, 0x06,
+	0xf3, 0x6c, 0xdb, 0x17, 0x6a, 0xd2, 0x2e, 0x9a, 0xfd, 0x6f, 0x7c, 0x1b, 0x18, 0xce, 0x5d, 0x18,
+	0xbb, 0x18, 0xd9, 0x31, 0x86, 0xc8, 0xfe, 0x69, 0x42, 0x18, 0xec, 0x2f, 0x0d, 0xb5, 0x4d, 0xdd,
+	0xba, 0xac, 0x9e, 0x58, 0x18, 0xa2, 0x1f, 0x71, 0xb9, 0xb1, 0x0d, 0x7a, 0x11, 0x9c, 0x06, 0x38,
+	0x64, 0x36, 0x94, 0x50, 0xf6, 0x5b, 0x0b, 0x40, 0x5e, 0x51, 0x13, 0x94, 0xd4, 0x30, 0x41, 0x37,
+	0x8a, 0xbd, 0x00, 0xc6, 0x53, 0x9b, 0x46, 0xdc, 0xdf, 0xf6, 0x50, 0xdb, 0xec, 0x5a, 0x1d, 0x25,
+	0xdc, 0x8f, 0xc6, 0xc8, 0xd8, 0x01, 0x03, 0xd7, 0x27, 0x13, 0xe8, 0xdb, 0x47, 0x5e, 0xcc, 0x12,
+	0xe8, 0xdb, 0x6e, 0x4c, 0x92, 0xc8, 0x3e, 0x84, 0x81, 0xe7, 0x4f, 0xf9, 0x24, 0x20, 0x26, 0xad,
+	0x4b, 0xad, 0xf7, 0xa5, 0xd2, 0xf7, 0xb9, 0xce, 0x5b, 0x42, 0x65, 0x8c, 0x8c, 0x9b, 0xa0, 0x49,
+	0x19, 0x64, 0x09, 0xed, 0x77, 0x04, 0x28, 0xc3, 0x79, 0xa0, 0x48, 0xc6, 0xec, 0x0b, 0x3d, 0x4b,
+	0xe9, 0x9b, 0xbf, 0xac, 0x29, 0x56, 0xed, 0x61, 0x1f, 0x67, 0xac, 0x7a, 0x13, 0xb4, 0x48, 0x84,
+	0x63, 0xc8, 0xc8, 0x62, 0x62, 0x65, 0x9a, 0x33, 0x2e, 0xd6, 0xce, 0xc4, 0xc5, 0x7a, 0x89, 0x8b,
+	0x05, 0xaa, 0xe8, 0x55, 0xa8, 0xb2, 0x18, 0xd4, 0xc6, 0x22, 0x50, 0xcd, 0x9f, 0xd5, 0xc1, 0x37,
+	0x04, 0x34, 0xef, 0x45, 0x28, 0x3b, 0x70, 0xe3, 0xf0, 0x90, 0x9c, 0x11, 0x9e, 0x85, 0x47, 0xaf,
+	0xe0, 0x6e, 0xbd, 0x8a, 0xbb, 0xf3, 0x89, 0xad, 0x9f, 0x40, 0xec, 0x6f, 0x96, 0x89, 0x2d, 0xce,
+	0x61, 0x89, 0xbe, 0xc5, 0x58, 0xd0, 0x3c, 0x53, 0x2c, 0x58, 0xbc, 0x13, 0x4b, 0x0b, 0x77, 0xe2,
+	0xd7, 0x1a, 0xb8, 0x22, 0x49, 0xea, 0x51, 0x87, 0x84, 0xcc, 0x0b, 0x93, 0x94, 0xa9, 0x05, 0xcc,
+	0xb4, 0x2a, 0x98, 0x2d, 0xdc, 0x8e, 0x2b, 0xa0, 0x19, 0x63, 0x48, 0x49, 0xa8, 0x98, 0xa9, 0x46,
+	0x3c, 0xba, 0x21, 0x71, 0x58, 0x72, 0xd1, 0x4d, 0x0a, 0xb6, 0x99, 0xf9, 0xf3, 0x66, 0x21, 0x4a,
+	0xbf, 0x3b, 0xf9, 0x09, 0x76, 0x98, 0x71, 0x03, 0x2c, 0x89, 0xf8, 0x77, 0x0a, 0xbe, 0xa4, 0x8a,
+	0x5f, 0xfd, 0x69, 0xda, 0x00, 0x1d, 0x22, 0xcc, 0x91, 0x0a, 0xba, 0x54, 0x90, 0xa2, 0x32, 0xff,
+	0x9a, 0x55, 0xb0, 0xbc, 0x09, 0xda, 0x6a, 0x69, 0xb5, 0x9f, 0x8b, 0x66, 0x4a, 0xed, 0x31, 0x2a,
+	0x47, 0xc8, 0x56, 0x39, 0x42, 0xbe, 0x06, 0x96, 0x23, 0x38, 0xf5, 0x09, 0x44, 0x36, 0xf5, 0x3e,
+	0xc4, 0x22, 0x88, 0xea, 0x56, 0x47, 0xc9, 0xf6, 0xbd, 0x0f, 0x8f, 0x67, 0x2d, 0x70, 0x26, 0xa6,
+	0xbe, 0x06, 0x96, 0x39, 0xb9, 0xf8, 0xb1, 0x10, 0xf9, 0xa5, 0x23, 0x00, 0xea, 0x28, 0x99, 0x48,
+	0x20, 0x85, 0xc4, 0xb6, 0x5c, 0x4a, 0x6c, 0x69, 0x10, 0xee, 0x9e, 0x1c, 0x84, 0x25, 0x21, 0x8a,
+	0x41, 0xd8, 0xf8, 0x01, 0xe8, 0xc5, 0x18, 0x25, 0x21, 0x82, 0xa1, 0x33, 0x95, 0x2f, 0x5f, 0x39,
+	0xd9, 0x05, 0x2b, 0x53, 0x15, 0x2e, 0xac, 0xc4, 0x85, 0xf1, 0xf1, 0x2c, 0xd9, 0xab, 0x9c, 0x25,
+	0x5f, 0x01, 0x6d, 0xe7, 0x2e, 0x76, 0xee, 0xd1, 0x24, 0xa0, 0xfd, 0xcb, 0xc3, 0xfa, 0xe6, 0xb2,
+	0x35, 0x13, 0x18, 0x6f, 0x80, 0x2b, 0x3e, 0x71, 0x4a, 0xc7, 0xd9, 0x43, 0xfd, 0x55, 0xb1, 0x73,
+	0x2f, 0x88, 0xa7, 0xf9, 0x63, 0x3c, 0x46, 0xe6, 0xbf, 0x35, 0xf0, 0xa2, 0x3c, 0x15, 0x30, 0x74,
+	0xb0, 0x5f, 0x38, 0x1b, 0xe7, 0x14, 0x4c, 0x8f, 0xb1, 0xbd, 0x5e, 0x62, 0x7b, 0x89, 0x79, 0x7a,
+	0x99, 0x79, 0x05, 0x5e, 0x37, 0x2b, 0xf0, 0xda, 0xfc, 0xb2, 0x06, 0x7a, 0xc2, 0xe3, 0x7d, 0x0c,
+	0xfd, 0x67, 0xec, 0x69, 0xc1, 0x8b, 0x46, 0x95, 0xd3, 0x39, 0xa3, 0x74, 0xb3, 0x22, 0xa5, 0xbf,
+	0x03, 0x5e, 0x9c, 0x1b, 0xf6, 0xb3, 0x78, 0xbf, 0x56, 0x8e, 0xf7, 0x63, 0xf4, 0x04, 0x76, 0xb5,
+	0x4e, 0x66, 0xd7, 0x27, 0x75, 0x85, 0xf5, 0x2e, 0x89, 0xa6, 0x4f, 0x85, 0xf5, 0x55, 0xd0, 0xa3,
+	0xb1, 0x63, 0x97, 0xf1, 0xee, 0xd2, 0xd8, 0xd9, 0x99, 0x41, 0xae, 0xf4, 0xca, 0xb0, 0x73, 0xbd,
+	0x77, 0x67, 0xc8, 0x5f, 0x05, 0x3d, 0x44, 0x59, 0x61, 0x3d, 0x19, 0x76, 0xbb, 0x88, 0xb2, 0xe2,
+	0x7a, 0x5c, 0x2f, 0xbf, 0x5e, 0x23, 0xd3, 0xcb, 0xad, 0x77, 0x0b, 0x74, 0x73, 0xef, 0x3d, 0x1d,
+	0x27, 0x3b, 0x99, 0x49, 0xa2, 0x84, 0xee, 0xe6, 0x5e, 0x74, 0xba, 0x60, 0xdd, 0xc9, 0x6c, 0x38,
+	0xeb, 0x06, 0xfd, 0x57, 0x2b, 0x14, 0x99, 0x17, 0xe9, 0x38, 0xe8, 0x55, 0x8e, 0xc3, 0xc9, 0xce,
+	0x37, 0x4e, 0x76, 0xfe, 0x8f, 0x9a, 0x2a, 0x23, 0x2d, 0x2c, 0xce, 0xc9, 0x05, 0x8b, 0x07, 0x55,
+	0x00, 0x98, 0x5b, 0x88, 0x29, 0x67, 0x8e, 0x99, 0xa5, 0xcd, 0xab, 0x6e, 0x67, 0x6f, 0xad, 0x55,
+	0x81, 0xfd, 0x4c, 0x85, 0xd8, 0x47, 0xb5, 0x42, 0xf5, 0xae, 0x08, 0x7c, 0x8e, 0xd5, 0xfb, 0x39,
+	0xf2, 0xae, 0x58, 0xdd, 0x34, 0xce, 0x52, 0xdd, 0x98, 0xff, 0xd1, 0xc0, 0xe5, 0x5c, 0x61, 0x2a,
+	0xd8, 0x59, 0xb9, 0x7b, 0xf0, 0x2a, 0x00, 0x92, 0xf2, 0x39, 0x0c, 0xda, 0x42, 0x22, 0x3c, 0xfc,
+	0x2e, 0x68, 0x65, 0x27, 0xe2, 0x14, 0xdf, 0x2f, 0x4b, 0xae, 0x8a, 0xfa, 0xc7, 0x4a, 0x16, 0xbd,
+	0x72, 0xc9, 0xb2, 0x06, 0x1a, 0xf8, 0x3e, 0x8b, 0xa1, 0x8a, 0x9a, 0x72, 0x60, 0xfe, 0x2a, 0x75,
+	0x59, 0x86, 0x9d, 0x63, 0x2e, 0xd7, 0xce, 0xe2, 0x72, 0xfd, 0x49, 0x2e, 0xeb, 0xa7, 0x77, 0xd9,
+	0xfc, 0xab, 0xa6, 0x72, 0xd6, 0x0f, 0x31, 0x3c, 0x52, 0xa6, 0xdd, 0x02, 0x2b, 0x01, 0x0e, 0x26,
+	0x38, 0xce, 0x3e, 0xcb, 0x16, 0x6d, 0x4b, 0x57, 0xea, 0x2b, 0xe1, 0x45, 0xf1, 0xed, 0x5f, 0x35,
+	0x70, 0x25, 0x77, 0xf4, 0x84, 0x73, 0x6f, 0x0b, 0x43, 0xbf, 0xa6, 0xc6, 0xc2, 0xf9, 0xf8, 0x65,
+	0xdc, 0x49, 0xf7, 0x87, 0xda, 0x8c, 0xf0, 0x3d, 0xea, 0x37, 0x86, 0xf5, 0xcd, 0xce, 0x8d, 0x6f,
+	0xcd, 0x63, 0xaa, 0x00, 0x20, 0xe7, 0xfa, 0x1e, 0x66, 0xd0, 0xf3, 0xad, 0x65, 0xb5, 0xc2, 0x01,
+	0xd9, 0x46, 0xc8, 0xd8, 0x03, 0xab, 0xb9, 0x15, 0x65, 0xec, 0xea, 0x37, 0x87, 0xf5, 0x27, 0x3a,
+	0xd9, 0xcb, 0x96, 0x90, 0xbc, 0x36, 0xff, 0x56, 0xcb, 0x32, 0x4c, 0x88, 0x3f, 0xf8, 0xbf, 0x81,
+	0xfb, 0x58, 0x54, 0x68, 0x54, 0x8e, 0x0a, 0x7b, 0x60, 0x49, 0x41, 0xd5, 0x6f, 0x56, 0xde, 0xa8,
+	0x74, 0xaa, 0xf9, 0x8b, 0x34, 0xe7, 0x95, 0x74, 0x8c, 0xd7, 0x41, 0x53, 0x6a, 0x2d, 0x04, 0x57,
+	0xe9, 0x19, 0x63, 0xd0, 0xc3, 0xf7, 0x23, 0x2f, 0x86, 0xcc, 0x23, 0xa1, 0xcd, 0x3c, 0x15, 0x45,
+	0x3b, 0x37, 0xd6, 0x47, 0xb2, 0xc3, 0x3c, 0x4a, 0x3b, 0xcc, 0xa3, 0x83, 0xb4, 0xc3, 0xbc, 0xa3,
+	0x7f, 0xfc, 0xf7, 0x0d, 0xcd, 0x5a, 0x99, 0x4d, 0xe4, 0x8f, 0xcc, 0x7f, 0x6a, 0x85, 0x04, 0x27,
+	0xac, 0xbb, 0xcd, 0xe3, 0xde, 0xf3, 0xbd, 0xeb, 0xf3, 0x43, 0xf9, 0x83, 0xb4, 0x82, 0x7c, 0xdb,
+	0x8b, 0x63, 0x12, 0x3f, 0x55, 0x9b, 0xb2, 0x5a, 0x1f, 0xae, 0x52, 0xdb, 0xd1, 0x04, 0x5d, 0x84,
+	0x29, 0xb3, 0x9d, 0xbb, 0xd0, 0x0b, 0x67, 0x75, 0x61, 0x87, 0x0b, 0x77, 0xb9, 0x6c, 0x8c, 0xcc,
+	0xdf, 0xa7, 0xdf, 0xc2, 0x79, 0x57, 0x2c, 0x4c, 0x13, 0x9f, 0xf1, 0x4a, 0x47, 0x7d, 0x6f, 0x69,
+	0x62, 0xa2, 0x1a, 0x3d, 0x6b, 0x93, 0xbf, 0x2c, 0xa2, 0xff, 0xdc, 0xd6, 0xef, 0xa7, 0xf1, 0xf5,
+	0x4f, 0xc5, 0xed, 0x91, 0xbe, 0x3e, 0xed, 0xf6, 0x3c, 0x63, 0x9f, 0xfe, 0x90, 0x16, 0x42, 0xd2,
+	0xa7, 0x0b, 0x55, 0xfb, 0x95, 0xec, 0xd7, 0xcb, 0xf6, 0xff, 0x36, 0x0d, 0xc1, 0x39, 0xfb, 0x17,
+	0x6c, 0xc9, 0x33, 0xb4, 0xf6, 0x48, 0x11, 0x68, 0x9f, 0x41, 0x1f, 0xdf, 0x21, 0xbe, 0xe7, 0x4c,
+	0x77, 0x7d, 0x0c, 0xc3, 0x24, 0x32, 0xd6, 0x41, 0x6b, 0xe2, 0x13, 0xe7, 0xde, 0x3b, 0x49, 0x20,
+	0xec, 0xad, 0x5b, 0xd9, 0x98, 0xa7, 0x3b, 0xf5, 0x35, 0xe3, 0x85, 0x87, 0x44, 0xa5, 0x85, 0xb9,
+	0xe9, 0x4e, 0xa6, 0x7d, 0xfe, 0x2d, 0x63, 0x01, 0x94, 0xfd, 0x6f, 0x3e, 0xd4, 0xc0, 0x9a, 0x42,
+	0xc9, 0x95, 0x79, 0xe2, 0x6b, 0x0c, 0x93, 0x95, 0xae, 0x2b, 0xae, 0x81, 0x55, 0xde, 0x85, 0x98,
+	0xd7, 0x7e, 0x5b, 0x41, 0x94, 0xdd, 0x99, 0x75, 0xe0, 0xcc, 0xdf, 0x69, 0x60, 0x3d, 0xd7, 0x39,
+	0xbc, 0xe8, 0xae, 0x71, 0xaa, 0xf6, 0x73, 0x5f, 0xfb, 0xd2, 0x5e, 0x7c, 0x51, 0xad, 0xfd, 0xa4,
+	0x06, 0x5e, 0x91, 0xe8, 0x92, 0x20, 0xe2, 0x44, 0xba, 0xf0, 0xd4, 0x59, 0x7c, 0x9d, 0xa4, 0x2f,
+	0xbc, 0x2d, 0xbd, 0x06, 0x56, 0x79, 0x17, 0xad, 0x48, 0x3f, 0x19, 0x36, 0x57, 0x68, 0xec, 0xe4,
+	0xe9, 0x67, 0x83, 0x8e, 0xea, 0xe2, 0xb2, 0x03, 0xe8, 0xf2, 0xf3, 0x9b, 0x5e, 0xee, 0xab, 0x0e,
+	0x47, 0x36, 0x36, 0xde, 0x04, 0x3a, 0x83, 0x2e, 0x55, 0x07, 0x77, 0x38, 0xbf, 0x73, 0xaf, 0xaa,
+	0x53, 0xe8, 0x52, 0x4b, 0x68, 0x9b, 0x9f, 0xd7, 0x14, 0x5f, 0x76, 0xc5, 0x2d, 0xdd, 0x6d, 0x18,
+	0xfb, 0x53, 0x71, 0xbe, 0x3d, 0x12, 0x16, 0xb3, 0x82, 0x56, 0x25, 0x2b, 0x3c, 0x7d, 0x46, 0x9a,
+	0x73, 0xfb, 0xad, 0x57, 0xbc, 0xfd, 0xbe, 0x06, 0xd2, 0xbb, 0x47, 0x1b, 0x25, 0x92, 0x56, 0x02,
+	0xe6, 0xba, 0xd5, 0x53, 0xf2, 0x3d, 0x25, 0x36, 0x0e, 0x40, 0x13, 0x06, 0x24, 0x09, 0x99, 0xea,
+	0x68, 0x7e, 0x4f, 0xb9, 0x79, 0xd5, 0xf5, 0xd8, 0xdd, 0x64, 0x32, 0x72, 0x48, 0xa0, 0x7e, 0xa6,
+	0xa1, 0xfe, 0x5c, 0xa7, 0xe8, 0x9e, 0xfa, 0x79, 0xc4, 0x58, 0x00, 0x01, 0x94, 0x49, 0xe3, 0x90,
+	0x59, 0x6a, 0x2d, 0xf3, 0x37, 0xe9, 0x87, 0xe8, 0x7e, 0x32, 0x09, 0x3c, 0xc6, 0x6f, 0x40, 0x2d,
+	0xec, 0x60, 0x2f, 0x3a, 0xd7, 0x7b, 0xc3, 0x57, 0x01, 0x10, 0x97, 0xb0, 0x93, 0x29, 0xc3, 0x54,
+	0x20, 0xab, 0x5b, 0x6d, 0x2e, 0xd9, 0xe1, 0x02, 0xfe, 0x98, 0x32, 0x18, 0x33, 0x59, 0xfd, 0xcb,
+	0xb6, 0x55, 0x5b, 0x48, 0x78, 0x59, 0x6f, 0xbc, 0x04, 0x5a, 0x38, 0x44, 0xf2, 0xa1, 0x04, 0x6b,
+	0x09, 0x87, 0x48, 0x3c, 0x7a, 0x1d, 0xac, 0x39, 0x3e, 0xf4, 0x02, 0x38, 0xf1, 0xb1, 0x9d, 0x7b,
+	0x45, 0x53, 0xbc, 0xc2, 0xc8, 0x9e, 0x59, 0xd9, 0xbb, 0x5e, 0x00, 0x0d, 0x9a, 0xeb, 0xb9, 0xeb,
+	0x54, 0x55, 0xd8, 0x21, 0x09, 0x1d, 0x2c, 0x3a, 0xb6, 0xba, 0x25, 0x07, 0xe6, 0x47, 0xf5, 0xf4,
+	0xe2, 0x92, 0x2f, 0xc3, 0x97, 0x78, 0x0b, 0xe3, 0xf3, 0x84, 0x29, 0xb3, 0xad, 0x9e, 0xb3, 0xed,
+	0x2b, 0x60, 0x1d, 0xbf, 0x0b, 0xe7, 0x2e, 0x60, 0x94, 0xc7, 0xa8, 0xa1, 0xee, 0xc2, 0xe5, 0x93,
+	0x19, 0x42, 0xe7, 0x42, 0x3c, 0xee, 0xc6, 0x61, 0x12, 0x22, 0x2f, 0x74, 0x33, 0x37, 0x96, 0x16,
+	0xb9, 0xa1, 0x26, 0x28, 0xe9, 0xce, 0xf8, 0xc1, 0xa3, 0x81, 0xf6, 0xd9, 0xa3, 0x81, 0xf6, 0x8f,
+	0x47, 0x03, 0xed, 0xe3, 0xc7, 0x83, 0x4b, 0x9f, 0x3d, 0x1e, 0x5c, 0xfa, 0xcb, 0xe3, 0xc1, 0xa5,
+	0x1f, 0x6f, 0xe5, 0x4c, 0x9b, 0x84, 0x93, 0xeb, 0xa2, 0x16, 0xd9, 0xca, 0xfd, 0x7a, 0xe8, 0x7e,
+	0xf1, 0xf7, 0x43, 0x93, 0xa6, 0xf8, 0xa6, 0x7c, 0xe3, 0x7f, 0x03, 0x00, 0xb4, 0xf3, 0xfd, 0x8f,
+	0x2b, 0x25, 0x00, 0x00,
 }
 
 func (m *EventCreateBucket) Marshal() (dAtA []byte, err error) {
@@ -4371,59 +4653,272 @@ func (m *EventSetTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventChargeEarlyDeletion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventChargeEarlyDeletion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChargeEarlyDeletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovEvents(uint64(m.Visibility))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovEvents(uint64(m.CreateAt))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = m.BucketId.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SourceType != 0 {
-		n += 1 + sovEvents(uint64(m.SourceType))
+	i--
+	dAtA[i] = 0x32
+	if m.ChargedDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChargedDuration))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PrimarySpId != 0 {
-		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	{
+		size := m.ObjectId.Size()
+		i -= size
+		if _, err := m.ObjectId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSubmitReadReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmitReadReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmitReadReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ClaimableReadBytes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ClaimableReadBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadBytes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimReadFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimReadFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimReadFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundingAddress) > 0 {
+		i -= len(m.FundingAddress)
+		copy(dAtA[i:], m.FundingAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundingAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ClaimedReadBytes != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ClaimedReadBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovEvents(uint64(m.Visibility))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovEvents(uint64(m.CreateAt))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SourceType != 0 {
+		n += 1 + sovEvents(uint64(m.SourceType))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedReadQuota))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovEvents(uint64(m.PrimarySpId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
 }
 
 func (m *EventDeleteBucket) Size() (n int) {
@@ -5194,6 +5689,98 @@ func (m *EventSetTag) Size() (n int) {
 	return n
 }
 
+func (m *EventChargeEarlyDeletion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChargedDuration != 0 {
+		n += 1 + sovEvents(uint64(m.ChargedDuration))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubmitReadReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovEvents(uint64(m.ReadBytes))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovEvents(uint64(m.EndTime))
+	}
+	if m.ClaimableReadBytes != 0 {
+		n += 1 + sovEvents(uint64(m.ClaimableReadBytes))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EventClaimReadFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SpId != 0 {
+		n += 1 + sovEvents(uint64(m.SpId))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ClaimedReadBytes != 0 {
+		n += 1 + sovEvents(uint64(m.ClaimedReadBytes))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.FundingAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -11291,6 +11878,721 @@ func (m *EventSetTag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventChargeEarlyDeletion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChargeEarlyDeletion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChargeEarlyDeletion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedDuration", wireType)
+			}
+			m.ChargedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitReadReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitReadReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitReadReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+			}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableReadBytes", wireType)
+			}
+			m.ClaimableReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimableReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimReadFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimReadFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimReadFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedReadBytes", wireType)
+			}
+			m.ClaimedReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
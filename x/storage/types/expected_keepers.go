@@ -40,15 +40,23 @@ type SpKeeper interface {
 	GetStorageProviderBySealAddr(ctx sdk.Context, sealAddr sdk.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetStorageProviderByGcAddr(ctx sdk.Context, gcAddr sdk.AccAddress) (sp *sptypes.StorageProvider, found bool)
 	GetGlobalSpStorePriceByTime(ctx sdk.Context, time int64) (val sptypes.GlobalSpStorePrice, err error)
+	RecordRejectSealObject(ctx sdk.Context, spId uint32)
+	RecordRejectMigrateBucket(ctx sdk.Context, spId uint32)
 }
 
 type PaymentKeeper interface {
 	GetVersionedParamsWithTs(ctx sdk.Context, time int64) (paymenttypes.VersionedParams, error)
 	IsPaymentAccountOwner(ctx sdk.Context, addr, owner sdk.AccAddress) bool
 	ApplyUserFlowsList(ctx sdk.Context, userFlows []paymenttypes.UserFlows) (err error)
+	RecordBucketFlows(ctx sdk.Context, bucketId math.Uint, userFlowsList []paymenttypes.UserFlows)
 	UpdateStreamRecordByAddr(ctx sdk.Context, change *paymenttypes.StreamRecordChange) (ret *paymenttypes.StreamRecord, err error)
 	GetStreamRecord(ctx sdk.Context, account sdk.AccAddress) (ret *paymenttypes.StreamRecord, found bool)
 	MergeOutFlows(flows []paymenttypes.OutFlow) []paymenttypes.OutFlow
+	GetPrepaidPlan(ctx sdk.Context, id uint64) (*paymenttypes.PrepaidPlan, bool)
+	CreatePrepaidPlan(ctx sdk.Context, owner sdk.AccAddress, bucketId math.Uint, chargeSize uint64, duration int64, amount math.Int) (*paymenttypes.PrepaidPlan, error)
+	ClosePrepaidPlan(ctx sdk.Context, id uint64) error
+	GetExpiredPrepaidPlans(ctx sdk.Context, limit uint64) []paymenttypes.PrepaidPlan
+	PostponePrepaidPlanExpiry(ctx sdk.Context, id uint64, endTime int64)
 }
 
 type PermissionKeeper interface {
@@ -95,6 +103,7 @@ type VirtualGroupKeeper interface {
 	SettleAndDistributeGVG(ctx sdk.Context, gvg *types.GlobalVirtualGroup) error
 	GetAndCheckGVGFamilyAvailableForNewBucket(ctx sdk.Context, familyID uint32) (*types.GlobalVirtualGroupFamily, error)
 	GetGlobalVirtualGroupIfAvailable(ctx sdk.Context, gvgID uint32, expectedStoreSize uint64) (*types.GlobalVirtualGroup, error)
+	GetSwapOutInfo(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32) (*types.SwapOutInfo, bool)
 }
 
 // StorageKeeper used by the cross-chain applications
@@ -263,6 +263,30 @@ func (mr *MockSpKeeperMockRecorder) MustGetStorageProvider(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustGetStorageProvider", reflect.TypeOf((*MockSpKeeper)(nil).MustGetStorageProvider), ctx, id)
 }
 
+// RecordRejectMigrateBucket mocks base method.
+func (m *MockSpKeeper) RecordRejectMigrateBucket(ctx types3.Context, spId uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordRejectMigrateBucket", ctx, spId)
+}
+
+// RecordRejectMigrateBucket indicates an expected call of RecordRejectMigrateBucket.
+func (mr *MockSpKeeperMockRecorder) RecordRejectMigrateBucket(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRejectMigrateBucket", reflect.TypeOf((*MockSpKeeper)(nil).RecordRejectMigrateBucket), ctx, spId)
+}
+
+// RecordRejectSealObject mocks base method.
+func (m *MockSpKeeper) RecordRejectSealObject(ctx types3.Context, spId uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordRejectSealObject", ctx, spId)
+}
+
+// RecordRejectSealObject indicates an expected call of RecordRejectSealObject.
+func (mr *MockSpKeeperMockRecorder) RecordRejectSealObject(ctx, spId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRejectSealObject", reflect.TypeOf((*MockSpKeeper)(nil).RecordRejectSealObject), ctx, spId)
+}
+
 // MockPaymentKeeper is a mock of PaymentKeeper interface.
 type MockPaymentKeeper struct {
 	ctrl     *gomock.Controller
@@ -300,6 +324,64 @@ func (mr *MockPaymentKeeperMockRecorder) ApplyUserFlowsList(ctx, userFlows inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUserFlowsList", reflect.TypeOf((*MockPaymentKeeper)(nil).ApplyUserFlowsList), ctx, userFlows)
 }
 
+// ClosePrepaidPlan mocks base method.
+func (m *MockPaymentKeeper) ClosePrepaidPlan(ctx types3.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePrepaidPlan", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClosePrepaidPlan indicates an expected call of ClosePrepaidPlan.
+func (mr *MockPaymentKeeperMockRecorder) ClosePrepaidPlan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePrepaidPlan", reflect.TypeOf((*MockPaymentKeeper)(nil).ClosePrepaidPlan), ctx, id)
+}
+
+// CreatePrepaidPlan mocks base method.
+func (m *MockPaymentKeeper) CreatePrepaidPlan(ctx types3.Context, owner types3.AccAddress, bucketId math.Uint, chargeSize uint64, duration int64, amount math.Int) (*types.PrepaidPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrepaidPlan", ctx, owner, bucketId, chargeSize, duration, amount)
+	ret0, _ := ret[0].(*types.PrepaidPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrepaidPlan indicates an expected call of CreatePrepaidPlan.
+func (mr *MockPaymentKeeperMockRecorder) CreatePrepaidPlan(ctx, owner, bucketId, chargeSize, duration, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrepaidPlan", reflect.TypeOf((*MockPaymentKeeper)(nil).CreatePrepaidPlan), ctx, owner, bucketId, chargeSize, duration, amount)
+}
+
+// GetExpiredPrepaidPlans mocks base method.
+func (m *MockPaymentKeeper) GetExpiredPrepaidPlans(ctx types3.Context, limit uint64) []types.PrepaidPlan {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredPrepaidPlans", ctx, limit)
+	ret0, _ := ret[0].([]types.PrepaidPlan)
+	return ret0
+}
+
+// GetExpiredPrepaidPlans indicates an expected call of GetExpiredPrepaidPlans.
+func (mr *MockPaymentKeeperMockRecorder) GetExpiredPrepaidPlans(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredPrepaidPlans", reflect.TypeOf((*MockPaymentKeeper)(nil).GetExpiredPrepaidPlans), ctx, limit)
+}
+
+// GetPrepaidPlan mocks base method.
+func (m *MockPaymentKeeper) GetPrepaidPlan(ctx types3.Context, id uint64) (*types.PrepaidPlan, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrepaidPlan", ctx, id)
+	ret0, _ := ret[0].(*types.PrepaidPlan)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPrepaidPlan indicates an expected call of GetPrepaidPlan.
+func (mr *MockPaymentKeeperMockRecorder) GetPrepaidPlan(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrepaidPlan", reflect.TypeOf((*MockPaymentKeeper)(nil).GetPrepaidPlan), ctx, id)
+}
+
 // GetStreamRecord mocks base method.
 func (m *MockPaymentKeeper) GetStreamRecord(ctx types3.Context, account types3.AccAddress) (*types.StreamRecord, bool) {
 	m.ctrl.T.Helper()
@@ -358,6 +440,30 @@ func (mr *MockPaymentKeeperMockRecorder) MergeOutFlows(flows interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOutFlows", reflect.TypeOf((*MockPaymentKeeper)(nil).MergeOutFlows), flows)
 }
 
+// PostponePrepaidPlanExpiry mocks base method.
+func (m *MockPaymentKeeper) PostponePrepaidPlanExpiry(ctx types3.Context, id uint64, endTime int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PostponePrepaidPlanExpiry", ctx, id, endTime)
+}
+
+// PostponePrepaidPlanExpiry indicates an expected call of PostponePrepaidPlanExpiry.
+func (mr *MockPaymentKeeperMockRecorder) PostponePrepaidPlanExpiry(ctx, id, endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostponePrepaidPlanExpiry", reflect.TypeOf((*MockPaymentKeeper)(nil).PostponePrepaidPlanExpiry), ctx, id, endTime)
+}
+
+// RecordBucketFlows mocks base method.
+func (m *MockPaymentKeeper) RecordBucketFlows(ctx types3.Context, bucketId math.Uint, userFlowsList []types.UserFlows) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordBucketFlows", ctx, bucketId, userFlowsList)
+}
+
+// RecordBucketFlows indicates an expected call of RecordBucketFlows.
+func (mr *MockPaymentKeeperMockRecorder) RecordBucketFlows(ctx, bucketId, userFlowsList interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordBucketFlows", reflect.TypeOf((*MockPaymentKeeper)(nil).RecordBucketFlows), ctx, bucketId, userFlowsList)
+}
+
 // UpdateStreamRecordByAddr mocks base method.
 func (m *MockPaymentKeeper) UpdateStreamRecordByAddr(ctx types3.Context, change *types.StreamRecordChange) (*types.StreamRecord, error) {
 	m.ctrl.T.Helper()
@@ -834,6 +940,21 @@ func (mr *MockVirtualGroupKeeperMockRecorder) GetGlobalVirtualGroupIfAvailable(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalVirtualGroupIfAvailable", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetGlobalVirtualGroupIfAvailable), ctx, gvgID, expectedStoreSize)
 }
 
+// GetSwapOutInfo mocks base method.
+func (m *MockVirtualGroupKeeper) GetSwapOutInfo(ctx types3.Context, gvgFamilyID, gvgID uint32) (*types2.SwapOutInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSwapOutInfo", ctx, gvgFamilyID, gvgID)
+	ret0, _ := ret[0].(*types2.SwapOutInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSwapOutInfo indicates an expected call of GetSwapOutInfo.
+func (mr *MockVirtualGroupKeeperMockRecorder) GetSwapOutInfo(ctx, gvgFamilyID, gvgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwapOutInfo", reflect.TypeOf((*MockVirtualGroupKeeper)(nil).GetSwapOutInfo), ctx, gvgFamilyID, gvgID)
+}
+
 // SetGVGAndEmitUpdateEvent mocks base method.
 func (m *MockVirtualGroupKeeper) SetGVGAndEmitUpdateEvent(ctx types3.Context, gvg *types2.GlobalVirtualGroup) error {
 	m.ctrl.T.Helper()
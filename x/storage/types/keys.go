@@ -37,6 +37,7 @@ var (
 	GroupInfoPrefix          = []byte{0x13}
 	QuotaPrefix              = []byte{0x14}
 	InternalBucketInfoPrefix = []byte{0x15}
+	BucketReadRecordPrefix   = []byte{0x16}
 
 	BucketByIDPrefix = []byte{0x21}
 	ObjectByIDPrefix = []byte{0x22}
@@ -59,6 +60,14 @@ var (
 	DeleteStalePoliciesPrefix          = []byte{0x52}
 
 	MigrateBucketPrefix = []byte{0x61}
+
+	// SealedObjectSampleCountsKey, SealedObjectSamplePrefix and SealedObjectSamplePositionPrefix keep a dense index of
+	// the sealed non-empty objects grouped by size class, which is used to sample objects for random challenges.
+	// SealedObjectSampleSizesKey keeps the total payload size of the objects of each size class.
+	SealedObjectSampleCountsKey      = []byte{0x71}
+	SealedObjectSamplePrefix         = []byte{0x72}
+	SealedObjectSamplePositionPrefix = []byte{0x73}
+	SealedObjectSampleSizesKey       = []byte{0x74}
 )
 
 // GetBucketKey return the bucket name store key
@@ -146,6 +155,20 @@ func GetMigrationBucketKey(bucketID math.Uint) []byte {
 	return append(MigrateBucketPrefix, seq.EncodeSequence(bucketID)...)
 }
 
+// GetSealedObjectSampleKey return the store key of the object at the index of the size class in the sampling index
+func GetSealedObjectSampleKey(sizeClass uint32, index uint64) []byte {
+	bz := make([]byte, 9)
+	bz[0] = byte(sizeClass)
+	binary.BigEndian.PutUint64(bz[1:], index)
+	return append(SealedObjectSamplePrefix, bz...)
+}
+
+// GetSealedObjectSamplePositionKey return the store key of the object position in the sampling index
+func GetSealedObjectSamplePositionKey(objectId math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(SealedObjectSamplePositionPrefix, seq.EncodeSequence(objectId)...)
+}
+
 // GetQuotaKey return the quota store key
 func GetQuotaKey(bucketID math.Uint) []byte {
 	var seq sequence.Sequence[math.Uint]
@@ -156,3 +179,9 @@ func GetInternalBucketInfoKey(bucketID math.Uint) []byte {
 	var seq sequence.Sequence[math.Uint]
 	return append(InternalBucketInfoPrefix, seq.EncodeSequence(bucketID)...)
 }
+
+// GetBucketReadRecordKey return the read receipts record store key of the bucket
+func GetBucketReadRecordKey(bucketID math.Uint) []byte {
+	var seq sequence.Sequence[math.Uint]
+	return append(BucketReadRecordPrefix, seq.EncodeSequence(bucketID)...)
+}
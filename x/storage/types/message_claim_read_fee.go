@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const TypeMsgClaimReadFee = "claim_read_fee"
+
+var _ sdk.Msg = &MsgClaimReadFee{}
+
+func NewMsgClaimReadFee(operator sdk.AccAddress, bucketName string) *MsgClaimReadFee {
+	return &MsgClaimReadFee{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+	}
+}
+
+func (msg *MsgClaimReadFee) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimReadFee) Type() string {
+	return TypeMsgClaimReadFee
+}
+
+func (msg *MsgClaimReadFee) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgClaimReadFee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimReadFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	return s3util.CheckValidBucketName(msg.BucketName)
+}
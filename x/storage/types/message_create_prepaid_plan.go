@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const TypeMsgCreatePrepaidPlan = "create_prepaid_plan"
+
+var _ sdk.Msg = &MsgCreatePrepaidPlan{}
+
+func NewMsgCreatePrepaidPlan(operator sdk.AccAddress, bucketName string, chargeSize uint64, months uint32) *MsgCreatePrepaidPlan {
+	return &MsgCreatePrepaidPlan{
+		Operator:   operator.String(),
+		BucketName: bucketName,
+		ChargeSize: chargeSize,
+		Months:     months,
+	}
+}
+
+func (msg *MsgCreatePrepaidPlan) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreatePrepaidPlan) Type() string {
+	return TypeMsgCreatePrepaidPlan
+}
+
+func (msg *MsgCreatePrepaidPlan) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgCreatePrepaidPlan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreatePrepaidPlan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if msg.ChargeSize == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("charge size should be positive")
+	}
+	if msg.Months == 0 || msg.Months > MaxPrepaidPlanMonths {
+		return gnfderrors.ErrInvalidParameter.Wrapf("months should be within [1, %d]", MaxPrepaidPlanMonths)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgCreatePrepaidPlan_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreatePrepaidPlan
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreatePrepaidPlan{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+				ChargeSize: 1024,
+				Months:     1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero charge size",
+			msg: MsgCreatePrepaidPlan{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				Months:     1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "too many months",
+			msg: MsgCreatePrepaidPlan{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ChargeSize: 1024,
+				Months:     MaxPrepaidPlanMonths + 1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid message",
+			msg: MsgCreatePrepaidPlan{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				ChargeSize: 1024,
+				Months:     12,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const TypeMsgSubmitReadReceipt = "submit_read_receipt"
+
+var _ sdk.Msg = &MsgSubmitReadReceipt{}
+
+func NewMsgSubmitReadReceipt(operator sdk.AccAddress, bucketName string, bucketID math.Uint, chainID string,
+	readBytes uint64, startTime, endTime int64, nonce uint64, ownerSignature []byte,
+) *MsgSubmitReadReceipt {
+	return &MsgSubmitReadReceipt{
+		Operator:       operator.String(),
+		BucketName:     bucketName,
+		BucketId:       bucketID,
+		ChainId:        chainID,
+		ReadBytes:      readBytes,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Nonce:          nonce,
+		OwnerSignature: ownerSignature,
+	}
+}
+
+func (msg *MsgSubmitReadReceipt) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitReadReceipt) Type() string {
+	return TypeMsgSubmitReadReceipt
+}
+
+func (msg *MsgSubmitReadReceipt) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSubmitReadReceipt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetReceiptBytes returns the bytes of the receipt signed by the bucket owner, which is the message without the signature.
+func (msg *MsgSubmitReadReceipt) GetReceiptBytes() []byte {
+	// proto.Clone cannot copy the custom type of the bucket id
+	fakeMsg := *msg
+	fakeMsg.OwnerSignature = nil
+	return fakeMsg.GetSignBytes()
+}
+
+func (msg *MsgSubmitReadReceipt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	err = s3util.CheckValidBucketName(msg.BucketName)
+	if err != nil {
+		return err
+	}
+
+	if msg.BucketId.IsNil() || msg.BucketId.IsZero() {
+		return gnfderrors.ErrInvalidParameter.Wrapf("bucket id should be positive")
+	}
+	if len(msg.ChainId) == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("chain id should not be empty")
+	}
+	if msg.ReadBytes == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("read bytes should be positive")
+	}
+	if msg.StartTime < 0 || msg.EndTime <= msg.StartTime {
+		return gnfderrors.ErrInvalidParameter.Wrapf("invalid receipt period [%d, %d]", msg.StartTime, msg.EndTime)
+	}
+	if msg.Nonce == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("nonce should be positive")
+	}
+	if len(msg.OwnerSignature) == 0 {
+		return gnfderrors.ErrInvalidParameter.Wrapf("owner signature should not be empty")
+	}
+	return nil
+}
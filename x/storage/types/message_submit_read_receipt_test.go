@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgSubmitReadReceipt_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitReadReceipt
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitReadReceipt{
+				Operator:   "invalid_address",
+				BucketName: testBucketName,
+				BucketId:   math.NewUint(1),
+				ChainId:    testChainId,
+				ReadBytes:  1024,
+				StartTime:  100,
+				EndTime:    200,
+				Nonce:      1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no bucket id",
+			msg: MsgSubmitReadReceipt{
+				Operator:       sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				ChainId:        testChainId,
+				ReadBytes:      1024,
+				StartTime:      100,
+				EndTime:        200,
+				Nonce:          1,
+				OwnerSignature: []byte("signature"),
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "no chain id",
+			msg: MsgSubmitReadReceipt{
+				Operator:       sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				BucketId:       math.NewUint(1),
+				ReadBytes:      1024,
+				StartTime:      100,
+				EndTime:        200,
+				Nonce:          1,
+				OwnerSignature: []byte("signature"),
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "zero read bytes",
+			msg: MsgSubmitReadReceipt{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				BucketId:   math.NewUint(1),
+				ChainId:    testChainId,
+				StartTime:  100,
+				EndTime:    200,
+				Nonce:      1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "invalid period",
+			msg: MsgSubmitReadReceipt{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				BucketId:   math.NewUint(1),
+				ChainId:    testChainId,
+				ReadBytes:  1024,
+				StartTime:  200,
+				EndTime:    200,
+				Nonce:      1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "zero nonce",
+			msg: MsgSubmitReadReceipt{
+				Operator:       sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				BucketId:       math.NewUint(1),
+				ChainId:        testChainId,
+				ReadBytes:      1024,
+				StartTime:      100,
+				EndTime:        200,
+				OwnerSignature: []byte("signature"),
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "empty owner signature",
+			msg: MsgSubmitReadReceipt{
+				Operator:   sample.RandAccAddressHex(),
+				BucketName: testBucketName,
+				BucketId:   math.NewUint(1),
+				ChainId:    testChainId,
+				ReadBytes:  1024,
+				StartTime:  100,
+				EndTime:    200,
+				Nonce:      1,
+			},
+			err: gnfderrors.ErrInvalidParameter,
+		}, {
+			name: "valid message",
+			msg: MsgSubmitReadReceipt{
+				Operator:       sample.RandAccAddressHex(),
+				BucketName:     testBucketName,
+				BucketId:       math.NewUint(1),
+				ChainId:        testChainId,
+				ReadBytes:      1024,
+				StartTime:      100,
+				EndTime:        200,
+				Nonce:          1,
+				OwnerSignature: []byte("signature"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -20,6 +20,7 @@ var (
 	testBucketName                      = "testbucket"
 	testObjectName                      = "testobject"
 	testGroupName                       = "testgroup"
+	testChainId                         = "greenfield_9000-121"
 	testInvalidBucketNameWithLongLength = [68]byte{}
 )
 
@@ -3,6 +3,8 @@ package types
 import (
 	time "time"
 
+	"cosmossdk.io/math"
+
 	"github.com/bnb-chain/greenfield/types/common"
 )
 
@@ -76,3 +78,14 @@ type RenewGroupMemberOptions struct {
 type DeleteGroupOptions struct {
 	SourceType SourceType
 }
+
+type SubmitReadReceiptOptions struct {
+	BucketId        math.Uint
+	ChainId         string
+	ReadBytes       uint64
+	StartTime       int64
+	EndTime         int64
+	Nonce           uint64
+	OwnerSignature  []byte
+	ReceiptMsgBytes []byte
+}
@@ -1713,6 +1713,250 @@ func (m *QueryLockFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryLockFeeResponse proto.InternalMessageInfo
 
+type QueryEstimateStorageCostRequest struct {
+	// primary_sp_address is the address of the primary sp of the bucket.
+	PrimarySpAddress string `protobuf:"bytes,1,opt,name=primary_sp_address,json=primarySpAddress,proto3" json:"primary_sp_address,omitempty"`
+	// payload_size is the total size of the object payload.
+	PayloadSize uint64 `protobuf:"varint,2,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// redundancy_type is the redundancy type of the object.
+	RedundancyType RedundancyType `protobuf:"varint,3,opt,name=redundancy_type,json=redundancyType,proto3,enum=greenfield.storage.RedundancyType" json:"redundancy_type,omitempty"`
+	// read_quota is the charged read quota of the bucket in bytes.
+	ReadQuota uint64 `protobuf:"varint,4,opt,name=read_quota,json=readQuota,proto3" json:"read_quota,omitempty"`
+}
+
+func (m *QueryEstimateStorageCostRequest) Reset()         { *m = QueryEstimateStorageCostRequest{} }
+func (m *QueryEstimateStorageCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateStorageCostRequest) ProtoMessage()    {}
+func (*QueryEstimateStorageCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{35}
+}
+func (m *QueryEstimateStorageCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateStorageCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateStorageCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateStorageCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateStorageCostRequest.Merge(m, src)
+}
+func (m *QueryEstimateStorageCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateStorageCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateStorageCostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateStorageCostRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateStorageCostRequest) GetPrimarySpAddress() string {
+	if m != nil {
+		return m.PrimarySpAddress
+	}
+	return ""
+}
+
+func (m *QueryEstimateStorageCostRequest) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *QueryEstimateStorageCostRequest) GetRedundancyType() RedundancyType {
+	if m != nil {
+		return m.RedundancyType
+	}
+	return REDUNDANCY_EC_TYPE
+}
+
+func (m *QueryEstimateStorageCostRequest) GetReadQuota() uint64 {
+	if m != nil {
+		return m.ReadQuota
+	}
+	return 0
+}
+
+type QueryEstimateStorageCostResponse struct {
+	// lock_amount is the amount locked from the payment account when the object is created.
+	LockAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=lock_amount,json=lockAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lock_amount"`
+	// netflow_rate_delta is the change of the netflow rate of the payment account once the object is sealed
+	// and the read quota is charged, it is negative.
+	NetflowRateDelta github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=netflow_rate_delta,json=netflowRateDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"netflow_rate_delta"`
+	// reserve_buffer is the buffer balance to be reserved for the netflow rate delta for the reserve time.
+	ReserveBuffer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=reserve_buffer,json=reserveBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reserve_buffer"`
+	// monthly_cost is the amount paid for storing the object and the read quota in 30 days.
+	MonthlyCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=monthly_cost,json=monthlyCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"monthly_cost"`
+	// charge_size is the size used for charging, which is not less than the min charge size.
+	ChargeSize uint64 `protobuf:"varint,5,opt,name=charge_size,json=chargeSize,proto3" json:"charge_size,omitempty"`
+	// total_charge_size is the charge size including the redundancy overhead of the redundancy type, i.e. the total
+	// size stored on the primary sp and the secondary sps.
+	TotalChargeSize uint64 `protobuf:"varint,6,opt,name=total_charge_size,json=totalChargeSize,proto3" json:"total_charge_size,omitempty"`
+}
+
+func (m *QueryEstimateStorageCostResponse) Reset()         { *m = QueryEstimateStorageCostResponse{} }
+func (m *QueryEstimateStorageCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateStorageCostResponse) ProtoMessage()    {}
+func (*QueryEstimateStorageCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{36}
+}
+func (m *QueryEstimateStorageCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateStorageCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateStorageCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateStorageCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateStorageCostResponse.Merge(m, src)
+}
+func (m *QueryEstimateStorageCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateStorageCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateStorageCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateStorageCostResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateStorageCostResponse) GetChargeSize() uint64 {
+	if m != nil {
+		return m.ChargeSize
+	}
+	return 0
+}
+
+func (m *QueryEstimateStorageCostResponse) GetTotalChargeSize() uint64 {
+	if m != nil {
+		return m.TotalChargeSize
+	}
+	return 0
+}
+
+type QueryEarlyDeletionPenaltyRequest struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectName string `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+}
+
+func (m *QueryEarlyDeletionPenaltyRequest) Reset()         { *m = QueryEarlyDeletionPenaltyRequest{} }
+func (m *QueryEarlyDeletionPenaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEarlyDeletionPenaltyRequest) ProtoMessage()    {}
+func (*QueryEarlyDeletionPenaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{37}
+}
+func (m *QueryEarlyDeletionPenaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEarlyDeletionPenaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEarlyDeletionPenaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEarlyDeletionPenaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarlyDeletionPenaltyRequest.Merge(m, src)
+}
+func (m *QueryEarlyDeletionPenaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEarlyDeletionPenaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarlyDeletionPenaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEarlyDeletionPenaltyRequest proto.InternalMessageInfo
+
+func (m *QueryEarlyDeletionPenaltyRequest) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *QueryEarlyDeletionPenaltyRequest) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+type QueryEarlyDeletionPenaltyResponse struct {
+	// charged_duration is the seconds left to the reserve time of the object, zero if the object can be deleted without penalty.
+	ChargedDuration int64 `protobuf:"varint,1,opt,name=charged_duration,json=chargedDuration,proto3" json:"charged_duration,omitempty"`
+	// amount is the total amount to be charged from the payment account if the object is deleted now.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// payment_address is the payment account of the bucket which pays the penalty.
+	PaymentAddress string `protobuf:"bytes,3,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+}
+
+func (m *QueryEarlyDeletionPenaltyResponse) Reset()         { *m = QueryEarlyDeletionPenaltyResponse{} }
+func (m *QueryEarlyDeletionPenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEarlyDeletionPenaltyResponse) ProtoMessage()    {}
+func (*QueryEarlyDeletionPenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1b80b580af04cb0, []int{38}
+}
+func (m *QueryEarlyDeletionPenaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEarlyDeletionPenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEarlyDeletionPenaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEarlyDeletionPenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarlyDeletionPenaltyResponse.Merge(m, src)
+}
+func (m *QueryEarlyDeletionPenaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEarlyDeletionPenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarlyDeletionPenaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEarlyDeletionPenaltyResponse proto.InternalMessageInfo
+
+func (m *QueryEarlyDeletionPenaltyResponse) GetChargedDuration() int64 {
+	if m != nil {
+		return m.ChargedDuration
+	}
+	return 0
+}
+
+func (m *QueryEarlyDeletionPenaltyResponse) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
 type QueryHeadBucketExtraRequest struct {
 	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 }
@@ -1721,7 +1965,7 @@ func (m *QueryHeadBucketExtraRequest) Reset()         { *m = QueryHeadBucketExtr
 func (m *QueryHeadBucketExtraRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraRequest) ProtoMessage()    {}
 func (*QueryHeadBucketExtraRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{35}
+	return fileDescriptor_b1b80b580af04cb0, []int{39}
 }
 func (m *QueryHeadBucketExtraRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1765,7 +2009,7 @@ func (m *QueryHeadBucketExtraResponse) Reset()         { *m = QueryHeadBucketExt
 func (m *QueryHeadBucketExtraResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHeadBucketExtraResponse) ProtoMessage()    {}
 func (*QueryHeadBucketExtraResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{36}
+	return fileDescriptor_b1b80b580af04cb0, []int{40}
 }
 func (m *QueryHeadBucketExtraResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1809,7 +2053,7 @@ func (m *QueryIsPriceChangedRequest) Reset()         { *m = QueryIsPriceChangedR
 func (m *QueryIsPriceChangedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedRequest) ProtoMessage()    {}
 func (*QueryIsPriceChangedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{37}
+	return fileDescriptor_b1b80b580af04cb0, []int{41}
 }
 func (m *QueryIsPriceChangedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1861,7 +2105,7 @@ func (m *QueryIsPriceChangedResponse) Reset()         { *m = QueryIsPriceChanged
 func (m *QueryIsPriceChangedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryIsPriceChangedResponse) ProtoMessage()    {}
 func (*QueryIsPriceChangedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{38}
+	return fileDescriptor_b1b80b580af04cb0, []int{42}
 }
 func (m *QueryIsPriceChangedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2149,7 @@ func (m *QueryQuoteUpdateTimeRequest) Reset()         { *m = QueryQuoteUpdateTim
 func (m *QueryQuoteUpdateTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeRequest) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{39}
+	return fileDescriptor_b1b80b580af04cb0, []int{43}
 }
 func (m *QueryQuoteUpdateTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,13 +2187,15 @@ func (m *QueryQuoteUpdateTimeRequest) GetBucketName() string {
 
 type QueryQuoteUpdateTimeResponse struct {
 	UpdateAt int64 `protobuf:"varint,6,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	// read_record is the read receipts record of the bucket, it is empty if no receipt is submitted
+	ReadRecord *BucketReadRecord `protobuf:"bytes,7,opt,name=read_record,json=readRecord,proto3" json:"read_record,omitempty"`
 }
 
 func (m *QueryQuoteUpdateTimeResponse) Reset()         { *m = QueryQuoteUpdateTimeResponse{} }
 func (m *QueryQuoteUpdateTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryQuoteUpdateTimeResponse) ProtoMessage()    {}
 func (*QueryQuoteUpdateTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{40}
+	return fileDescriptor_b1b80b580af04cb0, []int{44}
 }
 func (m *QueryQuoteUpdateTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1985,6 +2231,13 @@ func (m *QueryQuoteUpdateTimeResponse) GetUpdateAt() int64 {
 	return 0
 }
 
+func (m *QueryQuoteUpdateTimeResponse) GetReadRecord() *BucketReadRecord {
+	if m != nil {
+		return m.ReadRecord
+	}
+	return nil
+}
+
 type QueryGroupMembersExistRequest struct {
 	GroupId string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
@@ -1994,7 +2247,7 @@ func (m *QueryGroupMembersExistRequest) Reset()         { *m = QueryGroupMembers
 func (m *QueryGroupMembersExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistRequest) ProtoMessage()    {}
 func (*QueryGroupMembersExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{41}
+	return fileDescriptor_b1b80b580af04cb0, []int{45}
 }
 func (m *QueryGroupMembersExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2298,7 @@ func (m *QueryGroupMembersExistResponse) Reset()         { *m = QueryGroupMember
 func (m *QueryGroupMembersExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupMembersExistResponse) ProtoMessage()    {}
 func (*QueryGroupMembersExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{42}
+	return fileDescriptor_b1b80b580af04cb0, []int{46}
 }
 func (m *QueryGroupMembersExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2090,7 +2343,7 @@ func (m *QueryGroupsExistRequest) Reset()         { *m = QueryGroupsExistRequest
 func (m *QueryGroupsExistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistRequest) ProtoMessage()    {}
 func (*QueryGroupsExistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{43}
+	return fileDescriptor_b1b80b580af04cb0, []int{47}
 }
 func (m *QueryGroupsExistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2394,7 @@ func (m *QueryGroupsExistByIdRequest) Reset()         { *m = QueryGroupsExistByI
 func (m *QueryGroupsExistByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistByIdRequest) ProtoMessage()    {}
 func (*QueryGroupsExistByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{44}
+	return fileDescriptor_b1b80b580af04cb0, []int{48}
 }
 func (m *QueryGroupsExistByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2185,7 +2438,7 @@ func (m *QueryGroupsExistResponse) Reset()         { *m = QueryGroupsExistRespon
 func (m *QueryGroupsExistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGroupsExistResponse) ProtoMessage()    {}
 func (*QueryGroupsExistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1b80b580af04cb0, []int{45}
+	return fileDescriptor_b1b80b580af04cb0, []int{49}
 }
 func (m *QueryGroupsExistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2257,6 +2510,10 @@ func init() {
 	proto.RegisterType((*QueryPolicyByIdResponse)(nil), "greenfield.storage.QueryPolicyByIdResponse")
 	proto.RegisterType((*QueryLockFeeRequest)(nil), "greenfield.storage.QueryLockFeeRequest")
 	proto.RegisterType((*QueryLockFeeResponse)(nil), "greenfield.storage.QueryLockFeeResponse")
+	proto.RegisterType((*QueryEstimateStorageCostRequest)(nil), "greenfield.storage.QueryEstimateStorageCostRequest")
+	proto.RegisterType((*QueryEstimateStorageCostResponse)(nil), "greenfield.storage.QueryEstimateStorageCostResponse")
+	proto.RegisterType((*QueryEarlyDeletionPenaltyRequest)(nil), "greenfield.storage.QueryEarlyDeletionPenaltyRequest")
+	proto.RegisterType((*QueryEarlyDeletionPenaltyResponse)(nil), "greenfield.storage.QueryEarlyDeletionPenaltyResponse")
 	proto.RegisterType((*QueryHeadBucketExtraRequest)(nil), "greenfield.storage.QueryHeadBucketExtraRequest")
 	proto.RegisterType((*QueryHeadBucketExtraResponse)(nil), "greenfield.storage.QueryHeadBucketExtraResponse")
 	proto.RegisterType((*QueryIsPriceChangedRequest)(nil), "greenfield.storage.QueryIsPriceChangedRequest")
@@ -2275,171 +2532,195 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/query.proto", fileDescriptor_b1b80b580af04cb0) }
 
 var fileDescriptor_b1b80b580af04cb0 = []byte{
-	// 2621 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0xdb, 0x89, 0x63, 0x97, 0x4d, 0x12, 0x6a, 0xbd, 0x1b, 0x67, 0x92, 0x38, 0x49, 0x2f,
-	0x24, 0xd9, 0x24, 0x9e, 0x4e, 0x9c, 0x04, 0xc5, 0xf9, 0x42, 0xf6, 0xc6, 0x0e, 0x23, 0xe5, 0xc3,
-	0xe9, 0x18, 0x23, 0x22, 0xa1, 0x56, 0x4d, 0x77, 0x79, 0xd2, 0xeb, 0x99, 0xee, 0x49, 0x77, 0x4f,
-	0x9c, 0x59, 0x6b, 0x84, 0xd8, 0x0b, 0x1c, 0x11, 0x08, 0x09, 0x09, 0x90, 0x10, 0x88, 0xcf, 0x0b,
-	0x82, 0x5d, 0x21, 0x71, 0xe2, 0x02, 0xd2, 0x4a, 0x08, 0x69, 0xb5, 0x5c, 0xd0, 0x1e, 0x56, 0x90,
-	0xf0, 0x2f, 0x70, 0x47, 0x5d, 0xf5, 0xaa, 0xbb, 0xfa, 0x63, 0xba, 0xc7, 0xeb, 0xe1, 0xe4, 0xe9,
-	0xea, 0x7a, 0xef, 0xfd, 0xde, 0x47, 0xbd, 0xaa, 0xfa, 0xb5, 0xd1, 0x6c, 0xc3, 0xa3, 0xd4, 0xd9,
-	0xb0, 0x69, 0xd3, 0xd2, 0xfc, 0xc0, 0xf5, 0x48, 0x83, 0x6a, 0xcf, 0x3a, 0xd4, 0xeb, 0x56, 0xdb,
-	0x9e, 0x1b, 0xb8, 0x18, 0xc7, 0xef, 0xab, 0xf0, 0xbe, 0x72, 0xce, 0x74, 0xfd, 0x96, 0xeb, 0x6b,
-	0x75, 0xe2, 0xc3, 0x64, 0xed, 0xf9, 0xa5, 0x3a, 0x0d, 0xc8, 0x25, 0xad, 0x4d, 0x1a, 0xb6, 0x43,
-	0x02, 0xdb, 0x75, 0xb8, 0x7c, 0xe5, 0x08, 0x9f, 0x6b, 0xb0, 0x27, 0x8d, 0x3f, 0xc0, 0xab, 0xe9,
-	0x86, 0xdb, 0x70, 0xf9, 0x78, 0xf8, 0x0b, 0x46, 0x8f, 0x35, 0x5c, 0xb7, 0xd1, 0xa4, 0x1a, 0x69,
-	0xdb, 0x1a, 0x71, 0x1c, 0x37, 0x60, 0xda, 0x84, 0x8c, 0x2a, 0xc1, 0x6d, 0x53, 0xaf, 0x65, 0xfb,
-	0xbe, 0xed, 0x3a, 0x9a, 0xe9, 0xb6, 0x5a, 0x91, 0xc9, 0x53, 0xf9, 0x73, 0x82, 0x6e, 0x9b, 0x0a,
-	0x35, 0x27, 0x72, 0xbc, 0x6e, 0x13, 0x8f, 0xb4, 0xc4, 0x84, 0xbc, 0xb0, 0xc8, 0x0a, 0xde, 0x94,
-	0xde, 0x3f, 0xb7, 0xbd, 0xa0, 0x43, 0x9a, 0x0d, 0xcf, 0xed, 0xb4, 0xe5, 0x49, 0xea, 0x34, 0xc2,
-	0x8f, 0xc2, 0xe8, 0xac, 0x32, 0xcd, 0x3a, 0x7d, 0xd6, 0xa1, 0x7e, 0xa0, 0x3e, 0x44, 0xaf, 0x25,
-	0x46, 0xfd, 0xb6, 0xeb, 0xf8, 0x14, 0x5f, 0x43, 0x63, 0x1c, 0xc1, 0x8c, 0x72, 0x52, 0x39, 0x3b,
-	0x39, 0x5f, 0xa9, 0x66, 0x23, 0x5f, 0xe5, 0x32, 0x4b, 0x7b, 0x3f, 0xfc, 0xf4, 0xc4, 0x1e, 0x1d,
-	0xe6, 0xab, 0xb7, 0xd0, 0x71, 0x49, 0xe1, 0x52, 0x77, 0xcd, 0x6e, 0x51, 0x3f, 0x20, 0xad, 0x36,
-	0x58, 0xc4, 0xc7, 0xd0, 0x44, 0x20, 0xc6, 0x98, 0xf6, 0x51, 0x3d, 0x1e, 0x50, 0x9f, 0xa0, 0xd9,
-	0x7e, 0xe2, 0xbb, 0x86, 0xb6, 0x80, 0xde, 0x60, 0xba, 0xbf, 0x42, 0x89, 0xb5, 0xd4, 0x31, 0x37,
-	0x69, 0x20, 0x30, 0x9d, 0x40, 0x93, 0x75, 0x36, 0x60, 0x38, 0xa4, 0x45, 0x99, 0xe2, 0x09, 0x1d,
-	0xf1, 0xa1, 0x07, 0xa4, 0x45, 0xd5, 0x05, 0x54, 0x49, 0x89, 0x2e, 0x75, 0x6b, 0x96, 0x10, 0x3f,
-	0x8a, 0x26, 0x40, 0xdc, 0xb6, 0x40, 0x78, 0x9c, 0x0f, 0xd4, 0x2c, 0xf5, 0x09, 0x3a, 0x9c, 0xb1,
-	0x0a, 0xae, 0x7c, 0x39, 0x32, 0x6b, 0x3b, 0x1b, 0x2e, 0xf8, 0x33, 0x9b, 0xe7, 0x0f, 0x17, 0xac,
-	0x39, 0x1b, 0xae, 0x80, 0x15, 0xfe, 0x56, 0x9f, 0x48, 0x1e, 0x3d, 0xac, 0xbf, 0x43, 0xcd, 0x81,
-	0x3d, 0x0a, 0x27, 0xb8, 0x4c, 0x82, 0x4f, 0x18, 0xe1, 0x13, 0xf8, 0x50, 0xc6, 0x65, 0xae, 0x3b,
-	0xe5, 0x32, 0x88, 0xc7, 0x2e, 0xf3, 0x81, 0x9a, 0xa5, 0xfe, 0x49, 0x91, 0x7c, 0x16, 0xb8, 0x62,
-	0x9f, 0x85, 0x60, 0x89, 0xcf, 0x5c, 0x90, 0xfb, 0xec, 0x46, 0xbf, 0xf1, 0x37, 0xd0, 0x74, 0xa3,
-	0xe9, 0xd6, 0x49, 0xd3, 0x80, 0x52, 0x37, 0x58, 0xad, 0x33, 0x0f, 0x26, 0xe7, 0xcf, 0xcb, 0x9a,
-	0xe4, 0xb5, 0x50, 0xbd, 0xcb, 0x84, 0xd6, 0xf9, 0xd0, 0xdd, 0x70, 0x48, 0xc7, 0x8d, 0xcc, 0x98,
-	0x4a, 0x00, 0xfa, 0x3d, 0xdb, 0x0f, 0x78, 0xd4, 0xc5, 0x5a, 0xc1, 0x2b, 0x08, 0xc5, 0x1d, 0x05,
-	0x90, 0x9f, 0xae, 0x42, 0x17, 0x09, 0xdb, 0x4f, 0x95, 0xf7, 0x2a, 0x68, 0x3f, 0xd5, 0x55, 0xd2,
-	0xa0, 0x20, 0xab, 0x4b, 0x92, 0xea, 0x2f, 0x15, 0x34, 0x93, 0xb5, 0x01, 0xf1, 0x59, 0x44, 0x53,
-	0x52, 0x4d, 0x84, 0x45, 0x3e, 0x3a, 0x40, 0x51, 0x4c, 0xc6, 0x45, 0xe1, 0xe3, 0xbb, 0x09, 0x9c,
-	0x3c, 0x2e, 0x67, 0x4a, 0x71, 0x72, 0xfb, 0x09, 0xa0, 0xef, 0x29, 0x52, 0x30, 0x78, 0x3a, 0x86,
-	0x1d, 0x8c, 0x74, 0xa1, 0x8e, 0x64, 0x96, 0xde, 0x77, 0x14, 0x74, 0x2a, 0x0d, 0x62, 0xa9, 0x0b,
-	0xbe, 0x5b, 0xc3, 0x86, 0x93, 0x58, 0xca, 0x23, 0xa9, 0xa5, 0x9c, 0x48, 0x5c, 0x14, 0x8f, 0x38,
-	0x71, 0x52, 0x61, 0x17, 0x26, 0x4e, 0xaa, 0xec, 0xc9, 0xb8, 0xb2, 0x87, 0x98, 0xb8, 0x0b, 0xe8,
-	0x20, 0xc3, 0xf9, 0x60, 0x65, 0x4d, 0x04, 0xe8, 0x08, 0x1a, 0x0f, 0xdc, 0x4d, 0xea, 0xc4, 0xeb,
-	0x75, 0x3f, 0x7b, 0xae, 0x59, 0xea, 0xd7, 0xa1, 0x8b, 0xf0, 0x98, 0x32, 0x99, 0x68, 0xb1, 0x4e,
-	0xb4, 0x68, 0x40, 0x0c, 0x8b, 0x04, 0x04, 0x82, 0xaa, 0xf6, 0xaf, 0xc4, 0xfb, 0x34, 0x20, 0x77,
-	0x48, 0x40, 0xf4, 0xf1, 0x16, 0xfc, 0x8a, 0x54, 0x73, 0x8f, 0x3f, 0x8b, 0x6a, 0x2e, 0x99, 0xa3,
-	0xfa, 0x6b, 0xe8, 0x75, 0xa6, 0x9a, 0x2d, 0x5b, 0x59, 0xf3, 0xed, 0xac, 0xe6, 0x53, 0x79, 0x9a,
-	0x99, 0x60, 0x8e, 0xe2, 0x6f, 0x29, 0xe8, 0x18, 0xdf, 0x83, 0xdc, 0xa6, 0x6d, 0x76, 0x57, 0x5c,
-	0x6f, 0xd1, 0x34, 0xdd, 0x8e, 0x13, 0xf5, 0xd6, 0x0a, 0x1a, 0xf7, 0xa8, 0xef, 0x76, 0x3c, 0x53,
-	0x34, 0xd6, 0xe8, 0x19, 0x2f, 0xa3, 0xcf, 0xb7, 0x3d, 0xdb, 0x31, 0xed, 0x36, 0x69, 0x1a, 0xc4,
-	0xb2, 0x3c, 0xea, 0xfb, 0xbc, 0x8e, 0x96, 0x66, 0x3e, 0xfe, 0x60, 0x6e, 0x1a, 0x92, 0xb9, 0xc8,
-	0xdf, 0x3c, 0x0e, 0x3c, 0xdb, 0x69, 0xe8, 0x87, 0x22, 0x11, 0x18, 0x57, 0xd7, 0xc5, 0x2e, 0x9a,
-	0x81, 0x00, 0x4e, 0x5e, 0x45, 0x63, 0x6d, 0xf6, 0x0e, 0x3c, 0x3c, 0x2e, 0x7b, 0x18, 0x9f, 0x33,
-	0xaa, 0x5c, 0x81, 0x0e, 0x93, 0xd5, 0x4f, 0x84, 0x6f, 0xeb, 0xd4, 0xb3, 0x37, 0xba, 0xab, 0xd1,
-	0x44, 0xe1, 0xdb, 0x15, 0x34, 0xee, 0xb6, 0xa9, 0x47, 0x02, 0xd7, 0xe3, 0xbe, 0x15, 0xc0, 0x8e,
-	0x66, 0x96, 0x2e, 0xe2, 0xf4, 0x6e, 0x33, 0x9a, 0xde, 0x6d, 0xf0, 0x12, 0x9a, 0x24, 0x66, 0x58,
-	0xbb, 0x46, 0x78, 0x66, 0x99, 0xd9, 0x7b, 0x52, 0x39, 0x7b, 0x20, 0x99, 0x36, 0xc9, 0xa9, 0x45,
-	0x36, 0x73, 0xad, 0xdb, 0xa6, 0x3a, 0x22, 0xd1, 0xef, 0x28, 0x68, 0x59, 0xdf, 0xe2, 0xa0, 0xd1,
-	0x8d, 0x0d, 0x6a, 0x06, 0xcc, 0xb5, 0x03, 0x7d, 0x83, 0xb6, 0xcc, 0x26, 0xe9, 0x30, 0x59, 0x7d,
-	0x06, 0x95, 0x16, 0xee, 0x66, 0x7c, 0xe3, 0x80, 0x60, 0x2d, 0xa0, 0x49, 0xb6, 0xb7, 0x18, 0xee,
-	0x96, 0x43, 0xcb, 0xe3, 0x85, 0xd8, 0xe4, 0x87, 0xe1, 0x5c, 0x7c, 0x1c, 0xf1, 0x27, 0x39, 0x60,
-	0x13, 0x6c, 0x84, 0x35, 0xbd, 0x75, 0x69, 0x63, 0x07, 0x93, 0xe0, 0xc3, 0x4d, 0x21, 0x28, 0x6d,
-	0x9f, 0xc7, 0xfb, 0x96, 0x37, 0xeb, 0x31, 0x5c, 0x2f, 0x3b, 0x30, 0xfc, 0x48, 0x01, 0xc5, 0x61,
-	0x07, 0x63, 0x33, 0x86, 0xde, 0xd0, 0x53, 0x41, 0x19, 0x19, 0x3c, 0x28, 0xea, 0xcf, 0xe4, 0xfd,
-	0x46, 0xa0, 0x03, 0xbf, 0xef, 0xe6, 0xc0, 0xfb, 0x2c, 0xbd, 0x11, 0xdf, 0x16, 0xf8, 0x78, 0x9b,
-	0x1e, 0x61, 0x6d, 0xba, 0x24, 0x82, 0x28, 0x8a, 0xa0, 0xaf, 0xfe, 0x46, 0x41, 0x47, 0x93, 0xb9,
-	0xb9, 0x4f, 0x5b, 0x75, 0xea, 0x89, 0x38, 0x5e, 0x44, 0x63, 0x2d, 0x36, 0x50, 0x5a, 0x0f, 0x30,
-	0x6f, 0x17, 0x11, 0x4b, 0x95, 0xd1, 0x68, 0xba, 0x8c, 0x28, 0xac, 0xf6, 0x0c, 0x54, 0x08, 0xea,
-	0x32, 0x9a, 0xe2, 0xe2, 0x12, 0xe2, 0x54, 0x1f, 0x96, 0x96, 0x85, 0xac, 0x81, 0x23, 0xe6, 0x0f,
-	0xea, 0x06, 0x1c, 0x15, 0xa3, 0x6e, 0x95, 0x58, 0x25, 0x45, 0xed, 0xf2, 0x02, 0xc2, 0x71, 0xbb,
-	0x84, 0xb4, 0x88, 0x7d, 0x37, 0xee, 0x8a, 0x3c, 0x11, 0x96, 0xba, 0x06, 0x91, 0x4f, 0xdb, 0xd9,
-	0x5d, 0x4f, 0xbc, 0x0a, 0x4b, 0x82, 0x0f, 0xa7, 0x0e, 0xb9, 0x7c, 0x8e, 0x74, 0xc8, 0xe5, 0x03,
-	0x35, 0x4b, 0x5d, 0x85, 0x5a, 0x95, 0xc5, 0x76, 0x07, 0xe4, 0x27, 0x0a, 0x5c, 0xc6, 0xee, 0xb9,
-	0xe6, 0xe6, 0x0a, 0xa5, 0xf1, 0xca, 0x0c, 0x83, 0xd4, 0x22, 0x5e, 0xd7, 0xf0, 0xdb, 0xd1, 0xa6,
-	0xa2, 0x0c, 0xb0, 0xa9, 0x84, 0x32, 0x8f, 0xdb, 0x30, 0x1e, 0xba, 0x63, 0x7a, 0x94, 0x04, 0xd4,
-	0x20, 0x01, 0x8b, 0xf1, 0xa8, 0x3e, 0xce, 0x07, 0x16, 0x03, 0x7c, 0x0a, 0x4d, 0xb5, 0x49, 0xb7,
-	0xe9, 0x12, 0xcb, 0xf0, 0xed, 0x77, 0x79, 0x2d, 0xed, 0xd5, 0x27, 0x61, 0xec, 0xb1, 0xfd, 0x2e,
-	0x55, 0x9b, 0x68, 0x3a, 0x09, 0x0f, 0xdc, 0x5d, 0x43, 0x63, 0xa4, 0x15, 0xee, 0x4e, 0x80, 0xe9,
-	0x66, 0x78, 0xeb, 0xfa, 0xe4, 0xd3, 0x13, 0xa7, 0x1b, 0x76, 0xf0, 0xb4, 0x53, 0xaf, 0x9a, 0x6e,
-	0x0b, 0xee, 0xda, 0xf0, 0x67, 0xce, 0xb7, 0x36, 0xe1, 0x6e, 0x5a, 0x73, 0x82, 0x8f, 0x3f, 0x98,
-	0x43, 0xe0, 0x41, 0xcd, 0x09, 0x74, 0xd0, 0xa5, 0xde, 0x96, 0x96, 0x19, 0x3f, 0x5f, 0x2c, 0xbf,
-	0x08, 0x3c, 0x32, 0xf0, 0x95, 0x4d, 0xae, 0xfd, 0x84, 0x7c, 0x54, 0xfb, 0x88, 0x86, 0x03, 0x72,
-	0x23, 0x3d, 0x9d, 0xd7, 0x06, 0x6a, 0x4e, 0x40, 0x3d, 0x87, 0x34, 0xa5, 0xe3, 0xf6, 0x04, 0x93,
-	0x64, 0x1d, 0xf5, 0x16, 0xd4, 0x7e, 0xcd, 0x5f, 0xf5, 0x6c, 0x93, 0xbe, 0xfd, 0x94, 0x38, 0x0d,
-	0x6a, 0x0d, 0x8c, 0xf2, 0xdf, 0xfb, 0xc1, 0xcd, 0xb4, 0x3c, 0xa0, 0x9c, 0x41, 0xfb, 0x4d, 0x3e,
-	0xc4, 0x84, 0xc7, 0x75, 0xf1, 0x88, 0xdf, 0x41, 0xd8, 0xec, 0x78, 0x1e, 0x75, 0x02, 0xc3, 0xa3,
-	0xc4, 0x32, 0xda, 0xa1, 0x38, 0x34, 0x8f, 0x9d, 0x64, 0xe0, 0x0e, 0x35, 0xa5, 0x0c, 0xdc, 0xa1,
-	0xa6, 0x7e, 0x08, 0xf4, 0xea, 0x94, 0x58, 0x0c, 0x14, 0xde, 0x46, 0x47, 0x85, 0xad, 0xa8, 0x12,
-	0x03, 0xd7, 0xa3, 0x60, 0x74, 0x74, 0x08, 0x46, 0x67, 0xc0, 0xc0, 0x2a, 0x54, 0x6d, 0xa8, 0x9e,
-	0x1b, 0xff, 0x26, 0x3a, 0x2e, 0x8c, 0xfb, 0xd4, 0x74, 0x1d, 0x2b, 0x6d, 0x7e, 0xef, 0x10, 0xcc,
-	0x57, 0xc0, 0xc4, 0x63, 0x61, 0x41, 0x02, 0xd0, 0x45, 0xe2, 0xad, 0xf1, 0x9c, 0x34, 0x6d, 0x2b,
-	0x3c, 0xf2, 0x18, 0x01, 0x79, 0x61, 0x78, 0x24, 0xa0, 0x33, 0xfb, 0x86, 0x60, 0xfd, 0x30, 0xe8,
-	0x5f, 0x17, 0xea, 0xd7, 0xc8, 0x0b, 0x9d, 0x04, 0x14, 0xd7, 0xd1, 0x01, 0x87, 0x6e, 0xc9, 0x09,
-	0x1e, 0x1b, 0x82, 0xb9, 0x29, 0x87, 0x6e, 0xc5, 0xc9, 0xf5, 0xd1, 0xe1, 0xd0, 0x46, 0x5e, 0x62,
-	0xf7, 0x0f, 0xc1, 0xd8, 0xb4, 0x43, 0xb7, 0xb2, 0x49, 0xdd, 0x42, 0x47, 0x42, 0xa3, 0xf9, 0x09,
-	0x1d, 0x1f, 0x82, 0xd9, 0x37, 0x1c, 0xba, 0x95, 0x97, 0xcc, 0x67, 0x28, 0x7c, 0x93, 0x97, 0xc8,
-	0x89, 0x21, 0x58, 0x7d, 0xcd, 0xa1, 0x5b, 0xe9, 0x24, 0x46, 0x9d, 0xec, 0x51, 0xc7, 0x0d, 0xe8,
-	0x57, 0xdb, 0x16, 0x09, 0xe8, 0x9a, 0xdd, 0xa2, 0x03, 0xf7, 0x88, 0x1b, 0xd0, 0xc9, 0x32, 0xf2,
-	0xd0, 0x23, 0x8e, 0xa2, 0x89, 0x0e, 0x1b, 0x0d, 0xfb, 0xfa, 0x18, 0xef, 0xeb, 0x7c, 0x60, 0x31,
-	0x50, 0x1d, 0x38, 0x14, 0x4b, 0x9b, 0xb7, 0xbf, 0xfc, 0xc2, 0xf6, 0x03, 0xe9, 0x62, 0x18, 0x6d,
-	0xbc, 0x70, 0x31, 0xe4, 0xa7, 0x1d, 0x0b, 0xcf, 0xa3, 0xfd, 0xfc, 0x60, 0xc0, 0x8f, 0x49, 0x45,
-	0xbb, 0x8d, 0x98, 0xa8, 0xbe, 0xaf, 0x00, 0x83, 0x97, 0x63, 0x10, 0xf0, 0xae, 0xa3, 0x31, 0x1a,
-	0x0e, 0x88, 0x3b, 0xf2, 0xed, 0xbc, 0xae, 0x5b, 0xac, 0xa3, 0xca, 0x9e, 0xfc, 0x65, 0x27, 0xf0,
-	0xba, 0x3a, 0x68, 0xab, 0x2c, 0xa0, 0x49, 0x69, 0x18, 0x1f, 0x42, 0xa3, 0x9b, 0xb4, 0x0b, 0x3e,
-	0x85, 0x3f, 0xf1, 0x34, 0xda, 0xf7, 0x9c, 0x34, 0x3b, 0xbc, 0x4b, 0x8e, 0xeb, 0xfc, 0xe1, 0xfa,
-	0xc8, 0x35, 0x45, 0xed, 0xc0, 0x66, 0xce, 0x0f, 0x9d, 0x89, 0xf8, 0xec, 0xe2, 0x90, 0x7f, 0x42,
-	0x88, 0x86, 0x89, 0x85, 0x18, 0xc2, 0x84, 0x30, 0xb1, 0xbe, 0x7a, 0x1d, 0x2a, 0x43, 0x32, 0x9b,
-	0x3a, 0x7f, 0x88, 0xd4, 0xf0, 0x58, 0x4d, 0xe8, 0xe3, 0x90, 0x1b, 0x5f, 0xfd, 0x95, 0x20, 0x23,
-	0x12, 0x98, 0x21, 0xc4, 0xab, 0xa9, 0x10, 0x5f, 0x2b, 0x0e, 0xf1, 0xff, 0x35, 0xb8, 0xf3, 0xff,
-	0x55, 0xd1, 0x3e, 0x66, 0x0b, 0xf7, 0xd0, 0x18, 0x67, 0x66, 0xf1, 0xe9, 0xbe, 0x80, 0x12, 0xfc,
-	0x74, 0xe5, 0x4c, 0xe9, 0x3c, 0x8e, 0x59, 0x55, 0xdf, 0xfb, 0xc7, 0x7f, 0xbe, 0x3f, 0x72, 0x0c,
-	0x57, 0xb4, 0xbe, 0x6c, 0x3a, 0xfe, 0x9d, 0xb8, 0xfd, 0x64, 0xd8, 0x65, 0x7c, 0xa9, 0xc4, 0x4e,
-	0x96, 0xc8, 0xae, 0xcc, 0xef, 0x44, 0x04, 0x50, 0x56, 0x19, 0xca, 0xb3, 0xf8, 0x74, 0x7f, 0x94,
-	0xda, 0x76, 0xc4, 0x86, 0xf7, 0xf0, 0x8f, 0x15, 0x84, 0xe2, 0x03, 0x0c, 0x3e, 0xd7, 0xd7, 0x64,
-	0x86, 0xd3, 0xae, 0x9c, 0x1f, 0x68, 0x2e, 0xe0, 0xba, 0xca, 0x70, 0x69, 0x78, 0x2e, 0x0f, 0xd7,
-	0xd3, 0x70, 0xf7, 0xe1, 0xfd, 0x48, 0xdb, 0x96, 0x5a, 0x55, 0x0f, 0xff, 0x5a, 0x41, 0x07, 0x92,
-	0x94, 0x38, 0xae, 0x0e, 0x60, 0x56, 0xaa, 0xf1, 0x9d, 0xc1, 0x5c, 0x60, 0x30, 0x2f, 0xe3, 0x4b,
-	0x25, 0x30, 0x8d, 0x7a, 0x78, 0x64, 0x8f, 0xc0, 0xda, 0x56, 0x0f, 0xff, 0x50, 0x41, 0x9f, 0x8b,
-	0x35, 0x3e, 0x58, 0x59, 0xc3, 0x6f, 0xf6, 0xb5, 0x1c, 0xd3, 0x66, 0x95, 0xfe, 0x11, 0xcf, 0xb0,
-	0x65, 0xea, 0x97, 0x18, 0xba, 0x8b, 0xb8, 0x5a, 0x86, 0xce, 0xd9, 0x08, 0xb4, 0x6d, 0xc1, 0xc6,
-	0xf5, 0xf0, 0x6f, 0x21, 0xc9, 0x9c, 0xea, 0x2a, 0x49, 0x72, 0x82, 0xe6, 0x2f, 0x89, 0x5e, 0x92,
-	0x7a, 0x57, 0xdf, 0x66, 0xf8, 0x6e, 0xe1, 0x1b, 0x7d, 0xf1, 0x71, 0x42, 0x26, 0x99, 0x64, 0x6d,
-	0x5b, 0x62, 0x6e, 0xe2, 0x94, 0xc7, 0x9f, 0x04, 0x4a, 0x52, 0x9e, 0xf9, 0x76, 0xb0, 0x33, 0xd0,
-	0xe5, 0x29, 0x07, 0x78, 0x90, 0xf2, 0xe8, 0xab, 0x44, 0x9c, 0xf2, 0x88, 0x7c, 0xdc, 0x6d, 0xca,
-	0x33, 0x2c, 0xe6, 0x00, 0x29, 0x17, 0xc1, 0x4b, 0xa6, 0xfc, 0x7b, 0x0a, 0x9a, 0x94, 0xd8, 0x7f,
-	0xdc, 0x3f, 0x24, 0xd9, 0xef, 0x10, 0x95, 0x0b, 0x83, 0x4d, 0x06, 0x88, 0x67, 0x19, 0x44, 0x15,
-	0x9f, 0xcc, 0x83, 0xd8, 0xb4, 0xfd, 0x00, 0xaa, 0xd2, 0xc7, 0x3f, 0x05, 0x50, 0xc0, 0x6c, 0x97,
-	0x80, 0x4a, 0x7e, 0x0f, 0x28, 0x01, 0x95, 0x22, 0xcb, 0x8b, 0xe3, 0xc6, 0x40, 0xf1, 0xb8, 0xf9,
-	0xa9, 0x86, 0xf3, 0x67, 0x05, 0xbd, 0x9e, 0xfb, 0x1d, 0x00, 0x5f, 0x1d, 0xc4, 0x7e, 0xe6, 0xbb,
-	0xc1, 0x0e, 0x61, 0x2f, 0x32, 0xd8, 0x37, 0xf0, 0x42, 0x19, 0xec, 0xb0, 0x1a, 0xa3, 0xe6, 0x93,
-	0xe8, 0x43, 0x3f, 0x50, 0xd0, 0x54, 0x44, 0xc7, 0x0c, 0x5c, 0x93, 0x6f, 0x15, 0xef, 0xdf, 0x72,
-	0x49, 0x96, 0xb7, 0x72, 0x38, 0x93, 0x24, 0x2b, 0xf2, 0x6f, 0x0a, 0xb0, 0x9c, 0x69, 0xca, 0x19,
-	0x5f, 0xec, 0xbf, 0xcf, 0xe5, 0x13, 0xe4, 0x95, 0x4b, 0x3b, 0x90, 0x00, 0xd4, 0xf7, 0x19, 0xea,
-	0xbb, 0x78, 0x39, 0x77, 0x63, 0xe4, 0x24, 0xcc, 0x86, 0xeb, 0x19, 0x84, 0xcb, 0x69, 0xdb, 0x82,
-	0x42, 0xea, 0x69, 0xdb, 0x19, 0xc2, 0xbd, 0x87, 0xff, 0xae, 0xa0, 0x43, 0x69, 0x1a, 0xb8, 0xc0,
-	0x91, 0x3e, 0x6c, 0x78, 0x81, 0x23, 0xfd, 0x38, 0x66, 0x75, 0x8d, 0x39, 0xf2, 0x00, 0xdf, 0xcb,
-	0x73, 0xe4, 0x39, 0x93, 0x32, 0xa4, 0xff, 0x03, 0xd8, 0x16, 0x1c, 0x7a, 0x2f, 0xdd, 0x75, 0x25,
-	0x3a, 0xbc, 0x87, 0x7f, 0xa1, 0xa0, 0x89, 0xa8, 0x6a, 0xf0, 0x5b, 0x85, 0x0d, 0x54, 0x26, 0xdf,
-	0x2a, 0xe7, 0x06, 0x99, 0x3a, 0x48, 0x75, 0xc7, 0x95, 0xa3, 0x6d, 0x4b, 0xe7, 0xe1, 0x9e, 0x78,
-	0xe2, 0xeb, 0x33, 0x3c, 0xaf, 0xc4, 0xe4, 0x6d, 0xc1, 0x56, 0x96, 0xe1, 0x9f, 0x2b, 0xe7, 0x07,
-	0x9a, 0x3b, 0x48, 0x91, 0xb3, 0x85, 0xc8, 0x50, 0xf9, 0x49, 0xac, 0xf8, 0xe7, 0x0a, 0x3a, 0x98,
-	0xe2, 0x42, 0xb1, 0x56, 0x1e, 0xa1, 0x04, 0xc1, 0x5b, 0xb9, 0x38, 0xb8, 0x00, 0xa0, 0x9d, 0x63,
-	0x68, 0xcf, 0xe0, 0x2f, 0x96, 0x2c, 0x49, 0xe0, 0x83, 0xff, 0x22, 0x78, 0xc0, 0x24, 0xcf, 0x59,
-	0xb0, 0xcf, 0xe6, 0x12, 0xaf, 0x15, 0x6d, 0xe0, 0xf9, 0x80, 0xf3, 0x1e, 0xc3, 0xb9, 0x82, 0xef,
-	0x94, 0x2c, 0x42, 0x28, 0x83, 0xdc, 0x25, 0x28, 0x2e, 0x2c, 0xbd, 0x70, 0x3b, 0x39, 0x98, 0x62,
-	0x48, 0x0b, 0x0a, 0x22, 0xc3, 0xbe, 0x16, 0x14, 0x44, 0x96, 0x72, 0x55, 0xaf, 0x30, 0xe8, 0x55,
-	0x7c, 0xa1, 0x00, 0x3a, 0x9c, 0x10, 0x22, 0x4a, 0xb7, 0x87, 0xbf, 0xad, 0xa0, 0x29, 0x99, 0xd2,
-	0xc4, 0xfd, 0xaf, 0x1b, 0x49, 0x4e, 0xb6, 0x72, 0xb6, 0x7c, 0x22, 0x20, 0xfb, 0x02, 0x43, 0x36,
-	0x8b, 0x8f, 0xe5, 0x96, 0xaa, 0x6b, 0x6e, 0x1a, 0x1b, 0x94, 0xe2, 0xdf, 0x43, 0x65, 0x4a, 0x4c,
-	0x65, 0x49, 0x65, 0x66, 0x39, 0xd1, 0x92, 0xca, 0xcc, 0x21, 0x41, 0xd5, 0x1b, 0x0c, 0xdc, 0x55,
-	0x7c, 0xb9, 0xec, 0xc8, 0xca, 0x08, 0xcf, 0xd4, 0x66, 0xfc, 0x07, 0x51, 0xa7, 0x49, 0xee, 0xb2,
-	0xa0, 0x4e, 0x73, 0x49, 0xd2, 0x82, 0x3a, 0xcd, 0x27, 0x45, 0xd5, 0xeb, 0x0c, 0xf5, 0x15, 0x3c,
-	0x9f, 0x87, 0xda, 0xf6, 0x39, 0x8b, 0x64, 0x00, 0x51, 0x9a, 0x02, 0xfd, 0x47, 0x05, 0x58, 0xec,
-	0x47, 0x1d, 0x37, 0x20, 0x31, 0x9b, 0x52, 0x10, 0xed, 0x7c, 0xde, 0xa6, 0x20, 0xda, 0x7d, 0x88,
-	0x9a, 0xe2, 0x68, 0x3f, 0x0b, 0xf1, 0x18, 0x40, 0xe4, 0x84, 0x57, 0xc0, 0x14, 0xf0, 0xbf, 0x8a,
-	0xcb, 0x6b, 0x86, 0x14, 0x29, 0xb8, 0xbc, 0xf6, 0x63, 0x7d, 0x0a, 0x2e, 0xaf, 0x7d, 0x39, 0x17,
-	0xf5, 0x0e, 0x83, 0x7f, 0x1b, 0xdf, 0xcc, 0x83, 0x2f, 0x77, 0x30, 0xdf, 0x60, 0xa4, 0x81, 0x68,
-	0xbe, 0xb6, 0xd5, 0xd3, 0xb6, 0xe1, 0x4d, 0x0f, 0xbf, 0xaf, 0xa0, 0x43, 0x69, 0xe6, 0xa1, 0xe0,
-	0xa8, 0x99, 0x65, 0x64, 0x0a, 0xce, 0x6c, 0x39, 0x64, 0xc6, 0x00, 0xa8, 0x53, 0x70, 0xb3, 0xfb,
-	0x9a, 0xdf, 0x0b, 0xd7, 0xe7, 0x74, 0x1e, 0x55, 0x53, 0x50, 0x36, 0xf9, 0xa4, 0xce, 0x0e, 0xd1,
-	0x17, 0x96, 0xba, 0x8c, 0x5e, 0x74, 0xb7, 0x88, 0x30, 0xea, 0x2d, 0xd5, 0x3e, 0x7c, 0x39, 0xab,
-	0x7c, 0xf4, 0x72, 0x56, 0xf9, 0xd7, 0xcb, 0x59, 0xe5, 0xbb, 0xaf, 0x66, 0xf7, 0x7c, 0xf4, 0x6a,
-	0x76, 0xcf, 0x3f, 0x5f, 0xcd, 0xee, 0x79, 0xa2, 0x49, 0xe4, 0x66, 0xdd, 0xa9, 0xcf, 0x99, 0x4f,
-	0x89, 0xed, 0xc8, 0x16, 0x5e, 0x24, 0xff, 0xcf, 0xb0, 0x3e, 0xc6, 0xfe, 0x87, 0xf0, 0xf2, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x17, 0x56, 0x66, 0x4d, 0xa1, 0x29, 0x00, 0x00,
+	// 2997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0xd8, 0x8e, 0x63, 0x9f, 0x75, 0x6d, 0xf7, 0xd6, 0x4d, 0x9c, 0x71, 0xe2, 0x24, 0xd3,
+	0x92, 0xe6, 0x73, 0x37, 0x76, 0x12, 0xd4, 0xf4, 0x23, 0xc8, 0x8e, 0xed, 0xd4, 0x22, 0x4d, 0xdd,
+	0x89, 0x09, 0x22, 0x52, 0x35, 0xba, 0x3b, 0x73, 0x77, 0x33, 0xcd, 0xee, 0xcc, 0x66, 0x66, 0x36,
+	0xce, 0xd6, 0x5a, 0x01, 0x7d, 0x01, 0x89, 0x17, 0x04, 0x42, 0x42, 0x02, 0x24, 0x04, 0xe2, 0xf3,
+	0x05, 0x95, 0x56, 0x08, 0x9e, 0x78, 0x01, 0xa9, 0x12, 0x42, 0xaa, 0xca, 0x4b, 0xd5, 0x87, 0x0a,
+	0x5a, 0x24, 0xfe, 0x05, 0x1e, 0xd1, 0xdc, 0x7b, 0xe6, 0x7b, 0x76, 0x77, 0x5c, 0x2f, 0x4f, 0xde,
+	0xb9, 0x73, 0x3e, 0x7e, 0xe7, 0xe3, 0x9e, 0x3b, 0xf7, 0x1c, 0xc3, 0x62, 0xdd, 0x61, 0xcc, 0xaa,
+	0x99, 0xac, 0x61, 0x54, 0x5c, 0xcf, 0x76, 0x68, 0x9d, 0x55, 0x1e, 0xb6, 0x99, 0xd3, 0x29, 0xb7,
+	0x1c, 0xdb, 0xb3, 0x09, 0x89, 0xde, 0x97, 0xf1, 0xbd, 0x7c, 0x4e, 0xb7, 0xdd, 0xa6, 0xed, 0x56,
+	0xaa, 0xd4, 0x45, 0xe2, 0xca, 0xa3, 0xa5, 0x2a, 0xf3, 0xe8, 0x52, 0xa5, 0x45, 0xeb, 0xa6, 0x45,
+	0x3d, 0xd3, 0xb6, 0x04, 0xbf, 0x7c, 0x54, 0xd0, 0x6a, 0xfc, 0xa9, 0x22, 0x1e, 0xf0, 0xd5, 0x5c,
+	0xdd, 0xae, 0xdb, 0x62, 0xdd, 0xff, 0x85, 0xab, 0xc7, 0xea, 0xb6, 0x5d, 0x6f, 0xb0, 0x0a, 0x6d,
+	0x99, 0x15, 0x6a, 0x59, 0xb6, 0xc7, 0xa5, 0x05, 0x3c, 0x4a, 0x0c, 0x6e, 0x8b, 0x39, 0x4d, 0xd3,
+	0x75, 0x4d, 0xdb, 0xaa, 0xe8, 0x76, 0xb3, 0x19, 0xaa, 0x3c, 0x95, 0x4f, 0xe3, 0x75, 0x5a, 0x2c,
+	0x10, 0x73, 0x22, 0xc7, 0xea, 0x84, 0x8c, 0x3c, 0x82, 0x16, 0x75, 0x68, 0x33, 0x90, 0x90, 0xe7,
+	0xb7, 0xb8, 0x86, 0x67, 0x62, 0xef, 0x1f, 0x99, 0x8e, 0xd7, 0xa6, 0x8d, 0xba, 0x63, 0xb7, 0x5b,
+	0x71, 0x22, 0x65, 0x0e, 0xc8, 0xeb, 0xbe, 0xfb, 0xb6, 0xb8, 0x64, 0x95, 0x3d, 0x6c, 0x33, 0xd7,
+	0x53, 0x5e, 0x83, 0xa7, 0x12, 0xab, 0x6e, 0xcb, 0xb6, 0x5c, 0x46, 0x9e, 0x87, 0x71, 0x81, 0x60,
+	0x5e, 0x3a, 0x29, 0x9d, 0x29, 0x2d, 0xcb, 0xe5, 0x6c, 0x68, 0xca, 0x82, 0x67, 0x75, 0xec, 0xfd,
+	0x4f, 0x4e, 0x1c, 0x50, 0x91, 0x5e, 0x79, 0x19, 0x8e, 0xc7, 0x04, 0xae, 0x76, 0xb6, 0xcd, 0x26,
+	0x73, 0x3d, 0xda, 0x6c, 0xa1, 0x46, 0x72, 0x0c, 0x26, 0xbd, 0x60, 0x8d, 0x4b, 0x1f, 0x55, 0xa3,
+	0x05, 0xe5, 0x1e, 0x2c, 0xf6, 0x62, 0xdf, 0x37, 0xb4, 0x6b, 0x70, 0x98, 0xcb, 0x7e, 0x85, 0x51,
+	0x63, 0xb5, 0xad, 0x3f, 0x60, 0x5e, 0x80, 0xe9, 0x04, 0x94, 0xaa, 0x7c, 0x41, 0xb3, 0x68, 0x93,
+	0x71, 0xc1, 0x93, 0x2a, 0x88, 0xa5, 0xdb, 0xb4, 0xc9, 0x94, 0x6b, 0x20, 0xa7, 0x58, 0x57, 0x3b,
+	0x9b, 0x46, 0xc0, 0xbe, 0x00, 0x93, 0xc8, 0x6e, 0x1a, 0xc8, 0x3c, 0x21, 0x16, 0x36, 0x0d, 0xe5,
+	0x1e, 0x1c, 0xc9, 0x68, 0x45, 0x53, 0xbe, 0x14, 0xaa, 0x35, 0xad, 0x9a, 0x8d, 0xf6, 0x2c, 0xe6,
+	0xd9, 0x23, 0x18, 0x37, 0xad, 0x9a, 0x1d, 0xc0, 0xf2, 0x7f, 0x2b, 0xf7, 0x62, 0x16, 0xbd, 0x56,
+	0x7d, 0x93, 0xe9, 0x85, 0x2d, 0xf2, 0x09, 0x6c, 0xce, 0x21, 0x08, 0x46, 0x04, 0x81, 0x58, 0xca,
+	0x98, 0x2c, 0x64, 0xa7, 0x4c, 0x46, 0xf6, 0xc8, 0x64, 0xb1, 0xb0, 0x69, 0x28, 0x7f, 0x92, 0xe0,
+	0x48, 0x8a, 0x37, 0x6e, 0x73, 0xc0, 0x38, 0xc0, 0x66, 0xc1, 0x28, 0x6c, 0xb6, 0xc3, 0xdf, 0xe4,
+	0x0d, 0x98, 0xab, 0x37, 0xec, 0x2a, 0x6d, 0x68, 0x98, 0xea, 0x1a, 0xcf, 0x75, 0x6e, 0x41, 0x69,
+	0xf9, 0x7c, 0x5c, 0x52, 0x7c, 0x2f, 0x94, 0x6f, 0x72, 0xa6, 0xbb, 0x62, 0xe9, 0xa6, 0xbf, 0xa4,
+	0x92, 0x7a, 0x66, 0x4d, 0xa1, 0x08, 0xfd, 0x96, 0xe9, 0x7a, 0xc2, 0xeb, 0xc1, 0x5e, 0x21, 0x1b,
+	0x00, 0x51, 0xc9, 0x41, 0xe4, 0xa7, 0xcb, 0x58, 0x66, 0xfc, 0xfa, 0x54, 0x16, 0xc5, 0x0c, 0xeb,
+	0x53, 0x79, 0x8b, 0xd6, 0x19, 0xf2, 0xaa, 0x31, 0x4e, 0xe5, 0x97, 0x12, 0xcc, 0x67, 0x75, 0xa0,
+	0x7f, 0x56, 0x60, 0x2a, 0x96, 0x13, 0x7e, 0x92, 0x8f, 0x16, 0x48, 0x8a, 0x52, 0x94, 0x14, 0x2e,
+	0xb9, 0x99, 0xc0, 0x29, 0xfc, 0xf2, 0xdc, 0x40, 0x9c, 0x42, 0x7f, 0x02, 0xe8, 0xdb, 0x52, 0xcc,
+	0x19, 0x22, 0x1c, 0xc3, 0x76, 0x46, 0x3a, 0x51, 0x47, 0x32, 0x5b, 0xef, 0xdb, 0x12, 0x9c, 0x4a,
+	0x83, 0x58, 0xed, 0xa0, 0xed, 0xc6, 0xb0, 0xe1, 0x24, 0xb6, 0xf2, 0x48, 0x6a, 0x2b, 0x27, 0x02,
+	0x17, 0xfa, 0x23, 0x0a, 0x5c, 0x2c, 0xb1, 0xfb, 0x06, 0x2e, 0x96, 0xd9, 0xa5, 0x28, 0xb3, 0x87,
+	0x18, 0xb8, 0x0b, 0x30, 0xc3, 0x71, 0xde, 0xde, 0xd8, 0x0e, 0x1c, 0x74, 0x14, 0x26, 0x3c, 0xfb,
+	0x01, 0xb3, 0xa2, 0xfd, 0x7a, 0x88, 0x3f, 0x6f, 0x1a, 0xca, 0xd7, 0xb0, 0x8a, 0x08, 0x9f, 0x72,
+	0x9e, 0x70, 0xb3, 0x4e, 0x36, 0x99, 0x47, 0x35, 0x83, 0x7a, 0x14, 0x9d, 0xaa, 0xf4, 0xce, 0xc4,
+	0x57, 0x99, 0x47, 0xd7, 0xa8, 0x47, 0xd5, 0x89, 0x26, 0xfe, 0x0a, 0x45, 0x0b, 0x8b, 0x3f, 0x8f,
+	0x68, 0xc1, 0x99, 0x23, 0xfa, 0xab, 0xf0, 0x34, 0x17, 0xcd, 0xb7, 0x6d, 0x5c, 0xf2, 0xf5, 0xac,
+	0xe4, 0x53, 0x79, 0x92, 0x39, 0x63, 0x8e, 0xe0, 0x6f, 0x4a, 0x70, 0x4c, 0x9c, 0x41, 0x76, 0xc3,
+	0xd4, 0x3b, 0x1b, 0xb6, 0xb3, 0xa2, 0xeb, 0x76, 0xdb, 0x0a, 0x6b, 0xab, 0x0c, 0x13, 0x0e, 0x73,
+	0xed, 0xb6, 0xa3, 0x07, 0x85, 0x35, 0x7c, 0x26, 0xeb, 0xf0, 0x64, 0xcb, 0x31, 0x2d, 0xdd, 0x6c,
+	0xd1, 0x86, 0x46, 0x0d, 0xc3, 0x61, 0xae, 0x2b, 0xf2, 0x68, 0x75, 0xfe, 0xc3, 0xf7, 0x2e, 0xce,
+	0x61, 0x30, 0x57, 0xc4, 0x9b, 0x3b, 0x9e, 0x63, 0x5a, 0x75, 0x75, 0x36, 0x64, 0xc1, 0x75, 0xe5,
+	0x2e, 0x1c, 0xef, 0x01, 0x01, 0x8d, 0xbc, 0x0a, 0xe3, 0x2d, 0xfe, 0x0e, 0x2d, 0x3c, 0x1e, 0xb7,
+	0x30, 0xfa, 0x10, 0x29, 0x0b, 0x01, 0x2a, 0x12, 0x2b, 0x1f, 0x07, 0xb6, 0xdd, 0x65, 0x8e, 0x59,
+	0xeb, 0x6c, 0x85, 0x84, 0x81, 0x6d, 0x57, 0x60, 0xc2, 0x6e, 0x31, 0x87, 0x7a, 0xb6, 0x33, 0x2f,
+	0x0d, 0x80, 0x1d, 0x52, 0x0e, 0xdc, 0xc4, 0xe9, 0xd3, 0x66, 0x34, 0x7d, 0xda, 0x90, 0x55, 0x28,
+	0x51, 0xdd, 0xcf, 0x5d, 0xcd, 0xff, 0x66, 0x99, 0x1f, 0x3b, 0x29, 0x9d, 0x99, 0x5e, 0x3e, 0xd5,
+	0xc3, 0xa8, 0x15, 0x4e, 0xb9, 0xdd, 0x69, 0x31, 0x15, 0x68, 0xf8, 0x3b, 0x74, 0x5a, 0xd6, 0xb6,
+	0xc8, 0x69, 0xac, 0x56, 0x63, 0xba, 0xc7, 0x4d, 0x9b, 0xee, 0xe9, 0xb4, 0x75, 0x4e, 0xa4, 0x22,
+	0xb1, 0xf2, 0x10, 0x9e, 0x0e, 0x4f, 0x33, 0x71, 0x70, 0xa0, 0xb3, 0xae, 0x41, 0x89, 0x9f, 0x2d,
+	0x9a, 0xbd, 0x63, 0xb1, 0xc1, 0xfe, 0x02, 0x4e, 0xfc, 0x9a, 0x4f, 0x4b, 0x8e, 0x83, 0x78, 0x8a,
+	0x3b, 0x6c, 0x92, 0xaf, 0xf0, 0xa2, 0x77, 0x17, 0x0e, 0xa7, 0x55, 0xa2, 0x0d, 0x2f, 0x05, 0x8c,
+	0xb1, 0xe3, 0xf3, 0x78, 0xcf, 0xf4, 0xe6, 0x35, 0x66, 0xb2, 0x1e, 0xfc, 0x54, 0x7e, 0x24, 0xc1,
+	0xe1, 0xb0, 0x82, 0x71, 0x8a, 0xa1, 0x17, 0xf4, 0x94, 0x53, 0x46, 0x8a, 0x3b, 0x45, 0xf9, 0x59,
+	0xfc, 0xbc, 0x09, 0xd0, 0xa1, 0xdd, 0x37, 0x73, 0xe0, 0x7d, 0x9e, 0xda, 0x48, 0xae, 0x43, 0x29,
+	0x72, 0xa0, 0xbf, 0x37, 0x47, 0x07, 0x7b, 0x10, 0x42, 0x0f, 0xba, 0xca, 0x6f, 0x24, 0x58, 0x48,
+	0xc6, 0xe6, 0x55, 0xd6, 0xac, 0x32, 0x27, 0xf0, 0xe3, 0x25, 0x18, 0x6f, 0xf2, 0x85, 0x81, 0xf9,
+	0x80, 0x74, 0xfb, 0xf0, 0x58, 0x2a, 0x8d, 0x46, 0xd3, 0x69, 0xc4, 0xe0, 0x58, 0x3e, 0x54, 0x74,
+	0xea, 0x3a, 0x4c, 0x09, 0xf6, 0x18, 0xe2, 0x54, 0x1d, 0x8e, 0x6d, 0x8b, 0xb8, 0x84, 0x52, 0x3d,
+	0x7a, 0x50, 0x6a, 0xf8, 0xa9, 0x18, 0x56, 0xab, 0xc4, 0x2e, 0xe9, 0x57, 0x2e, 0x2f, 0x00, 0x89,
+	0xca, 0x25, 0x86, 0x25, 0x38, 0x77, 0xa3, 0xaa, 0x28, 0x02, 0x61, 0x28, 0xdb, 0xb0, 0x90, 0xab,
+	0x67, 0x7f, 0x35, 0xf1, 0x2a, 0x6e, 0x09, 0xb1, 0x9c, 0xfa, 0xc8, 0x15, 0x34, 0xb1, 0x8f, 0x5c,
+	0xb1, 0xb0, 0x69, 0x28, 0x5b, 0x70, 0x24, 0xc3, 0xb6, 0x3f, 0x20, 0x3f, 0x91, 0xf0, 0x32, 0x76,
+	0xcb, 0xd6, 0x1f, 0x6c, 0x30, 0x16, 0xed, 0x4c, 0xdf, 0x49, 0x4d, 0xea, 0x74, 0x34, 0xb7, 0x15,
+	0x1e, 0x2a, 0x52, 0x81, 0x43, 0xc5, 0xe7, 0xb9, 0xd3, 0xc2, 0x75, 0xdf, 0x1c, 0xdd, 0x61, 0xd4,
+	0x63, 0x1a, 0xf5, 0xb8, 0x8f, 0x47, 0xd5, 0x09, 0xb1, 0xb0, 0xe2, 0x91, 0x53, 0x30, 0xd5, 0xa2,
+	0x9d, 0x86, 0x4d, 0x0d, 0xcd, 0x35, 0xdf, 0x12, 0xb9, 0x34, 0xa6, 0x96, 0x70, 0xed, 0x8e, 0xf9,
+	0x16, 0x53, 0x1a, 0x30, 0x97, 0x84, 0x87, 0xe6, 0x6e, 0xc3, 0x38, 0x6d, 0xfa, 0xa7, 0x13, 0x62,
+	0x7a, 0xc9, 0xbf, 0x75, 0x7d, 0xfc, 0xc9, 0x89, 0xd3, 0x75, 0xd3, 0xbb, 0xdf, 0xae, 0x96, 0x75,
+	0xbb, 0x89, 0x97, 0x71, 0xfc, 0x73, 0xd1, 0x35, 0x1e, 0xe0, 0xdd, 0x74, 0xd3, 0xf2, 0x3e, 0x7c,
+	0xef, 0x22, 0xa0, 0x05, 0x9b, 0x96, 0xa7, 0xa2, 0x2c, 0xe5, 0xbf, 0x12, 0x9c, 0xe0, 0xea, 0xd6,
+	0x5d, 0xcf, 0x6c, 0x52, 0x8f, 0xdd, 0x11, 0xdb, 0xf2, 0x86, 0xed, 0x7a, 0xc3, 0xf6, 0x4c, 0xda,
+	0xf8, 0x91, 0x8c, 0xf1, 0xe4, 0xcb, 0x30, 0xe3, 0x30, 0xa3, 0x6d, 0x19, 0xd4, 0xd2, 0x3b, 0xe2,
+	0x90, 0x1a, 0xe5, 0x87, 0x48, 0xee, 0x57, 0x8b, 0x1a, 0x92, 0xf2, 0x53, 0x6a, 0xda, 0x49, 0x3c,
+	0xfb, 0xdb, 0xd6, 0x61, 0xd4, 0xd0, 0x1e, 0xb6, 0x6d, 0x8f, 0xf2, 0xc3, 0x6e, 0x4c, 0x9d, 0xf4,
+	0x57, 0x5e, 0xf7, 0x17, 0x94, 0x6f, 0x8c, 0xc1, 0xc9, 0xde, 0xa6, 0xa3, 0xd7, 0xdf, 0x80, 0x52,
+	0xc3, 0xd6, 0x1f, 0x68, 0x43, 0x74, 0x3d, 0xf8, 0x02, 0x57, 0xb8, 0x3c, 0xf2, 0x26, 0x10, 0x8b,
+	0x79, 0xb5, 0x86, 0xbd, 0xa3, 0x39, 0x7e, 0xca, 0x18, 0xac, 0xe1, 0xd1, 0xf9, 0x91, 0x21, 0x68,
+	0x99, 0x45, 0xb9, 0x2a, 0xf5, 0xd8, 0x9a, 0x2f, 0x95, 0xe8, 0x30, 0xed, 0x30, 0x97, 0x39, 0x8f,
+	0x98, 0x56, 0x6d, 0xd7, 0x6a, 0xcc, 0x99, 0x1f, 0x1d, 0x82, 0x9e, 0x27, 0x50, 0xe6, 0x2a, 0x17,
+	0x49, 0x34, 0x98, 0x6a, 0xda, 0x96, 0x77, 0xbf, 0xd1, 0xd1, 0x74, 0xdb, 0xf5, 0xe6, 0xc7, 0x86,
+	0xa0, 0xa2, 0x84, 0x12, 0xfd, 0xc0, 0xf8, 0xdf, 0x38, 0xfa, 0x7d, 0xea, 0xd4, 0x99, 0xc8, 0xa1,
+	0x83, 0x3c, 0xaa, 0x20, 0x96, 0x78, 0x0a, 0x9d, 0x83, 0x27, 0x3d, 0xdb, 0xa3, 0x0d, 0x2d, 0x4e,
+	0x36, 0xce, 0xc9, 0x66, 0xf8, 0x8b, 0x1b, 0x21, 0xad, 0x62, 0x04, 0x19, 0x40, 0x9d, 0x46, 0x67,
+	0x8d, 0x35, 0x98, 0x7f, 0x76, 0x6d, 0x31, 0x8b, 0x36, 0xbc, 0xce, 0xf0, 0xee, 0xf8, 0xff, 0x09,
+	0xee, 0x56, 0xf9, 0x6a, 0x30, 0xd3, 0xce, 0xc2, 0xac, 0x40, 0x6c, 0x68, 0x46, 0xdb, 0x89, 0x0e,
+	0xe0, 0x51, 0x75, 0x06, 0xd7, 0xd7, 0x70, 0x39, 0x56, 0x0a, 0x46, 0x86, 0x57, 0x0a, 0xc8, 0x0a,
+	0xcc, 0xb4, 0x68, 0xa7, 0xc9, 0x2c, 0x2f, 0xdc, 0xe3, 0xa3, 0x03, 0xf6, 0xf8, 0x34, 0x32, 0xe0,
+	0xaa, 0x72, 0x3d, 0x76, 0x68, 0x8b, 0xdb, 0xca, 0xfa, 0x63, 0xcf, 0xa1, 0x85, 0x1b, 0x40, 0xf1,
+	0x93, 0x34, 0xc1, 0x1f, 0x9e, 0xa4, 0xc0, 0xfc, 0x85, 0xf8, 0x67, 0xd9, 0xe9, 0xbc, 0xca, 0xb0,
+	0x69, 0x79, 0xcc, 0xb1, 0x68, 0x23, 0x76, 0x79, 0x9f, 0xe4, 0x9c, 0xfe, 0x4f, 0xe5, 0x65, 0x3c,
+	0x49, 0x37, 0xdd, 0x2d, 0xc7, 0xd4, 0xd9, 0x8d, 0xfb, 0xd4, 0xaa, 0x33, 0xa3, 0x30, 0xca, 0x7f,
+	0x1d, 0x82, 0x85, 0x5c, 0x7e, 0x44, 0x39, 0x0f, 0x87, 0x74, 0xb1, 0xc4, 0x99, 0x27, 0xd4, 0xe0,
+	0xd1, 0xdf, 0xee, 0x7a, 0xdb, 0x71, 0x7c, 0x17, 0xf3, 0xca, 0xd4, 0xf2, 0xd9, 0x3f, 0x47, 0x10,
+	0xd7, 0x98, 0x1e, 0x0b, 0xe2, 0x1a, 0xd3, 0xd5, 0x59, 0x94, 0xab, 0x32, 0x6a, 0x70, 0x50, 0x64,
+	0x17, 0x16, 0x02, 0x5d, 0x61, 0xf5, 0xf6, 0x6c, 0x87, 0xa1, 0xd2, 0xd1, 0x21, 0x28, 0x9d, 0x47,
+	0x05, 0x5b, 0x58, 0xe9, 0x7d, 0xf1, 0x42, 0xf9, 0xd7, 0xe1, 0x78, 0xa0, 0xdc, 0x65, 0xba, 0x6d,
+	0x19, 0x69, 0xf5, 0x63, 0x43, 0x50, 0x2f, 0xa3, 0x8a, 0x3b, 0x81, 0x86, 0x18, 0x80, 0x0e, 0x04,
+	0x6f, 0xb5, 0x47, 0xb4, 0x61, 0x1a, 0xd4, 0xb3, 0x1d, 0xcd, 0xa3, 0x8f, 0x79, 0x99, 0x9d, 0x3f,
+	0x38, 0x04, 0xed, 0x47, 0x50, 0xfe, 0xdd, 0x40, 0xfc, 0x36, 0x7d, 0xec, 0x17, 0x5b, 0x52, 0x85,
+	0x69, 0x8b, 0xed, 0xc4, 0x03, 0x3c, 0x3e, 0x04, 0x75, 0x53, 0x16, 0xdb, 0x89, 0x82, 0xeb, 0xc2,
+	0x11, 0x5f, 0x47, 0x5e, 0x60, 0x0f, 0x0d, 0x41, 0xd9, 0x9c, 0xc5, 0x76, 0xb2, 0x41, 0xdd, 0x81,
+	0xa3, 0xbe, 0xd2, 0xfc, 0x80, 0x4e, 0x0c, 0x41, 0xed, 0x61, 0x8b, 0xed, 0xe4, 0x05, 0xf3, 0x21,
+	0xf8, 0x6f, 0xf2, 0x02, 0x39, 0x39, 0x04, 0xad, 0x4f, 0x59, 0x6c, 0x27, 0x1d, 0xc4, 0xb0, 0x92,
+	0xf9, 0x9f, 0x0a, 0xec, 0x2b, 0x2d, 0x83, 0x7a, 0xcc, 0x6f, 0x92, 0x17, 0xae, 0x11, 0x6f, 0x07,
+	0x2d, 0x80, 0x8c, 0x00, 0x2c, 0x12, 0x0b, 0x30, 0xd9, 0x6e, 0x19, 0xf8, 0x99, 0x38, 0x2e, 0x3e,
+	0x13, 0xc5, 0xc2, 0x8a, 0x47, 0xd6, 0xa1, 0xc4, 0xd3, 0xc7, 0x61, 0xba, 0xed, 0x18, 0x3c, 0xa4,
+	0xa5, 0xe5, 0x67, 0x7b, 0xf7, 0x84, 0xfc, 0xc4, 0x50, 0x39, 0xad, 0x0a, 0x4e, 0xf8, 0x5b, 0xb1,
+	0xf0, 0xaa, 0x1e, 0xbb, 0x52, 0xb8, 0xeb, 0x8f, 0xcd, 0xe8, 0xcb, 0xee, 0x28, 0x4c, 0x84, 0xd7,
+	0x01, 0x6c, 0x57, 0x89, 0x3b, 0x98, 0x41, 0x96, 0xe1, 0x90, 0xb8, 0xae, 0x88, 0xcb, 0x5b, 0xbf,
+	0x53, 0x20, 0x20, 0x54, 0xde, 0x95, 0x60, 0xb1, 0x97, 0x42, 0x34, 0xfb, 0x2e, 0x8c, 0x33, 0x7f,
+	0x21, 0xe8, 0xdc, 0x5d, 0xcf, 0x33, 0xaa, 0xbf, 0x8c, 0x32, 0x7f, 0x72, 0xd7, 0x2d, 0xcf, 0xe9,
+	0xa8, 0x28, 0x4d, 0xbe, 0x06, 0xa5, 0xd8, 0x32, 0x99, 0x85, 0xd1, 0x07, 0xac, 0x83, 0x36, 0xf9,
+	0x3f, 0xc9, 0x1c, 0x1c, 0x7c, 0x44, 0x1b, 0x6d, 0x51, 0x6d, 0x27, 0x54, 0xf1, 0xf0, 0xc2, 0xc8,
+	0xf3, 0x92, 0xd2, 0x86, 0x23, 0x91, 0xc2, 0xa4, 0x7f, 0xf6, 0xd1, 0x7a, 0x38, 0x11, 0xb0, 0xfa,
+	0x09, 0x82, 0x3e, 0x44, 0x02, 0x3f, 0x41, 0x5c, 0xe5, 0x05, 0x58, 0x48, 0xab, 0x4d, 0xdd, 0x8a,
+	0x82, 0xd0, 0x08, 0x5f, 0x4d, 0xaa, 0x13, 0x18, 0x1b, 0x57, 0xf9, 0x55, 0xd0, 0x22, 0x4d, 0x60,
+	0x46, 0x17, 0x6f, 0xa5, 0x5c, 0xfc, 0x7c, 0x7f, 0x17, 0xff, 0x5f, 0x9d, 0xbb, 0xfc, 0x9d, 0xd3,
+	0x70, 0x90, 0xeb, 0x22, 0x5d, 0x18, 0x17, 0xf3, 0x22, 0x72, 0xba, 0x27, 0xa0, 0xc4, 0xd4, 0x4c,
+	0x7e, 0x6e, 0x20, 0x9d, 0xc0, 0xac, 0x28, 0x6f, 0xff, 0xe3, 0xdf, 0xdf, 0x1f, 0x39, 0x46, 0xe4,
+	0x4a, 0xcf, 0x19, 0x1f, 0xf9, 0x5d, 0xd0, 0x93, 0xc9, 0xcc, 0xbc, 0xc8, 0xd2, 0x00, 0x3d, 0xd9,
+	0xf1, 0x9a, 0xbc, 0xbc, 0x17, 0x16, 0x44, 0x59, 0xe6, 0x28, 0xcf, 0x90, 0xd3, 0xbd, 0x51, 0x56,
+	0x76, 0xc3, 0x19, 0x5d, 0x97, 0xfc, 0x58, 0x02, 0x88, 0x3e, 0x84, 0xc8, 0xb9, 0x9e, 0x2a, 0x33,
+	0x93, 0x36, 0xf9, 0x7c, 0x21, 0x5a, 0xc4, 0x75, 0x95, 0xe3, 0xaa, 0x90, 0x8b, 0x79, 0xb8, 0xee,
+	0xfb, 0x65, 0x48, 0xd4, 0xb5, 0xca, 0x6e, 0xac, 0xe4, 0x75, 0xc9, 0xaf, 0x25, 0x98, 0x4e, 0x0e,
+	0xea, 0x48, 0xb9, 0x80, 0xda, 0x58, 0x8e, 0xef, 0x0d, 0xe6, 0x35, 0x0e, 0xf3, 0x32, 0x59, 0x1a,
+	0x00, 0x53, 0xab, 0xfa, 0x8d, 0x84, 0x10, 0xac, 0x69, 0x74, 0xc9, 0x0f, 0x25, 0x78, 0x22, 0x92,
+	0x78, 0x7b, 0x63, 0x9b, 0x3c, 0xd3, 0x53, 0x73, 0xd4, 0xcc, 0x97, 0x7b, 0x7b, 0x3c, 0xd3, 0xc3,
+	0x57, 0xbe, 0xc8, 0xd1, 0x5d, 0x22, 0xe5, 0x41, 0xe8, 0xac, 0x9a, 0x57, 0xd9, 0x0d, 0x66, 0x04,
+	0x5d, 0xf2, 0x5b, 0x0c, 0xb2, 0x68, 0xc0, 0x0f, 0x08, 0x72, 0x62, 0xf8, 0x28, 0x9f, 0x2f, 0x44,
+	0x8b, 0xf8, 0x6e, 0x70, 0x7c, 0x2f, 0x93, 0x17, 0x7b, 0xe2, 0x13, 0x17, 0x96, 0x64, 0x90, 0x2b,
+	0xbb, 0xb1, 0x9b, 0x4d, 0x14, 0xf2, 0x68, 0x50, 0x39, 0x20, 0xe4, 0x99, 0x89, 0xe6, 0xde, 0x40,
+	0x0f, 0x0e, 0x39, 0xc2, 0xc3, 0x90, 0x87, 0xb3, 0xd2, 0x28, 0xe4, 0xe1, 0x48, 0x64, 0xbf, 0x21,
+	0xcf, 0xcc, 0x56, 0x0a, 0x84, 0x3c, 0x70, 0x5e, 0x32, 0xe4, 0xdf, 0x93, 0xa0, 0x14, 0x9b, 0x49,
+	0x92, 0xde, 0x2e, 0xc9, 0x4e, 0x47, 0xe5, 0x0b, 0xc5, 0x88, 0x11, 0xe2, 0x19, 0x0e, 0x51, 0x21,
+	0x27, 0xf3, 0x20, 0x36, 0x4c, 0xd7, 0xc3, 0xac, 0x74, 0xc9, 0x4f, 0x11, 0x94, 0x30, 0x73, 0x10,
+	0xa8, 0xe4, 0x94, 0x52, 0xbe, 0x50, 0x8c, 0xb8, 0x88, 0xdf, 0x38, 0x28, 0xe1, 0x37, 0x37, 0x55,
+	0x70, 0xfe, 0x2c, 0xc1, 0xd3, 0xb9, 0xd3, 0x49, 0x72, 0xb5, 0x88, 0xfe, 0xcc, 0x34, 0x73, 0x8f,
+	0xb0, 0x57, 0x38, 0xec, 0x17, 0xc9, 0xb5, 0x41, 0xb0, 0xfd, 0x6c, 0x0c, 0x8b, 0x4f, 0xa2, 0x0e,
+	0xfd, 0x40, 0x82, 0xa9, 0xb0, 0x49, 0x5c, 0x38, 0x27, 0xcf, 0xf6, 0x3f, 0xbf, 0xe3, 0x29, 0x39,
+	0xb8, 0x94, 0xe3, 0x37, 0x49, 0x32, 0x23, 0xff, 0x26, 0xe1, 0xec, 0x25, 0x3d, 0x08, 0x23, 0x97,
+	0x7a, 0x9f, 0x73, 0xf9, 0x63, 0x3b, 0x79, 0x69, 0x0f, 0x1c, 0x88, 0xfa, 0x55, 0x8e, 0xfa, 0x26,
+	0x59, 0xcf, 0x3d, 0x18, 0x39, 0x97, 0x56, 0xb3, 0x1d, 0x8d, 0x0a, 0xbe, 0xca, 0x6e, 0xd0, 0xd8,
+	0xee, 0x56, 0x76, 0x33, 0x63, 0xc0, 0x2e, 0xf9, 0xbb, 0x04, 0xb3, 0xe9, 0xe1, 0x54, 0x1f, 0x43,
+	0x7a, 0xcc, 0xe8, 0xe4, 0xa5, 0x3d, 0x70, 0xa0, 0x21, 0xdb, 0xdc, 0x90, 0xdb, 0xe4, 0x56, 0x9e,
+	0x21, 0x8f, 0x38, 0x97, 0x16, 0xfb, 0xf7, 0xa5, 0xdd, 0x60, 0xb2, 0xd7, 0x4d, 0x57, 0xdd, 0xd8,
+	0x90, 0xae, 0x4b, 0x7e, 0x21, 0xc1, 0x64, 0x98, 0x35, 0xe4, 0x6c, 0xdf, 0x02, 0x1a, 0x1f, 0x09,
+	0xc8, 0xe7, 0x8a, 0x90, 0x16, 0xc9, 0xee, 0x28, 0x73, 0x2a, 0xbb, 0xb1, 0xef, 0xe1, 0x6e, 0xf0,
+	0x24, 0xf6, 0xa7, 0xff, 0xbd, 0x12, 0x8d, 0x94, 0xfa, 0x1c, 0x65, 0x99, 0xa9, 0x98, 0x7c, 0xbe,
+	0x10, 0x6d, 0x91, 0x24, 0xe7, 0x1b, 0x91, 0xa3, 0x72, 0x93, 0x58, 0xc9, 0xcf, 0x25, 0x98, 0x49,
+	0x4d, 0x68, 0x48, 0x65, 0xb0, 0x87, 0x12, 0x63, 0x27, 0xf9, 0x52, 0x71, 0x06, 0x44, 0x7b, 0x91,
+	0xa3, 0x7d, 0x8e, 0x7c, 0x61, 0xc0, 0x96, 0xc4, 0x29, 0xd5, 0x5f, 0x82, 0xe9, 0x44, 0x72, 0xfa,
+	0xd2, 0xe7, 0x9c, 0xcd, 0x1d, 0x07, 0xc9, 0x95, 0xc2, 0xf4, 0x88, 0xf3, 0x16, 0xc7, 0xb9, 0x41,
+	0xd6, 0x06, 0x6c, 0x42, 0x4c, 0x83, 0xdc, 0x2d, 0x18, 0x5c, 0x58, 0xba, 0xfe, 0x71, 0x32, 0x93,
+	0x9a, 0xdb, 0xf4, 0x49, 0x88, 0xcc, 0x4c, 0x48, 0x3e, 0x5f, 0x88, 0x16, 0xa1, 0x5f, 0xe1, 0xd0,
+	0xcb, 0xe4, 0x42, 0x1f, 0xe8, 0xf8, 0x85, 0x10, 0x0e, 0x9a, 0xba, 0xe4, 0x5b, 0x12, 0x4c, 0xc5,
+	0x07, 0x2d, 0xa4, 0xf7, 0x75, 0x23, 0x39, 0x29, 0x92, 0xcf, 0x0c, 0x26, 0x44, 0x64, 0xcf, 0x72,
+	0x64, 0x8b, 0xe4, 0x58, 0x6e, 0xaa, 0xfa, 0x73, 0x85, 0x1a, 0x63, 0xe4, 0x1d, 0xcc, 0xcc, 0x58,
+	0xc7, 0x73, 0x40, 0x66, 0x66, 0x7b, 0xab, 0xf2, 0xa5, 0xe2, 0x0c, 0x08, 0xee, 0x45, 0x0e, 0xee,
+	0x2a, 0xb9, 0x3c, 0xe8, 0x93, 0x95, 0x37, 0x4e, 0x53, 0x87, 0xf1, 0x1f, 0x83, 0x1b, 0x68, 0xce,
+	0xf0, 0x84, 0x5c, 0xee, 0x89, 0xa5, 0xf7, 0x94, 0x49, 0xbe, 0xb2, 0x37, 0x26, 0x34, 0x62, 0x89,
+	0x1b, 0x71, 0x9e, 0x9c, 0xcd, 0x33, 0x82, 0x21, 0xa3, 0x86, 0x0b, 0x7c, 0x24, 0x41, 0x3e, 0x92,
+	0xe0, 0x68, 0xcf, 0x76, 0x3c, 0xe9, 0x03, 0xa3, 0xf7, 0x90, 0x40, 0xbe, 0xba, 0x47, 0x2e, 0x44,
+	0x7f, 0x9b, 0xa3, 0x7f, 0x85, 0x6c, 0xe4, 0xa2, 0xf7, 0x39, 0x35, 0x03, 0x59, 0xb5, 0x96, 0xe0,
+	0xed, 0xfb, 0x81, 0xfe, 0xfb, 0xa0, 0x7a, 0x24, 0x3b, 0xd3, 0x7d, 0xaa, 0x47, 0x6e, 0x0b, 0x5c,
+	0xae, 0x14, 0xa6, 0x47, 0x43, 0x5e, 0xe0, 0x86, 0x5c, 0x21, 0xcb, 0x79, 0x86, 0x98, 0xae, 0xe8,
+	0x11, 0x6a, 0xd8, 0x06, 0x4f, 0xa5, 0xd2, 0x1f, 0x24, 0x98, 0x0b, 0x5b, 0x65, 0x34, 0x6a, 0x95,
+	0xf5, 0xd9, 0x03, 0xf9, 0x5d, 0x39, 0xf9, 0x52, 0x71, 0x86, 0x22, 0x7b, 0x80, 0xcf, 0x0d, 0x35,
+	0xec, 0xd2, 0xf9, 0x17, 0xf3, 0x14, 0xf0, 0xbf, 0x06, 0x2d, 0x85, 0x4c, 0xab, 0xaa, 0x4f, 0x4b,
+	0xa1, 0x57, 0x2f, 0x4e, 0x5e, 0xde, 0x0b, 0x0b, 0xc2, 0x5f, 0xe3, 0xf0, 0xaf, 0x93, 0x97, 0xf2,
+	0xe0, 0xc7, 0xcf, 0x15, 0x57, 0xe3, 0xad, 0x9c, 0xe0, 0x48, 0x34, 0x8d, 0x6e, 0x65, 0x17, 0xdf,
+	0x74, 0xc9, 0xbb, 0x12, 0xcc, 0xa6, 0xfb, 0x41, 0x7d, 0x2e, 0x00, 0xd9, 0x3e, 0x99, 0x7c, 0xa1,
+	0x18, 0x71, 0x61, 0xd4, 0x29, 0xb8, 0xd9, 0xaf, 0x0d, 0xb7, 0x4b, 0xde, 0x09, 0xd2, 0x26, 0xd5,
+	0x40, 0xeb, 0x93, 0x36, 0xf9, 0xad, 0xb6, 0x3d, 0xa2, 0xef, 0x9b, 0xea, 0x71, 0xf4, 0xc1, 0x99,
+	0x13, 0xb8, 0xdc, 0xed, 0xae, 0x6e, 0xbe, 0xff, 0xe9, 0xa2, 0xf4, 0xc1, 0xa7, 0x8b, 0xd2, 0x3f,
+	0x3f, 0x5d, 0x94, 0xbe, 0xfb, 0xd9, 0xe2, 0x81, 0x0f, 0x3e, 0x5b, 0x3c, 0xf0, 0xd1, 0x67, 0x8b,
+	0x07, 0xee, 0x55, 0x62, 0xad, 0xeb, 0xaa, 0x55, 0xbd, 0xa8, 0xdf, 0xa7, 0xa6, 0x15, 0xd7, 0xf0,
+	0x38, 0xf9, 0x3f, 0xe9, 0xd5, 0x71, 0xfe, 0xff, 0xe6, 0x97, 0xff, 0x37, 0x00, 0x63, 0x98, 0x86,
+	0xfa, 0xee, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2496,6 +2777,10 @@ type QueryClient interface {
 	QueryLockFee(ctx context.Context, in *QueryLockFeeRequest, opts ...grpc.CallOption) (*QueryLockFeeResponse, error)
 	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
 	HeadBucketExtra(ctx context.Context, in *QueryHeadBucketExtraRequest, opts ...grpc.CallOption) (*QueryHeadBucketExtraResponse, error)
+	// Queries the estimated cost of storing an object and charging read quota with the current price.
+	QueryEstimateStorageCost(ctx context.Context, in *QueryEstimateStorageCostRequest, opts ...grpc.CallOption) (*QueryEstimateStorageCostResponse, error)
+	// Queries the early deletion penalty which deleting the object would incur at the current block time.
+	QueryEarlyDeletionPenalty(ctx context.Context, in *QueryEarlyDeletionPenaltyRequest, opts ...grpc.CallOption) (*QueryEarlyDeletionPenaltyResponse, error)
 	// Queries whether read and storage prices changed for the bucket.
 	QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error)
 	// Queries whether read and storage prices changed for the bucket.
@@ -2705,6 +2990,24 @@ func (c *queryClient) HeadBucketExtra(ctx context.Context, in *QueryHeadBucketEx
 	return out, nil
 }
 
+func (c *queryClient) QueryEstimateStorageCost(ctx context.Context, in *QueryEstimateStorageCostRequest, opts ...grpc.CallOption) (*QueryEstimateStorageCostResponse, error) {
+	out := new(QueryEstimateStorageCostResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryEstimateStorageCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryEarlyDeletionPenalty(ctx context.Context, in *QueryEarlyDeletionPenaltyRequest, opts ...grpc.CallOption) (*QueryEarlyDeletionPenaltyResponse, error) {
+	out := new(QueryEarlyDeletionPenaltyResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryEarlyDeletionPenalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryIsPriceChanged(ctx context.Context, in *QueryIsPriceChangedRequest, opts ...grpc.CallOption) (*QueryIsPriceChangedResponse, error) {
 	out := new(QueryIsPriceChangedResponse)
 	err := c.cc.Invoke(ctx, "/greenfield.storage.Query/QueryIsPriceChanged", in, out, opts...)
@@ -2794,6 +3097,10 @@ type QueryServer interface {
 	QueryLockFee(context.Context, *QueryLockFeeRequest) (*QueryLockFeeResponse, error)
 	// Queries a bucket extra info (with gvg bindings and price time) with specify name.
 	HeadBucketExtra(context.Context, *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error)
+	// Queries the estimated cost of storing an object and charging read quota with the current price.
+	QueryEstimateStorageCost(context.Context, *QueryEstimateStorageCostRequest) (*QueryEstimateStorageCostResponse, error)
+	// Queries the early deletion penalty which deleting the object would incur at the current block time.
+	QueryEarlyDeletionPenalty(context.Context, *QueryEarlyDeletionPenaltyRequest) (*QueryEarlyDeletionPenaltyResponse, error)
 	// Queries whether read and storage prices changed for the bucket.
 	QueryIsPriceChanged(context.Context, *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error)
 	// Queries whether read and storage prices changed for the bucket.
@@ -2873,6 +3180,12 @@ func (*UnimplementedQueryServer) QueryLockFee(ctx context.Context, req *QueryLoc
 func (*UnimplementedQueryServer) HeadBucketExtra(ctx context.Context, req *QueryHeadBucketExtraRequest) (*QueryHeadBucketExtraResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeadBucketExtra not implemented")
 }
+func (*UnimplementedQueryServer) QueryEstimateStorageCost(ctx context.Context, req *QueryEstimateStorageCostRequest) (*QueryEstimateStorageCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEstimateStorageCost not implemented")
+}
+func (*UnimplementedQueryServer) QueryEarlyDeletionPenalty(ctx context.Context, req *QueryEarlyDeletionPenaltyRequest) (*QueryEarlyDeletionPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEarlyDeletionPenalty not implemented")
+}
 func (*UnimplementedQueryServer) QueryIsPriceChanged(ctx context.Context, req *QueryIsPriceChangedRequest) (*QueryIsPriceChangedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryIsPriceChanged not implemented")
 }
@@ -3271,6 +3584,42 @@ func _Query_HeadBucketExtra_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryEstimateStorageCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateStorageCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEstimateStorageCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryEstimateStorageCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEstimateStorageCost(ctx, req.(*QueryEstimateStorageCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryEarlyDeletionPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEarlyDeletionPenaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEarlyDeletionPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Query/QueryEarlyDeletionPenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEarlyDeletionPenalty(ctx, req.(*QueryEarlyDeletionPenaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryIsPriceChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryIsPriceChangedRequest)
 	if err := dec(in); err != nil {
@@ -3449,6 +3798,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HeadBucketExtra",
 			Handler:    _Query_HeadBucketExtra_Handler,
 		},
+		{
+			MethodName: "QueryEstimateStorageCost",
+			Handler:    _Query_QueryEstimateStorageCost_Handler,
+		},
+		{
+			MethodName: "QueryEarlyDeletionPenalty",
+			Handler:    _Query_QueryEarlyDeletionPenalty_Handler,
+		},
 		{
 			MethodName: "QueryIsPriceChanged",
 			Handler:    _Query_QueryIsPriceChanged_Handler,
@@ -4753,7 +5110,7 @@ func (m *QueryLockFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEstimateStorageCostRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4763,27 +5120,42 @@ func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEstimateStorageCostRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEstimateStorageCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BucketName) > 0 {
-		i -= len(m.BucketName)
-		copy(dAtA[i:], m.BucketName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+	if m.ReadQuota != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReadQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RedundancyType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RedundancyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PrimarySpAddress) > 0 {
+		i -= len(m.PrimarySpAddress)
+		copy(dAtA[i:], m.PrimarySpAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrimarySpAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEstimateStorageCostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4793,41 +5165,226 @@ func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEstimateStorageCostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEstimateStorageCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExtraInfo != nil {
-		{
-			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.TotalChargeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalChargeSize))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ChargeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChargeSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
+	{
+		size := m.MonthlyCost.Size()
+		i -= size
+		if _, err := m.MonthlyCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReserveBuffer.Size()
+		i -= size
+		if _, err := m.ReserveBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetflowRateDelta.Size()
+		i -= size
+		if _, err := m.NetflowRateDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LockAmount.Size()
+		i -= size
+		if _, err := m.LockAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarlyDeletionPenaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarlyDeletionPenaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarlyDeletionPenaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarlyDeletionPenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarlyDeletionPenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarlyDeletionPenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentAddress) > 0 {
+		i -= len(m.PaymentAddress)
+		copy(dAtA[i:], m.PaymentAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PaymentAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChargedDuration != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChargedDuration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadBucketExtraRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadBucketExtraRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadBucketExtraRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadBucketExtraResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadBucketExtraResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadBucketExtraResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtraInfo != nil {
+		{
+			size, err := m.ExtraInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsPriceChangedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
 func (m *QueryIsPriceChangedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -5011,6 +5568,18 @@ func (m *QueryQuoteUpdateTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.ReadRecord != nil {
+		{
+			size, err := m.ReadRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.UpdateAt != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.UpdateAt))
 		i--
@@ -5748,6 +6317,86 @@ func (m *QueryLockFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEstimateStorageCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovQuery(uint64(m.PayloadSize))
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovQuery(uint64(m.RedundancyType))
+	}
+	if m.ReadQuota != 0 {
+		n += 1 + sovQuery(uint64(m.ReadQuota))
+	}
+	return n
+}
+
+func (m *QueryEstimateStorageCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LockAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetflowRateDelta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReserveBuffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MonthlyCost.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ChargeSize != 0 {
+		n += 1 + sovQuery(uint64(m.ChargeSize))
+	}
+	if m.TotalChargeSize != 0 {
+		n += 1 + sovQuery(uint64(m.TotalChargeSize))
+	}
+	return n
+}
+
+func (m *QueryEarlyDeletionPenaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEarlyDeletionPenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChargedDuration != 0 {
+		n += 1 + sovQuery(uint64(m.ChargedDuration))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryHeadBucketExtraRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5837,6 +6486,10 @@ func (m *QueryQuoteUpdateTimeResponse) Size() (n int) {
 	if m.UpdateAt != 0 {
 		n += 1 + sovQuery(uint64(m.UpdateAt))
 	}
+	if m.ReadRecord != nil {
+		l = m.ReadRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -9364,7 +10017,7 @@ func (m *QueryLockFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateStorageCostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9387,15 +10040,627 @@ func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateStorageCostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateStorageCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimarySpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedundancyType", wireType)
+			}
+			m.RedundancyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedundancyType |= RedundancyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadQuota", wireType)
+			}
+			m.ReadQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateStorageCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateStorageCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateStorageCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetflowRateDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetflowRateDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBuffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyCost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthlyCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSize", wireType)
+			}
+			m.ChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChargeSize", wireType)
+			}
+			m.TotalChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarlyDeletionPenaltyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarlyDeletionPenaltyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarlyDeletionPenaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarlyDeletionPenaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarlyDeletionPenaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarlyDeletionPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedDuration", wireType)
+			}
+			m.ChargedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeadBucketExtraRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeadBucketExtraRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10086,6 +11351,42 @@ func (m *QueryQuoteUpdateTimeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadRecord == nil {
+				m.ReadRecord = &BucketReadRecord{}
+			}
+			if err := m.ReadRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	common "github.com/bnb-chain/greenfield/types/common"
 	types "github.com/bnb-chain/greenfield/x/permission/types"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -3189,6 +3190,372 @@ func (m *MsgSetTagResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetTagResponse proto.InternalMessageInfo
 
+type MsgCreatePrepaidPlan struct {
+	// operator defines the account address of the bucket owner
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket covered by the plan
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// charge_size defines the max charge size of the bucket covered by the plan
+	ChargeSize uint64 `protobuf:"varint,3,opt,name=charge_size,json=chargeSize,proto3" json:"charge_size,omitempty"`
+	// months defines the number of months the plan lasts
+	Months uint32 `protobuf:"varint,4,opt,name=months,proto3" json:"months,omitempty"`
+}
+
+func (m *MsgCreatePrepaidPlan) Reset()         { *m = MsgCreatePrepaidPlan{} }
+func (m *MsgCreatePrepaidPlan) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePrepaidPlan) ProtoMessage()    {}
+func (*MsgCreatePrepaidPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{59}
+}
+func (m *MsgCreatePrepaidPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePrepaidPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePrepaidPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePrepaidPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePrepaidPlan.Merge(m, src)
+}
+func (m *MsgCreatePrepaidPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePrepaidPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePrepaidPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePrepaidPlan proto.InternalMessageInfo
+
+func (m *MsgCreatePrepaidPlan) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgCreatePrepaidPlan) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *MsgCreatePrepaidPlan) GetChargeSize() uint64 {
+	if m != nil {
+		return m.ChargeSize
+	}
+	return 0
+}
+
+func (m *MsgCreatePrepaidPlan) GetMonths() uint32 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+type MsgCreatePrepaidPlanResponse struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgCreatePrepaidPlanResponse) Reset()         { *m = MsgCreatePrepaidPlanResponse{} }
+func (m *MsgCreatePrepaidPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePrepaidPlanResponse) ProtoMessage()    {}
+func (*MsgCreatePrepaidPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{60}
+}
+func (m *MsgCreatePrepaidPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePrepaidPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePrepaidPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePrepaidPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePrepaidPlanResponse.Merge(m, src)
+}
+func (m *MsgCreatePrepaidPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePrepaidPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePrepaidPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePrepaidPlanResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePrepaidPlanResponse) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+type MsgSubmitReadReceipt struct {
+	// operator defines the operator account address of the sp which served the read bytes
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket which is read
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// read_bytes defines the aggregated bytes served by the sp during the receipt period
+	ReadBytes uint64 `protobuf:"varint,3,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// start_time defines the start timestamp of the receipt period, in seconds
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time defines the end timestamp of the receipt period, in seconds
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// nonce defines the sequence of the receipt of the sp in the bucket, it should be the nonce of the last receipt
+	// submitted by the sp for the bucket plus one
+	Nonce uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// owner_signature defines the signature of the bucket owner over the receipt, which is the message without the signature
+	OwnerSignature []byte `protobuf:"bytes,7,opt,name=owner_signature,json=ownerSignature,proto3" json:"owner_signature,omitempty"`
+	// bucket_id defines the id of the bucket which is read, so that the receipt cannot be replayed to a recreated bucket
+	// with the same name
+	BucketId Uint `protobuf:"bytes,8,opt,name=bucket_id,json=bucketId,proto3,customtype=Uint" json:"bucket_id"`
+	// chain_id defines the id of the chain which the receipt is submitted to, so that the receipt cannot be replayed to
+	// another chain
+	ChainId string `protobuf:"bytes,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgSubmitReadReceipt) Reset()         { *m = MsgSubmitReadReceipt{} }
+func (m *MsgSubmitReadReceipt) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitReadReceipt) ProtoMessage()    {}
+func (*MsgSubmitReadReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{61}
+}
+func (m *MsgSubmitReadReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitReadReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitReadReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitReadReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitReadReceipt.Merge(m, src)
+}
+func (m *MsgSubmitReadReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitReadReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitReadReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitReadReceipt proto.InternalMessageInfo
+
+func (m *MsgSubmitReadReceipt) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgSubmitReadReceipt) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *MsgSubmitReadReceipt) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *MsgSubmitReadReceipt) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgSubmitReadReceipt) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *MsgSubmitReadReceipt) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgSubmitReadReceipt) GetOwnerSignature() []byte {
+	if m != nil {
+		return m.OwnerSignature
+	}
+	return nil
+}
+
+func (m *MsgSubmitReadReceipt) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type MsgSubmitReadReceiptResponse struct {
+}
+
+func (m *MsgSubmitReadReceiptResponse) Reset()         { *m = MsgSubmitReadReceiptResponse{} }
+func (m *MsgSubmitReadReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitReadReceiptResponse) ProtoMessage()    {}
+func (*MsgSubmitReadReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{62}
+}
+func (m *MsgSubmitReadReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitReadReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitReadReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitReadReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitReadReceiptResponse.Merge(m, src)
+}
+func (m *MsgSubmitReadReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitReadReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitReadReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitReadReceiptResponse proto.InternalMessageInfo
+
+type MsgClaimReadFee struct {
+	// operator defines the operator account address of the sp which served the receipted read bytes
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// bucket_name defines the name of the bucket to claim the read fee from
+	BucketName string `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+}
+
+func (m *MsgClaimReadFee) Reset()         { *m = MsgClaimReadFee{} }
+func (m *MsgClaimReadFee) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReadFee) ProtoMessage()    {}
+func (*MsgClaimReadFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{63}
+}
+func (m *MsgClaimReadFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReadFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReadFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReadFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReadFee.Merge(m, src)
+}
+func (m *MsgClaimReadFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReadFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReadFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimReadFee proto.InternalMessageInfo
+
+func (m *MsgClaimReadFee) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgClaimReadFee) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+type MsgClaimReadFeeResponse struct {
+	// claimed_read_bytes defines the receipted read bytes which are claimed
+	ClaimedReadBytes uint64 `protobuf:"varint,1,opt,name=claimed_read_bytes,json=claimedReadBytes,proto3" json:"claimed_read_bytes,omitempty"`
+	// amount defines the read fee paid by the payment account of the bucket, including the validator tax
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgClaimReadFeeResponse) Reset()         { *m = MsgClaimReadFeeResponse{} }
+func (m *MsgClaimReadFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReadFeeResponse) ProtoMessage()    {}
+func (*MsgClaimReadFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddb71b028305a3cc, []int{64}
+}
+func (m *MsgClaimReadFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReadFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReadFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReadFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReadFeeResponse.Merge(m, src)
+}
+func (m *MsgClaimReadFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReadFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReadFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimReadFeeResponse proto.InternalMessageInfo
+
+func (m *MsgClaimReadFeeResponse) GetClaimedReadBytes() uint64 {
+	if m != nil {
+		return m.ClaimedReadBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateBucket)(nil), "greenfield.storage.MsgCreateBucket")
 	proto.RegisterType((*MsgCreateBucketResponse)(nil), "greenfield.storage.MsgCreateBucketResponse")
@@ -3249,159 +3616,183 @@ func init() {
 	proto.RegisterType((*MsgRejectMigrateBucketResponse)(nil), "greenfield.storage.MsgRejectMigrateBucketResponse")
 	proto.RegisterType((*MsgSetTag)(nil), "greenfield.storage.MsgSetTag")
 	proto.RegisterType((*MsgSetTagResponse)(nil), "greenfield.storage.MsgSetTagResponse")
+	proto.RegisterType((*MsgCreatePrepaidPlan)(nil), "greenfield.storage.MsgCreatePrepaidPlan")
+	proto.RegisterType((*MsgCreatePrepaidPlanResponse)(nil), "greenfield.storage.MsgCreatePrepaidPlanResponse")
+	proto.RegisterType((*MsgSubmitReadReceipt)(nil), "greenfield.storage.MsgSubmitReadReceipt")
+	proto.RegisterType((*MsgSubmitReadReceiptResponse)(nil), "greenfield.storage.MsgSubmitReadReceiptResponse")
+	proto.RegisterType((*MsgClaimReadFee)(nil), "greenfield.storage.MsgClaimReadFee")
+	proto.RegisterType((*MsgClaimReadFeeResponse)(nil), "greenfield.storage.MsgClaimReadFeeResponse")
 }
 
 func init() { proto.RegisterFile("greenfield/storage/tx.proto", fileDescriptor_ddb71b028305a3cc) }
 
 var fileDescriptor_ddb71b028305a3cc = []byte{
-	// 2339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0x36, 0x45, 0x4a, 0x32, 0x1f, 0x29, 0xc9, 0x5e, 0x2b, 0x11, 0x43, 0xd5, 0x14, 0xcd, 0xb4,
-	0x89, 0xfc, 0x27, 0x3a, 0xaa, 0x6b, 0xa4, 0x42, 0x51, 0x54, 0x52, 0x1a, 0x97, 0x48, 0x18, 0x2b,
-	0x2b, 0xd9, 0x05, 0x02, 0x14, 0xcc, 0x90, 0x3b, 0x5e, 0x6d, 0x43, 0xee, 0x6e, 0x77, 0x96, 0xb2,
-	0x99, 0x02, 0x3d, 0xf4, 0xd2, 0x53, 0x81, 0x00, 0xe9, 0xa1, 0x87, 0xa2, 0x87, 0x9e, 0x7a, 0x28,
-	0x8a, 0xa2, 0xc8, 0xb9, 0xe8, 0x25, 0x80, 0xd1, 0x93, 0x91, 0x53, 0xd1, 0x83, 0x1b, 0xd8, 0x05,
-	0x8a, 0x5e, 0x7b, 0xe9, 0xb5, 0x98, 0x9d, 0xd9, 0xd9, 0xe1, 0xfe, 0x52, 0xb2, 0x14, 0xeb, 0x24,
-	0xed, 0xcc, 0x37, 0x33, 0xef, 0x7f, 0xde, 0x7b, 0x43, 0x58, 0xd6, 0x1d, 0x8c, 0xcd, 0xfb, 0x06,
-	0xee, 0x6b, 0x4d, 0xe2, 0x5a, 0x0e, 0xd2, 0x71, 0xd3, 0x7d, 0xb8, 0x66, 0x3b, 0x96, 0x6b, 0x29,
-	0x4a, 0x30, 0xb9, 0xc6, 0x27, 0xab, 0x4b, 0x3d, 0x8b, 0x0c, 0x2c, 0xd2, 0x1c, 0x10, 0xbd, 0x79,
-	0xf0, 0x06, 0xfd, 0xc3, 0xc0, 0xd5, 0x57, 0xd8, 0x44, 0xc7, 0xfb, 0x6a, 0xb2, 0x0f, 0x3e, 0xb5,
-	0xa8, 0x5b, 0xba, 0xc5, 0xc6, 0xe9, 0x7f, 0x7c, 0x74, 0x45, 0xb7, 0x2c, 0xbd, 0x8f, 0x9b, 0xde,
-	0x57, 0x77, 0x78, 0xbf, 0xe9, 0x1a, 0x03, 0x4c, 0x5c, 0x34, 0xb0, 0x39, 0xa0, 0x2e, 0xd1, 0xd6,
-	0xb3, 0x06, 0x03, 0xcb, 0x6c, 0x22, 0xdb, 0x76, 0xac, 0x03, 0xd4, 0x17, 0x5b, 0x44, 0x10, 0x0f,
-	0x1c, 0x64, 0xdb, 0xd8, 0xe1, 0x80, 0x86, 0x04, 0xb0, 0xb1, 0x33, 0x30, 0x08, 0x31, 0x2c, 0x93,
-	0x63, 0x63, 0x36, 0xf1, 0x45, 0x90, 0x09, 0xb0, 0x91, 0x83, 0x06, 0x3e, 0x7f, 0xb5, 0x38, 0x21,
-	0x8e, 0x6c, 0xcc, 0xe7, 0x1b, 0x7f, 0xc9, 0xc3, 0x42, 0x9b, 0xe8, 0xdb, 0x0e, 0x46, 0x2e, 0xde,
-	0x1a, 0xf6, 0x3e, 0xc2, 0xae, 0xb2, 0x0e, 0xb3, 0x3d, 0xfa, 0x6d, 0x39, 0x95, 0x5c, 0x3d, 0xb7,
-	0x5a, 0xdc, 0xaa, 0x7c, 0xf1, 0xd9, 0xf5, 0x45, 0x2e, 0xb6, 0x4d, 0x4d, 0x73, 0x30, 0x21, 0xbb,
-	0xae, 0x63, 0x98, 0xba, 0xea, 0x03, 0x95, 0x15, 0x28, 0x75, 0xbd, 0xd5, 0x1d, 0x13, 0x0d, 0x70,
-	0x65, 0x8a, 0xae, 0x53, 0x81, 0x0d, 0xbd, 0x87, 0x06, 0x58, 0xd9, 0x02, 0x38, 0x30, 0x88, 0xd1,
-	0x35, 0xfa, 0x86, 0x3b, 0xaa, 0xe4, 0xeb, 0xb9, 0xd5, 0xf9, 0xf5, 0xc6, 0x5a, 0x54, 0x8b, 0x6b,
-	0xf7, 0x04, 0x6a, 0x6f, 0x64, 0x63, 0x55, 0x5a, 0xa5, 0x6c, 0xc2, 0x82, 0x8d, 0x46, 0x03, 0x6c,
-	0xba, 0x1d, 0xc4, 0xc8, 0xa8, 0x14, 0x32, 0x08, 0x9c, 0xe7, 0x0b, 0xf8, 0xa8, 0xf2, 0x36, 0x28,
-	0xb6, 0x63, 0x0c, 0x90, 0x33, 0xea, 0x10, 0x5b, 0xec, 0x32, 0x9d, 0xb1, 0xcb, 0x39, 0xbe, 0x66,
-	0xd7, 0xf6, 0xf7, 0x79, 0x07, 0x2e, 0xc8, 0xfb, 0x70, 0xdd, 0x57, 0x66, 0xea, 0xb9, 0xd5, 0xd2,
-	0xfa, 0xb2, 0xcc, 0x17, 0xd7, 0xd7, 0x26, 0x87, 0xa8, 0xe7, 0x83, 0xbd, 0xf8, 0x90, 0x72, 0x0d,
-	0x94, 0xde, 0x3e, 0x72, 0x74, 0xac, 0x75, 0x1c, 0x8c, 0xb4, 0xce, 0x4f, 0x86, 0x96, 0x8b, 0x2a,
-	0xb3, 0xf5, 0xdc, 0x6a, 0x41, 0x3d, 0xc7, 0x67, 0x54, 0x8c, 0xb4, 0xf7, 0xe9, 0xf8, 0x46, 0xf9,
-	0xe7, 0xff, 0xfe, 0xd3, 0x15, 0x5f, 0xf0, 0x8d, 0x5d, 0x58, 0x0a, 0xe9, 0x4f, 0xc5, 0xc4, 0xb6,
-	0x4c, 0x82, 0x95, 0x37, 0xa1, 0xc8, 0x75, 0x62, 0x68, 0x5c, 0x93, 0xcb, 0x8f, 0x9e, 0xac, 0x9c,
-	0xf9, 0xc7, 0x93, 0x95, 0xc2, 0x5d, 0xc3, 0x74, 0xbf, 0xf8, 0xec, 0x7a, 0x89, 0xb3, 0x4b, 0x3f,
-	0xd5, 0xb3, 0x0c, 0xdd, 0xd2, 0x1a, 0x0f, 0x3c, 0xa3, 0x78, 0x0b, 0xf7, 0xb1, 0x30, 0x8a, 0x9b,
-	0x70, 0xd6, 0xb2, 0xb1, 0x33, 0x91, 0x55, 0x08, 0x64, 0xa6, 0x59, 0x6c, 0xcc, 0x51, 0x66, 0x04,
-	0xbe, 0xf1, 0x8a, 0xc7, 0x8d, 0x7c, 0xb0, 0xcf, 0x4d, 0xe3, 0x57, 0x39, 0x58, 0xa4, 0x73, 0x06,
-	0xe9, 0x59, 0xa6, 0x6b, 0x98, 0xc3, 0x93, 0xa5, 0x4c, 0x79, 0x19, 0x66, 0x1c, 0x8c, 0x88, 0x65,
-	0x7a, 0xc6, 0x5a, 0x54, 0xf9, 0x57, 0x98, 0xe2, 0x1a, 0x7c, 0x2d, 0x8e, 0x2a, 0x41, 0xf6, 0xbf,
-	0x64, 0x07, 0xbb, 0xd3, 0xfd, 0x31, 0xee, 0x9d, 0x90, 0x83, 0xad, 0x40, 0xc9, 0xf2, 0xb6, 0x67,
-	0x00, 0x46, 0x34, 0xb0, 0x21, 0x0f, 0x70, 0x09, 0xca, 0x36, 0x1a, 0xf5, 0x2d, 0xa4, 0x75, 0x88,
-	0xf1, 0x31, 0xf6, 0x5c, 0xa7, 0xa0, 0x96, 0xf8, 0xd8, 0xae, 0xf1, 0x71, 0xd8, 0x49, 0xa7, 0x8f,
-	0xe4, 0xa4, 0x97, 0xa0, 0x4c, 0x45, 0x41, 0x9d, 0x94, 0x06, 0x1a, 0xcf, 0x25, 0x8a, 0x6a, 0x89,
-	0x8f, 0x51, 0x78, 0x92, 0xf3, 0xcc, 0x1e, 0xc9, 0x79, 0x2e, 0xc3, 0x39, 0xfc, 0xd0, 0xa6, 0x7c,
-	0xf7, 0xf6, 0x71, 0xef, 0x23, 0x32, 0x1c, 0x90, 0xca, 0xd9, 0x7a, 0x7e, 0xb5, 0xac, 0x2e, 0xb0,
-	0xf1, 0x6d, 0x7f, 0x58, 0x79, 0x07, 0x16, 0x1c, 0xac, 0x0d, 0x4d, 0x0d, 0x99, 0xbd, 0x11, 0xa3,
-	0xae, 0x98, 0xcc, 0xa3, 0x2a, 0xa0, 0x1e, 0x8f, 0xf3, 0xce, 0xd8, 0x77, 0x8a, 0x1b, 0x32, 0x2d,
-	0xcb, 0x6e, 0xc8, 0x15, 0x33, 0xa1, 0x1b, 0x32, 0x74, 0x4b, 0x6b, 0x7c, 0x3a, 0x05, 0x73, 0x6d,
-	0xa2, 0xef, 0x62, 0xd4, 0xe7, 0x96, 0x73, 0x42, 0xb6, 0x9e, 0x69, 0x3b, 0xdf, 0x82, 0x25, 0xbd,
-	0x6f, 0x75, 0x51, 0xbf, 0x73, 0x60, 0x38, 0xee, 0x10, 0xf5, 0x3b, 0xba, 0x63, 0x0d, 0x6d, 0xca,
-	0x11, 0x35, 0xa3, 0x39, 0x75, 0x91, 0x4d, 0xdf, 0x63, 0xb3, 0xb7, 0xe9, 0x64, 0x4b, 0x53, 0xde,
-	0x82, 0x15, 0x82, 0x7b, 0x96, 0xa9, 0x71, 0x55, 0x77, 0xfb, 0xa4, 0x83, 0x74, 0xbd, 0x43, 0x0c,
-	0xdd, 0x44, 0xee, 0xd0, 0xc1, 0x2c, 0xf4, 0x96, 0xd5, 0x65, 0x01, 0xdb, 0xb5, 0xb7, 0xfa, 0x64,
-	0x53, 0xd7, 0x77, 0x05, 0x24, 0xec, 0x71, 0x4b, 0xf0, 0xd2, 0x98, 0x50, 0x84, 0xab, 0xfd, 0x26,
-	0x07, 0x17, 0xda, 0x44, 0x57, 0x31, 0x1d, 0x7d, 0xf1, 0x42, 0x0b, 0xd3, 0x7d, 0x11, 0x96, 0x63,
-	0xa8, 0x13, 0xd4, 0xff, 0x91, 0x29, 0x7b, 0xdb, 0xb2, 0x47, 0x9c, 0xee, 0x6a, 0x98, 0x6e, 0x89,
-	0xba, 0xd7, 0x60, 0x81, 0x38, 0xbd, 0x4e, 0x94, 0xc2, 0x39, 0xe2, 0xf4, 0xb6, 0x02, 0x22, 0x5f,
-	0x83, 0x05, 0x8d, 0xb8, 0x63, 0x38, 0x46, 0xe8, 0x9c, 0x46, 0xdc, 0x71, 0x1c, 0xdd, 0x4f, 0x66,
-	0xa8, 0x20, 0xf6, 0xbb, 0x13, 0x18, 0x02, 0xdf, 0x4f, 0xc6, 0x4d, 0x8b, 0xfd, 0x24, 0x9c, 0x0a,
-	0x4b, 0x14, 0x77, 0xc4, 0x3b, 0x72, 0x51, 0x23, 0xee, 0x4e, 0xd8, 0xd3, 0xc3, 0xf2, 0x7c, 0xdf,
-	0xb3, 0x83, 0x40, 0x5e, 0xc7, 0xe0, 0x70, 0xbf, 0xce, 0x49, 0x17, 0xdf, 0xe9, 0xb2, 0x1e, 0xf9,
-	0x66, 0x0c, 0x59, 0xce, 0xe3, 0xc8, 0xcd, 0x78, 0xb2, 0xa4, 0x6f, 0x00, 0x08, 0xf9, 0x92, 0x4a,
-	0xbe, 0x9e, 0xcf, 0x12, 0x70, 0xd1, 0x17, 0x30, 0x91, 0x6e, 0xd5, 0xc2, 0xa1, 0x6e, 0xd5, 0x10,
-	0xcb, 0xbf, 0xc8, 0xc1, 0xbc, 0x88, 0xb7, 0x5e, 0xb4, 0x39, 0xd2, 0xa5, 0x7a, 0x11, 0x80, 0xc5,
-	0x31, 0x89, 0xd3, 0xa2, 0x37, 0xe2, 0x31, 0xba, 0x08, 0xd3, 0xf8, 0xa1, 0xeb, 0x20, 0xae, 0x1d,
-	0xf6, 0x11, 0x0a, 0xfc, 0x3b, 0xf0, 0xf2, 0x38, 0x21, 0xc2, 0x0c, 0x6f, 0xc1, 0x59, 0x11, 0x24,
-	0x27, 0xb0, 0xc2, 0x59, 0x9d, 0x05, 0xcd, 0x86, 0xeb, 0xb1, 0xc6, 0x34, 0xcd, 0x58, 0x3b, 0x9a,
-	0x1e, 0xd3, 0x99, 0x0b, 0x4b, 0xbc, 0xe2, 0xf1, 0x21, 0x9d, 0x2a, 0x64, 0xfd, 0xf9, 0x94, 0x67,
-	0x5e, 0x77, 0x6d, 0xcd, 0x67, 0xb1, 0x8d, 0x07, 0x5d, 0xec, 0x1c, 0x91, 0xac, 0x6f, 0x43, 0x89,
-	0x91, 0x65, 0x3d, 0x30, 0xb1, 0xc3, 0xe8, 0x4a, 0x59, 0xc8, 0x78, 0xb8, 0x43, 0xb1, 0x21, 0x8e,
-	0xf2, 0x61, 0x75, 0xfd, 0x00, 0xe6, 0x07, 0x1e, 0x65, 0xa4, 0xe3, 0x5a, 0x34, 0xb7, 0xaf, 0x14,
-	0xea, 0xf9, 0xd5, 0x52, 0xfc, 0xed, 0xde, 0x26, 0xba, 0xc4, 0x8b, 0x5a, 0xe6, 0x2b, 0xf7, 0xac,
-	0x4d, 0x8d, 0xde, 0x5b, 0xe7, 0xa5, 0x9d, 0x34, 0x4f, 0x28, 0x95, 0x69, 0xcf, 0xd0, 0x93, 0x29,
-	0x5d, 0x10, 0x5b, 0x30, 0x29, 0xc6, 0xdb, 0x74, 0x44, 0x8c, 0x42, 0xce, 0xff, 0xf5, 0xaf, 0x2f,
-	0x13, 0x3f, 0x38, 0xcd, 0x62, 0xfe, 0x0e, 0xcc, 0x72, 0x4e, 0x0f, 0x21, 0x5f, 0x7f, 0x49, 0xd2,
-	0xa5, 0x38, 0xce, 0xb3, 0x90, 0xc9, 0x2f, 0x99, 0x9f, 0xcb, 0xe2, 0xb8, 0x01, 0x33, 0x6c, 0xaf,
-	0x4c, 0x61, 0x70, 0x9c, 0xd2, 0x02, 0x9a, 0x09, 0x1a, 0x0e, 0x72, 0x0d, 0xcb, 0xec, 0xd0, 0x52,
-	0xde, 0x13, 0x47, 0x69, 0xbd, 0xba, 0xc6, 0xea, 0xfc, 0x35, 0xbf, 0xce, 0x5f, 0xdb, 0xf3, 0xeb,
-	0xfc, 0xad, 0xc2, 0x27, 0xff, 0x5c, 0xc9, 0xa9, 0xf3, 0xc1, 0x42, 0x3a, 0xd5, 0xf8, 0x1b, 0xd3,
-	0x91, 0xa4, 0xc4, 0xef, 0xd3, 0x98, 0x70, 0xea, 0x74, 0x24, 0x22, 0x57, 0x41, 0x8e, 0x5c, 0xb1,
-	0xb2, 0x0f, 0xf3, 0x22, 0x64, 0xff, 0xfb, 0x9c, 0x97, 0x90, 0xbc, 0x8b, 0xd1, 0x01, 0x8f, 0x43,
-	0x87, 0x17, 0xfd, 0x89, 0x71, 0xb8, 0x51, 0xa2, 0xbc, 0xf0, 0x63, 0x78, 0x4a, 0x18, 0x50, 0x1a,
-	0x5c, 0x8d, 0x53, 0x92, 0xbe, 0x58, 0xba, 0xd3, 0x32, 0xef, 0x5b, 0x27, 0x75, 0x33, 0xbe, 0x1b,
-	0x5b, 0xc8, 0xe7, 0x3d, 0x63, 0xab, 0xc5, 0x24, 0x3c, 0x77, 0x5b, 0xa6, 0x7b, 0xeb, 0xe6, 0x3d,
-	0xd4, 0x1f, 0xe2, 0x68, 0xa1, 0x7f, 0x1c, 0xed, 0x8e, 0x63, 0x28, 0xe8, 0xd2, 0xac, 0x26, 0x90,
-	0xa8, 0x90, 0xf8, 0x6f, 0x73, 0x2c, 0x2d, 0x43, 0x66, 0x0f, 0xf7, 0xc7, 0xaa, 0xde, 0x53, 0x92,
-	0x48, 0xad, 0xc0, 0xc5, 0x58, 0xfa, 0x04, 0x07, 0x7f, 0x9d, 0x82, 0x72, 0x9b, 0xe8, 0x3b, 0x43,
-	0x77, 0xc7, 0xea, 0x1b, 0xbd, 0xd1, 0x11, 0x09, 0xff, 0x2e, 0x14, 0x6d, 0xc7, 0x30, 0x7b, 0x86,
-	0x8d, 0xfa, 0x3c, 0xde, 0xd4, 0x65, 0xc9, 0x07, 0x3d, 0xbf, 0xb5, 0x1d, 0x1f, 0xa7, 0x06, 0x4b,
-	0x68, 0xf6, 0xef, 0x60, 0x62, 0x0d, 0x9d, 0x9e, 0xcf, 0x94, 0xf8, 0x56, 0xbe, 0x07, 0x40, 0x5c,
-	0xe4, 0x62, 0xaa, 0x6a, 0x3f, 0x0a, 0x27, 0x6d, 0xbe, 0xeb, 0x03, 0x55, 0x69, 0x8d, 0xd2, 0x8e,
-	0xc6, 0xc4, 0xd9, 0xcc, 0x98, 0x78, 0xf6, 0xd1, 0x93, 0x95, 0x5c, 0x5c, 0x5c, 0x0c, 0xcb, 0x78,
-	0xc7, 0xcb, 0x18, 0x84, 0x04, 0xe5, 0xcc, 0xdc, 0xf6, 0x46, 0xfc, 0xc2, 0x31, 0x2b, 0x33, 0x67,
-	0xe8, 0x96, 0xd6, 0xf8, 0xb3, 0x9c, 0x99, 0x9f, 0x56, 0xbd, 0x84, 0xc5, 0xb0, 0x2b, 0xe5, 0xec,
-	0xc7, 0x26, 0x89, 0xff, 0x30, 0x49, 0xb4, 0x0d, 0xc7, 0xb1, 0x9c, 0xe7, 0x72, 0xad, 0xab, 0x30,
-	0x65, 0x68, 0x3c, 0x26, 0xa7, 0x1e, 0x3e, 0x65, 0x68, 0x61, 0x3f, 0xcc, 0x67, 0xf9, 0x61, 0x21,
-	0xd2, 0x43, 0x68, 0xc0, 0x9c, 0x86, 0x89, 0xdb, 0xe9, 0xed, 0x23, 0xc3, 0xa4, 0x6c, 0x4f, 0x7b,
-	0x9d, 0x83, 0x12, 0x1d, 0xdc, 0xa6, 0x63, 0x2d, 0x2d, 0xbe, 0xe8, 0x91, 0x59, 0x15, 0x5e, 0xfa,
-	0x48, 0x16, 0xc3, 0x73, 0x75, 0x02, 0x8f, 0x57, 0x0c, 0x11, 0x2e, 0x0b, 0x99, 0x5c, 0xca, 0x11,
-	0x95, 0x71, 0x39, 0x16, 0x51, 0xbf, 0x94, 0x73, 0x8e, 0x60, 0xfe, 0x85, 0xf5, 0x82, 0xc6, 0xef,
-	0x94, 0xc2, 0x71, 0xdc, 0x29, 0xb2, 0x9e, 0x43, 0xfd, 0xd3, 0xcf, 0x59, 0x06, 0xc8, 0xe6, 0x9e,
-	0xa7, 0x1c, 0x3a, 0x94, 0x9a, 0x33, 0xd2, 0xab, 0x23, 0x28, 0x99, 0xd5, 0x57, 0x12, 0x1b, 0x82,
-	0xc3, 0x4f, 0x99, 0x25, 0x33, 0xfd, 0xee, 0x78, 0x8f, 0x37, 0xca, 0x2d, 0x28, 0xa2, 0xa1, 0xbb,
-	0x6f, 0x39, 0x54, 0xc4, 0x59, 0x3c, 0x06, 0x50, 0xe5, 0x4d, 0x98, 0x61, 0xcf, 0x3f, 0x41, 0x86,
-	0x1b, 0xd5, 0x0b, 0x3b, 0x63, 0xab, 0x40, 0x85, 0xa0, 0x72, 0xfc, 0xc6, 0x3c, 0x25, 0x37, 0xd8,
-	0x89, 0xab, 0x44, 0x26, 0x4a, 0x10, 0xfc, 0xbf, 0x1c, 0x9c, 0xf3, 0x78, 0xd1, 0x1d, 0x74, 0xc2,
-	0xef, 0x03, 0xca, 0x65, 0x38, 0x1f, 0xea, 0x23, 0x19, 0x9a, 0xa7, 0x8f, 0x39, 0x75, 0x5e, 0x6e,
-	0x12, 0xb5, 0xb4, 0xb4, 0x96, 0x53, 0xe1, 0x98, 0x5a, 0x4e, 0x55, 0xa8, 0x84, 0x19, 0x0f, 0x5a,
-	0x12, 0x53, 0xde, 0xe4, 0xb6, 0x35, 0xb0, 0x69, 0xbc, 0xff, 0x4a, 0xa4, 0xb3, 0x05, 0xb5, 0xd8,
-	0xb6, 0xec, 0x7d, 0x34, 0x30, 0xfa, 0xa3, 0x40, 0x54, 0xd5, 0x68, 0x77, 0xf6, 0x6d, 0x0f, 0xd2,
-	0xd2, 0x94, 0x4d, 0x28, 0xeb, 0x07, 0x7a, 0x67, 0x80, 0x6c, 0xdb, 0x30, 0x75, 0x3f, 0x9b, 0xa8,
-	0xc5, 0x19, 0xce, 0xed, 0x7b, 0xb7, 0xdb, 0x0c, 0xa6, 0x96, 0xf4, 0x03, 0x9d, 0xff, 0x1f, 0xa9,
-	0xe9, 0x1a, 0x50, 0x4f, 0x12, 0x84, 0x90, 0xd6, 0xcf, 0x58, 0xdb, 0xc4, 0xcb, 0xc2, 0xbe, 0x0a,
-	0x51, 0x85, 0x69, 0xac, 0x43, 0x2d, 0xfe, 0xfc, 0x10, 0x85, 0xac, 0x5d, 0xfb, 0xe2, 0x28, 0x8c,
-	0x39, 0x5f, 0x50, 0xf8, 0xbb, 0x1c, 0x14, 0xbd, 0x4e, 0xb8, 0xbb, 0x87, 0xf4, 0x23, 0x52, 0x25,
-	0x67, 0x33, 0x53, 0xa1, 0x2c, 0xf3, 0x26, 0x14, 0x5c, 0xa4, 0x13, 0x5e, 0xbf, 0xd4, 0xe3, 0xdf,
-	0x48, 0x18, 0x76, 0x0f, 0xe9, 0x44, 0xf5, 0xd0, 0x61, 0x36, 0x2e, 0xc0, 0x79, 0x41, 0xa3, 0x4f,
-	0xf9, 0xfa, 0x1f, 0x96, 0x20, 0xdf, 0x26, 0xba, 0xf2, 0x21, 0x94, 0xc7, 0x5e, 0x9e, 0x5f, 0x4d,
-	0xe8, 0x24, 0xc8, 0xa0, 0xea, 0xd5, 0x09, 0x40, 0x22, 0xcf, 0xfa, 0x10, 0xca, 0x63, 0xcf, 0x98,
-	0x49, 0x27, 0xc8, 0xa0, 0xc4, 0x13, 0xe2, 0xde, 0x25, 0x95, 0x3e, 0x9c, 0x8b, 0x94, 0x97, 0xaf,
-	0x27, 0x6c, 0x10, 0x06, 0x56, 0x9b, 0x13, 0x02, 0x65, 0x7e, 0xc6, 0x52, 0x9e, 0x24, 0x7e, 0x64,
-	0x50, 0x22, 0x3f, 0x71, 0x17, 0xae, 0x62, 0xc1, 0xf9, 0xe8, 0x1b, 0xeb, 0x6a, 0x92, 0x44, 0xc2,
-	0xc8, 0xea, 0x8d, 0x49, 0x91, 0x32, 0x4b, 0x63, 0x75, 0x62, 0xba, 0x11, 0x30, 0x50, 0x86, 0x11,
-	0x84, 0x1e, 0x04, 0x3e, 0x00, 0x90, 0x9e, 0x83, 0x2e, 0x25, 0x2c, 0x0d, 0x20, 0xd5, 0xcb, 0x99,
-	0x10, 0x59, 0xfd, 0x91, 0x07, 0xa7, 0x24, 0xf5, 0x87, 0x81, 0x89, 0xea, 0x4f, 0x7a, 0x24, 0xa2,
-	0x9c, 0x48, 0x0f, 0x44, 0x49, 0x9c, 0x04, 0x90, 0x44, 0x4e, 0x62, 0x9e, 0x4d, 0x84, 0xab, 0x64,
-	0xe8, 0x41, 0x06, 0x65, 0xb8, 0x4a, 0xe8, 0x04, 0x07, 0x94, 0x98, 0xbe, 0x40, 0x22, 0x89, 0x11,
-	0x68, 0xf5, 0x8d, 0x89, 0xa1, 0x51, 0x87, 0xc9, 0xe0, 0x4a, 0x06, 0x65, 0x38, 0x4c, 0xe8, 0x84,
-	0x71, 0x87, 0xe1, 0xc7, 0x4c, 0xe0, 0x30, 0xfc, 0xac, 0x1b, 0x93, 0x22, 0xa3, 0x11, 0x47, 0x2a,
-	0x06, 0xd2, 0x23, 0x4e, 0x00, 0xcc, 0x88, 0x38, 0xd1, 0xf2, 0x43, 0xf9, 0x11, 0x94, 0xe4, 0x67,
-	0x96, 0x46, 0xaa, 0xe3, 0x79, 0x98, 0xea, 0x95, 0x6c, 0x8c, 0xbc, 0xbd, 0xfc, 0xd4, 0xd1, 0x48,
-	0xb5, 0xa7, 0xf4, 0xed, 0x63, 0x1e, 0x2f, 0xa8, 0x72, 0xa2, 0x0f, 0x17, 0xab, 0xa9, 0x32, 0x90,
-	0x90, 0x89, 0xca, 0x49, 0xec, 0xe2, 0x07, 0xca, 0x91, 0xba, 0xc3, 0xaf, 0x67, 0xef, 0xe2, 0x01,
-	0x33, 0x94, 0x13, 0xed, 0xd1, 0xd2, 0x78, 0x20, 0xf5, 0x67, 0x93, 0xe2, 0x41, 0x00, 0x49, 0x8c,
-	0x07, 0xd1, 0xde, 0x29, 0xd5, 0x8c, 0x5c, 0x75, 0x35, 0x52, 0x7d, 0x22, 0x5d, 0x33, 0x31, 0x65,
-	0x0f, 0x0b, 0x9c, 0xa1, 0xa7, 0x8e, 0xe4, 0xc0, 0x39, 0x0e, 0x4c, 0x09, 0x9c, 0xf1, 0x0f, 0x09,
-	0xca, 0x0f, 0xa1, 0x18, 0x34, 0xf4, 0xea, 0x09, 0xab, 0x05, 0xa2, 0xba, 0x9a, 0x85, 0x88, 0x46,
-	0x4d, 0xbe, 0x77, 0x7a, 0xd4, 0xe4, 0xdb, 0x5f, 0x9d, 0x00, 0x24, 0x9f, 0x30, 0x56, 0x1b, 0xbe,
-	0x9a, 0x6a, 0x24, 0x0c, 0x94, 0x78, 0x42, 0x5c, 0x41, 0xa7, 0xf4, 0x60, 0x6e, 0x3c, 0xc3, 0xfd,
-	0x7a, 0xa2, 0x1e, 0x25, 0x54, 0xf5, 0xda, 0x24, 0x28, 0x71, 0xc8, 0x4f, 0xe1, 0xa5, 0xf8, 0xda,
-	0xe8, 0x5a, 0xe2, 0x15, 0x15, 0x83, 0xae, 0xde, 0x3c, 0x0c, 0x5a, 0x1c, 0x3e, 0x84, 0x0b, 0x71,
-	0xb5, 0xc6, 0x95, 0xd4, 0xfb, 0x64, 0xfc, 0xe0, 0xf5, 0xc9, 0xb1, 0xf2, 0xb1, 0x71, 0x05, 0xc4,
-	0x95, 0xd4, 0x6b, 0x7f, 0xb2, 0x63, 0x53, 0x0a, 0x03, 0xe5, 0x3d, 0x98, 0xe1, 0x45, 0xc1, 0xc5,
-	0xc4, 0x44, 0x86, 0x4e, 0x57, 0xbf, 0x91, 0x3a, 0xed, 0xef, 0xb7, 0xd5, 0x7a, 0xf4, 0xb4, 0x96,
-	0x7b, 0xfc, 0xb4, 0x96, 0xfb, 0xf2, 0x69, 0x2d, 0xf7, 0xc9, 0xb3, 0xda, 0x99, 0xc7, 0xcf, 0x6a,
-	0x67, 0xfe, 0xfe, 0xac, 0x76, 0xe6, 0x83, 0xa6, 0x6e, 0xb8, 0xfb, 0xc3, 0x2e, 0xad, 0xa6, 0x9b,
-	0x5d, 0xb3, 0x7b, 0xdd, 0x6b, 0x88, 0x34, 0xa5, 0xdf, 0x9c, 0x3e, 0x1c, 0xff, 0xd5, 0x69, 0x77,
-	0xc6, 0x6b, 0x2b, 0x7f, 0xf3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x12, 0xe3, 0x8e, 0x56, 0xdd,
-	0x2b, 0x00, 0x00,
+	// 2635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
+	0xf5, 0xcf, 0x78, 0xc6, 0x1f, 0xf3, 0xc6, 0x1f, 0x49, 0xc7, 0x1b, 0x4f, 0x3a, 0x9b, 0xf1, 0xec,
+	0xec, 0xff, 0x9f, 0x38, 0x71, 0xe2, 0xf1, 0x9a, 0x10, 0x96, 0x68, 0x85, 0xb0, 0xbd, 0x24, 0x8c,
+	0x76, 0xbd, 0xf1, 0xb6, 0x9d, 0x20, 0xad, 0x84, 0x66, 0x6b, 0xa6, 0x2b, 0x9d, 0x26, 0x33, 0xdd,
+	0x4d, 0x57, 0x8f, 0x13, 0x2f, 0x12, 0x07, 0x2e, 0x9c, 0x90, 0x56, 0x5a, 0x0e, 0x1c, 0x80, 0x03,
+	0x27, 0x4e, 0x08, 0xa1, 0xe5, 0x8a, 0xb8, 0xac, 0x14, 0x71, 0x8a, 0xf6, 0x84, 0x38, 0x84, 0x55,
+	0x82, 0x84, 0xb8, 0x21, 0x2e, 0x5c, 0x51, 0x75, 0xd5, 0x54, 0x57, 0x7f, 0x4d, 0x77, 0x1c, 0x3b,
+	0xc9, 0xc9, 0xee, 0xaa, 0x5f, 0xd5, 0xfb, 0xaa, 0xf7, 0xea, 0xbd, 0x57, 0x03, 0x67, 0x0c, 0x17,
+	0x63, 0xeb, 0x8e, 0x89, 0x7b, 0x7a, 0x93, 0x78, 0xb6, 0x8b, 0x0c, 0xdc, 0xf4, 0x1e, 0xac, 0x38,
+	0xae, 0xed, 0xd9, 0x8a, 0x12, 0x4c, 0xae, 0xf0, 0x49, 0x75, 0xa1, 0x6b, 0x93, 0xbe, 0x4d, 0x9a,
+	0x7d, 0x62, 0x34, 0xf7, 0xde, 0xa2, 0x7f, 0x18, 0x58, 0x3d, 0xcd, 0x26, 0xda, 0xfe, 0x57, 0x93,
+	0x7d, 0xf0, 0xa9, 0x79, 0xc3, 0x36, 0x6c, 0x36, 0x4e, 0xff, 0xe3, 0xa3, 0x8b, 0x86, 0x6d, 0x1b,
+	0x3d, 0xdc, 0xf4, 0xbf, 0x3a, 0x83, 0x3b, 0x4d, 0xcf, 0xec, 0x63, 0xe2, 0xa1, 0xbe, 0xc3, 0x01,
+	0x75, 0x89, 0xb7, 0xae, 0xdd, 0xef, 0xdb, 0x56, 0x13, 0x39, 0x8e, 0x6b, 0xef, 0xa1, 0x9e, 0xd8,
+	0x22, 0x86, 0xb8, 0xef, 0x22, 0xc7, 0xc1, 0x2e, 0x07, 0x34, 0x24, 0x80, 0x83, 0xdd, 0xbe, 0x49,
+	0x88, 0x69, 0x5b, 0x1c, 0x9b, 0xb0, 0xc9, 0x50, 0x05, 0x99, 0x00, 0x07, 0xb9, 0xa8, 0x3f, 0x94,
+	0xaf, 0x96, 0xa4, 0xc4, 0x7d, 0x07, 0xf3, 0xf9, 0xc6, 0x9f, 0x8a, 0x30, 0xb7, 0x45, 0x8c, 0x4d,
+	0x17, 0x23, 0x0f, 0x6f, 0x0c, 0xba, 0xf7, 0xb0, 0xa7, 0xac, 0xc1, 0x64, 0x97, 0x7e, 0xdb, 0x6e,
+	0xb5, 0x50, 0x2f, 0x2c, 0x95, 0x37, 0xaa, 0x5f, 0x7e, 0x7e, 0x79, 0x9e, 0xab, 0x6d, 0x5d, 0xd7,
+	0x5d, 0x4c, 0xc8, 0x8e, 0xe7, 0x9a, 0x96, 0xa1, 0x0d, 0x81, 0xca, 0x22, 0x54, 0x3a, 0xfe, 0xea,
+	0xb6, 0x85, 0xfa, 0xb8, 0x3a, 0x46, 0xd7, 0x69, 0xc0, 0x86, 0x3e, 0x40, 0x7d, 0xac, 0x6c, 0x00,
+	0xec, 0x99, 0xc4, 0xec, 0x98, 0x3d, 0xd3, 0xdb, 0xaf, 0x16, 0xeb, 0x85, 0xa5, 0xd9, 0xb5, 0xc6,
+	0x4a, 0xdc, 0x8a, 0x2b, 0xb7, 0x05, 0x6a, 0x77, 0xdf, 0xc1, 0x9a, 0xb4, 0x4a, 0x59, 0x87, 0x39,
+	0x07, 0xed, 0xf7, 0xb1, 0xe5, 0xb5, 0x11, 0x63, 0xa3, 0x5a, 0xca, 0x60, 0x70, 0x96, 0x2f, 0xe0,
+	0xa3, 0xca, 0x75, 0x50, 0x1c, 0xd7, 0xec, 0x23, 0x77, 0xbf, 0x4d, 0x1c, 0xb1, 0xcb, 0x78, 0xc6,
+	0x2e, 0xc7, 0xf9, 0x9a, 0x1d, 0x67, 0xb8, 0xcf, 0x7b, 0x70, 0x52, 0xde, 0x87, 0xdb, 0xbe, 0x3a,
+	0x51, 0x2f, 0x2c, 0x55, 0xd6, 0xce, 0xc8, 0x72, 0x71, 0x7b, 0xad, 0x73, 0x88, 0x76, 0x22, 0xd8,
+	0x8b, 0x0f, 0x29, 0x97, 0x40, 0xe9, 0xde, 0x45, 0xae, 0x81, 0xf5, 0xb6, 0x8b, 0x91, 0xde, 0xfe,
+	0xe1, 0xc0, 0xf6, 0x50, 0x75, 0xb2, 0x5e, 0x58, 0x2a, 0x69, 0xc7, 0xf9, 0x8c, 0x86, 0x91, 0xfe,
+	0x21, 0x1d, 0xbf, 0x36, 0xfd, 0x93, 0x7f, 0xfe, 0xfe, 0xe2, 0x50, 0xf1, 0x8d, 0x1d, 0x58, 0x88,
+	0xd8, 0x4f, 0xc3, 0xc4, 0xb1, 0x2d, 0x82, 0x95, 0xb7, 0xa1, 0xcc, 0x6d, 0x62, 0xea, 0xdc, 0x92,
+	0x67, 0x1e, 0x3e, 0x5e, 0x3c, 0xf6, 0xb7, 0xc7, 0x8b, 0xa5, 0x5b, 0xa6, 0xe5, 0x7d, 0xf9, 0xf9,
+	0xe5, 0x0a, 0x17, 0x97, 0x7e, 0x6a, 0x53, 0x0c, 0xdd, 0xd2, 0x1b, 0xf7, 0xfd, 0x43, 0xf1, 0x2e,
+	0xee, 0x61, 0x71, 0x28, 0xae, 0xc0, 0x94, 0xed, 0x60, 0x37, 0xd7, 0xa9, 0x10, 0xc8, 0xcc, 0x63,
+	0x71, 0x6d, 0x86, 0x0a, 0x23, 0xf0, 0x8d, 0xd3, 0xb0, 0x10, 0x21, 0x3c, 0x94, 0xa6, 0xf1, 0xf3,
+	0x02, 0xcc, 0xd3, 0x39, 0x93, 0x74, 0x6d, 0xcb, 0x33, 0xad, 0xc1, 0xd1, 0x72, 0xa6, 0x9c, 0x82,
+	0x09, 0x17, 0x23, 0x62, 0x5b, 0xfe, 0x61, 0x2d, 0x6b, 0xfc, 0x2b, 0xca, 0x71, 0x0d, 0x5e, 0x4f,
+	0xe2, 0x4a, 0xb0, 0xfd, 0x0f, 0xd9, 0xc1, 0x6e, 0x76, 0x7e, 0x80, 0xbb, 0x47, 0xe4, 0x60, 0x8b,
+	0x50, 0xb1, 0xfd, 0xed, 0x19, 0x80, 0x31, 0x0d, 0x6c, 0xc8, 0x07, 0xbc, 0x01, 0xd3, 0x0e, 0xda,
+	0xef, 0xd9, 0x48, 0x6f, 0x13, 0xf3, 0x13, 0xec, 0xbb, 0x4e, 0x49, 0xab, 0xf0, 0xb1, 0x1d, 0xf3,
+	0x93, 0xa8, 0x93, 0x8e, 0x1f, 0xc8, 0x49, 0xdf, 0x80, 0x69, 0xaa, 0x0a, 0xea, 0xa4, 0x34, 0xd0,
+	0xf8, 0x2e, 0x51, 0xd6, 0x2a, 0x7c, 0x8c, 0xc2, 0xd3, 0x9c, 0x67, 0xf2, 0x40, 0xce, 0x73, 0x01,
+	0x8e, 0xe3, 0x07, 0x0e, 0x95, 0xbb, 0x7b, 0x17, 0x77, 0xef, 0x91, 0x41, 0x9f, 0x54, 0xa7, 0xea,
+	0xc5, 0xa5, 0x69, 0x6d, 0x8e, 0x8d, 0x6f, 0x0e, 0x87, 0x95, 0xf7, 0x60, 0xce, 0xc5, 0xfa, 0xc0,
+	0xd2, 0x91, 0xd5, 0xdd, 0x67, 0xdc, 0x95, 0xd3, 0x65, 0xd4, 0x04, 0xd4, 0x97, 0x71, 0xd6, 0x0d,
+	0x7d, 0x8f, 0x70, 0x43, 0x66, 0x65, 0xd9, 0x0d, 0xb9, 0x61, 0x72, 0xba, 0x21, 0x43, 0xb7, 0xf4,
+	0xc6, 0x67, 0x63, 0x30, 0xb3, 0x45, 0x8c, 0x1d, 0x8c, 0x7a, 0xfc, 0xe4, 0x1c, 0xd1, 0x59, 0xcf,
+	0x3c, 0x3b, 0x5f, 0x87, 0x05, 0xa3, 0x67, 0x77, 0x50, 0xaf, 0xbd, 0x67, 0xba, 0xde, 0x00, 0xf5,
+	0xda, 0x86, 0x6b, 0x0f, 0x1c, 0x2a, 0x11, 0x3d, 0x46, 0x33, 0xda, 0x3c, 0x9b, 0xbe, 0xcd, 0x66,
+	0x6f, 0xd0, 0xc9, 0x96, 0xae, 0xbc, 0x0b, 0x8b, 0x04, 0x77, 0x6d, 0x4b, 0xe7, 0xa6, 0xee, 0xf4,
+	0x48, 0x1b, 0x19, 0x46, 0x9b, 0x98, 0x86, 0x85, 0xbc, 0x81, 0x8b, 0x59, 0xe8, 0x9d, 0xd6, 0xce,
+	0x08, 0xd8, 0x8e, 0xb3, 0xd1, 0x23, 0xeb, 0x86, 0xb1, 0x23, 0x20, 0x51, 0x8f, 0x5b, 0x80, 0xd7,
+	0x42, 0x4a, 0x11, 0xae, 0xf6, 0xcb, 0x02, 0x9c, 0xdc, 0x22, 0x86, 0x86, 0xe9, 0xe8, 0xcb, 0x57,
+	0x5a, 0x94, 0xef, 0xb3, 0x70, 0x26, 0x81, 0x3b, 0xc1, 0xfd, 0xef, 0x98, 0xb1, 0x37, 0x6d, 0x67,
+	0x9f, 0xf3, 0xad, 0x46, 0xf9, 0x96, 0xb8, 0x3b, 0x07, 0x73, 0xc4, 0xed, 0xb6, 0xe3, 0x1c, 0xce,
+	0x10, 0xb7, 0xbb, 0x11, 0x30, 0x79, 0x0e, 0xe6, 0x74, 0xe2, 0x85, 0x70, 0x8c, 0xd1, 0x19, 0x9d,
+	0x78, 0x61, 0x1c, 0xdd, 0x4f, 0x16, 0xa8, 0x24, 0xf6, 0xbb, 0x19, 0x1c, 0x04, 0xbe, 0x9f, 0x8c,
+	0x1b, 0x17, 0xfb, 0x49, 0x38, 0x0d, 0x16, 0x28, 0xee, 0x80, 0x77, 0xe4, 0xbc, 0x4e, 0xbc, 0xed,
+	0xa8, 0xa7, 0x47, 0xf5, 0xf9, 0x21, 0xbc, 0x16, 0xd2, 0xd7, 0x21, 0x38, 0xdc, 0x2f, 0x0a, 0xd2,
+	0xc5, 0xf7, 0x6a, 0x9d, 0x1e, 0xf9, 0x66, 0x8c, 0x9c, 0x9c, 0x47, 0xb1, 0x9b, 0xf1, 0x68, 0x59,
+	0xbf, 0x06, 0x20, 0xf4, 0x4b, 0xaa, 0xc5, 0x7a, 0x31, 0x4b, 0xc1, 0xe5, 0xa1, 0x82, 0x89, 0x74,
+	0xab, 0x96, 0x9e, 0xe9, 0x56, 0x8d, 0x88, 0xfc, 0xd3, 0x02, 0xcc, 0x8a, 0x78, 0xeb, 0x47, 0x9b,
+	0x03, 0x5d, 0xaa, 0x67, 0x01, 0x58, 0x1c, 0x93, 0x24, 0x2d, 0xfb, 0x23, 0xbe, 0xa0, 0xf3, 0x30,
+	0x8e, 0x1f, 0x78, 0x2e, 0xe2, 0xd6, 0x61, 0x1f, 0x91, 0xc0, 0xbf, 0x0d, 0xa7, 0xc2, 0x8c, 0x88,
+	0x63, 0x78, 0x15, 0xa6, 0x44, 0x90, 0xcc, 0x71, 0x0a, 0x27, 0x0d, 0x16, 0x34, 0x1b, 0x1e, 0xcc,
+	0x0a, 0x4b, 0x33, 0xd1, 0x0e, 0x66, 0xc7, 0xd1, 0xc2, 0x45, 0x35, 0x5e, 0x85, 0x53, 0x61, 0xaa,
+	0x42, 0xd7, 0x5f, 0x8c, 0xf9, 0xc7, 0xeb, 0x96, 0xa3, 0x0f, 0x45, 0xdc, 0xc2, 0xfd, 0x0e, 0x76,
+	0x0f, 0xc8, 0xd6, 0x37, 0xa1, 0xc2, 0xd8, 0xb2, 0xef, 0x5b, 0xd8, 0xad, 0x8e, 0x65, 0x2c, 0x64,
+	0x32, 0xdc, 0xa4, 0xd8, 0x88, 0x44, 0xc5, 0xa8, 0xb9, 0xbe, 0x0b, 0xb3, 0x7d, 0x9f, 0x33, 0xd2,
+	0xf6, 0x6c, 0x9a, 0xdb, 0x57, 0x4b, 0xf5, 0xe2, 0x52, 0x25, 0xf9, 0x76, 0xdf, 0x22, 0x86, 0x24,
+	0x8b, 0x36, 0xcd, 0x57, 0xee, 0xda, 0xeb, 0x3a, 0xbd, 0xb7, 0x4e, 0x48, 0x3b, 0xe9, 0xbe, 0x52,
+	0xaa, 0xe3, 0xf5, 0xe2, 0x48, 0x4e, 0xe7, 0xc4, 0x16, 0x4c, 0x8b, 0xc9, 0x67, 0x3a, 0xa6, 0x46,
+	0xa1, 0xe7, 0xff, 0x0c, 0xaf, 0x2f, 0x0b, 0xdf, 0x7f, 0x95, 0xd5, 0xfc, 0x0e, 0x4c, 0x72, 0x49,
+	0x9f, 0x41, 0xbf, 0xc3, 0x25, 0x69, 0x97, 0x62, 0x58, 0x66, 0xa1, 0x93, 0x9f, 0x31, 0x3f, 0x97,
+	0xd5, 0xb1, 0x0a, 0x13, 0x6c, 0xaf, 0x4c, 0x65, 0x70, 0x9c, 0xd2, 0x02, 0x9a, 0x09, 0x9a, 0x2e,
+	0xf2, 0x4c, 0xdb, 0x6a, 0xd3, 0x52, 0xde, 0x57, 0x47, 0x65, 0x4d, 0x5d, 0x61, 0x75, 0xfe, 0xca,
+	0xb0, 0xce, 0x5f, 0xd9, 0x1d, 0xd6, 0xf9, 0x1b, 0xa5, 0x4f, 0xff, 0xbe, 0x58, 0xd0, 0x66, 0x83,
+	0x85, 0x74, 0xaa, 0xf1, 0x17, 0x66, 0x23, 0xc9, 0x88, 0xdf, 0xa1, 0x31, 0xe1, 0x95, 0xb3, 0x91,
+	0x88, 0x5c, 0x25, 0x39, 0x72, 0x25, 0xea, 0x3e, 0x2a, 0x8b, 0xd0, 0xfd, 0x6f, 0x0b, 0x7e, 0x42,
+	0xf2, 0x3e, 0x46, 0x7b, 0x6c, 0xfa, 0x00, 0xaa, 0x3f, 0x32, 0x09, 0xaf, 0x55, 0xa8, 0x2c, 0x9c,
+	0x0c, 0x4f, 0x09, 0x03, 0x4e, 0x83, 0xab, 0x71, 0x4c, 0xb2, 0x17, 0x4b, 0x77, 0x5a, 0xd6, 0x1d,
+	0xfb, 0xa8, 0x6e, 0xc6, 0xf7, 0x13, 0x0b, 0xf9, 0xa2, 0x7f, 0xd8, 0x6a, 0x09, 0x09, 0xcf, 0xad,
+	0x96, 0xe5, 0x5d, 0xbd, 0x72, 0x1b, 0xf5, 0x06, 0x38, 0x5e, 0xe8, 0x1f, 0x46, 0xbb, 0xe3, 0x10,
+	0x0a, 0xba, 0x51, 0xa7, 0x26, 0xd0, 0xa8, 0xd0, 0xf8, 0xaf, 0x0b, 0x2c, 0x2d, 0x43, 0x56, 0x17,
+	0xf7, 0x42, 0x55, 0xef, 0x2b, 0x92, 0x48, 0x2d, 0xc2, 0xd9, 0x44, 0xfe, 0x84, 0x04, 0x7f, 0x1e,
+	0x83, 0xe9, 0x2d, 0x62, 0x6c, 0x0f, 0xbc, 0x6d, 0xbb, 0x67, 0x76, 0xf7, 0x0f, 0xc8, 0xf8, 0xb7,
+	0xa0, 0xec, 0xb8, 0xa6, 0xd5, 0x35, 0x1d, 0xd4, 0xe3, 0xf1, 0xa6, 0x2e, 0x6b, 0x3e, 0xe8, 0xf9,
+	0xad, 0x6c, 0x0f, 0x71, 0x5a, 0xb0, 0x84, 0x66, 0xff, 0x2e, 0x26, 0xf6, 0xc0, 0xed, 0x0e, 0x85,
+	0x12, 0xdf, 0xca, 0xb7, 0x01, 0x88, 0x87, 0x3c, 0x4c, 0x4d, 0x3d, 0x8c, 0xc2, 0x69, 0x9b, 0xef,
+	0x0c, 0x81, 0x9a, 0xb4, 0x46, 0xd9, 0x8a, 0xc7, 0xc4, 0xc9, 0xcc, 0x98, 0x38, 0xf5, 0xf0, 0xf1,
+	0x62, 0x21, 0x29, 0x2e, 0x46, 0x75, 0xbc, 0x0d, 0xf3, 0xb2, 0x06, 0xe5, 0xcc, 0xdc, 0xf1, 0x47,
+	0x86, 0x85, 0x63, 0x56, 0x66, 0xce, 0xd0, 0x2d, 0xbd, 0xf1, 0x07, 0x39, 0x33, 0x7f, 0x55, 0xed,
+	0x12, 0x55, 0xc3, 0x0e, 0x2c, 0x44, 0x78, 0x3e, 0x04, 0x4d, 0xfc, 0x8b, 0x69, 0x62, 0xcb, 0x74,
+	0x5d, 0xdb, 0x7d, 0x2e, 0xd7, 0x5a, 0x86, 0x31, 0x53, 0xaf, 0x8e, 0x65, 0x13, 0x1f, 0x33, 0xf5,
+	0xa8, 0x1f, 0x16, 0xb3, 0xfc, 0xb0, 0x14, 0xeb, 0x21, 0x34, 0x60, 0x46, 0xc7, 0x84, 0xb6, 0x69,
+	0x90, 0x69, 0x51, 0xb1, 0xc7, 0xfd, 0xce, 0x41, 0x85, 0x0e, 0x6e, 0xd2, 0xb1, 0x96, 0x9e, 0x5c,
+	0xf4, 0xc8, 0xa2, 0x0a, 0x2f, 0x7d, 0x28, 0xab, 0xe1, 0xb9, 0x3a, 0x81, 0x87, 0xab, 0x86, 0x98,
+	0x94, 0xa5, 0x4c, 0x29, 0xe5, 0x88, 0xca, 0xa4, 0x0c, 0x45, 0xd4, 0xaf, 0xe4, 0x9c, 0x23, 0x98,
+	0x7f, 0x69, 0xbd, 0xa0, 0xf0, 0x9d, 0x52, 0x3a, 0x8c, 0x3b, 0x45, 0xb6, 0x73, 0xa4, 0x7f, 0xfa,
+	0x05, 0xcb, 0x00, 0xd9, 0xdc, 0xf3, 0x94, 0x43, 0xcf, 0x64, 0xe6, 0x8c, 0xf4, 0xea, 0x00, 0x46,
+	0x66, 0xf5, 0x95, 0x24, 0x86, 0x90, 0xf0, 0x33, 0x76, 0x92, 0x99, 0x7d, 0xb7, 0xfd, 0xc7, 0x1b,
+	0xe5, 0x2a, 0x94, 0xd1, 0xc0, 0xbb, 0x6b, 0xbb, 0x54, 0xc5, 0x59, 0x32, 0x06, 0x50, 0xe5, 0x6d,
+	0x98, 0x60, 0xcf, 0x3f, 0x41, 0x86, 0x1b, 0xb7, 0x0b, 0xa3, 0xb1, 0x51, 0xa2, 0x4a, 0xd0, 0x38,
+	0xfe, 0xda, 0x2c, 0x65, 0x37, 0xd8, 0x89, 0x9b, 0x44, 0x66, 0x4a, 0x30, 0xfc, 0xdf, 0x02, 0x1c,
+	0xf7, 0x65, 0x31, 0x5c, 0x74, 0xc4, 0xef, 0x03, 0xca, 0x05, 0x38, 0x11, 0xe9, 0x23, 0x99, 0xba,
+	0x6f, 0x8f, 0x19, 0x6d, 0x56, 0x6e, 0x12, 0xb5, 0xf4, 0x51, 0x2d, 0xa7, 0xd2, 0x21, 0xb5, 0x9c,
+	0x54, 0xa8, 0x46, 0x05, 0x0f, 0x5a, 0x12, 0x63, 0xfe, 0xe4, 0xa6, 0xdd, 0x77, 0x7a, 0xd8, 0xc3,
+	0x2f, 0x44, 0x3b, 0x1b, 0x50, 0x4b, 0x6c, 0xcb, 0xde, 0x41, 0x7d, 0xb3, 0xb7, 0x1f, 0xa8, 0x4a,
+	0x8d, 0x77, 0x67, 0xaf, 0xfb, 0x90, 0x96, 0xae, 0xac, 0xc3, 0xb4, 0xb1, 0x67, 0xb4, 0xfb, 0xc8,
+	0x71, 0x4c, 0xcb, 0x18, 0x66, 0x13, 0xb5, 0xa4, 0x83, 0x73, 0xe3, 0xf6, 0x8d, 0x2d, 0x06, 0xd3,
+	0x2a, 0xc6, 0x9e, 0xc1, 0xff, 0x8f, 0xd5, 0x74, 0x0d, 0xa8, 0xa7, 0x29, 0x42, 0x68, 0xeb, 0xc7,
+	0x70, 0x4a, 0x64, 0x61, 0x2f, 0x42, 0x55, 0x51, 0x1e, 0xeb, 0x50, 0x4b, 0xa6, 0x1f, 0xe1, 0x90,
+	0xb5, 0x6b, 0x5f, 0x1e, 0x87, 0x09, 0xf4, 0x05, 0x87, 0xbf, 0x29, 0x40, 0xd9, 0xef, 0x84, 0x7b,
+	0xbb, 0xc8, 0x38, 0x20, 0x57, 0x72, 0x36, 0x33, 0x16, 0xc9, 0x32, 0xaf, 0x40, 0xc9, 0x43, 0x06,
+	0xa9, 0x16, 0xe3, 0x49, 0x52, 0xf0, 0x46, 0xc2, 0xb0, 0xbb, 0xc8, 0x20, 0x9a, 0x8f, 0x8e, 0x8a,
+	0x71, 0x12, 0x4e, 0x08, 0x1e, 0x05, 0xe7, 0x7f, 0x64, 0x1d, 0x4b, 0x96, 0x7e, 0x6f, 0xbb, 0xd8,
+	0x41, 0xa6, 0xbe, 0xdd, 0x43, 0xd6, 0x11, 0xde, 0x69, 0xac, 0xba, 0x62, 0x2f, 0x5f, 0x45, 0xff,
+	0xe5, 0x0b, 0xd8, 0x90, 0xff, 0xf0, 0x75, 0x0a, 0x26, 0xfa, 0xb6, 0xe5, 0xdd, 0x25, 0x3c, 0x92,
+	0xf3, 0xaf, 0xa8, 0x30, 0xdf, 0x80, 0xd7, 0x93, 0xd8, 0x16, 0x59, 0xdd, 0x02, 0x4c, 0x3a, 0x3d,
+	0x64, 0x0d, 0x3b, 0x7e, 0x25, 0x6d, 0x82, 0x7e, 0xd2, 0xa4, 0x8d, 0xf5, 0xd0, 0x76, 0x06, 0x9d,
+	0xbe, 0xe9, 0xd1, 0x0a, 0x4f, 0xc3, 0x5d, 0x6c, 0x3a, 0x47, 0x16, 0x18, 0xce, 0x02, 0xf8, 0x05,
+	0x68, 0x67, 0xdf, 0xc3, 0x84, 0xcb, 0x5b, 0xa6, 0x23, 0x1b, 0x74, 0x80, 0x4e, 0x13, 0x0f, 0xb9,
+	0x1e, 0x4b, 0xfc, 0xa9, 0xc8, 0x45, 0xad, 0xec, 0x8f, 0xd0, 0x6c, 0x5e, 0x39, 0x0d, 0x53, 0xd8,
+	0xd2, 0xd9, 0xe4, 0xb8, 0x3f, 0x39, 0x89, 0x2d, 0xdd, 0x9f, 0x9a, 0x87, 0x71, 0xcb, 0xb6, 0xba,
+	0xec, 0x59, 0xaf, 0xa4, 0xb1, 0x0f, 0xe5, 0x3c, 0xcc, 0xf9, 0x05, 0x7e, 0xf0, 0xb0, 0xe3, 0x57,
+	0x13, 0xd3, 0xda, 0xac, 0x3f, 0x2c, 0xde, 0x72, 0xc2, 0x4f, 0xd2, 0x53, 0xcf, 0xf0, 0x24, 0x4d,
+	0x79, 0x12, 0xb7, 0x6d, 0xd9, 0x97, 0x77, 0xb2, 0x9b, 0x7c, 0xd3, 0xb2, 0x3e, 0x5b, 0x4c, 0xd5,
+	0xe2, 0xf0, 0xb1, 0xc7, 0xed, 0xcd, 0x1e, 0x32, 0xfb, 0x74, 0xfa, 0x3a, 0xc6, 0x2f, 0xc8, 0xa3,
+	0x7f, 0x55, 0x80, 0x85, 0x08, 0x65, 0x71, 0x72, 0xe8, 0x4f, 0x00, 0xe8, 0xf8, 0xb0, 0x73, 0xc0,
+	0x0c, 0x57, 0xe0, 0x3f, 0x01, 0x60, 0x33, 0x9a, 0xb0, 0xdf, 0x2e, 0x4c, 0xa0, 0xbe, 0x3d, 0xb0,
+	0x3c, 0x9e, 0xcf, 0xbc, 0xc3, 0x75, 0x78, 0xce, 0x30, 0xbd, 0xbb, 0x83, 0x0e, 0xbd, 0xdc, 0xf8,
+	0xcf, 0x5c, 0xf8, 0x9f, 0xcb, 0x44, 0xbf, 0xc7, 0x7f, 0xf7, 0xd1, 0xf2, 0xb5, 0x0c, 0x5c, 0xb6,
+	0x96, 0xe5, 0x69, 0x7c, 0xaf, 0xb5, 0x7f, 0x9f, 0x86, 0xe2, 0x16, 0x31, 0x94, 0x8f, 0x61, 0x3a,
+	0xf4, 0x7b, 0x90, 0x37, 0x53, 0xfa, 0x7b, 0x32, 0x48, 0x5d, 0xce, 0x01, 0x12, 0xd2, 0x7e, 0x0c,
+	0xd3, 0xa1, 0x1f, 0x17, 0xa4, 0x51, 0x90, 0x41, 0xea, 0x72, 0x0e, 0x90, 0xa0, 0xd0, 0x83, 0xe3,
+	0xb1, 0xa6, 0xcf, 0xf9, 0x94, 0x0d, 0xa2, 0x40, 0xb5, 0x99, 0x13, 0x28, 0xcb, 0x13, 0x2a, 0x44,
+	0xd2, 0xe4, 0x91, 0x41, 0xea, 0x72, 0x0e, 0x90, 0xa0, 0x60, 0xc3, 0x89, 0xf8, 0x2f, 0x1f, 0x96,
+	0xd2, 0x34, 0x12, 0x45, 0xaa, 0xab, 0x79, 0x91, 0xb2, 0x48, 0xa1, 0xee, 0xcd, 0xe8, 0x43, 0xc0,
+	0x40, 0xea, 0x72, 0x0e, 0x90, 0xa0, 0xf0, 0x11, 0x80, 0xf4, 0x48, 0xfb, 0x46, 0xca, 0xd2, 0x00,
+	0xa2, 0x5e, 0xc8, 0x84, 0xc8, 0xe6, 0x8f, 0x3d, 0x03, 0xa7, 0x99, 0x3f, 0x0a, 0x54, 0x9b, 0x39,
+	0x81, 0xb2, 0x24, 0xd2, 0xb3, 0x6d, 0x9a, 0x24, 0x01, 0x44, 0xbd, 0x90, 0x09, 0x89, 0xbb, 0x4a,
+	0x86, 0x1d, 0x64, 0x90, 0xba, 0x9c, 0x03, 0x24, 0x28, 0xb8, 0xa0, 0x24, 0x74, 0xeb, 0x52, 0x59,
+	0x8c, 0x41, 0xd5, 0xb7, 0x72, 0x43, 0xe3, 0x0e, 0x93, 0x21, 0x95, 0x0c, 0x52, 0x97, 0x73, 0x80,
+	0x52, 0x1c, 0x86, 0x93, 0xc9, 0xe1, 0x30, 0x9c, 0xd6, 0x6a, 0x5e, 0x64, 0x3c, 0xe2, 0x48, 0x25,
+	0xfa, 0xe8, 0x88, 0x13, 0x00, 0xd5, 0x66, 0x4e, 0xa0, 0xa0, 0xf6, 0x7d, 0xa8, 0xc8, 0x8f, 0x9f,
+	0x8d, 0x91, 0x8e, 0xe7, 0x63, 0xd4, 0x8b, 0xd9, 0x18, 0x79, 0x7b, 0xf9, 0x01, 0xb2, 0x31, 0xf2,
+	0x3c, 0x8d, 0xde, 0x3e, 0xe1, 0x49, 0x91, 0x1a, 0x27, 0xfe, 0x9c, 0xb8, 0x34, 0x52, 0x07, 0x12,
+	0x52, 0x5d, 0xcd, 0x8b, 0x8c, 0x1b, 0x47, 0x7a, 0xb3, 0x39, 0x9f, 0xbd, 0x8b, 0x0f, 0x54, 0x9b,
+	0x39, 0x81, 0x72, 0x3c, 0x90, 0x5e, 0x4d, 0xd2, 0xe2, 0x41, 0x00, 0x51, 0x2f, 0x64, 0x42, 0x64,
+	0xcb, 0xc8, 0xbd, 0x90, 0xc6, 0x48, 0x9f, 0x18, 0x6d, 0x99, 0x84, 0x66, 0x04, 0x0b, 0x9c, 0x91,
+	0x07, 0xc8, 0xf4, 0xc0, 0x19, 0x06, 0xaa, 0xcd, 0x9c, 0x40, 0x41, 0xed, 0x7b, 0x50, 0x0e, 0xda,
+	0xec, 0xf5, 0x94, 0xd5, 0x02, 0xa1, 0x2e, 0x65, 0x21, 0xe2, 0x51, 0x93, 0xef, 0x3d, 0x3a, 0x6a,
+	0xf2, 0xed, 0x97, 0x73, 0x80, 0x64, 0x0a, 0xa1, 0x8e, 0xcd, 0x9b, 0x23, 0x0f, 0x09, 0x03, 0xa9,
+	0xcb, 0x39, 0x40, 0x82, 0x42, 0x17, 0x66, 0xc2, 0x75, 0xe7, 0xff, 0xa5, 0xda, 0x51, 0x42, 0xa9,
+	0x97, 0xf2, 0xa0, 0x04, 0x91, 0x1f, 0xc1, 0x6b, 0xc9, 0x1d, 0x8b, 0x4b, 0xa9, 0x57, 0x54, 0x02,
+	0x5a, 0xbd, 0xf2, 0x2c, 0x68, 0x41, 0x7c, 0x00, 0x27, 0x93, 0x3a, 0x00, 0x17, 0x47, 0xde, 0x27,
+	0x61, 0xc2, 0x6b, 0xf9, 0xb1, 0x32, 0xd9, 0xa4, 0xb2, 0xfe, 0xe2, 0xc8, 0x6b, 0x3f, 0x1f, 0xd9,
+	0x11, 0xe5, 0xba, 0xf2, 0x01, 0x4c, 0xf0, 0x52, 0xfd, 0x6c, 0x6a, 0x22, 0x43, 0xa7, 0xd5, 0xff,
+	0x1f, 0x39, 0x2d, 0x07, 0xd1, 0x78, 0x01, 0xbd, 0x34, 0x32, 0xc8, 0x4b, 0x48, 0x75, 0x35, 0x2f,
+	0x52, 0x26, 0x18, 0x2f, 0x60, 0xd3, 0x08, 0xc6, 0x90, 0xea, 0x6a, 0x5e, 0x64, 0x28, 0x07, 0x95,
+	0xcb, 0xb4, 0xd4, 0x1c, 0x54, 0x02, 0xa9, 0xcb, 0x39, 0x40, 0x43, 0x0a, 0x1b, 0xad, 0x87, 0x4f,
+	0x6a, 0x85, 0x47, 0x4f, 0x6a, 0x85, 0xaf, 0x9e, 0xd4, 0x0a, 0x9f, 0x3e, 0xad, 0x1d, 0x7b, 0xf4,
+	0xb4, 0x76, 0xec, 0xaf, 0x4f, 0x6b, 0xc7, 0x3e, 0x6a, 0x4a, 0xa5, 0x54, 0xc7, 0xea, 0x5c, 0xf6,
+	0x8b, 0xcd, 0xa6, 0xf4, 0x6b, 0xfa, 0x07, 0xe1, 0xdf, 0xd3, 0x77, 0x26, 0xfc, 0x07, 0xb3, 0xaf,
+	0xfd, 0x6f, 0x00, 0xf4, 0x1f, 0x6d, 0x0a, 0xb7, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3451,6 +3842,9 @@ type MsgClient interface {
 	RejectMigrateBucket(ctx context.Context, in *MsgRejectMigrateBucket, opts ...grpc.CallOption) (*MsgRejectMigrateBucketResponse, error)
 	// Since: Manchurian upgrade
 	SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.CallOption) (*MsgSetTagResponse, error)
+	CreatePrepaidPlan(ctx context.Context, in *MsgCreatePrepaidPlan, opts ...grpc.CallOption) (*MsgCreatePrepaidPlanResponse, error)
+	SubmitReadReceipt(ctx context.Context, in *MsgSubmitReadReceipt, opts ...grpc.CallOption) (*MsgSubmitReadReceiptResponse, error)
+	ClaimReadFee(ctx context.Context, in *MsgClaimReadFee, opts ...grpc.CallOption) (*MsgClaimReadFeeResponse, error)
 }
 
 type msgClient struct {
@@ -3722,6 +4116,33 @@ func (c *msgClient) SetTag(ctx context.Context, in *MsgSetTag, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) CreatePrepaidPlan(ctx context.Context, in *MsgCreatePrepaidPlan, opts ...grpc.CallOption) (*MsgCreatePrepaidPlanResponse, error) {
+	out := new(MsgCreatePrepaidPlanResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/CreatePrepaidPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitReadReceipt(ctx context.Context, in *MsgSubmitReadReceipt, opts ...grpc.CallOption) (*MsgSubmitReadReceiptResponse, error) {
+	out := new(MsgSubmitReadReceiptResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/SubmitReadReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimReadFee(ctx context.Context, in *MsgClaimReadFee, opts ...grpc.CallOption) (*MsgClaimReadFeeResponse, error) {
+	out := new(MsgClaimReadFeeResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.storage.Msg/ClaimReadFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// basic operation of bucket
@@ -3759,6 +4180,9 @@ type MsgServer interface {
 	RejectMigrateBucket(context.Context, *MsgRejectMigrateBucket) (*MsgRejectMigrateBucketResponse, error)
 	// Since: Manchurian upgrade
 	SetTag(context.Context, *MsgSetTag) (*MsgSetTagResponse, error)
+	CreatePrepaidPlan(context.Context, *MsgCreatePrepaidPlan) (*MsgCreatePrepaidPlanResponse, error)
+	SubmitReadReceipt(context.Context, *MsgSubmitReadReceipt) (*MsgSubmitReadReceiptResponse, error)
+	ClaimReadFee(context.Context, *MsgClaimReadFee) (*MsgClaimReadFeeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -3852,6 +4276,15 @@ func (*UnimplementedMsgServer) RejectMigrateBucket(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SetTag(ctx context.Context, req *MsgSetTag) (*MsgSetTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetTag not implemented")
 }
+func (*UnimplementedMsgServer) CreatePrepaidPlan(ctx context.Context, req *MsgCreatePrepaidPlan) (*MsgCreatePrepaidPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrepaidPlan not implemented")
+}
+func (*UnimplementedMsgServer) SubmitReadReceipt(ctx context.Context, req *MsgSubmitReadReceipt) (*MsgSubmitReadReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReadReceipt not implemented")
+}
+func (*UnimplementedMsgServer) ClaimReadFee(ctx context.Context, req *MsgClaimReadFee) (*MsgClaimReadFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReadFee not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -4379,26 +4812,80 @@ func _Msg_SetTag_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.storage.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBucket",
-			Handler:    _Msg_CreateBucket_Handler,
-		},
-		{
-			MethodName: "DeleteBucket",
-			Handler:    _Msg_DeleteBucket_Handler,
-		},
-		{
-			MethodName: "UpdateBucketInfo",
-			Handler:    _Msg_UpdateBucketInfo_Handler,
-		},
-		{
-			MethodName: "MirrorBucket",
-			Handler:    _Msg_MirrorBucket_Handler,
-		},
+func _Msg_CreatePrepaidPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePrepaidPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePrepaidPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/CreatePrepaidPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePrepaidPlan(ctx, req.(*MsgCreatePrepaidPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitReadReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitReadReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitReadReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/SubmitReadReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitReadReceipt(ctx, req.(*MsgSubmitReadReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimReadFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReadFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimReadFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.storage.Msg/ClaimReadFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimReadFee(ctx, req.(*MsgClaimReadFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.storage.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBucket",
+			Handler:    _Msg_CreateBucket_Handler,
+		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _Msg_DeleteBucket_Handler,
+		},
+		{
+			MethodName: "UpdateBucketInfo",
+			Handler:    _Msg_UpdateBucketInfo_Handler,
+		},
+		{
+			MethodName: "MirrorBucket",
+			Handler:    _Msg_MirrorBucket_Handler,
+		},
 		{
 			MethodName: "DiscontinueBucket",
 			Handler:    _Msg_DiscontinueBucket_Handler,
@@ -4499,6 +4986,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetTag",
 			Handler:    _Msg_SetTag_Handler,
 		},
+		{
+			MethodName: "CreatePrepaidPlan",
+			Handler:    _Msg_CreatePrepaidPlan_Handler,
+		},
+		{
+			MethodName: "SubmitReadReceipt",
+			Handler:    _Msg_SubmitReadReceipt_Handler,
+		},
+		{
+			MethodName: "ClaimReadFee",
+			Handler:    _Msg_ClaimReadFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/storage/tx.proto",
@@ -6797,159 +7296,413 @@ func (m *MsgSetTagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreatePrepaidPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreatePrepaidPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrepaidPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
-	}
-	l = len(m.PaymentAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Months != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Months))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.PrimarySpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ChargeSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChargeSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PrimarySpApproval != nil {
-		l = m.PrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChargedReadQuota != 0 {
-		n += 1 + sovTx(uint64(m.ChargedReadQuota))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePrepaidPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BucketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCreatePrepaidPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreatePrepaidPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.PlanId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDiscontinueBucket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Operator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSubmitReadReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDiscontinueBucketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSubmitReadReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitReadReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BucketName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.ObjectName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.BucketId.Size()
+		i -= size
+		if _, err := m.BucketId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.PayloadSize != 0 {
-		n += 1 + sovTx(uint64(m.PayloadSize))
+	i--
+	dAtA[i] = 0x42
+	if len(m.OwnerSignature) > 0 {
+		i -= len(m.OwnerSignature)
+		copy(dAtA[i:], m.OwnerSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerSignature)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Visibility != 0 {
-		n += 1 + sovTx(uint64(m.Visibility))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.PrimarySpApproval != nil {
-		l = m.PrimarySpApproval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.ExpectChecksums) > 0 {
-		for _, b := range m.ExpectChecksums {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.ReadBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RedundancyType != 0 {
-		n += 1 + sovTx(uint64(m.RedundancyType))
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitReadReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitReadReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitReadReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimReadFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReadFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReadFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BucketName) > 0 {
+		i -= len(m.BucketName)
+		copy(dAtA[i:], m.BucketName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BucketName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimReadFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReadFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReadFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ClaimedReadBytes != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimedReadBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.PaymentAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrimarySpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PrimarySpApproval != nil {
+		l = m.PrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChargedReadQuota != 0 {
+		n += 1 + sovTx(uint64(m.ChargedReadQuota))
+	}
+	return n
+}
+
+func (m *MsgCreateBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BucketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDiscontinueBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDiscontinueBucketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTx(uint64(m.PayloadSize))
+	}
+	if m.Visibility != 0 {
+		n += 1 + sovTx(uint64(m.Visibility))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PrimarySpApproval != nil {
+		l = m.PrimarySpApproval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExpectChecksums) > 0 {
+		for _, b := range m.ExpectChecksums {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RedundancyType != 0 {
+		n += 1 + sovTx(uint64(m.RedundancyType))
 	}
 	return n
 }
@@ -7804,8 +8557,122 @@ func (m *MsgSetTagResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCreatePrepaidPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChargeSize != 0 {
+		n += 1 + sovTx(uint64(m.ChargeSize))
+	}
+	if m.Months != 0 {
+		n += 1 + sovTx(uint64(m.Months))
+	}
+	return n
+}
+
+func (m *MsgCreatePrepaidPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovTx(uint64(m.PlanId))
+	}
+	return n
+}
+
+func (m *MsgSubmitReadReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovTx(uint64(m.ReadBytes))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.OwnerSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BucketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitReadReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimReadFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BucketName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimReadFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedReadBytes != 0 {
+		n += 1 + sovTx(uint64(m.ClaimedReadBytes))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -14536,6 +15403,784 @@ func (m *MsgSetTagResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreatePrepaidPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePrepaidPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePrepaidPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeSize", wireType)
+			}
+			m.ChargeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePrepaidPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePrepaidPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePrepaidPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitReadReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitReadReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitReadReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+			}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerSignature = append(m.OwnerSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerSignature == nil {
+				m.OwnerSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BucketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitReadReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitReadReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitReadReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimReadFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReadFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReadFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimReadFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReadFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReadFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedReadBytes", wireType)
+			}
+			m.ClaimedReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/bits"
 	"reflect"
 
 	sdkmath "cosmossdk.io/math"
@@ -16,8 +17,25 @@ const (
 	TagKeyTraits       = "traits"
 	TagValueOmit       = "omit"
 	MaxPaginationLimit = 200 // the default limit is 200 if pagination parameters is not provided
+	SecondsPerMonth    = 30 * 24 * 60 * 60
+
+	// MaxPrepaidPlanMonths is the max number of months a prepaid plan can last
+	MaxPrepaidPlanMonths = 36
+	// MaxExpiredPrepaidPlanCount is the max number of expired prepaid plans closed in one block
+	MaxExpiredPrepaidPlanCount = 100
+	// PrepaidPlanExpiryRetryInterval is the seconds after which an expired prepaid plan failed to be closed is retried
+	PrepaidPlanExpiryRetryInterval = 60 * 60
+
+	// SealedObjectSizeClasses is the number of size classes in the sampling index of sealed objects
+	SealedObjectSizeClasses = 65
 )
 
+// SealedObjectSizeClass returns the size class of an object in the sampling index of sealed objects,
+// the payload size of an object in size class c is in [2^(c-1), 2^c).
+func SealedObjectSizeClass(payloadSize uint64) uint32 {
+	return uint32(bits.Len64(payloadSize))
+}
+
 func (m *BucketInfo) ToNFTMetadata() *BucketMetaData {
 	return &BucketMetaData{
 		BucketName: m.BucketName,
@@ -137,3 +155,24 @@ func (b *InternalBucketInfo) DeleteLVG(lvgID uint32) {
 		}
 	}
 }
+
+// AddChargeSize adds the charge size of an object of the redundancy type to the lvg
+func (lvg *LocalVirtualGroup) AddChargeSize(chargeSize uint64, redundancyType RedundancyType) {
+	lvg.TotalChargeSize += chargeSize
+	if redundancyType == REDUNDANCY_REPLICA_TYPE {
+		lvg.ReplicaChargeSize += chargeSize
+	}
+}
+
+// SubChargeSize subtracts the charge size of an object of the redundancy type from the lvg
+func (lvg *LocalVirtualGroup) SubChargeSize(chargeSize uint64, redundancyType RedundancyType) {
+	lvg.TotalChargeSize -= chargeSize
+	if redundancyType == REDUNDANCY_REPLICA_TYPE {
+		lvg.ReplicaChargeSize -= chargeSize
+	}
+}
+
+// GetECChargeSize returns the charge size of the objects of REDUNDANCY_EC_TYPE in the lvg
+func (lvg *LocalVirtualGroup) GetECChargeSize() uint64 {
+	return lvg.TotalChargeSize - lvg.ReplicaChargeSize
+}
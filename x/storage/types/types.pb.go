@@ -165,6 +165,8 @@ type InternalBucketInfo struct {
 	LocalVirtualGroups []*LocalVirtualGroup `protobuf:"bytes,3,rep,name=local_virtual_groups,json=localVirtualGroups,proto3" json:"local_virtual_groups,omitempty"`
 	// next_local_virtual_group_id store the next id used by local virtual group
 	NextLocalVirtualGroupId uint32 `protobuf:"varint,4,opt,name=next_local_virtual_group_id,json=nextLocalVirtualGroupId,proto3" json:"next_local_virtual_group_id,omitempty"`
+	// prepaid_plan_id is the id of the prepaid plan which pays for the store fee of the bucket, zero means none
+	PrepaidPlanId uint64 `protobuf:"varint,5,opt,name=prepaid_plan_id,json=prepaidPlanId,proto3" json:"prepaid_plan_id,omitempty"`
 }
 
 func (m *InternalBucketInfo) Reset()         { *m = InternalBucketInfo{} }
@@ -228,6 +230,224 @@ func (m *InternalBucketInfo) GetNextLocalVirtualGroupId() uint32 {
 	return 0
 }
 
+func (m *InternalBucketInfo) GetPrepaidPlanId() uint64 {
+	if m != nil {
+		return m.PrepaidPlanId
+	}
+	return 0
+}
+
+// BucketReadRecord tracks the read receipts of a bucket, which are the served bytes acknowledged by the bucket owner,
+// and the read fee claimed against them by the sps which served the bytes.
+type BucketReadRecord struct {
+	// quota_period_start is the start time of the current read quota period, the periods are aligned to the quota update time
+	QuotaPeriodStart int64 `protobuf:"varint,1,opt,name=quota_period_start,json=quotaPeriodStart,proto3" json:"quota_period_start,omitempty"`
+	// period_read_bytes is the receipted read bytes in the current read quota period
+	PeriodReadBytes uint64 `protobuf:"varint,2,opt,name=period_read_bytes,json=periodReadBytes,proto3" json:"period_read_bytes,omitempty"`
+	// sp_claims are the receipted read bytes beyond the charged read quota which are not claimed yet, by the serving sp
+	SpClaims []SpReadClaim `protobuf:"bytes,3,rep,name=sp_claims,json=spClaims,proto3" json:"sp_claims"`
+	// total_read_bytes is the total receipted read bytes of the bucket
+	TotalReadBytes uint64 `protobuf:"varint,4,opt,name=total_read_bytes,json=totalReadBytes,proto3" json:"total_read_bytes,omitempty"`
+	// total_claimed_read_bytes is the total read bytes claimed by the sps
+	TotalClaimedReadBytes uint64 `protobuf:"varint,5,opt,name=total_claimed_read_bytes,json=totalClaimedReadBytes,proto3" json:"total_claimed_read_bytes,omitempty"`
+	// sp_sequences are the sequences of the read receipts submitted by each sp
+	SpSequences []SpReadSequence `protobuf:"bytes,6,rep,name=sp_sequences,json=spSequences,proto3" json:"sp_sequences"`
+}
+
+func (m *BucketReadRecord) Reset()         { *m = BucketReadRecord{} }
+func (m *BucketReadRecord) String() string { return proto.CompactTextString(m) }
+func (*BucketReadRecord) ProtoMessage()    {}
+func (*BucketReadRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{2}
+}
+func (m *BucketReadRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketReadRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketReadRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BucketReadRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketReadRecord.Merge(m, src)
+}
+func (m *BucketReadRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketReadRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketReadRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketReadRecord proto.InternalMessageInfo
+
+func (m *BucketReadRecord) GetQuotaPeriodStart() int64 {
+	if m != nil {
+		return m.QuotaPeriodStart
+	}
+	return 0
+}
+
+func (m *BucketReadRecord) GetPeriodReadBytes() uint64 {
+	if m != nil {
+		return m.PeriodReadBytes
+	}
+	return 0
+}
+
+func (m *BucketReadRecord) GetSpClaims() []SpReadClaim {
+	if m != nil {
+		return m.SpClaims
+	}
+	return nil
+}
+
+func (m *BucketReadRecord) GetTotalReadBytes() uint64 {
+	if m != nil {
+		return m.TotalReadBytes
+	}
+	return 0
+}
+
+func (m *BucketReadRecord) GetTotalClaimedReadBytes() uint64 {
+	if m != nil {
+		return m.TotalClaimedReadBytes
+	}
+	return 0
+}
+
+func (m *BucketReadRecord) GetSpSequences() []SpReadSequence {
+	if m != nil {
+		return m.SpSequences
+	}
+	return nil
+}
+
+// SpReadSequence is the sequence of the read receipts submitted by a sp in a bucket.
+type SpReadSequence struct {
+	// sp_id is the id of the sp which submitted the read receipts
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// nonce is the nonce of the last read receipt of the sp, which prevents the receipts from being replayed
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// last_receipt_end is the end time of the last read receipt of the sp, the receipt periods of a sp cannot overlap
+	LastReceiptEnd int64 `protobuf:"varint,3,opt,name=last_receipt_end,json=lastReceiptEnd,proto3" json:"last_receipt_end,omitempty"`
+}
+
+func (m *SpReadSequence) Reset()         { *m = SpReadSequence{} }
+func (m *SpReadSequence) String() string { return proto.CompactTextString(m) }
+func (*SpReadSequence) ProtoMessage()    {}
+func (*SpReadSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{3}
+}
+func (m *SpReadSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpReadSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpReadSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpReadSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpReadSequence.Merge(m, src)
+}
+func (m *SpReadSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpReadSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpReadSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpReadSequence proto.InternalMessageInfo
+
+func (m *SpReadSequence) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpReadSequence) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SpReadSequence) GetLastReceiptEnd() int64 {
+	if m != nil {
+		return m.LastReceiptEnd
+	}
+	return 0
+}
+
+// SpReadClaim is the claimable read bytes of a sp in a bucket.
+type SpReadClaim struct {
+	// sp_id is the id of the sp which served the read bytes
+	SpId uint32 `protobuf:"varint,1,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	// claimable_read_bytes is the receipted read bytes beyond the charged read quota, which are not claimed yet
+	ClaimableReadBytes uint64 `protobuf:"varint,2,opt,name=claimable_read_bytes,json=claimableReadBytes,proto3" json:"claimable_read_bytes,omitempty"`
+}
+
+func (m *SpReadClaim) Reset()         { *m = SpReadClaim{} }
+func (m *SpReadClaim) String() string { return proto.CompactTextString(m) }
+func (*SpReadClaim) ProtoMessage()    {}
+func (*SpReadClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf95fa2efdc74d97, []int{4}
+}
+func (m *SpReadClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpReadClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpReadClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpReadClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpReadClaim.Merge(m, src)
+}
+func (m *SpReadClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpReadClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpReadClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpReadClaim proto.InternalMessageInfo
+
+func (m *SpReadClaim) GetSpId() uint32 {
+	if m != nil {
+		return m.SpId
+	}
+	return 0
+}
+
+func (m *SpReadClaim) GetClaimableReadBytes() uint64 {
+	if m != nil {
+		return m.ClaimableReadBytes
+	}
+	return 0
+}
+
 type ObjectInfo struct {
 	// owner is the object owner
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -265,7 +485,7 @@ func (m *ObjectInfo) Reset()         { *m = ObjectInfo{} }
 func (m *ObjectInfo) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfo) ProtoMessage()    {}
 func (*ObjectInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{2}
+	return fileDescriptor_bf95fa2efdc74d97, []int{5}
 }
 func (m *ObjectInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +631,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{3}
+	return fileDescriptor_bf95fa2efdc74d97, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +704,7 @@ func (m *Trait) Reset()         { *m = Trait{} }
 func (m *Trait) String() string { return proto.CompactTextString(m) }
 func (*Trait) ProtoMessage()    {}
 func (*Trait) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{4}
+	return fileDescriptor_bf95fa2efdc74d97, []int{7}
 }
 func (m *Trait) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +764,7 @@ func (m *BucketMetaData) Reset()         { *m = BucketMetaData{} }
 func (m *BucketMetaData) String() string { return proto.CompactTextString(m) }
 func (*BucketMetaData) ProtoMessage()    {}
 func (*BucketMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{5}
+	return fileDescriptor_bf95fa2efdc74d97, []int{8}
 }
 func (m *BucketMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +845,7 @@ func (m *ObjectMetaData) Reset()         { *m = ObjectMetaData{} }
 func (m *ObjectMetaData) String() string { return proto.CompactTextString(m) }
 func (*ObjectMetaData) ProtoMessage()    {}
 func (*ObjectMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{6}
+	return fileDescriptor_bf95fa2efdc74d97, []int{9}
 }
 func (m *ObjectMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -706,7 +926,7 @@ func (m *GroupMetaData) Reset()         { *m = GroupMetaData{} }
 func (m *GroupMetaData) String() string { return proto.CompactTextString(m) }
 func (*GroupMetaData) ProtoMessage()    {}
 func (*GroupMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{7}
+	return fileDescriptor_bf95fa2efdc74d97, []int{10}
 }
 func (m *GroupMetaData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +999,7 @@ func (m *Ids) Reset()         { *m = Ids{} }
 func (m *Ids) String() string { return proto.CompactTextString(m) }
 func (*Ids) ProtoMessage()    {}
 func (*Ids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{8}
+	return fileDescriptor_bf95fa2efdc74d97, []int{11}
 }
 func (m *Ids) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +1038,7 @@ func (m *DeleteInfo) Reset()         { *m = DeleteInfo{} }
 func (m *DeleteInfo) String() string { return proto.CompactTextString(m) }
 func (*DeleteInfo) ProtoMessage()    {}
 func (*DeleteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{9}
+	return fileDescriptor_bf95fa2efdc74d97, []int{12}
 }
 func (m *DeleteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1100,7 @@ func (m *MigrationBucketInfo) Reset()         { *m = MigrationBucketInfo{} }
 func (m *MigrationBucketInfo) String() string { return proto.CompactTextString(m) }
 func (*MigrationBucketInfo) ProtoMessage()    {}
 func (*MigrationBucketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{10}
+	return fileDescriptor_bf95fa2efdc74d97, []int{13}
 }
 func (m *MigrationBucketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,7 +1159,7 @@ func (m *ResourceTags) Reset()         { *m = ResourceTags{} }
 func (m *ResourceTags) String() string { return proto.CompactTextString(m) }
 func (*ResourceTags) ProtoMessage()    {}
 func (*ResourceTags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{11}
+	return fileDescriptor_bf95fa2efdc74d97, []int{14}
 }
 func (m *ResourceTags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -984,7 +1204,7 @@ func (m *ResourceTags_Tag) Reset()         { *m = ResourceTags_Tag{} }
 func (m *ResourceTags_Tag) String() string { return proto.CompactTextString(m) }
 func (*ResourceTags_Tag) ProtoMessage()    {}
 func (*ResourceTags_Tag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bf95fa2efdc74d97, []int{11, 0}
+	return fileDescriptor_bf95fa2efdc74d97, []int{14, 0}
 }
 func (m *ResourceTags_Tag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,6 +1250,9 @@ func (m *ResourceTags_Tag) GetValue() string {
 func init() {
 	proto.RegisterType((*BucketInfo)(nil), "greenfield.storage.BucketInfo")
 	proto.RegisterType((*InternalBucketInfo)(nil), "greenfield.storage.InternalBucketInfo")
+	proto.RegisterType((*BucketReadRecord)(nil), "greenfield.storage.BucketReadRecord")
+	proto.RegisterType((*SpReadSequence)(nil), "greenfield.storage.SpReadSequence")
+	proto.RegisterType((*SpReadClaim)(nil), "greenfield.storage.SpReadClaim")
 	proto.RegisterType((*ObjectInfo)(nil), "greenfield.storage.ObjectInfo")
 	proto.RegisterType((*GroupInfo)(nil), "greenfield.storage.GroupInfo")
 	proto.RegisterType((*Trait)(nil), "greenfield.storage.Trait")
@@ -1046,85 +1269,100 @@ func init() {
 func init() { proto.RegisterFile("greenfield/storage/types.proto", fileDescriptor_bf95fa2efdc74d97) }
 
 var fileDescriptor_bf95fa2efdc74d97 = []byte{
-	// 1240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x51, 0x6f, 0xd4, 0x46,
-	0x10, 0x8e, 0xe3, 0xbb, 0x24, 0x1e, 0xe7, 0x12, 0x58, 0xa2, 0x62, 0x82, 0xb8, 0x1c, 0xa7, 0xb6,
-	0x3a, 0xb5, 0xcd, 0x9d, 0x08, 0x08, 0x55, 0x15, 0x2a, 0xe2, 0x0a, 0x45, 0xa7, 0x96, 0x56, 0x75,
-	0x02, 0x95, 0xfa, 0x62, 0xed, 0xd9, 0x1b, 0x67, 0x8b, 0xed, 0xbd, 0xee, 0xae, 0x43, 0x0e, 0xa9,
-	0xff, 0xa1, 0x0f, 0xfd, 0x25, 0x15, 0x3f, 0x02, 0x55, 0xaa, 0x84, 0x78, 0xaa, 0xfa, 0x10, 0x55,
-	0xf0, 0x0f, 0xfa, 0xd0, 0xbe, 0x56, 0xde, 0xdd, 0x0b, 0xce, 0xdd, 0x85, 0x90, 0x08, 0xde, 0xbc,
-	0xb3, 0xdf, 0x78, 0x67, 0xbe, 0x99, 0x6f, 0xd6, 0x86, 0x7a, 0xcc, 0x09, 0xc9, 0xb6, 0x29, 0x49,
-	0xa2, 0x8e, 0x90, 0x8c, 0xe3, 0x98, 0x74, 0xe4, 0x70, 0x40, 0x44, 0x7b, 0xc0, 0x99, 0x64, 0x08,
-	0xbd, 0xda, 0x6f, 0x9b, 0xfd, 0xd5, 0x7a, 0xc8, 0x44, 0xca, 0x44, 0xa7, 0x8f, 0x05, 0xe9, 0xec,
-	0x5e, 0xe9, 0x13, 0x89, 0xaf, 0x74, 0x42, 0x46, 0x33, 0xed, 0xb3, 0x7a, 0x41, 0xef, 0x07, 0x6a,
-	0xd5, 0xd1, 0x0b, 0xb3, 0xb5, 0x12, 0xb3, 0x98, 0x69, 0x7b, 0xf1, 0x64, 0xac, 0x97, 0x4b, 0x41,
-	0x0c, 0xf0, 0x30, 0x25, 0x99, 0xec, 0xb0, 0x5c, 0x06, 0xdb, 0x09, 0x7b, 0x64, 0x20, 0x1f, 0x4e,
-	0x81, 0x08, 0xc9, 0x09, 0x4e, 0x03, 0x4e, 0x42, 0xc6, 0x23, 0x83, 0x5b, 0x9b, 0x92, 0x4f, 0xc8,
-	0xd2, 0x94, 0x99, 0xe0, 0x9a, 0xfb, 0x15, 0x80, 0x6e, 0x1e, 0x3e, 0x24, 0xb2, 0x97, 0x6d, 0x33,
-	0xd4, 0x86, 0x2a, 0x7b, 0x94, 0x11, 0xee, 0x59, 0x0d, 0xab, 0xe5, 0x74, 0xbd, 0xe7, 0x4f, 0xd6,
-	0x57, 0x4c, 0xc4, 0xb7, 0xa2, 0x88, 0x13, 0x21, 0x36, 0x25, 0xa7, 0x59, 0xec, 0x6b, 0x18, 0x5a,
-	0x03, 0xb7, 0xaf, 0xbc, 0x83, 0x0c, 0xa7, 0xc4, 0x9b, 0x2d, 0xbc, 0x7c, 0xd0, 0xa6, 0x6f, 0x70,
-	0x4a, 0x50, 0x17, 0x60, 0x97, 0x0a, 0xda, 0xa7, 0x09, 0x95, 0x43, 0xcf, 0x6e, 0x58, 0xad, 0xa5,
-	0x8d, 0x66, 0x7b, 0x92, 0xc5, 0xf6, 0x83, 0x03, 0xd4, 0xd6, 0x70, 0x40, 0xfc, 0x92, 0x17, 0xfa,
-	0x18, 0x66, 0x69, 0xe4, 0x55, 0x54, 0x44, 0x17, 0x9f, 0xee, 0xaf, 0xcd, 0xfc, 0xb5, 0xbf, 0x56,
-	0xb9, 0x4f, 0x33, 0xf9, 0xfc, 0xc9, 0xba, 0x6b, 0xa2, 0x2b, 0x96, 0xfe, 0x2c, 0x8d, 0xd0, 0x4d,
-	0x70, 0x05, 0xcb, 0x79, 0x48, 0x82, 0xa2, 0x6e, 0x5e, 0x55, 0x9d, 0x58, 0x9f, 0x76, 0xe2, 0xa6,
-	0x82, 0xe9, 0xd3, 0xc4, 0xc1, 0x33, 0xba, 0x08, 0x4e, 0xc8, 0x09, 0x96, 0x24, 0xc0, 0xd2, 0x9b,
-	0x6b, 0x58, 0x2d, 0xdb, 0x5f, 0xd0, 0x86, 0x5b, 0x12, 0xdd, 0x82, 0x65, 0x43, 0x77, 0x80, 0x35,
-	0x1f, 0xde, 0xfc, 0x31, 0x4c, 0x2d, 0x19, 0x07, 0x63, 0x45, 0x5d, 0xa8, 0xc7, 0x09, 0xeb, 0xe3,
-	0x24, 0xd8, 0xa5, 0x5c, 0xe6, 0x38, 0x09, 0x62, 0xce, 0xf2, 0x41, 0xb0, 0x8d, 0x53, 0x9a, 0x0c,
-	0x03, 0x1a, 0x79, 0x0b, 0x0d, 0xab, 0x55, 0xf3, 0x57, 0x35, 0xea, 0x81, 0x06, 0xdd, 0x2d, 0x30,
-	0x5f, 0x2a, 0x48, 0x2f, 0x42, 0x9f, 0x00, 0x0a, 0x77, 0x30, 0x8f, 0x49, 0x14, 0x70, 0x82, 0xa3,
-	0xe0, 0xa7, 0x9c, 0x49, 0xec, 0x39, 0x0d, 0xab, 0x55, 0xf1, 0xcf, 0x98, 0x1d, 0x9f, 0xe0, 0xe8,
-	0xbb, 0xc2, 0x8e, 0xee, 0x40, 0xcd, 0x14, 0x49, 0x48, 0x2c, 0x73, 0xe1, 0x81, 0x22, 0xa5, 0x31,
-	0x8d, 0x14, 0xdd, 0x0b, 0x9b, 0x0a, 0xe7, 0x2f, 0xf6, 0x4b, 0x2b, 0x74, 0x0d, 0x2a, 0x12, 0xc7,
-	0xc2, 0x73, 0x1b, 0x56, 0xcb, 0x9d, 0xee, 0xed, 0x13, 0x43, 0x24, 0x8e, 0x85, 0xaf, 0xd0, 0xcd,
-	0x7f, 0x2d, 0x40, 0xbd, 0x4c, 0x12, 0x9e, 0xe1, 0xa4, 0xd4, 0x68, 0x97, 0x00, 0x06, 0x9c, 0x16,
-	0x55, 0xa2, 0x29, 0x51, 0xdd, 0x66, 0xfb, 0x8e, 0xb2, 0x6c, 0xd1, 0x94, 0xa0, 0x8f, 0xe0, 0xac,
-	0x64, 0x12, 0x27, 0x81, 0x4e, 0x26, 0x10, 0xf4, 0xb1, 0xee, 0xae, 0x8a, 0xbf, 0xac, 0x36, 0xbe,
-	0x50, 0xf6, 0x4d, 0xfa, 0x98, 0xa0, 0xef, 0x61, 0x25, 0x61, 0xe1, 0x38, 0x9f, 0xc2, 0xb3, 0x1b,
-	0x76, 0xcb, 0xdd, 0xf8, 0x60, 0x5a, 0x9c, 0x5f, 0x17, 0xf8, 0x32, 0xb3, 0x3e, 0x4a, 0xc6, 0x4d,
-	0x02, 0xdd, 0x80, 0x8b, 0x19, 0xd9, 0x93, 0xc1, 0x94, 0xb7, 0x07, 0xa6, 0x21, 0x6b, 0xfe, 0xf9,
-	0x02, 0x32, 0xf1, 0xbe, 0x5e, 0xd4, 0xfc, 0xaf, 0x0a, 0xf0, 0x6d, 0xff, 0x47, 0x12, 0x9e, 0x4e,
-	0x59, 0x1b, 0x30, 0xaf, 0xba, 0x8e, 0x71, 0xad, 0xaa, 0xd7, 0x78, 0x8c, 0x80, 0xe3, 0x6a, 0xb4,
-	0x27, 0xd4, 0xb8, 0x06, 0x2e, 0x53, 0x21, 0x69, 0x40, 0x45, 0x03, 0xb4, 0x49, 0x01, 0xb4, 0xd4,
-	0xaa, 0x6f, 0x26, 0xb5, 0xab, 0xf0, 0xde, 0x11, 0xd4, 0xcc, 0x29, 0x6a, 0xce, 0x25, 0x93, 0xb4,
-	0xa0, 0xcb, 0xb0, 0x38, 0xc0, 0xc3, 0x84, 0xe1, 0x48, 0x17, 0x75, 0x5e, 0x15, 0xd5, 0x35, 0x36,
-	0x55, 0xd0, 0xc3, 0x33, 0x63, 0xe1, 0x54, 0x33, 0xe3, 0x32, 0x2c, 0x86, 0x2c, 0x93, 0x85, 0x50,
-	0xd5, 0x1c, 0x70, 0x54, 0xaa, 0xae, 0xb1, 0x4d, 0x0a, 0x1d, 0xc6, 0x84, 0x7e, 0x07, 0x6a, 0x86,
-	0x29, 0xa3, 0x19, 0xf7, 0x68, 0xcd, 0xe8, 0x2a, 0x8f, 0x34, 0xc3, 0x4a, 0x2b, 0xf4, 0x15, 0x2c,
-	0x73, 0x12, 0xe5, 0x59, 0x84, 0xb3, 0x70, 0xa8, 0x23, 0x59, 0x3c, 0x3a, 0x1f, 0xff, 0x00, 0xaa,
-	0xf2, 0x59, 0xe2, 0x87, 0xd6, 0xe3, 0xa3, 0xad, 0x76, 0xe2, 0xd1, 0xd6, 0x01, 0x27, 0xdc, 0x21,
-	0xe1, 0x43, 0x91, 0xa7, 0xc2, 0x5b, 0x6a, 0xd8, 0xad, 0xc5, 0xee, 0xd9, 0x7f, 0xf6, 0xd7, 0x6a,
-	0x92, 0x63, 0x2a, 0xc5, 0x67, 0x4d, 0x96, 0x52, 0xd9, 0xf4, 0x5f, 0x61, 0x0e, 0x24, 0xbf, 0x7c,
-	0x22, 0xc9, 0xff, 0x3a, 0x0b, 0x8e, 0x2e, 0xf7, 0x69, 0x1a, 0xff, 0x12, 0x80, 0xee, 0xa3, 0xd2,
-	0x8d, 0xe2, 0x28, 0x8b, 0xea, 0xd0, 0x31, 0x12, 0xec, 0x13, 0x93, 0x70, 0xa2, 0xdb, 0x64, 0x05,
-	0xaa, 0x64, 0x4f, 0x72, 0xac, 0x25, 0xe1, 0xeb, 0xc5, 0x01, 0x2d, 0x73, 0x27, 0xa2, 0xe5, 0x06,
-	0x54, 0xb7, 0x0a, 0xa2, 0x8b, 0x0c, 0x15, 0xe3, 0x3a, 0x03, 0x4b, 0x67, 0xa8, 0x2c, 0x2a, 0xc0,
-	0x15, 0xa8, 0xee, 0xe2, 0x24, 0x1f, 0xe5, 0xae, 0x17, 0xcd, 0x3f, 0x2c, 0x58, 0xd2, 0xf3, 0xf3,
-	0x1e, 0x91, 0xf8, 0x36, 0x96, 0x18, 0x35, 0xc0, 0x8d, 0x88, 0x08, 0x39, 0x1d, 0x48, 0xca, 0x32,
-	0xf3, 0xa2, 0xb2, 0xa9, 0x50, 0x01, 0xd9, 0xd3, 0xb3, 0x37, 0xc8, 0x79, 0x62, 0xde, 0xe8, 0x8e,
-	0x6c, 0xf7, 0x79, 0x72, 0xfc, 0xcc, 0x58, 0x81, 0x2a, 0x4d, 0x71, 0x3c, 0x9a, 0x16, 0x7a, 0x81,
-	0x6e, 0x02, 0x60, 0x29, 0x39, 0xed, 0xe7, 0x92, 0x08, 0xaf, 0xaa, 0x46, 0xed, 0x85, 0x69, 0x44,
-	0xa8, 0x94, 0xbb, 0x95, 0x82, 0x68, 0xbf, 0xe4, 0xa2, 0xf2, 0xd1, 0xc2, 0x79, 0xeb, 0xf9, 0x94,
-	0x47, 0x9c, 0x3d, 0x31, 0xe2, 0xde, 0x51, 0x3e, 0xbf, 0x5b, 0x50, 0x53, 0x4d, 0xff, 0x76, 0xd3,
-	0x39, 0xac, 0x06, 0x7b, 0x5c, 0x0d, 0xef, 0x28, 0x99, 0x0d, 0xb0, 0x7b, 0x91, 0x30, 0x52, 0xb1,
-	0x1a, 0xf6, 0x1b, 0x48, 0xa5, 0xf9, 0x9b, 0x05, 0x70, 0x9b, 0x24, 0x44, 0x12, 0x25, 0xfb, 0xeb,
-	0x60, 0x9a, 0x28, 0xa0, 0x91, 0x50, 0xc9, 0xbb, 0x1b, 0xe7, 0xa7, 0xc5, 0xd0, 0x8b, 0x84, 0xef,
-	0x68, 0x68, 0x71, 0xe6, 0x75, 0x30, 0xc5, 0x52, 0x7e, 0xb3, 0xc7, 0xf8, 0x69, 0x68, 0xe1, 0x77,
-	0x0d, 0x9c, 0xd1, 0xf5, 0x23, 0x14, 0x4f, 0xaf, 0x71, 0x5b, 0x88, 0xf5, 0x65, 0x24, 0x9a, 0xcf,
-	0x2d, 0x38, 0x77, 0x8f, 0xc6, 0x1c, 0x17, 0xf5, 0x28, 0x7d, 0x9e, 0xac, 0x82, 0x23, 0x78, 0x18,
-	0x08, 0x75, 0x9b, 0x59, 0xea, 0x36, 0x9b, 0x17, 0x3c, 0xdc, 0x2c, 0x6e, 0xb0, 0x1e, 0x34, 0x8b,
-	0xbd, 0x63, 0x3e, 0xe2, 0x66, 0x95, 0xd3, 0x25, 0xc1, 0xc3, 0xbb, 0x47, 0x7f, 0xc7, 0xad, 0x82,
-	0x13, 0x09, 0x69, 0x8e, 0xb1, 0xf5, 0x31, 0x91, 0x90, 0xea, 0x98, 0x4f, 0xc1, 0x39, 0x20, 0xf0,
-	0x4d, 0xc6, 0xd5, 0xc2, 0x88, 0xc3, 0xe6, 0xcf, 0xb0, 0x58, 0x1e, 0x3f, 0xe8, 0x73, 0x33, 0xae,
-	0x2c, 0xd5, 0x08, 0xef, 0x1f, 0x37, 0xae, 0xda, 0x5b, 0x38, 0x36, 0x3d, 0xa1, 0xfc, 0x56, 0xd7,
-	0xc1, 0xde, 0xc2, 0x31, 0x3a, 0x03, 0xf6, 0x43, 0x32, 0x34, 0x7d, 0x5c, 0x3c, 0x4e, 0x9f, 0x54,
-	0xdd, 0xde, 0xd3, 0x17, 0x75, 0xeb, 0xd9, 0x8b, 0xba, 0xf5, 0xf7, 0x8b, 0xba, 0xf5, 0xcb, 0xcb,
-	0xfa, 0xcc, 0xb3, 0x97, 0xf5, 0x99, 0x3f, 0x5f, 0xd6, 0x67, 0x7e, 0xe8, 0xc4, 0x54, 0xee, 0xe4,
-	0xfd, 0x76, 0xc8, 0xd2, 0x4e, 0x3f, 0xeb, 0xaf, 0x87, 0x3b, 0x98, 0x66, 0x9d, 0xd2, 0x2f, 0xca,
-	0xde, 0xe1, 0x9f, 0xae, 0xfe, 0x9c, 0xfa, 0x49, 0xb9, 0xfa, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x29, 0xb8, 0xed, 0x11, 0x97, 0x0d, 0x00, 0x00,
+	// 1474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0x49,
+	0x16, 0x4f, 0xbb, 0xed, 0x24, 0x7e, 0x8e, 0x9d, 0xd0, 0x78, 0x97, 0x26, 0x08, 0xc7, 0xb4, 0x76,
+	0x91, 0xb5, 0xbb, 0x89, 0x97, 0x80, 0xd8, 0xd5, 0x0a, 0x2d, 0xc2, 0xc0, 0x22, 0x8b, 0x65, 0x86,
+	0xe9, 0x04, 0x46, 0x9a, 0x4b, 0xab, 0xdc, 0x5d, 0xe9, 0xd4, 0xd0, 0xdd, 0xd5, 0x54, 0x95, 0x43,
+	0x8c, 0x34, 0xdf, 0x61, 0x0e, 0x73, 0x9e, 0x0f, 0x31, 0xe2, 0x43, 0xa0, 0x91, 0x46, 0x42, 0x9c,
+	0x46, 0x73, 0x88, 0x10, 0x7c, 0x83, 0xb9, 0xcc, 0x75, 0x54, 0x7f, 0xec, 0x74, 0x12, 0x67, 0x42,
+	0x10, 0xdc, 0xba, 0x5e, 0xfd, 0x5e, 0xd7, 0x7b, 0xbf, 0xf7, 0xea, 0xf7, 0xba, 0xa1, 0x15, 0x33,
+	0x8c, 0xb3, 0x2d, 0x82, 0x93, 0xa8, 0xcb, 0x05, 0x65, 0x28, 0xc6, 0x5d, 0x31, 0xca, 0x31, 0x5f,
+	0xcb, 0x19, 0x15, 0xd4, 0x71, 0xf6, 0xf7, 0xd7, 0xcc, 0xfe, 0x72, 0x2b, 0xa4, 0x3c, 0xa5, 0xbc,
+	0x3b, 0x40, 0x1c, 0x77, 0x77, 0xae, 0x0c, 0xb0, 0x40, 0x57, 0xba, 0x21, 0x25, 0x99, 0xf6, 0x59,
+	0x3e, 0xaf, 0xf7, 0x03, 0xb5, 0xea, 0xea, 0x85, 0xd9, 0x6a, 0xc6, 0x34, 0xa6, 0xda, 0x2e, 0x9f,
+	0x8c, 0xf5, 0x52, 0x21, 0x88, 0x1c, 0x8d, 0x52, 0x9c, 0x89, 0x2e, 0x1d, 0x8a, 0x60, 0x2b, 0xa1,
+	0xcf, 0x0c, 0xe4, 0xf2, 0x14, 0x08, 0x17, 0x0c, 0xa3, 0x34, 0x60, 0x38, 0xa4, 0x2c, 0x32, 0xb8,
+	0x95, 0x29, 0xf9, 0x84, 0x34, 0x4d, 0xa9, 0x09, 0xce, 0xdb, 0x2b, 0x03, 0xf4, 0x86, 0xe1, 0x13,
+	0x2c, 0xfa, 0xd9, 0x16, 0x75, 0xd6, 0xa0, 0x42, 0x9f, 0x65, 0x98, 0xb9, 0x56, 0xdb, 0xea, 0x54,
+	0x7b, 0xee, 0xeb, 0x17, 0xab, 0x4d, 0x13, 0xf1, 0xad, 0x28, 0x62, 0x98, 0xf3, 0x0d, 0xc1, 0x48,
+	0x16, 0xfb, 0x1a, 0xe6, 0xac, 0x40, 0x6d, 0xa0, 0xbc, 0x83, 0x0c, 0xa5, 0xd8, 0x2d, 0x49, 0x2f,
+	0x1f, 0xb4, 0xe9, 0x33, 0x94, 0x62, 0xa7, 0x07, 0xb0, 0x43, 0x38, 0x19, 0x90, 0x84, 0x88, 0x91,
+	0x6b, 0xb7, 0xad, 0x4e, 0x63, 0xdd, 0x5b, 0x3b, 0xca, 0xe2, 0xda, 0xe3, 0x09, 0x6a, 0x73, 0x94,
+	0x63, 0xbf, 0xe0, 0xe5, 0xfc, 0x1d, 0x4a, 0x24, 0x72, 0xcb, 0x2a, 0xa2, 0x0b, 0x2f, 0xf7, 0x56,
+	0x66, 0x7e, 0xd9, 0x5b, 0x29, 0x3f, 0x22, 0x99, 0x78, 0xfd, 0x62, 0xb5, 0x66, 0xa2, 0x93, 0x4b,
+	0xbf, 0x44, 0x22, 0xe7, 0x26, 0xd4, 0x38, 0x1d, 0xb2, 0x10, 0x07, 0xb2, 0x6e, 0x6e, 0x45, 0x9d,
+	0xd8, 0x9a, 0x76, 0xe2, 0x86, 0x82, 0xe9, 0xd3, 0xf8, 0xe4, 0xd9, 0xb9, 0x00, 0xd5, 0x90, 0x61,
+	0x24, 0x70, 0x80, 0x84, 0x3b, 0xdb, 0xb6, 0x3a, 0xb6, 0x3f, 0xaf, 0x0d, 0xb7, 0x84, 0x73, 0x0b,
+	0x16, 0x0d, 0xdd, 0x01, 0xd2, 0x7c, 0xb8, 0x73, 0x27, 0x30, 0xd5, 0x30, 0x0e, 0xc6, 0xea, 0xf4,
+	0xa0, 0x15, 0x27, 0x74, 0x80, 0x92, 0x60, 0x87, 0x30, 0x31, 0x44, 0x49, 0x10, 0x33, 0x3a, 0xcc,
+	0x83, 0x2d, 0x94, 0x92, 0x64, 0x14, 0x90, 0xc8, 0x9d, 0x6f, 0x5b, 0x9d, 0xba, 0xbf, 0xac, 0x51,
+	0x8f, 0x35, 0xe8, 0x9e, 0xc4, 0xfc, 0x4f, 0x41, 0xfa, 0x91, 0xf3, 0x0f, 0x70, 0xc2, 0x6d, 0xc4,
+	0x62, 0x1c, 0x05, 0x0c, 0xa3, 0x28, 0x78, 0x3a, 0xa4, 0x02, 0xb9, 0xd5, 0xb6, 0xd5, 0x29, 0xfb,
+	0x4b, 0x66, 0xc7, 0xc7, 0x28, 0xfa, 0x42, 0xda, 0x9d, 0xbb, 0x50, 0x37, 0x45, 0xe2, 0x02, 0x89,
+	0x21, 0x77, 0x41, 0x91, 0xd2, 0x9e, 0x46, 0x8a, 0xee, 0x85, 0x0d, 0x85, 0xf3, 0x17, 0x06, 0x85,
+	0x95, 0x73, 0x0d, 0xca, 0x02, 0xc5, 0xdc, 0xad, 0xb5, 0xad, 0x4e, 0x6d, 0xba, 0xb7, 0x8f, 0x0d,
+	0x91, 0x28, 0xe6, 0xbe, 0x42, 0x7b, 0xdf, 0x97, 0xc0, 0xe9, 0x67, 0x02, 0xb3, 0x0c, 0x25, 0x85,
+	0x46, 0xbb, 0x08, 0x90, 0x33, 0x22, 0xab, 0x44, 0x52, 0xac, 0xba, 0xcd, 0xf6, 0xab, 0xca, 0xb2,
+	0x49, 0x52, 0xec, 0xfc, 0x0d, 0xce, 0x08, 0x2a, 0x50, 0x12, 0xe8, 0x64, 0x02, 0x4e, 0x9e, 0xeb,
+	0xee, 0x2a, 0xfb, 0x8b, 0x6a, 0xe3, 0xb6, 0xb2, 0x6f, 0x90, 0xe7, 0xd8, 0xf9, 0x12, 0x9a, 0x09,
+	0x0d, 0x0f, 0xf3, 0xc9, 0x5d, 0xbb, 0x6d, 0x77, 0x6a, 0xeb, 0x7f, 0x9d, 0x16, 0xe7, 0xff, 0x69,
+	0x78, 0x90, 0x59, 0xdf, 0x49, 0x0e, 0x9b, 0xb8, 0x73, 0x03, 0x2e, 0x64, 0x78, 0x57, 0x04, 0x53,
+	0xde, 0x1e, 0x98, 0x86, 0xac, 0xfb, 0xe7, 0x24, 0xe4, 0xc8, 0xfb, 0xfa, 0x91, 0x73, 0x19, 0x16,
+	0x73, 0x86, 0x73, 0x44, 0xa2, 0x20, 0x4f, 0x50, 0x26, 0x3d, 0x2a, 0x2a, 0x81, 0xba, 0x31, 0x3f,
+	0x4c, 0x50, 0xd6, 0x8f, 0xbc, 0x37, 0x25, 0x58, 0xd2, 0xc4, 0xc8, 0x8a, 0xf9, 0xea, 0xf6, 0xca,
+	0x02, 0xab, 0x9a, 0x06, 0x39, 0x66, 0x84, 0x46, 0xb2, 0x70, 0x4c, 0x18, 0x9a, 0x96, 0xd4, 0xce,
+	0x43, 0xb5, 0xb1, 0x21, 0xed, 0x92, 0x2d, 0x83, 0x53, 0xdd, 0x30, 0x18, 0x09, 0xcc, 0xc7, 0x6c,
+	0xe9, 0x0d, 0xf9, 0xea, 0x9e, 0x34, 0x3b, 0x3d, 0xa8, 0xf2, 0x3c, 0x08, 0x13, 0x44, 0xd2, 0x31,
+	0x45, 0x2b, 0x53, 0x6f, 0x47, 0x2e, 0x7d, 0x6e, 0x4b, 0x5c, 0xaf, 0x2c, 0x2f, 0x9d, 0x3f, 0xcf,
+	0x73, 0xb5, 0xe4, 0x4e, 0x07, 0x96, 0x74, 0x75, 0x0a, 0xc7, 0x95, 0xd5, 0x71, 0x0d, 0x65, 0xdf,
+	0x3f, 0xed, 0x5f, 0xe0, 0x9a, 0x3a, 0x4a, 0x4f, 0x7c, 0x20, 0x40, 0xcd, 0xc6, 0x9f, 0x74, 0x39,
+	0xf5, 0xf6, 0xbe, 0xe3, 0x7d, 0x58, 0xe0, 0x79, 0xc0, 0xf1, 0xd3, 0x21, 0xce, 0x42, 0xcc, 0xdd,
+	0x59, 0x15, 0xa9, 0x77, 0x7c, 0xa4, 0x1b, 0x06, 0x6a, 0x82, 0xad, 0xf1, 0x7c, 0x6c, 0xe1, 0x1e,
+	0x86, 0xc6, 0x41, 0x90, 0x73, 0x16, 0x2a, 0x5c, 0x15, 0xd1, 0x52, 0x45, 0x2c, 0x73, 0x59, 0xb1,
+	0x26, 0x54, 0x32, 0x9a, 0x85, 0xe3, 0x46, 0xd3, 0x0b, 0x99, 0x6c, 0x82, 0xb8, 0x90, 0xba, 0x8a,
+	0x49, 0x2e, 0x02, 0x9c, 0x45, 0x4a, 0xc7, 0x6c, 0xbf, 0x21, 0xed, 0xbe, 0x36, 0xdf, 0xcd, 0x22,
+	0x6f, 0x13, 0x6a, 0x05, 0xd6, 0xa6, 0x9f, 0xf1, 0x4f, 0x68, 0x2a, 0x2a, 0xd0, 0x20, 0xc1, 0x47,
+	0xab, 0xe5, 0x4c, 0xf6, 0x26, 0x4c, 0x78, 0xbf, 0x55, 0x00, 0x3e, 0x1f, 0x7c, 0x8d, 0xc3, 0x0f,
+	0x53, 0xe8, 0x75, 0x98, 0x53, 0xea, 0x45, 0x99, 0x5b, 0x3a, 0xc1, 0x63, 0x0c, 0x3c, 0xac, 0xea,
+	0xf6, 0x11, 0x55, 0x5f, 0x81, 0x1a, 0x55, 0x21, 0x69, 0x40, 0x59, 0x03, 0xb4, 0x49, 0x01, 0xb4,
+	0x64, 0x57, 0xde, 0x4f, 0xb2, 0xaf, 0xc2, 0x9f, 0x8f, 0xb9, 0x62, 0xb3, 0x8a, 0xb9, 0xb3, 0xc9,
+	0x94, 0xeb, 0x75, 0x09, 0x16, 0x72, 0x34, 0x4a, 0x28, 0x8a, 0xb4, 0x38, 0xcc, 0x29, 0x02, 0x6b,
+	0xc6, 0xa6, 0x84, 0xe1, 0xe0, 0xec, 0x99, 0xff, 0xa0, 0xd9, 0x73, 0x09, 0x16, 0x42, 0x9a, 0x09,
+	0x29, 0xf8, 0x6a, 0x9e, 0x54, 0x55, 0xaa, 0x35, 0x63, 0x3b, 0x3a, 0x30, 0xe0, 0xd0, 0xc0, 0xb8,
+	0x0b, 0x75, 0xc3, 0x94, 0xd1, 0xde, 0xda, 0xf1, 0xda, 0xab, 0xab, 0x3c, 0xd6, 0x5e, 0x5a, 0x58,
+	0x39, 0xf7, 0x61, 0x91, 0xe1, 0x68, 0x98, 0x45, 0x28, 0x0b, 0x47, 0x3a, 0x92, 0x85, 0xe3, 0xf3,
+	0xf1, 0x27, 0x50, 0x95, 0x4f, 0x83, 0x1d, 0x58, 0x1f, 0x1e, 0x91, 0xf5, 0x53, 0x8f, 0xc8, 0x2e,
+	0x54, 0xc3, 0x6d, 0x1c, 0x3e, 0xe1, 0xc3, 0x94, 0xbb, 0x8d, 0xb6, 0xdd, 0x59, 0xe8, 0x9d, 0xf9,
+	0x75, 0x6f, 0xa5, 0x2e, 0x18, 0x22, 0x82, 0xff, 0xc7, 0xa3, 0x29, 0x11, 0x9e, 0xbf, 0x8f, 0x99,
+	0x8c, 0x8e, 0xc5, 0x53, 0x8d, 0x8e, 0xef, 0x4a, 0x50, 0xd5, 0xe5, 0xfe, 0x90, 0xc6, 0xbf, 0x08,
+	0xa0, 0xfb, 0xa8, 0xf0, 0x65, 0x52, 0x55, 0x16, 0xd5, 0xa1, 0x87, 0x48, 0xb0, 0x4f, 0x4d, 0xc2,
+	0xa9, 0xbe, 0x4a, 0x9a, 0x50, 0xc1, 0xbb, 0x82, 0x21, 0x7d, 0x25, 0x7c, 0xbd, 0x98, 0xd0, 0x32,
+	0x7b, 0x2a, 0x5a, 0x6e, 0x40, 0x65, 0x53, 0x12, 0x2d, 0x33, 0x54, 0x8c, 0xeb, 0x0c, 0x2c, 0x9d,
+	0xa1, 0xb2, 0xa8, 0x00, 0x9b, 0x50, 0xd9, 0x41, 0xc9, 0x70, 0x9c, 0xbb, 0x5e, 0x78, 0x3f, 0x59,
+	0xd0, 0xd0, 0xe3, 0xe6, 0x01, 0x16, 0xe8, 0x0e, 0x12, 0xc8, 0x69, 0x43, 0x2d, 0xc2, 0x3c, 0x64,
+	0x24, 0x17, 0x84, 0x66, 0xe6, 0x45, 0x45, 0x93, 0xbc, 0x05, 0x78, 0x57, 0xcf, 0xf0, 0x60, 0xc8,
+	0x12, 0xf3, 0xc6, 0xda, 0xd8, 0xf6, 0x88, 0x25, 0x27, 0x6b, 0x46, 0x13, 0x2a, 0x24, 0x45, 0xf1,
+	0x58, 0x2d, 0xf4, 0xc2, 0xb9, 0x09, 0x80, 0x84, 0x60, 0x64, 0x30, 0xd4, 0x23, 0x41, 0xaa, 0xfc,
+	0xf9, 0x69, 0x44, 0xa8, 0x94, 0x8d, 0xb8, 0x17, 0x5c, 0x54, 0x3e, 0xfa, 0xe2, 0x7c, 0xf4, 0x7c,
+	0x8a, 0x12, 0x67, 0x1f, 0x91, 0xb8, 0x4f, 0x94, 0xcf, 0x8f, 0x16, 0xd4, 0x55, 0xd3, 0x7f, 0xdc,
+	0x74, 0x0e, 0xde, 0x06, 0xfb, 0xf0, 0x6d, 0xf8, 0x44, 0xc9, 0xac, 0x83, 0xdd, 0x8f, 0xb8, 0xb9,
+	0x2a, 0x56, 0xdb, 0x7e, 0x8f, 0xab, 0xe2, 0xfd, 0x60, 0x01, 0xdc, 0xc1, 0x09, 0x16, 0x58, 0x5d,
+	0xfb, 0xeb, 0x60, 0x9a, 0x28, 0x20, 0x11, 0x57, 0xc9, 0xd7, 0xd6, 0xcf, 0x4d, 0x8b, 0xa1, 0x1f,
+	0x71, 0xbf, 0xaa, 0xa1, 0xf2, 0xcc, 0xeb, 0x60, 0x8a, 0xa5, 0xfc, 0x4a, 0x27, 0xf8, 0x69, 0xa8,
+	0xf4, 0xbb, 0x06, 0xd5, 0xf1, 0xf8, 0xe1, 0xae, 0xfd, 0xc7, 0x6e, 0xf3, 0xb1, 0x1e, 0x46, 0xdc,
+	0x7b, 0x6d, 0xc1, 0xd9, 0x07, 0x24, 0x66, 0x48, 0xd6, 0xa3, 0xf0, 0x99, 0xbb, 0x0c, 0x55, 0xce,
+	0xc2, 0xa0, 0xf8, 0x1d, 0x30, 0xc7, 0x59, 0xb8, 0x21, 0x27, 0x58, 0x1f, 0x3c, 0xb9, 0x77, 0xc2,
+	0xcf, 0x40, 0x49, 0x39, 0x5d, 0xe4, 0x2c, 0xbc, 0x77, 0xfc, 0xff, 0xc0, 0x32, 0x54, 0x23, 0x2e,
+	0xcc, 0x31, 0xb6, 0x3e, 0x26, 0xe2, 0x42, 0x1d, 0xf3, 0x6f, 0xa8, 0x4e, 0x08, 0x7c, 0x1f, 0xb9,
+	0x9a, 0x1f, 0x73, 0xe8, 0x7d, 0x03, 0x0b, 0x45, 0xf9, 0x71, 0xfe, 0x6b, 0xe4, 0xca, 0x52, 0x8d,
+	0xf0, 0x97, 0x93, 0xe4, 0x6a, 0x6d, 0x13, 0xc5, 0xa6, 0x27, 0x94, 0xdf, 0xf2, 0x2a, 0xd8, 0x9b,
+	0x28, 0x76, 0x96, 0xc0, 0x7e, 0x82, 0x47, 0xa6, 0x8f, 0xe5, 0xe3, 0x74, 0xa5, 0xea, 0xf5, 0x5f,
+	0xbe, 0x6d, 0x59, 0xaf, 0xde, 0xb6, 0xac, 0x37, 0x6f, 0x5b, 0xd6, 0xb7, 0xef, 0x5a, 0x33, 0xaf,
+	0xde, 0xb5, 0x66, 0x7e, 0x7e, 0xd7, 0x9a, 0xf9, 0xaa, 0x1b, 0x13, 0xb1, 0x3d, 0x1c, 0xac, 0x85,
+	0x34, 0xed, 0x0e, 0xb2, 0xc1, 0x6a, 0xb8, 0x8d, 0x48, 0xd6, 0x2d, 0xfc, 0xea, 0xee, 0x1e, 0xfc,
+	0x79, 0x1f, 0xcc, 0xaa, 0x9f, 0xdd, 0xab, 0xbf, 0x0f, 0x00, 0xa2, 0x3a, 0xef, 0xa2, 0xdf, 0x0f,
+	0x00, 0x00,
 }
 
 func (m *BucketInfo) Marshal() (dAtA []byte, err error) {
@@ -1243,6 +1481,11 @@ func (m *InternalBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PrepaidPlanId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrepaidPlanId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.NextLocalVirtualGroupId != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.NextLocalVirtualGroupId))
 		i--
@@ -1275,6 +1518,148 @@ func (m *InternalBucketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BucketReadRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketReadRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BucketReadRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpSequences) > 0 {
+		for iNdEx := len(m.SpSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TotalClaimedReadBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalClaimedReadBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalReadBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalReadBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SpClaims) > 0 {
+		for iNdEx := len(m.SpClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PeriodReadBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PeriodReadBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QuotaPeriodStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QuotaPeriodStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpReadSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpReadSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpReadSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastReceiptEnd != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastReceiptEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpReadClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpReadClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpReadClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimableReadBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimableReadBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1997,17 +2382,86 @@ func (m *InternalBucketInfo) Size() (n int) {
 	if m.NextLocalVirtualGroupId != 0 {
 		n += 1 + sovTypes(uint64(m.NextLocalVirtualGroupId))
 	}
+	if m.PrepaidPlanId != 0 {
+		n += 1 + sovTypes(uint64(m.PrepaidPlanId))
+	}
 	return n
 }
 
-func (m *ObjectInfo) Size() (n int) {
+func (m *BucketReadRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
+	if m.QuotaPeriodStart != 0 {
+		n += 1 + sovTypes(uint64(m.QuotaPeriodStart))
+	}
+	if m.PeriodReadBytes != 0 {
+		n += 1 + sovTypes(uint64(m.PeriodReadBytes))
+	}
+	if len(m.SpClaims) > 0 {
+		for _, e := range m.SpClaims {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.TotalReadBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalReadBytes))
+	}
+	if m.TotalClaimedReadBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalClaimedReadBytes))
+	}
+	if len(m.SpSequences) > 0 {
+		for _, e := range m.SpSequences {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpReadSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.LastReceiptEnd != 0 {
+		n += 1 + sovTypes(uint64(m.LastReceiptEnd))
+	}
+	return n
+}
+
+func (m *SpReadClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpId != 0 {
+		n += 1 + sovTypes(uint64(m.SpId))
+	}
+	if m.ClaimableReadBytes != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimableReadBytes))
+	}
+	return n
+}
+
+func (m *ObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.Creator)
@@ -2746,6 +3200,414 @@ func (m *InternalBucketInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidPlanId", wireType)
+			}
+			m.PrepaidPlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepaidPlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketReadRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketReadRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketReadRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaPeriodStart", wireType)
+			}
+			m.QuotaPeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuotaPeriodStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReadBytes", wireType)
+			}
+			m.PeriodReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpClaims = append(m.SpClaims, SpReadClaim{})
+			if err := m.SpClaims[len(m.SpClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReadBytes", wireType)
+			}
+			m.TotalReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimedReadBytes", wireType)
+			}
+			m.TotalClaimedReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalClaimedReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpSequences = append(m.SpSequences, SpReadSequence{})
+			if err := m.SpSequences[len(m.SpSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpReadSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpReadSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpReadSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReceiptEnd", wireType)
+			}
+			m.LastReceiptEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReceiptEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpReadClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpReadClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpReadClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpId", wireType)
+			}
+			m.SpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableReadBytes", wireType)
+			}
+			m.ClaimableReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimableReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -26,6 +26,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGlobalVirtualGroupByFamilyID())
 	cmd.AddCommand(CmdGlobalVirtualGroupFamily())
 	cmd.AddCommand(CmdGlobalVirtualGroupFamilies())
+	cmd.AddCommand(CmdPlacementCandidateFamilies())
+	cmd.AddCommand(CmdGlobalVirtualGroupsBySecondarySP())
+	cmd.AddCommand(CmdGlobalVirtualGroupFamiliesByPrimarySP())
+	cmd.AddCommand(CmdSPExitProgress())
 	cmd.AddCommand(CmdQueryParams())
 
 	return cmd
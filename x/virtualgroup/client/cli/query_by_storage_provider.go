@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func CmdGlobalVirtualGroupsBySecondarySP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "global-virtual-groups-by-secondary-sp [sp id]",
+		Short: "query the global virtual groups which the storage provider serves as a secondary sp.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid sp id %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GlobalVirtualGroupsBySecondarySP(cmd.Context(), &types.QueryGlobalVirtualGroupsBySecondarySPRequest{
+				StorageProviderId: uint32(spID),
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdGlobalVirtualGroupFamiliesByPrimarySP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "global-virtual-group-families-by-primary-sp [sp id]",
+		Short: "query the global virtual group families which the storage provider serves as the primary sp.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			spID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid sp id %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GlobalVirtualGroupFamiliesByPrimarySP(cmd.Context(), &types.QueryGlobalVirtualGroupFamiliesByPrimarySPRequest{
+				StorageProviderId: uint32(spID),
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
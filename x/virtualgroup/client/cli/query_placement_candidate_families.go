@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+const FlagLimit = "limit"
+
+func CmdPlacementCandidateFamilies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "placement-candidate-families [expected-size]",
+		Short: "query the global virtual group families ranked by their available size for a new bucket",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			expectedSize, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expected size %s", args[0])
+			}
+
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPlacementCandidateFamiliesRequest{
+				ExpectedSize: expectedSize,
+				Limit:        limit,
+			}
+
+			res, err := queryClient.PlacementCandidateFamilies(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagLimit, types.DefaultPlacementCandidateLimit, "The max number of candidate families to return")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
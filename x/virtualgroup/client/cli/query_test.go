@@ -77,6 +77,50 @@ func (s *CLITestSuite) TestQueryCmd() {
 			),
 			false, "", &types.QueryGlobalVirtualGroupFamilyResponse{},
 		},
+		{
+			"query placement-candidate-families",
+			append(
+				[]string{
+					"placement-candidate-families",
+					"1024",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryPlacementCandidateFamiliesResponse{},
+		},
+		{
+			"query global-virtual-groups-by-secondary-sp",
+			append(
+				[]string{
+					"global-virtual-groups-by-secondary-sp",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryGlobalVirtualGroupsBySecondarySPResponse{},
+		},
+		{
+			"query global-virtual-group-families-by-primary-sp",
+			append(
+				[]string{
+					"global-virtual-group-families-by-primary-sp",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QueryGlobalVirtualGroupFamiliesByPrimarySPResponse{},
+		},
+		{
+			"query sp-exit-progress",
+			append(
+				[]string{
+					"sp-exit-progress",
+					"1",
+				},
+				commonFlags...,
+			),
+			false, "", &types.QuerySPExitProgressResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -24,6 +26,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSettle())
+	cmd.AddCommand(CmdForcedSwapOut())
+	cmd.AddCommand(CmdRebalanceGlobalVirtualGroups())
 
 	return cmd
 }
@@ -72,3 +76,109 @@ If none zero is provided for GVG family, then the provided GVG family will be se
 
 	return cmd
 }
+
+func CmdForcedSwapOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forced-swap-out [gvg family id] [bls signatures]",
+		Short: "Take over a GVG family whose primary SP is jailed or exiting",
+		Long: `Forced swap out lets an in-service SP take over the GVG family whose primary SP has been jailed or exiting for longer than the forced swap out timeout.
+The bls signatures are the comma separated hex encoded aggregated signatures of the secondary SPs of each GVG in the family, in the same order as the GVG ids of the family.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			gvgFamilyId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil || gvgFamilyId == 0 {
+				return fmt.Errorf("invalid GVG family id %s", args[0])
+			}
+			blsSignatures := make([][]byte, 0)
+			if len(args) > 1 && args[1] != "" {
+				for _, split := range strings.Split(args[1], ",") {
+					sig, err := hex.DecodeString(split)
+					if err != nil {
+						return fmt.Errorf("invalid bls signature %s", split)
+					}
+					blsSignatures = append(blsSignatures, sig)
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForcedSwapOut(
+				clientCtx.GetFromAddress(),
+				uint32(gvgFamilyId),
+				blsSignatures,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRebalanceGlobalVirtualGroups() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance [src gvg family id] [dst gvg family id] [gvg ids] [bucket ids]",
+		Short: "Move GVGs between GVG families of the primary SP",
+		Long: `Rebalance moves the GVGs (by specifying comma separated ids) from the source GVG family to the destination GVG family.
+Both families should be owned by the primary SP. If zero is provided for the destination GVG family, then a new family will be created.
+The objects are bound to the buckets served by the source GVG family, so the GVGs storing objects should be moved along with the buckets
+(by specifying comma separated ids) storing objects in them, which will be rebound to the destination GVG family.`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			srcFamilyId, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil || srcFamilyId == 0 {
+				return fmt.Errorf("invalid source GVG family id %s", args[0])
+			}
+			dstFamilyId, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid destination GVG family id %s", args[1])
+			}
+			gvgIds := make([]uint32, 0)
+			for _, split := range strings.Split(args[2], ",") {
+				gvgId, err := strconv.ParseUint(split, 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid GVG id %s", split)
+				}
+				gvgIds = append(gvgIds, uint32(gvgId))
+			}
+			bucketIds := make([]sdkmath.Uint, 0)
+			if len(args) > 3 {
+				for _, split := range strings.Split(args[3], ",") {
+					bucketId, err := sdkmath.ParseUint(split)
+					if err != nil {
+						return fmt.Errorf("invalid bucket id %s", split)
+					}
+					bucketIds = append(bucketIds, bucketId)
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRebalanceGlobalVirtualGroups(
+				clientCtx.GetFromAddress(),
+				uint32(srcFamilyId),
+				uint32(dstFamilyId),
+				gvgIds,
+				bucketIds,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
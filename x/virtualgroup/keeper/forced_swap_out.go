@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/types"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// SetSPExitTime records the time when the sp starts to exit
+func (k Keeper) SetSPExitTime(ctx sdk.Context, spID uint32, exitTime int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSPExitTimeKey(spID), sdk.Uint64ToBigEndian(uint64(exitTime)))
+}
+
+// GetSPExitTime returns the time when the sp starts to exit
+func (k Keeper) GetSPExitTime(ctx sdk.Context, spID uint32) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSPExitTimeKey(spID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k Keeper) DeleteSPExitTime(ctx sdk.Context, spID uint32) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSPExitTimeKey(spID))
+}
+
+// GetOrphanedTime returns the time since when the families of the sp are orphaned,
+// only the families of a jailed or exiting sp can be orphaned.
+func (k Keeper) GetOrphanedTime(ctx sdk.Context, sp *sptypes.StorageProvider) (int64, bool) {
+	switch sp.Status {
+	case sptypes.STATUS_GRACEFUL_EXITING:
+		return k.GetSPExitTime(ctx, sp.Id)
+	case sptypes.STATUS_IN_JAILED:
+		record, found := k.spKeeper.GetSpJailRecord(ctx, sp.Id)
+		if !found {
+			return 0, false
+		}
+		return record.JailedAt, true
+	default:
+		return 0, false
+	}
+}
+
+// VerifyGVGSecondarySPsBlsSignature verifies the aggregated bls signature of the secondary sps of the gvg
+func (k Keeper) VerifyGVGSecondarySPsBlsSignature(ctx sdk.Context, gvg *types.GlobalVirtualGroup, signHash [32]byte, signature []byte) error {
+	secondarySpBlsPubKeys := make([]bls.PublicKey, 0, len(gvg.SecondarySpIds))
+	for _, spID := range gvg.GetSecondarySpIds() {
+		secondarySp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+		if !found {
+			return sptypes.ErrStorageProviderNotFound.Wrapf("secondary sp(ID: %d) not found", spID)
+		}
+		spBlsPubKey, err := bls.PublicKeyFromBytes(secondarySp.BlsKey)
+		if err != nil {
+			return types.ErrInvalidBlsSignature.Wrapf("BLS public key converts failed: %v", err)
+		}
+		secondarySpBlsPubKeys = append(secondarySpBlsPubKeys, spBlsPubKey)
+	}
+	return gnfdtypes.VerifyBlsAggSignature(secondarySpBlsPubKeys, signHash, signature)
+}
+
+// ForcedSwapOut lets the successor sp take over the family whose primary sp is jailed or exiting for longer than
+// the forced swap out timeout. The secondary sps of every gvg in the family must accept the successor sp.
+func (k Keeper) ForcedSwapOut(ctx sdk.Context, familyID uint32, successorSP *sptypes.StorageProvider, secondarySpBlsSignatures [][]byte) error {
+	family, found := k.GetGVGFamily(ctx, familyID)
+	if !found {
+		return types.ErrGVGFamilyNotExist
+	}
+	primarySP, found := k.spKeeper.GetStorageProvider(ctx, family.PrimarySpId)
+	if !found {
+		return sptypes.ErrStorageProviderNotFound.Wrapf("The primary sp(ID: %d) of the family not found.", family.PrimarySpId)
+	}
+
+	orphanedTime, found := k.GetOrphanedTime(ctx, primarySP)
+	if !found {
+		return types.ErrSwapOutFailed.Wrapf("The primary sp(ID: %d) is not jailed or exiting, status: %s", primarySP.Id, primarySP.Status.String())
+	}
+	// the timeout is never zero once set, a zero timeout means the param is not initialized, the forced swap out
+	// is disabled rather than letting any sp take over the family immediately
+	timeout := k.ForcedSwapOutTimeout(ctx)
+	if timeout == 0 {
+		return types.ErrSwapOutFailed.Wrapf("The forced swap out is disabled since the forced swap out timeout is not set")
+	}
+	deadline := orphanedTime + int64(timeout)
+	if ctx.BlockTime().Unix() < deadline {
+		return types.ErrSwapOutFailed.Wrapf("The family(ID: %d) can not be taken over before %d", familyID, deadline)
+	}
+
+	if len(secondarySpBlsSignatures) != len(family.GlobalVirtualGroupIds) {
+		return types.ErrInvalidBlsSignature.Wrapf("The number of bls signatures(%d) is mismatch with the number of gvgs(%d) in the family",
+			len(secondarySpBlsSignatures), len(family.GlobalVirtualGroupIds))
+	}
+	for i, gvgID := range family.GlobalVirtualGroupIds {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return types.ErrGVGNotExist
+		}
+		signDoc := types.NewSecondarySpForcedSwapOutSignDoc(ctx.ChainID(), gvgID, successorSP.Id)
+		if err := k.VerifyGVGSecondarySPsBlsSignature(ctx, gvg, signDoc.GetBlsSignHash(), secondarySpBlsSignatures[i]); err != nil {
+			return types.ErrInvalidBlsSignature.Wrapf("The secondary sps of gvg(ID: %d) do not accept the successor sp, err: %s", gvgID, err)
+		}
+	}
+
+	// the pending swap out of the primary sp is replaced by the forced one
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetSwapOutFamilyKey(familyID)) {
+		if err := k.DeleteSwapOutInfo(ctx, familyID, nil, primarySP.Id); err != nil {
+			return err
+		}
+	}
+	if err := k.SetSwapOutInfo(ctx, familyID, nil, primarySP.Id, successorSP.Id); err != nil {
+		return err
+	}
+	if err := k.CompleteSwapOut(ctx, familyID, nil, successorSP); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvents(&types.EventForcedSwapOut{
+		StorageProviderId:          successorSP.Id,
+		SrcStorageProviderId:       primarySP.Id,
+		GlobalVirtualGroupFamilyId: familyID,
+	})
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestForcedSwapOut() {
+	now := time.Unix(1700000000, 0)
+	s.ctx = s.ctx.WithBlockTime(now)
+
+	primarySP := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE, FundingAddress: sample.RandAccAddress().String()}
+	successorSP := &sptypes.StorageProvider{Id: 3, Status: sptypes.STATUS_IN_SERVICE, FundingAddress: sample.RandAccAddress().String()}
+	sps := map[uint32]*sptypes.StorageProvider{1: primarySP, 3: successorSP}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, id uint32) (*sptypes.StorageProvider, bool) {
+			sp, found := sps[id]
+			return sp, found
+		}).AnyTimes()
+	s.paymentKeeper.EXPECT().QueryDynamicBalance(gomock.Any(), gomock.Any()).
+		Return(math.ZeroInt(), nil).AnyTimes()
+
+	// family 1 has a gvg, family 2 is empty
+	s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{
+		Id:             1,
+		FamilyId:       1,
+		PrimarySpId:    primarySP.Id,
+		SecondarySpIds: []uint32{2},
+		TotalDeposit:   math.ZeroInt(),
+	})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{
+		Id:                    1,
+		PrimarySpId:           primarySP.Id,
+		GlobalVirtualGroupIds: []uint32{1},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{
+		Id:                    2,
+		PrimarySpId:           primarySP.Id,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	})
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, &types.GVGStatisticsWithinSP{StorageProviderId: primarySP.Id, PrimaryCount: 2})
+
+	// the primary sp is in service
+	err := s.virtualgroupKeeper.ForcedSwapOut(s.ctx, 2, successorSP, nil)
+	s.Require().ErrorIs(err, types.ErrSwapOutFailed)
+
+	// the primary sp starts to exit, but the timeout is not reached
+	primarySP.Status = sptypes.STATUS_GRACEFUL_EXITING
+	s.virtualgroupKeeper.SetSPExitTime(s.ctx, primarySP.Id, now.Unix())
+	err = s.virtualgroupKeeper.ForcedSwapOut(s.ctx, 2, successorSP, nil)
+	s.Require().ErrorIs(err, types.ErrSwapOutFailed)
+
+	s.ctx = s.ctx.WithBlockTime(now.Add(time.Duration(types.DefaultForcedSwapOutTimeout) * time.Second))
+
+	// the forced swap out is disabled when the timeout is not set, e.g. on an upgraded chain
+	params := types.DefaultParams()
+	params.ForcedSwapOutTimeout = 0
+	s.ctx.KVStore(s.storeKey).Set(types.ParamsKey, s.cdc.MustMarshal(&params))
+	err = s.virtualgroupKeeper.ForcedSwapOut(s.ctx, 2, successorSP, nil)
+	s.Require().ErrorIs(err, types.ErrSwapOutFailed)
+	s.Require().NoError(s.virtualgroupKeeper.SetParams(s.ctx, types.DefaultParams()))
+
+	// the secondary sps of every gvg should accept the successor sp
+	err = s.virtualgroupKeeper.ForcedSwapOut(s.ctx, 1, successorSP, nil)
+	s.Require().ErrorIs(err, types.ErrInvalidBlsSignature)
+
+	// the pending swap out of the primary sp is replaced
+	err = s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 2, nil, primarySP.Id, 4)
+	s.Require().NoError(err)
+
+	err = s.virtualgroupKeeper.ForcedSwapOut(s.ctx, 2, successorSP, nil)
+	s.Require().NoError(err)
+
+	family, found := s.virtualgroupKeeper.GetGVGFamily(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().Equal(successorSP.Id, family.PrimarySpId)
+	// no swap out info is left
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 2, nil, successorSP.Id, 4))
+}
+
+func (s *TestSuite) TestGetOrphanedTime() {
+	sp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_JAILED}
+	s.spKeeper.EXPECT().GetSpJailRecord(gomock.Any(), sp.Id).
+		Return(&sptypes.SpJailRecord{SpId: sp.Id, JailedAt: 100}, true)
+	orphanedTime, found := s.virtualgroupKeeper.GetOrphanedTime(s.ctx, sp)
+	s.Require().True(found)
+	s.Require().Equal(int64(100), orphanedTime)
+
+	sp.Status = sptypes.STATUS_IN_MAINTENANCE
+	_, found = s.virtualgroupKeeper.GetOrphanedTime(s.ctx, sp)
+	s.Require().False(found)
+}
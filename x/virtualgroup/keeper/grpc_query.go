@@ -3,13 +3,16 @@ package keeper
 import (
 	"context"
 	"math"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/bnb-chain/greenfield/internal/sequence"
 	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
@@ -113,9 +116,115 @@ func (k Keeper) AvailableGlobalVirtualGroupFamilies(goCtx context.Context, req *
 		if err != nil {
 			return nil, err
 		}
+		if freeCapacity, reported := k.GetFreeCapacityOfFamily(ctx, gvgFamily); reported && freeCapacity == 0 {
+			continue
+		}
 		if float64(stored) < math.Min(float64(totalStakingSize), float64(k.MaxStoreSizePerFamily(ctx))) && uint32(len(gvgFamily.GlobalVirtualGroupIds)) < k.MaxGlobalVirtualGroupNumPerFamily(ctx) {
 			availableFamilyIds = append(availableFamilyIds, gvgfID)
 		}
 	}
 	return &types.AvailableGlobalVirtualGroupFamiliesResponse{GlobalVirtualGroupFamilyIds: availableFamilyIds}, nil
 }
+
+func (k Keeper) PlacementCandidateFamilies(goCtx context.Context, req *types.QueryPlacementCandidateFamiliesRequest) (*types.QueryPlacementCandidateFamiliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultPlacementCandidateLimit
+	}
+	if limit > types.MaxPlacementCandidateLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit should not be larger than %d", types.MaxPlacementCandidateLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GVGFamilyKey)
+	defer iterator.Close()
+
+	candidates := make([]types.PlacementCandidateFamily, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var gvgFamily types.GlobalVirtualGroupFamily
+		k.cdc.MustUnmarshal(iterator.Value(), &gvgFamily)
+
+		sp, found := k.spKeeper.GetStorageProvider(ctx, gvgFamily.PrimarySpId)
+		if !found || !sp.IsInService() {
+			continue
+		}
+		available, err := k.GetAvailableStoreSizeOfFamily(ctx, &gvgFamily)
+		if err != nil {
+			return nil, err
+		}
+		if available == 0 || available < req.ExpectedSize {
+			continue
+		}
+		candidates = append(candidates, types.PlacementCandidateFamily{
+			FamilyId:      gvgFamily.Id,
+			PrimarySpId:   gvgFamily.PrimarySpId,
+			AvailableSize: available,
+		})
+	}
+
+	// the families with more available size are preferred
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].AvailableSize != candidates[j].AvailableSize {
+			return candidates[i].AvailableSize > candidates[j].AvailableSize
+		}
+		return candidates[i].FamilyId < candidates[j].FamilyId
+	})
+	if uint32(len(candidates)) > limit {
+		candidates = candidates[:limit]
+	}
+	return &types.QueryPlacementCandidateFamiliesResponse{Candidates: candidates}, nil
+}
+
+func (k Keeper) GlobalVirtualGroupsBySecondarySP(goCtx context.Context, req *types.QueryGlobalVirtualGroupsBySecondarySPRequest) (*types.QueryGlobalVirtualGroupsBySecondarySPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var gvgs []*types.GlobalVirtualGroup
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSecondarySPGVGIndexPrefix(req.StorageProviderId))
+
+	var uint32Seq sequence.Sequence[uint32]
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		gvg, found := k.GetGVG(ctx, uint32Seq.DecodeSequence(key))
+		if !found {
+			return types.ErrGVGNotExist
+		}
+		gvgs = append(gvgs, gvg)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryGlobalVirtualGroupsBySecondarySPResponse{GlobalVirtualGroups: gvgs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) GlobalVirtualGroupFamiliesByPrimarySP(goCtx context.Context, req *types.QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) (*types.QueryGlobalVirtualGroupFamiliesByPrimarySPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var gvgFamilies []*types.GlobalVirtualGroupFamily
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPrimarySPGVGFamilyIndexPrefix(req.StorageProviderId))
+
+	var uint32Seq sequence.Sequence[uint32]
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		gvgFamily, found := k.GetGVGFamily(ctx, uint32Seq.DecodeSequence(key))
+		if !found {
+			return types.ErrGVGFamilyNotExist
+		}
+		gvgFamilies = append(gvgFamilies, gvgFamily)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryGlobalVirtualGroupFamiliesByPrimarySPResponse{GlobalVirtualGroupFamilies: gvgFamilies, Pagination: pageRes}, nil
+}
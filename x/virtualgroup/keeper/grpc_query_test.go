@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	"github.com/bnb-chain/greenfield/types/common"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestPlacementCandidateFamilies() {
+	sps := map[uint32]*sptypes.StorageProvider{
+		1: {Id: 1, Status: sptypes.STATUS_IN_SERVICE},
+		2: {Id: 2, Status: sptypes.STATUS_IN_SERVICE, FreeCapacity: &common.UInt64Value{Value: 2048}},
+		3: {Id: 3, Status: sptypes.STATUS_IN_MAINTENANCE},
+		4: {Id: 4, Status: sptypes.STATUS_IN_SERVICE, FreeCapacity: &common.UInt64Value{Value: 0}},
+		5: {Id: 5, Status: sptypes.STATUS_IN_SERVICE},
+	}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, id uint32) (*sptypes.StorageProvider, bool) {
+			sp, found := sps[id]
+			return sp, found
+		}).AnyTimes()
+
+	stakingPerBytes := s.virtualgroupKeeper.GVGStakingPerBytes(s.ctx)
+	families := []struct {
+		primarySpId  uint32
+		secondarySps []uint32
+		stakingSize  int64
+		storedSize   uint64
+	}{
+		{1, []uint32{5}, 4096, 1024}, // available: 3072
+		{5, []uint32{2}, 8192, 1024}, // available: 2048, limited by the free capacity of sp 2
+		{3, []uint32{1}, 8192, 0},    // primary sp is not in service
+		{1, []uint32{4}, 8192, 0},    // sp 4 has no free capacity
+		{5, []uint32{1}, 1024, 512},  // available: 512
+	}
+	for i, f := range families {
+		id := uint32(i + 1)
+		s.virtualgroupKeeper.SetGVG(s.ctx, &types.GlobalVirtualGroup{
+			Id:             id,
+			FamilyId:       id,
+			PrimarySpId:    f.primarySpId,
+			SecondarySpIds: f.secondarySps,
+			StoredSize:     f.storedSize,
+			TotalDeposit:   stakingPerBytes.MulRaw(f.stakingSize),
+		})
+		s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{
+			Id:                    id,
+			PrimarySpId:           f.primarySpId,
+			GlobalVirtualGroupIds: []uint32{id},
+		})
+	}
+
+	_, err := s.virtualgroupKeeper.PlacementCandidateFamilies(s.ctx, nil)
+	s.Require().Error(err)
+	_, err = s.virtualgroupKeeper.PlacementCandidateFamilies(s.ctx, &types.QueryPlacementCandidateFamiliesRequest{
+		Limit: types.MaxPlacementCandidateLimit + 1,
+	})
+	s.Require().Error(err)
+
+	resp, err := s.virtualgroupKeeper.PlacementCandidateFamilies(s.ctx, &types.QueryPlacementCandidateFamiliesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PlacementCandidateFamily{
+		{FamilyId: 1, PrimarySpId: 1, AvailableSize: 3072},
+		{FamilyId: 2, PrimarySpId: 5, AvailableSize: 2048},
+		{FamilyId: 5, PrimarySpId: 5, AvailableSize: 512},
+	}, resp.Candidates)
+
+	resp, err = s.virtualgroupKeeper.PlacementCandidateFamilies(s.ctx, &types.QueryPlacementCandidateFamiliesRequest{
+		ExpectedSize: 1024,
+		Limit:        1,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PlacementCandidateFamily{
+		{FamilyId: 1, PrimarySpId: 1, AvailableSize: 3072},
+	}, resp.Candidates)
+
+	// the family on the sp without free capacity can't serve new buckets
+	_, err = s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 4)
+	s.Require().ErrorIs(err, types.ErrLimitationExceed)
+	_, err = s.virtualgroupKeeper.GetAndCheckGVGFamilyAvailableForNewBucket(s.ctx, 2)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestQueryByStorageProvider() {
+	s.paymentKeeper.EXPECT().QueryDynamicBalance(gomock.Any(), gomock.Any()).
+		Return(math.ZeroInt(), nil).AnyTimes()
+	s.paymentKeeper.EXPECT().IsEmptyNetFlow(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	for _, family := range []*types.GlobalVirtualGroupFamily{
+		{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1, 2}},
+		{Id: 2, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{3}},
+		{Id: 3, PrimarySpId: 2},
+	} {
+		family.VirtualPaymentAddress = sample.RandAccAddress().String()
+		s.virtualgroupKeeper.SetGVGFamily(s.ctx, family)
+	}
+	for _, gvg := range []*types.GlobalVirtualGroup{
+		{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}},
+		{Id: 2, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{3, 4}},
+		{Id: 3, FamilyId: 2, PrimarySpId: 1, SecondarySpIds: []uint32{2, 4}},
+	} {
+		gvg.TotalDeposit = math.ZeroInt()
+		gvg.VirtualPaymentAddress = sample.RandAccAddress().String()
+		s.virtualgroupKeeper.SetGVG(s.ctx, gvg)
+	}
+	for spID, stat := range map[uint32]*types.GVGStatisticsWithinSP{
+		1: {PrimaryCount: 3}, 2: {SecondaryCount: 2}, 3: {SecondaryCount: 2}, 4: {SecondaryCount: 2},
+	} {
+		stat.StorageProviderId = spID
+		s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, stat)
+	}
+
+	gvgIDsOf := func(spID uint32, pagination *query.PageRequest) []uint32 {
+		res, err := s.virtualgroupKeeper.GlobalVirtualGroupsBySecondarySP(s.ctx, &types.QueryGlobalVirtualGroupsBySecondarySPRequest{
+			StorageProviderId: spID,
+			Pagination:        pagination,
+		})
+		s.Require().NoError(err)
+		ids := make([]uint32, 0)
+		for _, gvg := range res.GlobalVirtualGroups {
+			ids = append(ids, gvg.Id)
+		}
+		return ids
+	}
+	familyIDsOf := func(spID uint32) []uint32 {
+		res, err := s.virtualgroupKeeper.GlobalVirtualGroupFamiliesByPrimarySP(s.ctx, &types.QueryGlobalVirtualGroupFamiliesByPrimarySPRequest{
+			StorageProviderId: spID,
+		})
+		s.Require().NoError(err)
+		ids := make([]uint32, 0)
+		for _, family := range res.GlobalVirtualGroupFamilies {
+			ids = append(ids, family.Id)
+		}
+		return ids
+	}
+
+	s.Require().Equal([]uint32{1, 3}, gvgIDsOf(2, nil))
+	s.Require().Equal([]uint32{1, 2}, gvgIDsOf(3, nil))
+	s.Require().Equal([]uint32{2}, gvgIDsOf(3, &query.PageRequest{Offset: 1, Limit: 1}))
+	s.Require().Equal([]uint32{1, 2}, familyIDsOf(1))
+	s.Require().Equal([]uint32{3}, familyIDsOf(2))
+
+	// swap out sp 3 of gvg 2 with sp 5
+	err := s.virtualgroupKeeper.SwapOutAsSecondarySP(s.ctx, &sptypes.StorageProvider{Id: 3}, &sptypes.StorageProvider{Id: 5}, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint32{1}, gvgIDsOf(3, nil))
+	s.Require().Equal([]uint32{2}, gvgIDsOf(5, nil))
+
+	// delete gvg 3, the empty family 2 is deleted as well
+	err = s.virtualgroupKeeper.DeleteGVG(s.ctx, &sptypes.StorageProvider{Id: 1}, 3)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint32{1}, gvgIDsOf(2, nil))
+	s.Require().Equal([]uint32{2}, gvgIDsOf(4, nil))
+	s.Require().Equal([]uint32{1}, familyIDsOf(1))
+
+	// swap out the primary sp of family 1 with sp 6
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), uint32(1)).
+		Return(&sptypes.StorageProvider{Id: 1, FundingAddress: sample.RandAccAddress().String()}, true).AnyTimes()
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 1, nil, 1, 6))
+	err = s.virtualgroupKeeper.CompleteSwapOut(s.ctx, 1, nil, &sptypes.StorageProvider{Id: 6, FundingAddress: sample.RandAccAddress().String()})
+	s.Require().NoError(err)
+	s.Require().Empty(familyIDsOf(1))
+	s.Require().Equal([]uint32{1}, familyIDsOf(6))
+}
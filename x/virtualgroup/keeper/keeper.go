@@ -96,6 +96,10 @@ func (k Keeper) SetGVG(ctx sdk.Context, gvg *types.GlobalVirtualGroup) {
 
 	bz := k.cdc.MustMarshal(gvg)
 	store.Set(types.GetGVGKey(gvg.Id), bz)
+
+	for _, spID := range gvg.SecondarySpIds {
+		store.Set(types.GetSecondarySPGVGIndexKey(spID, gvg.Id), []byte{})
+	}
 }
 
 func (k Keeper) SetGVGAndEmitUpdateEvent(ctx sdk.Context, gvg *types.GlobalVirtualGroup) error {
@@ -156,6 +160,9 @@ func (k Keeper) DeleteGVG(ctx sdk.Context, primarySp *sptypes.StorageProvider, g
 	}
 
 	store.Delete(types.GetGVGKey(gvg.Id))
+	for _, secondarySPID := range gvg.SecondarySpIds {
+		store.Delete(types.GetSecondarySPGVGIndexKey(secondarySPID, gvg.Id))
+	}
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventDeleteGlobalVirtualGroup{
 		Id:          gvg.Id,
 		PrimarySpId: gvg.PrimarySpId,
@@ -167,6 +174,7 @@ func (k Keeper) DeleteGVG(ctx sdk.Context, primarySp *sptypes.StorageProvider, g
 		k.paymentKeeper.IsEmptyNetFlow(ctx, sdk.MustAccAddressFromHex(gvgFamily.VirtualPaymentAddress)) &&
 		!ctx.IsUpgraded(upgradetypes.Manchurian) {
 		store.Delete(types.GetGVGFamilyKey(gvg.FamilyId))
+		store.Delete(types.GetPrimarySPGVGFamilyIndexKey(gvgFamily.PrimarySpId, gvgFamily.Id))
 		if err := ctx.EventManager().EmitTypedEvents(&types.EventDeleteGlobalVirtualGroupFamily{
 			Id:          gvgFamily.Id,
 			PrimarySpId: gvgFamily.PrimarySpId,
@@ -211,6 +219,7 @@ func (k Keeper) SetGVGFamily(ctx sdk.Context, gvgFamily *types.GlobalVirtualGrou
 
 	bz := k.cdc.MustMarshal(gvgFamily)
 	store.Set(types.GetGVGFamilyKey(gvgFamily.Id), bz)
+	store.Set(types.GetPrimarySPGVGFamilyIndexKey(gvgFamily.PrimarySpId, gvgFamily.Id), []byte{})
 }
 
 func (k Keeper) GetGVGFamily(ctx sdk.Context, familyID uint32) (*types.GlobalVirtualGroupFamily, bool) {
@@ -237,9 +246,64 @@ func (k Keeper) GetAndCheckGVGFamilyAvailableForNewBucket(ctx sdk.Context, famil
 	if storeSize >= k.MaxStoreSizePerFamily(ctx) {
 		return nil, types.ErrLimitationExceed.Wrapf("The storage size within the family exceeds the limit and can't serve more buckets.. Current: %d, now: %d", k.MaxStoreSizePerFamily(ctx), storeSize)
 	}
+
+	// check the free capacity reported by the sps of the family
+	if freeCapacity, reported := k.GetFreeCapacityOfFamily(ctx, gvgFamily); reported && freeCapacity == 0 {
+		return nil, types.ErrLimitationExceed.Wrapf("The storage providers within the family have no free capacity and can't serve more buckets.")
+	}
 	return gvgFamily, nil
 }
 
+// GetFreeCapacityOfFamily returns the minimal free capacity reported by the primary sp and the secondary sps of the family,
+// reported is false if none of them has reported its free capacity.
+func (k Keeper) GetFreeCapacityOfFamily(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) (freeCapacity uint64, reported bool) {
+	spIDs := []uint32{gvgFamily.PrimarySpId}
+	for _, gvgID := range gvgFamily.GlobalVirtualGroupIds {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			continue
+		}
+		spIDs = append(spIDs, gvg.SecondarySpIds...)
+	}
+
+	freeCapacity = math2.MaxUint64
+	for _, spID := range spIDs {
+		sp, found := k.spKeeper.GetStorageProvider(ctx, spID)
+		if !found || sp.FreeCapacity == nil {
+			continue
+		}
+		reported = true
+		if sp.FreeCapacity.Value < freeCapacity {
+			freeCapacity = sp.FreeCapacity.Value
+		}
+	}
+	if !reported {
+		return 0, false
+	}
+	return freeCapacity, true
+}
+
+// GetAvailableStoreSizeOfFamily returns the size the family can still serve, which is limited by the staking of its gvgs,
+// the max store size per family and the free capacity reported by its sps.
+func (k Keeper) GetAvailableStoreSizeOfFamily(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily) (uint64, error) {
+	totalStakingSize, stored, err := k.GetGlobalVirtualFamilyTotalStakingAndStoredSize(ctx, gvgFamily)
+	if err != nil {
+		return 0, err
+	}
+	limit := totalStakingSize
+	if maxStoreSize := k.MaxStoreSizePerFamily(ctx); maxStoreSize < limit {
+		limit = maxStoreSize
+	}
+	if stored >= limit {
+		return 0, nil
+	}
+	available := limit - stored
+	if freeCapacity, reported := k.GetFreeCapacityOfFamily(ctx, gvgFamily); reported && freeCapacity < available {
+		available = freeCapacity
+	}
+	return available, nil
+}
+
 func (k Keeper) GetOrCreateEmptyGVGFamily(ctx sdk.Context, familyID uint32, primarySPID uint32) (*types.GlobalVirtualGroupFamily, error) {
 	store := ctx.KVStore(k.storeKey)
 	var gvgFamily types.GlobalVirtualGroupFamily
@@ -377,6 +441,7 @@ func (k Keeper) SwapOutAsSecondarySP(ctx sdk.Context, secondarySP, successorSP *
 		panic("secondary sp found but the index is not correct when swap out as secondary sp")
 	}
 	gvg.SecondarySpIds[secondarySPIndex] = successorSP.Id
+	ctx.KVStore(k.storeKey).Delete(types.GetSecondarySPGVGIndexKey(secondarySP.Id, gvg.Id))
 	origin := k.MustGetGVGStatisticsWithinSP(ctx, secondarySP.Id)
 	successor, found := k.GetGVGStatisticsWithinSP(ctx, successorSP.Id)
 	if !found {
@@ -515,6 +580,26 @@ func (k Keeper) GetGlobalVirtualGroupIfAvailable(ctx sdk.Context, gvgID uint32,
 	return gvg, nil
 }
 
+// GetSwapOutInfo returns the pending swap out info of the gvg family, or of the gvg if no family is specified
+func (k Keeper) GetSwapOutInfo(ctx sdk.Context, gvgFamilyID uint32, gvgID uint32) (*types.SwapOutInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var key []byte
+	if gvgFamilyID != types.NoSpecifiedFamilyId {
+		key = types.GetSwapOutFamilyKey(gvgFamilyID)
+	} else {
+		key = types.GetSwapOutGVGKey(gvgID)
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, false
+	}
+
+	var swapOutInfo types.SwapOutInfo
+	k.cdc.MustUnmarshal(bz, &swapOutInfo)
+	return &swapOutInfo, true
+}
+
 func (k Keeper) SetSwapOutInfo(ctx sdk.Context, gvgFamilyID uint32, gvgIDs []uint32, spID uint32, successorSPID uint32) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -615,6 +700,7 @@ func (k Keeper) CompleteSwapOut(ctx sdk.Context, gvgFamilyID uint32, gvgIDs []ui
 			return err
 		}
 		store.Delete(key)
+		store.Delete(types.GetPrimarySPGVGFamilyIndexKey(sp.Id, gvgFamilyID))
 	} else {
 		for _, gvgID := range gvgIDs {
 			key := types.GetSwapOutGVGKey(gvgID)
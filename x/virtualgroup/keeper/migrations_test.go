@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"github.com/bnb-chain/greenfield/x/virtualgroup/keeper"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestMigrateV1toV2() {
+	store := s.ctx.KVStore(s.storeKey)
+
+	// the gvgs and families stored before the upgrade have no sp indexes
+	gvg := &types.GlobalVirtualGroup{Id: 1, FamilyId: 1, PrimarySpId: 1, SecondarySpIds: []uint32{2, 3}}
+	family := &types.GlobalVirtualGroupFamily{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{1}}
+	store.Set(types.GetGVGKey(gvg.Id), s.cdc.MustMarshal(gvg))
+	store.Set(types.GetGVGFamilyKey(family.Id), s.cdc.MustMarshal(family))
+
+	err := keeper.NewMigrator(*s.virtualgroupKeeper).MigrateV1toV2(s.ctx)
+	s.Require().NoError(err)
+
+	for _, spID := range gvg.SecondarySpIds {
+		s.Require().True(store.Has(types.GetSecondarySPGVGIndexKey(spID, gvg.Id)))
+	}
+	s.Require().False(store.Has(types.GetSecondarySPGVGIndexKey(gvg.PrimarySpId, gvg.Id)))
+	s.Require().True(store.Has(types.GetPrimarySPGVGFamilyIndexKey(family.PrimarySpId, family.Id)))
+	s.Require().False(store.Has(types.GetPrimarySPGVGFamilyIndexKey(2, family.Id)))
+}
@@ -53,23 +53,64 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.MsgCreateGlobalVirtualGroup) (*types.MsgCreateGlobalVirtualGroupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkSecondarySPCount(ctx, req.SecondarySpIds); err != nil {
+		return nil, err
+	}
+
+	sp, err := k.getServingPrimarySP(ctx, req.StorageProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.createGlobalVirtualGroup(ctx, sp, req.FamilyId, req.SecondarySpIds, req.Deposit); err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateGlobalVirtualGroupResponse{}, nil
+}
+
+func (k msgServer) CreateGlobalVirtualGroups(goCtx context.Context, req *types.MsgCreateGlobalVirtualGroups) (*types.MsgCreateGlobalVirtualGroupsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, creation := range req.GlobalVirtualGroups {
+		if err := k.checkSecondarySPCount(ctx, creation.SecondarySpIds); err != nil {
+			return nil, err
+		}
+	}
+
+	sp, err := k.getServingPrimarySP(ctx, req.StorageProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	gvgIDs := make([]uint32, 0, len(req.GlobalVirtualGroups))
+	for _, creation := range req.GlobalVirtualGroups {
+		gvg, err := k.createGlobalVirtualGroup(ctx, sp, creation.FamilyId, creation.SecondarySpIds, creation.Deposit)
+		if err != nil {
+			return nil, err
+		}
+		gvgIDs = append(gvgIDs, gvg.Id)
+	}
+	return &types.MsgCreateGlobalVirtualGroupsResponse{GlobalVirtualGroupIds: gvgIDs}, nil
+}
+
+func (k msgServer) checkSecondarySPCount(ctx sdk.Context, secondarySpIds []uint32) error {
 	if ctx.IsUpgraded(upgradetypes.Pampas) {
 		expectSecondarySPNum := int(k.storageKeeper.GetExpectSecondarySPNumForECObject(ctx, ctx.BlockTime().Unix()))
-		if len(req.GetSecondarySpIds()) != expectSecondarySPNum {
-			return nil, types.ErrInvalidSecondarySPCount.Wrapf("the number of secondary sp in the Global virtual group should be %d", expectSecondarySPNum)
+		if len(secondarySpIds) != expectSecondarySPNum {
+			return types.ErrInvalidSecondarySPCount.Wrapf("the number of secondary sp in the Global virtual group should be %d", expectSecondarySPNum)
 		}
-		spIdSet := make(map[uint32]struct{}, len(req.GetSecondarySpIds()))
-		for _, spId := range req.GetSecondarySpIds() {
+		spIdSet := make(map[uint32]struct{}, len(secondarySpIds))
+		for _, spId := range secondarySpIds {
 			if _, ok := spIdSet[spId]; ok {
-				return nil, types.ErrDuplicateSecondarySP.Wrapf("the SP(id=%d) is duplicate in the Global virtual group.", spId)
+				return types.ErrDuplicateSecondarySP.Wrapf("the SP(id=%d) is duplicate in the Global virtual group.", spId)
 			}
 			spIdSet[spId] = struct{}{}
 		}
 	}
+	return nil
+}
 
-	var gvgStatisticsWithinSPs []*types.GVGStatisticsWithinSP
-
-	spOperatorAddr := sdk.MustAccAddressFromHex(req.StorageProvider)
+func (k msgServer) getServingPrimarySP(ctx sdk.Context, operatorAddr string) (*sptypes.StorageProvider, error) {
+	spOperatorAddr := sdk.MustAccAddressFromHex(operatorAddr)
 
 	sp, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, spOperatorAddr)
 	if !found {
@@ -79,13 +120,18 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 	if !sp.IsInService() && !sp.IsInMaintenance() {
 		return nil, sptypes.ErrStorageProviderNotInService.Wrapf("sp is not in service or in maintenance, status: %s", sp.Status.String())
 	}
+	return sp, nil
+}
+
+func (k msgServer) createGlobalVirtualGroup(ctx sdk.Context, sp *sptypes.StorageProvider, familyID uint32, secondarySpIDs []uint32, deposit sdk.Coin) (*types.GlobalVirtualGroup, error) {
+	var gvgStatisticsWithinSPs []*types.GVGStatisticsWithinSP
 
 	stat := k.GetOrCreateGVGStatisticsWithinSP(ctx, sp.Id)
 	stat.PrimaryCount++
 	gvgStatisticsWithinSPs = append(gvgStatisticsWithinSPs, stat)
 
 	var secondarySpIds []uint32
-	for _, id := range req.SecondarySpIds {
+	for _, id := range secondarySpIDs {
 		ssp, found := k.spKeeper.GetStorageProvider(ctx, id)
 		if !found {
 			return nil, sdkerrors.Wrapf(sptypes.ErrStorageProviderNotFound, "secondary sp not found, ID: %d", id)
@@ -100,25 +146,14 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 		gvgStatisticsWithinSPs = append(gvgStatisticsWithinSPs, gvgStatisticsWithinSP)
 	}
 
-	gvgFamily, err := k.GetOrCreateEmptyGVGFamily(ctx, req.FamilyId, sp.Id)
+	gvgFamily, err := k.GetOrCreateEmptyGVGFamily(ctx, familyID, sp.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	if ctx.IsUpgraded(upgradetypes.Manchurian) {
-		for _, gvgID := range gvgFamily.GlobalVirtualGroupIds {
-			gvg, found := k.GetGVG(ctx, gvgID)
-			if !found {
-				return nil, types.ErrGVGNotExist
-			}
-			for i, secondarySPId := range gvg.SecondarySpIds {
-				if secondarySPId != secondarySpIds[i] {
-					break
-				}
-				if i == len(secondarySpIds)-1 {
-					return nil, types.ErrDuplicateGVG.Wrapf("the global virtual group family already has a GVG with same SP in same order")
-				}
-			}
+		if err := k.checkDuplicateGVGWithinFamily(ctx, gvgFamily, secondarySpIds); err != nil {
+			return nil, err
 		}
 	}
 
@@ -128,7 +163,7 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 	}
 
 	// deposit enough tokens for oncoming objects
-	coins := sdk.NewCoins(sdk.NewCoin(k.DepositDenomForGVG(ctx), req.Deposit.Amount))
+	coins := sdk.NewCoins(sdk.NewCoin(k.DepositDenomForGVG(ctx), deposit.Amount))
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromHex(sp.FundingAddress), types.ModuleName, coins)
 	if err != nil {
 		return nil, err
@@ -142,7 +177,7 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 		SecondarySpIds:        secondarySpIds,
 		StoredSize:            0,
 		VirtualPaymentAddress: k.DeriveVirtualPaymentAccount(types.GVGVirtualPaymentAccountName, gvgID).String(),
-		TotalDeposit:          req.Deposit.Amount,
+		TotalDeposit:          deposit.Amount,
 	}
 
 	gvgFamily.AppendGVG(gvg.Id)
@@ -162,7 +197,7 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 	}); err != nil {
 		return nil, err
 	}
-	if req.FamilyId == types.NoSpecifiedFamilyId {
+	if familyID == types.NoSpecifiedFamilyId {
 		if err := ctx.EventManager().EmitTypedEvents(&types.EventCreateGlobalVirtualGroupFamily{
 			Id:                    gvgFamily.Id,
 			PrimarySpId:           gvgFamily.PrimarySpId,
@@ -171,7 +206,7 @@ func (k msgServer) CreateGlobalVirtualGroup(goCtx context.Context, req *types.Ms
 			return nil, err
 		}
 	}
-	return &types.MsgCreateGlobalVirtualGroupResponse{}, nil
+	return gvg, nil
 }
 
 func (k msgServer) DeleteGlobalVirtualGroup(goCtx context.Context, req *types.MsgDeleteGlobalVirtualGroup) (*types.MsgDeleteGlobalVirtualGroupResponse, error) {
@@ -376,6 +411,26 @@ func (k msgServer) CompleteSwapOut(goCtx context.Context, msg *types.MsgComplete
 	return &types.MsgCompleteSwapOutResponse{}, nil
 }
 
+func (k msgServer) ForcedSwapOut(goCtx context.Context, msg *types.MsgForcedSwapOut) (*types.MsgForcedSwapOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromHex(msg.StorageProvider)
+	successorSP, found := k.spKeeper.GetStorageProviderByOperatorAddr(ctx, operatorAddr)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound.Wrapf("The address must be operator address of sp.")
+	}
+	if !successorSP.IsInService() {
+		return nil, sptypes.ErrStorageProviderNotInService.Wrapf("successor sp is not in service, status: %s", successorSP.Status.String())
+	}
+
+	err := k.Keeper.ForcedSwapOut(ctx, msg.GlobalVirtualGroupFamilyId, successorSP, msg.SecondarySpBlsSignatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForcedSwapOutResponse{}, nil
+}
+
 func (k msgServer) Settle(goCtx context.Context, req *types.MsgSettle) (*types.MsgSettleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -463,6 +518,7 @@ func (k msgServer) StorageProviderExit(goCtx context.Context, msg *types.MsgStor
 	sp.Status = sptypes.STATUS_GRACEFUL_EXITING
 
 	k.spKeeper.SetStorageProvider(ctx, sp)
+	k.SetSPExitTime(ctx, sp.Id, ctx.BlockTime().Unix())
 
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventStorageProviderExit{
 		StorageProviderId: sp.Id,
@@ -504,6 +560,7 @@ func (k msgServer) CompleteStorageProviderExit(goCtx context.Context, msg *types
 	if err != nil {
 		return nil, err
 	}
+	k.DeleteSPExitTime(ctx, sp.Id)
 	if err := ctx.EventManager().EmitTypedEvents(&types.EventCompleteStorageProviderExit{
 		StorageProviderId: sp.Id,
 		OperatorAddress:   sp.OperatorAddress,
@@ -513,3 +570,18 @@ func (k msgServer) CompleteStorageProviderExit(goCtx context.Context, msg *types
 	}
 	return &types.MsgCompleteStorageProviderExitResponse{}, nil
 }
+
+func (k msgServer) RebalanceGlobalVirtualGroups(goCtx context.Context, msg *types.MsgRebalanceGlobalVirtualGroups) (*types.MsgRebalanceGlobalVirtualGroupsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sp, err := k.getServingPrimarySP(ctx, msg.StorageProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	dstFamily, err := k.RebalanceGVGs(ctx, sp, msg.SrcGlobalVirtualGroupFamilyId, msg.DstGlobalVirtualGroupFamilyId, msg.GlobalVirtualGroupIds, msg.BucketIds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRebalanceGlobalVirtualGroupsResponse{DstGlobalVirtualGroupFamilyId: dstFamily.Id}, nil
+}
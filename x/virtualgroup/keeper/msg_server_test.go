@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/keeper"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestCreateGlobalVirtualGroups() {
+	operatorAddr := sample.RandAccAddress()
+	primarySP := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE, OperatorAddress: operatorAddr.String(), FundingAddress: sample.RandAccAddress().String()}
+	sps := map[uint32]*sptypes.StorageProvider{
+		1: primarySP,
+		2: {Id: 2, Status: sptypes.STATUS_IN_SERVICE},
+		3: {Id: 3, Status: sptypes.STATUS_IN_MAINTENANCE},
+	}
+	s.spKeeper.EXPECT().GetStorageProviderByOperatorAddr(gomock.Any(), operatorAddr).Return(primarySP, true).AnyTimes()
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, id uint32) (*sptypes.StorageProvider, bool) {
+			sp, found := sps[id]
+			return sp, found
+		}).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+
+	msgServer := keeper.NewMsgServerImpl(*s.virtualgroupKeeper)
+	deposit := sdk.NewCoin(types.DefaultParams().DepositDenom, math.NewInt(1))
+
+	// the secondary sp does not exist
+	_, err := msgServer.CreateGlobalVirtualGroups(s.ctx, types.NewMsgCreateGlobalVirtualGroups(operatorAddr, []types.GlobalVirtualGroupCreation{
+		{FamilyId: types.NoSpecifiedFamilyId, SecondarySpIds: []uint32{4}, Deposit: deposit},
+	}))
+	s.Require().ErrorIs(err, sptypes.ErrStorageProviderNotFound)
+
+	res, err := msgServer.CreateGlobalVirtualGroups(s.ctx, types.NewMsgCreateGlobalVirtualGroups(operatorAddr, []types.GlobalVirtualGroupCreation{
+		{FamilyId: types.NoSpecifiedFamilyId, SecondarySpIds: []uint32{2}, Deposit: deposit},
+		{FamilyId: types.NoSpecifiedFamilyId, SecondarySpIds: []uint32{3}, Deposit: deposit},
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(res.GlobalVirtualGroupIds, 2)
+
+	gvg, found := s.virtualgroupKeeper.GetGVG(s.ctx, res.GlobalVirtualGroupIds[0])
+	s.Require().True(found)
+
+	// add another gvg into the family of the first one
+	res, err = msgServer.CreateGlobalVirtualGroups(s.ctx, types.NewMsgCreateGlobalVirtualGroups(operatorAddr, []types.GlobalVirtualGroupCreation{
+		{FamilyId: gvg.FamilyId, SecondarySpIds: []uint32{3}, Deposit: deposit},
+	}))
+	s.Require().NoError(err)
+	family, found := s.virtualgroupKeeper.GetGVGFamily(s.ctx, gvg.FamilyId)
+	s.Require().True(found)
+	s.Require().Equal([]uint32{gvg.Id, res.GlobalVirtualGroupIds[0]}, family.GlobalVirtualGroupIds)
+
+	stat, found := s.virtualgroupKeeper.GetGVGStatisticsWithinSP(s.ctx, primarySP.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint32(3), stat.PrimaryCount)
+	stat, found = s.virtualgroupKeeper.GetGVGStatisticsWithinSP(s.ctx, 3)
+	s.Require().True(found)
+	s.Require().Equal(uint32(2), stat.SecondaryCount)
+}
@@ -27,6 +27,11 @@ func (k Keeper) MaxStoreSizePerFamily(ctx sdk.Context) (res uint64) {
 	return params.MaxStoreSizePerFamily
 }
 
+func (k Keeper) ForcedSwapOutTimeout(ctx sdk.Context) (res uint64) {
+	params := k.GetParams(ctx)
+	return params.ForcedSwapOutTimeout
+}
+
 // GetParams returns the current sp module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -27,6 +27,7 @@ type TestSuite struct {
 	suite.Suite
 
 	cdc                codec.Codec
+	storeKey           *storetypes.KVStoreKey
 	virtualgroupKeeper *keeper.Keeper
 
 	bankKeeper    *types.MockBankKeeper
@@ -62,6 +63,7 @@ func (s *TestSuite) SetupTest() {
 	)
 
 	s.cdc = encCfg.Codec
+	s.storeKey = key
 	s.bankKeeper = bankKeeper
 	s.accountKeeper = accountKeeper
 	s.spKeeper = spKeeper
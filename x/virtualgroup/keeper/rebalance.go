@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// checkDuplicateGVGWithinFamily checks the family has no gvg with the same secondary sps in the same order
+func (k Keeper) checkDuplicateGVGWithinFamily(ctx sdk.Context, gvgFamily *types.GlobalVirtualGroupFamily, secondarySpIds []uint32) error {
+	for _, gvgID := range gvgFamily.GlobalVirtualGroupIds {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return types.ErrGVGNotExist
+		}
+		for i, secondarySPId := range gvg.SecondarySpIds {
+			if secondarySPId != secondarySpIds[i] {
+				break
+			}
+			if i == len(secondarySpIds)-1 {
+				return types.ErrDuplicateGVG.Wrapf("the global virtual group family already has a GVG with same SP in same order")
+			}
+		}
+	}
+	return nil
+}
+
+// RebalanceGVGs moves the gvgs of the primary sp from the source family into the destination family, a new family
+// is created if the destination family is not specified. The objects stored in a gvg are bound to the buckets served
+// by its family, so a gvg storing objects is moved along with the given buckets, which are rebound to the destination
+// family. The buckets should be all the buckets storing objects in the moved gvgs, and their objects should be stored
+// in the moved gvgs only. A bucket may still use a moved gvg for its empty objects without being rebound, since they
+// store nothing in it.
+func (k Keeper) RebalanceGVGs(ctx sdk.Context, primarySp *sptypes.StorageProvider, srcFamilyID, dstFamilyID uint32, gvgIDs []uint32, bucketIDs []sdkmath.Uint) (*types.GlobalVirtualGroupFamily, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	srcFamily, found := k.GetGVGFamily(ctx, srcFamilyID)
+	if !found {
+		return nil, types.ErrGVGFamilyNotExist
+	}
+	if srcFamily.PrimarySpId != primarySp.Id {
+		return nil, types.ErrRebalanceFailed.Wrapf("the family(ID: %d) is not owned by primary sp(ID: %d)", srcFamily.Id, primarySp.Id)
+	}
+
+	dstFamily, err := k.GetOrCreateEmptyGVGFamily(ctx, dstFamilyID, primarySp.Id)
+	if err != nil {
+		return nil, err
+	}
+	if dstFamily.PrimarySpId != primarySp.Id {
+		return nil, types.ErrRebalanceFailed.Wrapf("the family(ID: %d) is not owned by primary sp(ID: %d)", dstFamily.Id, primarySp.Id)
+	}
+
+	for _, family := range []*types.GlobalVirtualGroupFamily{srcFamily, dstFamily} {
+		if store.Has(types.GetSwapOutFamilyKey(family.Id)) {
+			return nil, types.ErrRebalanceFailed.Wrapf("the family(ID: %d) is swapping out", family.Id)
+		}
+	}
+
+	if k.MaxGlobalVirtualGroupNumPerFamily(ctx) < uint32(len(dstFamily.GlobalVirtualGroupIds)+len(gvgIDs)) {
+		return nil, types.ErrLimitationExceed.Wrapf("The gvg number within the family(ID: %d) exceeds the limit.", dstFamily.Id)
+	}
+
+	// the destination family should still be able to serve more buckets
+	storeSize := k.GetStoreSizeOfFamily(ctx, dstFamily)
+	if storeSize >= k.MaxStoreSizePerFamily(ctx) {
+		return nil, types.ErrLimitationExceed.Wrapf("The storage size within the family(ID: %d) exceeds the limit. Current: %d, now: %d", dstFamily.Id, k.MaxStoreSizePerFamily(ctx), storeSize)
+	}
+
+	gvgs := make([]*types.GlobalVirtualGroup, 0, len(gvgIDs))
+	movedStoredSize := uint64(0)
+	for _, gvgID := range gvgIDs {
+		gvg, found := k.GetGVG(ctx, gvgID)
+		if !found {
+			return nil, types.ErrGVGNotExist
+		}
+		if gvg.FamilyId != srcFamily.Id {
+			return nil, types.ErrGVGNotExistInFamily.Wrapf("the gvg(ID: %d) is not in the family(ID: %d)", gvg.Id, srcFamily.Id)
+		}
+		// the virtual payment account of a gvg storing objects is still charged by the buckets moved along with it
+		if gvg.StoredSize == 0 && !k.paymentKeeper.IsEmptyNetFlow(ctx, sdk.MustAccAddressFromHex(gvg.VirtualPaymentAddress)) {
+			return nil, types.ErrGVGNotEmpty.Wrapf("the virtual payment account of gvg(ID: %d) is still not empty", gvg.Id)
+		}
+		if store.Has(types.GetSwapOutGVGKey(gvg.Id)) {
+			return nil, types.ErrRebalanceFailed.Wrapf("the gvg(ID: %d) is swapping out", gvg.Id)
+		}
+		if ctx.IsUpgraded(upgradetypes.Manchurian) {
+			if err := k.checkDuplicateGVGWithinFamily(ctx, dstFamily, gvg.SecondarySpIds); err != nil {
+				return nil, err
+			}
+		}
+
+		srcFamily.MustRemoveGVG(gvg.Id)
+		dstFamily.AppendGVG(gvg.Id)
+		gvg.FamilyId = dstFamily.Id
+		gvgs = append(gvgs, gvg)
+		movedStoredSize += gvg.StoredSize
+	}
+
+	// the destination family should be able to hold the objects moved into it
+	if storeSize+movedStoredSize > k.MaxStoreSizePerFamily(ctx) {
+		return nil, types.ErrLimitationExceed.Wrapf("The storage size within the family(ID: %d) exceeds the limit. Current: %d, now: %d, moved: %d",
+			dstFamily.Id, k.MaxStoreSizePerFamily(ctx), storeSize, movedStoredSize)
+	}
+
+	// the gvgs are still served by the same sps, so the GVGStatisticsWithinSP of the sps are unchanged
+	for _, gvg := range gvgs {
+		if err := k.SetGVGAndEmitUpdateEvent(ctx, gvg); err != nil {
+			return nil, err
+		}
+	}
+
+	// the buckets storing objects in the moved gvgs are rebound to the destination family
+	reboundSizes := make(map[uint32]uint64)
+	for _, bucketID := range bucketIDs {
+		storedSizes, err := k.storageKeeper.RebindBucketToFamily(ctx, sdk.MustAccAddressFromHex(primarySp.OperatorAddress), bucketID, srcFamily.Id, dstFamily.Id)
+		if err != nil {
+			return nil, err
+		}
+		for gvgID, size := range storedSizes {
+			reboundSizes[gvgID] += size
+		}
+	}
+	for _, gvg := range gvgs {
+		if gvg.StoredSize != reboundSizes[gvg.Id] {
+			return nil, types.ErrRebalanceFailed.Wrapf("the gvg(ID: %d) stores objects of the buckets not rebound, stored: %d, rebound: %d",
+				gvg.Id, gvg.StoredSize, reboundSizes[gvg.Id])
+		}
+	}
+
+	if dstFamilyID == types.NoSpecifiedFamilyId {
+		if err := ctx.EventManager().EmitTypedEvents(&types.EventCreateGlobalVirtualGroupFamily{
+			Id:                    dstFamily.Id,
+			PrimarySpId:           dstFamily.PrimarySpId,
+			VirtualPaymentAddress: dstFamily.VirtualPaymentAddress,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.SetGVGFamilyAndEmitUpdateEvent(ctx, srcFamily); err != nil {
+		return nil, err
+	}
+	if err := k.SetGVGFamilyAndEmitUpdateEvent(ctx, dstFamily); err != nil {
+		return nil, err
+	}
+	return dstFamily, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestRebalanceGVGs() {
+	primarySP := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_IN_SERVICE, OperatorAddress: sample.RandAccAddress().String(), FundingAddress: sample.RandAccAddress().String()}
+	storageKeeper := types.NewMockStorageKeeper(gomock.NewController(s.T()))
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+	s.paymentKeeper.EXPECT().IsEmptyNetFlow(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	// gvg 1 stores objects, gvg 2 and gvg 3 are empty
+	for _, gvg := range []*types.GlobalVirtualGroup{
+		{Id: 1, FamilyId: 1, PrimarySpId: primarySP.Id, SecondarySpIds: []uint32{2}, StoredSize: 100},
+		{Id: 2, FamilyId: 1, PrimarySpId: primarySP.Id, SecondarySpIds: []uint32{3}},
+		{Id: 3, FamilyId: 1, PrimarySpId: primarySP.Id, SecondarySpIds: []uint32{4}},
+	} {
+		gvg.TotalDeposit = math.ZeroInt()
+		gvg.VirtualPaymentAddress = sample.RandAccAddress().String()
+		s.virtualgroupKeeper.SetGVG(s.ctx, gvg)
+	}
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{
+		Id:                    1,
+		PrimarySpId:           primarySP.Id,
+		GlobalVirtualGroupIds: []uint32{1, 2, 3},
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	})
+	s.virtualgroupKeeper.SetGVGFamily(s.ctx, &types.GlobalVirtualGroupFamily{
+		Id:                    2,
+		PrimarySpId:           5,
+		VirtualPaymentAddress: sample.RandAccAddress().String(),
+	})
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, &types.GVGStatisticsWithinSP{StorageProviderId: primarySP.Id, PrimaryCount: 3})
+
+	// the destination family is owned by another sp
+	_, err := s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, 1, 2, []uint32{2}, nil)
+	s.Require().ErrorIs(err, types.ErrRebalanceFailed)
+
+	// the gvg stores objects of the buckets not rebound
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(cacheCtx, primarySP, 1, types.NoSpecifiedFamilyId, []uint32{1}, nil)
+	s.Require().ErrorIs(err, types.ErrRebalanceFailed)
+
+	// the source family is swapping out
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 1, nil, primarySP.Id, 6))
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, 1, types.NoSpecifiedFamilyId, []uint32{2}, nil)
+	s.Require().ErrorIs(err, types.ErrRebalanceFailed)
+	s.Require().NoError(s.virtualgroupKeeper.DeleteSwapOutInfo(s.ctx, 1, nil, primarySP.Id))
+
+	// move the empty gvg into a new family
+	dstFamily, err := s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, 1, types.NoSpecifiedFamilyId, []uint32{2}, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(primarySP.Id, dstFamily.PrimarySpId)
+	s.Require().Equal([]uint32{2}, dstFamily.GlobalVirtualGroupIds)
+
+	// move another empty gvg into the existing family
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, 1, dstFamily.Id, []uint32{3}, nil)
+	s.Require().NoError(err)
+
+	srcFamily, found := s.virtualgroupKeeper.GetGVGFamily(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal([]uint32{1}, srcFamily.GlobalVirtualGroupIds)
+	dstFamily, found = s.virtualgroupKeeper.GetGVGFamily(s.ctx, dstFamily.Id)
+	s.Require().True(found)
+	s.Require().Equal([]uint32{2, 3}, dstFamily.GlobalVirtualGroupIds)
+	gvg, found := s.virtualgroupKeeper.GetGVG(s.ctx, 3)
+	s.Require().True(found)
+	s.Require().Equal(dstFamily.Id, gvg.FamilyId)
+
+	// the gvg is not in the source family any more
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, 1, dstFamily.Id, []uint32{3}, nil)
+	s.Require().ErrorIs(err, types.ErrGVGNotExistInFamily)
+
+	// the number of gvgs within a family is limited
+	params := s.virtualgroupKeeper.GetParams(s.ctx)
+	params.MaxGlobalVirtualGroupNumPerFamily = 2
+	s.Require().NoError(s.virtualgroupKeeper.SetParams(s.ctx, params))
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, dstFamily.Id, 1, []uint32{2}, nil)
+	s.Require().NoError(err)
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, dstFamily.Id, 1, []uint32{3}, nil)
+	s.Require().ErrorIs(err, types.ErrLimitationExceed)
+
+	// only part of the objects stored in the gvg belong to the rebound buckets
+	storageKeeper.EXPECT().RebindBucketToFamily(gomock.Any(), gomock.Any(), math.NewUint(1), uint32(1), dstFamily.Id).
+		Return(map[uint32]uint64{1: 60}, nil)
+	cacheCtx, _ = s.ctx.CacheContext()
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(cacheCtx, primarySP, 1, dstFamily.Id, []uint32{1}, []math.Uint{math.NewUint(1)})
+	s.Require().ErrorIs(err, types.ErrRebalanceFailed)
+
+	// move the gvg storing objects along with the buckets storing objects in it
+	storageKeeper.EXPECT().RebindBucketToFamily(gomock.Any(), gomock.Any(), math.NewUint(1), uint32(1), dstFamily.Id).
+		Return(map[uint32]uint64{1: 60}, nil)
+	storageKeeper.EXPECT().RebindBucketToFamily(gomock.Any(), gomock.Any(), math.NewUint(2), uint32(1), dstFamily.Id).
+		Return(map[uint32]uint64{1: 40}, nil)
+	_, err = s.virtualgroupKeeper.RebalanceGVGs(s.ctx, primarySP, 1, dstFamily.Id, []uint32{1}, []math.Uint{math.NewUint(1), math.NewUint(2)})
+	s.Require().NoError(err)
+	gvg, found = s.virtualgroupKeeper.GetGVG(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(dstFamily.Id, gvg.FamilyId)
+	dstFamily, found = s.virtualgroupKeeper.GetGVGFamily(s.ctx, dstFamily.Id)
+	s.Require().True(found)
+	s.Require().Equal([]uint32{3, 1}, dstFamily.GlobalVirtualGroupIds)
+
+	stat, found := s.virtualgroupKeeper.GetGVGStatisticsWithinSP(s.ctx, primarySP.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint32(3), stat.PrimaryCount)
+}
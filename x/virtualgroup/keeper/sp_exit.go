@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bnb-chain/greenfield/internal/sequence"
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+const (
+	SPExitCheckStatus          = "status"
+	SPExitCheckPrimaryFamilies = "primary_families"
+	SPExitCheckSecondaryGVGs   = "secondary_gvgs"
+	SPExitCheckBucketMigration = "bucket_migrations"
+)
+
+// GetSwapOutInfosOfSP returns the families and the gvgs which the sp is swapping out from
+func (k Keeper) GetSwapOutInfosOfSP(ctx sdk.Context, spID uint32) (familyIDs, gvgIDs []uint32) {
+	store := ctx.KVStore(k.storeKey)
+	collect := func(keyPrefix []byte) []uint32 {
+		var uint32Seq sequence.Sequence[uint32]
+		ids := make([]uint32, 0)
+		iterator := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var swapOutInfo types.SwapOutInfo
+			k.cdc.MustUnmarshal(iterator.Value(), &swapOutInfo)
+			if swapOutInfo.SpId == spID {
+				ids = append(ids, uint32Seq.DecodeSequence(iterator.Key()))
+			}
+		}
+		return ids
+	}
+	return collect(types.SwapOutFamilyKey), collect(types.SwapOutGVGKey)
+}
+
+func (k Keeper) SPExitProgress(goCtx context.Context, req *types.QuerySPExitProgressRequest) (*types.QuerySPExitProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sp, found := k.spKeeper.GetStorageProvider(ctx, req.StorageProviderId)
+	if !found {
+		return nil, sptypes.ErrStorageProviderNotFound
+	}
+
+	res := &types.QuerySPExitProgressResponse{}
+	res.ExitTime, _ = k.GetSPExitTime(ctx, sp.Id)
+	if stat, found := k.GetGVGStatisticsWithinSP(ctx, sp.Id); found {
+		res.PrimaryCount = stat.PrimaryCount
+		res.SecondaryCount = stat.SecondaryCount
+	}
+	res.SwappingOutFamilyIds, res.SwappingOutGvgIds = k.GetSwapOutInfosOfSP(ctx, sp.Id)
+	if k.storageKeeper != nil {
+		res.MigratingOutBucketIds, res.MigratingInBucketIds = k.storageKeeper.GetMigrationBucketIDsOfSP(ctx, sp.Id)
+	}
+
+	res.Checklist = []types.SPExitCheckItem{
+		{
+			Name:   SPExitCheckStatus,
+			Passed: sp.Status == sptypes.STATUS_GRACEFUL_EXITING,
+			Detail: fmt.Sprintf("sp status: %s", sp.Status.String()),
+		},
+		{
+			Name:   SPExitCheckPrimaryFamilies,
+			Passed: res.PrimaryCount == 0,
+			Detail: fmt.Sprintf("%d gvgs left as primary sp, %d families swapping out", res.PrimaryCount, len(res.SwappingOutFamilyIds)),
+		},
+		{
+			Name:   SPExitCheckSecondaryGVGs,
+			Passed: res.SecondaryCount == 0,
+			Detail: fmt.Sprintf("%d gvgs left as secondary sp, %d gvgs swapping out", res.SecondaryCount, len(res.SwappingOutGvgIds)),
+		},
+		{
+			Name:   SPExitCheckBucketMigration,
+			Passed: len(res.MigratingOutBucketIds) == 0 && len(res.MigratingInBucketIds) == 0,
+			Detail: fmt.Sprintf("%d buckets migrating away, %d buckets migrating in", len(res.MigratingOutBucketIds), len(res.MigratingInBucketIds)),
+		},
+	}
+	// the sp can only complete its exit when all the checks are passed, including the ones not checked by
+	// StorageProviderExitable such as the bucket migrations
+	res.Exitable = true
+	for _, item := range res.Checklist {
+		res.Exitable = res.Exitable && item.Passed
+	}
+	return res, nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/keeper"
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func (s *TestSuite) TestSPExitProgress() {
+	storageKeeper := types.NewMockStorageKeeper(gomock.NewController(s.T()))
+	s.virtualgroupKeeper.SetStorageKeeper(storageKeeper)
+
+	sp := &sptypes.StorageProvider{Id: 1, Status: sptypes.STATUS_GRACEFUL_EXITING}
+	s.spKeeper.EXPECT().GetStorageProvider(gomock.Any(), sp.Id).Return(sp, true).AnyTimes()
+
+	s.virtualgroupKeeper.SetSPExitTime(s.ctx, sp.Id, 100)
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, &types.GVGStatisticsWithinSP{StorageProviderId: sp.Id, PrimaryCount: 2, SecondaryCount: 1})
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 3, nil, sp.Id, 2))
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 0, []uint32{5, 6}, 4, 2))
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 0, []uint32{7}, sp.Id, 2))
+	storageKeeper.EXPECT().GetMigrationBucketIDsOfSP(gomock.Any(), sp.Id).
+		Return([]sdkmath.Uint{sdkmath.NewUint(10)}, nil)
+
+	res, err := s.virtualgroupKeeper.SPExitProgress(s.ctx, &types.QuerySPExitProgressRequest{StorageProviderId: sp.Id})
+	s.Require().NoError(err)
+	s.Require().False(res.Exitable)
+	s.Require().Equal(int64(100), res.ExitTime)
+	s.Require().Equal(uint32(2), res.PrimaryCount)
+	s.Require().Equal(uint32(1), res.SecondaryCount)
+	s.Require().Equal([]uint32{3}, res.SwappingOutFamilyIds)
+	s.Require().Equal([]uint32{7}, res.SwappingOutGvgIds)
+	s.Require().Equal([]sdkmath.Uint{sdkmath.NewUint(10)}, res.MigratingOutBucketIds)
+	s.Require().Empty(res.MigratingInBucketIds)
+
+	passed := make(map[string]bool)
+	for _, item := range res.Checklist {
+		passed[item.Name] = item.Passed
+	}
+	s.Require().Equal(map[string]bool{
+		keeper.SPExitCheckStatus:          true,
+		keeper.SPExitCheckPrimaryFamilies: false,
+		keeper.SPExitCheckSecondaryGVGs:   false,
+		keeper.SPExitCheckBucketMigration: false,
+	}, passed)
+
+	// all the families and gvgs are swapped out, but a bucket is still migrating to the sp
+	s.virtualgroupKeeper.SetGVGStatisticsWithSP(s.ctx, &types.GVGStatisticsWithinSP{StorageProviderId: sp.Id})
+	storageKeeper.EXPECT().GetMigrationBucketIDsOfSP(gomock.Any(), sp.Id).
+		Return(nil, []sdkmath.Uint{sdkmath.NewUint(11)})
+	res, err = s.virtualgroupKeeper.SPExitProgress(s.ctx, &types.QuerySPExitProgressRequest{StorageProviderId: sp.Id})
+	s.Require().NoError(err)
+	s.Require().False(res.Exitable)
+
+	// the bucket migration is completed
+	storageKeeper.EXPECT().GetMigrationBucketIDsOfSP(gomock.Any(), sp.Id).Return(nil, nil)
+	res, err = s.virtualgroupKeeper.SPExitProgress(s.ctx, &types.QuerySPExitProgressRequest{StorageProviderId: sp.Id})
+	s.Require().NoError(err)
+	s.Require().True(res.Exitable)
+}
+
+func (s *TestSuite) TestGetSwapOutInfo() {
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, 3, nil, 1, 2))
+	s.Require().NoError(s.virtualgroupKeeper.SetSwapOutInfo(s.ctx, types.NoSpecifiedFamilyId, []uint32{7}, 4, 5))
+
+	info, found := s.virtualgroupKeeper.GetSwapOutInfo(s.ctx, 3, 7)
+	s.Require().True(found)
+	s.Require().Equal(types.SwapOutInfo{SpId: 1, SuccessorSpId: 2}, *info)
+
+	info, found = s.virtualgroupKeeper.GetSwapOutInfo(s.ctx, types.NoSpecifiedFamilyId, 7)
+	s.Require().True(found)
+	s.Require().Equal(types.SwapOutInfo{SpId: 4, SuccessorSpId: 5}, *info)
+
+	_, found = s.virtualgroupKeeper.GetSwapOutInfo(s.ctx, 4, 7)
+	s.Require().False(found)
+	_, found = s.virtualgroupKeeper.GetSwapOutInfo(s.ctx, types.NoSpecifiedFamilyId, 8)
+	s.Require().False(found)
+}
@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+// MigrateStore builds the secondary sp index of the existing global virtual groups and the primary sp index of the
+// existing global virtual group families, which are only maintained for the ones stored after the upgrade.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// collect the index keys first, the store should not be written while being iterated
+	var indexKeys [][]byte
+
+	gvgIterator := prefix.NewStore(store, types.GVGKey).Iterator(nil, nil)
+	for ; gvgIterator.Valid(); gvgIterator.Next() {
+		var gvg types.GlobalVirtualGroup
+		cdc.MustUnmarshal(gvgIterator.Value(), &gvg)
+		for _, spID := range gvg.SecondarySpIds {
+			indexKeys = append(indexKeys, types.GetSecondarySPGVGIndexKey(spID, gvg.Id))
+		}
+	}
+	gvgIterator.Close()
+
+	familyIterator := prefix.NewStore(store, types.GVGFamilyKey).Iterator(nil, nil)
+	for ; familyIterator.Valid(); familyIterator.Next() {
+		var family types.GlobalVirtualGroupFamily
+		cdc.MustUnmarshal(familyIterator.Value(), &family)
+		indexKeys = append(indexKeys, types.GetPrimarySPGVGFamilyIndexKey(family.PrimarySpId, family.Id))
+	}
+	familyIterator.Close()
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCompleteStorageProviderExit{}, "virtualgroup/CompleteStorageProviderExit", nil)
 	cdc.RegisterConcrete(&MsgCompleteSwapOut{}, "virtualgroup/CompleteSwapOut", nil)
 	cdc.RegisterConcrete(&MsgCancelSwapOut{}, "virtualgroup/CancelSwapOut", nil)
+	cdc.RegisterConcrete(&MsgForcedSwapOut{}, "virtualgroup/ForcedSwapOut", nil)
+	cdc.RegisterConcrete(&MsgCreateGlobalVirtualGroups{}, "virtualgroup/CreateGlobalVirtualGroups", nil)
+	cdc.RegisterConcrete(&MsgRebalanceGlobalVirtualGroups{}, "virtualgroup/RebalanceGlobalVirtualGroups", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -48,6 +51,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelSwapOut{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgForcedSwapOut{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGlobalVirtualGroups{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRebalanceGlobalVirtualGroups{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -23,6 +23,8 @@ var (
 	ErrDuplicateSecondarySP    = errors.Register(ModuleName, 1124, "the global virtual group has duplicate secondary sp.")
 	ErrInsufficientStaking     = errors.Register(ModuleName, 1125, "insufficient staking for gvg")
 	ErrDuplicateGVG            = errors.Register(ModuleName, 1126, "global virtual group is duplicate")
+	ErrInvalidBlsSignature     = errors.Register(ModuleName, 1127, "invalid bls signature")
+	ErrRebalanceFailed         = errors.Register(ModuleName, 1128, "rebalance global virtual groups failed.")
 
 	ErrInvalidDenom = errors.Register(ModuleName, 2000, "Invalid denom.")
 )
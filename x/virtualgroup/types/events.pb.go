@@ -830,6 +830,69 @@ func (m *EventCancelSwapOut) GetSuccessorSpId() uint32 {
 	return 0
 }
 
+type EventForcedSwapOut struct {
+	// The id of the storage provider who takes over the family
+	StorageProviderId uint32 `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// The id of the jailed or exiting storage provider
+	SrcStorageProviderId uint32 `protobuf:"varint,2,opt,name=src_storage_provider_id,json=srcStorageProviderId,proto3" json:"src_storage_provider_id,omitempty"`
+	// The id of the gvg family
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,3,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+}
+
+func (m *EventForcedSwapOut) Reset()         { *m = EventForcedSwapOut{} }
+func (m *EventForcedSwapOut) String() string { return proto.CompactTextString(m) }
+func (*EventForcedSwapOut) ProtoMessage()    {}
+func (*EventForcedSwapOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ece39ea12016bd5b, []int{12}
+}
+func (m *EventForcedSwapOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForcedSwapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForcedSwapOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForcedSwapOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForcedSwapOut.Merge(m, src)
+}
+func (m *EventForcedSwapOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForcedSwapOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForcedSwapOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForcedSwapOut proto.InternalMessageInfo
+
+func (m *EventForcedSwapOut) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *EventForcedSwapOut) GetSrcStorageProviderId() uint32 {
+	if m != nil {
+		return m.SrcStorageProviderId
+	}
+	return 0
+}
+
+func (m *EventForcedSwapOut) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
 type EventStorageProviderExit struct {
 	// The id of the storage provider who wants to exit
 	StorageProviderId uint32 `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
@@ -841,7 +904,7 @@ func (m *EventStorageProviderExit) Reset()         { *m = EventStorageProviderEx
 func (m *EventStorageProviderExit) String() string { return proto.CompactTextString(m) }
 func (*EventStorageProviderExit) ProtoMessage()    {}
 func (*EventStorageProviderExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ece39ea12016bd5b, []int{12}
+	return fileDescriptor_ece39ea12016bd5b, []int{13}
 }
 func (m *EventStorageProviderExit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +960,7 @@ func (m *EventCompleteStorageProviderExit) Reset()         { *m = EventCompleteS
 func (m *EventCompleteStorageProviderExit) String() string { return proto.CompactTextString(m) }
 func (*EventCompleteStorageProviderExit) ProtoMessage()    {}
 func (*EventCompleteStorageProviderExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ece39ea12016bd5b, []int{13}
+	return fileDescriptor_ece39ea12016bd5b, []int{14}
 }
 func (m *EventCompleteStorageProviderExit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,6 +1016,7 @@ func init() {
 	proto.RegisterType((*EventSwapOut)(nil), "greenfield.virtualgroup.EventSwapOut")
 	proto.RegisterType((*EventCompleteSwapOut)(nil), "greenfield.virtualgroup.EventCompleteSwapOut")
 	proto.RegisterType((*EventCancelSwapOut)(nil), "greenfield.virtualgroup.EventCancelSwapOut")
+	proto.RegisterType((*EventForcedSwapOut)(nil), "greenfield.virtualgroup.EventForcedSwapOut")
 	proto.RegisterType((*EventStorageProviderExit)(nil), "greenfield.virtualgroup.EventStorageProviderExit")
 	proto.RegisterType((*EventCompleteStorageProviderExit)(nil), "greenfield.virtualgroup.EventCompleteStorageProviderExit")
 }
@@ -962,56 +1026,57 @@ func init() {
 }
 
 var fileDescriptor_ece39ea12016bd5b = []byte{
-	// 778 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0xb6, 0x85, 0x2f, 0x1d, 0x28, 0xf0, 0x5d, 0x4b, 0xba, 0x82, 0x94, 0xa6, 0x1a, 0xd2,
-	0x4b, 0xdb, 0x83, 0x12, 0x3d, 0x78, 0xb1, 0x80, 0xa4, 0x89, 0x51, 0xd2, 0x06, 0x13, 0xbd, 0x6c,
-	0xb6, 0x3b, 0xc3, 0x32, 0x61, 0xbb, 0xb3, 0x99, 0x99, 0x22, 0xe5, 0x9f, 0xd0, 0xf8, 0xb7, 0xf0,
-	0x07, 0x78, 0xe4, 0x48, 0x38, 0x19, 0x0f, 0xc4, 0x50, 0x2f, 0xde, 0x3c, 0x7b, 0xd1, 0xec, 0xcc,
-	0xb4, 0xb4, 0xf4, 0x07, 0x58, 0xd4, 0xa8, 0xa7, 0x76, 0xdf, 0xbc, 0x79, 0xef, 0x7d, 0x3e, 0xef,
-	0x33, 0x6f, 0x06, 0xdc, 0x71, 0x28, 0x42, 0xde, 0x36, 0x46, 0x2e, 0x2c, 0xec, 0x61, 0xca, 0xeb,
-	0x96, 0xeb, 0x50, 0x52, 0xf7, 0x0b, 0x68, 0x0f, 0x79, 0x9c, 0xe5, 0x7d, 0x4a, 0x38, 0xd1, 0x93,
-	0xe7, 0x5e, 0xf9, 0x4e, 0xaf, 0xf9, 0x9b, 0x36, 0x61, 0x35, 0xc2, 0x4c, 0xe1, 0x56, 0x90, 0x1f,
-	0x72, 0xcf, 0x7c, 0xc2, 0x21, 0x0e, 0x91, 0xf6, 0xe0, 0x9f, 0xb4, 0x66, 0xbe, 0x84, 0xc1, 0xe2,
-	0x7a, 0x10, 0x7a, 0x95, 0x22, 0x8b, 0xa3, 0x0d, 0x97, 0x54, 0x2d, 0xf7, 0xb9, 0x0c, 0xb9, 0x11,
-	0x84, 0xd4, 0xa7, 0x41, 0x18, 0x43, 0x43, 0x4b, 0x6b, 0xd9, 0x78, 0x39, 0x8c, 0xa1, 0xbe, 0x00,
-	0x62, 0xdb, 0x56, 0x0d, 0xbb, 0x0d, 0x13, 0x43, 0x23, 0x2c, 0xcc, 0x13, 0xd2, 0x50, 0x82, 0x7a,
-	0x06, 0xc4, 0x7d, 0x8a, 0x6b, 0x16, 0x6d, 0x98, 0xcc, 0x0f, 0x1c, 0x22, 0xc2, 0x61, 0x52, 0x19,
-	0x2b, 0x7e, 0x09, 0xea, 0x59, 0x30, 0xcb, 0x90, 0x4d, 0x3c, 0xd8, 0xf6, 0x62, 0x46, 0x34, 0x1d,
-	0xc9, 0xc6, 0xcb, 0xd3, 0x6d, 0x7b, 0xe0, 0xc8, 0xf4, 0x25, 0x30, 0xc9, 0x38, 0xa1, 0x08, 0x9a,
-	0x0c, 0x1f, 0x20, 0x63, 0x2c, 0xad, 0x65, 0xa3, 0x65, 0x20, 0x4d, 0x15, 0x7c, 0x80, 0xf4, 0x4d,
-	0x90, 0x54, 0xf0, 0x4d, 0xdf, 0x6a, 0xd4, 0x90, 0xc7, 0x4d, 0x0b, 0x42, 0x8a, 0x18, 0x33, 0xc6,
-	0xd3, 0x5a, 0x36, 0x56, 0x34, 0x4e, 0x0e, 0x73, 0x09, 0x45, 0xc3, 0x23, 0xb9, 0x52, 0xe1, 0x14,
-	0x7b, 0x4e, 0x79, 0x4e, 0x6d, 0xdc, 0x94, 0xfb, 0xd4, 0xa2, 0x6e, 0x81, 0x38, 0x27, 0xdc, 0x72,
-	0x4d, 0x88, 0x7c, 0xc2, 0x30, 0x37, 0xfe, 0x13, 0x71, 0x1e, 0x1e, 0x9d, 0x2e, 0x85, 0x3e, 0x9c,
-	0x2e, 0x2d, 0x3b, 0x98, 0xef, 0xd4, 0xab, 0x79, 0x9b, 0xd4, 0x14, 0xbb, 0xea, 0x27, 0xc7, 0xe0,
-	0x6e, 0x81, 0x37, 0x7c, 0xc4, 0xf2, 0x25, 0x8f, 0x9f, 0x1c, 0xe6, 0x80, 0xca, 0x5a, 0xf2, 0x78,
-	0x79, 0x4a, 0x84, 0x5c, 0x93, 0x11, 0x33, 0xdf, 0x34, 0x45, 0xf9, 0x96, 0x0f, 0xaf, 0x46, 0xf9,
-	0x22, 0x90, 0xa0, 0x25, 0x0d, 0x61, 0x41, 0x43, 0x4c, 0x58, 0x04, 0x0b, 0x3d, 0x35, 0x47, 0x7e,
-	0x76, 0xcd, 0xbd, 0x7d, 0x8d, 0x5e, 0xad, 0xaf, 0x63, 0xfd, 0xfa, 0x9a, 0xa9, 0x28, 0x02, 0xd6,
-	0x90, 0x8b, 0xae, 0x44, 0x40, 0x4f, 0xfa, 0x70, 0x4f, 0xfa, 0xcc, 0x27, 0x0d, 0xdc, 0x1e, 0xaa,
-	0xe4, 0xc7, 0x42, 0xa4, 0xa3, 0xc4, 0x1e, 0xa6, 0xb3, 0xc8, 0x68, 0x3a, 0xbb, 0x0f, 0x0c, 0x47,
-	0x54, 0x68, 0xb6, 0x02, 0x8b, 0x03, 0xdc, 0x71, 0x18, 0xe6, 0x9c, 0x1e, 0x04, 0x01, 0x77, 0x6f,
-	0x5b, 0x30, 0x07, 0xa9, 0xe7, 0x1a, 0x30, 0x87, 0x15, 0x15, 0x19, 0x56, 0xd4, 0x0b, 0x55, 0xd3,
-	0xa0, 0x86, 0x8e, 0x5e, 0x53, 0xe6, 0x9d, 0x06, 0x6e, 0x75, 0xb4, 0xf5, 0x09, 0xb1, 0x2f, 0xd1,
-	0xca, 0x03, 0x10, 0xab, 0xd6, 0xed, 0x5d, 0xc4, 0x5b, 0x01, 0x63, 0xc5, 0x05, 0x75, 0x12, 0xa2,
-	0x5b, 0x58, 0xe8, 0x7c, 0x52, 0x75, 0x2a, 0xf8, 0x2c, 0x4f, 0x48, 0xef, 0x12, 0xd4, 0x57, 0x40,
-	0x72, 0x00, 0x7c, 0x35, 0xc6, 0x12, 0xfd, 0xd0, 0x5f, 0x9c, 0x52, 0xd1, 0x8b, 0x53, 0xea, 0x1c,
-	0x82, 0x6c, 0xd9, 0xdf, 0x08, 0x61, 0x47, 0x21, 0x90, 0x0d, 0xfe, 0x85, 0x08, 0x32, 0x4d, 0x0d,
-	0x4c, 0x89, 0x54, 0x95, 0x57, 0x96, 0xff, 0xac, 0xce, 0xf5, 0x3c, 0xb8, 0x11, 0x14, 0x62, 0x39,
-	0x28, 0xb8, 0xd5, 0xf6, 0x30, 0x44, 0xd4, 0x6c, 0xe7, 0xfa, 0x5f, 0x2d, 0x6d, 0xaa, 0x95, 0x12,
-	0xd4, 0x8b, 0x20, 0xd5, 0x97, 0x82, 0x8b, 0x97, 0xd6, 0xbc, 0x33, 0x40, 0xa6, 0xd7, 0x38, 0x08,
-	0xfa, 0x32, 0x98, 0x61, 0x75, 0xdb, 0x46, 0x8c, 0x11, 0xda, 0x35, 0x29, 0xe3, 0x6d, 0xb3, 0x50,
-	0xf5, 0x57, 0x0d, 0x24, 0xa4, 0xaa, 0x49, 0xcd, 0x0f, 0x28, 0x1d, 0x15, 0xed, 0x0a, 0x48, 0x32,
-	0x6a, 0x9b, 0xfd, 0xf6, 0x48, 0x98, 0x09, 0x46, 0xed, 0xca, 0x08, 0x24, 0x45, 0xae, 0x45, 0xd2,
-	0xd0, 0x11, 0xf6, 0x59, 0x03, 0xba, 0x04, 0x6f, 0x79, 0x36, 0x72, 0xff, 0xe9, 0x46, 0xbf, 0xd6,
-	0x80, 0x21, 0xe5, 0xdc, 0x5d, 0xff, 0xfa, 0x3e, 0xfe, 0x71, 0xc4, 0xab, 0x60, 0x96, 0xf8, 0x88,
-	0x5a, 0x9c, 0xd0, 0xf6, 0xfd, 0x13, 0xbe, 0xe4, 0xfe, 0x99, 0x69, 0xed, 0x50, 0xe6, 0x40, 0x7a,
-	0xe9, 0x6e, 0xe9, 0xfd, 0x21, 0x95, 0xfd, 0x86, 0x77, 0x4c, 0xf1, 0xe9, 0xd1, 0x59, 0x4a, 0x3b,
-	0x3e, 0x4b, 0x69, 0x1f, 0xcf, 0x52, 0xda, 0x9b, 0x66, 0x2a, 0x74, 0xdc, 0x4c, 0x85, 0xde, 0x37,
-	0x53, 0xa1, 0x97, 0xf7, 0x3a, 0xa2, 0x57, 0xbd, 0x6a, 0xce, 0xde, 0xb1, 0xb0, 0x57, 0xe8, 0x78,
-	0x8d, 0xef, 0x77, 0xbf, 0xc7, 0x45, 0xbe, 0xea, 0xb8, 0x78, 0x45, 0xdf, 0xfd, 0x1e, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0xa5, 0x62, 0x6c, 0xb7, 0x0b, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0xda, 0x86, 0xe2, 0x01, 0x03, 0xdd, 0x1a, 0x79, 0xcb, 0x0f, 0x63, 0x2d, 0x15, 0xf2,
+	0xc5, 0xf6, 0xa1, 0x45, 0xed, 0xa1, 0x97, 0x9a, 0x5f, 0xb2, 0x54, 0xb5, 0xc8, 0x16, 0x95, 0x9a,
+	0xcb, 0x6a, 0xbd, 0x33, 0x2c, 0x23, 0xd6, 0x3b, 0xab, 0x99, 0x31, 0xc1, 0xfc, 0x13, 0x89, 0xf2,
+	0xb7, 0x70, 0x4f, 0x8e, 0x1c, 0x11, 0xa7, 0x28, 0x07, 0x14, 0xe1, 0x5c, 0x72, 0xcb, 0x39, 0x97,
+	0x44, 0x3b, 0x33, 0x36, 0x36, 0x36, 0x86, 0x98, 0x24, 0x0a, 0x39, 0xc1, 0xbe, 0x79, 0xf3, 0xbd,
+	0xf7, 0x7d, 0xef, 0xbd, 0x19, 0x0f, 0xf8, 0xc5, 0xa5, 0x08, 0xf9, 0x7b, 0x18, 0x79, 0xb0, 0x78,
+	0x88, 0x29, 0x6f, 0xd8, 0x9e, 0x4b, 0x49, 0x23, 0x28, 0xa2, 0x43, 0xe4, 0x73, 0x56, 0x08, 0x28,
+	0xe1, 0x44, 0x4f, 0x5f, 0x79, 0x15, 0xba, 0xbd, 0xe6, 0x7f, 0x76, 0x08, 0xab, 0x13, 0x66, 0x09,
+	0xb7, 0xa2, 0xfc, 0x90, 0x7b, 0xe6, 0x53, 0x2e, 0x71, 0x89, 0xb4, 0x87, 0xff, 0x49, 0xab, 0xf9,
+	0x2e, 0x0a, 0x96, 0x36, 0x43, 0xe8, 0x75, 0x8a, 0x6c, 0x8e, 0xb6, 0x3d, 0x52, 0xb3, 0xbd, 0xff,
+	0x24, 0xe4, 0x76, 0x08, 0xa9, 0x4f, 0x83, 0x28, 0x86, 0x86, 0x96, 0xd5, 0x72, 0xc9, 0x4a, 0x14,
+	0x43, 0x7d, 0x01, 0x24, 0xf6, 0xec, 0x3a, 0xf6, 0x9a, 0x16, 0x86, 0x46, 0x54, 0x98, 0x27, 0xa4,
+	0xa1, 0x0c, 0x75, 0x13, 0x24, 0x03, 0x8a, 0xeb, 0x36, 0x6d, 0x5a, 0x2c, 0x08, 0x1d, 0x62, 0xc2,
+	0x61, 0x52, 0x19, 0xab, 0x41, 0x19, 0xea, 0x39, 0x30, 0xcb, 0x90, 0x43, 0x7c, 0xd8, 0xf1, 0x62,
+	0x46, 0x3c, 0x1b, 0xcb, 0x25, 0x2b, 0xd3, 0x1d, 0x7b, 0xe8, 0xc8, 0xf4, 0x65, 0x30, 0xc9, 0x38,
+	0xa1, 0x08, 0x5a, 0x0c, 0x1f, 0x23, 0x63, 0x2c, 0xab, 0xe5, 0xe2, 0x15, 0x20, 0x4d, 0x55, 0x7c,
+	0x8c, 0xf4, 0x1d, 0x90, 0x56, 0xf4, 0xad, 0xc0, 0x6e, 0xd6, 0x91, 0xcf, 0x2d, 0x1b, 0x42, 0x8a,
+	0x18, 0x33, 0xc6, 0xb3, 0x5a, 0x2e, 0x51, 0x32, 0xce, 0x4f, 0xf2, 0x29, 0x25, 0xc3, 0x5f, 0x72,
+	0xa5, 0xca, 0x29, 0xf6, 0xdd, 0xca, 0x9c, 0xda, 0xb8, 0x23, 0xf7, 0xa9, 0x45, 0xdd, 0x06, 0x49,
+	0x4e, 0xb8, 0xed, 0x59, 0x10, 0x05, 0x84, 0x61, 0x6e, 0xfc, 0x20, 0x70, 0xfe, 0x3c, 0xbd, 0x58,
+	0x8e, 0xbc, 0xba, 0x58, 0x5e, 0x75, 0x31, 0xdf, 0x6f, 0xd4, 0x0a, 0x0e, 0xa9, 0x2b, 0x75, 0xd5,
+	0x9f, 0x3c, 0x83, 0x07, 0x45, 0xde, 0x0c, 0x10, 0x2b, 0x94, 0x7d, 0x7e, 0x7e, 0x92, 0x07, 0x2a,
+	0x6a, 0xd9, 0xe7, 0x95, 0x29, 0x01, 0xb9, 0x21, 0x11, 0xcd, 0x0f, 0x9a, 0x92, 0x7c, 0x37, 0x80,
+	0x77, 0x93, 0x7c, 0x09, 0x48, 0xd2, 0x52, 0x86, 0xa8, 0x90, 0x21, 0x21, 0x2c, 0x42, 0x85, 0xbe,
+	0x9c, 0x63, 0x9f, 0x3b, 0xe7, 0xfe, 0xba, 0xc6, 0xef, 0x56, 0xd7, 0xb1, 0x41, 0x75, 0x35, 0xab,
+	0x4a, 0x80, 0x0d, 0xe4, 0xa1, 0x3b, 0x09, 0xd0, 0x17, 0x3e, 0xda, 0x17, 0xde, 0x7c, 0xa3, 0x81,
+	0x95, 0xa1, 0x9d, 0xbc, 0x25, 0x9a, 0x74, 0x14, 0xec, 0x61, 0x7d, 0x16, 0x1b, 0xad, 0xcf, 0x7e,
+	0x07, 0x86, 0x2b, 0x32, 0xb4, 0xda, 0xc0, 0x62, 0x80, 0xbb, 0x86, 0x61, 0xce, 0xed, 0x63, 0x10,
+	0x6a, 0xf7, 0xac, 0x4d, 0xf3, 0xa6, 0xee, 0xb9, 0x07, 0xcd, 0x61, 0x49, 0xc5, 0x86, 0x25, 0xf5,
+	0x3f, 0x58, 0x19, 0x5a, 0xd0, 0xd1, 0x73, 0x32, 0x5f, 0x68, 0x60, 0xb1, 0xab, 0xac, 0x7f, 0x13,
+	0xe7, 0x96, 0x5e, 0xf9, 0x03, 0x24, 0x6a, 0x0d, 0xe7, 0x00, 0xf1, 0x36, 0x60, 0xa2, 0xb4, 0xa0,
+	0x26, 0x21, 0xbe, 0x8b, 0x45, 0x9f, 0x4f, 0xaa, 0x4a, 0x85, 0x9f, 0x95, 0x09, 0xe9, 0x5d, 0x86,
+	0xfa, 0x1a, 0x48, 0xdf, 0x40, 0x5f, 0x1d, 0x63, 0xa9, 0x41, 0xec, 0xaf, 0x9f, 0x52, 0xf1, 0xeb,
+	0xa7, 0xd4, 0x15, 0x05, 0x59, 0xb2, 0x87, 0x48, 0x61, 0x1f, 0x2c, 0x76, 0x15, 0xf8, 0x0b, 0x32,
+	0x30, 0x5b, 0x1a, 0x98, 0x12, 0xa1, 0xaa, 0x8f, 0xed, 0xe0, 0xdf, 0x06, 0xd7, 0x0b, 0xe0, 0xa7,
+	0x30, 0x11, 0xdb, 0x45, 0xe1, 0xad, 0x76, 0x88, 0x21, 0xa2, 0x56, 0x27, 0xd6, 0x8f, 0x6a, 0x69,
+	0x47, 0xad, 0x94, 0xa1, 0x5e, 0x02, 0x99, 0x81, 0x12, 0x5c, 0xbf, 0xb4, 0xe6, 0xdd, 0x1b, 0xda,
+	0xf4, 0x1e, 0x83, 0xa0, 0xaf, 0x82, 0x19, 0xd6, 0x70, 0x1c, 0xc4, 0x18, 0xa1, 0x3d, 0x27, 0x65,
+	0xb2, 0x63, 0x16, 0x5d, 0xfd, 0x5e, 0x03, 0x29, 0xd9, 0xd5, 0xa4, 0x1e, 0x84, 0x92, 0x8e, 0xca,
+	0x76, 0x0d, 0xa4, 0x19, 0x75, 0xac, 0x41, 0x7b, 0x24, 0xcd, 0x14, 0xa3, 0x4e, 0x75, 0x04, 0x91,
+	0x62, 0xf7, 0x12, 0x69, 0xe8, 0x11, 0xf6, 0x56, 0x03, 0xba, 0x24, 0x6f, 0xfb, 0x0e, 0xf2, 0xbe,
+	0xeb, 0x42, 0x3f, 0x6f, 0x73, 0xdd, 0x22, 0xd4, 0x41, 0xf0, 0xe1, 0x95, 0xd9, 0x7c, 0xa2, 0x01,
+	0x43, 0x0e, 0x64, 0x2f, 0xfc, 0xe6, 0x11, 0xfe, 0x74, 0x1e, 0xeb, 0x60, 0x96, 0x04, 0x88, 0xda,
+	0x9c, 0xd0, 0xce, 0x0d, 0x1a, 0xbd, 0xe5, 0x06, 0x9d, 0x69, 0xef, 0x50, 0xe6, 0x70, 0x78, 0xb2,
+	0xbd, 0xc3, 0xf3, 0x8d, 0x64, 0xf6, 0x15, 0x7e, 0x89, 0x95, 0xfe, 0x39, 0xbd, 0xcc, 0x68, 0x67,
+	0x97, 0x19, 0xed, 0xf5, 0x65, 0x46, 0x7b, 0xda, 0xca, 0x44, 0xce, 0x5a, 0x99, 0xc8, 0xcb, 0x56,
+	0x26, 0xf2, 0xe8, 0xb7, 0x2e, 0xf4, 0x9a, 0x5f, 0xcb, 0x3b, 0xfb, 0x36, 0xf6, 0x8b, 0x5d, 0xef,
+	0x89, 0xa3, 0xde, 0x17, 0x85, 0x88, 0x57, 0x1b, 0x17, 0xef, 0x80, 0x5f, 0x3f, 0x0e, 0x00, 0xe9,
+	0xe2, 0x33, 0xb1, 0x79, 0x0c, 0x00, 0x00,
 }
 
 func (m *EventCreateGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
@@ -1635,6 +1700,44 @@ func (m *EventCancelSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventForcedSwapOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForcedSwapOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForcedSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcStorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SrcStorageProviderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventStorageProviderExit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1994,6 +2097,24 @@ func (m *EventCancelSwapOut) Size() (n int) {
 	return n
 }
 
+func (m *EventForcedSwapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.StorageProviderId))
+	}
+	if m.SrcStorageProviderId != 0 {
+		n += 1 + sovEvents(uint64(m.SrcStorageProviderId))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovEvents(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	return n
+}
+
 func (m *EventStorageProviderExit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3989,6 +4110,113 @@ func (m *EventCancelSwapOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventForcedSwapOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForcedSwapOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForcedSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageProviderId", wireType)
+			}
+			m.SrcStorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcStorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventStorageProviderExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
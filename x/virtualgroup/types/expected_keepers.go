@@ -15,6 +15,7 @@ type SpKeeper interface {
 	SetStorageProvider(ctx sdk.Context, sp *sptypes.StorageProvider)
 	Exit(ctx sdk.Context, sp *sptypes.StorageProvider) error
 	DepositDenomForSP(ctx sdk.Context) (res string)
+	GetSpJailRecord(ctx sdk.Context, spId uint32) (*sptypes.SpJailRecord, bool)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -47,4 +48,6 @@ type PaymentKeeper interface {
 
 type StorageKeeper interface {
 	GetExpectSecondarySPNumForECObject(ctx sdk.Context, time int64) (res uint32)
+	GetMigrationBucketIDsOfSP(ctx sdk.Context, spID uint32) (srcBucketIDs, dstBucketIDs []sdkmath.Uint)
+	RebindBucketToFamily(ctx sdk.Context, operator sdk.AccAddress, bucketID sdkmath.Uint, srcFamilyID, dstFamilyID uint32) (map[uint32]uint64, error)
 }
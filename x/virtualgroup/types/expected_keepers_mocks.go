@@ -65,6 +65,21 @@ func (mr *MockSpKeeperMockRecorder) Exit(ctx, sp any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exit", reflect.TypeOf((*MockSpKeeper)(nil).Exit), ctx, sp)
 }
 
+// GetSpJailRecord mocks base method.
+func (m *MockSpKeeper) GetSpJailRecord(ctx types0.Context, spId uint32) (*types.SpJailRecord, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpJailRecord", ctx, spId)
+	ret0, _ := ret[0].(*types.SpJailRecord)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSpJailRecord indicates an expected call of GetSpJailRecord.
+func (mr *MockSpKeeperMockRecorder) GetSpJailRecord(ctx, spId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpJailRecord", reflect.TypeOf((*MockSpKeeper)(nil).GetSpJailRecord), ctx, spId)
+}
+
 // GetStorageProvider mocks base method.
 func (m *MockSpKeeper) GetStorageProvider(ctx types0.Context, id uint32) (*types.StorageProvider, bool) {
 	m.ctrl.T.Helper()
@@ -434,3 +449,33 @@ func (mr *MockStorageKeeperMockRecorder) GetExpectSecondarySPNumForECObject(ctx,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpectSecondarySPNumForECObject", reflect.TypeOf((*MockStorageKeeper)(nil).GetExpectSecondarySPNumForECObject), ctx, time)
 }
+
+// GetMigrationBucketIDsOfSP mocks base method.
+func (m *MockStorageKeeper) GetMigrationBucketIDsOfSP(ctx types0.Context, spID uint32) ([]math.Uint, []math.Uint) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationBucketIDsOfSP", ctx, spID)
+	ret0, _ := ret[0].([]math.Uint)
+	ret1, _ := ret[1].([]math.Uint)
+	return ret0, ret1
+}
+
+// GetMigrationBucketIDsOfSP indicates an expected call of GetMigrationBucketIDsOfSP.
+func (mr *MockStorageKeeperMockRecorder) GetMigrationBucketIDsOfSP(ctx, spID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationBucketIDsOfSP", reflect.TypeOf((*MockStorageKeeper)(nil).GetMigrationBucketIDsOfSP), ctx, spID)
+}
+
+// RebindBucketToFamily mocks base method.
+func (m *MockStorageKeeper) RebindBucketToFamily(ctx types0.Context, operator types0.AccAddress, bucketID math.Uint, srcFamilyID, dstFamilyID uint32) (map[uint32]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebindBucketToFamily", ctx, operator, bucketID, srcFamilyID, dstFamilyID)
+	ret0, _ := ret[0].(map[uint32]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebindBucketToFamily indicates an expected call of RebindBucketToFamily.
+func (mr *MockStorageKeeperMockRecorder) RebindBucketToFamily(ctx, operator, bucketID, srcFamilyID, dstFamilyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebindBucketToFamily", reflect.TypeOf((*MockStorageKeeper)(nil).RebindBucketToFamily), ctx, operator, bucketID, srcFamilyID, dstFamilyID)
+}
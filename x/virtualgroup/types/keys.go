@@ -28,6 +28,16 @@ const (
 
 	// NoSpecifiedFamilyId defines
 	NoSpecifiedFamilyId = uint32(0)
+
+	// DefaultPlacementCandidateLimit defines the default number of candidate families returned by the placement query
+	DefaultPlacementCandidateLimit = uint32(10)
+	// MaxPlacementCandidateLimit defines the max number of candidate families returned by the placement query
+	MaxPlacementCandidateLimit = uint32(100)
+
+	// MaxGlobalVirtualGroupsPerBatch defines the max number of global virtual groups created or rebalanced in one message
+	MaxGlobalVirtualGroupsPerBatch = 20
+	// MaxBucketsPerRebalance defines the max number of buckets rebound along with the global virtual groups in one message
+	MaxBucketsPerRebalance = 100
 )
 
 var (
@@ -43,6 +53,11 @@ var (
 
 	SwapOutFamilyKey = []byte{0x51}
 	SwapOutGVGKey    = []byte{0x61}
+
+	SPExitTimeKey = []byte{0x71}
+
+	SecondarySPGVGIndexKey     = []byte{0x81}
+	PrimarySPGVGFamilyIndexKey = []byte{0x82}
 )
 
 func GetGVGKey(gvgID uint32) []byte {
@@ -69,3 +84,30 @@ func GetSwapOutGVGKey(globalVirtualGroupID uint32) []byte {
 	var uint32Seq sequence.Sequence[uint32]
 	return append(SwapOutGVGKey, uint32Seq.EncodeSequence(globalVirtualGroupID)...)
 }
+
+func GetSPExitTimeKey(spID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(SPExitTimeKey, uint32Seq.EncodeSequence(spID)...)
+}
+
+// GetSecondarySPGVGIndexPrefix returns the prefix of the index from the secondary sp to its gvgs
+func GetSecondarySPGVGIndexPrefix(spID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(SecondarySPGVGIndexKey, uint32Seq.EncodeSequence(spID)...)
+}
+
+func GetSecondarySPGVGIndexKey(spID, gvgID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(GetSecondarySPGVGIndexPrefix(spID), uint32Seq.EncodeSequence(gvgID)...)
+}
+
+// GetPrimarySPGVGFamilyIndexPrefix returns the prefix of the index from the primary sp to its gvg families
+func GetPrimarySPGVGFamilyIndexPrefix(spID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(PrimarySPGVGFamilyIndexKey, uint32Seq.EncodeSequence(spID)...)
+}
+
+func GetPrimarySPGVGFamilyIndexKey(spID, familyID uint32) []byte {
+	var uint32Seq sequence.Sequence[uint32]
+	return append(GetPrimarySPGVGFamilyIndexPrefix(spID), uint32Seq.EncodeSequence(familyID)...)
+}
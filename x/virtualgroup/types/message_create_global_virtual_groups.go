@@ -0,0 +1,61 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+const TypeMsgCreateGlobalVirtualGroups = "create_global_virtual_groups"
+
+var _ sdk.Msg = &MsgCreateGlobalVirtualGroups{}
+
+func NewMsgCreateGlobalVirtualGroups(primarySpAddress sdk.AccAddress, globalVirtualGroups []GlobalVirtualGroupCreation) *MsgCreateGlobalVirtualGroups {
+	return &MsgCreateGlobalVirtualGroups{
+		StorageProvider:     primarySpAddress.String(),
+		GlobalVirtualGroups: globalVirtualGroups,
+	}
+}
+
+func (msg *MsgCreateGlobalVirtualGroups) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateGlobalVirtualGroups) Type() string {
+	return TypeMsgCreateGlobalVirtualGroups
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgCreateGlobalVirtualGroups) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgCreateGlobalVirtualGroups) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgCreateGlobalVirtualGroups) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid storage provider address (%s)", err)
+	}
+
+	if len(msg.GlobalVirtualGroups) == 0 || len(msg.GlobalVirtualGroups) > MaxGlobalVirtualGroupsPerBatch {
+		return gnfderrors.ErrInvalidMessage.Wrapf("The number of global virtual groups should be between 1 and %d.", MaxGlobalVirtualGroupsPerBatch)
+	}
+
+	for _, gvg := range msg.GlobalVirtualGroups {
+		if !gvg.Deposit.IsValid() || !gvg.Deposit.Amount.IsPositive() {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "invalid deposit amount")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgCreateGlobalVirtualGroups_ValidateBasic(t *testing.T) {
+	deposit := sdk.NewCoin("denom", sdk.NewInt(1))
+	tests := []struct {
+		name string
+		msg  MsgCreateGlobalVirtualGroups
+		err  error
+	}{
+		{
+			name: "valid case",
+			msg: *NewMsgCreateGlobalVirtualGroups(
+				sample.RandAccAddress(),
+				[]GlobalVirtualGroupCreation{
+					{FamilyId: NoSpecifiedFamilyId, SecondarySpIds: []uint32{1, 2}, Deposit: deposit},
+					{FamilyId: 1, SecondarySpIds: []uint32{2, 3}, Deposit: deposit},
+				},
+			),
+		},
+		{
+			name: "invalid address",
+			msg: MsgCreateGlobalVirtualGroups{
+				StorageProvider:     "invalid_address",
+				GlobalVirtualGroups: []GlobalVirtualGroupCreation{{Deposit: deposit}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty batch",
+			msg: MsgCreateGlobalVirtualGroups{
+				StorageProvider: sample.RandAccAddressHex(),
+			},
+			err: gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "too many global virtual groups",
+			msg: MsgCreateGlobalVirtualGroups{
+				StorageProvider:     sample.RandAccAddressHex(),
+				GlobalVirtualGroups: make([]GlobalVirtualGroupCreation, MaxGlobalVirtualGroupsPerBatch+1),
+			},
+			err: gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "invalid deposit amount",
+			msg: MsgCreateGlobalVirtualGroups{
+				StorageProvider: sample.RandAccAddressHex(),
+				GlobalVirtualGroups: []GlobalVirtualGroupCreation{
+					{Deposit: deposit},
+					{Deposit: sdk.NewCoin("denom", sdk.NewInt(0))},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+const TypeMsgForcedSwapOut = "forced_swap_out"
+
+var _ sdk.Msg = &MsgForcedSwapOut{}
+
+func NewMsgForcedSwapOut(storageProvider sdk.AccAddress, globalVirtualGroupFamilyID uint32, secondarySpBlsSignatures [][]byte) *MsgForcedSwapOut {
+	return &MsgForcedSwapOut{
+		StorageProvider:            storageProvider.String(),
+		GlobalVirtualGroupFamilyId: globalVirtualGroupFamilyID,
+		SecondarySpBlsSignatures:   secondarySpBlsSignatures,
+	}
+}
+
+func (msg *MsgForcedSwapOut) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgForcedSwapOut) Type() string {
+	return TypeMsgForcedSwapOut
+}
+
+func (msg *MsgForcedSwapOut) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgForcedSwapOut) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgForcedSwapOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+	if msg.GlobalVirtualGroupFamilyId == NoSpecifiedFamilyId {
+		return gnfderrors.ErrInvalidMessage.Wrap("The family id is not specified.")
+	}
+	for _, sig := range msg.SecondarySpBlsSignatures {
+		if len(sig) != sdk.BLSSignatureLength {
+			return gnfderrors.ErrInvalidMessage.Wrapf("The length of the bls signature should be %d.", sdk.BLSSignatureLength)
+		}
+	}
+	return nil
+}
+
+// NewSecondarySpForcedSwapOutSignDoc creates the doc for the secondary sps of a gvg to accept the successor sp
+func NewSecondarySpForcedSwapOutSignDoc(chainID string, gvgID uint32, successorSPID uint32) *SecondarySpForcedSwapOutSignDoc {
+	return &SecondarySpForcedSwapOutSignDoc{
+		ChainId:              chainID,
+		GlobalVirtualGroupId: gvgID,
+		SuccessorSpId:        successorSPID,
+	}
+}
+
+func (c *SecondarySpForcedSwapOutSignDoc) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(c))
+}
+
+func (c *SecondarySpForcedSwapOutSignDoc) GetBlsSignHash() [32]byte {
+	return sdk.Keccak256Hash(c.GetSignBytes())
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgForcedSwapOut_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgForcedSwapOut
+		err  error
+	}{
+		{
+			name: "valid address",
+			msg: *NewMsgForcedSwapOut(
+				sample.RandAccAddress(),
+				1,
+				[][]byte{make([]byte, sdk.BLSSignatureLength)},
+			),
+		},
+		{
+			name: "invalid address",
+			msg: MsgForcedSwapOut{
+				StorageProvider:            "invalid_address",
+				GlobalVirtualGroupFamilyId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid family id",
+			msg: MsgForcedSwapOut{
+				StorageProvider:            sample.RandAccAddressHex(),
+				GlobalVirtualGroupFamilyId: NoSpecifiedFamilyId,
+			},
+			err: gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "invalid bls signature",
+			msg: MsgForcedSwapOut{
+				StorageProvider:            sample.RandAccAddressHex(),
+				GlobalVirtualGroupFamilyId: 1,
+				SecondarySpBlsSignatures:   [][]byte{[]byte("sig")},
+			},
+			err: gnfderrors.ErrInvalidMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
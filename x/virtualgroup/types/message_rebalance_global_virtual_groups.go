@@ -0,0 +1,82 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+const TypeMsgRebalanceGlobalVirtualGroups = "rebalance_global_virtual_groups"
+
+var _ sdk.Msg = &MsgRebalanceGlobalVirtualGroups{}
+
+func NewMsgRebalanceGlobalVirtualGroups(primarySpAddress sdk.AccAddress, srcFamilyID, dstFamilyID uint32, gvgIDs []uint32, bucketIDs []math.Uint) *MsgRebalanceGlobalVirtualGroups {
+	return &MsgRebalanceGlobalVirtualGroups{
+		StorageProvider:               primarySpAddress.String(),
+		SrcGlobalVirtualGroupFamilyId: srcFamilyID,
+		DstGlobalVirtualGroupFamilyId: dstFamilyID,
+		GlobalVirtualGroupIds:         gvgIDs,
+		BucketIds:                     bucketIDs,
+	}
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroups) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroups) Type() string {
+	return TypeMsgRebalanceGlobalVirtualGroups
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRebalanceGlobalVirtualGroups) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRebalanceGlobalVirtualGroups) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRebalanceGlobalVirtualGroups) ValidateBasic() error {
+	_, err := sdk.AccAddressFromHexUnsafe(msg.StorageProvider)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid storage provider address (%s)", err)
+	}
+
+	if msg.SrcGlobalVirtualGroupFamilyId == NoSpecifiedFamilyId {
+		return gnfderrors.ErrInvalidMessage.Wrap("The source family id is not specified.")
+	}
+	if msg.SrcGlobalVirtualGroupFamilyId == msg.DstGlobalVirtualGroupFamilyId {
+		return gnfderrors.ErrInvalidMessage.Wrap("The source family and the destination family should be different.")
+	}
+
+	if len(msg.GlobalVirtualGroupIds) == 0 || len(msg.GlobalVirtualGroupIds) > MaxGlobalVirtualGroupsPerBatch {
+		return ErrInvalidGVGCount.Wrapf("The number of global virtual groups should be between 1 and %d.", MaxGlobalVirtualGroupsPerBatch)
+	}
+	gvgIDSet := make(map[uint32]struct{}, len(msg.GlobalVirtualGroupIds))
+	for _, gvgID := range msg.GlobalVirtualGroupIds {
+		if _, ok := gvgIDSet[gvgID]; ok {
+			return ErrInvalidGVGCount.Wrapf("The global virtual group(id=%d) is duplicate.", gvgID)
+		}
+		gvgIDSet[gvgID] = struct{}{}
+	}
+
+	if len(msg.BucketIds) > MaxBucketsPerRebalance {
+		return gnfderrors.ErrInvalidMessage.Wrapf("The number of buckets should not exceed %d.", MaxBucketsPerRebalance)
+	}
+	bucketIDSet := make(map[string]struct{}, len(msg.BucketIds))
+	for _, bucketID := range msg.BucketIds {
+		if _, ok := bucketIDSet[bucketID.String()]; ok {
+			return gnfderrors.ErrInvalidMessage.Wrapf("The bucket(id=%s) is duplicate.", bucketID.String())
+		}
+		bucketIDSet[bucketID.String()] = struct{}{}
+	}
+	return nil
+}
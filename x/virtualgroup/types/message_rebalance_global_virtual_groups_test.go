@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield/testutil/sample"
+	gnfderrors "github.com/bnb-chain/greenfield/types/errors"
+)
+
+func TestMsgRebalanceGlobalVirtualGroups_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRebalanceGlobalVirtualGroups
+		err  error
+	}{
+		{
+			name: "valid case",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 2, []uint32{1, 2}, nil),
+		},
+		{
+			name: "valid case with new family",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, NoSpecifiedFamilyId, []uint32{1}, nil),
+		},
+		{
+			name: "invalid address",
+			msg: MsgRebalanceGlobalVirtualGroups{
+				StorageProvider:               "invalid_address",
+				SrcGlobalVirtualGroupFamilyId: 1,
+				GlobalVirtualGroupIds:         []uint32{1},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "source family not specified",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), NoSpecifiedFamilyId, 2, []uint32{1}, nil),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "same family",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 1, []uint32{1}, nil),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "no global virtual group",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 2, nil, nil),
+			err:  ErrInvalidGVGCount,
+		},
+		{
+			name: "duplicate global virtual group",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 2, []uint32{1, 1}, nil),
+			err:  ErrInvalidGVGCount,
+		},
+		{
+			name: "valid case with buckets",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 2, []uint32{1}, []math.Uint{math.NewUint(1), math.NewUint(2)}),
+		},
+		{
+			name: "too many buckets",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 2, []uint32{1}, make([]math.Uint, MaxBucketsPerRebalance+1)),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+		{
+			name: "duplicate bucket",
+			msg:  *NewMsgRebalanceGlobalVirtualGroups(sample.RandAccAddress(), 1, 2, []uint32{1}, []math.Uint{math.NewUint(1), math.NewUint(1)}),
+			err:  gnfderrors.ErrInvalidMessage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -21,11 +21,13 @@ var (
 	DefaultGVGStakingPerBytes                = sdk.NewInt(16000) // 20%~30% of store price
 	DefaultMaxGlobalVirtualGroupNumPerFamily = uint32(10)
 	DefaultMaxStoreSizePerFamily             = uint64(64) * 1024 * 1024 * 1024 * 1024 //64T
+	DefaultForcedSwapOutTimeout              = uint64(7 * 24 * 60 * 60)               // 7 days
 
 	KeyDepositDenom                      = []byte("DepositDenom")
 	KeyGVGStakingPerBytes                = []byte("GVGStakingPerBytes")
 	KeyMaxGlobalVirtualGroupNumPerFamily = []byte("MaxGlobalVirtualGroupNumPerFamily")
 	KeyMaxStoreSizePerFamily             = []byte("MaxStoreSizePerFamily")
+	KeyForcedSwapOutTimeout              = []byte("ForcedSwapOutTimeout")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -37,18 +39,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams(depositDenom string, gvgStakingPerBytes math.Int, maxGlobalVirtualGroupPerFamily uint32,
-	maxStoreSizePerFamily uint64) Params {
+	maxStoreSizePerFamily uint64, forcedSwapOutTimeout uint64) Params {
 	return Params{
 		DepositDenom:                      depositDenom,
 		GvgStakingPerBytes:                gvgStakingPerBytes,
 		MaxGlobalVirtualGroupNumPerFamily: maxGlobalVirtualGroupPerFamily,
 		MaxStoreSizePerFamily:             maxStoreSizePerFamily,
+		ForcedSwapOutTimeout:              forcedSwapOutTimeout,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultDepositDenom, DefaultGVGStakingPerBytes, DefaultMaxGlobalVirtualGroupNumPerFamily, DefaultMaxStoreSizePerFamily)
+	return NewParams(DefaultDepositDenom, DefaultGVGStakingPerBytes, DefaultMaxGlobalVirtualGroupNumPerFamily, DefaultMaxStoreSizePerFamily,
+		DefaultForcedSwapOutTimeout)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -58,6 +62,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyGVGStakingPerBytes, &p.GvgStakingPerBytes, validateGVGStakingPerBytes),
 		paramtypes.NewParamSetPair(KeyMaxGlobalVirtualGroupNumPerFamily, &p.MaxGlobalVirtualGroupNumPerFamily, validateMaxGlobalVirtualGroupNumPerFamily),
 		paramtypes.NewParamSetPair(KeyMaxStoreSizePerFamily, &p.MaxStoreSizePerFamily, validateMaxStoreSizePerFamily),
+		paramtypes.NewParamSetPair(KeyForcedSwapOutTimeout, &p.ForcedSwapOutTimeout, validateForcedSwapOutTimeout),
 	}
 }
 
@@ -75,6 +80,9 @@ func (p Params) Validate() error {
 	if err := validateMaxStoreSizePerFamily(p.MaxStoreSizePerFamily); err != nil {
 		return err
 	}
+	if err := validateForcedSwapOutTimeout(p.ForcedSwapOutTimeout); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -137,3 +145,16 @@ func validateMaxStoreSizePerFamily(i interface{}) error {
 
 	return nil
 }
+
+func validateForcedSwapOutTimeout(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("forced swap out timeout must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -37,6 +37,8 @@ type Params struct {
 	MaxGlobalVirtualGroupNumPerFamily uint32 `protobuf:"varint,4,opt,name=max_global_virtual_group_num_per_family,json=maxGlobalVirtualGroupNumPerFamily,proto3" json:"max_global_virtual_group_num_per_family,omitempty"`
 	// if the store size reach the exceed, the family is not allowed to sever more buckets
 	MaxStoreSizePerFamily uint64 `protobuf:"varint,5,opt,name=max_store_size_per_family,json=maxStoreSizePerFamily,proto3" json:"max_store_size_per_family,omitempty"`
+	// the time(in seconds) after which the family of a jailed or exiting primary sp can be claimed by other sps via forced swap out
+	ForcedSwapOutTimeout uint64 `protobuf:"varint,6,opt,name=forced_swap_out_timeout,json=forcedSwapOutTimeout,proto3" json:"forced_swap_out_timeout,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -99,6 +101,13 @@ func (m *Params) GetMaxStoreSizePerFamily() uint64 {
 	return 0
 }
 
+func (m *Params) GetForcedSwapOutTimeout() uint64 {
+	if m != nil {
+		return m.ForcedSwapOutTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "greenfield.virtualgroup.Params")
 }
@@ -108,33 +117,35 @@ func init() {
 }
 
 var fileDescriptor_d8ecf89dd5128885 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0x87, 0x63, 0x5a, 0x2a, 0xb0, 0xe8, 0x12, 0x51, 0x91, 0x76, 0x48, 0xc2, 0x1f, 0x95, 0x5b,
-	0x2e, 0x19, 0x60, 0x40, 0x88, 0xe9, 0x84, 0xa8, 0x2a, 0xa1, 0xea, 0x94, 0x93, 0x18, 0x58, 0x2c,
-	0x27, 0x71, 0x5d, 0xeb, 0x62, 0x3b, 0xb2, 0x9d, 0x53, 0xae, 0x9f, 0x80, 0x91, 0x91, 0xb1, 0x1f,
-	0x82, 0x0f, 0x71, 0xe3, 0x89, 0x09, 0x31, 0x9c, 0xd0, 0xdd, 0xc2, 0xc7, 0x40, 0x76, 0x22, 0x08,
-	0x42, 0x9d, 0x92, 0xfc, 0xf2, 0xf8, 0xd1, 0xfb, 0xbe, 0x7e, 0xe1, 0x33, 0xaa, 0x08, 0x11, 0x97,
-	0x8c, 0x54, 0x65, 0xba, 0x60, 0xca, 0x34, 0xb8, 0xa2, 0x4a, 0x36, 0x75, 0x5a, 0x63, 0x85, 0xb9,
-	0x4e, 0x6a, 0x25, 0x8d, 0xf4, 0x1f, 0xfd, 0xa5, 0x92, 0x21, 0x75, 0x72, 0x5c, 0x48, 0xcd, 0xa5,
-	0x46, 0x0e, 0x4b, 0xbb, 0x8f, 0xee, 0xcc, 0xc9, 0x43, 0x2a, 0xa9, 0xec, 0x72, 0xfb, 0xd6, 0xa5,
-	0x4f, 0x3e, 0xed, 0xc1, 0x83, 0xa9, 0x53, 0xfb, 0x4f, 0xe1, 0x61, 0x49, 0x6a, 0xa9, 0x99, 0x41,
-	0x25, 0x11, 0x92, 0x07, 0x20, 0x06, 0xa3, 0xfb, 0xd9, 0x83, 0x3e, 0x7c, 0x6b, 0x33, 0x5f, 0xc2,
-	0x23, 0xba, 0xa0, 0x48, 0x1b, 0x3c, 0x67, 0x82, 0xa2, 0x9a, 0x28, 0x94, 0x2f, 0x0d, 0xd1, 0xc1,
-	0x1d, 0x0b, 0x4f, 0xde, 0xac, 0x36, 0x91, 0xf7, 0x63, 0x13, 0x9d, 0x52, 0x66, 0xae, 0x9a, 0x3c,
-	0x29, 0x24, 0xef, 0xab, 0xe8, 0x1f, 0x63, 0x5d, 0xce, 0x53, 0xb3, 0xac, 0x89, 0x4e, 0xce, 0x85,
-	0xf9, 0xf6, 0x75, 0x0c, 0xfb, 0x22, 0xcf, 0x85, 0xc9, 0x7c, 0xba, 0xa0, 0xb3, 0xce, 0x3c, 0x25,
-	0x6a, 0x62, 0xbd, 0xfe, 0x14, 0x9e, 0x72, 0xdc, 0xa2, 0x4a, 0x16, 0xb8, 0x42, 0x7d, 0xaf, 0xc8,
-	0x35, 0x8b, 0x44, 0xc3, 0xbb, 0x02, 0x9a, 0x62, 0x4e, 0x4c, 0xb0, 0x17, 0x83, 0xd1, 0x61, 0x16,
-	0x73, 0xdc, 0xbe, 0xb7, 0xf0, 0x87, 0x8e, 0x3d, 0xb3, 0xe8, 0x45, 0xc3, 0xad, 0xd0, 0x71, 0x7e,
-	0x06, 0x9f, 0x5b, 0x23, 0xad, 0x64, 0x7e, 0xab, 0xf2, 0x12, 0x73, 0x56, 0x2d, 0x83, 0x7d, 0xa7,
-	0x7c, 0xcc, 0x71, 0x7b, 0xe6, 0xe8, 0xff, 0x9d, 0xef, 0x1c, 0xe8, 0xbf, 0x82, 0xc7, 0xd6, 0xa9,
-	0x8d, 0x54, 0x04, 0x69, 0x76, 0x4d, 0x86, 0x96, 0xbb, 0x31, 0x18, 0xed, 0x67, 0x47, 0x1c, 0xb7,
-	0x33, 0xfb, 0x7f, 0xc6, 0xae, 0xc9, 0x9f, 0x93, 0xaf, 0xef, 0x7d, 0xb9, 0x89, 0xbc, 0x5f, 0x37,
-	0x11, 0x98, 0x5c, 0xac, 0xb6, 0x21, 0x58, 0x6f, 0x43, 0xf0, 0x73, 0x1b, 0x82, 0xcf, 0xbb, 0xd0,
-	0x5b, 0xef, 0x42, 0xef, 0xfb, 0x2e, 0xf4, 0x3e, 0xbe, 0x1c, 0x4c, 0x33, 0x17, 0xf9, 0xb8, 0xb8,
-	0xc2, 0x4c, 0xa4, 0x83, 0x4d, 0x69, 0xff, 0xdd, 0x15, 0x37, 0xdf, 0xfc, 0xc0, 0xdd, 0xf0, 0x8b,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x12, 0x99, 0xe2, 0xe9, 0x53, 0x02, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x5a, 0x22, 0x38, 0xd1, 0xc5, 0x6a, 0x55, 0xb7, 0x83, 0x13, 0x7e, 0xa8, 0x64,
+	0x49, 0x3c, 0x00, 0x12, 0x42, 0x4c, 0x11, 0xa2, 0xaa, 0x84, 0x4a, 0xe4, 0x20, 0x06, 0x96, 0xd3,
+	0xd9, 0xbe, 0x5c, 0x4f, 0xf1, 0xf9, 0x59, 0xf7, 0x23, 0x75, 0xfa, 0x57, 0x30, 0x32, 0xf6, 0x8f,
+	0x60, 0x67, 0xed, 0x58, 0x31, 0x21, 0x86, 0x0a, 0x25, 0x0b, 0x7f, 0x06, 0xba, 0x3b, 0x4b, 0x0d,
+	0x42, 0x4c, 0xf6, 0x7d, 0xdf, 0xe7, 0x7d, 0xf4, 0x4e, 0xf7, 0xd0, 0x13, 0x26, 0x29, 0xad, 0x66,
+	0x9c, 0x96, 0x45, 0xb2, 0xe0, 0x52, 0x1b, 0x52, 0x32, 0x09, 0xa6, 0x4e, 0x6a, 0x22, 0x89, 0x50,
+	0xa3, 0x5a, 0x82, 0x86, 0x70, 0xff, 0x96, 0x1a, 0x6d, 0x52, 0x87, 0x07, 0x39, 0x28, 0x01, 0x0a,
+	0x3b, 0x2c, 0xf1, 0x07, 0xdf, 0x73, 0xb8, 0xcb, 0x80, 0x81, 0xcf, 0xed, 0x9f, 0x4f, 0x1f, 0x7d,
+	0xdb, 0x42, 0xdd, 0x89, 0x53, 0x87, 0x8f, 0xd1, 0x4e, 0x41, 0x6b, 0x50, 0x5c, 0xe3, 0x82, 0x56,
+	0x20, 0xa2, 0xa0, 0x1f, 0x0c, 0xee, 0xa7, 0x0f, 0xda, 0xf0, 0x8d, 0xcd, 0x42, 0x40, 0x7b, 0x6c,
+	0xc1, 0xb0, 0xd2, 0x64, 0xce, 0x2b, 0x86, 0x6b, 0x2a, 0x71, 0xb6, 0xd4, 0x54, 0x45, 0x77, 0x2c,
+	0x3c, 0x7e, 0x7d, 0x75, 0xd3, 0xeb, 0xfc, 0xbc, 0xe9, 0x1d, 0x31, 0xae, 0xcf, 0x4c, 0x36, 0xca,
+	0x41, 0xb4, 0x53, 0xb4, 0x9f, 0xa1, 0x2a, 0xe6, 0x89, 0x5e, 0xd6, 0x54, 0x8d, 0x4e, 0x2a, 0xfd,
+	0xfd, 0xeb, 0x10, 0xb5, 0x43, 0x9e, 0x54, 0x3a, 0x0d, 0xd9, 0x82, 0x4d, 0xbd, 0x79, 0x42, 0xe5,
+	0xd8, 0x7a, 0xc3, 0x09, 0x3a, 0x12, 0xa4, 0xc1, 0x25, 0xe4, 0xa4, 0xc4, 0xed, 0x5d, 0xb1, 0xbb,
+	0x2c, 0xae, 0x8c, 0xf0, 0x03, 0x98, 0x7c, 0x4e, 0x75, 0xb4, 0xd5, 0x0f, 0x06, 0x3b, 0x69, 0x5f,
+	0x90, 0xe6, 0x9d, 0x85, 0x3f, 0x7a, 0xf6, 0xd8, 0xa2, 0xa7, 0x46, 0x58, 0xa1, 0xe3, 0xc2, 0x14,
+	0x3d, 0xb5, 0x46, 0x56, 0x42, 0xf6, 0x5f, 0xe5, 0x8c, 0x08, 0x5e, 0x2e, 0xa3, 0x6d, 0xa7, 0x7c,
+	0x28, 0x48, 0x73, 0xec, 0xe8, 0x7f, 0x9d, 0x6f, 0x1d, 0x18, 0xbe, 0x44, 0x07, 0xd6, 0xa9, 0x34,
+	0x48, 0x8a, 0x15, 0xbf, 0xa0, 0x9b, 0x96, 0xbb, 0xfd, 0x60, 0xb0, 0x9d, 0xee, 0x09, 0xd2, 0x4c,
+	0x6d, 0x7d, 0xca, 0x2f, 0xe8, 0x6d, 0xe7, 0x0b, 0xb4, 0x3f, 0x03, 0x99, 0xd3, 0x02, 0xab, 0x73,
+	0x52, 0x63, 0x30, 0x1a, 0x6b, 0x2e, 0x28, 0x18, 0x1d, 0x75, 0x5d, 0xdf, 0xae, 0x2f, 0x4f, 0xcf,
+	0x49, 0xfd, 0xde, 0xe8, 0x0f, 0xbe, 0xf6, 0xea, 0xde, 0x97, 0xcb, 0x5e, 0xe7, 0xf7, 0x65, 0x2f,
+	0x18, 0x9f, 0x5e, 0xad, 0xe2, 0xe0, 0x7a, 0x15, 0x07, 0xbf, 0x56, 0x71, 0xf0, 0x79, 0x1d, 0x77,
+	0xae, 0xd7, 0x71, 0xe7, 0xc7, 0x3a, 0xee, 0x7c, 0x7a, 0xbe, 0xf1, 0x08, 0x59, 0x95, 0x0d, 0xf3,
+	0x33, 0xc2, 0xab, 0x64, 0x63, 0xc1, 0x9a, 0xbf, 0x57, 0xcc, 0x3d, 0x4b, 0xd6, 0x75, 0x8b, 0xf1,
+	0xec, 0xcf, 0x00, 0xc5, 0xbd, 0xea, 0x2f, 0x8a, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -171,6 +182,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxStoreSizePerFamily != that1.MaxStoreSizePerFamily {
 		return false
 	}
+	if this.ForcedSwapOutTimeout != that1.ForcedSwapOutTimeout {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -193,6 +207,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ForcedSwapOutTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ForcedSwapOutTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.MaxStoreSizePerFamily != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxStoreSizePerFamily))
 		i--
@@ -260,6 +279,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxStoreSizePerFamily != 0 {
 		n += 1 + sovParams(uint64(m.MaxStoreSizePerFamily))
 	}
+	if m.ForcedSwapOutTimeout != 0 {
+		n += 1 + sovParams(uint64(m.ForcedSwapOutTimeout))
+	}
 	return n
 }
 
@@ -421,6 +443,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedSwapOutTimeout", wireType)
+			}
+			m.ForcedSwapOutTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForcedSwapOutTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
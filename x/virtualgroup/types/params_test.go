@@ -150,6 +150,40 @@ func TestMaxStoreSizePerFamily(t *testing.T) {
 	}
 }
 
+func TestForcedSwapOutTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout interface{}
+		err     string
+	}{
+
+		{
+			name:    "valid",
+			timeout: uint64(1),
+		},
+		{
+			name:    "invalid type",
+			timeout: 1,
+			err:     "invalid parameter type",
+		},
+		{
+			name:    "invalid timeout",
+			timeout: uint64(0),
+			err:     "forced swap out timeout must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateForcedSwapOutTimeout(tt.timeout)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateParams(t *testing.T) {
 	err := DefaultParams().Validate()
 	require.NoError(t, err)
@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -579,941 +581,3368 @@ func (m *AvailableGlobalVirtualGroupFamiliesResponse) GetGlobalVirtualGroupFamil
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.virtualgroup.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.virtualgroup.QueryParamsResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupByFamilyIDRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupByFamilyIDResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamilyRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamilyRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamilyResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamilyResponse")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamiliesRequest")
-	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamiliesResponse")
-	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesRequest)(nil), "greenfield.virtualgroup.AvailableGlobalVirtualGroupFamiliesRequest")
-	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesResponse)(nil), "greenfield.virtualgroup.AvailableGlobalVirtualGroupFamiliesResponse")
+type QueryPlacementCandidateFamiliesRequest struct {
+	// expected_size is the size in bytes expected to be stored in the family
+	ExpectedSize uint64 `protobuf:"varint,1,opt,name=expected_size,json=expectedSize,proto3" json:"expected_size,omitempty"`
+	// limit is the max number of candidates to return, the default value is used if it is 0
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("greenfield/virtualgroup/query.proto", fileDescriptor_83cd53fc415e00e7)
+func (m *QueryPlacementCandidateFamiliesRequest) Reset() {
+	*m = QueryPlacementCandidateFamiliesRequest{}
 }
-
-var fileDescriptor_83cd53fc415e00e7 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0xa8, 0x44, 0x1e, 0x72, 0x19, 0x6a, 0x20, 0x0b, 0xd9, 0xea, 0x82, 0x80, 0x20,
-	0xbb, 0x01, 0x01, 0x89, 0x01, 0x84, 0x42, 0x40, 0x2e, 0x06, 0x1b, 0xa2, 0x89, 0x89, 0x69, 0x66,
-	0xe9, 0x30, 0x6c, 0xb2, 0xdd, 0x5d, 0xba, 0xdb, 0xc6, 0xde, 0x8c, 0x67, 0x0e, 0x26, 0x9e, 0xfc,
-	0x67, 0x3c, 0x73, 0x24, 0xf1, 0xa0, 0x27, 0x35, 0xe0, 0xc5, 0x7f, 0xc1, 0x78, 0x30, 0x9d, 0x99,
-	0x4d, 0xa9, 0xed, 0xf4, 0x17, 0xde, 0x9a, 0xdd, 0xf7, 0xbe, 0xef, 0xfb, 0x79, 0xc3, 0x7c, 0x59,
-	0x18, 0x63, 0x05, 0x4a, 0xbd, 0x43, 0x87, 0xba, 0x39, 0xab, 0xe4, 0x14, 0xa2, 0x22, 0x71, 0x59,
-	0xc1, 0x2f, 0x06, 0xd6, 0x71, 0x91, 0x16, 0xca, 0x66, 0x50, 0xf0, 0x23, 0x1f, 0x0f, 0x55, 0x8b,
-	0xcc, 0xcb, 0x45, 0xda, 0xf4, 0x81, 0x1f, 0xe6, 0xfd, 0xd0, 0xb2, 0x49, 0x48, 0x45, 0x87, 0x55,
-	0x9a, 0xb3, 0x69, 0x44, 0xe6, 0xac, 0x80, 0x30, 0xc7, 0x23, 0x91, 0xe3, 0x7b, 0x42, 0x44, 0x4b,
-	0x32, 0x9f, 0xf9, 0xfc, 0xa7, 0x55, 0xf9, 0x25, 0x9f, 0x8e, 0x32, 0xdf, 0x67, 0x2e, 0xb5, 0x48,
-	0xe0, 0x58, 0xc4, 0xf3, 0xfc, 0x88, 0xb7, 0x84, 0xf2, 0xed, 0xb8, 0xca, 0x5d, 0x40, 0x0a, 0x24,
-	0x1f, 0x57, 0x29, 0x19, 0xa2, 0x72, 0x40, 0x65, 0x91, 0x91, 0x04, 0xfc, 0xbc, 0x62, 0x70, 0x8f,
-	0x77, 0x66, 0xe8, 0x71, 0x91, 0x86, 0x91, 0xb1, 0x0f, 0x83, 0x35, 0x4f, 0xc3, 0xc0, 0xf7, 0x42,
-	0x8a, 0x57, 0xa1, 0x57, 0x4c, 0x18, 0x46, 0x77, 0xd0, 0x54, 0xff, 0x7c, 0xca, 0x54, 0x6c, 0xc0,
-	0x14, 0x8d, 0xe9, 0xeb, 0xa7, 0xdf, 0x52, 0x89, 0x8c, 0x6c, 0x32, 0x5e, 0x82, 0xce, 0x55, 0x77,
-	0x5c, 0xdf, 0x26, 0xee, 0x0b, 0x51, 0xbf, 0x53, 0xa9, 0x97, 0x73, 0xf1, 0x22, 0x0c, 0x31, 0xfe,
-	0x32, 0x2b, 0xd5, 0xb2, 0x5c, 0x2e, 0xeb, 0xe4, 0xf8, 0xc4, 0x81, 0x4c, 0x92, 0xd5, 0xf5, 0xee,
-	0xe6, 0x8c, 0xb7, 0x08, 0x52, 0x4a, 0x65, 0xe9, 0xfd, 0x35, 0x24, 0x1b, 0x49, 0x4b, 0x92, 0x19,
-	0x25, 0x49, 0x03, 0x49, 0x5c, 0x6f, 0xc2, 0xf0, 0x60, 0x4a, 0xe1, 0x20, 0x5d, 0xde, 0x26, 0x79,
-	0xc7, 0x2d, 0xef, 0x6e, 0xc5, 0x94, 0x69, 0xd0, 0x1b, 0x52, 0x1e, 0xf2, 0xba, 0x2a, 0xac, 0x56,
-	0x3f, 0x47, 0x4a, 0xe5, 0x8c, 0x13, 0x04, 0xf7, 0xdb, 0x18, 0x28, 0xe1, 0xb3, 0x70, 0xbb, 0xd1,
-	0xc4, 0xca, 0x39, 0x5e, 0xeb, 0x94, 0x7e, 0xb0, 0xde, 0x55, 0x68, 0x6c, 0xc2, 0xb8, 0xc2, 0x8d,
-	0xf0, 0x12, 0xa3, 0x8f, 0x40, 0xdf, 0xbf, 0x94, 0x37, 0x0f, 0x63, 0xa6, 0x8f, 0x08, 0xee, 0xb5,
-	0x50, 0x91, 0x3c, 0x01, 0x8c, 0x34, 0xd9, 0xa0, 0x3c, 0xd3, 0xb9, 0x0e, 0xa8, 0xa4, 0xfe, 0xb0,
-	0x6a, 0xe3, 0x46, 0x00, 0x13, 0xcd, 0xac, 0x39, 0x34, 0xbe, 0x3b, 0x78, 0x1b, 0xa0, 0x7a, 0xc9,
-	0xa5, 0x95, 0x09, 0x53, 0x24, 0x82, 0x59, 0x49, 0x04, 0x53, 0x64, 0x88, 0x4c, 0x04, 0x73, 0x8f,
-	0x30, 0x2a, 0x7b, 0x33, 0x97, 0x3a, 0x8d, 0x53, 0x04, 0x93, 0x2d, 0x47, 0xca, 0x7d, 0xec, 0xc3,
-	0x2d, 0x56, 0x62, 0x02, 0xdf, 0xa1, 0xf1, 0xb1, 0x76, 0xb1, 0x80, 0x7e, 0x56, 0x62, 0xb1, 0x3a,
-	0xde, 0xa9, 0x21, 0xe9, 0xe1, 0x24, 0x93, 0x2d, 0x49, 0x84, 0xa5, 0x1a, 0x94, 0x02, 0x4c, 0x6f,
-	0x94, 0x88, 0xe3, 0x12, 0xdb, 0xa5, 0xad, 0x17, 0xb8, 0x05, 0xa9, 0xe6, 0xd7, 0x43, 0xf0, 0x0d,
-	0x64, 0x46, 0xd4, 0xf7, 0x23, 0x34, 0x42, 0x98, 0x69, 0x6b, 0xa6, 0xdc, 0xe0, 0x7f, 0x19, 0x3a,
-	0xff, 0xa7, 0x0f, 0x6e, 0xf0, 0x33, 0xc3, 0x27, 0x08, 0x7a, 0x45, 0x08, 0x62, 0xf5, 0xed, 0xaa,
-	0x4f, 0x5e, 0xed, 0x41, 0x7b, 0xc5, 0xc2, 0xb5, 0x31, 0xf9, 0xee, 0xf3, 0xcf, 0x0f, 0x3d, 0x77,
-	0x71, 0xca, 0x6a, 0xfe, 0x1f, 0x01, 0x7f, 0x42, 0x80, 0xeb, 0xb7, 0x80, 0x1f, 0x35, 0x9f, 0xa6,
-	0x0c, 0x6a, 0x6d, 0xb9, 0xf3, 0x46, 0x69, 0x79, 0x91, 0x5b, 0xb6, 0xf0, 0xac, 0xd2, 0x72, 0xa3,
-	0x73, 0xc0, 0xbf, 0x10, 0x8c, 0x36, 0x8b, 0x3a, 0xbc, 0xd1, 0xa9, 0xa3, 0xba, 0x5c, 0xd6, 0xd2,
-	0x57, 0x91, 0x90, 0x78, 0x69, 0x8e, 0xb7, 0x82, 0x1f, 0x77, 0x84, 0x97, 0xb5, 0xcb, 0xd5, 0xbf,
-	0x34, 0xfc, 0x05, 0xc1, 0xb0, 0xea, 0x86, 0xe2, 0xd5, 0x4e, 0x4d, 0xd6, 0x04, 0xb0, 0xb6, 0xd6,
-	0x6d, 0xbb, 0xe4, 0x5b, 0xe1, 0x7c, 0x4b, 0x78, 0xa1, 0x33, 0x3e, 0x01, 0x87, 0xbf, 0x23, 0xd0,
-	0xd4, 0x97, 0x11, 0x3f, 0xe9, 0xca, 0x5c, 0x35, 0x3a, 0xb4, 0xf5, 0xee, 0x05, 0x24, 0xdf, 0x1a,
-	0xe7, 0x5b, 0xc6, 0x4b, 0x5d, 0xf0, 0x55, 0x10, 0x7e, 0x23, 0x18, 0x6b, 0x23, 0x77, 0xf0, 0xa6,
-	0xd2, 0x69, 0xfb, 0x49, 0xa9, 0x6d, 0x5d, 0x4d, 0x44, 0x22, 0x3f, 0xe5, 0xc8, 0x69, 0xbc, 0xae,
-	0x44, 0x26, 0xb1, 0x5a, 0xb6, 0x29, 0x7c, 0xfa, 0xd9, 0xe9, 0xb9, 0x8e, 0xce, 0xce, 0x75, 0xf4,
-	0xe3, 0x5c, 0x47, 0xef, 0x2f, 0xf4, 0xc4, 0xd9, 0x85, 0x9e, 0xf8, 0x7a, 0xa1, 0x27, 0x5e, 0x2d,
-	0x30, 0x27, 0x3a, 0x2a, 0xda, 0xe6, 0x81, 0x9f, 0xb7, 0x6c, 0xcf, 0x9e, 0x3d, 0x38, 0x22, 0x8e,
-	0x77, 0x79, 0xde, 0x9b, 0x06, 0x9f, 0xa8, 0x76, 0x2f, 0xff, 0x46, 0x7d, 0xf8, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0x7c, 0x68, 0xc4, 0x2a, 0x8e, 0x0b, 0x00, 0x00,
+func (m *QueryPlacementCandidateFamiliesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlacementCandidateFamiliesRequest) ProtoMessage()    {}
+func (*QueryPlacementCandidateFamiliesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{12}
+}
+func (m *QueryPlacementCandidateFamiliesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlacementCandidateFamiliesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlacementCandidateFamiliesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlacementCandidateFamiliesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlacementCandidateFamiliesRequest.Merge(m, src)
+}
+func (m *QueryPlacementCandidateFamiliesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlacementCandidateFamiliesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlacementCandidateFamiliesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPlacementCandidateFamiliesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a global virtual group by its id.
-	GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error)
-	// Queries a list of global virtual groups by family id.
-	GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
-	// Queries a global virtual group family by its id.
-	GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error)
-	// Queries a list of GlobalVirtualGroupFamilies items.
-	GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error)
-	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
-	AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
+func (m *QueryPlacementCandidateFamiliesRequest) GetExpectedSize() uint64 {
+	if m != nil {
+		return m.ExpectedSize
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPlacementCandidateFamiliesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type PlacementCandidateFamily struct {
+	// family_id is the identifier of the GlobalVirtualGroupFamily
+	FamilyId uint32 `protobuf:"varint,1,opt,name=family_id,json=familyId,proto3" json:"family_id,omitempty"`
+	// primary_sp_id is the identifier of the primary storage provider of the family
+	PrimarySpId uint32 `protobuf:"varint,2,opt,name=primary_sp_id,json=primarySpId,proto3" json:"primary_sp_id,omitempty"`
+	// available_size is the size in bytes the family can still hold, limited by the staking, the max store size
+	// per family and the free capacity reported by the storage providers of the family
+	AvailableSize uint64 `protobuf:"varint,3,opt,name=available_size,json=availableSize,proto3" json:"available_size,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementCandidateFamily) Reset()         { *m = PlacementCandidateFamily{} }
+func (m *PlacementCandidateFamily) String() string { return proto.CompactTextString(m) }
+func (*PlacementCandidateFamily) ProtoMessage()    {}
+func (*PlacementCandidateFamily) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{13}
+}
+func (m *PlacementCandidateFamily) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlacementCandidateFamily) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlacementCandidateFamily.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PlacementCandidateFamily) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementCandidateFamily.Merge(m, src)
+}
+func (m *PlacementCandidateFamily) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlacementCandidateFamily) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementCandidateFamily.DiscardUnknown(m)
 }
 
-func (c *queryClient) GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error) {
-	out := new(QueryGlobalVirtualGroupResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PlacementCandidateFamily proto.InternalMessageInfo
+
+func (m *PlacementCandidateFamily) GetFamilyId() uint32 {
+	if m != nil {
+		return m.FamilyId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
-	out := new(QueryGlobalVirtualGroupByFamilyIDResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupByFamilyID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementCandidateFamily) GetPrimarySpId() uint32 {
+	if m != nil {
+		return m.PrimarySpId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error) {
-	out := new(QueryGlobalVirtualGroupFamilyResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamily", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PlacementCandidateFamily) GetAvailableSize() uint64 {
+	if m != nil {
+		return m.AvailableSize
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
-	out := new(QueryGlobalVirtualGroupFamiliesResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamilies", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPlacementCandidateFamiliesResponse struct {
+	Candidates []PlacementCandidateFamily `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates"`
 }
 
-func (c *queryClient) AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
-	out := new(AvailableGlobalVirtualGroupFamiliesResponse)
-	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPlacementCandidateFamiliesResponse) Reset() {
+	*m = QueryPlacementCandidateFamiliesResponse{}
+}
+func (m *QueryPlacementCandidateFamiliesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlacementCandidateFamiliesResponse) ProtoMessage()    {}
+func (*QueryPlacementCandidateFamiliesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{14}
+}
+func (m *QueryPlacementCandidateFamiliesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlacementCandidateFamiliesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlacementCandidateFamiliesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPlacementCandidateFamiliesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlacementCandidateFamiliesResponse.Merge(m, src)
+}
+func (m *QueryPlacementCandidateFamiliesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlacementCandidateFamiliesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlacementCandidateFamiliesResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a global virtual group by its id.
-	GlobalVirtualGroup(context.Context, *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error)
-	// Queries a list of global virtual groups by family id.
-	GlobalVirtualGroupByFamilyID(context.Context, *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
-	// Queries a global virtual group family by its id.
-	GlobalVirtualGroupFamily(context.Context, *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error)
-	// Queries a list of GlobalVirtualGroupFamilies items.
-	GlobalVirtualGroupFamilies(context.Context, *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error)
-	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
-	AvailableGlobalVirtualGroupFamilies(context.Context, *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
+var xxx_messageInfo_QueryPlacementCandidateFamiliesResponse proto.InternalMessageInfo
+
+func (m *QueryPlacementCandidateFamiliesResponse) GetCandidates() []PlacementCandidateFamily {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryGlobalVirtualGroupsBySecondarySPRequest struct {
+	// storage_provider_id is the id of the secondary storage provider
+	StorageProviderId uint32             `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	Pagination        *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) Reset() {
+	*m = QueryGlobalVirtualGroupsBySecondarySPRequest{}
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroup(ctx context.Context, req *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroup not implemented")
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroupByFamilyID(ctx context.Context, req *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupByFamilyID not implemented")
+func (*QueryGlobalVirtualGroupsBySecondarySPRequest) ProtoMessage() {}
+func (*QueryGlobalVirtualGroupsBySecondarySPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{15}
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroupFamily(ctx context.Context, req *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamily not implemented")
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GlobalVirtualGroupFamilies(ctx context.Context, req *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamilies not implemented")
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AvailableGlobalVirtualGroupFamilies(ctx context.Context, req *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AvailableGlobalVirtualGroupFamilies not implemented")
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.virtualgroup.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPRequest proto.InternalMessageInfo
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GlobalVirtualGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroup(ctx, req.(*QueryGlobalVirtualGroupRequest))
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GlobalVirtualGroupByFamilyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupByFamilyIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupByFamilyID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, req.(*QueryGlobalVirtualGroupByFamilyIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGlobalVirtualGroupsBySecondarySPResponse struct {
+	GlobalVirtualGroups []*GlobalVirtualGroup `protobuf:"bytes,1,rep,name=global_virtual_groups,json=globalVirtualGroups,proto3" json:"global_virtual_groups,omitempty"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupFamilyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamily",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, req.(*QueryGlobalVirtualGroupFamilyRequest))
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) Reset() {
+	*m = QueryGlobalVirtualGroupsBySecondarySPResponse{}
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGlobalVirtualGroupsBySecondarySPResponse) ProtoMessage() {}
+func (*QueryGlobalVirtualGroupsBySecondarySPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{16}
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPResponse.Merge(m, src)
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPResponse.DiscardUnknown(m)
 }
 
-func _Query_GlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGlobalVirtualGroupFamiliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamilies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, req.(*QueryGlobalVirtualGroupFamiliesRequest))
+var xxx_messageInfo_QueryGlobalVirtualGroupsBySecondarySPResponse proto.InternalMessageInfo
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) GetGlobalVirtualGroups() []*GlobalVirtualGroup {
+	if m != nil {
+		return m.GlobalVirtualGroups
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AvailableGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AvailableGlobalVirtualGroupFamiliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/greenfield.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, req.(*AvailableGlobalVirtualGroupFamiliesRequest))
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "greenfield.virtualgroup.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroup",
-			Handler:    _Query_GlobalVirtualGroup_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroupByFamilyID",
-			Handler:    _Query_GlobalVirtualGroupByFamilyID_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroupFamily",
-			Handler:    _Query_GlobalVirtualGroupFamily_Handler,
-		},
-		{
-			MethodName: "GlobalVirtualGroupFamilies",
-			Handler:    _Query_GlobalVirtualGroupFamilies_Handler,
-		},
-		{
-			MethodName: "AvailableGlobalVirtualGroupFamilies",
-			Handler:    _Query_AvailableGlobalVirtualGroupFamilies_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "greenfield/virtualgroup/query.proto",
+type QueryGlobalVirtualGroupFamiliesByPrimarySPRequest struct {
+	// storage_provider_id is the id of the primary storage provider
+	StorageProviderId uint32             `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	Pagination        *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) Reset() {
+	*m = QueryGlobalVirtualGroupFamiliesByPrimarySPRequest{}
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) ProtoMessage() {}
+func (*QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{17}
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPRequest.Merge(m, src)
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPRequest proto.InternalMessageInfo
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGlobalVirtualGroupFamiliesByPrimarySPResponse struct {
+	GlobalVirtualGroupFamilies []*GlobalVirtualGroupFamily `protobuf:"bytes,1,rep,name=global_virtual_group_families,json=globalVirtualGroupFamilies,proto3" json:"global_virtual_group_families,omitempty"`
+	Pagination                 *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) Reset() {
+	*m = QueryGlobalVirtualGroupFamiliesByPrimarySPResponse{}
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) ProtoMessage() {}
+func (*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{18}
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGlobalVirtualGroupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPResponse.Merge(m, src)
 }
-
-func (m *QueryGlobalVirtualGroupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGlobalVirtualGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryGlobalVirtualGroupFamiliesByPrimarySPResponse proto.InternalMessageInfo
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) GetGlobalVirtualGroupFamilies() []*GlobalVirtualGroupFamily {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilies
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySPExitProgressRequest struct {
+	// storage_provider_id is the id of the exiting storage provider
+	StorageProviderId uint32 `protobuf:"varint,1,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
 }
 
-func (m *QueryGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		{
-			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySPExitProgressRequest) Reset()         { *m = QuerySPExitProgressRequest{} }
+func (m *QuerySPExitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySPExitProgressRequest) ProtoMessage()    {}
+func (*QuerySPExitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{19}
+}
+func (m *QuerySPExitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySPExitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySPExitProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySPExitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySPExitProgressRequest.Merge(m, src)
+}
+func (m *QuerySPExitProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySPExitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySPExitProgressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySPExitProgressRequest proto.InternalMessageInfo
+
+func (m *QuerySPExitProgressRequest) GetStorageProviderId() uint32 {
+	if m != nil {
+		return m.StorageProviderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SPExitCheckItem is an item of the checklist for a storage provider to complete its exit.
+type SPExitCheckItem struct {
+	// name is the name of the check
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// passed is true if the check is satisfied
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// detail describes what is still left
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
-		i--
-		dAtA[i] = 0x8
+func (m *SPExitCheckItem) Reset()         { *m = SPExitCheckItem{} }
+func (m *SPExitCheckItem) String() string { return proto.CompactTextString(m) }
+func (*SPExitCheckItem) ProtoMessage()    {}
+func (*SPExitCheckItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{20}
+}
+func (m *SPExitCheckItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SPExitCheckItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SPExitCheckItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SPExitCheckItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SPExitCheckItem.Merge(m, src)
+}
+func (m *SPExitCheckItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SPExitCheckItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SPExitCheckItem.DiscardUnknown(m)
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SPExitCheckItem proto.InternalMessageInfo
+
+func (m *SPExitCheckItem) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SPExitCheckItem) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroups) > 0 {
-		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *SPExitCheckItem) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+type QuerySPExitProgressResponse struct {
+	// exitable is true if the storage provider can complete its exit now
+	Exitable bool `protobuf:"varint,1,opt,name=exitable,proto3" json:"exitable,omitempty"`
+	// exit_time is the unix time when the storage provider started to exit, 0 if it is not exiting
+	ExitTime int64 `protobuf:"varint,2,opt,name=exit_time,json=exitTime,proto3" json:"exit_time,omitempty"`
+	// primary_count is the number of global virtual groups the storage provider still serves as the primary sp
+	PrimaryCount uint32 `protobuf:"varint,3,opt,name=primary_count,json=primaryCount,proto3" json:"primary_count,omitempty"`
+	// secondary_count is the number of global virtual groups the storage provider still serves as a secondary sp
+	SecondaryCount uint32 `protobuf:"varint,4,opt,name=secondary_count,json=secondaryCount,proto3" json:"secondary_count,omitempty"`
+	// swapping_out_family_ids are the families which the storage provider is swapping out from
+	SwappingOutFamilyIds []uint32 `protobuf:"varint,5,rep,packed,name=swapping_out_family_ids,json=swappingOutFamilyIds,proto3" json:"swapping_out_family_ids,omitempty"`
+	// swapping_out_gvg_ids are the global virtual groups which the storage provider is swapping out from as a secondary sp
+	SwappingOutGvgIds []uint32 `protobuf:"varint,6,rep,packed,name=swapping_out_gvg_ids,json=swappingOutGvgIds,proto3" json:"swapping_out_gvg_ids,omitempty"`
+	// migrating_out_bucket_ids are the buckets migrating away from the storage provider
+	MigratingOutBucketIds []cosmossdk_io_math.Uint `protobuf:"bytes,7,rep,name=migrating_out_bucket_ids,json=migratingOutBucketIds,proto3,customtype=cosmossdk.io/math.Uint" json:"migrating_out_bucket_ids"`
+	// migrating_in_bucket_ids are the buckets migrating to the storage provider
+	MigratingInBucketIds []cosmossdk_io_math.Uint `protobuf:"bytes,8,rep,name=migrating_in_bucket_ids,json=migratingInBucketIds,proto3,customtype=cosmossdk.io/math.Uint" json:"migrating_in_bucket_ids"`
+	// checklist shows the checks for the storage provider to complete its exit
+	Checklist []SPExitCheckItem `protobuf:"bytes,9,rep,name=checklist,proto3" json:"checklist"`
+}
+
+func (m *QuerySPExitProgressResponse) Reset()         { *m = QuerySPExitProgressResponse{} }
+func (m *QuerySPExitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySPExitProgressResponse) ProtoMessage()    {}
+func (*QuerySPExitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83cd53fc415e00e7, []int{21}
+}
+func (m *QuerySPExitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySPExitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySPExitProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySPExitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySPExitProgressResponse.Merge(m, src)
+}
+func (m *QuerySPExitProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySPExitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySPExitProgressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySPExitProgressResponse proto.InternalMessageInfo
+
+func (m *QuerySPExitProgressResponse) GetExitable() bool {
+	if m != nil {
+		return m.Exitable
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySPExitProgressResponse) GetExitTime() int64 {
+	if m != nil {
+		return m.ExitTime
+	}
+	return 0
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FamilyId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FamilyId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySPExitProgressResponse) GetPrimaryCount() uint32 {
+	if m != nil {
+		return m.PrimaryCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySPExitProgressResponse) GetSecondaryCount() uint32 {
+	if m != nil {
+		return m.SecondaryCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySPExitProgressResponse) GetSwappingOutFamilyIds() []uint32 {
+	if m != nil {
+		return m.SwappingOutFamilyIds
+	}
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamily != nil {
-		{
-			size, err := m.GlobalVirtualGroupFamily.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySPExitProgressResponse) GetSwappingOutGvgIds() []uint32 {
+	if m != nil {
+		return m.SwappingOutGvgIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySPExitProgressResponse) GetChecklist() []SPExitCheckItem {
+	if m != nil {
+		return m.Checklist
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "greenfield.virtualgroup.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "greenfield.virtualgroup.QueryParamsResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupByFamilyIDRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupByFamilyIDResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupByFamilyIDResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamilyRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamilyRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamilyResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamilyResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamiliesRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamiliesResponse")
+	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesRequest)(nil), "greenfield.virtualgroup.AvailableGlobalVirtualGroupFamiliesRequest")
+	proto.RegisterType((*AvailableGlobalVirtualGroupFamiliesResponse)(nil), "greenfield.virtualgroup.AvailableGlobalVirtualGroupFamiliesResponse")
+	proto.RegisterType((*QueryPlacementCandidateFamiliesRequest)(nil), "greenfield.virtualgroup.QueryPlacementCandidateFamiliesRequest")
+	proto.RegisterType((*PlacementCandidateFamily)(nil), "greenfield.virtualgroup.PlacementCandidateFamily")
+	proto.RegisterType((*QueryPlacementCandidateFamiliesResponse)(nil), "greenfield.virtualgroup.QueryPlacementCandidateFamiliesResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupsBySecondarySPRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupsBySecondarySPRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupsBySecondarySPResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupsBySecondarySPResponse")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesByPrimarySPRequest)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamiliesByPrimarySPRequest")
+	proto.RegisterType((*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse)(nil), "greenfield.virtualgroup.QueryGlobalVirtualGroupFamiliesByPrimarySPResponse")
+	proto.RegisterType((*QuerySPExitProgressRequest)(nil), "greenfield.virtualgroup.QuerySPExitProgressRequest")
+	proto.RegisterType((*SPExitCheckItem)(nil), "greenfield.virtualgroup.SPExitCheckItem")
+	proto.RegisterType((*QuerySPExitProgressResponse)(nil), "greenfield.virtualgroup.QuerySPExitProgressResponse")
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("greenfield/virtualgroup/query.proto", fileDescriptor_83cd53fc415e00e7)
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_83cd53fc415e00e7 = []byte{
+	// 1451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6c, 0x13, 0xc7,
+	0x17, 0xcf, 0x26, 0x21, 0xff, 0xe4, 0x05, 0x83, 0x18, 0x0c, 0xc9, 0x7f, 0x43, 0x1d, 0xba, 0x7c,
+	0x24, 0x05, 0xe2, 0x55, 0x80, 0x00, 0xaa, 0xf8, 0x74, 0x80, 0x34, 0x2d, 0x2a, 0xae, 0x13, 0x40,
+	0x42, 0x54, 0xd6, 0xd8, 0x3b, 0xd9, 0x8c, 0xb0, 0x77, 0x97, 0xdd, 0xb1, 0x1b, 0x53, 0x55, 0xaa,
+	0x38, 0x23, 0xb5, 0x52, 0x4f, 0xbd, 0x57, 0x55, 0xa5, 0x5e, 0x7b, 0xee, 0xa9, 0x07, 0x8e, 0xa8,
+	0x3d, 0xf4, 0x43, 0x2a, 0xad, 0xa0, 0x97, 0x9e, 0x7b, 0xeb, 0xa9, 0xda, 0x99, 0x59, 0xaf, 0x13,
+	0x7b, 0xfc, 0x95, 0xa8, 0xbd, 0x79, 0x67, 0xe6, 0xfd, 0xde, 0xef, 0xf7, 0x66, 0xde, 0x9b, 0x37,
+	0x86, 0x23, 0xb6, 0x4f, 0x88, 0xb3, 0x46, 0x49, 0xc9, 0x32, 0xab, 0xd4, 0x67, 0x15, 0x5c, 0xb2,
+	0x7d, 0xb7, 0xe2, 0x99, 0x8f, 0x2a, 0xc4, 0xaf, 0xa5, 0x3d, 0xdf, 0x65, 0x2e, 0x9a, 0x88, 0x17,
+	0xa5, 0x1b, 0x17, 0xe9, 0x27, 0x8a, 0x6e, 0x50, 0x76, 0x03, 0xb3, 0x80, 0x03, 0x22, 0x2c, 0xcc,
+	0xea, 0x7c, 0x81, 0x30, 0x3c, 0x6f, 0x7a, 0xd8, 0xa6, 0x0e, 0x66, 0xd4, 0x75, 0x04, 0x88, 0xfe,
+	0x7f, 0xb1, 0x36, 0xcf, 0xbf, 0x4c, 0xf1, 0x21, 0xa7, 0x92, 0xb6, 0x6b, 0xbb, 0x62, 0x3c, 0xfc,
+	0x25, 0x47, 0x0f, 0xd9, 0xae, 0x6b, 0x97, 0x88, 0x89, 0x3d, 0x6a, 0x62, 0xc7, 0x71, 0x19, 0x47,
+	0x8b, 0x6c, 0x8e, 0xaa, 0x88, 0x7b, 0xd8, 0xc7, 0xe5, 0x68, 0x95, 0x52, 0x1e, 0xab, 0x79, 0x44,
+	0x2e, 0x32, 0x92, 0x80, 0xde, 0x0b, 0xb9, 0x67, 0xb9, 0x65, 0x8e, 0x3c, 0xaa, 0x90, 0x80, 0x19,
+	0xab, 0xb0, 0x7f, 0xd3, 0x68, 0xe0, 0xb9, 0x4e, 0x40, 0xd0, 0x25, 0x18, 0x11, 0x1e, 0x26, 0xb5,
+	0xc3, 0xda, 0xec, 0xf8, 0xe9, 0xe9, 0xb4, 0x22, 0x38, 0x69, 0x61, 0x98, 0x19, 0x7e, 0xf6, 0x62,
+	0x7a, 0x20, 0x27, 0x8d, 0x8c, 0x7b, 0x90, 0xe2, 0xa8, 0x4b, 0x25, 0xb7, 0x80, 0x4b, 0x77, 0xc5,
+	0xfa, 0xa5, 0x70, 0xbd, 0xf4, 0x8b, 0x16, 0x60, 0xc2, 0xe6, 0x93, 0x79, 0x89, 0x96, 0xe7, 0x70,
+	0x79, 0x6a, 0x71, 0x8f, 0x89, 0x5c, 0xd2, 0x6e, 0xb2, 0x5d, 0xb6, 0x8c, 0x8f, 0x35, 0x98, 0x56,
+	0x22, 0x4b, 0xee, 0xef, 0x43, 0xb2, 0x15, 0xb4, 0x54, 0x72, 0x52, 0xa9, 0xa4, 0x05, 0x24, 0x6a,
+	0x26, 0x61, 0x38, 0x30, 0xab, 0x60, 0x90, 0xa9, 0xdd, 0xc4, 0x65, 0x5a, 0xaa, 0x2d, 0x5f, 0x8f,
+	0x54, 0x66, 0x20, 0xd5, 0x52, 0xe5, 0x1a, 0x5f, 0x17, 0x8b, 0xd5, 0x9b, 0xfd, 0x48, 0x28, 0xcb,
+	0x78, 0xaa, 0xc1, 0x1b, 0x5d, 0x38, 0x94, 0xe2, 0xf3, 0x70, 0xa0, 0x95, 0xc7, 0x70, 0x1f, 0x87,
+	0x7a, 0x55, 0xbf, 0xbf, 0x99, 0x55, 0x60, 0x2c, 0xc2, 0x51, 0x05, 0x1b, 0xc1, 0x25, 0x92, 0x3e,
+	0x05, 0x63, 0x5b, 0x55, 0x8e, 0xae, 0x45, 0x9a, 0x3e, 0xd7, 0xe0, 0x58, 0x07, 0x14, 0xa9, 0xc7,
+	0x83, 0xa9, 0x36, 0x11, 0x94, 0x7b, 0x3a, 0xdf, 0x83, 0x2a, 0x89, 0x3f, 0xa9, 0x8a, 0xb8, 0xe1,
+	0xc1, 0xf1, 0x76, 0xd4, 0x28, 0x89, 0x72, 0x07, 0xdd, 0x04, 0x88, 0xf3, 0x5f, 0x52, 0x39, 0x9e,
+	0x96, 0x39, 0x1f, 0x16, 0x8b, 0xb4, 0x28, 0x2f, 0xb2, 0x58, 0xa4, 0xb3, 0xd8, 0x26, 0xd2, 0x36,
+	0xd7, 0x60, 0x69, 0x3c, 0xd3, 0x60, 0xa6, 0xa3, 0x4b, 0x19, 0x8f, 0x55, 0xd8, 0x6d, 0x57, 0x6d,
+	0x21, 0x9f, 0x92, 0x68, 0x5b, 0xfb, 0x08, 0xc0, 0xb8, 0x5d, 0xb5, 0x23, 0x74, 0xb4, 0xb4, 0x49,
+	0xc9, 0x20, 0x57, 0x32, 0xd3, 0x51, 0x89, 0xa0, 0xb4, 0x49, 0x8a, 0x0f, 0x27, 0xae, 0x55, 0x31,
+	0x2d, 0xe1, 0x42, 0x89, 0x74, 0x0e, 0xe0, 0x75, 0x98, 0x6e, 0x9f, 0x1e, 0x42, 0x5f, 0x22, 0x37,
+	0xa5, 0xce, 0x8f, 0xc0, 0x08, 0xe0, 0x64, 0x57, 0x3e, 0x65, 0x04, 0x77, 0xc6, 0x69, 0x51, 0x9e,
+	0x92, 0x6c, 0x09, 0x17, 0x49, 0x99, 0x38, 0x6c, 0x11, 0x3b, 0x16, 0xb5, 0x30, 0x23, 0x5b, 0x45,
+	0x1e, 0x81, 0x04, 0xd9, 0xf0, 0x48, 0x91, 0x11, 0x2b, 0x1f, 0xd0, 0xc7, 0x84, 0x1f, 0x94, 0xe1,
+	0xdc, 0xee, 0x68, 0x70, 0x85, 0x3e, 0x26, 0x28, 0x09, 0xbb, 0x4a, 0xb4, 0x4c, 0x19, 0x8f, 0x7d,
+	0x22, 0x27, 0x3e, 0x8c, 0x27, 0x1a, 0x4c, 0x2a, 0x1c, 0xd4, 0xda, 0x26, 0x18, 0x32, 0x20, 0xe1,
+	0xf9, 0xb4, 0x8c, 0xfd, 0x5a, 0x3e, 0xe0, 0x45, 0x55, 0xe0, 0x8e, 0xcb, 0xc1, 0x15, 0x6f, 0xd9,
+	0x42, 0xc7, 0x60, 0x0f, 0x8e, 0xe2, 0x26, 0x98, 0x0d, 0x71, 0x66, 0x89, 0xfa, 0x68, 0x48, 0xcd,
+	0x78, 0x12, 0x9d, 0xce, 0x76, 0x52, 0x65, 0x6c, 0xef, 0x01, 0x14, 0xa3, 0xc9, 0xce, 0x67, 0x53,
+	0x25, 0x4d, 0x5e, 0x26, 0x0d, 0x50, 0xc6, 0x97, 0x1a, 0x9c, 0x52, 0xa4, 0x48, 0x90, 0xa9, 0xad,
+	0x90, 0xa2, 0xeb, 0x58, 0xa1, 0xae, 0x6c, 0x14, 0xf5, 0x34, 0xec, 0x0f, 0x98, 0xeb, 0x63, 0x9b,
+	0x84, 0x57, 0x71, 0x95, 0x5a, 0xc4, 0x8f, 0xe3, 0xb4, 0x4f, 0x4e, 0x65, 0xe5, 0xcc, 0xb2, 0xb5,
+	0x25, 0x97, 0x07, 0xfb, 0xce, 0xe5, 0x9f, 0x35, 0x98, 0xeb, 0x92, 0xe8, 0xbf, 0x54, 0xb1, 0x77,
+	0x2e, 0xb9, 0xbf, 0xd6, 0x60, 0xbe, 0x43, 0x9d, 0xca, 0xd4, 0xb2, 0xf2, 0x84, 0xfd, 0xe7, 0x3b,
+	0xf1, 0x97, 0x06, 0xa7, 0x7b, 0x61, 0x2b, 0xb7, 0x83, 0xc1, 0x6b, 0xea, 0xf2, 0xb0, 0xad, 0x8a,
+	0xab, 0xba, 0xe4, 0x77, 0xb4, 0x00, 0xdf, 0x02, 0x9d, 0x8b, 0x5e, 0xc9, 0xde, 0xd8, 0xa0, 0x2c,
+	0xeb, 0xbb, 0xb6, 0x4f, 0x82, 0xa0, 0xcf, 0xbd, 0x30, 0xee, 0xc0, 0x5e, 0x01, 0xb4, 0xb8, 0x4e,
+	0x8a, 0x0f, 0x97, 0x19, 0x29, 0x23, 0x04, 0xc3, 0x0e, 0x2e, 0x8b, 0x2a, 0x36, 0x96, 0xe3, 0xbf,
+	0xd1, 0xc1, 0xb0, 0x5b, 0x0c, 0x02, 0x22, 0xca, 0xcc, 0x68, 0x4e, 0x7e, 0x85, 0xe3, 0x16, 0x61,
+	0x98, 0x96, 0x78, 0x65, 0x19, 0xcb, 0xc9, 0x2f, 0xe3, 0xab, 0x61, 0x98, 0x6a, 0xc9, 0x52, 0xee,
+	0x81, 0x0e, 0xa3, 0x64, 0x83, 0xb2, 0xb0, 0x04, 0x71, 0x3f, 0xa3, 0xb9, 0xfa, 0x77, 0x58, 0xf6,
+	0xc2, 0xdf, 0x79, 0x46, 0xcb, 0x84, 0xbb, 0x1b, 0x12, 0x93, 0xab, 0xb4, 0x4c, 0xc2, 0x5a, 0x1b,
+	0x95, 0xbd, 0xa2, 0x5b, 0x71, 0x18, 0xf7, 0x9b, 0xc8, 0xed, 0x96, 0x83, 0x8b, 0xe1, 0x18, 0x9a,
+	0x81, 0xbd, 0x41, 0x94, 0x87, 0x72, 0xd9, 0x30, 0x5f, 0xb6, 0xa7, 0x3e, 0x2c, 0x16, 0x2e, 0xc0,
+	0x44, 0xf0, 0x01, 0xf6, 0x3c, 0xea, 0xd8, 0x79, 0xb7, 0xc2, 0x1a, 0x6f, 0x88, 0x5d, 0xfc, 0x86,
+	0x48, 0x46, 0xd3, 0xb7, 0x2b, 0xac, 0x7e, 0x35, 0x20, 0x13, 0x92, 0x9b, 0xcc, 0xc2, 0xfb, 0x3a,
+	0xb4, 0x19, 0xe1, 0x36, 0xfb, 0x1a, 0x6c, 0x96, 0xaa, 0x76, 0x68, 0xb0, 0x06, 0x93, 0x65, 0x6a,
+	0xfb, 0x98, 0x45, 0x16, 0x85, 0x4a, 0xf1, 0x21, 0x61, 0xdc, 0xe8, 0x7f, 0x87, 0x87, 0x66, 0xc7,
+	0x32, 0x73, 0x61, 0x41, 0xfc, 0xe5, 0xc5, 0xf4, 0x41, 0x71, 0x22, 0x02, 0xeb, 0x61, 0x9a, 0xba,
+	0x66, 0x19, 0xb3, 0xf5, 0xf4, 0x1d, 0xea, 0xb0, 0xef, 0xbf, 0x99, 0x1b, 0x17, 0x33, 0xfc, 0x33,
+	0x77, 0xa0, 0x0e, 0x77, 0xbb, 0xc2, 0x32, 0x1c, 0x2c, 0xf4, 0x63, 0xc1, 0x44, 0xec, 0x87, 0x3a,
+	0x8d, 0x6e, 0x46, 0xfb, 0x71, 0x93, 0xac, 0xa3, 0x2d, 0x3b, 0xb1, 0x97, 0x5b, 0x30, 0x56, 0x0c,
+	0x4f, 0x4b, 0x89, 0x06, 0x6c, 0x72, 0x8c, 0x27, 0xcb, 0xac, 0x32, 0x59, 0xb6, 0x9c, 0x2e, 0x59,
+	0xf9, 0x63, 0x80, 0xd3, 0xbf, 0xee, 0x83, 0x5d, 0xfc, 0xa8, 0xa0, 0xa7, 0x1a, 0x8c, 0x88, 0xc7,
+	0x06, 0x52, 0xd7, 0xc4, 0xe6, 0x17, 0x8e, 0x7e, 0xaa, 0xbb, 0xc5, 0xe2, 0xe8, 0x19, 0x33, 0x4f,
+	0x7e, 0xf8, 0xe3, 0xb3, 0xc1, 0xd7, 0xd1, 0xb4, 0xd9, 0xfe, 0xe5, 0x85, 0xbe, 0xd5, 0x00, 0x35,
+	0xa7, 0x3a, 0x3a, 0xdf, 0xde, 0x9b, 0xf2, 0x41, 0xa4, 0x5f, 0xe8, 0xdd, 0x50, 0x52, 0x5e, 0xe0,
+	0x94, 0x4d, 0x34, 0xa7, 0xa4, 0xdc, 0xaa, 0xa0, 0xa1, 0x3f, 0x35, 0x38, 0xd4, 0xee, 0x49, 0x81,
+	0xae, 0xf5, 0xca, 0xa8, 0xe9, 0xfd, 0xa3, 0x67, 0xb6, 0x03, 0x21, 0xe5, 0x65, 0xb8, 0xbc, 0x8b,
+	0xe8, 0xcd, 0x9e, 0xe4, 0xe5, 0x0b, 0xb5, 0x38, 0x5f, 0xd1, 0x8f, 0x1a, 0x4c, 0xaa, 0xea, 0x32,
+	0xba, 0xd4, 0x2b, 0xc9, 0x4d, 0x0f, 0x1d, 0xfd, 0x72, 0xbf, 0xe6, 0x52, 0xdf, 0x45, 0xae, 0xef,
+	0x1c, 0x3a, 0xdb, 0x9b, 0x3e, 0x21, 0x0e, 0xfd, 0xa6, 0x81, 0xae, 0xbe, 0xe0, 0xd0, 0x95, 0xbe,
+	0xc8, 0xc5, 0xdd, 0xab, 0x7e, 0xb5, 0x7f, 0x00, 0xa9, 0xef, 0x32, 0xd7, 0x77, 0x01, 0x9d, 0xeb,
+	0x43, 0x5f, 0x28, 0xe1, 0x6f, 0x0d, 0x8e, 0x74, 0xd1, 0xdf, 0xa3, 0x45, 0x25, 0xd3, 0xee, 0x5f,
+	0x24, 0xfa, 0xf5, 0xed, 0x81, 0x48, 0xc9, 0x6f, 0x71, 0xc9, 0x19, 0x74, 0x55, 0x29, 0x39, 0x6e,
+	0xbc, 0xdb, 0x8b, 0x7f, 0xa1, 0x81, 0xae, 0xee, 0xbb, 0x3b, 0x6d, 0x6f, 0xc7, 0xc7, 0x89, 0x7e,
+	0xb5, 0x7f, 0x00, 0xa9, 0xf5, 0x12, 0xd7, 0x7a, 0x1e, 0x2d, 0xa8, 0x0b, 0x66, 0x04, 0x92, 0xaf,
+	0x37, 0xf4, 0xb1, 0xc0, 0x4f, 0x06, 0xe1, 0x70, 0xa7, 0x56, 0x19, 0xdd, 0xe8, 0xf5, 0x10, 0xb6,
+	0x7c, 0x13, 0xe8, 0x37, 0xb7, 0x0b, 0x23, 0x25, 0x3f, 0xe0, 0x92, 0xef, 0xa2, 0xd5, 0x9e, 0x4e,
+	0x74, 0x10, 0x96, 0xa4, 0xb8, 0xf1, 0x08, 0x3c, 0xf3, 0xc3, 0x16, 0xbd, 0xd8, 0x47, 0xe8, 0x8b,
+	0x41, 0x38, 0xd6, 0x55, 0xcb, 0x8a, 0xde, 0xee, 0x37, 0x37, 0x9b, 0xbb, 0x74, 0xfd, 0x9d, 0x1d,
+	0xc1, 0x92, 0x01, 0x2a, 0xf0, 0x00, 0x3d, 0x40, 0xf7, 0xfb, 0x4b, 0xf9, 0x30, 0x50, 0xf1, 0xeb,
+	0x55, 0x11, 0xa6, 0xef, 0x34, 0xd8, 0xb3, 0xb9, 0x7d, 0x44, 0x67, 0xda, 0x6b, 0x68, 0xd9, 0x12,
+	0xeb, 0x67, 0x7b, 0x33, 0x92, 0x0a, 0x97, 0xb8, 0xc2, 0x6b, 0xe8, 0x8a, 0x52, 0x61, 0xe0, 0xe5,
+	0x79, 0x9f, 0xea, 0x49, 0xd3, 0xd6, 0x32, 0x32, 0xef, 0x3e, 0x7b, 0x99, 0xd2, 0x9e, 0xbf, 0x4c,
+	0x69, 0xbf, 0xbf, 0x4c, 0x69, 0x9f, 0xbe, 0x4a, 0x0d, 0x3c, 0x7f, 0x95, 0x1a, 0xf8, 0xe9, 0x55,
+	0x6a, 0xe0, 0xfe, 0x59, 0x9b, 0xb2, 0xf5, 0x4a, 0x21, 0x5d, 0x74, 0xcb, 0x66, 0xc1, 0x29, 0xcc,
+	0x15, 0xd7, 0x31, 0x75, 0x1a, 0xdd, 0x6d, 0xb4, 0xf8, 0xaf, 0xb7, 0x30, 0xc2, 0xff, 0xec, 0x3d,
+	0xf3, 0xcf, 0x00, 0xbb, 0xfb, 0x26, 0xb6, 0xf2, 0x16, 0x00, 0x00,
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a global virtual group by its id.
+	GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error)
+	// Queries a list of global virtual groups by family id.
+	GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
+	// Queries a global virtual group family by its id.
+	GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error)
+	// Queries a list of GlobalVirtualGroupFamilies items.
+	GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error)
+	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
+	AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
+	// PlacementCandidateFamilies returns the GlobalVirtualGroupFamilies which can hold the expected size, ranked by the available size
+	PlacementCandidateFamilies(ctx context.Context, in *QueryPlacementCandidateFamiliesRequest, opts ...grpc.CallOption) (*QueryPlacementCandidateFamiliesResponse, error)
+	// Queries a list of global virtual groups which the storage provider serves as a secondary sp.
+	GlobalVirtualGroupsBySecondarySP(ctx context.Context, in *QueryGlobalVirtualGroupsBySecondarySPRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupsBySecondarySPResponse, error)
+	// Queries a list of global virtual group families which the storage provider serves as the primary sp.
+	GlobalVirtualGroupFamiliesByPrimarySP(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse, error)
+	// SPExitProgress queries what is left before the storage provider can complete its exit.
+	SPExitProgress(ctx context.Context, in *QuerySPExitProgressRequest, opts ...grpc.CallOption) (*QuerySPExitProgressResponse, error)
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GvgFamilies) > 0 {
-		for iNdEx := len(m.GvgFamilies) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GvgFamilies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GlobalVirtualGroup(ctx context.Context, in *QueryGlobalVirtualGroupRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupResponse, error) {
+	out := new(QueryGlobalVirtualGroupResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		dAtA7 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
-		var j6 int
-		for _, num := range m.GlobalVirtualGroupFamilyIds {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintQuery(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GlobalVirtualGroupByFamilyID(ctx context.Context, in *QueryGlobalVirtualGroupByFamilyIDRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
+	out := new(QueryGlobalVirtualGroupByFamilyIDResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupByFamilyID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GlobalVirtualGroupFamily(ctx context.Context, in *QueryGlobalVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamilyResponse, error) {
+	out := new(QueryGlobalVirtualGroupFamilyResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamily", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GlobalVirtualGroupFamilies(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
+	out := new(QueryGlobalVirtualGroupFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamilies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		dAtA9 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
-		var j8 int
-		for _, num := range m.GlobalVirtualGroupFamilyIds {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintQuery(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AvailableGlobalVirtualGroupFamilies(ctx context.Context, in *AvailableGlobalVirtualGroupFamiliesRequest, opts ...grpc.CallOption) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
+	out := new(AvailableGlobalVirtualGroupFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) PlacementCandidateFamilies(ctx context.Context, in *QueryPlacementCandidateFamiliesRequest, opts ...grpc.CallOption) (*QueryPlacementCandidateFamiliesResponse, error) {
+	out := new(QueryPlacementCandidateFamiliesResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/PlacementCandidateFamilies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) GlobalVirtualGroupsBySecondarySP(ctx context.Context, in *QueryGlobalVirtualGroupsBySecondarySPRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupsBySecondarySPResponse, error) {
+	out := new(QueryGlobalVirtualGroupsBySecondarySPResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupsBySecondarySP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GlobalVirtualGroupFamiliesByPrimarySP(ctx context.Context, in *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest, opts ...grpc.CallOption) (*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse, error) {
+	out := new(QueryGlobalVirtualGroupFamiliesByPrimarySPResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamiliesByPrimarySP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGlobalVirtualGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+func (c *queryClient) SPExitProgress(ctx context.Context, in *QuerySPExitProgressRequest, opts ...grpc.CallOption) (*QuerySPExitProgressResponse, error) {
+	out := new(QuerySPExitProgressResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Query/SPExitProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGlobalVirtualGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroup != nil {
-		l = m.GlobalVirtualGroup.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a global virtual group by its id.
+	GlobalVirtualGroup(context.Context, *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error)
+	// Queries a list of global virtual groups by family id.
+	GlobalVirtualGroupByFamilyID(context.Context, *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error)
+	// Queries a global virtual group family by its id.
+	GlobalVirtualGroupFamily(context.Context, *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error)
+	// Queries a list of GlobalVirtualGroupFamilies items.
+	GlobalVirtualGroupFamilies(context.Context, *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error)
+	// AvailableGlobalVirtualGroupFamilies filters a list of GlobalVirtualGroupFamilies ID which are qualified to create bucket on
+	AvailableGlobalVirtualGroupFamilies(context.Context, *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error)
+	// PlacementCandidateFamilies returns the GlobalVirtualGroupFamilies which can hold the expected size, ranked by the available size
+	PlacementCandidateFamilies(context.Context, *QueryPlacementCandidateFamiliesRequest) (*QueryPlacementCandidateFamiliesResponse, error)
+	// Queries a list of global virtual groups which the storage provider serves as a secondary sp.
+	GlobalVirtualGroupsBySecondarySP(context.Context, *QueryGlobalVirtualGroupsBySecondarySPRequest) (*QueryGlobalVirtualGroupsBySecondarySPResponse, error)
+	// Queries a list of global virtual group families which the storage provider serves as the primary sp.
+	GlobalVirtualGroupFamiliesByPrimarySP(context.Context, *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) (*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse, error)
+	// SPExitProgress queries what is left before the storage provider can complete its exit.
+	SPExitProgress(context.Context, *QuerySPExitProgressRequest) (*QuerySPExitProgressResponse, error)
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroups) > 0 {
-		for _, e := range m.GlobalVirtualGroups {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroup(ctx context.Context, req *QueryGlobalVirtualGroupRequest) (*QueryGlobalVirtualGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroup not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupByFamilyID(ctx context.Context, req *QueryGlobalVirtualGroupByFamilyIDRequest) (*QueryGlobalVirtualGroupByFamilyIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupByFamilyID not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupFamily(ctx context.Context, req *QueryGlobalVirtualGroupFamilyRequest) (*QueryGlobalVirtualGroupFamilyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamily not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupFamilies(ctx context.Context, req *QueryGlobalVirtualGroupFamiliesRequest) (*QueryGlobalVirtualGroupFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamilies not implemented")
+}
+func (*UnimplementedQueryServer) AvailableGlobalVirtualGroupFamilies(ctx context.Context, req *AvailableGlobalVirtualGroupFamiliesRequest) (*AvailableGlobalVirtualGroupFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailableGlobalVirtualGroupFamilies not implemented")
+}
+func (*UnimplementedQueryServer) PlacementCandidateFamilies(ctx context.Context, req *QueryPlacementCandidateFamiliesRequest) (*QueryPlacementCandidateFamiliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlacementCandidateFamilies not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupsBySecondarySP(ctx context.Context, req *QueryGlobalVirtualGroupsBySecondarySPRequest) (*QueryGlobalVirtualGroupsBySecondarySPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupsBySecondarySP not implemented")
+}
+func (*UnimplementedQueryServer) GlobalVirtualGroupFamiliesByPrimarySP(ctx context.Context, req *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) (*QueryGlobalVirtualGroupFamiliesByPrimarySPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalVirtualGroupFamiliesByPrimarySP not implemented")
+}
+func (*UnimplementedQueryServer) SPExitProgress(ctx context.Context, req *QuerySPExitProgressRequest) (*QuerySPExitProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SPExitProgress not implemented")
 }
 
-func (m *QueryGlobalVirtualGroupFamilyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FamilyId != 0 {
-		n += 1 + sovQuery(uint64(m.FamilyId))
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGlobalVirtualGroupFamilyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GlobalVirtualGroupFamily != nil {
-		l = m.GlobalVirtualGroupFamily.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGlobalVirtualGroupFamiliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/Params",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGlobalVirtualGroupFamiliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GlobalVirtualGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.GvgFamilies) > 0 {
-		for _, e := range m.GvgFamilies {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroup(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroup",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroup(ctx, req.(*QueryGlobalVirtualGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GlobalVirtualGroupByFamilyID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupByFamilyIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupByFamilyID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupByFamilyID(ctx, req.(*QueryGlobalVirtualGroupByFamilyIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GlobalVirtualGroupFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupFamilyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamily",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupFamily(ctx, req.(*QueryGlobalVirtualGroupFamilyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupFamiliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamilies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupFamilies(ctx, req.(*QueryGlobalVirtualGroupFamiliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AvailableGlobalVirtualGroupFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailableGlobalVirtualGroupFamiliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/AvailableGlobalVirtualGroupFamilies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvailableGlobalVirtualGroupFamilies(ctx, req.(*AvailableGlobalVirtualGroupFamiliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlacementCandidateFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlacementCandidateFamiliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlacementCandidateFamilies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/PlacementCandidateFamilies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlacementCandidateFamilies(ctx, req.(*QueryPlacementCandidateFamiliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GlobalVirtualGroupsBySecondarySP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupsBySecondarySPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupsBySecondarySP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupsBySecondarySP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupsBySecondarySP(ctx, req.(*QueryGlobalVirtualGroupsBySecondarySPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GlobalVirtualGroupFamiliesByPrimarySP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalVirtualGroupFamiliesByPrimarySPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalVirtualGroupFamiliesByPrimarySP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/GlobalVirtualGroupFamiliesByPrimarySP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalVirtualGroupFamiliesByPrimarySP(ctx, req.(*QueryGlobalVirtualGroupFamiliesByPrimarySPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SPExitProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySPExitProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SPExitProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Query/SPExitProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SPExitProgress(ctx, req.(*QuerySPExitProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "greenfield.virtualgroup.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroup",
+			Handler:    _Query_GlobalVirtualGroup_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupByFamilyID",
+			Handler:    _Query_GlobalVirtualGroupByFamilyID_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupFamily",
+			Handler:    _Query_GlobalVirtualGroupFamily_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupFamilies",
+			Handler:    _Query_GlobalVirtualGroupFamilies_Handler,
+		},
+		{
+			MethodName: "AvailableGlobalVirtualGroupFamilies",
+			Handler:    _Query_AvailableGlobalVirtualGroupFamilies_Handler,
+		},
+		{
+			MethodName: "PlacementCandidateFamilies",
+			Handler:    _Query_PlacementCandidateFamilies_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupsBySecondarySP",
+			Handler:    _Query_GlobalVirtualGroupsBySecondarySP_Handler,
+		},
+		{
+			MethodName: "GlobalVirtualGroupFamiliesByPrimarySP",
+			Handler:    _Query_GlobalVirtualGroupFamiliesByPrimarySP_Handler,
+		},
+		{
+			MethodName: "SPExitProgress",
+			Handler:    _Query_SPExitProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "greenfield/virtualgroup/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupFamilyIds {
-			l += sovQuery(uint64(e))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		{
+			size, err := m.GlobalVirtualGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamily != nil {
+		{
+			size, err := m.GlobalVirtualGroupFamily.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GvgFamilies) > 0 {
+		for iNdEx := len(m.GvgFamilies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GvgFamilies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA7 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j6 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		dAtA9 := make([]byte, len(m.GlobalVirtualGroupFamilyIds)*10)
+		var j8 int
+		for _, num := range m.GlobalVirtualGroupFamilyIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlacementCandidateFamiliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlacementCandidateFamiliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlacementCandidateFamiliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExpectedSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpectedSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlacementCandidateFamily) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementCandidateFamily) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementCandidateFamily) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AvailableSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AvailableSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrimarySpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PrimarySpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FamilyId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlacementCandidateFamiliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlacementCandidateFamiliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlacementCandidateFamiliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GlobalVirtualGroups) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GlobalVirtualGroupFamilies) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroupFamilies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroupFamilies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySPExitProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySPExitProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySPExitProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SPExitCheckItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SPExitCheckItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SPExitCheckItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySPExitProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySPExitProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySPExitProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checklist) > 0 {
+		for iNdEx := len(m.Checklist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checklist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MigratingInBucketIds) > 0 {
+		for iNdEx := len(m.MigratingInBucketIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MigratingInBucketIds[iNdEx].Size()
+				i -= size
+				if _, err := m.MigratingInBucketIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MigratingOutBucketIds) > 0 {
+		for iNdEx := len(m.MigratingOutBucketIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MigratingOutBucketIds[iNdEx].Size()
+				i -= size
+				if _, err := m.MigratingOutBucketIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SwappingOutGvgIds) > 0 {
+		dAtA15 := make([]byte, len(m.SwappingOutGvgIds)*10)
+		var j14 int
+		for _, num := range m.SwappingOutGvgIds {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SwappingOutFamilyIds) > 0 {
+		dAtA17 := make([]byte, len(m.SwappingOutFamilyIds)*10)
+		var j16 int
+		for _, num := range m.SwappingOutFamilyIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SecondaryCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondaryCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PrimaryCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PrimaryCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExitTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExitTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Exitable {
+		i--
+		if m.Exitable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroup != nil {
+		l = m.GlobalVirtualGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamilyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.FamilyId))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamilyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupFamily != nil {
+		l = m.GlobalVirtualGroupFamily.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GvgFamilies) > 0 {
+		for _, e := range m.GvgFamilies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupFamilyIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPlacementCandidateFamiliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpectedSize != 0 {
+		n += 1 + sovQuery(uint64(m.ExpectedSize))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *PlacementCandidateFamily) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FamilyId != 0 {
+		n += 1 + sovQuery(uint64(m.FamilyId))
+	}
+	if m.PrimarySpId != 0 {
+		n += 1 + sovQuery(uint64(m.PrimarySpId))
+	}
+	if m.AvailableSize != 0 {
+		n += 1 + sovQuery(uint64(m.AvailableSize))
+	}
+	return n
+}
+
+func (m *QueryPlacementCandidateFamiliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovQuery(uint64(m.StorageProviderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovQuery(uint64(m.StorageProviderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupFamilies) > 0 {
+		for _, e := range m.GlobalVirtualGroupFamilies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySPExitProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageProviderId != 0 {
+		n += 1 + sovQuery(uint64(m.StorageProviderId))
+	}
+	return n
+}
+
+func (m *SPExitCheckItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySPExitProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exitable {
+		n += 2
+	}
+	if m.ExitTime != 0 {
+		n += 1 + sovQuery(uint64(m.ExitTime))
+	}
+	if m.PrimaryCount != 0 {
+		n += 1 + sovQuery(uint64(m.PrimaryCount))
+	}
+	if m.SecondaryCount != 0 {
+		n += 1 + sovQuery(uint64(m.SecondaryCount))
+	}
+	if len(m.SwappingOutFamilyIds) > 0 {
+		l = 0
+		for _, e := range m.SwappingOutFamilyIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.SwappingOutGvgIds) > 0 {
+		l = 0
+		for _, e := range m.SwappingOutGvgIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.MigratingOutBucketIds) > 0 {
+		for _, e := range m.MigratingOutBucketIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MigratingInBucketIds) > 0 {
+		for _, e := range m.MigratingInBucketIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Checklist) > 0 {
+		for _, e := range m.Checklist {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroup == nil {
+				m.GlobalVirtualGroup = &GlobalVirtualGroup{}
+			}
+			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalVirtualGroups = append(m.GlobalVirtualGroups, &GlobalVirtualGroup{})
+			if err := m.GlobalVirtualGroups[len(m.GlobalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			}
+			m.FamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamily", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalVirtualGroupFamily == nil {
+				m.GlobalVirtualGroupFamily = &GlobalVirtualGroupFamily{}
+			}
+			if err := m.GlobalVirtualGroupFamily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GvgFamilies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GvgFamilies = append(m.GvgFamilies, &GlobalVirtualGroupFamily{})
+			if err := m.GvgFamilies[len(m.GvgFamilies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.GlobalVirtualGroupFamilyIds) > 0 {
-		l = 0
-		for _, e := range m.GlobalVirtualGroupFamilyIds {
-			l += sovQuery(uint64(e))
+	return nil
+}
+func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1536,12 +3965,88 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
+					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1563,7 +4068,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPlacementCandidateFamiliesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1586,17 +4091,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlacementCandidateFamiliesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlacementCandidateFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedSize", wireType)
 			}
-			var msglen int
+			m.ExpectedSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1606,25 +4111,30 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpectedSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1646,7 +4156,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *PlacementCandidateFamily) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,17 +4179,17 @@ func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementCandidateFamily: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementCandidateFamily: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
 			}
-			m.GlobalVirtualGroupId = 0
+			m.FamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1689,7 +4199,45 @@ func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimarySpId", wireType)
+			}
+			m.PrimarySpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimarySpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableSize", wireType)
+			}
+			m.AvailableSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1715,7 +4263,7 @@ func (m *QueryGlobalVirtualGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPlacementCandidateFamiliesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1738,15 +4286,15 @@ func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPlacementCandidateFamiliesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPlacementCandidateFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1773,10 +4321,8 @@ func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GlobalVirtualGroup == nil {
-				m.GlobalVirtualGroup = &GlobalVirtualGroup{}
-			}
-			if err := m.GlobalVirtualGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candidates = append(m.Candidates, PlacementCandidateFamily{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1801,7 +4347,7 @@ func (m *QueryGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalVirtualGroupsBySecondarySPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1824,17 +4370,17 @@ func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupsBySecondarySPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupsBySecondarySPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.GlobalVirtualGroupFamilyId = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1844,11 +4390,47 @@ func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1870,7 +4452,7 @@ func (m *QueryGlobalVirtualGroupByFamilyIDRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalVirtualGroupsBySecondarySPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,10 +4475,10 @@ func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupsBySecondarySPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupByFamilyIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupsBySecondarySPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1933,6 +4515,42 @@ func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1954,7 +4572,7 @@ func (m *QueryGlobalVirtualGroupByFamilyIDResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1977,17 +4595,17 @@ func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesByPrimarySPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesByPrimarySPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.FamilyId = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1997,11 +4615,47 @@ func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FamilyId |= uint32(b&0x7F) << shift
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2023,7 +4677,7 @@ func (m *QueryGlobalVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalVirtualGroupFamiliesByPrimarySPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2046,15 +4700,15 @@ func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesByPrimarySPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesByPrimarySPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamily", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2081,10 +4735,44 @@ func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GlobalVirtualGroupFamily == nil {
-				m.GlobalVirtualGroupFamily = &GlobalVirtualGroupFamily{}
+			m.GlobalVirtualGroupFamilies = append(m.GlobalVirtualGroupFamilies, &GlobalVirtualGroupFamily{})
+			if err := m.GlobalVirtualGroupFamilies[len(m.GlobalVirtualGroupFamilies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.GlobalVirtualGroupFamily.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2109,7 +4797,7 @@ func (m *QueryGlobalVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySPExitProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,48 +4820,31 @@ func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySPExitProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySPExitProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			var msglen int
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2195,7 +4866,7 @@ func (m *QueryGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
+func (m *SPExitCheckItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,17 +4889,17 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SPExitCheckItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SPExitCheckItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GvgFamilies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2238,31 +4909,49 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GvgFamilies = append(m.GvgFamilies, &GlobalVirtualGroupFamily{})
-			if err := m.GvgFamilies[len(m.GvgFamilies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2272,27 +4961,23 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2315,7 +5000,7 @@ func (m *QueryGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySPExitProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2338,13 +5023,90 @@ func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySPExitProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySPExitProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exitable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exitable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitTime", wireType)
+			}
+			m.ExitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryCount", wireType)
+			}
+			m.PrimaryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryCount", wireType)
+			}
+			m.SecondaryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondaryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType == 0 {
 				var v uint32
 				for shift := uint(0); ; shift += 7 {
@@ -2361,7 +5123,7 @@ func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) erro
 						break
 					}
 				}
-				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				m.SwappingOutFamilyIds = append(m.SwappingOutFamilyIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -2396,8 +5158,8 @@ func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) erro
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
-					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				if elementCount != 0 && len(m.SwappingOutFamilyIds) == 0 {
+					m.SwappingOutFamilyIds = make([]uint32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint32
@@ -2415,62 +5177,12 @@ func (m *AvailableGlobalVirtualGroupFamiliesRequest) Unmarshal(dAtA []byte) erro
 							break
 						}
 					}
-					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+					m.SwappingOutFamilyIds = append(m.SwappingOutFamilyIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+				return fmt.Errorf("proto: wrong wireType = %d for field SwappingOutFamilyIds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailableGlobalVirtualGroupFamiliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType == 0 {
 				var v uint32
 				for shift := uint(0); ; shift += 7 {
@@ -2487,7 +5199,7 @@ func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) err
 						break
 					}
 				}
-				m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+				m.SwappingOutGvgIds = append(m.SwappingOutGvgIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -2522,8 +5234,8 @@ func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) err
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.GlobalVirtualGroupFamilyIds) == 0 {
-					m.GlobalVirtualGroupFamilyIds = make([]uint32, 0, elementCount)
+				if elementCount != 0 && len(m.SwappingOutGvgIds) == 0 {
+					m.SwappingOutGvgIds = make([]uint32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint32
@@ -2541,11 +5253,117 @@ func (m *AvailableGlobalVirtualGroupFamiliesResponse) Unmarshal(dAtA []byte) err
 							break
 						}
 					}
-					m.GlobalVirtualGroupFamilyIds = append(m.GlobalVirtualGroupFamilyIds, v)
+					m.SwappingOutGvgIds = append(m.SwappingOutGvgIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwappingOutGvgIds", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratingOutBucketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MigratingOutBucketIds = append(m.MigratingOutBucketIds, v)
+			if err := m.MigratingOutBucketIds[len(m.MigratingOutBucketIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratingInBucketIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MigratingInBucketIds = append(m.MigratingInBucketIds, v)
+			if err := m.MigratingInBucketIds[len(m.MigratingInBucketIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checklist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checklist = append(m.Checklist, SPExitCheckItem{})
+			if err := m.Checklist[len(m.Checklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -235,6 +235,296 @@ func (m *MsgCreateGlobalVirtualGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateGlobalVirtualGroupResponse proto.InternalMessageInfo
 
+// GlobalVirtualGroupCreation defines a global virtual group to be created within a batch.
+type GlobalVirtualGroupCreation struct {
+	// family_id is the identifier for the virtual group's family, 0 means creating a new family.
+	FamilyId uint32 `protobuf:"varint,1,opt,name=family_id,json=familyId,proto3" json:"family_id,omitempty"`
+	// secondary_sp_ids is a list of secondary storage provider IDs associated with the virtual group.
+	SecondarySpIds []uint32 `protobuf:"varint,2,rep,packed,name=secondary_sp_ids,json=secondarySpIds,proto3" json:"secondary_sp_ids,omitempty"`
+	// deposit is the total deposit amount required for the virtual group.
+	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *GlobalVirtualGroupCreation) Reset()         { *m = GlobalVirtualGroupCreation{} }
+func (m *GlobalVirtualGroupCreation) String() string { return proto.CompactTextString(m) }
+func (*GlobalVirtualGroupCreation) ProtoMessage()    {}
+func (*GlobalVirtualGroupCreation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{4}
+}
+func (m *GlobalVirtualGroupCreation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalVirtualGroupCreation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalVirtualGroupCreation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalVirtualGroupCreation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalVirtualGroupCreation.Merge(m, src)
+}
+func (m *GlobalVirtualGroupCreation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalVirtualGroupCreation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalVirtualGroupCreation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalVirtualGroupCreation proto.InternalMessageInfo
+
+func (m *GlobalVirtualGroupCreation) GetFamilyId() uint32 {
+	if m != nil {
+		return m.FamilyId
+	}
+	return 0
+}
+
+func (m *GlobalVirtualGroupCreation) GetSecondarySpIds() []uint32 {
+	if m != nil {
+		return m.SecondarySpIds
+	}
+	return nil
+}
+
+func (m *GlobalVirtualGroupCreation) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+type MsgCreateGlobalVirtualGroups struct {
+	// storage_provider defines the operator account address of the storage provider who create the global virtual groups.
+	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
+	// global_virtual_groups defines the global virtual groups to be created, in order.
+	GlobalVirtualGroups []GlobalVirtualGroupCreation `protobuf:"bytes,2,rep,name=global_virtual_groups,json=globalVirtualGroups,proto3" json:"global_virtual_groups"`
+}
+
+func (m *MsgCreateGlobalVirtualGroups) Reset()         { *m = MsgCreateGlobalVirtualGroups{} }
+func (m *MsgCreateGlobalVirtualGroups) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGlobalVirtualGroups) ProtoMessage()    {}
+func (*MsgCreateGlobalVirtualGroups) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{5}
+}
+func (m *MsgCreateGlobalVirtualGroups) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGlobalVirtualGroups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGlobalVirtualGroups.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGlobalVirtualGroups) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGlobalVirtualGroups.Merge(m, src)
+}
+func (m *MsgCreateGlobalVirtualGroups) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGlobalVirtualGroups) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGlobalVirtualGroups.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGlobalVirtualGroups proto.InternalMessageInfo
+
+func (m *MsgCreateGlobalVirtualGroups) GetStorageProvider() string {
+	if m != nil {
+		return m.StorageProvider
+	}
+	return ""
+}
+
+func (m *MsgCreateGlobalVirtualGroups) GetGlobalVirtualGroups() []GlobalVirtualGroupCreation {
+	if m != nil {
+		return m.GlobalVirtualGroups
+	}
+	return nil
+}
+
+type MsgCreateGlobalVirtualGroupsResponse struct {
+	// global_virtual_group_ids are the identifiers of the created global virtual groups, in the order of the request.
+	GlobalVirtualGroupIds []uint32 `protobuf:"varint,1,rep,packed,name=global_virtual_group_ids,json=globalVirtualGroupIds,proto3" json:"global_virtual_group_ids,omitempty"`
+}
+
+func (m *MsgCreateGlobalVirtualGroupsResponse) Reset()         { *m = MsgCreateGlobalVirtualGroupsResponse{} }
+func (m *MsgCreateGlobalVirtualGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGlobalVirtualGroupsResponse) ProtoMessage()    {}
+func (*MsgCreateGlobalVirtualGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{6}
+}
+func (m *MsgCreateGlobalVirtualGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGlobalVirtualGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGlobalVirtualGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGlobalVirtualGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGlobalVirtualGroupsResponse.Merge(m, src)
+}
+func (m *MsgCreateGlobalVirtualGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGlobalVirtualGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGlobalVirtualGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGlobalVirtualGroupsResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGlobalVirtualGroupsResponse) GetGlobalVirtualGroupIds() []uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupIds
+	}
+	return nil
+}
+
+// MsgRebalanceGlobalVirtualGroups moves the global virtual groups between the families of the same primary storage
+// provider. The objects are bound to the buckets served by the family, so the global virtual groups storing objects are
+// moved along with the buckets storing objects in them, which are rebound to the destination family.
+type MsgRebalanceGlobalVirtualGroups struct {
+	// storage_provider defines the operator account address of the primary storage provider of both families.
+	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
+	// src_global_virtual_group_family_id is the identifier of the family which the global virtual groups are moved out of.
+	SrcGlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=src_global_virtual_group_family_id,json=srcGlobalVirtualGroupFamilyId,proto3" json:"src_global_virtual_group_family_id,omitempty"`
+	// dst_global_virtual_group_family_id is the identifier of the family which the global virtual groups are moved into,
+	// 0 means creating a new family.
+	DstGlobalVirtualGroupFamilyId uint32 `protobuf:"varint,3,opt,name=dst_global_virtual_group_family_id,json=dstGlobalVirtualGroupFamilyId,proto3" json:"dst_global_virtual_group_family_id,omitempty"`
+	// global_virtual_group_ids are the identifiers of the global virtual groups to be moved.
+	GlobalVirtualGroupIds []uint32 `protobuf:"varint,4,rep,packed,name=global_virtual_group_ids,json=globalVirtualGroupIds,proto3" json:"global_virtual_group_ids,omitempty"`
+	// bucket_ids are the identifiers of the buckets to be rebound to the destination family, they should be all the
+	// buckets storing objects in the moved global virtual groups, and their objects should be stored in the moved global
+	// virtual groups only.
+	BucketIds []Uint `protobuf:"bytes,5,rep,name=bucket_ids,json=bucketIds,proto3,customtype=Uint" json:"bucket_ids"`
+}
+
+func (m *MsgRebalanceGlobalVirtualGroups) Reset()         { *m = MsgRebalanceGlobalVirtualGroups{} }
+func (m *MsgRebalanceGlobalVirtualGroups) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceGlobalVirtualGroups) ProtoMessage()    {}
+func (*MsgRebalanceGlobalVirtualGroups) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{7}
+}
+func (m *MsgRebalanceGlobalVirtualGroups) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceGlobalVirtualGroups) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceGlobalVirtualGroups.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceGlobalVirtualGroups) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroups.Merge(m, src)
+}
+func (m *MsgRebalanceGlobalVirtualGroups) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceGlobalVirtualGroups) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroups.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceGlobalVirtualGroups proto.InternalMessageInfo
+
+func (m *MsgRebalanceGlobalVirtualGroups) GetStorageProvider() string {
+	if m != nil {
+		return m.StorageProvider
+	}
+	return ""
+}
+
+func (m *MsgRebalanceGlobalVirtualGroups) GetSrcGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.SrcGlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+func (m *MsgRebalanceGlobalVirtualGroups) GetDstGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.DstGlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+func (m *MsgRebalanceGlobalVirtualGroups) GetGlobalVirtualGroupIds() []uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupIds
+	}
+	return nil
+}
+
+type MsgRebalanceGlobalVirtualGroupsResponse struct {
+	// dst_global_virtual_group_family_id is the identifier of the family which the global virtual groups are moved into.
+	DstGlobalVirtualGroupFamilyId uint32 `protobuf:"varint,1,opt,name=dst_global_virtual_group_family_id,json=dstGlobalVirtualGroupFamilyId,proto3" json:"dst_global_virtual_group_family_id,omitempty"`
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) Reset() {
+	*m = MsgRebalanceGlobalVirtualGroupsResponse{}
+}
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceGlobalVirtualGroupsResponse) ProtoMessage()    {}
+func (*MsgRebalanceGlobalVirtualGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{8}
+}
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceGlobalVirtualGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroupsResponse.Merge(m, src)
+}
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceGlobalVirtualGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceGlobalVirtualGroupsResponse proto.InternalMessageInfo
+
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) GetDstGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.DstGlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
 type MsgDeleteGlobalVirtualGroup struct {
 	// storage_provider defines the operator account address of the storage provider who delete the global virtual group.
 	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
@@ -246,7 +536,7 @@ func (m *MsgDeleteGlobalVirtualGroup) Reset()         { *m = MsgDeleteGlobalVirt
 func (m *MsgDeleteGlobalVirtualGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteGlobalVirtualGroup) ProtoMessage()    {}
 func (*MsgDeleteGlobalVirtualGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{4}
+	return fileDescriptor_478f7001009bf3f2, []int{9}
 }
 func (m *MsgDeleteGlobalVirtualGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +586,7 @@ func (m *MsgDeleteGlobalVirtualGroupResponse) Reset()         { *m = MsgDeleteGl
 func (m *MsgDeleteGlobalVirtualGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteGlobalVirtualGroupResponse) ProtoMessage()    {}
 func (*MsgDeleteGlobalVirtualGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{5}
+	return fileDescriptor_478f7001009bf3f2, []int{10}
 }
 func (m *MsgDeleteGlobalVirtualGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,7 +628,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{6}
+	return fileDescriptor_478f7001009bf3f2, []int{11}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +685,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{7}
+	return fileDescriptor_478f7001009bf3f2, []int{12}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +728,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{8}
+	return fileDescriptor_478f7001009bf3f2, []int{13}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +785,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{9}
+	return fileDescriptor_478f7001009bf3f2, []int{14}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +835,7 @@ func (m *MsgSwapOut) Reset()         { *m = MsgSwapOut{} }
 func (m *MsgSwapOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapOut) ProtoMessage()    {}
 func (*MsgSwapOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{10}
+	return fileDescriptor_478f7001009bf3f2, []int{15}
 }
 func (m *MsgSwapOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +906,7 @@ func (m *MsgSwapOutResponse) Reset()         { *m = MsgSwapOutResponse{} }
 func (m *MsgSwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapOutResponse) ProtoMessage()    {}
 func (*MsgSwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{11}
+	return fileDescriptor_478f7001009bf3f2, []int{16}
 }
 func (m *MsgSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,7 +951,7 @@ func (m *MsgCompleteSwapOut) Reset()         { *m = MsgCompleteSwapOut{} }
 func (m *MsgCompleteSwapOut) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteSwapOut) ProtoMessage()    {}
 func (*MsgCompleteSwapOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{12}
+	return fileDescriptor_478f7001009bf3f2, []int{17}
 }
 func (m *MsgCompleteSwapOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +1008,7 @@ func (m *MsgCompleteSwapOutResponse) Reset()         { *m = MsgCompleteSwapOutRe
 func (m *MsgCompleteSwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteSwapOutResponse) ProtoMessage()    {}
 func (*MsgCompleteSwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{13}
+	return fileDescriptor_478f7001009bf3f2, []int{18}
 }
 func (m *MsgCompleteSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +1053,7 @@ func (m *MsgCancelSwapOut) Reset()         { *m = MsgCancelSwapOut{} }
 func (m *MsgCancelSwapOut) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSwapOut) ProtoMessage()    {}
 func (*MsgCancelSwapOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{14}
+	return fileDescriptor_478f7001009bf3f2, []int{19}
 }
 func (m *MsgCancelSwapOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +1110,7 @@ func (m *MsgCancelSwapOutResponse) Reset()         { *m = MsgCancelSwapOutRespon
 func (m *MsgCancelSwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSwapOutResponse) ProtoMessage()    {}
 func (*MsgCancelSwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{15}
+	return fileDescriptor_478f7001009bf3f2, []int{20}
 }
 func (m *MsgCancelSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,31 +1139,30 @@ func (m *MsgCancelSwapOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSwapOutResponse proto.InternalMessageInfo
 
-// MsgSettle define the message for settling storage income of GVG family or several GVGs.
-// Firstly, the handler will do stream settlement for the payment account; and
-// secondly, the income will be distributed to related storage providers.
-type MsgSettle struct {
-	// storage_provider defines the account address who initiates the settle request.
-	// After Pampas upgrade, not only storage provider, anyone can submit this message.
+// MsgForcedSwapOut defines the message for an in-service storage provider to take over the family
+// whose primary storage provider is jailed or exiting but does not swap out in time.
+type MsgForcedSwapOut struct {
+	// storage_provider defines the operator account address of the successor storage provider.
 	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
-	// global_virtual_group_family_id is the identifier of the global virtual group family.
+	// global_virtual_group_family_id is the identifier of the orphaned virtual group family.
 	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
-	// global_virtual_group_id is the identifier of the global virtual group.
-	GlobalVirtualGroupIds []uint32 `protobuf:"varint,3,rep,packed,name=global_virtual_group_ids,json=globalVirtualGroupIds,proto3" json:"global_virtual_group_ids,omitempty"`
+	// secondary_sp_bls_signatures are the aggregated bls signatures of the secondary sps of each gvg in the family,
+	// in the same order as the gvg ids of the family.
+	SecondarySpBlsSignatures [][]byte `protobuf:"bytes,3,rep,name=secondary_sp_bls_signatures,json=secondarySpBlsSignatures,proto3" json:"secondary_sp_bls_signatures,omitempty"`
 }
 
-func (m *MsgSettle) Reset()         { *m = MsgSettle{} }
-func (m *MsgSettle) String() string { return proto.CompactTextString(m) }
-func (*MsgSettle) ProtoMessage()    {}
-func (*MsgSettle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{16}
+func (m *MsgForcedSwapOut) Reset()         { *m = MsgForcedSwapOut{} }
+func (m *MsgForcedSwapOut) String() string { return proto.CompactTextString(m) }
+func (*MsgForcedSwapOut) ProtoMessage()    {}
+func (*MsgForcedSwapOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{21}
 }
-func (m *MsgSettle) XXX_Unmarshal(b []byte) error {
+func (m *MsgForcedSwapOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgForcedSwapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSettle.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgForcedSwapOut.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -883,54 +1172,54 @@ func (m *MsgSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgSettle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSettle.Merge(m, src)
+func (m *MsgForcedSwapOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForcedSwapOut.Merge(m, src)
 }
-func (m *MsgSettle) XXX_Size() int {
+func (m *MsgForcedSwapOut) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSettle) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSettle.DiscardUnknown(m)
+func (m *MsgForcedSwapOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForcedSwapOut.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSettle proto.InternalMessageInfo
+var xxx_messageInfo_MsgForcedSwapOut proto.InternalMessageInfo
 
-func (m *MsgSettle) GetStorageProvider() string {
+func (m *MsgForcedSwapOut) GetStorageProvider() string {
 	if m != nil {
 		return m.StorageProvider
 	}
 	return ""
 }
 
-func (m *MsgSettle) GetGlobalVirtualGroupFamilyId() uint32 {
+func (m *MsgForcedSwapOut) GetGlobalVirtualGroupFamilyId() uint32 {
 	if m != nil {
 		return m.GlobalVirtualGroupFamilyId
 	}
 	return 0
 }
 
-func (m *MsgSettle) GetGlobalVirtualGroupIds() []uint32 {
+func (m *MsgForcedSwapOut) GetSecondarySpBlsSignatures() [][]byte {
 	if m != nil {
-		return m.GlobalVirtualGroupIds
+		return m.SecondarySpBlsSignatures
 	}
 	return nil
 }
 
-type MsgSettleResponse struct {
+type MsgForcedSwapOutResponse struct {
 }
 
-func (m *MsgSettleResponse) Reset()         { *m = MsgSettleResponse{} }
-func (m *MsgSettleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSettleResponse) ProtoMessage()    {}
-func (*MsgSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{17}
+func (m *MsgForcedSwapOutResponse) Reset()         { *m = MsgForcedSwapOutResponse{} }
+func (m *MsgForcedSwapOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForcedSwapOutResponse) ProtoMessage()    {}
+func (*MsgForcedSwapOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{22}
 }
-func (m *MsgSettleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgForcedSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgForcedSwapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSettleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgForcedSwapOutResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -940,28 +1229,131 @@ func (m *MsgSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgSettleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSettleResponse.Merge(m, src)
+func (m *MsgForcedSwapOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForcedSwapOutResponse.Merge(m, src)
 }
-func (m *MsgSettleResponse) XXX_Size() int {
+func (m *MsgForcedSwapOutResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSettleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSettleResponse.DiscardUnknown(m)
+func (m *MsgForcedSwapOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForcedSwapOutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSettleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgForcedSwapOutResponse proto.InternalMessageInfo
 
-type MsgStorageProviderExit struct {
-	// storage_provider defines the operator account address of the storage provider who want to exit from the greenfield storage network.
+// MsgSettle define the message for settling storage income of GVG family or several GVGs.
+// Firstly, the handler will do stream settlement for the payment account; and
+// secondly, the income will be distributed to related storage providers.
+type MsgSettle struct {
+	// storage_provider defines the account address who initiates the settle request.
+	// After Pampas upgrade, not only storage provider, anyone can submit this message.
 	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
+	// global_virtual_group_family_id is the identifier of the global virtual group family.
+	GlobalVirtualGroupFamilyId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_family_id,json=globalVirtualGroupFamilyId,proto3" json:"global_virtual_group_family_id,omitempty"`
+	// global_virtual_group_id is the identifier of the global virtual group.
+	GlobalVirtualGroupIds []uint32 `protobuf:"varint,3,rep,packed,name=global_virtual_group_ids,json=globalVirtualGroupIds,proto3" json:"global_virtual_group_ids,omitempty"`
 }
 
-func (m *MsgStorageProviderExit) Reset()         { *m = MsgStorageProviderExit{} }
-func (m *MsgStorageProviderExit) String() string { return proto.CompactTextString(m) }
-func (*MsgStorageProviderExit) ProtoMessage()    {}
+func (m *MsgSettle) Reset()         { *m = MsgSettle{} }
+func (m *MsgSettle) String() string { return proto.CompactTextString(m) }
+func (*MsgSettle) ProtoMessage()    {}
+func (*MsgSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{23}
+}
+func (m *MsgSettle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettle.Merge(m, src)
+}
+func (m *MsgSettle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettle proto.InternalMessageInfo
+
+func (m *MsgSettle) GetStorageProvider() string {
+	if m != nil {
+		return m.StorageProvider
+	}
+	return ""
+}
+
+func (m *MsgSettle) GetGlobalVirtualGroupFamilyId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupFamilyId
+	}
+	return 0
+}
+
+func (m *MsgSettle) GetGlobalVirtualGroupIds() []uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupIds
+	}
+	return nil
+}
+
+type MsgSettleResponse struct {
+}
+
+func (m *MsgSettleResponse) Reset()         { *m = MsgSettleResponse{} }
+func (m *MsgSettleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleResponse) ProtoMessage()    {}
+func (*MsgSettleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478f7001009bf3f2, []int{24}
+}
+func (m *MsgSettleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleResponse.Merge(m, src)
+}
+func (m *MsgSettleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleResponse proto.InternalMessageInfo
+
+type MsgStorageProviderExit struct {
+	// storage_provider defines the operator account address of the storage provider who want to exit from the greenfield storage network.
+	StorageProvider string `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider,omitempty"`
+}
+
+func (m *MsgStorageProviderExit) Reset()         { *m = MsgStorageProviderExit{} }
+func (m *MsgStorageProviderExit) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageProviderExit) ProtoMessage()    {}
 func (*MsgStorageProviderExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{18}
+	return fileDescriptor_478f7001009bf3f2, []int{25}
 }
 func (m *MsgStorageProviderExit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1396,7 @@ func (m *MsgStorageProviderExitResponse) Reset()         { *m = MsgStorageProvid
 func (m *MsgStorageProviderExitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStorageProviderExitResponse) ProtoMessage()    {}
 func (*MsgStorageProviderExitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{19}
+	return fileDescriptor_478f7001009bf3f2, []int{26}
 }
 func (m *MsgStorageProviderExitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1434,7 @@ func (m *MsgCompleteStorageProviderExit) Reset()         { *m = MsgCompleteStora
 func (m *MsgCompleteStorageProviderExit) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteStorageProviderExit) ProtoMessage()    {}
 func (*MsgCompleteStorageProviderExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{20}
+	return fileDescriptor_478f7001009bf3f2, []int{27}
 }
 func (m *MsgCompleteStorageProviderExit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1479,7 @@ func (m *MsgCompleteStorageProviderExitResponse) Reset() {
 func (m *MsgCompleteStorageProviderExitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCompleteStorageProviderExitResponse) ProtoMessage()    {}
 func (*MsgCompleteStorageProviderExitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478f7001009bf3f2, []int{21}
+	return fileDescriptor_478f7001009bf3f2, []int{28}
 }
 func (m *MsgCompleteStorageProviderExitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1121,6 +1513,11 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "greenfield.virtualgroup.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCreateGlobalVirtualGroup)(nil), "greenfield.virtualgroup.MsgCreateGlobalVirtualGroup")
 	proto.RegisterType((*MsgCreateGlobalVirtualGroupResponse)(nil), "greenfield.virtualgroup.MsgCreateGlobalVirtualGroupResponse")
+	proto.RegisterType((*GlobalVirtualGroupCreation)(nil), "greenfield.virtualgroup.GlobalVirtualGroupCreation")
+	proto.RegisterType((*MsgCreateGlobalVirtualGroups)(nil), "greenfield.virtualgroup.MsgCreateGlobalVirtualGroups")
+	proto.RegisterType((*MsgCreateGlobalVirtualGroupsResponse)(nil), "greenfield.virtualgroup.MsgCreateGlobalVirtualGroupsResponse")
+	proto.RegisterType((*MsgRebalanceGlobalVirtualGroups)(nil), "greenfield.virtualgroup.MsgRebalanceGlobalVirtualGroups")
+	proto.RegisterType((*MsgRebalanceGlobalVirtualGroupsResponse)(nil), "greenfield.virtualgroup.MsgRebalanceGlobalVirtualGroupsResponse")
 	proto.RegisterType((*MsgDeleteGlobalVirtualGroup)(nil), "greenfield.virtualgroup.MsgDeleteGlobalVirtualGroup")
 	proto.RegisterType((*MsgDeleteGlobalVirtualGroupResponse)(nil), "greenfield.virtualgroup.MsgDeleteGlobalVirtualGroupResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "greenfield.virtualgroup.MsgDeposit")
@@ -1133,6 +1530,8 @@ func init() {
 	proto.RegisterType((*MsgCompleteSwapOutResponse)(nil), "greenfield.virtualgroup.MsgCompleteSwapOutResponse")
 	proto.RegisterType((*MsgCancelSwapOut)(nil), "greenfield.virtualgroup.MsgCancelSwapOut")
 	proto.RegisterType((*MsgCancelSwapOutResponse)(nil), "greenfield.virtualgroup.MsgCancelSwapOutResponse")
+	proto.RegisterType((*MsgForcedSwapOut)(nil), "greenfield.virtualgroup.MsgForcedSwapOut")
+	proto.RegisterType((*MsgForcedSwapOutResponse)(nil), "greenfield.virtualgroup.MsgForcedSwapOutResponse")
 	proto.RegisterType((*MsgSettle)(nil), "greenfield.virtualgroup.MsgSettle")
 	proto.RegisterType((*MsgSettleResponse)(nil), "greenfield.virtualgroup.MsgSettleResponse")
 	proto.RegisterType((*MsgStorageProviderExit)(nil), "greenfield.virtualgroup.MsgStorageProviderExit")
@@ -1144,68 +1543,85 @@ func init() {
 func init() { proto.RegisterFile("greenfield/virtualgroup/tx.proto", fileDescriptor_478f7001009bf3f2) }
 
 var fileDescriptor_478f7001009bf3f2 = []byte{
-	// 970 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x9b, 0x92, 0x26, 0xaf, 0x6c, 0x13, 0x9c, 0x84, 0x38, 0x0e, 0x72, 0x57, 0xdb, 0x52,
-	0x2d, 0x2d, 0xb5, 0x49, 0x5b, 0xa8, 0x28, 0x20, 0xd4, 0x04, 0xa8, 0x72, 0x08, 0xad, 0x36, 0xe2,
-	0x87, 0x40, 0x62, 0x35, 0x6b, 0x4f, 0x1d, 0x23, 0xdb, 0x63, 0x79, 0x66, 0x37, 0x89, 0x84, 0x84,
-	0xc4, 0x81, 0x23, 0xe2, 0x06, 0x7f, 0x46, 0x0f, 0xfc, 0x11, 0x3d, 0x56, 0x9c, 0x10, 0x12, 0x15,
-	0x4a, 0x90, 0x38, 0x20, 0xfe, 0x02, 0x2e, 0xc8, 0xe3, 0xf1, 0xac, 0xb3, 0xbb, 0x9e, 0x6c, 0xab,
-	0x40, 0xa5, 0x9c, 0x92, 0x9d, 0xf9, 0xde, 0x7b, 0xdf, 0xf7, 0xcd, 0xf8, 0xed, 0xf3, 0x42, 0xdd,
-	0x4f, 0x31, 0x8e, 0xef, 0x07, 0x38, 0xf4, 0x9c, 0x5e, 0x90, 0xb2, 0x2e, 0x0a, 0xfd, 0x94, 0x74,
-	0x13, 0x87, 0xed, 0xda, 0x49, 0x4a, 0x18, 0xd1, 0x97, 0xfa, 0x08, 0xbb, 0x8c, 0x30, 0x2d, 0x97,
-	0xd0, 0x88, 0x50, 0xa7, 0x83, 0x28, 0x76, 0x7a, 0xab, 0x1d, 0xcc, 0xd0, 0xaa, 0xe3, 0x92, 0x20,
-	0xce, 0x03, 0xcd, 0x25, 0xb1, 0x1f, 0x51, 0xdf, 0xe9, 0xad, 0x66, 0x7f, 0xc4, 0xc6, 0x72, 0xbe,
-	0xd1, 0xe6, 0x9f, 0x9c, 0xfc, 0x83, 0xd8, 0x5a, 0xf0, 0x89, 0x4f, 0xf2, 0xf5, 0xec, 0x3f, 0xb1,
-	0x5a, 0x26, 0xe9, 0x92, 0x28, 0x22, 0xb1, 0x83, 0x92, 0x24, 0x25, 0x3d, 0x14, 0x0a, 0xc4, 0xc5,
-	0x2a, 0x19, 0x09, 0x4a, 0x51, 0x24, 0xb2, 0x37, 0x7e, 0xd4, 0x60, 0x76, 0x93, 0xfa, 0x1f, 0x25,
-	0x1e, 0x62, 0xf8, 0x1e, 0xdf, 0xd1, 0xdf, 0x80, 0x19, 0xd4, 0x65, 0xdb, 0x24, 0x0d, 0xd8, 0x9e,
-	0xa1, 0xd5, 0xb5, 0xe6, 0xcc, 0x9a, 0xf1, 0xf3, 0x4f, 0x57, 0x17, 0x04, 0xad, 0xdb, 0x9e, 0x97,
-	0x62, 0x4a, 0xb7, 0x58, 0x1a, 0xc4, 0x7e, 0xab, 0x0f, 0xd5, 0xdf, 0x81, 0xa9, 0x3c, 0xb7, 0x71,
-	0xaa, 0xae, 0x35, 0xcf, 0x5e, 0x3b, 0x6f, 0x57, 0xf8, 0x64, 0xe7, 0x85, 0xd6, 0x4e, 0x3f, 0x7c,
-	0x7c, 0x7e, 0xa2, 0x25, 0x82, 0x6e, 0x9d, 0xfb, 0xe6, 0xcf, 0x07, 0x97, 0xfb, 0xe9, 0x1a, 0xcb,
-	0xb0, 0x34, 0xc0, 0xac, 0x85, 0x69, 0x42, 0x62, 0x8a, 0x1b, 0xff, 0x68, 0xb0, 0xb2, 0x49, 0xfd,
-	0xf5, 0x14, 0x23, 0x86, 0xef, 0x84, 0xa4, 0x83, 0xc2, 0x8f, 0xf3, 0xfc, 0x77, 0xb2, 0xfc, 0xfa,
-	0x3a, 0xcc, 0x51, 0x46, 0x52, 0xe4, 0xe3, 0xcc, 0xd1, 0x5e, 0xe0, 0xe1, 0xf4, 0x48, 0x21, 0xb3,
-	0x22, 0xe2, 0x9e, 0x08, 0xd0, 0x57, 0x60, 0xe6, 0x3e, 0x8a, 0x82, 0x70, 0xaf, 0x1d, 0x78, 0x5c,
-	0x51, 0xad, 0x35, 0x9d, 0x2f, 0x6c, 0x78, 0x7a, 0x13, 0xe6, 0x28, 0x76, 0x49, 0xec, 0xa1, 0x74,
-	0xaf, 0x4d, 0x93, 0x76, 0xe0, 0x51, 0x63, 0xb2, 0x3e, 0xd9, 0xac, 0xb5, 0xce, 0xc9, 0xf5, 0xad,
-	0x64, 0xc3, 0xa3, 0xfa, 0x9b, 0x70, 0xc6, 0xc3, 0x09, 0xa1, 0x01, 0x33, 0x4e, 0x73, 0x5b, 0x96,
-	0x6d, 0x51, 0x3f, 0xbb, 0x25, 0xb6, 0xb8, 0x25, 0xf6, 0x3a, 0x09, 0x62, 0x61, 0x48, 0x81, 0xbf,
-	0xb5, 0x98, 0x39, 0x32, 0xa4, 0xa4, 0xf1, 0x32, 0x5c, 0x50, 0x88, 0x97, 0x26, 0x3d, 0xc8, 0x4d,
-	0x7a, 0x0f, 0x87, 0xf8, 0xbf, 0x33, 0xe9, 0x75, 0x58, 0xf2, 0x79, 0xea, 0xb6, 0x38, 0xe0, 0x36,
-	0x3f, 0xe1, 0xbe, 0x65, 0x0b, 0xfe, 0x50, 0xe5, 0x0d, 0x4f, 0xad, 0xac, 0x8a, 0xb1, 0x54, 0xf6,
-	0xab, 0x06, 0xc0, 0x71, 0xdc, 0xa6, 0x67, 0x29, 0xa4, 0x7c, 0xba, 0x93, 0xc7, 0x73, 0xba, 0x0b,
-	0xa0, 0xf7, 0xb5, 0x49, 0xc9, 0xbf, 0x69, 0x70, 0x76, 0x93, 0xfa, 0x9f, 0x04, 0x6c, 0xdb, 0x4b,
-	0xd1, 0xce, 0x33, 0xd5, 0xfc, 0x16, 0x4c, 0xef, 0x08, 0x1e, 0xe3, 0x8a, 0x96, 0x01, 0x55, 0xaa,
-	0x17, 0x61, 0xbe, 0x24, 0x4f, 0xca, 0x7e, 0x7c, 0x8a, 0x9f, 0xf4, 0xd6, 0x0e, 0x4a, 0xee, 0x76,
-	0x8f, 0xe9, 0xa4, 0xd7, 0xc0, 0x1a, 0xa9, 0x7a, 0xf0, 0x61, 0x37, 0x87, 0xc5, 0x7f, 0x50, 0x3c,
-	0xfe, 0x37, 0xc1, 0xa8, 0x70, 0xae, 0x68, 0x03, 0x8b, 0xa3, 0xac, 0xa3, 0xfa, 0x25, 0x98, 0xa5,
-	0x5d, 0xd7, 0xc5, 0x94, 0x92, 0x34, 0xef, 0x1b, 0xbc, 0x2b, 0xd4, 0x5a, 0x35, 0xb9, 0x9c, 0xb5,
-	0x0d, 0xfd, 0x2e, 0x2c, 0x1e, 0xc2, 0x15, 0xcd, 0xdd, 0x78, 0x8e, 0x1b, 0xbe, 0x52, 0x6e, 0xad,
-	0x79, 0xff, 0xb7, 0x6f, 0x0b, 0x48, 0x6b, 0xbe, 0x94, 0xaa, 0x58, 0x54, 0xdf, 0x36, 0xe1, 0xaf,
-	0xb4, 0xfd, 0x6f, 0x8d, 0x2f, 0xaf, 0x93, 0x28, 0xc9, 0x1e, 0xc5, 0x13, 0x63, 0x7f, 0x95, 0x0b,
-	0x2f, 0x81, 0x39, 0x2c, 0x57, 0xba, 0xf1, 0x97, 0x06, 0x73, 0xd9, 0x36, 0x8a, 0x5d, 0x1c, 0x9e,
-	0x78, 0x2f, 0x4c, 0x30, 0x06, 0xc5, 0x4a, 0x27, 0xfe, 0xd0, 0x60, 0x26, 0xbb, 0x2e, 0x98, 0xb1,
-	0x10, 0x9f, 0x5c, 0x0b, 0xe6, 0xe1, 0x05, 0xa9, 0x52, 0x6a, 0x67, 0xf0, 0x62, 0xb6, 0x78, 0x98,
-	0xfe, 0xfb, 0xbb, 0xc7, 0xf4, 0xfd, 0x53, 0x45, 0xa5, 0x0e, 0xd6, 0xe8, 0xaa, 0x92, 0xd7, 0x57,
-	0x1c, 0x21, 0xef, 0xee, 0xff, 0xcc, 0xaf, 0x09, 0x97, 0xd4, 0xd5, 0x0b, 0x9e, 0xd7, 0xbe, 0x05,
-	0x98, 0xdc, 0xa4, 0xbe, 0xfe, 0x9d, 0x06, 0x46, 0xe5, 0xe0, 0x76, 0xa3, 0x72, 0x64, 0x54, 0x4c,
-	0x3c, 0xe6, 0xdb, 0x4f, 0x13, 0x55, 0x10, 0xe3, 0x84, 0x2a, 0x87, 0x24, 0x25, 0xa1, 0xaa, 0x28,
-	0x35, 0xa1, 0xa3, 0xc6, 0x1b, 0xfd, 0x73, 0x38, 0x53, 0x8c, 0x36, 0x17, 0xd4, 0x89, 0x38, 0xc8,
-	0xbc, 0x32, 0x06, 0x48, 0x26, 0xff, 0x02, 0xa6, 0xe5, 0x10, 0x71, 0x51, 0x15, 0x58, 0xa0, 0xcc,
-	0x57, 0xc7, 0x41, 0x95, 0xc9, 0x17, 0x2d, 0x52, 0x49, 0x5e, 0x80, 0xd4, 0xe4, 0x07, 0xfa, 0x8f,
-	0xfe, 0x29, 0x4c, 0x89, 0xde, 0xd3, 0x50, 0x86, 0x71, 0x8c, 0x79, 0xf9, 0x68, 0x8c, 0xcc, 0xfc,
-	0x25, 0x3c, 0x7f, 0xe8, 0x1d, 0xa8, 0xa9, 0x8a, 0x2d, 0x23, 0xcd, 0xd7, 0xc6, 0x45, 0xca, 0x5a,
-	0x5f, 0xc3, 0xfc, 0xa8, 0xc7, 0xd4, 0x51, 0xd2, 0x1d, 0x0e, 0x30, 0x6f, 0x3e, 0x61, 0x80, 0x24,
-	0xf0, 0x83, 0x06, 0x2b, 0xaa, 0x86, 0xa1, 0x4c, 0xac, 0x08, 0x34, 0xdf, 0x7d, 0xca, 0x40, 0xc9,
-	0x8c, 0xc2, 0xec, 0xe0, 0xd0, 0x71, 0x65, 0xac, 0x9c, 0xe2, 0x36, 0x5d, 0x7f, 0x02, 0xb0, 0x2c,
-	0x1a, 0x41, 0xed, 0xf0, 0x77, 0xfb, 0x2b, 0xca, 0x2c, 0x65, 0xa8, 0xb9, 0x3a, 0x36, 0xb4, 0x28,
-	0xb7, 0xf6, 0xe1, 0xc3, 0x7d, 0x4b, 0x7b, 0xb4, 0x6f, 0x69, 0xbf, 0xef, 0x5b, 0xda, 0xf7, 0x07,
-	0xd6, 0xc4, 0xa3, 0x03, 0x6b, 0xe2, 0x97, 0x03, 0x6b, 0xe2, 0xb3, 0x1b, 0x7e, 0xc0, 0xb6, 0xbb,
-	0x9d, 0x6c, 0xa0, 0x73, 0x3a, 0x71, 0xe7, 0xaa, 0xbb, 0x8d, 0x82, 0xd8, 0x29, 0xbd, 0xc7, 0xef,
-	0x0e, 0xfc, 0x20, 0xb1, 0x97, 0x60, 0xda, 0x99, 0xe2, 0x6f, 0xf2, 0xd7, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xc0, 0xc3, 0x09, 0x30, 0xb8, 0x10, 0x00, 0x00,
+	// 1242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x1b, 0xce, 0xd6, 0x69, 0x1a, 0xbf, 0xa9, 0x9b, 0x7c, 0x9b, 0xe4, 0x8b, 0xb3, 0x0e, 0x8e, 0xe5,
+	0x86, 0x62, 0x1a, 0xe2, 0x25, 0x49, 0x4b, 0x21, 0x34, 0x82, 0x3a, 0xd0, 0xca, 0x87, 0xd0, 0xca,
+	0x51, 0x01, 0x81, 0x84, 0xb5, 0xde, 0x9d, 0x6e, 0x16, 0xd6, 0x3b, 0xab, 0x9d, 0x71, 0x7e, 0x48,
+	0x48, 0x48, 0x9c, 0x38, 0x21, 0x38, 0x81, 0x38, 0x70, 0xe4, 0xdc, 0x43, 0xff, 0x88, 0x1e, 0xab,
+	0x9e, 0x50, 0x25, 0x22, 0x94, 0x20, 0x21, 0x84, 0x38, 0x70, 0xe6, 0x82, 0x76, 0x77, 0x3c, 0x59,
+	0xc7, 0xd9, 0xc9, 0xc6, 0xa4, 0x14, 0xe5, 0x94, 0x78, 0xe6, 0x79, 0xdf, 0x79, 0x9e, 0x67, 0x66,
+	0xdf, 0x7d, 0x77, 0xa0, 0x60, 0x7a, 0x08, 0x39, 0xf7, 0x2c, 0x64, 0x1b, 0xea, 0x86, 0xe5, 0xd1,
+	0x96, 0x66, 0x9b, 0x1e, 0x6e, 0xb9, 0x2a, 0xdd, 0x2a, 0xbb, 0x1e, 0xa6, 0x58, 0x9e, 0xd8, 0x47,
+	0x94, 0xa3, 0x08, 0x25, 0xaf, 0x63, 0xd2, 0xc4, 0x44, 0x6d, 0x68, 0x04, 0xa9, 0x1b, 0xf3, 0x0d,
+	0x44, 0xb5, 0x79, 0x55, 0xc7, 0x96, 0x13, 0x06, 0x2a, 0x13, 0x6c, 0xbe, 0x49, 0x4c, 0x75, 0x63,
+	0xde, 0xff, 0xc3, 0x26, 0x26, 0xc3, 0x89, 0x7a, 0xf0, 0x4b, 0x0d, 0x7f, 0xb0, 0xa9, 0x31, 0x13,
+	0x9b, 0x38, 0x1c, 0xf7, 0xff, 0x63, 0xa3, 0x51, 0x92, 0x3a, 0x6e, 0x36, 0xb1, 0xa3, 0x6a, 0xae,
+	0xeb, 0xe1, 0x0d, 0xcd, 0x66, 0x88, 0x99, 0x38, 0x19, 0xae, 0xe6, 0x69, 0x4d, 0x96, 0xbd, 0xf8,
+	0xad, 0x04, 0xc3, 0xab, 0xc4, 0xbc, 0xeb, 0x1a, 0x1a, 0x45, 0x77, 0x82, 0x19, 0xf9, 0x15, 0x48,
+	0x6b, 0x2d, 0xba, 0x8e, 0x3d, 0x8b, 0x6e, 0x67, 0xa5, 0x82, 0x54, 0x4a, 0x57, 0xb2, 0x8f, 0x1f,
+	0xcc, 0x8d, 0x31, 0x5a, 0x37, 0x0c, 0xc3, 0x43, 0x84, 0xac, 0x51, 0xcf, 0x72, 0xcc, 0xda, 0x3e,
+	0x54, 0x5e, 0x86, 0x81, 0x30, 0x77, 0xf6, 0x4c, 0x41, 0x2a, 0x0d, 0x2d, 0x4c, 0x97, 0x63, 0x7c,
+	0x2a, 0x87, 0x0b, 0x55, 0xfa, 0x1f, 0xee, 0x4c, 0xf7, 0xd5, 0x58, 0xd0, 0xd2, 0x85, 0xcf, 0x7f,
+	0xbd, 0x7f, 0x79, 0x3f, 0x5d, 0x71, 0x12, 0x26, 0x0e, 0x30, 0xab, 0x21, 0xe2, 0x62, 0x87, 0xa0,
+	0xe2, 0x5f, 0x12, 0xe4, 0x56, 0x89, 0xb9, 0xe2, 0x21, 0x8d, 0xa2, 0x5b, 0x36, 0x6e, 0x68, 0xf6,
+	0xbb, 0x61, 0xfe, 0x5b, 0x7e, 0x7e, 0x79, 0x05, 0x46, 0x08, 0xc5, 0x9e, 0x66, 0x22, 0xdf, 0xd1,
+	0x0d, 0xcb, 0x40, 0xde, 0x91, 0x42, 0x86, 0x59, 0xc4, 0x1d, 0x16, 0x20, 0xe7, 0x20, 0x7d, 0x4f,
+	0x6b, 0x5a, 0xf6, 0x76, 0xdd, 0x32, 0x02, 0x45, 0x99, 0xda, 0x60, 0x38, 0x50, 0x35, 0xe4, 0x12,
+	0x8c, 0x10, 0xa4, 0x63, 0xc7, 0xd0, 0xbc, 0xed, 0x3a, 0x71, 0xeb, 0x96, 0x41, 0xb2, 0xa9, 0x42,
+	0xaa, 0x94, 0xa9, 0x5d, 0xe0, 0xe3, 0x6b, 0x6e, 0xd5, 0x20, 0xf2, 0x6b, 0x70, 0xce, 0x40, 0x2e,
+	0x26, 0x16, 0xcd, 0xf6, 0x07, 0xb6, 0x4c, 0x96, 0xd9, 0xfa, 0xfe, 0x29, 0x29, 0xb3, 0x53, 0x52,
+	0x5e, 0xc1, 0x96, 0xc3, 0x0c, 0x69, 0xe3, 0x97, 0xc6, 0x7d, 0x47, 0xba, 0x94, 0x14, 0x9f, 0x87,
+	0x8b, 0x02, 0xf1, 0xdc, 0xa4, 0xef, 0x25, 0x50, 0xba, 0xa7, 0x83, 0x30, 0x0b, 0x3b, 0x9d, 0xf2,
+	0xa4, 0x04, 0xf2, 0xce, 0x1c, 0x25, 0x2f, 0x75, 0x3c, 0x79, 0xc5, 0xdf, 0x24, 0x98, 0x12, 0x08,
+	0x21, 0x27, 0xb3, 0x8d, 0x4d, 0x18, 0x37, 0x83, 0xdc, 0x75, 0x76, 0x04, 0xeb, 0xc1, 0x19, 0x0c,
+	0xf5, 0x0c, 0x2d, 0x2c, 0xc6, 0x1e, 0xd2, 0x78, 0xef, 0x98, 0x90, 0x51, 0xb3, 0x9b, 0x73, 0xdc,
+	0x9e, 0xd5, 0x61, 0x46, 0x24, 0xb5, 0xbd, 0x69, 0xf2, 0x35, 0xc8, 0x1e, 0xc6, 0x36, 0xd8, 0x00,
+	0x29, 0xd8, 0x80, 0xf1, 0xee, 0x55, 0xab, 0x06, 0x29, 0x7e, 0x91, 0x82, 0xe9, 0x55, 0x62, 0xd6,
+	0x50, 0x43, 0xb3, 0x35, 0x47, 0x7f, 0x7a, 0x7e, 0x56, 0xa1, 0x48, 0x3c, 0xbd, 0x7e, 0x28, 0xcb,
+	0x83, 0xcf, 0xcb, 0x73, 0xc4, 0xd3, 0xbb, 0x89, 0xdc, 0x6c, 0x9f, 0xb2, 0x2a, 0x14, 0x0d, 0x42,
+	0x8f, 0x4a, 0x95, 0x0a, 0x53, 0x19, 0x84, 0x0a, 0x52, 0x89, 0x7c, 0xeb, 0x17, 0xf8, 0x26, 0x2f,
+	0x01, 0x34, 0x5a, 0xfa, 0x27, 0x88, 0x06, 0xd0, 0xb3, 0x85, 0x54, 0x29, 0x5d, 0xc9, 0xf9, 0xdb,
+	0xfb, 0x64, 0x67, 0xba, 0xff, 0xae, 0xe5, 0xd0, 0xc7, 0x0f, 0xe6, 0x86, 0x98, 0x33, 0xfe, 0xcf,
+	0x5a, 0x3a, 0x84, 0x57, 0x8d, 0xd8, 0xbd, 0xa6, 0xf0, 0xc2, 0x11, 0x3b, 0xc1, 0xb7, 0x3b, 0x99,
+	0x03, 0x52, 0x02, 0x07, 0x8a, 0xf7, 0xc3, 0x9a, 0xf8, 0x16, 0xb2, 0xd1, 0xd3, 0xab, 0x89, 0x57,
+	0x61, 0x22, 0xc6, 0x66, 0xb6, 0xe3, 0x63, 0x87, 0xb9, 0x2c, 0x2e, 0x64, 0x71, 0x8c, 0x79, 0x21,
+	0x7b, 0x22, 0x01, 0x04, 0xb8, 0xa0, 0x6c, 0x3c, 0x4b, 0x21, 0xff, 0xa0, 0xda, 0xc5, 0x79, 0x30,
+	0x06, 0xf2, 0xbe, 0x36, 0x2e, 0xf9, 0x27, 0x09, 0x86, 0x56, 0x89, 0xf9, 0x9e, 0x45, 0xd7, 0x0d,
+	0x4f, 0xdb, 0x7c, 0xa6, 0x9a, 0x5f, 0x87, 0xc1, 0x4d, 0xc6, 0x23, 0xa9, 0x68, 0x1e, 0x10, 0xa7,
+	0x7a, 0x1c, 0x46, 0x23, 0xf2, 0xb8, 0xec, 0x9d, 0x33, 0xc1, 0x4e, 0xaf, 0x6d, 0x6a, 0xee, 0xed,
+	0xd6, 0x09, 0xed, 0x74, 0x05, 0xf2, 0x89, 0x6a, 0x95, 0x62, 0xf6, 0x56, 0x5d, 0x52, 0xa2, 0xea,
+	0x72, 0x09, 0x86, 0x49, 0x4b, 0xd7, 0x11, 0x21, 0xd8, 0x0b, 0xdf, 0xa3, 0x41, 0x13, 0x90, 0xa9,
+	0x65, 0xf8, 0xb0, 0xff, 0x1a, 0x95, 0x6f, 0xc3, 0x78, 0x07, 0xae, 0xdd, 0xcb, 0x65, 0xcf, 0x06,
+	0x86, 0xe7, 0xa2, 0x2f, 0xa9, 0xb0, 0xdd, 0x2b, 0xdf, 0x60, 0x90, 0xda, 0x68, 0x24, 0x55, 0x7b,
+	0x50, 0x7c, 0xda, 0x98, 0xbf, 0xdc, 0xf6, 0x3f, 0xa4, 0x60, 0x78, 0x05, 0x37, 0x5d, 0xff, 0x51,
+	0x3c, 0x35, 0xf6, 0xc7, 0xb9, 0x30, 0x05, 0x4a, 0xb7, 0x5c, 0xee, 0xc6, 0xef, 0x12, 0x8c, 0xf8,
+	0xd3, 0x7e, 0xed, 0xb6, 0x4f, 0xbd, 0x17, 0x0a, 0x64, 0x0f, 0x8a, 0xe5, 0x4e, 0xfc, 0x19, 0x3a,
+	0x71, 0x13, 0x7b, 0x3a, 0x32, 0xfe, 0x73, 0x4e, 0x2c, 0x43, 0xae, 0xa3, 0x47, 0x6d, 0xd8, 0xa4,
+	0x4e, 0x2c, 0xd3, 0xd1, 0x68, 0xcb, 0x43, 0xa1, 0x19, 0xe7, 0x6b, 0xd9, 0x48, 0xbb, 0x5a, 0xb1,
+	0xc9, 0x1a, 0x9f, 0x17, 0xfb, 0xd1, 0x21, 0x99, 0xfb, 0xf1, 0x8b, 0x04, 0x69, 0xff, 0xf1, 0x41,
+	0x94, 0xda, 0xe8, 0xf4, 0x1e, 0x89, 0x51, 0xf8, 0x1f, 0x57, 0xc9, 0xb5, 0x53, 0xf8, 0xbf, 0x3f,
+	0xd8, 0x49, 0xff, 0xed, 0xad, 0x13, 0x7a, 0x1f, 0xc7, 0x51, 0x29, 0x40, 0xfe, 0xf0, 0x55, 0x39,
+	0xaf, 0x4f, 0x21, 0x1f, 0x7d, 0x96, 0xff, 0x65, 0x7e, 0x25, 0xb8, 0x24, 0x5e, 0xbd, 0xcd, 0x73,
+	0xe1, 0x87, 0x0c, 0xa4, 0x56, 0x89, 0x29, 0x7f, 0x29, 0x41, 0x36, 0xf6, 0xbb, 0xf5, 0x4a, 0xec,
+	0xc7, 0x88, 0xe0, 0xe3, 0x41, 0xb9, 0xde, 0x4b, 0x14, 0x6f, 0x41, 0x7d, 0x42, 0xb1, 0x4d, 0xa3,
+	0x90, 0x50, 0x5c, 0x94, 0x72, 0xbd, 0x97, 0x28, 0x4e, 0xe8, 0x43, 0x38, 0xd7, 0x6e, 0xf5, 0x2e,
+	0x8a, 0x13, 0x05, 0x20, 0x65, 0x36, 0x01, 0x88, 0x27, 0xff, 0x08, 0x06, 0x79, 0x53, 0x35, 0x23,
+	0x0a, 0x6c, 0xa3, 0x94, 0x97, 0x92, 0xa0, 0xa2, 0xe4, 0xdb, 0x85, 0x52, 0x48, 0x9e, 0x81, 0x94,
+	0xd9, 0x04, 0x20, 0x9e, 0xfc, 0x7d, 0x18, 0x60, 0xb5, 0xa7, 0x28, 0x0c, 0x0b, 0x30, 0xca, 0xe5,
+	0xa3, 0x31, 0x3c, 0xf3, 0xc7, 0x70, 0xbe, 0xe3, 0x0a, 0xa8, 0x24, 0x8a, 0x8d, 0x22, 0x95, 0x97,
+	0x93, 0x22, 0xf9, 0x5a, 0x9f, 0xc1, 0xe8, 0x61, 0x8f, 0xa9, 0x2a, 0xa4, 0xdb, 0x1d, 0xa0, 0x5c,
+	0x3b, 0x66, 0x00, 0x27, 0xf0, 0x8d, 0x04, 0x39, 0x51, 0xc1, 0x10, 0x26, 0x16, 0x04, 0x2a, 0x6f,
+	0xf4, 0x18, 0xc8, 0x99, 0x11, 0x18, 0x3e, 0xd8, 0x84, 0xcd, 0x26, 0xca, 0xc9, 0x4e, 0xd3, 0xe2,
+	0x31, 0xc0, 0x7c, 0xd1, 0x26, 0x64, 0x3a, 0x7b, 0x9d, 0x17, 0x85, 0x59, 0xa2, 0x50, 0x65, 0x3e,
+	0x31, 0x34, 0xba, 0x5c, 0x67, 0x43, 0x21, 0x5c, 0xae, 0x03, 0xaa, 0xcc, 0x27, 0x86, 0xf2, 0xe5,
+	0xbe, 0x96, 0x60, 0x32, 0xfe, 0x86, 0xe9, 0x6a, 0x2f, 0xa5, 0x93, 0x28, 0xcb, 0x3d, 0x85, 0x71,
+	0x4e, 0xdf, 0x49, 0x30, 0x25, 0xbc, 0xa8, 0x79, 0x55, 0x94, 0x5f, 0x14, 0xa9, 0xbc, 0xd9, 0x6b,
+	0x64, 0x9b, 0x5c, 0xe5, 0x9d, 0x87, 0xbb, 0x79, 0xe9, 0xd1, 0x6e, 0x5e, 0xfa, 0x79, 0x37, 0x2f,
+	0x7d, 0xb5, 0x97, 0xef, 0x7b, 0xb4, 0x97, 0xef, 0xfb, 0x71, 0x2f, 0xdf, 0xf7, 0xc1, 0x15, 0xd3,
+	0xa2, 0xeb, 0xad, 0x86, 0xff, 0x01, 0xa2, 0x36, 0x9c, 0xc6, 0x9c, 0xbe, 0xae, 0x59, 0x8e, 0x1a,
+	0xb9, 0x66, 0xde, 0x3a, 0x70, 0x5f, 0xbe, 0xed, 0x22, 0xd2, 0x18, 0x08, 0x2e, 0x9a, 0x17, 0xff,
+	0x1e, 0x00, 0x35, 0x02, 0x4f, 0x34, 0x57, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1232,6 +1648,9 @@ type MsgClient interface {
 	CompleteStorageProviderExit(ctx context.Context, in *MsgCompleteStorageProviderExit, opts ...grpc.CallOption) (*MsgCompleteStorageProviderExitResponse, error)
 	CompleteSwapOut(ctx context.Context, in *MsgCompleteSwapOut, opts ...grpc.CallOption) (*MsgCompleteSwapOutResponse, error)
 	CancelSwapOut(ctx context.Context, in *MsgCancelSwapOut, opts ...grpc.CallOption) (*MsgCancelSwapOutResponse, error)
+	ForcedSwapOut(ctx context.Context, in *MsgForcedSwapOut, opts ...grpc.CallOption) (*MsgForcedSwapOutResponse, error)
+	CreateGlobalVirtualGroups(ctx context.Context, in *MsgCreateGlobalVirtualGroups, opts ...grpc.CallOption) (*MsgCreateGlobalVirtualGroupsResponse, error)
+	RebalanceGlobalVirtualGroups(ctx context.Context, in *MsgRebalanceGlobalVirtualGroups, opts ...grpc.CallOption) (*MsgRebalanceGlobalVirtualGroupsResponse, error)
 }
 
 type msgClient struct {
@@ -1341,6 +1760,33 @@ func (c *msgClient) CancelSwapOut(ctx context.Context, in *MsgCancelSwapOut, opt
 	return out, nil
 }
 
+func (c *msgClient) ForcedSwapOut(ctx context.Context, in *MsgForcedSwapOut, opts ...grpc.CallOption) (*MsgForcedSwapOutResponse, error) {
+	out := new(MsgForcedSwapOutResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Msg/ForcedSwapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGlobalVirtualGroups(ctx context.Context, in *MsgCreateGlobalVirtualGroups, opts ...grpc.CallOption) (*MsgCreateGlobalVirtualGroupsResponse, error) {
+	out := new(MsgCreateGlobalVirtualGroupsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Msg/CreateGlobalVirtualGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RebalanceGlobalVirtualGroups(ctx context.Context, in *MsgRebalanceGlobalVirtualGroups, opts ...grpc.CallOption) (*MsgRebalanceGlobalVirtualGroupsResponse, error) {
+	out := new(MsgRebalanceGlobalVirtualGroupsResponse)
+	err := c.cc.Invoke(ctx, "/greenfield.virtualgroup.Msg/RebalanceGlobalVirtualGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGlobalVirtualGroup(context.Context, *MsgCreateGlobalVirtualGroup) (*MsgCreateGlobalVirtualGroupResponse, error)
@@ -1355,6 +1801,9 @@ type MsgServer interface {
 	CompleteStorageProviderExit(context.Context, *MsgCompleteStorageProviderExit) (*MsgCompleteStorageProviderExitResponse, error)
 	CompleteSwapOut(context.Context, *MsgCompleteSwapOut) (*MsgCompleteSwapOutResponse, error)
 	CancelSwapOut(context.Context, *MsgCancelSwapOut) (*MsgCancelSwapOutResponse, error)
+	ForcedSwapOut(context.Context, *MsgForcedSwapOut) (*MsgForcedSwapOutResponse, error)
+	CreateGlobalVirtualGroups(context.Context, *MsgCreateGlobalVirtualGroups) (*MsgCreateGlobalVirtualGroupsResponse, error)
+	RebalanceGlobalVirtualGroups(context.Context, *MsgRebalanceGlobalVirtualGroups) (*MsgRebalanceGlobalVirtualGroupsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1394,6 +1843,15 @@ func (*UnimplementedMsgServer) CompleteSwapOut(ctx context.Context, req *MsgComp
 func (*UnimplementedMsgServer) CancelSwapOut(ctx context.Context, req *MsgCancelSwapOut) (*MsgCancelSwapOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSwapOut not implemented")
 }
+func (*UnimplementedMsgServer) ForcedSwapOut(ctx context.Context, req *MsgForcedSwapOut) (*MsgForcedSwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForcedSwapOut not implemented")
+}
+func (*UnimplementedMsgServer) CreateGlobalVirtualGroups(ctx context.Context, req *MsgCreateGlobalVirtualGroups) (*MsgCreateGlobalVirtualGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGlobalVirtualGroups not implemented")
+}
+func (*UnimplementedMsgServer) RebalanceGlobalVirtualGroups(ctx context.Context, req *MsgRebalanceGlobalVirtualGroups) (*MsgRebalanceGlobalVirtualGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceGlobalVirtualGroups not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1597,6 +2055,60 @@ func _Msg_CancelSwapOut_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ForcedSwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForcedSwapOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForcedSwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Msg/ForcedSwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForcedSwapOut(ctx, req.(*MsgForcedSwapOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGlobalVirtualGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGlobalVirtualGroups)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGlobalVirtualGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Msg/CreateGlobalVirtualGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGlobalVirtualGroups(ctx, req.(*MsgCreateGlobalVirtualGroups))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RebalanceGlobalVirtualGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceGlobalVirtualGroups)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceGlobalVirtualGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/greenfield.virtualgroup.Msg/RebalanceGlobalVirtualGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceGlobalVirtualGroups(ctx, req.(*MsgRebalanceGlobalVirtualGroups))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "greenfield.virtualgroup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1645,6 +2157,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSwapOut",
 			Handler:    _Msg_CancelSwapOut_Handler,
 		},
+		{
+			MethodName: "ForcedSwapOut",
+			Handler:    _Msg_ForcedSwapOut_Handler,
+		},
+		{
+			MethodName: "CreateGlobalVirtualGroups",
+			Handler:    _Msg_CreateGlobalVirtualGroups_Handler,
+		},
+		{
+			MethodName: "RebalanceGlobalVirtualGroups",
+			Handler:    _Msg_RebalanceGlobalVirtualGroups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "greenfield/virtualgroup/tx.proto",
@@ -1799,7 +2323,7 @@ func (m *MsgCreateGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
+func (m *GlobalVirtualGroupCreation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1809,32 +2333,53 @@ func (m *MsgDeleteGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *GlobalVirtualGroupCreation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GlobalVirtualGroupCreation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SecondarySpIds) > 0 {
+		dAtA7 := make([]byte, len(m.SecondarySpIds)*10)
+		var j6 int
+		for _, num := range m.SecondarySpIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.StorageProvider) > 0 {
-		i -= len(m.StorageProvider)
-		copy(dAtA[i:], m.StorageProvider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+	if m.FamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FamilyId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGlobalVirtualGroups) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1844,20 +2389,41 @@ func (m *MsgDeleteGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGlobalVirtualGroups) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGlobalVirtualGroups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.GlobalVirtualGroups) > 0 {
+		for iNdEx := len(m.GlobalVirtualGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalVirtualGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StorageProvider) > 0 {
+		i -= len(m.StorageProvider)
+		copy(dAtA[i:], m.StorageProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateGlobalVirtualGroupsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1867,42 +2433,38 @@ func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateGlobalVirtualGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateGlobalVirtualGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA9 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j8 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.GlobalVirtualGroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.StorageProvider) > 0 {
-		i -= len(m.StorageProvider)
-		copy(dAtA[i:], m.StorageProvider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRebalanceGlobalVirtualGroups) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1912,25 +2474,228 @@ func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRebalanceGlobalVirtualGroups) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRebalanceGlobalVirtualGroups) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.BucketIds) > 0 {
+		for iNdEx := len(m.BucketIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BucketIds[iNdEx].Size()
+				i -= size
+				if _, err := m.BucketIds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA11 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j10 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DstGlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DstGlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcGlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SrcGlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StorageProvider) > 0 {
+		i -= len(m.StorageProvider)
+		copy(dAtA[i:], m.StorageProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DstGlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DstGlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteGlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteGlobalVirtualGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteGlobalVirtualGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StorageProvider) > 0 {
+		i -= len(m.StorageProvider)
+		copy(dAtA[i:], m.StorageProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteGlobalVirtualGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteGlobalVirtualGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteGlobalVirtualGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StorageProvider) > 0 {
+		i -= len(m.StorageProvider)
+		copy(dAtA[i:], m.StorageProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -2031,20 +2796,20 @@ func (m *MsgSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA9 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j8 int
+		dAtA16 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j15 int
 		for _, num := range m.GlobalVirtualGroupIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j15++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2107,20 +2872,20 @@ func (m *MsgCompleteSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA11 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j10 int
+		dAtA18 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j17 int
 		for _, num := range m.GlobalVirtualGroupIds {
 			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j10++
+				j17++
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			dAtA18[j17] = uint8(num)
+			j17++
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2183,20 +2948,20 @@ func (m *MsgCancelSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA13 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j12 int
+		dAtA20 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j19 int
 		for _, num := range m.GlobalVirtualGroupIds {
 			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j12++
+				j19++
 			}
-			dAtA13[j12] = uint8(num)
-			j12++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j12
-		copy(dAtA[i:], dAtA13[:j12])
-		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2238,7 +3003,7 @@ func (m *MsgCancelSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSettle) Marshal() (dAtA []byte, err error) {
+func (m *MsgForcedSwapOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2248,33 +3013,24 @@ func (m *MsgSettle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSettle) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgForcedSwapOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgForcedSwapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GlobalVirtualGroupIds) > 0 {
-		dAtA15 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
-		var j14 int
-		for _, num := range m.GlobalVirtualGroupIds {
-			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j14++
-			}
-			dAtA15[j14] = uint8(num)
-			j14++
+	if len(m.SecondarySpBlsSignatures) > 0 {
+		for iNdEx := len(m.SecondarySpBlsSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SecondarySpBlsSignatures[iNdEx])
+			copy(dAtA[i:], m.SecondarySpBlsSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SecondarySpBlsSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintTx(dAtA, i, uint64(j14))
-		i--
-		dAtA[i] = 0x1a
 	}
 	if m.GlobalVirtualGroupFamilyId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
@@ -2291,7 +3047,7 @@ func (m *MsgSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSettleResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgForcedSwapOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2301,12 +3057,12 @@ func (m *MsgSettleResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSettleResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgForcedSwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgForcedSwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2314,7 +3070,7 @@ func (m *MsgSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStorageProviderExit) Marshal() (dAtA []byte, err error) {
+func (m *MsgSettle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2324,16 +3080,39 @@ func (m *MsgStorageProviderExit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSettle) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		dAtA22 := make([]byte, len(m.GlobalVirtualGroupIds)*10)
+		var j21 int
+		for _, num := range m.GlobalVirtualGroupIds {
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTx(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GlobalVirtualGroupFamilyId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.StorageProvider) > 0 {
 		i -= len(m.StorageProvider)
 		copy(dAtA[i:], m.StorageProvider)
@@ -2344,7 +3123,7 @@ func (m *MsgStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStorageProviderExitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSettleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2354,12 +3133,12 @@ func (m *MsgStorageProviderExitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStorageProviderExitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSettleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStorageProviderExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2367,7 +3146,7 @@ func (m *MsgStorageProviderExitResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteStorageProviderExit) Marshal() (dAtA []byte, err error) {
+func (m *MsgStorageProviderExit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2377,12 +3156,12 @@ func (m *MsgCompleteStorageProviderExit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCompleteStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2397,7 +3176,7 @@ func (m *MsgCompleteStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCompleteStorageProviderExitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStorageProviderExitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2407,7 +3186,60 @@ func (m *MsgCompleteStorageProviderExitResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MsgCompleteStorageProviderExitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStorageProviderExitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageProviderExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteStorageProviderExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteStorageProviderExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteStorageProviderExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageProvider) > 0 {
+		i -= len(m.StorageProvider)
+		copy(dAtA[i:], m.StorageProvider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteStorageProviderExitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteStorageProviderExitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2489,6 +3321,106 @@ func (m *MsgCreateGlobalVirtualGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *GlobalVirtualGroupCreation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FamilyId != 0 {
+		n += 1 + sovTx(uint64(m.FamilyId))
+	}
+	if len(m.SecondarySpIds) > 0 {
+		l = 0
+		for _, e := range m.SecondarySpIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateGlobalVirtualGroups) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.GlobalVirtualGroups) > 0 {
+		for _, e := range m.GlobalVirtualGroups {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateGlobalVirtualGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgRebalanceGlobalVirtualGroups) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SrcGlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.SrcGlobalVirtualGroupFamilyId))
+	}
+	if m.DstGlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.DstGlobalVirtualGroupFamilyId))
+	}
+	if len(m.GlobalVirtualGroupIds) > 0 {
+		l = 0
+		for _, e := range m.GlobalVirtualGroupIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BucketIds) > 0 {
+		for _, e := range m.BucketIds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DstGlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.DstGlobalVirtualGroupFamilyId))
+	}
+	return n
+}
+
 func (m *MsgDeleteGlobalVirtualGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2671,6 +3603,37 @@ func (m *MsgCancelSwapOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgForcedSwapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageProvider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GlobalVirtualGroupFamilyId != 0 {
+		n += 1 + sovTx(uint64(m.GlobalVirtualGroupFamilyId))
+	}
+	if len(m.SecondarySpBlsSignatures) > 0 {
+		for _, b := range m.SecondarySpBlsSignatures {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgForcedSwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSettle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2887,16 +3850,696 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			}
+			m.FamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalVirtualGroupCreation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalVirtualGroupCreation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalVirtualGroupCreation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+			}
+			m.FamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SecondarySpIds = append(m.SecondarySpIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
+					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SecondarySpIds = append(m.SecondarySpIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGlobalVirtualGroups) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroups: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroups: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalVirtualGroups = append(m.GlobalVirtualGroups, GlobalVirtualGroupCreation{})
+			if err := m.GlobalVirtualGroups[len(m.GlobalVirtualGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGlobalVirtualGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2918,7 +4561,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceGlobalVirtualGroups) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,10 +4584,10 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroups: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroups: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2981,9 +4624,9 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FamilyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcGlobalVirtualGroupFamilyId", wireType)
 			}
-			m.FamilyId = 0
+			m.SrcGlobalVirtualGroupFamilyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2993,12 +4636,31 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FamilyId |= uint32(b&0x7F) << shift
+				m.SrcGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstGlobalVirtualGroupFamilyId", wireType)
+			}
+			m.DstGlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType == 0 {
 				var v uint32
 				for shift := uint(0); ; shift += 7 {
@@ -3015,7 +4677,7 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.SecondarySpIds = append(m.SecondarySpIds, v)
+				m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3050,8 +4712,8 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.SecondarySpIds) == 0 {
-					m.SecondarySpIds = make([]uint32, 0, elementCount)
+				if elementCount != 0 && len(m.GlobalVirtualGroupIds) == 0 {
+					m.GlobalVirtualGroupIds = make([]uint32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint32
@@ -3069,16 +4731,16 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.SecondarySpIds = append(m.SecondarySpIds, v)
+					m.GlobalVirtualGroupIds = append(m.GlobalVirtualGroupIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupIds", wireType)
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3088,22 +4750,25 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v Uint
+			m.BucketIds = append(m.BucketIds, v)
+			if err := m.BucketIds[len(m.BucketIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3128,7 +4793,7 @@ func (m *MsgCreateGlobalVirtualGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRebalanceGlobalVirtualGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3151,12 +4816,31 @@ func (m *MsgCreateGlobalVirtualGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGlobalVirtualGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRebalanceGlobalVirtualGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstGlobalVirtualGroupFamilyId", wireType)
+			}
+			m.DstGlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstGlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4433,6 +6117,189 @@ func (m *MsgCancelSwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgForcedSwapOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForcedSwapOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForcedSwapOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupFamilyId", wireType)
+			}
+			m.GlobalVirtualGroupFamilyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupFamilyId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySpBlsSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondarySpBlsSignatures = append(m.SecondarySpBlsSignatures, make([]byte, postIndex-iNdEx))
+			copy(m.SecondarySpBlsSignatures[len(m.SecondarySpBlsSignatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForcedSwapOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForcedSwapOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForcedSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSettle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
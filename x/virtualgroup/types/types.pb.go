@@ -368,12 +368,75 @@ func (m *SwapOutInfo) GetSuccessorSpId() uint32 {
 	return 0
 }
 
+// SecondarySpForcedSwapOutSignDoc used to generate the bls signature of the secondary sps of a gvg,
+// which indicates they accept the successor sp to take over the gvg as the primary sp.
+type SecondarySpForcedSwapOutSignDoc struct {
+	ChainId              string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GlobalVirtualGroupId uint32 `protobuf:"varint,2,opt,name=global_virtual_group_id,json=globalVirtualGroupId,proto3" json:"global_virtual_group_id,omitempty"`
+	SuccessorSpId        uint32 `protobuf:"varint,3,opt,name=successor_sp_id,json=successorSpId,proto3" json:"successor_sp_id,omitempty"`
+}
+
+func (m *SecondarySpForcedSwapOutSignDoc) Reset()         { *m = SecondarySpForcedSwapOutSignDoc{} }
+func (m *SecondarySpForcedSwapOutSignDoc) String() string { return proto.CompactTextString(m) }
+func (*SecondarySpForcedSwapOutSignDoc) ProtoMessage()    {}
+func (*SecondarySpForcedSwapOutSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe6fc664532d0c3, []int{5}
+}
+func (m *SecondarySpForcedSwapOutSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecondarySpForcedSwapOutSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecondarySpForcedSwapOutSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecondarySpForcedSwapOutSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecondarySpForcedSwapOutSignDoc.Merge(m, src)
+}
+func (m *SecondarySpForcedSwapOutSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecondarySpForcedSwapOutSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecondarySpForcedSwapOutSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecondarySpForcedSwapOutSignDoc proto.InternalMessageInfo
+
+func (m *SecondarySpForcedSwapOutSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SecondarySpForcedSwapOutSignDoc) GetGlobalVirtualGroupId() uint32 {
+	if m != nil {
+		return m.GlobalVirtualGroupId
+	}
+	return 0
+}
+
+func (m *SecondarySpForcedSwapOutSignDoc) GetSuccessorSpId() uint32 {
+	if m != nil {
+		return m.SuccessorSpId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GlobalVirtualGroup)(nil), "greenfield.virtualgroup.GlobalVirtualGroup")
 	proto.RegisterType((*GlobalVirtualGroupFamily)(nil), "greenfield.virtualgroup.GlobalVirtualGroupFamily")
 	proto.RegisterType((*GlobalVirtualGroupsBindingOnBucket)(nil), "greenfield.virtualgroup.GlobalVirtualGroupsBindingOnBucket")
 	proto.RegisterType((*GVGStatisticsWithinSP)(nil), "greenfield.virtualgroup.GVGStatisticsWithinSP")
 	proto.RegisterType((*SwapOutInfo)(nil), "greenfield.virtualgroup.SwapOutInfo")
+	proto.RegisterType((*SecondarySpForcedSwapOutSignDoc)(nil), "greenfield.virtualgroup.SecondarySpForcedSwapOutSignDoc")
 }
 
 func init() {
@@ -381,47 +444,49 @@ func init() {
 }
 
 var fileDescriptor_1fe6fc664532d0c3 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0xc7, 0x9b, 0xae, 0xdb, 0xbb, 0xba, 0xeb, 0xf6, 0xe2, 0x6d, 0x5a, 0xd8, 0xa4, 0xae, 0xca,
-	0xa4, 0xd1, 0x4b, 0xdb, 0x03, 0x20, 0x38, 0x70, 0xa1, 0x20, 0xaa, 0x70, 0x60, 0x55, 0x22, 0x86,
-	0xc4, 0x25, 0x72, 0x62, 0x2f, 0xb3, 0x96, 0xda, 0x51, 0xec, 0x0c, 0xba, 0x4f, 0xc1, 0x85, 0x6f,
-	0xb2, 0x0f, 0xb1, 0x13, 0x9a, 0x76, 0x02, 0x0e, 0x13, 0xda, 0xc4, 0xf7, 0x40, 0xb1, 0x4d, 0x3b,
-	0x08, 0x1a, 0x12, 0xa7, 0xc4, 0xff, 0xe7, 0xef, 0xc7, 0x7a, 0x7e, 0x7e, 0x1e, 0x83, 0x9d, 0x38,
-	0x23, 0x84, 0x1d, 0x50, 0x92, 0xe0, 0xfe, 0x31, 0xcd, 0x64, 0x8e, 0x92, 0x38, 0xe3, 0x79, 0xda,
-	0x97, 0x93, 0x94, 0x88, 0x5e, 0x9a, 0x71, 0xc9, 0xe1, 0xc6, 0xcc, 0xd4, 0xbb, 0x69, 0xda, 0xbc,
-	0x1b, 0x71, 0x31, 0xe6, 0x22, 0x50, 0xb6, 0xbe, 0x5e, 0xe8, 0x3d, 0x9b, 0x6b, 0x31, 0x8f, 0xb9,
-	0xd6, 0x8b, 0x3f, 0xad, 0x3a, 0xdf, 0xab, 0x00, 0x0e, 0x13, 0x1e, 0xa2, 0x64, 0x5f, 0xe7, 0x19,
-	0x16, 0x79, 0xe0, 0x32, 0xa8, 0x52, 0x6c, 0x5b, 0x6d, 0xab, 0xd3, 0xf4, 0xaa, 0x14, 0xc3, 0x2d,
-	0x50, 0x3f, 0x40, 0x63, 0x9a, 0x4c, 0x02, 0x8a, 0xed, 0xaa, 0x92, 0x17, 0xb5, 0xe0, 0x62, 0xe8,
-	0x80, 0x66, 0x9a, 0xd1, 0x31, 0xca, 0x26, 0x81, 0x48, 0x0b, 0xc3, 0x9c, 0x32, 0x34, 0x8c, 0xe8,
-	0xa7, 0x2e, 0x86, 0x1d, 0xf0, 0xbf, 0x20, 0x11, 0x67, 0x78, 0xea, 0x12, 0x76, 0xad, 0x3d, 0xd7,
-	0x69, 0x7a, 0xcb, 0x53, 0xbd, 0x30, 0x0a, 0xb8, 0x0d, 0x1a, 0x42, 0xf2, 0x8c, 0xe0, 0x40, 0xd0,
-	0x13, 0x62, 0xcf, 0xb7, 0xad, 0x4e, 0xcd, 0x03, 0x5a, 0xf2, 0xe9, 0x09, 0x81, 0x23, 0xb0, 0x61,
-	0x6a, 0x0e, 0x52, 0x34, 0x19, 0x13, 0x26, 0x03, 0x84, 0x71, 0x46, 0x84, 0xb0, 0x17, 0xda, 0x56,
-	0xa7, 0x3e, 0xb0, 0x2f, 0x4e, 0xbb, 0x6b, 0xa6, 0xf6, 0xa7, 0x3a, 0xe2, 0xcb, 0x8c, 0xb2, 0xd8,
-	0x5b, 0x37, 0x1b, 0x47, 0x7a, 0x9f, 0x09, 0x42, 0x04, 0x9a, 0x92, 0x4b, 0x94, 0x04, 0x98, 0xa4,
-	0x5c, 0x50, 0x69, 0xff, 0xa7, 0xf2, 0x3c, 0x39, 0xbb, 0xdc, 0xae, 0x7c, 0xbd, 0xdc, 0xde, 0x8d,
-	0xa9, 0x3c, 0xcc, 0xc3, 0x5e, 0xc4, 0xc7, 0x06, 0xa9, 0xf9, 0x74, 0x05, 0x3e, 0x32, 0xf7, 0xe2,
-	0x32, 0x79, 0x71, 0xda, 0x05, 0xe6, 0x54, 0x97, 0x49, 0x6f, 0x49, 0xa5, 0x7c, 0xae, 0x33, 0x3a,
-	0x5f, 0x2c, 0x60, 0x97, 0x39, 0xbf, 0x50, 0x08, 0x4b, 0xb4, 0x4b, 0x40, 0xab, 0x65, 0xa0, 0x8f,
-	0x80, 0x1d, 0xab, 0x7c, 0xc1, 0x4f, 0x18, 0xaa, 0x03, 0x14, 0xd8, 0x39, 0x05, 0x76, 0x3d, 0x2e,
-	0x9d, 0x57, 0xf0, 0xbd, 0x05, 0x5f, 0xed, 0x9f, 0xf0, 0x39, 0x9f, 0x2c, 0xe0, 0x94, 0x6b, 0x13,
-	0x03, 0xca, 0x30, 0x65, 0xf1, 0x1e, 0x1b, 0xe4, 0xd1, 0x11, 0x91, 0xf0, 0x31, 0xa8, 0x87, 0xea,
-	0x2f, 0x30, 0xc5, 0xd6, 0x07, 0x5b, 0x86, 0x70, 0xed, 0x35, 0x55, 0xfc, 0x1a, 0xe6, 0xd8, 0x62,
-	0xe9, 0x2d, 0x6a, 0xf7, 0x5f, 0x6a, 0xad, 0xde, 0x56, 0xeb, 0x43, 0xb0, 0x91, 0xf0, 0xe8, 0x16,
-	0x46, 0x6b, 0x2a, 0xfc, 0xdb, 0x36, 0xe7, 0xa3, 0x05, 0xd6, 0x87, 0xfb, 0x43, 0x5f, 0x22, 0x49,
-	0x85, 0xa4, 0x91, 0x78, 0x43, 0xe5, 0x21, 0x65, 0xfe, 0x08, 0xf6, 0xc0, 0x6a, 0xd1, 0x89, 0x28,
-	0x26, 0xc5, 0x88, 0x1d, 0x53, 0x4c, 0xb2, 0x60, 0x7a, 0x75, 0x77, 0x4c, 0x68, 0x64, 0x22, 0x2e,
-	0x86, 0x3b, 0xb3, 0x9b, 0x8c, 0x78, 0xce, 0xa4, 0xb9, 0xc9, 0x25, 0x23, 0x3e, 0x2b, 0x34, 0x78,
-	0x0f, 0xac, 0xcc, 0x66, 0x43, 0xdb, 0xf4, 0x04, 0xcd, 0x46, 0x43, 0x19, 0x9d, 0x97, 0xa0, 0xe1,
-	0xbf, 0x43, 0xe9, 0x5e, 0x2e, 0x5d, 0x76, 0xc0, 0xe1, 0x2a, 0x98, 0xd7, 0xed, 0xa1, 0x8f, 0xaf,
-	0x89, 0xa2, 0x2f, 0x76, 0xc1, 0x8a, 0xc8, 0xa3, 0x88, 0x08, 0xc1, 0xb3, 0x5f, 0xba, 0xa7, 0x39,
-	0x95, 0x8b, 0xfe, 0x19, 0xbc, 0x3a, 0xbb, 0x6a, 0x59, 0xe7, 0x57, 0x2d, 0xeb, 0xdb, 0x55, 0xcb,
-	0xfa, 0x70, 0xdd, 0xaa, 0x9c, 0x5f, 0xb7, 0x2a, 0x9f, 0xaf, 0x5b, 0x95, 0xb7, 0x0f, 0x6e, 0xb4,
-	0x7b, 0xc8, 0xc2, 0x6e, 0x74, 0x88, 0x28, 0xeb, 0xdf, 0x78, 0x96, 0xde, 0xff, 0xe1, 0x61, 0x0a,
-	0x17, 0xd4, 0x7b, 0x72, 0xff, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x83, 0x77, 0xa9, 0xc0,
-	0x04, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x93, 0xf4, 0x27, 0x9b, 0xa6, 0x85, 0x6d, 0xaa, 0xba, 0xad, 0x94, 0x44, 0xae, 0x54,
+	0x72, 0x49, 0x72, 0x00, 0x04, 0x07, 0x2e, 0x84, 0xaa, 0x91, 0x39, 0xd0, 0xc8, 0x16, 0x45, 0xe2,
+	0x62, 0x39, 0xde, 0xad, 0xbb, 0xaa, 0xb3, 0x6b, 0x79, 0xd7, 0x85, 0xf4, 0x29, 0xb8, 0x70, 0xe2,
+	0x35, 0xfa, 0x10, 0x3d, 0xa1, 0xaa, 0x27, 0xe0, 0x50, 0xa1, 0x56, 0xbc, 0x07, 0xf2, 0xee, 0x92,
+	0x14, 0x12, 0x8a, 0xc4, 0xc9, 0xde, 0x6f, 0xbe, 0x99, 0xd9, 0xf9, 0x66, 0x66, 0xc1, 0x76, 0x98,
+	0x60, 0x4c, 0x0f, 0x09, 0x8e, 0x50, 0xe7, 0x84, 0x24, 0x22, 0xf5, 0xa3, 0x30, 0x61, 0x69, 0xdc,
+	0x11, 0xa3, 0x18, 0xf3, 0x76, 0x9c, 0x30, 0xc1, 0xe0, 0xfa, 0x84, 0xd4, 0xbe, 0x4d, 0xda, 0xdc,
+	0x08, 0x18, 0x1f, 0x32, 0xee, 0x49, 0x5a, 0x47, 0x1d, 0x94, 0xcf, 0x66, 0x35, 0x64, 0x21, 0x53,
+	0x78, 0xf6, 0xa7, 0x50, 0xeb, 0x47, 0x1e, 0xc0, 0x5e, 0xc4, 0x06, 0x7e, 0x74, 0xa0, 0xe2, 0xf4,
+	0xb2, 0x38, 0x70, 0x19, 0xe4, 0x09, 0x32, 0x8d, 0x86, 0xd1, 0xac, 0x38, 0x79, 0x82, 0xe0, 0x16,
+	0x28, 0x1d, 0xfa, 0x43, 0x12, 0x8d, 0x3c, 0x82, 0xcc, 0xbc, 0x84, 0x17, 0x15, 0x60, 0x23, 0x68,
+	0x81, 0x4a, 0x9c, 0x90, 0xa1, 0x9f, 0x8c, 0x3c, 0x1e, 0x67, 0x84, 0x82, 0x24, 0x94, 0x35, 0xe8,
+	0xc6, 0x36, 0x82, 0x4d, 0x70, 0x8f, 0xe3, 0x80, 0x51, 0x34, 0x66, 0x71, 0xb3, 0xd8, 0x28, 0x34,
+	0x2b, 0xce, 0xf2, 0x18, 0xcf, 0x88, 0x1c, 0xd6, 0x41, 0x99, 0x0b, 0x96, 0x60, 0xe4, 0x71, 0x72,
+	0x8a, 0xcd, 0xb9, 0x86, 0xd1, 0x2c, 0x3a, 0x40, 0x41, 0x2e, 0x39, 0xc5, 0xb0, 0x0f, 0xd6, 0x75,
+	0xcd, 0x5e, 0xec, 0x8f, 0x86, 0x98, 0x0a, 0xcf, 0x47, 0x28, 0xc1, 0x9c, 0x9b, 0xf3, 0x0d, 0xa3,
+	0x59, 0xea, 0x9a, 0x97, 0x67, 0xad, 0xaa, 0xae, 0xfd, 0xb9, 0xb2, 0xb8, 0x22, 0x21, 0x34, 0x74,
+	0xd6, 0xb4, 0x63, 0x5f, 0xf9, 0x69, 0x23, 0xf4, 0x41, 0x45, 0x30, 0xe1, 0x47, 0x1e, 0xc2, 0x31,
+	0xe3, 0x44, 0x98, 0x0b, 0x32, 0xce, 0xb3, 0xf3, 0xab, 0x7a, 0xee, 0xdb, 0x55, 0x7d, 0x27, 0x24,
+	0xe2, 0x28, 0x1d, 0xb4, 0x03, 0x36, 0xd4, 0x92, 0xea, 0x4f, 0x8b, 0xa3, 0x63, 0xdd, 0x17, 0x9b,
+	0x8a, 0xcb, 0xb3, 0x16, 0xd0, 0x59, 0x6d, 0x2a, 0x9c, 0x25, 0x19, 0x72, 0x57, 0x45, 0xb4, 0xbe,
+	0x1a, 0xc0, 0x9c, 0xd6, 0x79, 0x4f, 0x4a, 0x38, 0xa5, 0xf6, 0x94, 0xa0, 0xf9, 0x69, 0x41, 0x9f,
+	0x00, 0x33, 0x94, 0xf1, 0xbc, 0x5f, 0x62, 0xc8, 0x09, 0x90, 0xc2, 0x16, 0xa4, 0xb0, 0x6b, 0xe1,
+	0x54, 0xbe, 0x4c, 0xdf, 0x3b, 0xe4, 0x2b, 0xfe, 0x97, 0x7c, 0xd6, 0x67, 0x03, 0x58, 0xd3, 0xb5,
+	0xf1, 0x2e, 0xa1, 0x88, 0xd0, 0x70, 0x9f, 0x76, 0xd3, 0xe0, 0x18, 0x0b, 0xf8, 0x14, 0x94, 0x06,
+	0xf2, 0xcf, 0xd3, 0xc5, 0x96, 0xba, 0x5b, 0x5a, 0xe1, 0xe2, 0x6b, 0x22, 0xf5, 0x2b, 0xeb, 0xb4,
+	0xd9, 0xd1, 0x59, 0x54, 0xec, 0x7f, 0xd4, 0x9a, 0xbf, 0xab, 0xd6, 0xc7, 0x60, 0x3d, 0x62, 0xc1,
+	0x1d, 0x1a, 0x55, 0xa5, 0xf9, 0x0f, 0x37, 0xeb, 0xa3, 0x01, 0xd6, 0x7a, 0x07, 0x3d, 0x57, 0xf8,
+	0x82, 0x70, 0x41, 0x02, 0xfe, 0x86, 0x88, 0x23, 0x42, 0xdd, 0x3e, 0x6c, 0x83, 0xd5, 0x6c, 0x12,
+	0xfd, 0x10, 0x67, 0x2b, 0x76, 0x42, 0x10, 0x4e, 0xbc, 0x71, 0xeb, 0xee, 0x6b, 0x53, 0x5f, 0x5b,
+	0x6c, 0x04, 0xb7, 0x27, 0x9d, 0x0c, 0x58, 0x4a, 0x85, 0xee, 0xe4, 0x92, 0x06, 0x5f, 0x64, 0x18,
+	0x7c, 0x00, 0x56, 0x26, 0xbb, 0xa1, 0x68, 0x6a, 0x83, 0x26, 0xab, 0x21, 0x89, 0xd6, 0x4b, 0x50,
+	0x76, 0xdf, 0xf9, 0xf1, 0x7e, 0x2a, 0x6c, 0x7a, 0xc8, 0xe0, 0x2a, 0x98, 0xe3, 0xf1, 0x24, 0x7d,
+	0x91, 0x67, 0x73, 0xb1, 0x03, 0x56, 0x78, 0x1a, 0x04, 0x98, 0x73, 0x96, 0xfc, 0x36, 0x3d, 0x95,
+	0x31, 0x9c, 0xcd, 0x8f, 0xf5, 0xc9, 0x00, 0x75, 0x77, 0xb2, 0x79, 0x7b, 0x2c, 0x09, 0x30, 0xd2,
+	0xd1, 0x5d, 0x12, 0xd2, 0x5d, 0x16, 0xc0, 0x0d, 0xb0, 0x18, 0x1c, 0xf9, 0x84, 0x8e, 0x1b, 0xe6,
+	0x2c, 0xc8, 0xb3, 0x8d, 0x32, 0x65, 0xff, 0xd2, 0x12, 0x9d, 0xae, 0x3a, 0xab, 0x23, 0xb3, 0x6e,
+	0x57, 0x98, 0x71, 0xbb, 0xee, 0xab, 0xf3, 0xeb, 0x9a, 0x71, 0x71, 0x5d, 0x33, 0xbe, 0x5f, 0xd7,
+	0x8c, 0x0f, 0x37, 0xb5, 0xdc, 0xc5, 0x4d, 0x2d, 0xf7, 0xe5, 0xa6, 0x96, 0x7b, 0xfb, 0xe8, 0xd6,
+	0x32, 0x0e, 0xe8, 0xa0, 0x25, 0x2f, 0xd4, 0xb9, 0xf5, 0x68, 0xbe, 0x9f, 0xf1, 0x6c, 0x0e, 0xe6,
+	0xe5, 0x6b, 0xf7, 0xf0, 0xe7, 0x00, 0xe8, 0xc8, 0xea, 0xb4, 0x5e, 0x05, 0x00, 0x00,
 }
 
 func (m *GlobalVirtualGroup) Marshal() (dAtA []byte, err error) {
@@ -700,6 +765,46 @@ func (m *SwapOutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SecondarySpForcedSwapOutSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecondarySpForcedSwapOutSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecondarySpForcedSwapOutSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuccessorSpId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SuccessorSpId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GlobalVirtualGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -829,6 +934,25 @@ func (m *SwapOutInfo) Size() (n int) {
 	return n
 }
 
+func (m *SecondarySpForcedSwapOutSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GlobalVirtualGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GlobalVirtualGroupId))
+	}
+	if m.SuccessorSpId != 0 {
+		n += 1 + sovTypes(uint64(m.SuccessorSpId))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1730,6 +1854,126 @@ func (m *SwapOutInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SecondarySpForcedSwapOutSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecondarySpForcedSwapOutSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecondarySpForcedSwapOutSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalVirtualGroupId", wireType)
+			}
+			m.GlobalVirtualGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GlobalVirtualGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessorSpId", wireType)
+			}
+			m.SuccessorSpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessorSpId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0